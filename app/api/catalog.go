@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"io"
+)
+
+// Supported formats of catalog files.
+const (
+	CatalogFormatJSONL = "jsonl"
+	CatalogFormatCSV   = "csv"
+	CatalogFormatYAML  = "yaml"
+)
+
+// Supported modes of catalog import.
+const (
+	// CatalogImportModeUpsert creates or updates the items of the file and
+	// keeps all other items.
+	CatalogImportModeUpsert = "upsert"
+	// CatalogImportModeReplace makes the catalog exactly the items of the file,
+	// items missing from the file are deleted.
+	CatalogImportModeReplace = "replace"
+)
+
+// Operations of a catalog change.
+const (
+	CatalogChangeCreate = "create"
+	CatalogChangeUpdate = "update"
+	CatalogChangeDelete = "delete"
+)
+
+// CatalogService exposes all available use cases of bulk catalog management.
+type CatalogService interface {
+	ExportCatalog(ctx context.Context, req *ExportCatalogRequest) error
+	ImportCatalog(ctx context.Context, req *ImportCatalogRequest) (*ImportCatalogResponse, error)
+}
+
+// CatalogItem is the representation of an item in catalog files.
+//
+// Translations are left untouched on import when nil, CSV files never carry
+// them.
+type CatalogItem struct {
+	ID           string                    `json:"id" yaml:"id"`
+	Name         string                    `json:"name" yaml:"name"`
+	Category     string                    `json:"category,omitempty" yaml:"category,omitempty"`
+	Description  string                    `json:"description,omitempty" yaml:"description,omitempty"`
+	Translations []*CatalogItemTranslation `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+// CatalogItemTranslation is the representation of an item translation in
+// catalog files.
+type CatalogItemTranslation struct {
+	Locale      string `json:"locale" yaml:"locale"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// ExportCatalogRequest represents a request for exporting the catalog into
+// Writer.
+type ExportCatalogRequest struct {
+	Format string
+	Writer io.Writer
+}
+
+// ImportCatalogRequest represents a request for importing the catalog from
+// Reader. Nothing is written when DryRun is set.
+type ImportCatalogRequest struct {
+	Format string
+	Mode   string
+	DryRun bool
+	Reader io.Reader
+}
+
+// ImportCatalogResponse represents a response for importing the catalog.
+// Changes are applied only when there is no validation error.
+type ImportCatalogResponse struct {
+	Applied   bool                      `json:"applied"`
+	Version   int64                     `json:"version,omitempty"`
+	Created   int                       `json:"created"`
+	Updated   int                       `json:"updated"`
+	Deleted   int                       `json:"deleted"`
+	Unchanged int                       `json:"unchanged"`
+	Changes   []*CatalogChange          `json:"changes,omitempty"`
+	Errors    []*CatalogValidationError `json:"errors,omitempty"`
+}
+
+// CatalogChange describes the change of an item.
+type CatalogChange struct {
+	Op     string                `json:"op"`
+	ItemID string                `json:"itemID"`
+	Fields []*CatalogFieldChange `json:"fields,omitempty"`
+}
+
+// CatalogFieldChange describes the change of a field of an item.
+type CatalogFieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// CatalogValidationError describes why an item of the file is invalid. Record
+// is the 1-based position of the item in the file.
+type CatalogValidationError struct {
+	Record  int    `json:"record"`
+	ItemID  string `json:"itemID,omitempty"`
+	Message string `json:"message"`
+}
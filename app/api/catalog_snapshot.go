@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/render"
+)
+
+// Supported formats of catalog snapshots.
+const (
+	CatalogSnapshotFormatJSON     = "json"
+	CatalogSnapshotFormatProtobuf = "protobuf"
+)
+
+// Content types of catalog snapshots by format.
+const (
+	CatalogSnapshotContentTypeJSON     = "application/json"
+	CatalogSnapshotContentTypeProtobuf = "application/x-protobuf"
+)
+
+// CatalogSnapshotService exposes all available use cases of catalog
+// downloads by game clients.
+type CatalogSnapshotService interface {
+	GetCatalogManifest(ctx context.Context, req *GetCatalogManifestRequest) (*CatalogManifest, error)
+	GetCatalogSnapshot(ctx context.Context, req *GetCatalogSnapshotRequest) (*CatalogSnapshotBlob, error)
+}
+
+// CatalogManifest describes the current version of the catalog.
+//
+// +smkit:rest:resource=true
+type CatalogManifest struct {
+	Version int64 `json:"version"`
+	// Hash is the hex encoded SHA-256 of the JSON snapshot of the version.
+	Hash string `json:"hash"`
+	// URL is the path to download the snapshot of the version from.
+	URL string `json:"url"`
+}
+
+// CatalogSnapshot is the full catalog of a version, items are ordered by id
+// and their translations by locale. Live events, ordered by id, give the
+// windows items of events are available in.
+type CatalogSnapshot struct {
+	Version    int64               `json:"version"`
+	Items      []*CatalogItem      `json:"items"`
+	LiveEvents []*CatalogLiveEvent `json:"liveEvents,omitempty"`
+}
+
+// CatalogLiveEvent is a live event of a snapshot, its items are only
+// available from StartAt until EndAt, in unix seconds.
+type CatalogLiveEvent struct {
+	ID          string   `json:"id"`
+	Kind        string   `json:"kind"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	StartAt     int64    `json:"startAt"`
+	EndAt       int64    `json:"endAt"`
+	ItemIDs     []string `json:"itemIDs"`
+}
+
+// CatalogDelta is the difference between two versions of the catalog:
+// upserted items are the created and updated ones with all their
+// translations, upserted live events the created and updated ones.
+type CatalogDelta struct {
+	From             int64               `json:"from"`
+	To               int64               `json:"to"`
+	Upserts          []*CatalogItem      `json:"upserts"`
+	Deletes          []string            `json:"deletes"`
+	LiveEventUpserts []*CatalogLiveEvent `json:"liveEventUpserts,omitempty"`
+	LiveEventDeletes []string            `json:"liveEventDeletes,omitempty"`
+}
+
+// GetCatalogManifestRequest represents a request for the catalog manifest.
+type GetCatalogManifestRequest struct{}
+
+// GetCatalogSnapshotRequest represents a request for the snapshot of a
+// version, or for its delta from the version From, -1 when not requested.
+type GetCatalogSnapshotRequest struct {
+	Version int64  `json:"-"`
+	From    int64  `json:"-" query:"from"`
+	Format  string `json:"-" query:"format"`
+}
+
+// CatalogSnapshotBlob is an encoded snapshot or delta.
+type CatalogSnapshotBlob struct {
+	ContentType string
+	// ETag is a strong entity tag of the uncompressed blob.
+	ETag string
+	// Data is the gzip compressed blob.
+	Data []byte
+}
+
+func (g *GetCatalogManifestRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (c *CatalogManifest) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Cache-Control", "no-cache")
+	render.Status(r, 200)
+	return nil
+}
+
+func (g *GetCatalogSnapshotRequest) Bind(r *http.Request) error {
+	var err error
+	if g.Version, err = pathInt64(r, "version"); err != nil {
+		return err
+	}
+
+	from, err := queryInt(r, "from", -1)
+	if err != nil {
+		return err
+	}
+	if from >= 0 && int64(from) >= g.Version {
+		return fmt.Errorf("from: must be lower than the version")
+	}
+	g.From = int64(from)
+
+	g.Format = r.URL.Query().Get("format")
+	if g.Format == "" {
+		g.Format = CatalogSnapshotFormatJSON
+		if strings.Contains(r.Header.Get("Accept"), CatalogSnapshotContentTypeProtobuf) {
+			g.Format = CatalogSnapshotFormatProtobuf
+		}
+	}
+	if g.Format != CatalogSnapshotFormatJSON && g.Format != CatalogSnapshotFormatProtobuf {
+		return fmt.Errorf("format: must be %s or %s", CatalogSnapshotFormatJSON, CatalogSnapshotFormatProtobuf)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/render"
+)
+
+// ChangesetService exposes all available use cases of staged catalog
+// publishing: changes are drafted in a changeset, submitted for review,
+// approved by another principal, then published as a new catalog version.
+type ChangesetService interface {
+	CreateChangeset(ctx context.Context, req *CreateChangesetRequest) (*Changeset, error)
+	GetChangeset(ctx context.Context, req *ChangesetRequest) (*Changeset, error)
+	PutChangesetChanges(ctx context.Context, req *PutChangesetChangesRequest) (*Changeset, error)
+	SubmitChangeset(ctx context.Context, req *ChangesetRequest) (*Changeset, error)
+	ReviewChangeset(ctx context.Context, req *ReviewChangesetRequest) (*Changeset, error)
+	PublishChangeset(ctx context.Context, req *ChangesetRequest) (*Changeset, error)
+}
+
+// Changeset rest resource.
+//
+// +smkit:rest:resource=true
+type Changeset struct {
+	ID     int64  `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	Author string `json:"author"`
+	// Editors are the principals who edited the changeset, the author first.
+	Editors  []string `json:"editors"`
+	Reviewer string   `json:"reviewer,omitempty"`
+	// CatalogVersion is the version of the catalog the changeset was
+	// published as.
+	CatalogVersion int64              `json:"catalogVersion,omitempty"`
+	Changes        []*ChangesetChange `json:"changes"`
+	CreatedAt      int64              `json:"createdAt"`
+	UpdatedAt      int64              `json:"updatedAt"`
+}
+
+// ChangesetChange is the change of an item, op is "upsert" with the new
+// version of the item, or "delete" with only its id.
+type ChangesetChange struct {
+	Op string `json:"op"`
+	CatalogItem
+}
+
+// ChangesetRequest represents a request on the changeset of the path.
+type ChangesetRequest struct {
+	ID int64 `json:"-"`
+}
+
+// CreateChangesetRequest represents a request for creating a draft
+// changeset.
+type CreateChangesetRequest struct {
+	Title   string             `json:"title"`
+	Changes []*ChangesetChange `json:"changes"`
+}
+
+// PutChangesetChangesRequest represents a request for creating or replacing
+// changes of a draft changeset by item id.
+type PutChangesetChangesRequest struct {
+	ID      int64              `json:"-"`
+	Changes []*ChangesetChange `json:"changes"`
+}
+
+// ReviewChangesetRequest represents a request for approving or rejecting a
+// changeset in review.
+type ReviewChangesetRequest struct {
+	ID      int64 `json:"-"`
+	Approve bool  `json:"approve"`
+}
+
+func (c *ChangesetRequest) Bind(r *http.Request) error {
+	var err error
+	c.ID, err = pathInt64(r, "id")
+	return err
+}
+
+func (c *CreateChangesetRequest) Bind(r *http.Request) error {
+	c.Title = strings.TrimSpace(c.Title)
+	if c.Title == "" {
+		return errors.New("title: is required")
+	}
+
+	return nil
+}
+
+func (p *PutChangesetChangesRequest) Bind(r *http.Request) error {
+	var err error
+	p.ID, err = pathInt64(r, "id")
+	return err
+}
+
+func (rv *ReviewChangesetRequest) Bind(r *http.Request) error {
+	var err error
+	rv.ID, err = pathInt64(r, "id")
+	return err
+}
+
+func (c *Changeset) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
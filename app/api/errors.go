@@ -0,0 +1,14 @@
+package api
+
+import "errors"
+
+// Errors returned by services, wrapped with details.
+var (
+	// ErrNotFound is returned when the requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidArgument is returned for invalid requests.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrConflict is returned when the state of the resource does not allow
+	// the request.
+	ErrConflict = errors.New("conflict")
+)
@@ -0,0 +1,267 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/i18n"
+)
+
+// CatalogService implements all use cases of bulk catalog management.
+type CatalogService struct {
+	itemRepo        repo.ItemRepo
+	translationRepo repo.ItemTranslationRepo
+	catalogRepo     repo.CatalogRepo
+}
+
+// NewCatalogService creates and returns new instance of CatalogService.
+func NewCatalogService(
+	itemRepo repo.ItemRepo,
+	translationRepo repo.ItemTranslationRepo,
+	catalogRepo repo.CatalogRepo,
+) api.CatalogService {
+	return &CatalogService{
+		itemRepo:        itemRepo,
+		translationRepo: translationRepo,
+		catalogRepo:     catalogRepo,
+	}
+}
+
+// ExportCatalog writes all items with their translations in the requested
+// format, ordered by item id.
+func (s *CatalogService) ExportCatalog(ctx context.Context, req *api.ExportCatalogRequest) error {
+	current, err := s.loadCatalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	items := make([]*api.CatalogItem, 0, len(current))
+	for _, i := range current {
+		items = append(items, i)
+	}
+	sort.Slice(items, func(a, b int) bool {
+		return items[a].ID < items[b].ID
+	})
+
+	return encodeCatalog(req.Writer, req.Format, items)
+}
+
+// ImportCatalog validates the items read in the requested format, computes
+// their diff against the current catalog and, unless it is a dry run or
+// validation failed, applies the diff in one transaction.
+func (s *CatalogService) ImportCatalog(ctx context.Context, req *api.ImportCatalogRequest) (*api.ImportCatalogResponse, error) {
+	mode := req.Mode
+	if mode == "" {
+		mode = api.CatalogImportModeUpsert
+	}
+	if mode != api.CatalogImportModeUpsert && mode != api.CatalogImportModeReplace {
+		return nil, fmt.Errorf("unsupported catalog import mode %q", req.Mode)
+	}
+
+	items, err := decodeCatalog(req.Reader, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ImportCatalogResponse{
+		Errors: validateCatalog(items),
+	}
+
+	current, err := s.loadCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &repo.CatalogChanges{
+		ReplaceTranslations: make(map[string][]*entity.ItemTranslation),
+	}
+	seen := make(map[string]bool)
+	for _, i := range items {
+		seen[i.ID] = true
+		old, ok := current[i.ID]
+		if !ok {
+			res.Created++
+			res.Changes = append(res.Changes, &api.CatalogChange{
+				Op:     api.CatalogChangeCreate,
+				ItemID: i.ID,
+			})
+		} else if fields := diffCatalogItem(old, i); len(fields) > 0 {
+			res.Updated++
+			res.Changes = append(res.Changes, &api.CatalogChange{
+				Op:     api.CatalogChangeUpdate,
+				ItemID: i.ID,
+				Fields: fields,
+			})
+		} else {
+			res.Unchanged++
+			continue
+		}
+
+		changes.UpsertItems = append(changes.UpsertItems, &entity.Item{
+			ID:          i.ID,
+			Name:        i.Name,
+			Category:    i.Category,
+			Description: i.Description,
+		})
+		if i.Translations != nil {
+			translations := make([]*entity.ItemTranslation, 0, len(i.Translations))
+			for _, t := range i.Translations {
+				translations = append(translations, &entity.ItemTranslation{
+					ItemID:      i.ID,
+					Locale:      i18n.Normalize(t.Locale),
+					Name:        t.Name,
+					Description: t.Description,
+				})
+			}
+			changes.ReplaceTranslations[i.ID] = translations
+		}
+	}
+
+	if mode == api.CatalogImportModeReplace {
+		var deleted []string
+		for id := range current {
+			if !seen[id] {
+				deleted = append(deleted, id)
+			}
+		}
+		sort.Strings(deleted)
+
+		for _, id := range deleted {
+			res.Deleted++
+			res.Changes = append(res.Changes, &api.CatalogChange{
+				Op:     api.CatalogChangeDelete,
+				ItemID: id,
+			})
+		}
+		changes.DeleteItemIDs = deleted
+	}
+
+	if req.DryRun || len(res.Errors) > 0 || len(res.Changes) == 0 {
+		return res, nil
+	}
+
+	version, err := s.catalogRepo.Apply(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+	res.Applied = true
+	res.Version = version
+
+	return res, nil
+}
+
+// loadCatalog loads all items with their translations keyed by item id.
+func (s *CatalogService) loadCatalog(ctx context.Context) (map[string]*api.CatalogItem, error) {
+	items, err := s.itemRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.translationRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*api.CatalogItem, len(items))
+	for _, i := range items {
+		res[i.ID] = &api.CatalogItem{
+			ID:          i.ID,
+			Name:        i.Name,
+			Category:    i.Category,
+			Description: i.Description,
+		}
+	}
+
+	for _, t := range translations {
+		if i, ok := res[t.ItemID]; ok {
+			i.Translations = append(i.Translations, &api.CatalogItemTranslation{
+				Locale:      t.Locale,
+				Name:        t.Name,
+				Description: t.Description,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// validateCatalog reports all invalid items of a catalog file.
+func validateCatalog(items []*api.CatalogItem) []*api.CatalogValidationError {
+	var res []*api.CatalogValidationError
+	report := func(record int, itemID, format string, args ...interface{}) {
+		res = append(res, &api.CatalogValidationError{
+			Record:  record,
+			ItemID:  itemID,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	records := make(map[string]int)
+	for idx, i := range items {
+		record := idx + 1
+		if strings.TrimSpace(i.ID) == "" {
+			report(record, i.ID, "id is required")
+		} else if first, ok := records[i.ID]; ok {
+			report(record, i.ID, "duplicate id, first seen in record %d", first)
+		} else {
+			records[i.ID] = record
+		}
+
+		if strings.TrimSpace(i.Name) == "" {
+			report(record, i.ID, "name is required")
+		}
+
+		locales := make(map[string]bool)
+		for _, t := range i.Translations {
+			locale := i18n.Normalize(t.Locale)
+			switch {
+			case locale == "":
+				report(record, i.ID, "translation locale is required")
+			case locale == i18n.DefaultLocale:
+				report(record, i.ID, "translation locale %q is the default locale", locale)
+			case locales[locale]:
+				report(record, i.ID, "duplicate translation locale %q", locale)
+			}
+			locales[locale] = true
+		}
+	}
+
+	return res
+}
+
+// diffCatalogItem returns the changed fields between the current and the
+// imported version of an item. Translations are compared only when the
+// imported item carries them.
+func diffCatalogItem(current, imported *api.CatalogItem) []*api.CatalogFieldChange {
+	var res []*api.CatalogFieldChange
+	diff := func(field, o, n string) {
+		if o != n {
+			res = append(res, &api.CatalogFieldChange{Field: field, Old: o, New: n})
+		}
+	}
+
+	diff("name", current.Name, imported.Name)
+	diff("category", current.Category, imported.Category)
+	diff("description", current.Description, imported.Description)
+	if imported.Translations != nil {
+		diff("translations", formatTranslations(current.Translations), formatTranslations(imported.Translations))
+	}
+
+	return res
+}
+
+// formatTranslations formats translations into a comparable string.
+func formatTranslations(translations []*api.CatalogItemTranslation) string {
+	parts := make([]string, 0, len(translations))
+	for _, t := range translations {
+		parts = append(parts, fmt.Sprintf("%s=%q/%q", i18n.Normalize(t.Locale), t.Name, t.Description))
+	}
+	sort.Strings(parts)
+
+	return strings.Join(parts, ", ")
+}
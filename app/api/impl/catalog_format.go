@@ -0,0 +1,126 @@
+package impl
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/nhatquangsin/game-service/app/api"
+)
+
+// catalogCSVHeader is the header row of catalog CSV files.
+var catalogCSVHeader = []string{"id", "name", "category", "description"}
+
+// encodeCatalog writes catalog items in the given format.
+func encodeCatalog(w io.Writer, format string, items []*api.CatalogItem) error {
+	switch format {
+	case api.CatalogFormatJSONL:
+		enc := json.NewEncoder(w)
+		for _, i := range items {
+			if err := enc.Encode(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	case api.CatalogFormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(catalogCSVHeader); err != nil {
+			return err
+		}
+		for _, i := range items {
+			if err := cw.Write([]string{i.ID, i.Name, i.Category, i.Description}); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case api.CatalogFormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(items); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("unsupported catalog format %q", format)
+	}
+}
+
+// decodeCatalog reads catalog items in the given format.
+func decodeCatalog(r io.Reader, format string) ([]*api.CatalogItem, error) {
+	switch format {
+	case api.CatalogFormatJSONL:
+		var res []*api.CatalogItem
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for line := 1; scanner.Scan(); line++ {
+			if strings.TrimSpace(scanner.Text()) == "" {
+				continue
+			}
+
+			i := &api.CatalogItem{}
+			if err := json.Unmarshal(scanner.Bytes(), i); err != nil {
+				return nil, fmt.Errorf("jsonl: line %d: %w", line, err)
+			}
+			res = append(res, i)
+		}
+		return res, scanner.Err()
+	case api.CatalogFormatCSV:
+		cr := csv.NewReader(r)
+		cr.TrimLeadingSpace = true
+		header, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cols := make(map[string]int)
+		for i, h := range header {
+			cols[h] = i
+		}
+		if _, ok := cols["id"]; !ok {
+			return nil, fmt.Errorf("csv: missing column %q", "id")
+		}
+
+		get := func(record []string, col string) string {
+			if i, ok := cols[col]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		var res []*api.CatalogItem
+		for {
+			record, err := cr.Read()
+			if errors.Is(err, io.EOF) {
+				return res, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, &api.CatalogItem{
+				ID:          get(record, "id"),
+				Name:        get(record, "name"),
+				Category:    get(record, "category"),
+				Description: get(record, "description"),
+			})
+		}
+	case api.CatalogFormatYAML:
+		var res []*api.CatalogItem
+		if err := yaml.NewDecoder(r).Decode(&res); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported catalog format %q", format)
+	}
+}
@@ -0,0 +1,374 @@
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/cache"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// maxSnapshotBlobs is the max number of encoded snapshots and deltas kept in
+// memory.
+const maxSnapshotBlobs = 32
+
+// CatalogSnapshotService implements all use cases of catalog downloads.
+//
+// A snapshot of each version is saved when the version is loaded by the
+// catalog, snapshots being immutable their encodings are kept in memory.
+type CatalogSnapshotService struct {
+	snapshotRepo repo.CatalogSnapshotRepo
+	catalog      *cache.Catalog
+
+	mu    sync.Mutex
+	blobs map[string]*api.CatalogSnapshotBlob
+}
+
+// catalogSnapshotServicePermissions declares the permission each
+// CatalogSnapshotService method requires.
+var catalogSnapshotServicePermissions = struct {
+	GetCatalogManifest string
+	GetCatalogSnapshot string
+}{
+	GetCatalogManifest: auth.PermissionCatalogRead,
+	GetCatalogSnapshot: auth.PermissionCatalogRead,
+}
+
+// catalogSnapshotServiceTx declares the transaction each
+// CatalogSnapshotService method runs in.
+var catalogSnapshotServiceTx = struct {
+	GetCatalogManifest []database.TxOption
+	GetCatalogSnapshot []database.TxOption
+}{
+	GetCatalogManifest: []database.TxOption{database.TxOptions.ReadOnly()},
+	GetCatalogSnapshot: []database.TxOption{database.TxOptions.ReadOnly()},
+}
+
+// NewCatalogSnapshotService creates and returns new instance of
+// CatalogSnapshotService, its methods run through the endpoint middlewares
+// configured for them, for callers granted the permissions declared by
+// catalogSnapshotServicePermissions, in the transactions declared by
+// catalogSnapshotServiceTx.
+func NewCatalogSnapshotService(
+	snapshotRepo repo.CatalogSnapshotRepo,
+	catalog *cache.Catalog,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.CatalogSnapshotService, error) {
+	svc := &CatalogSnapshotService{
+		snapshotRepo: snapshotRepo,
+		catalog:      catalog,
+		blobs:        make(map[string]*api.CatalogSnapshotBlob),
+	}
+
+	save := func(ctx context.Context, items *cache.CachedItems) {
+		if err := svc.save(ctx, items); err != nil {
+			log.Printf("impl: saving snapshot of catalog version %d: %v", items.Version, err)
+		}
+	}
+	save(context.Background(), catalog.Items())
+	catalog.OnLoad(save)
+
+	perms, tx := catalogSnapshotServicePermissions, catalogSnapshotServiceTx
+	getManifest, err := middleware(endpoints, "catalog.manifest", policy,
+		perms.GetCatalogManifest, client, tx.GetCatalogManifest...)
+	if err != nil {
+		return nil, err
+	}
+	getSnapshot, err := middleware(endpoints, "catalog.snapshot", policy,
+		perms.GetCatalogSnapshot, client, tx.GetCatalogSnapshot...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txCatalogSnapshotService{
+		getCatalogManifest: wrap(svc.GetCatalogManifest, getManifest),
+		getCatalogSnapshot: wrap(svc.GetCatalogSnapshot, getSnapshot),
+	}, nil
+}
+
+// txCatalogSnapshotService runs the methods of CatalogSnapshotService
+// through their middlewares.
+type txCatalogSnapshotService struct {
+	getCatalogManifest func(context.Context, *api.GetCatalogManifestRequest) (*api.CatalogManifest, error)
+	getCatalogSnapshot func(context.Context, *api.GetCatalogSnapshotRequest) (*api.CatalogSnapshotBlob, error)
+}
+
+// GetCatalogManifest implements api.CatalogSnapshotService.
+func (s *txCatalogSnapshotService) GetCatalogManifest(ctx context.Context, req *api.GetCatalogManifestRequest) (*api.CatalogManifest, error) {
+	return s.getCatalogManifest(ctx, req)
+}
+
+// GetCatalogSnapshot implements api.CatalogSnapshotService.
+func (s *txCatalogSnapshotService) GetCatalogSnapshot(ctx context.Context, req *api.GetCatalogSnapshotRequest) (*api.CatalogSnapshotBlob, error) {
+	return s.getCatalogSnapshot(ctx, req)
+}
+
+// GetCatalogManifest returns the version and hash of the catalog served by
+// this replica.
+func (s *CatalogSnapshotService) GetCatalogManifest(ctx context.Context, req *api.GetCatalogManifestRequest) (*api.CatalogManifest, error) {
+	snapshot, err := s.find(ctx, s.catalog.Items().Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CatalogManifest{
+		Version: snapshot.Version,
+		Hash:    snapshot.Hash,
+		URL:     fmt.Sprintf("/catalog/%d", snapshot.Version),
+	}, nil
+}
+
+// GetCatalogSnapshot returns the gzip compressed snapshot of a version, or its
+// delta from an older version, in the requested format.
+func (s *CatalogSnapshotService) GetCatalogSnapshot(ctx context.Context, req *api.GetCatalogSnapshotRequest) (*api.CatalogSnapshotBlob, error) {
+	key := fmt.Sprintf("%d/%d/%s", req.Version, req.From, req.Format)
+	s.mu.Lock()
+	blob, ok := s.blobs[key]
+	s.mu.Unlock()
+	if ok {
+		return blob, nil
+	}
+
+	to, err := s.find(ctx, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.From < 0 && req.Format == api.CatalogSnapshotFormatJSON {
+		blob = &api.CatalogSnapshotBlob{
+			ContentType: api.CatalogSnapshotContentTypeJSON,
+			ETag:        to.Hash,
+			Data:        to.Data,
+		}
+	} else {
+		toSnapshot, err := decodeSnapshot(to)
+		if err != nil {
+			return nil, err
+		}
+
+		var v interface{} = toSnapshot
+		if req.From >= 0 {
+			from, err := s.find(ctx, req.From)
+			if err != nil {
+				return nil, err
+			}
+			fromSnapshot, err := decodeSnapshot(from)
+			if err != nil {
+				return nil, err
+			}
+			v = diffSnapshots(fromSnapshot, toSnapshot)
+		}
+
+		if blob, err = encodeSnapshotBlob(v, req.Format); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.blobs) >= maxSnapshotBlobs {
+		for k := range s.blobs {
+			delete(s.blobs, k)
+			break
+		}
+	}
+	s.blobs[key] = blob
+
+	return blob, nil
+}
+
+// find returns the snapshot of a version, built from the catalog if it is
+// the current version and not saved yet.
+func (s *CatalogSnapshotService) find(ctx context.Context, version int64) (*entity.CatalogSnapshot, error) {
+	snapshot, err := s.snapshotRepo.FindByVersion(ctx, version)
+	if errors.Is(err, repo.ErrNotFound) {
+		if items := s.catalog.Items(); items.Version == version {
+			return newSnapshot(items)
+		}
+		return nil, fmt.Errorf("%w: catalog version %d", api.ErrNotFound, version)
+	}
+
+	return snapshot, err
+}
+
+// save saves the snapshot of items unless their version has one.
+func (s *CatalogSnapshotService) save(ctx context.Context, items *cache.CachedItems) error {
+	if _, err := s.snapshotRepo.FindByVersion(ctx, items.Version); !errors.Is(err, repo.ErrNotFound) {
+		return err
+	}
+
+	snapshot, err := newSnapshot(items)
+	if err != nil {
+		return err
+	}
+
+	return s.snapshotRepo.Save(ctx, snapshot)
+}
+
+// newSnapshot builds the snapshot of items.
+func newSnapshot(items *cache.CachedItems) (*entity.CatalogSnapshot, error) {
+	res := &api.CatalogSnapshot{
+		Version: items.Version,
+		Items:   make([]*api.CatalogItem, 0, len(items.Items)),
+	}
+	for _, i := range items.Items {
+		item := &api.CatalogItem{
+			ID:          i.ID,
+			Name:        i.Name,
+			Category:    i.Category,
+			Description: i.Description,
+		}
+		for _, t := range items.Translations[i.ID] {
+			item.Translations = append(item.Translations, &api.CatalogItemTranslation{
+				Locale:      t.Locale,
+				Name:        t.Name,
+				Description: t.Description,
+			})
+		}
+		sort.Slice(item.Translations, func(a, b int) bool {
+			return item.Translations[a].Locale < item.Translations[b].Locale
+		})
+		res.Items = append(res.Items, item)
+	}
+	sort.Slice(res.Items, func(a, b int) bool {
+		return res.Items[a].ID < res.Items[b].ID
+	})
+	for _, e := range items.LiveEvents {
+		res.LiveEvents = append(res.LiveEvents, &api.CatalogLiveEvent{
+			ID:          e.ID,
+			Kind:        e.Kind,
+			Name:        e.Name,
+			Description: e.Description,
+			StartAt:     e.StartAt,
+			EndAt:       e.EndAt,
+			ItemIDs:     e.ItemIDs,
+		})
+	}
+	sort.Slice(res.LiveEvents, func(a, b int) bool {
+		return res.LiveEvents[a].ID < res.LiveEvents[b].ID
+	})
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+
+	gz, err := gzipBytes(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CatalogSnapshot{
+		Version: items.Version,
+		Hash:    hex.EncodeToString(sum[:]),
+		Data:    gz,
+	}, nil
+}
+
+// decodeSnapshot decodes the data of a saved snapshot.
+func decodeSnapshot(snapshot *entity.CatalogSnapshot) (*api.CatalogSnapshot, error) {
+	data, err := gunzipBytes(snapshot.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var res api.CatalogSnapshot
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// diffSnapshots returns the delta turning from into to.
+func diffSnapshots(from, to *api.CatalogSnapshot) *api.CatalogDelta {
+	res := &api.CatalogDelta{
+		From:    from.Version,
+		To:      to.Version,
+		Upserts: []*api.CatalogItem{},
+		Deletes: []string{},
+	}
+
+	old := make(map[string]*api.CatalogItem, len(from.Items))
+	for _, i := range from.Items {
+		old[i.ID] = i
+	}
+	for _, i := range to.Items {
+		if o, ok := old[i.ID]; !ok || !reflect.DeepEqual(o, i) {
+			res.Upserts = append(res.Upserts, i)
+		}
+		delete(old, i.ID)
+	}
+	for id := range old {
+		res.Deletes = append(res.Deletes, id)
+	}
+	sort.Strings(res.Deletes)
+
+	oldEvents := make(map[string]*api.CatalogLiveEvent, len(from.LiveEvents))
+	for _, e := range from.LiveEvents {
+		oldEvents[e.ID] = e
+	}
+	for _, e := range to.LiveEvents {
+		if o, ok := oldEvents[e.ID]; !ok || !reflect.DeepEqual(o, e) {
+			res.LiveEventUpserts = append(res.LiveEventUpserts, e)
+		}
+		delete(oldEvents, e.ID)
+	}
+	for id := range oldEvents {
+		res.LiveEventDeletes = append(res.LiveEventDeletes, id)
+	}
+	sort.Strings(res.LiveEventDeletes)
+
+	return res
+}
+
+// encodeSnapshotBlob encodes a snapshot or a delta in the given format.
+func encodeSnapshotBlob(v interface{}, format string) (*api.CatalogSnapshotBlob, error) {
+	res := &api.CatalogSnapshotBlob{}
+
+	var data []byte
+	switch format {
+	case api.CatalogSnapshotFormatJSON:
+		res.ContentType = api.CatalogSnapshotContentTypeJSON
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	case api.CatalogSnapshotFormatProtobuf:
+		res.ContentType = api.CatalogSnapshotContentTypeProtobuf
+		switch v := v.(type) {
+		case *api.CatalogSnapshot:
+			data = encodeSnapshotProto(v)
+		case *api.CatalogDelta:
+			data = encodeDeltaProto(v)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported snapshot format %q", api.ErrInvalidArgument, format)
+	}
+
+	sum := sha256.Sum256(data)
+	res.ETag = hex.EncodeToString(sum[:])
+
+	var err error
+	if res.Data, err = gzipBytes(data); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,176 @@
+package impl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+
+	"github.com/nhatquangsin/game-service/app/api"
+)
+
+// Catalog snapshots are encoded in protobuf by hand, following:
+//
+//	message CatalogSnapshot {
+//	  int64 version = 1;
+//	  repeated CatalogItem items = 2;
+//	  repeated CatalogLiveEvent live_events = 3;
+//	}
+//
+//	message CatalogDelta {
+//	  int64 from = 1;
+//	  int64 to = 2;
+//	  repeated CatalogItem upserts = 3;
+//	  repeated string deletes = 4;
+//	  repeated CatalogLiveEvent live_event_upserts = 5;
+//	  repeated string live_event_deletes = 6;
+//	}
+//
+//	message CatalogItem {
+//	  string id = 1;
+//	  string name = 2;
+//	  string category = 3;
+//	  string description = 4;
+//	  repeated CatalogItemTranslation translations = 5;
+//	}
+//
+//	message CatalogItemTranslation {
+//	  string locale = 1;
+//	  string name = 2;
+//	  string description = 3;
+//	}
+//
+//	message CatalogLiveEvent {
+//	  string id = 1;
+//	  string kind = 2;
+//	  string name = 3;
+//	  string description = 4;
+//	  int64 start_at = 5;
+//	  int64 end_at = 6;
+//	  repeated string item_ids = 7;
+//	}
+
+// Protobuf wire types.
+const (
+	protoVarint = 0
+	protoBytes  = 2
+)
+
+// encodeSnapshotProto encodes a snapshot as a CatalogSnapshot message.
+func encodeSnapshotProto(s *api.CatalogSnapshot) []byte {
+	var b []byte
+	b = appendProtoInt64(b, 1, s.Version)
+	for _, i := range s.Items {
+		b = appendProtoBytes(b, 2, encodeCatalogItemProto(i))
+	}
+	for _, e := range s.LiveEvents {
+		b = appendProtoBytes(b, 3, encodeCatalogLiveEventProto(e))
+	}
+
+	return b
+}
+
+// encodeDeltaProto encodes a delta as a CatalogDelta message.
+func encodeDeltaProto(d *api.CatalogDelta) []byte {
+	var b []byte
+	b = appendProtoInt64(b, 1, d.From)
+	b = appendProtoInt64(b, 2, d.To)
+	for _, i := range d.Upserts {
+		b = appendProtoBytes(b, 3, encodeCatalogItemProto(i))
+	}
+	for _, id := range d.Deletes {
+		b = appendProtoBytes(b, 4, []byte(id))
+	}
+	for _, e := range d.LiveEventUpserts {
+		b = appendProtoBytes(b, 5, encodeCatalogLiveEventProto(e))
+	}
+	for _, id := range d.LiveEventDeletes {
+		b = appendProtoBytes(b, 6, []byte(id))
+	}
+
+	return b
+}
+
+func encodeCatalogItemProto(i *api.CatalogItem) []byte {
+	var b []byte
+	b = appendProtoString(b, 1, i.ID)
+	b = appendProtoString(b, 2, i.Name)
+	b = appendProtoString(b, 3, i.Category)
+	b = appendProtoString(b, 4, i.Description)
+	for _, t := range i.Translations {
+		var tb []byte
+		tb = appendProtoString(tb, 1, t.Locale)
+		tb = appendProtoString(tb, 2, t.Name)
+		tb = appendProtoString(tb, 3, t.Description)
+		b = appendProtoBytes(b, 5, tb)
+	}
+
+	return b
+}
+
+func encodeCatalogLiveEventProto(e *api.CatalogLiveEvent) []byte {
+	var b []byte
+	b = appendProtoString(b, 1, e.ID)
+	b = appendProtoString(b, 2, e.Kind)
+	b = appendProtoString(b, 3, e.Name)
+	b = appendProtoString(b, 4, e.Description)
+	b = appendProtoInt64(b, 5, e.StartAt)
+	b = appendProtoInt64(b, 6, e.EndAt)
+	for _, id := range e.ItemIDs {
+		b = appendProtoBytes(b, 7, []byte(id))
+	}
+
+	return b
+}
+
+// appendProtoInt64 appends an int64 field, omitted when zero as proto3 does.
+func appendProtoInt64(b []byte, field int, v int64) []byte {
+	if v == 0 {
+		return b
+	}
+
+	b = binary.AppendUvarint(b, uint64(field)<<3|protoVarint)
+	return binary.AppendUvarint(b, uint64(v))
+}
+
+// appendProtoString appends a string field, omitted when empty as proto3
+// does.
+func appendProtoString(b []byte, field int, v string) []byte {
+	if v == "" {
+		return b
+	}
+
+	return appendProtoBytes(b, field, []byte(v))
+}
+
+// appendProtoBytes appends a length delimited field.
+func appendProtoBytes(b []byte, field int, v []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(field)<<3|protoBytes)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+// gzipBytes compresses data.
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// gunzipBytes decompresses data.
+func gunzipBytes(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	return io.ReadAll(zr)
+}
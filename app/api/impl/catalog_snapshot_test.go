@@ -0,0 +1,118 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/cache"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+)
+
+func TestCatalogSnapshotService_GetCatalogSnapshot(t *testing.T) {
+	v1, err := newSnapshot(&cache.CachedItems{
+		Version: 1,
+		Items: []*entity.Item{
+			{ID: "item_2", Name: "Shield"},
+			{ID: "item_1", Name: "Sword"},
+		},
+		LiveEvents: []*entity.LiveEvent{
+			{ID: "summer", Kind: entity.LiveEventKindEvent, Name: "Summer", StartAt: 10, EndAt: 20, ItemIDs: []string{"item_2"}},
+		},
+	})
+	require.NoError(t, err)
+
+	v2Items := &cache.CachedItems{
+		Version: 2,
+		Items: []*entity.Item{
+			{ID: "item_1", Name: "Sword"},
+			{ID: "item_3", Name: "Bow"},
+		},
+		Translations: map[string]map[string]*entity.ItemTranslation{
+			"item_3": {"pt": {ItemID: "item_3", Locale: "pt", Name: "Arco"}},
+		},
+		LiveEvents: []*entity.LiveEvent{
+			{ID: "winter", Kind: entity.LiveEventKindEvent, Name: "Winter", StartAt: 100, EndAt: 200, ItemIDs: []string{"item_3"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		req     *api.GetCatalogSnapshotRequest
+		want    string
+		wantErr error
+	}{
+		{
+			name: "TC01 - saved version - should return its json snapshot",
+			req:  &api.GetCatalogSnapshotRequest{Version: 1, From: -1, Format: api.CatalogSnapshotFormatJSON},
+			want: `{"version":1,"items":[{"id":"item_1","name":"Sword"},{"id":"item_2","name":"Shield"}],` +
+				`"liveEvents":[{"id":"summer","kind":"event","name":"Summer","startAt":10,"endAt":20,"itemIDs":["item_2"]}]}`,
+		},
+		{
+			name: "TC02 - current version not saved yet - should build its snapshot",
+			req:  &api.GetCatalogSnapshotRequest{Version: 2, From: -1, Format: api.CatalogSnapshotFormatJSON},
+			want: `{"version":2,"items":[{"id":"item_1","name":"Sword"},{"id":"item_3","name":"Bow","translations":[{"locale":"pt","name":"Arco"}]}],` +
+				`"liveEvents":[{"id":"winter","kind":"event","name":"Winter","startAt":100,"endAt":200,"itemIDs":["item_3"]}]}`,
+		},
+		{
+			name: "TC03 - from an older version - should return the delta",
+			req:  &api.GetCatalogSnapshotRequest{Version: 2, From: 1, Format: api.CatalogSnapshotFormatJSON},
+			want: `{"from":1,"to":2,"upserts":[{"id":"item_3","name":"Bow","translations":[{"locale":"pt","name":"Arco"}]}],"deletes":["item_2"],` +
+				`"liveEventUpserts":[{"id":"winter","kind":"event","name":"Winter","startAt":100,"endAt":200,"itemIDs":["item_3"]}],"liveEventDeletes":["summer"]}`,
+		},
+		{
+			name: "TC04 - protobuf delta - should encode a CatalogDelta message",
+			req:  &api.GetCatalogSnapshotRequest{Version: 2, From: 1, Format: api.CatalogSnapshotFormatProtobuf},
+			want: "\x08\x01\x10\x02" +
+				"\x1a\x19\x0a\x06item_3\x12\x03Bow\x2a\x0a\x0a\x02pt\x12\x04Arco" +
+				"\x22\x06item_2" +
+				"\x2a\x24\x0a\x06winter\x12\x05event\x1a\x06Winter\x28\x64\x30\xc8\x01\x3a\x06item_3" +
+				"\x32\x06summer",
+		},
+		{
+			name:    "TC05 - unknown version - should be not found",
+			req:     &api.GetCatalogSnapshotRequest{Version: 9, From: -1, Format: api.CatalogSnapshotFormatJSON},
+			wantErr: api.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			snapshotRepo := repo.NewMockCatalogSnapshotRepo(t)
+			snapshotRepo.EXPECT().FindByVersion(ctx, int64(1)).Return(v1, nil).Maybe()
+			snapshotRepo.EXPECT().FindByVersion(ctx, int64(2)).Return(nil, repo.ErrNotFound).Maybe()
+			snapshotRepo.EXPECT().FindByVersion(ctx, int64(9)).Return(nil, repo.ErrNotFound).Maybe()
+
+			svc := &CatalogSnapshotService{
+				snapshotRepo: snapshotRepo,
+				catalog:      cache.NewCatalog(v2Items),
+				blobs:        make(map[string]*api.CatalogSnapshotBlob),
+			}
+
+			blob, err := svc.GetCatalogSnapshot(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			data, err := gunzipBytes(blob.Data)
+			require.NoError(t, err)
+			if tt.req.Format == api.CatalogSnapshotFormatJSON {
+				assert.True(t, json.Valid(data))
+			}
+			assert.Equal(t, tt.want, string(data))
+			assert.Len(t, blob.ETag, 64)
+
+			cached, err := svc.GetCatalogSnapshot(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Same(t, blob, cached, "should be served from memory")
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+)
+
+func TestCatalogService_ImportCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *api.ImportCatalogRequest
+		input   string
+		wantRes *api.ImportCatalogResponse
+
+		wantChanges *repo.CatalogChanges
+	}{
+		{
+			name: "TC01 - upsert jsonl - should create new and update changed items",
+			req: &api.ImportCatalogRequest{
+				Format: api.CatalogFormatJSONL,
+				Mode:   api.CatalogImportModeUpsert,
+			},
+			input: `{"id":"item_1","name":"Sword"}
+{"id":"item_2","name":"Big shield","translations":[{"locale":"pt","name":"Escudo"}]}
+{"id":"item_3","name":"Potion"}
+`,
+			wantRes: &api.ImportCatalogResponse{
+				Applied:   true,
+				Version:   1,
+				Created:   1,
+				Updated:   1,
+				Unchanged: 1,
+				Changes: []*api.CatalogChange{
+					{
+						Op:     api.CatalogChangeUpdate,
+						ItemID: "item_2",
+						Fields: []*api.CatalogFieldChange{
+							{Field: "name", Old: "Shield", New: "Big shield"},
+						},
+					},
+					{Op: api.CatalogChangeCreate, ItemID: "item_3"},
+				},
+			},
+			wantChanges: &repo.CatalogChanges{
+				UpsertItems: []*entity.Item{
+					{ID: "item_2", Name: "Big shield"},
+					{ID: "item_3", Name: "Potion"},
+				},
+				ReplaceTranslations: map[string][]*entity.ItemTranslation{
+					"item_2": {{ItemID: "item_2", Locale: "pt", Name: "Escudo"}},
+				},
+			},
+		},
+		{
+			name: "TC02 - replace csv - should delete items missing from the file",
+			req: &api.ImportCatalogRequest{
+				Format: api.CatalogFormatCSV,
+				Mode:   api.CatalogImportModeReplace,
+			},
+			input: "id,name,category,description\nitem_1,Sword,,\n",
+			wantRes: &api.ImportCatalogResponse{
+				Applied:   true,
+				Version:   1,
+				Deleted:   1,
+				Unchanged: 1,
+				Changes: []*api.CatalogChange{
+					{Op: api.CatalogChangeDelete, ItemID: "item_2"},
+				},
+			},
+			wantChanges: &repo.CatalogChanges{
+				ReplaceTranslations: map[string][]*entity.ItemTranslation{},
+				DeleteItemIDs:       []string{"item_2"},
+			},
+		},
+		{
+			name: "TC03 - dry run yaml - should report changes without applying",
+			req: &api.ImportCatalogRequest{
+				Format: api.CatalogFormatYAML,
+				DryRun: true,
+			},
+			input: "- id: item_1\n  name: Sword\n  category: weapon\n",
+			wantRes: &api.ImportCatalogResponse{
+				Updated: 1,
+				Changes: []*api.CatalogChange{
+					{
+						Op:     api.CatalogChangeUpdate,
+						ItemID: "item_1",
+						Fields: []*api.CatalogFieldChange{
+							{Field: "category", Old: "", New: "weapon"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TC04 - invalid records - should report errors without applying",
+			req: &api.ImportCatalogRequest{
+				Format: api.CatalogFormatJSONL,
+			},
+			input: `{"id":"item_3","name":""}
+{"id":"item_3","name":"Potion","translations":[{"locale":"en","name":"Potion"}]}
+`,
+			wantRes: &api.ImportCatalogResponse{
+				Created: 2,
+				Changes: []*api.CatalogChange{
+					{Op: api.CatalogChangeCreate, ItemID: "item_3"},
+					{Op: api.CatalogChangeCreate, ItemID: "item_3"},
+				},
+				Errors: []*api.CatalogValidationError{
+					{Record: 1, ItemID: "item_3", Message: "name is required"},
+					{Record: 2, ItemID: "item_3", Message: "duplicate id, first seen in record 1"},
+					{Record: 2, ItemID: "item_3", Message: `translation locale "en" is the default locale`},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			itemRepo := repo.NewMockItemRepo(t)
+			translationRepo := repo.NewMockItemTranslationRepo(t)
+			catalogRepo := repo.NewMockCatalogRepo(t)
+
+			itemRepo.EXPECT().FindAll(ctx).Return([]*entity.Item{
+				{ID: "item_1", Name: "Sword"},
+				{ID: "item_2", Name: "Shield"},
+			}, nil).Once()
+			translationRepo.EXPECT().FindAll(ctx).Return([]*entity.ItemTranslation{
+				{ItemID: "item_2", Locale: "pt", Name: "Escudo"},
+			}, nil).Once()
+			if tt.wantChanges != nil {
+				catalogRepo.EXPECT().Apply(ctx, mock.Anything).Run(func(_ context.Context, changes *repo.CatalogChanges) {
+					assert.Equal(t, tt.wantChanges, changes)
+				}).Return(int64(1), nil).Once()
+			}
+
+			svc := &CatalogService{
+				itemRepo:        itemRepo,
+				translationRepo: translationRepo,
+				catalogRepo:     catalogRepo,
+			}
+
+			req := tt.req
+			req.Reader = strings.NewReader(tt.input)
+			res, err := svc.ImportCatalog(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRes, res)
+		})
+	}
+}
@@ -0,0 +1,497 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/cache"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/i18n"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// ChangesetService implements all use cases of staged catalog publishing.
+type ChangesetService struct {
+	changesetRepo repo.ChangesetRepo
+	catalogRepo   repo.CatalogRepo
+	itemRepo      repo.ItemRepo
+	policy        *auth.Policy
+}
+
+// changesetServicePermissions declares the permission each ChangesetService
+// method requires, and the permission required to write, or publish, changes
+// of currency items.
+var changesetServicePermissions = struct {
+	CreateChangeset     string
+	GetChangeset        string
+	PutChangesetChanges string
+	SubmitChangeset     string
+	ReviewChangeset     string
+	PublishChangeset    string
+	Currency            string
+}{
+	CreateChangeset:     auth.PermissionCatalogWrite,
+	GetChangeset:        auth.PermissionCatalogWrite,
+	PutChangesetChanges: auth.PermissionCatalogWrite,
+	SubmitChangeset:     auth.PermissionCatalogWrite,
+	ReviewChangeset:     auth.PermissionCatalogPublish,
+	PublishChangeset:    auth.PermissionCatalogPublish,
+	Currency:            auth.PermissionEconomyAdmin,
+}
+
+// changesetServiceTx declares the transaction each ChangesetService method
+// runs in. Status transitions are serializable so that concurrent ones on
+// the same changeset cannot both succeed.
+var changesetServiceTx = struct {
+	GetChangeset []database.TxOption
+	Write        []database.TxOption
+}{
+	GetChangeset: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+	Write: []database.TxOption{
+		database.TxOptions.Isolation(sql.LevelSerializable),
+	},
+}
+
+// NewChangesetService creates and returns new instance of ChangesetService,
+// its methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by changesetServicePermissions, in
+// the transactions declared by changesetServiceTx.
+func NewChangesetService(
+	changesetRepo repo.ChangesetRepo,
+	catalogRepo repo.CatalogRepo,
+	itemRepo repo.ItemRepo,
+	catalog *cache.Catalog,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.ChangesetService, error) {
+	svc := &ChangesetService{
+		changesetRepo: changesetRepo,
+		catalogRepo:   catalogRepo,
+		itemRepo:      itemRepo,
+		policy:        policy,
+	}
+	perms, tx := changesetServicePermissions, changesetServiceTx
+
+	create, err := middleware(endpoints, "changesets.create", policy, perms.CreateChangeset, client, tx.Write...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "changesets.get", policy, perms.GetChangeset, client, tx.GetChangeset...)
+	if err != nil {
+		return nil, err
+	}
+	putChanges, err := middleware(endpoints, "changesets.put_changes", policy, perms.PutChangesetChanges, client, tx.Write...)
+	if err != nil {
+		return nil, err
+	}
+	submit, err := middleware(endpoints, "changesets.submit", policy, perms.SubmitChangeset, client, tx.Write...)
+	if err != nil {
+		return nil, err
+	}
+	review, err := middleware(endpoints, "changesets.review", policy, perms.ReviewChangeset, client, tx.Write...)
+	if err != nil {
+		return nil, err
+	}
+	publish, err := middleware(endpoints, "changesets.publish", policy, perms.PublishChangeset, client, tx.Write...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txChangesetService{
+		client:              client,
+		catalog:             catalog,
+		createChangeset:     wrap(svc.CreateChangeset, create),
+		getChangeset:        wrap(svc.GetChangeset, get),
+		putChangesetChanges: wrap(svc.PutChangesetChanges, putChanges),
+		submitChangeset:     wrap(svc.SubmitChangeset, submit),
+		reviewChangeset:     wrap(svc.ReviewChangeset, review),
+		publishChangeset:    wrap(svc.PublishChangeset, publish),
+	}, nil
+}
+
+// txChangesetService runs the methods of ChangesetService through their
+// middlewares, and flushes the query cache and refreshes the catalog once a
+// changeset is published.
+type txChangesetService struct {
+	client  database.Client
+	catalog *cache.Catalog
+
+	createChangeset     func(context.Context, *api.CreateChangesetRequest) (*api.Changeset, error)
+	getChangeset        func(context.Context, *api.ChangesetRequest) (*api.Changeset, error)
+	putChangesetChanges func(context.Context, *api.PutChangesetChangesRequest) (*api.Changeset, error)
+	submitChangeset     func(context.Context, *api.ChangesetRequest) (*api.Changeset, error)
+	reviewChangeset     func(context.Context, *api.ReviewChangesetRequest) (*api.Changeset, error)
+	publishChangeset    func(context.Context, *api.ChangesetRequest) (*api.Changeset, error)
+}
+
+// CreateChangeset implements api.ChangesetService.
+func (s *txChangesetService) CreateChangeset(ctx context.Context, req *api.CreateChangesetRequest) (*api.Changeset, error) {
+	return s.createChangeset(ctx, req)
+}
+
+// GetChangeset implements api.ChangesetService.
+func (s *txChangesetService) GetChangeset(ctx context.Context, req *api.ChangesetRequest) (*api.Changeset, error) {
+	return s.getChangeset(ctx, req)
+}
+
+// PutChangesetChanges implements api.ChangesetService.
+func (s *txChangesetService) PutChangesetChanges(ctx context.Context, req *api.PutChangesetChangesRequest) (*api.Changeset, error) {
+	return s.putChangesetChanges(ctx, req)
+}
+
+// SubmitChangeset implements api.ChangesetService.
+func (s *txChangesetService) SubmitChangeset(ctx context.Context, req *api.ChangesetRequest) (*api.Changeset, error) {
+	return s.submitChangeset(ctx, req)
+}
+
+// ReviewChangeset implements api.ChangesetService.
+func (s *txChangesetService) ReviewChangeset(ctx context.Context, req *api.ReviewChangesetRequest) (*api.Changeset, error) {
+	return s.reviewChangeset(ctx, req)
+}
+
+// PublishChangeset implements api.ChangesetService.
+func (s *txChangesetService) PublishChangeset(ctx context.Context, req *api.ChangesetRequest) (*api.Changeset, error) {
+	res, err := s.publishChangeset(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// other replicas pick the new version up on their next refresh.
+	if err := s.client.FlushQueryCache(ctx); err != nil {
+		log.Printf("impl: flushing query cache after publishing changeset %d: %v", res.ID, err)
+	}
+	if err := s.catalog.Refresh(database.WithMaster(ctx)); err != nil {
+		log.Printf("impl: refreshing catalog after publishing changeset %d: %v", res.ID, err)
+	}
+
+	return res, nil
+}
+
+// CreateChangeset creates a draft changeset authored by the caller.
+func (s *ChangesetService) CreateChangeset(ctx context.Context, req *api.CreateChangesetRequest) (*api.Changeset, error) {
+	changes, err := toChangesetChanges(req.Changes)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeChanges(ctx, changes); err != nil {
+		return nil, err
+	}
+
+	cs, err := s.changesetRepo.Create(ctx, &entity.Changeset{
+		Title:   req.Title,
+		Status:  entity.ChangesetStatusDraft,
+		Author:  principalID(ctx),
+		Editors: []string{principalID(ctx)},
+		Changes: changes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIChangeset(cs), nil
+}
+
+// GetChangeset returns a changeset with its changes.
+func (s *ChangesetService) GetChangeset(ctx context.Context, req *api.ChangesetRequest) (*api.Changeset, error) {
+	cs, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIChangeset(cs), nil
+}
+
+// PutChangesetChanges creates or replaces changes of a draft changeset by
+// item id. Editing a rejected changeset makes it a draft again.
+func (s *ChangesetService) PutChangesetChanges(ctx context.Context, req *api.PutChangesetChangesRequest) (*api.Changeset, error) {
+	changes, err := toChangesetChanges(req.Changes)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeChanges(ctx, changes); err != nil {
+		return nil, err
+	}
+
+	cs, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := transition(cs, entity.ChangesetStatusDraft, entity.ChangesetStatusDraft, entity.ChangesetStatusRejected); err != nil {
+		return nil, err
+	}
+	cs.Reviewer = ""
+	if editor := principalID(ctx); !slices.Contains(cs.Editors, editor) {
+		cs.Editors = append(cs.Editors, editor)
+	}
+
+	if err := s.changesetRepo.PutChanges(ctx, cs.ID, changes); err != nil {
+		return nil, err
+	}
+	if err := s.changesetRepo.Update(ctx, cs); err != nil {
+		return nil, err
+	}
+
+	return s.GetChangeset(ctx, &api.ChangesetRequest{ID: cs.ID})
+}
+
+// SubmitChangeset submits a draft changeset for review.
+func (s *ChangesetService) SubmitChangeset(ctx context.Context, req *api.ChangesetRequest) (*api.Changeset, error) {
+	cs, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cs.Changes) == 0 {
+		return nil, fmt.Errorf("%w: changeset %d has no change", api.ErrConflict, cs.ID)
+	}
+	if err := transition(cs, entity.ChangesetStatusInReview, entity.ChangesetStatusDraft); err != nil {
+		return nil, err
+	}
+
+	if err := s.changesetRepo.Update(ctx, cs); err != nil {
+		return nil, err
+	}
+
+	return toAPIChangeset(cs), nil
+}
+
+// ReviewChangeset approves or rejects a changeset in review, neither the
+// author nor the other editors of a changeset can review it.
+func (s *ChangesetService) ReviewChangeset(ctx context.Context, req *api.ReviewChangesetRequest) (*api.Changeset, error) {
+	cs, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer := principalID(ctx)
+	if reviewer == cs.Author || slices.Contains(cs.Editors, reviewer) {
+		return nil, fmt.Errorf("%w: changeset %d cannot be reviewed by its editors", auth.ErrPermissionDenied, cs.ID)
+	}
+
+	status := entity.ChangesetStatusRejected
+	if req.Approve {
+		status = entity.ChangesetStatusApproved
+	}
+	if err := transition(cs, status, entity.ChangesetStatusInReview); err != nil {
+		return nil, err
+	}
+	cs.Reviewer = reviewer
+
+	if err := s.changesetRepo.Update(ctx, cs); err != nil {
+		return nil, err
+	}
+
+	return toAPIChangeset(cs), nil
+}
+
+// PublishChangeset applies the changes of an approved changeset as a new
+// version of the catalog, atomically with marking it published.
+func (s *ChangesetService) PublishChangeset(ctx context.Context, req *api.ChangesetRequest) (*api.Changeset, error) {
+	cs, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := transition(cs, entity.ChangesetStatusPublished, entity.ChangesetStatusApproved); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeChanges(ctx, cs.Changes); err != nil {
+		return nil, err
+	}
+
+	changes := &repo.CatalogChanges{
+		ChangesetID:         cs.ID,
+		PublishedBy:         principalID(ctx),
+		ReplaceTranslations: make(map[string][]*entity.ItemTranslation),
+	}
+	for _, c := range cs.Changes {
+		switch c.Op {
+		case entity.ChangeOpUpsert:
+			changes.UpsertItems = append(changes.UpsertItems, c.Item)
+			if c.Translations != nil {
+				changes.ReplaceTranslations[c.ItemID] = c.Translations
+			}
+		case entity.ChangeOpDelete:
+			changes.DeleteItemIDs = append(changes.DeleteItemIDs, c.ItemID)
+		}
+	}
+
+	if cs.CatalogVersion, err = s.catalogRepo.Apply(ctx, changes); err != nil {
+		return nil, err
+	}
+	if err := s.changesetRepo.Update(ctx, cs); err != nil {
+		return nil, err
+	}
+
+	return toAPIChangeset(cs), nil
+}
+
+// authorizeChanges requires the Currency permission for changes of currency
+// items, those upserted as currencies and those of items which currently are.
+func (s *ChangesetService) authorizeChanges(ctx context.Context, changes []*entity.ChangesetChange) error {
+	ids := make([]string, 0, len(changes))
+	for _, c := range changes {
+		if c.Item != nil && c.Item.Category == entity.CategoryCurrency {
+			return s.policy.Authorize(ctx, changesetServicePermissions.Currency)
+		}
+		ids = append(ids, c.ItemID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	items, err := s.itemRepo.FindByItemIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, i := range items {
+		if i.Category == entity.CategoryCurrency {
+			return s.policy.Authorize(ctx, changesetServicePermissions.Currency)
+		}
+	}
+
+	return nil
+}
+
+// find returns the changeset of id, or api.ErrNotFound.
+func (s *ChangesetService) find(ctx context.Context, id int64) (*entity.Changeset, error) {
+	cs, err := s.changesetRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: changeset %d", api.ErrNotFound, id)
+	}
+
+	return cs, err
+}
+
+// transition moves cs to status if it is in one of from, else returns
+// api.ErrConflict.
+func transition(cs *entity.Changeset, status string, from ...string) error {
+	for _, f := range from {
+		if cs.Status == f {
+			cs.Status = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: changeset %d is %s", api.ErrConflict, cs.ID, cs.Status)
+}
+
+// principalID returns the id of the principal of ctx, empty for anonymous
+// callers.
+func principalID(ctx context.Context) string {
+	if p := auth.FromContext(ctx); p != nil {
+		return p.ID
+	}
+
+	return ""
+}
+
+// toChangesetChanges validates changes and converts them to entities.
+func toChangesetChanges(changes []*api.ChangesetChange) ([]*entity.ChangesetChange, error) {
+	var msgs []string
+	records := make(map[string]int)
+	for idx, c := range changes {
+		record := idx + 1
+		switch c.Op {
+		case entity.ChangeOpUpsert:
+			for _, e := range validateCatalog([]*api.CatalogItem{&c.CatalogItem}) {
+				msgs = append(msgs, fmt.Sprintf("change %d: %s", record, e.Message))
+			}
+		case entity.ChangeOpDelete:
+			if strings.TrimSpace(c.ID) == "" {
+				msgs = append(msgs, fmt.Sprintf("change %d: id is required", record))
+			}
+		default:
+			msgs = append(msgs, fmt.Sprintf("change %d: unsupported op %q", record, c.Op))
+		}
+
+		if first, ok := records[c.ID]; ok {
+			msgs = append(msgs, fmt.Sprintf("change %d: duplicate id, first seen in change %d", record, first))
+		} else if c.ID != "" {
+			records[c.ID] = record
+		}
+	}
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	res := make([]*entity.ChangesetChange, 0, len(changes))
+	for _, c := range changes {
+		change := &entity.ChangesetChange{
+			ItemID: c.ID,
+			Op:     c.Op,
+		}
+		if c.Op == entity.ChangeOpUpsert {
+			change.Item = &entity.Item{
+				ID:          c.ID,
+				Name:        c.Name,
+				Category:    c.Category,
+				Description: c.Description,
+			}
+			if c.Translations != nil {
+				change.Translations = make([]*entity.ItemTranslation, 0, len(c.Translations))
+				for _, t := range c.Translations {
+					change.Translations = append(change.Translations, &entity.ItemTranslation{
+						ItemID:      c.ID,
+						Locale:      i18n.Normalize(t.Locale),
+						Name:        t.Name,
+						Description: t.Description,
+					})
+				}
+			}
+		}
+		res = append(res, change)
+	}
+
+	return res, nil
+}
+
+// toAPIChangeset converts a changeset to its rest resource.
+func toAPIChangeset(cs *entity.Changeset) *api.Changeset {
+	res := &api.Changeset{
+		ID:             cs.ID,
+		Title:          cs.Title,
+		Status:         cs.Status,
+		Author:         cs.Author,
+		Editors:        cs.Editors,
+		Reviewer:       cs.Reviewer,
+		CatalogVersion: cs.CatalogVersion,
+		Changes:        make([]*api.ChangesetChange, 0, len(cs.Changes)),
+		CreatedAt:      cs.CreatedAt,
+		UpdatedAt:      cs.UpdatedAt,
+	}
+	for _, c := range cs.Changes {
+		change := &api.ChangesetChange{
+			Op:          c.Op,
+			CatalogItem: api.CatalogItem{ID: c.ItemID},
+		}
+		if c.Item != nil {
+			change.Name = c.Item.Name
+			change.Category = c.Item.Category
+			change.Description = c.Item.Description
+		}
+		for _, t := range c.Translations {
+			change.Translations = append(change.Translations, &api.CatalogItemTranslation{
+				Locale:      t.Locale,
+				Name:        t.Name,
+				Description: t.Description,
+			})
+		}
+		res.Changes = append(res.Changes, change)
+	}
+
+	return res
+}
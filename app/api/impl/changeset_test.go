@@ -0,0 +1,226 @@
+package impl
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+)
+
+func TestChangesetService_Workflow(t *testing.T) {
+	changes := []*entity.ChangesetChange{
+		{ItemID: "item_1", Op: entity.ChangeOpUpsert, Item: &entity.Item{ID: "item_1", Name: "Sword"}},
+		{ItemID: "item_2", Op: entity.ChangeOpDelete},
+	}
+	policy := auth.NewPolicy(map[string][]string{
+		"economist": {auth.PermissionEconomyAdmin},
+	})
+
+	tests := []struct {
+		name        string
+		status      string
+		changes     []*entity.ChangesetChange
+		caller      string
+		roles       []string
+		call        func(s *ChangesetService, ctx context.Context) (*api.Changeset, error)
+		wantStatus  string
+		wantEditors []string
+		wantErr     error
+	}{
+		{
+			name:    "TC01 - submit a draft - should be in review",
+			status:  entity.ChangesetStatusDraft,
+			changes: changes,
+			caller:  "alice",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.SubmitChangeset(ctx, &api.ChangesetRequest{ID: 1})
+			},
+			wantStatus: entity.ChangesetStatusInReview,
+		},
+		{
+			name:   "TC02 - submit a draft without change - should conflict",
+			status: entity.ChangesetStatusDraft,
+			caller: "alice",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.SubmitChangeset(ctx, &api.ChangesetRequest{ID: 1})
+			},
+			wantErr: api.ErrConflict,
+		},
+		{
+			name:    "TC03 - review by its author - should be denied",
+			status:  entity.ChangesetStatusInReview,
+			changes: changes,
+			caller:  "alice",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.ReviewChangeset(ctx, &api.ReviewChangesetRequest{ID: 1, Approve: true})
+			},
+			wantErr: auth.ErrPermissionDenied,
+		},
+		{
+			name:    "TC04 - approve by a reviewer - should be approved",
+			status:  entity.ChangesetStatusInReview,
+			changes: changes,
+			caller:  "bob",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.ReviewChangeset(ctx, &api.ReviewChangesetRequest{ID: 1, Approve: true})
+			},
+			wantStatus: entity.ChangesetStatusApproved,
+		},
+		{
+			name:    "TC05 - reject by a reviewer - should be rejected",
+			status:  entity.ChangesetStatusInReview,
+			changes: changes,
+			caller:  "bob",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.ReviewChangeset(ctx, &api.ReviewChangesetRequest{ID: 1})
+			},
+			wantStatus: entity.ChangesetStatusRejected,
+		},
+		{
+			name:    "TC06 - publish an approved changeset - should apply it as a new version",
+			status:  entity.ChangesetStatusApproved,
+			changes: changes,
+			caller:  "bob",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.PublishChangeset(ctx, &api.ChangesetRequest{ID: 1})
+			},
+			wantStatus: entity.ChangesetStatusPublished,
+		},
+		{
+			name:    "TC07 - publish a changeset in review - should conflict",
+			status:  entity.ChangesetStatusInReview,
+			changes: changes,
+			caller:  "bob",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.PublishChangeset(ctx, &api.ChangesetRequest{ID: 1})
+			},
+			wantErr: api.ErrConflict,
+		},
+		{
+			name:    "TC08 - edit an approved changeset - should conflict",
+			status:  entity.ChangesetStatusApproved,
+			changes: changes,
+			caller:  "alice",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.PutChangesetChanges(ctx, &api.PutChangesetChangesRequest{ID: 1})
+			},
+			wantErr: api.ErrConflict,
+		},
+		{
+			name:   "TC09 - upsert a currency without economy admin - should be denied",
+			status: entity.ChangesetStatusDraft,
+			caller: "alice",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.PutChangesetChanges(ctx, &api.PutChangesetChangesRequest{ID: 1, Changes: []*api.ChangesetChange{{
+					Op:          entity.ChangeOpUpsert,
+					CatalogItem: api.CatalogItem{ID: "gems", Name: "Gems", Category: entity.CategoryCurrency},
+				}}})
+			},
+			wantErr: auth.ErrPermissionDenied,
+		},
+		{
+			name:    "TC10 - publish the deletion of a currency without economy admin - should be denied",
+			status:  entity.ChangesetStatusApproved,
+			changes: []*entity.ChangesetChange{{ItemID: "gold", Op: entity.ChangeOpDelete}},
+			caller:  "bob",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.PublishChangeset(ctx, &api.ChangesetRequest{ID: 1})
+			},
+			wantErr: auth.ErrPermissionDenied,
+		},
+		{
+			name:    "TC11 - publish changes of a currency with economy admin - should apply it",
+			status:  entity.ChangesetStatusApproved,
+			changes: []*entity.ChangesetChange{{ItemID: "gold", Op: entity.ChangeOpDelete}},
+			caller:  "bob",
+			roles:   []string{"economist"},
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.PublishChangeset(ctx, &api.ChangesetRequest{ID: 1})
+			},
+			wantStatus: entity.ChangesetStatusPublished,
+		},
+		{
+			name:    "TC12 - review by another editor - should be denied",
+			status:  entity.ChangesetStatusInReview,
+			changes: changes,
+			caller:  "dave",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.ReviewChangeset(ctx, &api.ReviewChangesetRequest{ID: 1, Approve: true})
+			},
+			wantErr: auth.ErrPermissionDenied,
+		},
+		{
+			name:    "TC13 - edit by a new editor - should record the editor",
+			status:  entity.ChangesetStatusRejected,
+			changes: changes,
+			caller:  "erin",
+			call: func(s *ChangesetService, ctx context.Context) (*api.Changeset, error) {
+				return s.PutChangesetChanges(ctx, &api.PutChangesetChangesRequest{ID: 1})
+			},
+			wantStatus:  entity.ChangesetStatusDraft,
+			wantEditors: []string{"alice", "dave", "erin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), &auth.Principal{ID: tt.caller, Roles: tt.roles})
+			changesetRepo := repo.NewMockChangesetRepo(t)
+			catalogRepo := repo.NewMockCatalogRepo(t)
+			itemRepo := repo.NewMockItemRepo(t)
+
+			changesetRepo.EXPECT().FindByID(ctx, int64(1)).Return(&entity.Changeset{
+				ID:      1,
+				Status:  tt.status,
+				Author:  "alice",
+				Editors: []string{"alice", "dave"},
+				Changes: tt.changes,
+			}, nil).Maybe()
+			itemRepo.EXPECT().FindByItemIDs(ctx, mock.Anything).RunAndReturn(func(_ context.Context, ids []string) ([]*entity.Item, error) {
+				if slices.Contains(ids, "gold") {
+					return []*entity.Item{{ID: "gold", Category: entity.CategoryCurrency}}, nil
+				}
+				return nil, nil
+			}).Maybe()
+			changesetRepo.EXPECT().PutChanges(ctx, int64(1), mock.Anything).Return(nil).Maybe()
+			var gotEditors []string
+			if tt.wantErr == nil {
+				changesetRepo.EXPECT().Update(ctx, mock.Anything).Run(func(_ context.Context, cs *entity.Changeset) {
+					gotEditors = cs.Editors
+				}).Return(nil).Once()
+			}
+			if tt.wantStatus == entity.ChangesetStatusPublished {
+				catalogRepo.EXPECT().Apply(ctx, mock.Anything).Return(int64(4), nil).Once()
+			}
+
+			svc := &ChangesetService{
+				changesetRepo: changesetRepo,
+				catalogRepo:   catalogRepo,
+				itemRepo:      itemRepo,
+				policy:        policy,
+			}
+
+			res, err := tt.call(svc, ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, res.Status)
+			if tt.wantStatus == entity.ChangesetStatusPublished {
+				assert.Equal(t, int64(4), res.CatalogVersion)
+			}
+			if tt.wantEditors != nil {
+				assert.Equal(t, tt.wantEditors, gotEditors)
+			}
+		})
+	}
+}
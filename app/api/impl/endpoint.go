@@ -0,0 +1,89 @@
+package impl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// middleware returns the middlewares of the endpoint named name: those
+// configured for it, then the permission check and the transaction declared
+// for the method.
+func middleware(
+	endpoints *endpoint.Factory,
+	name string,
+	policy *auth.Policy,
+	permission string,
+	client database.Client,
+	tx ...database.TxOption,
+) (endpoint.Middleware, error) {
+	configured, err := build(endpoints, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.Chain(
+		configured,
+		auth.RequirePermission(policy, permission),
+		database.EndpointTx(client, tx...),
+	), nil
+}
+
+// build returns the middlewares configured for the endpoint named name. Only
+// serialization failures and deadlocks are retried, the transaction of the
+// failed attempt being rolled back, and errors of callers do not open
+// circuits.
+func build(endpoints *endpoint.Factory, name string) (endpoint.Middleware, error) {
+	return endpoints.Build(name,
+		endpoint.RetryIf(database.IsRetryable),
+		endpoint.FailureIf(func(err error) bool { return !isCallerError(err) }),
+	)
+}
+
+// isCallerError reports whether err is caused by the caller rather than a
+// failure of the service.
+func isCallerError(err error) bool {
+	for _, target := range []error{
+		api.ErrInvalidArgument,
+		api.ErrNotFound,
+		api.ErrConflict,
+		repo.ErrNotFound,
+		repo.ErrConflict,
+		repo.ErrInsufficientFunds,
+		auth.ErrUnauthenticated,
+		auth.ErrPermissionDenied,
+		endpoint.ErrRateLimited,
+		context.Canceled,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wrap wraps a service method in the endpoint middleware mw.
+func wrap[Req, Res any](
+	method func(context.Context, Req) (Res, error),
+	mw endpoint.Middleware,
+) func(context.Context, Req) (Res, error) {
+	e := mw(func(ctx context.Context, request interface{}) (interface{}, error) {
+		return method(ctx, request.(Req))
+	})
+
+	return func(ctx context.Context, req Req) (Res, error) {
+		res, err := e(ctx, req)
+		if err != nil {
+			var zero Res
+			return zero, err
+		}
+
+		return res.(Res), nil
+	}
+}
@@ -0,0 +1,138 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// playerMe is the player id of paths standing for the calling player.
+const playerMe = "me"
+
+// InventoryService implements all use cases of player inventories.
+type InventoryService struct {
+	inventoryRepo repo.InventoryRepo
+	policy        *auth.Policy
+}
+
+// inventoryServicePermissions declares the permission each InventoryService
+// method requires. Players read their own inventory with Read, the
+// inventories of others require Admin.
+var inventoryServicePermissions = struct {
+	Read  string
+	Admin string
+}{
+	Read:  auth.PermissionCatalogRead,
+	Admin: auth.PermissionEconomyAdmin,
+}
+
+// inventoryServiceTx declares the transaction each InventoryService method
+// runs in.
+var inventoryServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewInventoryService creates and returns new instance of InventoryService,
+// its methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by inventoryServicePermissions,
+// in the transactions declared by inventoryServiceTx.
+func NewInventoryService(
+	inventoryRepo repo.InventoryRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.InventoryService, error) {
+	svc := &InventoryService{
+		inventoryRepo: inventoryRepo,
+		policy:        policy,
+	}
+	perms, tx := inventoryServicePermissions, inventoryServiceTx
+
+	get, err := middleware(endpoints, "inventory.get", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txInventoryService{
+		getInventory: wrap(svc.GetInventory, get),
+	}, nil
+}
+
+// txInventoryService runs the methods of InventoryService through their
+// middlewares.
+type txInventoryService struct {
+	getInventory func(context.Context, *api.InventoryRequest) (*api.Inventory, error)
+}
+
+// GetInventory implements api.InventoryService.
+func (s *txInventoryService) GetInventory(ctx context.Context, req *api.InventoryRequest) (*api.Inventory, error) {
+	return s.getInventory(ctx, req)
+}
+
+// GetInventory returns the items owned by a player ordered by item.
+func (s *InventoryService) GetInventory(ctx context.Context, req *api.InventoryRequest) (*api.Inventory, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, inventoryServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.inventoryRepo.FindByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.Inventory{PlayerID: playerID, Items: make([]*api.InventoryItem, 0, len(items))}
+	for _, i := range items {
+		res.Items = append(res.Items, &api.InventoryItem{
+			ItemID:    i.ItemID,
+			Quantity:  i.Quantity,
+			UpdatedAt: i.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// authorizePlayer resolves the player id of a path, "me" being the calling
+// player, and authorizes callers other than the player for permission.
+func authorizePlayer(ctx context.Context, policy *auth.Policy, playerID, permission string) (string, error) {
+	playerID, err := resolvePlayer(ctx, playerID)
+	if err != nil {
+		return "", err
+	}
+
+	if p := auth.FromContext(ctx); p != nil && p.Type == auth.PrincipalPlayer && p.ID == playerID {
+		return playerID, nil
+	}
+
+	return playerID, policy.Authorize(ctx, permission)
+}
+
+// resolvePlayer resolves the player id of a path, "me" being the calling
+// player.
+func resolvePlayer(ctx context.Context, playerID string) (string, error) {
+	if playerID == playerMe {
+		p := auth.FromContext(ctx)
+		if p == nil {
+			return "", auth.ErrUnauthenticated
+		}
+		if p.Type != auth.PrincipalPlayer {
+			return "", fmt.Errorf("%w: %q is only known to players", api.ErrInvalidArgument, playerMe)
+		}
+		playerID = p.ID
+	}
+	if playerID == "" {
+		return "", fmt.Errorf("%w: playerID: is required", api.ErrInvalidArgument)
+	}
+
+	return playerID, nil
+}
@@ -5,4 +5,18 @@ import "go.uber.org/fx"
 // FXModule represents a FX module for app service.
 var FXModule = fx.Provide(
 	NewItemService,
+	NewTranslationService,
+	NewCatalogService,
+	NewChangesetService,
+	NewCatalogSnapshotService,
+	NewWebhookService,
+	NewLiveEventService,
+	NewQuestService,
+	NewInventoryService,
+	NewLeaderboardService,
+	NewPlayerService,
+	NewWalletService,
+	NewTradeService,
+	NewMarketService,
+	NewItemInstanceService,
 )
@@ -2,70 +2,202 @@ package impl
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/nhatquangsin/game-service/app/api"
 	"github.com/nhatquangsin/game-service/cache"
+	"github.com/nhatquangsin/game-service/domain/entity"
 	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
 	"github.com/nhatquangsin/game-service/infra/utils"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
 )
 
 // ItemService implements all use cases of Item.
 type ItemService struct {
-	itemRepo    repo.ItemRepo
-	cachedItems *cache.CachedItems
+	itemRepo      repo.ItemRepo
+	changesetRepo repo.ChangesetRepo
+	catalog       *cache.Catalog
+	policy        *auth.Policy
 }
 
-// NewItemService creates and returns new instance of ItemService.
+// itemServiceTx declares the transaction each ItemService method runs in,
+// listings served from the in-memory catalog run outside of transactions.
+var itemServiceTx = struct {
+	ListItems []database.TxOption
+}{
+	ListItems: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+		database.TxOptions.Isolation(sql.LevelRepeatableRead),
+	},
+}
+
+// itemServicePermissions declares the permission each ItemService method
+// requires, and the permissions required to preview changesets and other
+// times.
+var itemServicePermissions = struct {
+	ListItems        string
+	PreviewChangeset string
+	PreviewAt        string
+}{
+	ListItems:        auth.PermissionCatalogRead,
+	PreviewChangeset: auth.PermissionCatalogWrite,
+	PreviewAt:        auth.PermissionCatalogWrite,
+}
+
+// NewItemService creates and returns new instance of ItemService, its methods
+// run through the endpoint middlewares configured for them, for callers
+// granted the permissions declared by itemServicePermissions, in the
+// transactions declared by itemServiceTx.
 func NewItemService(
 	itemRepo repo.ItemRepo,
-	cachedItems *cache.CachedItems,
-) api.ItemService {
+	changesetRepo repo.ChangesetRepo,
+	catalog *cache.Catalog,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.ItemService, error) {
 	svc := &ItemService{
-		itemRepo:    itemRepo,
-		cachedItems: cachedItems,
+		itemRepo:      itemRepo,
+		changesetRepo: changesetRepo,
+		catalog:       catalog,
+		policy:        policy,
+	}
+
+	// both chains share the configured middlewares, e.g. the rate limit of
+	// "items.list".
+	configured, err := build(endpoints, "items.list")
+	if err != nil {
+		return nil, err
 	}
+	authorized := auth.RequirePermission(policy, itemServicePermissions.ListItems)
 
-	return svc
+	return &txItemService{
+		listItems:   wrap(svc.ListItems, endpoint.Chain(configured, authorized)),
+		listItemsTx: wrap(svc.ListItems, endpoint.Chain(configured, authorized, database.EndpointTx(client, itemServiceTx.ListItems...))),
+	}, nil
 }
 
-// ListItems lists all items.
+// txItemService runs the methods of ItemService through their middlewares.
+type txItemService struct {
+	listItems   func(context.Context, *api.ListItemsRequest) (*api.ListItemsResponse, error)
+	listItemsTx func(context.Context, *api.ListItemsRequest) (*api.ListItemsResponse, error)
+}
+
+// ListItems implements api.ItemService. Only previews of changesets and
+// lookups by ids query the database, in a transaction.
+func (s *txItemService) ListItems(ctx context.Context, req *api.ListItemsRequest) (*api.ListItemsResponse, error) {
+	if req.Changeset != 0 || len(req.ItemIDs) > 0 {
+		return s.listItemsTx(ctx, req)
+	}
+
+	return s.listItems(ctx, req)
+}
+
+// ListItems lists the items of the published catalog, or of the catalog with
+// the changes of req.Changeset applied, localized to the first locale of
+// req.Locales that has a translation. Items of live events are listed while
+// their events are active, now or at req.At.
 func (s *ItemService) ListItems(ctx context.Context, req *api.ListItemsRequest) (*api.ListItemsResponse, error) {
 	var result []*api.Item
 	var pageMetadata utils.PageMetadata
 
+	items := s.catalog.Items()
+	if req.Changeset != 0 {
+		var err error
+		if items, err = s.preview(ctx, items, req.Changeset); err != nil {
+			return nil, err
+		}
+	}
+
+	at := time.Now()
+	if !req.At.IsZero() {
+		if err := s.policy.Authorize(ctx, itemServicePermissions.PreviewAt); err != nil {
+			return nil, err
+		}
+		at = req.At
+	}
+	unavailable := items.UnavailableAt(at)
+	items = items.Without(unavailable)
+
 	if len(req.ItemIDs) == 0 {
-		filteredResult := utils.PaginationOnMem(s.cachedItems.Items, req.Offset, req.Limit)
+		filteredResult := utils.PaginationOnMem(items.Items, req.Offset, req.Limit)
 		for _, i := range filteredResult.Items {
-			result = append(result, &api.Item{
-				ID:          i.ID,
-				Name:        i.Name,
-				Description: i.Description,
-				Category:    i.Category,
-			})
+			result = append(result, toAPIItem(items, i, req.Locales))
 		}
 
 		pageMetadata = filteredResult.Metadata
 	} else {
-		data, err := s.itemRepo.FindByItemIDs(ctx, req.ItemIDs)
-		if err != nil {
-			return nil, err
+		var data []*entity.Item
+		if req.Changeset != 0 {
+			for _, id := range req.ItemIDs {
+				if i, ok := items.ItemsMap[id]; ok {
+					data = append(data, i)
+				}
+			}
+		} else {
+			rows, err := s.itemRepo.FindByItemIDs(ctx, req.ItemIDs)
+			if err != nil {
+				return nil, err
+			}
+			for _, i := range rows {
+				if !unavailable[i.ID] {
+					data = append(data, i)
+				}
+			}
 		}
 
 		filteredResult := utils.PaginationOnMem(data, req.Offset, req.Limit)
 		for _, i := range filteredResult.Items {
-			result = append(result, &api.Item{
-				ID:          i.ID,
-				Name:        i.Name,
-				Description: i.Description,
-				Category:    i.Category,
-			})
+			result = append(result, toAPIItem(items, i, req.Locales))
 		}
 
 		pageMetadata = filteredResult.Metadata
 	}
 
-	return &api.ListItemsResponse{
-		Items:    result,
-		Metadata: pageMetadata,
-	}, nil
+	res := &api.ListItemsResponse{
+		Items:     result,
+		Metadata:  pageMetadata,
+		Version:   items.Version,
+		Changeset: req.Changeset,
+	}
+	if !req.At.IsZero() {
+		res.At = req.At.Unix()
+	}
+
+	return res, nil
+}
+
+// preview returns items with the changes of the changeset applied.
+func (s *ItemService) preview(ctx context.Context, items *cache.CachedItems, changesetID int64) (*cache.CachedItems, error) {
+	if err := s.policy.Authorize(ctx, itemServicePermissions.PreviewChangeset); err != nil {
+		return nil, err
+	}
+
+	cs, err := s.changesetRepo.FindByID(ctx, changesetID)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: changeset %d", api.ErrNotFound, changesetID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return items.WithChanges(cs.Changes), nil
+}
+
+// toAPIItem converts an item to its rest resource, translated into the
+// locales chain.
+func toAPIItem(items *cache.CachedItems, i *entity.Item, locales []string) *api.Item {
+	i = items.Localize(i, locales)
+
+	return &api.Item{
+		ID:          i.ID,
+		Name:        i.Name,
+		Description: i.Description,
+		Category:    i.Category,
+	}
 }
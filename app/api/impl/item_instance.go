@@ -0,0 +1,436 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// Limits of item instances.
+const (
+	// maxInstanceStatsSize is the maximum size of the JSON encoded stats of
+	// an instance.
+	maxInstanceStatsSize = 4096
+	maxInstanceReasonLen = 200
+)
+
+// ItemInstanceService implements all use cases of item instances.
+type ItemInstanceService struct {
+	instanceRepo repo.ItemInstanceRepo
+	playerRepo   repo.PlayerRepo
+	itemRepo     repo.ItemRepo
+	policy       *auth.Policy
+	now          func() time.Time
+}
+
+// itemInstanceServicePermissions declares the permission each
+// ItemInstanceService method requires. Players read, transfer and burn their
+// instances with Self, game servers mint and burn instances with Mint, the
+// instances of others are read with Read and transferred with Admin.
+var itemInstanceServicePermissions = struct {
+	Self  string
+	Read  string
+	Mint  string
+	Admin string
+}{
+	Self:  auth.PermissionCatalogRead,
+	Read:  auth.PermissionPlayerRead,
+	Mint:  auth.PermissionInstanceMint,
+	Admin: auth.PermissionEconomyAdmin,
+}
+
+// itemInstanceServiceTx declares the transaction each ItemInstanceService
+// method runs in, writes run in default transactions, transfers and burns
+// being conditional updates.
+var itemInstanceServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewItemInstanceService creates and returns new instance of
+// ItemInstanceService, its methods run through the endpoint middlewares
+// configured for them, for callers granted the permissions declared by
+// itemInstanceServicePermissions, in the transactions declared by
+// itemInstanceServiceTx.
+func NewItemInstanceService(
+	instanceRepo repo.ItemInstanceRepo,
+	playerRepo repo.PlayerRepo,
+	itemRepo repo.ItemRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.ItemInstanceService, error) {
+	svc := &ItemInstanceService{
+		instanceRepo: instanceRepo,
+		playerRepo:   playerRepo,
+		itemRepo:     itemRepo,
+		policy:       policy,
+		now:          time.Now,
+	}
+	perms, tx := itemInstanceServicePermissions, itemInstanceServiceTx
+
+	mint, err := middleware(endpoints, "instances.mint", policy, perms.Mint, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "instances.list", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "instances.get", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := middleware(endpoints, "instances.transfer", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	burn, err := middleware(endpoints, "instances.burn", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	history, err := middleware(endpoints, "instances.history", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txItemInstanceService{
+		mintItemInstance:        wrap(svc.MintItemInstance, mint),
+		listItemInstances:       wrap(svc.ListItemInstances, list),
+		getItemInstance:         wrap(svc.GetItemInstance, get),
+		transferItemInstance:    wrap(svc.TransferItemInstance, transfer),
+		burnItemInstance:        wrap(svc.BurnItemInstance, burn),
+		listItemInstanceHistory: wrap(svc.ListItemInstanceHistory, history),
+	}, nil
+}
+
+// txItemInstanceService runs the methods of ItemInstanceService through
+// their middlewares.
+type txItemInstanceService struct {
+	mintItemInstance        func(context.Context, *api.MintItemInstanceRequest) (*api.ItemInstance, error)
+	listItemInstances       func(context.Context, *api.ListItemInstancesRequest) (*api.ListItemInstancesResponse, error)
+	getItemInstance         func(context.Context, *api.ItemInstanceRequest) (*api.ItemInstance, error)
+	transferItemInstance    func(context.Context, *api.TransferItemInstanceRequest) (*api.ItemInstance, error)
+	burnItemInstance        func(context.Context, *api.BurnItemInstanceRequest) (*api.ItemInstance, error)
+	listItemInstanceHistory func(context.Context, *api.ItemInstanceRequest) (*api.ListItemInstanceHistoryResponse, error)
+}
+
+// MintItemInstance implements api.ItemInstanceService.
+func (s *txItemInstanceService) MintItemInstance(ctx context.Context, req *api.MintItemInstanceRequest) (*api.ItemInstance, error) {
+	return s.mintItemInstance(ctx, req)
+}
+
+// ListItemInstances implements api.ItemInstanceService.
+func (s *txItemInstanceService) ListItemInstances(ctx context.Context, req *api.ListItemInstancesRequest) (*api.ListItemInstancesResponse, error) {
+	return s.listItemInstances(ctx, req)
+}
+
+// GetItemInstance implements api.ItemInstanceService.
+func (s *txItemInstanceService) GetItemInstance(ctx context.Context, req *api.ItemInstanceRequest) (*api.ItemInstance, error) {
+	return s.getItemInstance(ctx, req)
+}
+
+// TransferItemInstance implements api.ItemInstanceService.
+func (s *txItemInstanceService) TransferItemInstance(ctx context.Context, req *api.TransferItemInstanceRequest) (*api.ItemInstance, error) {
+	return s.transferItemInstance(ctx, req)
+}
+
+// BurnItemInstance implements api.ItemInstanceService.
+func (s *txItemInstanceService) BurnItemInstance(ctx context.Context, req *api.BurnItemInstanceRequest) (*api.ItemInstance, error) {
+	return s.burnItemInstance(ctx, req)
+}
+
+// ListItemInstanceHistory implements api.ItemInstanceService.
+func (s *txItemInstanceService) ListItemInstanceHistory(ctx context.Context, req *api.ItemInstanceRequest) (*api.ListItemInstanceHistoryResponse, error) {
+	return s.listItemInstanceHistory(ctx, req)
+}
+
+// MintItemInstance mints an instance of a catalog item to a player.
+func (s *ItemInstanceService) MintItemInstance(ctx context.Context, req *api.MintItemInstanceRequest) (*api.ItemInstance, error) {
+	inst := &entity.ItemInstance{
+		ItemID:        req.ItemID,
+		OwnerID:       req.OwnerID,
+		Durability:    req.MaxDurability,
+		MaxDurability: req.MaxDurability,
+		Stats:         req.Stats,
+	}
+	if req.Durability != nil {
+		inst.Durability = *req.Durability
+	}
+	if err := s.validateInstance(ctx, inst, req.Reason); err != nil {
+		return nil, err
+	}
+	if err := s.checkPlayer(ctx, inst.OwnerID); err != nil {
+		return nil, err
+	}
+
+	inst, err := s.instanceRepo.Mint(ctx, inst, principalID(ctx), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIItemInstance(inst), nil
+}
+
+// ListItemInstances lists the instances of an owner or of a catalog item.
+func (s *ItemInstanceService) ListItemInstances(ctx context.Context, req *api.ListItemInstancesRequest) (*api.ListItemInstancesResponse, error) {
+	filter := repo.ItemInstanceFilter{ItemID: req.ItemID, Status: req.Status}
+
+	var msgs []string
+	if req.OwnerID == "" && req.ItemID == "" {
+		msgs = append(msgs, "ownerID, itemID: one of them is required")
+	}
+	if req.Status != "" && req.Status != entity.ItemInstanceStatusActive && req.Status != entity.ItemInstanceStatusBurned {
+		msgs = append(msgs, fmt.Sprintf("status: must be %s or %s", entity.ItemInstanceStatusActive, entity.ItemInstanceStatusBurned))
+	}
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	// players list their own instances, those of others or of a catalog
+	// item require Read.
+	if req.OwnerID != "" {
+		ownerID, err := authorizePlayer(ctx, s.policy, req.OwnerID, itemInstanceServicePermissions.Read)
+		if err != nil {
+			return nil, err
+		}
+		filter.OwnerID = ownerID
+	} else if err := s.policy.Authorize(ctx, itemInstanceServicePermissions.Read); err != nil {
+		return nil, err
+	}
+
+	result, err := s.instanceRepo.FindAll(ctx, filter, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListItemInstancesResponse{Items: make([]*api.ItemInstance, 0, len(result.Instances)), Metadata: result.Metadata}
+	for _, inst := range result.Instances {
+		res.Items = append(res.Items, toAPIItemInstance(inst))
+	}
+
+	return res, nil
+}
+
+// GetItemInstance returns an instance to its owner, or to callers granted
+// Read.
+func (s *ItemInstanceService) GetItemInstance(ctx context.Context, req *api.ItemInstanceRequest) (*api.ItemInstance, error) {
+	inst, err := s.authorize(ctx, req.ID, itemInstanceServicePermissions.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIItemInstance(inst), nil
+}
+
+// TransferItemInstance transfers an active instance of the calling player,
+// or of any player for admins, to another player.
+func (s *ItemInstanceService) TransferItemInstance(ctx context.Context, req *api.TransferItemInstanceRequest) (*api.ItemInstance, error) {
+	inst, err := s.authorize(ctx, req.ID, itemInstanceServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []string
+	if req.ToPlayerID == "" {
+		msgs = append(msgs, "toPlayerID: is required")
+	} else if req.ToPlayerID == inst.OwnerID {
+		msgs = append(msgs, "toPlayerID: must not be the owner")
+	}
+	if len(req.Reason) > maxInstanceReasonLen {
+		msgs = append(msgs, fmt.Sprintf("reason: at most %d characters are allowed", maxInstanceReasonLen))
+	}
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+	if inst.Status != entity.ItemInstanceStatusActive {
+		return nil, fmt.Errorf("%w: item instance %q is %s", api.ErrConflict, inst.ID, inst.Status)
+	}
+
+	// banned players neither give nor receive instances, admins may move
+	// instances away from them.
+	if isPlayer(ctx, inst.OwnerID) {
+		if err := s.checkPlayer(ctx, inst.OwnerID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkPlayer(ctx, req.ToPlayerID); err != nil {
+		return nil, err
+	}
+
+	inst, err = s.instanceRepo.Transfer(ctx, inst.ID, inst.OwnerID, req.ToPlayerID, principalID(ctx), req.Reason)
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: item instance %q changed, retry", api.ErrConflict, req.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIItemInstance(inst), nil
+}
+
+// BurnItemInstance burns an active instance of the calling player, or of any
+// player for callers granted Mint.
+func (s *ItemInstanceService) BurnItemInstance(ctx context.Context, req *api.BurnItemInstanceRequest) (*api.ItemInstance, error) {
+	inst, err := s.authorize(ctx, req.ID, itemInstanceServicePermissions.Mint)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Reason) > maxInstanceReasonLen {
+		return nil, fmt.Errorf("%w: reason: at most %d characters are allowed", api.ErrInvalidArgument, maxInstanceReasonLen)
+	}
+	if inst.Status != entity.ItemInstanceStatusActive {
+		return nil, fmt.Errorf("%w: item instance %q is %s", api.ErrConflict, inst.ID, inst.Status)
+	}
+
+	// banned players cannot burn their instances, admins may still burn them.
+	if isPlayer(ctx, inst.OwnerID) {
+		if err := s.checkPlayer(ctx, inst.OwnerID); err != nil {
+			return nil, err
+		}
+	}
+
+	inst, err = s.instanceRepo.Burn(ctx, inst.ID, principalID(ctx), req.Reason, s.now().Unix())
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: item instance %q is already burned", api.ErrConflict, req.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIItemInstance(inst), nil
+}
+
+// ListItemInstanceHistory returns the mint, transfers and burn of an
+// instance in order to its owner, or to callers granted Read.
+func (s *ItemInstanceService) ListItemInstanceHistory(ctx context.Context, req *api.ItemInstanceRequest) (*api.ListItemInstanceHistoryResponse, error) {
+	inst, err := s.authorize(ctx, req.ID, itemInstanceServicePermissions.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.instanceRepo.FindHistory(ctx, inst.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListItemInstanceHistoryResponse{InstanceID: inst.ID, Items: make([]*api.ItemInstanceHistory, 0, len(history))}
+	for _, h := range history {
+		res.Items = append(res.Items, &api.ItemInstanceHistory{
+			ID:           h.ID,
+			Action:       h.Action,
+			FromPlayerID: h.FromPlayerID,
+			ToPlayerID:   h.ToPlayerID,
+			Actor:        h.Actor,
+			Reason:       h.Reason,
+			CreatedAt:    h.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// authorize returns the instance of id to its owner, or to callers granted
+// permission.
+func (s *ItemInstanceService) authorize(ctx context.Context, id, permission string) (*entity.ItemInstance, error) {
+	inst, err := s.instanceRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: item instance %q", api.ErrNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isPlayer(ctx, inst.OwnerID) {
+		if err := s.policy.Authorize(ctx, permission); err != nil {
+			return nil, err
+		}
+	}
+
+	return inst, nil
+}
+
+// checkPlayer checks that a player exists and is not banned.
+func (s *ItemInstanceService) checkPlayer(ctx context.Context, id string) error {
+	p, err := s.playerRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return fmt.Errorf("%w: player %q", api.ErrNotFound, id)
+	}
+	if err != nil {
+		return err
+	}
+	if p.BannedAt(s.now()) {
+		return fmt.Errorf("%w: player %q is banned", api.ErrConflict, id)
+	}
+
+	return nil
+}
+
+// validateInstance validates an instance to mint, its item being a catalog
+// item other than a currency.
+func (s *ItemInstanceService) validateInstance(ctx context.Context, inst *entity.ItemInstance, reason string) error {
+	var msgs []string
+	if inst.OwnerID == "" {
+		msgs = append(msgs, "ownerID: is required")
+	}
+	if inst.MaxDurability < 0 {
+		msgs = append(msgs, "maxDurability: must not be negative")
+	}
+	if inst.Durability < 0 || inst.Durability > inst.MaxDurability {
+		msgs = append(msgs, "durability: must be between 0 and maxDurability")
+	}
+	if b, err := json.Marshal(inst.Stats); err != nil || len(b) > maxInstanceStatsSize {
+		msgs = append(msgs, fmt.Sprintf("stats: must be a JSON object of at most %d bytes", maxInstanceStatsSize))
+	}
+	if len(reason) > maxInstanceReasonLen {
+		msgs = append(msgs, fmt.Sprintf("reason: at most %d characters are allowed", maxInstanceReasonLen))
+	}
+
+	if inst.ItemID == "" {
+		msgs = append(msgs, "itemID: is required")
+	} else {
+		items, err := s.itemRepo.FindByItemIDs(ctx, []string{inst.ItemID})
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(items) == 0:
+			msgs = append(msgs, fmt.Sprintf("itemID: unknown item %q", inst.ItemID))
+		case items[0].Category == entity.CategoryCurrency:
+			msgs = append(msgs, fmt.Sprintf("itemID: %q is a currency", inst.ItemID))
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+func toAPIItemInstance(inst *entity.ItemInstance) *api.ItemInstance {
+	return &api.ItemInstance{
+		ID:            inst.ID,
+		ItemID:        inst.ItemID,
+		OwnerID:       inst.OwnerID,
+		Durability:    inst.Durability,
+		MaxDurability: inst.MaxDurability,
+		Stats:         inst.Stats,
+		Status:        inst.Status,
+		BurnedAt:      inst.BurnedAt,
+		CreatedAt:     inst.CreatedAt,
+		UpdatedAt:     inst.UpdatedAt,
+	}
+}
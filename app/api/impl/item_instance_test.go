@@ -0,0 +1,250 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+)
+
+func TestItemInstanceService_MintItemInstance(t *testing.T) {
+	items := map[string]*entity.Item{
+		"sw":   {ID: "sw", Category: "weapon"},
+		"gold": {ID: "gold", Category: entity.CategoryCurrency},
+	}
+	three := 3
+
+	tests := []struct {
+		name    string
+		req     *api.MintItemInstanceRequest
+		banned  bool
+		want    *entity.ItemInstance
+		wantErr error
+	}{
+		{
+			name: "TC01 - valid instance - should be minted at full durability",
+			req: &api.MintItemInstanceRequest{
+				ItemID:        "sw",
+				OwnerID:       "alice",
+				MaxDurability: 10,
+				Stats:         map[string]any{"atk": 12},
+				Reason:        "drop",
+			},
+			want: &entity.ItemInstance{
+				ItemID:        "sw",
+				OwnerID:       "alice",
+				Durability:    10,
+				MaxDurability: 10,
+				Stats:         map[string]any{"atk": 12},
+			},
+		},
+		{
+			name:    "TC02 - durability above max durability - should be invalid",
+			req:     &api.MintItemInstanceRequest{ItemID: "sw", OwnerID: "alice", Durability: &three, MaxDurability: 2},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name:    "TC03 - currency - should be invalid",
+			req:     &api.MintItemInstanceRequest{ItemID: "gold", OwnerID: "alice"},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name:    "TC04 - banned owner - should conflict",
+			req:     &api.MintItemInstanceRequest{ItemID: "sw", OwnerID: "alice"},
+			banned:  true,
+			wantErr: api.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), &auth.Principal{ID: "gs", Type: auth.PrincipalService})
+			instanceRepo := repo.NewMockItemInstanceRepo(t)
+			playerRepo := repo.NewMockPlayerRepo(t)
+			itemRepo := repo.NewMockItemRepo(t)
+
+			itemRepo.EXPECT().FindByItemIDs(ctx, []string{tt.req.ItemID}).Return([]*entity.Item{items[tt.req.ItemID]}, nil).Once()
+			playerRepo.EXPECT().FindByID(ctx, "alice").Return(&entity.Player{ID: "alice", Banned: tt.banned}, nil).Maybe()
+			if tt.want != nil {
+				instanceRepo.EXPECT().Mint(ctx, tt.want, "gs", tt.req.Reason).Return(tt.want, nil).Once()
+			}
+
+			svc := &ItemInstanceService{
+				instanceRepo: instanceRepo,
+				playerRepo:   playerRepo,
+				itemRepo:     itemRepo,
+				now:          func() time.Time { return time.Unix(1700000000, 0) },
+			}
+
+			res, err := svc.MintItemInstance(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, toAPIItemInstance(tt.want), res)
+		})
+	}
+}
+
+func TestItemInstanceService_TransferItemInstance(t *testing.T) {
+	policy := auth.NewPolicy(map[string][]string{
+		"admin": {auth.PermissionEconomyAdmin},
+	})
+	alice := &auth.Principal{ID: "alice", Type: auth.PrincipalPlayer}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		status    string
+		toID      string
+		transfer  error
+		wantErr   error
+	}{
+		{
+			name:      "TC01 - owner giving an instance - should be transferred",
+			principal: alice,
+			status:    entity.ItemInstanceStatusActive,
+			toID:      "bob",
+		},
+		{
+			name:      "TC02 - another player - should be denied",
+			principal: &auth.Principal{ID: "carol", Type: auth.PrincipalPlayer},
+			status:    entity.ItemInstanceStatusActive,
+			toID:      "bob",
+			wantErr:   auth.ErrPermissionDenied,
+		},
+		{
+			name:      "TC03 - admin moving an instance - should be transferred",
+			principal: &auth.Principal{ID: "ops", Type: auth.PrincipalService, Roles: []string{"admin"}},
+			status:    entity.ItemInstanceStatusActive,
+			toID:      "bob",
+		},
+		{
+			name:      "TC04 - recipient being the owner - should be invalid",
+			principal: alice,
+			status:    entity.ItemInstanceStatusActive,
+			toID:      "alice",
+			wantErr:   api.ErrInvalidArgument,
+		},
+		{
+			name:      "TC05 - burned instance - should conflict",
+			principal: alice,
+			status:    entity.ItemInstanceStatusBurned,
+			toID:      "bob",
+			wantErr:   api.ErrConflict,
+		},
+		{
+			name:      "TC06 - instance changed meanwhile - should conflict",
+			principal: alice,
+			status:    entity.ItemInstanceStatusActive,
+			toID:      "bob",
+			transfer:  repo.ErrConflict,
+			wantErr:   api.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), tt.principal)
+			instanceRepo := repo.NewMockItemInstanceRepo(t)
+			playerRepo := repo.NewMockPlayerRepo(t)
+
+			inst := &entity.ItemInstance{ID: "i1", ItemID: "sw", OwnerID: "alice", Status: tt.status}
+			instanceRepo.EXPECT().FindByID(ctx, "i1").Return(inst, nil).Once()
+			playerRepo.EXPECT().FindByID(ctx, "alice").Return(&entity.Player{ID: "alice"}, nil).Maybe()
+			playerRepo.EXPECT().FindByID(ctx, "bob").Return(&entity.Player{ID: "bob"}, nil).Maybe()
+
+			want := &entity.ItemInstance{ID: "i1", ItemID: "sw", OwnerID: "bob", Status: entity.ItemInstanceStatusActive}
+			if tt.wantErr == nil || tt.transfer != nil {
+				instanceRepo.EXPECT().Transfer(ctx, "i1", "alice", "bob", tt.principal.ID, "").Return(want, tt.transfer).Once()
+			}
+
+			svc := &ItemInstanceService{
+				instanceRepo: instanceRepo,
+				playerRepo:   playerRepo,
+				policy:       policy,
+				now:          func() time.Time { return time.Unix(1700000000, 0) },
+			}
+
+			res, err := svc.TransferItemInstance(ctx, &api.TransferItemInstanceRequest{ID: "i1", ToPlayerID: tt.toID})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, toAPIItemInstance(want), res)
+		})
+	}
+}
+
+func TestItemInstanceService_BurnItemInstance(t *testing.T) {
+	policy := auth.NewPolicy(map[string][]string{
+		"gameserver": {auth.PermissionInstanceMint},
+	})
+	alice := &auth.Principal{ID: "alice", Type: auth.PrincipalPlayer}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		banned    bool
+		wantErr   error
+	}{
+		{
+			name:      "TC01 - owner burning an instance - should be burned",
+			principal: alice,
+		},
+		{
+			name:      "TC02 - banned owner - should conflict",
+			principal: alice,
+			banned:    true,
+			wantErr:   api.ErrConflict,
+		},
+		{
+			name:      "TC03 - game server burning an instance of a banned owner - should be burned",
+			principal: &auth.Principal{ID: "gs", Type: auth.PrincipalService, Roles: []string{"gameserver"}},
+			banned:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), tt.principal)
+			instanceRepo := repo.NewMockItemInstanceRepo(t)
+			playerRepo := repo.NewMockPlayerRepo(t)
+
+			inst := &entity.ItemInstance{ID: "i1", ItemID: "sw", OwnerID: "alice", Status: entity.ItemInstanceStatusActive}
+			instanceRepo.EXPECT().FindByID(ctx, "i1").Return(inst, nil).Once()
+			playerRepo.EXPECT().FindByID(ctx, "alice").Return(&entity.Player{ID: "alice", Banned: tt.banned}, nil).Maybe()
+
+			want := &entity.ItemInstance{ID: "i1", ItemID: "sw", OwnerID: "alice", Status: entity.ItemInstanceStatusBurned}
+			if tt.wantErr == nil {
+				instanceRepo.EXPECT().Burn(ctx, "i1", tt.principal.ID, "", int64(1700000000)).Return(want, nil).Once()
+			}
+
+			svc := &ItemInstanceService{
+				instanceRepo: instanceRepo,
+				playerRepo:   playerRepo,
+				policy:       policy,
+				now:          func() time.Time { return time.Unix(1700000000, 0) },
+			}
+
+			res, err := svc.BurnItemInstance(ctx, &api.BurnItemInstanceRequest{ID: "i1"})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, toAPIItemInstance(want), res)
+		})
+	}
+}
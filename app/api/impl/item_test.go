@@ -3,13 +3,16 @@ package impl
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/nhatquangsin/game-service/app/api"
 	"github.com/nhatquangsin/game-service/cache"
 	"github.com/nhatquangsin/game-service/domain/entity"
 	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
 	"github.com/nhatquangsin/game-service/infra/utils"
 )
 
@@ -313,7 +316,7 @@ func TestItemService_ListItems(t *testing.T) {
 
 			svc := &ItemService{
 				itemRepo: itemRepo,
-				cachedItems: &cache.CachedItems{
+				catalog: cache.NewCatalog(&cache.CachedItems{
 					Items: []*entity.Item{
 						{
 							ID:          "item_1",
@@ -336,7 +339,7 @@ func TestItemService_ListItems(t *testing.T) {
 							Description: "desc 5",
 						},
 					},
-				},
+				}),
 			}
 
 			res, err := svc.ListItems(ctx, tt.args.req)
@@ -351,3 +354,259 @@ func TestItemService_ListItems(t *testing.T) {
 		})
 	}
 }
+
+func TestItemService_ListItems_Localized(t *testing.T) {
+	tests := []struct {
+		name    string
+		locales []string
+		want    []*api.Item
+	}{
+		{
+			name:    "TC01 - default locale - should return base name and description",
+			locales: []string{"en"},
+			want: []*api.Item{
+				{ID: "item_1", Name: "Sword", Description: "A sword"},
+				{ID: "item_2", Name: "Shield", Description: "A shield"},
+			},
+		},
+		{
+			name:    "TC02 - region locale - should fall back to language translation",
+			locales: []string{"pt-BR", "pt", "en"},
+			want: []*api.Item{
+				{ID: "item_1", Name: "Espada", Description: "Uma espada"},
+				{ID: "item_2", Name: "Escudo", Description: "A shield"},
+			},
+		},
+		{
+			name:    "TC03 - region translation exists - should prefer it over language",
+			locales: []string{"pt-PT", "pt", "en"},
+			want: []*api.Item{
+				{ID: "item_1", Name: "Espada lusa", Description: "Uma espada"},
+				{ID: "item_2", Name: "Escudo", Description: "A shield"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &ItemService{
+				itemRepo: repo.NewMockItemRepo(t),
+				catalog: cache.NewCatalog(&cache.CachedItems{
+					Items: []*entity.Item{
+						{ID: "item_1", Name: "Sword", Description: "A sword"},
+						{ID: "item_2", Name: "Shield", Description: "A shield"},
+					},
+					Translations: map[string]map[string]*entity.ItemTranslation{
+						"item_1": {
+							"pt":    {ItemID: "item_1", Locale: "pt", Name: "Espada", Description: "Uma espada"},
+							"pt-PT": {ItemID: "item_1", Locale: "pt-PT", Name: "Espada lusa"},
+						},
+						"item_2": {
+							"pt": {ItemID: "item_2", Locale: "pt", Name: "Escudo"},
+						},
+					},
+				}),
+			}
+
+			res, err := svc.ListItems(context.Background(), &api.ListItemsRequest{
+				Limit:   10,
+				Locales: tt.locales,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, res.Items)
+		})
+	}
+}
+
+func TestItemService_ListItems_Preview(t *testing.T) {
+	policy := auth.NewPolicy(map[string][]string{
+		"viewer":   {auth.PermissionCatalogRead},
+		"designer": {auth.PermissionCatalogWrite},
+	}, "viewer")
+	designer := &auth.Principal{ID: "alice", Type: auth.PrincipalService, Roles: []string{"designer"}}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		req       *api.ListItemsRequest
+		want      []*api.Item
+		wantErr   error
+	}{
+		{
+			name:      "TC01 - changeset - should apply its changes on the published items",
+			principal: designer,
+			req:       &api.ListItemsRequest{Limit: 10, Changeset: 7, Locales: []string{"pt", "en"}},
+			want: []*api.Item{
+				{ID: "item_1", Name: "Espada", Description: "A sword"},
+				{ID: "item_2", Name: "Great Shield"},
+				{ID: "item_4", Name: "Bow"},
+			},
+		},
+		{
+			name:      "TC02 - changeset and item ids - should filter the previewed items",
+			principal: designer,
+			req:       &api.ListItemsRequest{Limit: 10, Changeset: 7, ItemIDs: []string{"item_3", "item_4"}},
+			want: []*api.Item{
+				{ID: "item_4", Name: "Bow"},
+			},
+		},
+		{
+			name:    "TC03 - anonymous caller - should not preview drafts",
+			req:     &api.ListItemsRequest{Limit: 10, Changeset: 7},
+			wantErr: auth.ErrUnauthenticated,
+		},
+		{
+			name:      "TC04 - unknown changeset - should be not found",
+			principal: designer,
+			req:       &api.ListItemsRequest{Limit: 10, Changeset: 8},
+			wantErr:   api.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.NewContext(ctx, tt.principal)
+			}
+
+			changesetRepo := repo.NewMockChangesetRepo(t)
+			if tt.principal != nil {
+				changesetRepo.EXPECT().FindByID(ctx, int64(7)).Return(&entity.Changeset{
+					ID:     7,
+					Status: entity.ChangesetStatusDraft,
+					Changes: []*entity.ChangesetChange{
+						{ItemID: "item_2", Op: entity.ChangeOpUpsert, Item: &entity.Item{ID: "item_2", Name: "Great Shield"}},
+						{ItemID: "item_3", Op: entity.ChangeOpDelete},
+						{ItemID: "item_4", Op: entity.ChangeOpUpsert, Item: &entity.Item{ID: "item_4", Name: "Bow"}},
+					},
+				}, nil).Maybe()
+				changesetRepo.EXPECT().FindByID(ctx, int64(8)).Return(nil, repo.ErrNotFound).Maybe()
+			}
+
+			svc := &ItemService{
+				itemRepo:      repo.NewMockItemRepo(t),
+				changesetRepo: changesetRepo,
+				policy:        policy,
+				catalog: cache.NewCatalog(&cache.CachedItems{
+					Version: 3,
+					Items: []*entity.Item{
+						{ID: "item_1", Name: "Sword", Description: "A sword"},
+						{ID: "item_2", Name: "Shield"},
+						{ID: "item_3", Name: "Axe"},
+					},
+					Translations: map[string]map[string]*entity.ItemTranslation{
+						"item_1": {"pt": {ItemID: "item_1", Locale: "pt", Name: "Espada"}},
+					},
+				}),
+			}
+
+			res, err := svc.ListItems(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, res.Items)
+			assert.Equal(t, int64(3), res.Version)
+			assert.Equal(t, tt.req.Changeset, res.Changeset)
+		})
+	}
+}
+
+func TestItemService_ListItems_LiveEvents(t *testing.T) {
+	policy := auth.NewPolicy(map[string][]string{
+		"viewer":   {auth.PermissionCatalogRead},
+		"designer": {auth.PermissionCatalogWrite},
+	}, "viewer")
+	designer := &auth.Principal{ID: "alice", Type: auth.PrincipalService, Roles: []string{"designer"}}
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		req       *api.ListItemsRequest
+		want      []*api.Item
+		wantErr   error
+	}{
+		{
+			name: "TC01 - now - should list items of no event and of active events",
+			req:  &api.ListItemsRequest{Limit: 10},
+			want: []*api.Item{
+				{ID: "item_1", Name: "Sword"},
+				{ID: "item_2", Name: "Pumpkin"},
+			},
+		},
+		{
+			name:      "TC02 - at a future time - should list items of events active then",
+			principal: designer,
+			req:       &api.ListItemsRequest{Limit: 10, At: now.Add(48 * time.Hour)},
+			want: []*api.Item{
+				{ID: "item_1", Name: "Sword"},
+				{ID: "item_3", Name: "Snowball"},
+			},
+		},
+		{
+			name:      "TC03 - item ids at a future time - should filter unavailable items",
+			principal: designer,
+			req:       &api.ListItemsRequest{Limit: 10, ItemIDs: []string{"item_2", "item_3"}, At: now.Add(48 * time.Hour)},
+			want: []*api.Item{
+				{ID: "item_3", Name: "Snowball"},
+			},
+		},
+		{
+			name:    "TC04 - anonymous caller at a future time - should not preview",
+			req:     &api.ListItemsRequest{Limit: 10, At: now.Add(48 * time.Hour)},
+			wantErr: auth.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.NewContext(ctx, tt.principal)
+			}
+
+			items := []*entity.Item{
+				{ID: "item_1", Name: "Sword"},
+				{ID: "item_2", Name: "Pumpkin"},
+				{ID: "item_3", Name: "Snowball"},
+			}
+			itemRepo := repo.NewMockItemRepo(t)
+			itemRepo.EXPECT().FindByItemIDs(ctx, mock.Anything).Return(items[1:], nil).Maybe()
+
+			svc := &ItemService{
+				itemRepo: itemRepo,
+				policy:   policy,
+				catalog: cache.NewCatalog(&cache.CachedItems{
+					Items: items,
+					LiveEvents: []*entity.LiveEvent{
+						{
+							ID:      "halloween",
+							StartAt: now.Add(-time.Hour).Unix(),
+							EndAt:   now.Add(time.Hour).Unix(),
+							ItemIDs: []string{"item_2"},
+						},
+						{
+							ID:      "winter",
+							StartAt: now.Add(24 * time.Hour).Unix(),
+							EndAt:   now.Add(72 * time.Hour).Unix(),
+							ItemIDs: []string{"item_3"},
+						},
+					},
+				}),
+			}
+
+			res, err := svc.ListItems(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, res.Items)
+		})
+	}
+}
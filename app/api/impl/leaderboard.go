@@ -0,0 +1,595 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/cache"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/leaderboard"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// maxScores is the maximum number of scores of a submission request.
+const maxScores = 500
+
+// LeaderboardService implements all use cases of leaderboards.
+type LeaderboardService struct {
+	leaderboardRepo repo.LeaderboardRepo
+	snapshotRepo    repo.LeaderboardSnapshotRepo
+	store           leaderboard.Store
+	catalog         *cache.Catalog
+	now             func() time.Time
+}
+
+// leaderboardServicePermissions declares the permission each
+// LeaderboardService method requires. Rankings are read by everyone granted
+// Read, scores are submitted by game servers.
+var leaderboardServicePermissions = struct {
+	Read   string
+	Admin  string
+	Submit string
+}{
+	Read:   auth.PermissionCatalogRead,
+	Admin:  auth.PermissionLeaderboardAdmin,
+	Submit: auth.PermissionLeaderboardSubmit,
+}
+
+// leaderboardServiceTx declares the transaction each LeaderboardService
+// method runs in, writes of definitions run in default transactions. Scores
+// are written to the leaderboard store, out of transactions.
+var leaderboardServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewLeaderboardService creates and returns new instance of
+// LeaderboardService, its methods run through the endpoint middlewares
+// configured for them, for callers granted the permissions declared by
+// leaderboardServicePermissions, in the transactions declared by
+// leaderboardServiceTx.
+func NewLeaderboardService(
+	leaderboardRepo repo.LeaderboardRepo,
+	snapshotRepo repo.LeaderboardSnapshotRepo,
+	store leaderboard.Store,
+	catalog *cache.Catalog,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.LeaderboardService, error) {
+	svc := &LeaderboardService{
+		leaderboardRepo: leaderboardRepo,
+		snapshotRepo:    snapshotRepo,
+		store:           store,
+		catalog:         catalog,
+		now:             time.Now,
+	}
+	perms, tx := leaderboardServicePermissions, leaderboardServiceTx
+
+	create, err := middleware(endpoints, "leaderboards.create", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "leaderboards.list", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "leaderboards.get", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	update, err := middleware(endpoints, "leaderboards.update", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	del, err := middleware(endpoints, "leaderboards.delete", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	submit, err := middleware(endpoints, "leaderboards.submit", policy, perms.Submit, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	top, err := middleware(endpoints, "leaderboards.top", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	around, err := middleware(endpoints, "leaderboards.around", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := middleware(endpoints, "leaderboards.snapshots", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txLeaderboardService{
+		createLeaderboard:        wrap(svc.CreateLeaderboard, create),
+		listLeaderboards:         wrap(svc.ListLeaderboards, list),
+		getLeaderboard:           wrap(svc.GetLeaderboard, get),
+		updateLeaderboard:        wrap(svc.UpdateLeaderboard, update),
+		deleteLeaderboard:        wrap(svc.DeleteLeaderboard, del),
+		submitScores:             wrap(svc.SubmitScores, submit),
+		getLeaderboardTop:        wrap(svc.GetLeaderboardTop, top),
+		getLeaderboardAround:     wrap(svc.GetLeaderboardAround, around),
+		listLeaderboardSnapshots: wrap(svc.ListLeaderboardSnapshots, snapshots),
+	}, nil
+}
+
+// txLeaderboardService runs the methods of LeaderboardService through their
+// middlewares.
+type txLeaderboardService struct {
+	createLeaderboard        func(context.Context, *api.CreateLeaderboardRequest) (*api.Leaderboard, error)
+	listLeaderboards         func(context.Context, *api.ListLeaderboardsRequest) (*api.ListLeaderboardsResponse, error)
+	getLeaderboard           func(context.Context, *api.LeaderboardRequest) (*api.Leaderboard, error)
+	updateLeaderboard        func(context.Context, *api.UpdateLeaderboardRequest) (*api.Leaderboard, error)
+	deleteLeaderboard        func(context.Context, *api.LeaderboardRequest) (*api.Leaderboard, error)
+	submitScores             func(context.Context, *api.SubmitScoresRequest) (*api.SubmitScoresResponse, error)
+	getLeaderboardTop        func(context.Context, *api.LeaderboardTopRequest) (*api.LeaderboardEntriesResponse, error)
+	getLeaderboardAround     func(context.Context, *api.LeaderboardAroundRequest) (*api.LeaderboardEntriesResponse, error)
+	listLeaderboardSnapshots func(context.Context, *api.ListLeaderboardSnapshotsRequest) (*api.ListLeaderboardSnapshotsResponse, error)
+}
+
+// CreateLeaderboard implements api.LeaderboardService.
+func (s *txLeaderboardService) CreateLeaderboard(ctx context.Context, req *api.CreateLeaderboardRequest) (*api.Leaderboard, error) {
+	return s.createLeaderboard(ctx, req)
+}
+
+// ListLeaderboards implements api.LeaderboardService.
+func (s *txLeaderboardService) ListLeaderboards(ctx context.Context, req *api.ListLeaderboardsRequest) (*api.ListLeaderboardsResponse, error) {
+	return s.listLeaderboards(ctx, req)
+}
+
+// GetLeaderboard implements api.LeaderboardService.
+func (s *txLeaderboardService) GetLeaderboard(ctx context.Context, req *api.LeaderboardRequest) (*api.Leaderboard, error) {
+	return s.getLeaderboard(ctx, req)
+}
+
+// UpdateLeaderboard implements api.LeaderboardService.
+func (s *txLeaderboardService) UpdateLeaderboard(ctx context.Context, req *api.UpdateLeaderboardRequest) (*api.Leaderboard, error) {
+	return s.updateLeaderboard(ctx, req)
+}
+
+// DeleteLeaderboard implements api.LeaderboardService.
+func (s *txLeaderboardService) DeleteLeaderboard(ctx context.Context, req *api.LeaderboardRequest) (*api.Leaderboard, error) {
+	return s.deleteLeaderboard(ctx, req)
+}
+
+// SubmitScores implements api.LeaderboardService.
+func (s *txLeaderboardService) SubmitScores(ctx context.Context, req *api.SubmitScoresRequest) (*api.SubmitScoresResponse, error) {
+	return s.submitScores(ctx, req)
+}
+
+// GetLeaderboardTop implements api.LeaderboardService.
+func (s *txLeaderboardService) GetLeaderboardTop(ctx context.Context, req *api.LeaderboardTopRequest) (*api.LeaderboardEntriesResponse, error) {
+	return s.getLeaderboardTop(ctx, req)
+}
+
+// GetLeaderboardAround implements api.LeaderboardService.
+func (s *txLeaderboardService) GetLeaderboardAround(ctx context.Context, req *api.LeaderboardAroundRequest) (*api.LeaderboardEntriesResponse, error) {
+	return s.getLeaderboardAround(ctx, req)
+}
+
+// ListLeaderboardSnapshots implements api.LeaderboardService.
+func (s *txLeaderboardService) ListLeaderboardSnapshots(ctx context.Context, req *api.ListLeaderboardSnapshotsRequest) (*api.ListLeaderboardSnapshotsResponse, error) {
+	return s.listLeaderboardSnapshots(ctx, req)
+}
+
+// CreateLeaderboard creates a leaderboard.
+func (s *LeaderboardService) CreateLeaderboard(ctx context.Context, req *api.CreateLeaderboardRequest) (*api.Leaderboard, error) {
+	_, err := s.leaderboardRepo.FindByID(ctx, req.ID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: leaderboard %q already exists", api.ErrConflict, req.ID)
+	}
+	if !errors.Is(err, repo.ErrNotFound) {
+		return nil, err
+	}
+
+	l := &entity.Leaderboard{
+		ID:        req.ID,
+		Name:      req.Name,
+		Metric:    req.Metric,
+		Policy:    req.Policy,
+		Reset:     req.Reset,
+		Timezone:  req.Timezone,
+		CreatedBy: principalID(ctx),
+	}
+	if l.Policy == "" {
+		l.Policy = entity.LeaderboardPolicyBest
+	}
+	if l.Timezone == "" {
+		l.Timezone = "UTC"
+	}
+	if err := validateLeaderboard(l); err != nil {
+		return nil, err
+	}
+
+	l, err = s.leaderboardRepo.Create(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAPILeaderboard(l), nil
+}
+
+// ListLeaderboards lists the leaderboards ordered by id, or those of a
+// metric.
+func (s *LeaderboardService) ListLeaderboards(ctx context.Context, req *api.ListLeaderboardsRequest) (*api.ListLeaderboardsResponse, error) {
+	var boards []*entity.Leaderboard
+	var err error
+	if req.Metric != "" {
+		boards, err = s.leaderboardRepo.FindByMetric(ctx, req.Metric)
+	} else {
+		boards, err = s.leaderboardRepo.FindAll(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListLeaderboardsResponse{Items: make([]*api.Leaderboard, 0, len(boards))}
+	for _, l := range boards {
+		res.Items = append(res.Items, s.toAPILeaderboard(l))
+	}
+
+	return res, nil
+}
+
+// GetLeaderboard returns a leaderboard with its current period.
+func (s *LeaderboardService) GetLeaderboard(ctx context.Context, req *api.LeaderboardRequest) (*api.Leaderboard, error) {
+	l, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAPILeaderboard(l), nil
+}
+
+// UpdateLeaderboard updates the fields set of a leaderboard.
+func (s *LeaderboardService) UpdateLeaderboard(ctx context.Context, req *api.UpdateLeaderboardRequest) (*api.Leaderboard, error) {
+	l, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		l.Name = *req.Name
+	}
+	if req.Metric != nil {
+		l.Metric = *req.Metric
+	}
+	if req.Policy != nil {
+		l.Policy = *req.Policy
+	}
+	if req.Reset != nil {
+		l.Reset = *req.Reset
+	}
+	if req.Timezone != nil {
+		l.Timezone = *req.Timezone
+	}
+	if err := validateLeaderboard(l); err != nil {
+		return nil, err
+	}
+
+	if err := s.leaderboardRepo.Update(ctx, l); err != nil {
+		return nil, err
+	}
+	l.UpdatedAt = s.now().Unix()
+
+	return s.toAPILeaderboard(l), nil
+}
+
+// DeleteLeaderboard deletes a leaderboard along with its snapshots and the
+// scores of its periods.
+func (s *LeaderboardService) DeleteLeaderboard(ctx context.Context, req *api.LeaderboardRequest) (*api.Leaderboard, error) {
+	l, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.leaderboardRepo.Delete(ctx, l.ID); err != nil {
+		return nil, err
+	}
+
+	periods, err := s.store.Periods(ctx, l.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range periods {
+		if err := s.store.DeletePeriod(ctx, l.ID, p.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.toAPILeaderboard(l), nil
+}
+
+// SubmitScores submits scores, in order, to the current period of every
+// leaderboard of their metric by its policy. Seasonal leaderboards ignore
+// scores out of seasons.
+func (s *LeaderboardService) SubmitScores(ctx context.Context, req *api.SubmitScoresRequest) (*api.SubmitScoresResponse, error) {
+	if err := validateScores(req.Scores); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	boards := make(map[string][]*entity.Leaderboard)
+	res := &api.SubmitScoresResponse{Items: []*api.ScoreResult{}}
+	for _, score := range req.Scores {
+		metricBoards, ok := boards[score.Metric]
+		if !ok {
+			var err error
+			if metricBoards, err = s.leaderboardRepo.FindByMetric(ctx, score.Metric); err != nil {
+				return nil, err
+			}
+			boards[score.Metric] = metricBoards
+		}
+
+		for _, l := range metricBoards {
+			period, ok := l.PeriodAt(now, s.catalog.Items().LiveEvents)
+			if !ok {
+				continue
+			}
+
+			value, err := s.store.Submit(ctx, l, period, score.PlayerID, score.Value)
+			if err != nil {
+				return nil, err
+			}
+			res.Items = append(res.Items, &api.ScoreResult{
+				PlayerID:      score.PlayerID,
+				LeaderboardID: l.ID,
+				Period:        period.Key,
+				Score:         value,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// GetLeaderboardTop returns the entries of a period of a leaderboard from
+// the top, those of the snapshot of ended periods once snapshotted.
+func (s *LeaderboardService) GetLeaderboardTop(ctx context.Context, req *api.LeaderboardTopRequest) (*api.LeaderboardEntriesResponse, error) {
+	l, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	period, snapshot, err := s.period(ctx, l, req.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.LeaderboardEntriesResponse{LeaderboardID: l.ID, Items: []*api.LeaderboardEntry{}}
+	switch {
+	case snapshot != nil:
+		page := utils.PaginationOnMem(snapshot.Entries, req.Offset, req.Limit)
+		res.Period, res.Final = toAPILeaderboardPeriod(snapshot.Period), true
+		res.Items, res.Metadata = toAPILeaderboardEntries(page.Items), page.Metadata
+	case period != nil:
+		entries, total, err := s.store.Top(ctx, l.ID, period.Key, req.Offset, req.Limit)
+		if err != nil {
+			return nil, err
+		}
+		res.Period, res.Items = toAPILeaderboardPeriod(period), toAPILeaderboardEntries(entries)
+		res.Metadata = utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > req.Limit+req.Offset),
+			Offset:  utils.Of(req.Offset),
+			Limit:   utils.Of(req.Limit),
+		}
+	}
+
+	return res, nil
+}
+
+// GetLeaderboardAround returns the entries of a period of a leaderboard
+// ranked within a radius of a player, none when the player has no score.
+func (s *LeaderboardService) GetLeaderboardAround(ctx context.Context, req *api.LeaderboardAroundRequest) (*api.LeaderboardEntriesResponse, error) {
+	playerID, err := resolvePlayer(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	period, snapshot, err := s.period(ctx, l, req.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.LeaderboardEntriesResponse{LeaderboardID: l.ID, Items: []*api.LeaderboardEntry{}}
+	switch {
+	case snapshot != nil:
+		res.Period, res.Final = toAPILeaderboardPeriod(snapshot.Period), true
+		idx := slices.IndexFunc(snapshot.Entries, func(e *entity.LeaderboardEntry) bool { return e.PlayerID == playerID })
+		if idx >= 0 {
+			entries := snapshot.Entries[max(idx-req.Radius, 0):min(idx+req.Radius+1, len(snapshot.Entries))]
+			res.Items = toAPILeaderboardEntries(entries)
+		}
+	case period != nil:
+		entries, err := s.store.Around(ctx, l.ID, period.Key, playerID, req.Radius)
+		if err != nil {
+			return nil, err
+		}
+		res.Period, res.Items = toAPILeaderboardPeriod(period), toAPILeaderboardEntries(entries)
+	}
+
+	return res, nil
+}
+
+// ListLeaderboardSnapshots lists the snapshots of the ended periods of a
+// leaderboard from the latest.
+func (s *LeaderboardService) ListLeaderboardSnapshots(ctx context.Context, req *api.ListLeaderboardSnapshotsRequest) (*api.ListLeaderboardSnapshotsResponse, error) {
+	l, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.snapshotRepo.FindByLeaderboard(ctx, l.ID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListLeaderboardSnapshotsResponse{
+		Items:    make([]*api.LeaderboardSnapshot, 0, len(result.Snapshots)),
+		Metadata: result.Metadata,
+	}
+	for _, snapshot := range result.Snapshots {
+		res.Items = append(res.Items, &api.LeaderboardSnapshot{
+			Period:    toAPILeaderboardPeriod(snapshot.Period),
+			CreatedAt: snapshot.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// period resolves the period of key of the leaderboard l, the current one
+// when key is empty. Ended periods are returned as their snapshot once
+// snapshotted, and as their period in the store until then. Both are nil
+// for seasonal leaderboards out of seasons.
+func (s *LeaderboardService) period(ctx context.Context, l *entity.Leaderboard, key string) (*entity.LeaderboardPeriod, *entity.LeaderboardSnapshot, error) {
+	current, ok := l.PeriodAt(s.now(), s.catalog.Items().LiveEvents)
+	if key == "" || (ok && key == current.Key) {
+		return current, nil, nil
+	}
+
+	snapshot, err := s.snapshotRepo.FindByPeriod(ctx, l.ID, key)
+	if err == nil {
+		return nil, snapshot, nil
+	}
+	if !errors.Is(err, repo.ErrNotFound) {
+		return nil, nil, err
+	}
+
+	periods, err := s.store.Periods(ctx, l.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	idx := slices.IndexFunc(periods, func(p *entity.LeaderboardPeriod) bool { return p.Key == key })
+	if idx < 0 {
+		return nil, nil, fmt.Errorf("%w: period %q of leaderboard %q", api.ErrNotFound, key, l.ID)
+	}
+
+	return periods[idx], nil, nil
+}
+
+// find returns the leaderboard of id, or api.ErrNotFound.
+func (s *LeaderboardService) find(ctx context.Context, id string) (*entity.Leaderboard, error) {
+	l, err := s.leaderboardRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: leaderboard %q", api.ErrNotFound, id)
+	}
+
+	return l, err
+}
+
+// toAPILeaderboard converts a leaderboard to its rest resource, along with
+// its current period.
+func (s *LeaderboardService) toAPILeaderboard(l *entity.Leaderboard) *api.Leaderboard {
+	res := &api.Leaderboard{
+		ID:        l.ID,
+		Name:      l.Name,
+		Metric:    l.Metric,
+		Policy:    l.Policy,
+		Reset:     l.Reset,
+		Timezone:  l.Timezone,
+		CreatedBy: l.CreatedBy,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+	if period, ok := l.PeriodAt(s.now(), s.catalog.Items().LiveEvents); ok {
+		res.Period = toAPILeaderboardPeriod(period)
+	}
+
+	return res
+}
+
+// validateLeaderboard validates the leaderboard l.
+func validateLeaderboard(l *entity.Leaderboard) error {
+	var msgs []string
+	if strings.TrimSpace(l.ID) == "" {
+		msgs = append(msgs, "id: is required")
+	}
+	if strings.TrimSpace(l.Name) == "" {
+		msgs = append(msgs, "name: is required")
+	}
+	if strings.TrimSpace(l.Metric) == "" {
+		msgs = append(msgs, "metric: is required")
+	}
+	policies := []string{entity.LeaderboardPolicyBest, entity.LeaderboardPolicySum, entity.LeaderboardPolicyLatest}
+	if !slices.Contains(policies, l.Policy) {
+		msgs = append(msgs, "policy: must be "+strings.Join(policies, ", "))
+	}
+	resets := []string{entity.LeaderboardResetDaily, entity.LeaderboardResetWeekly, entity.LeaderboardResetSeason}
+	if !slices.Contains(resets, l.Reset) {
+		msgs = append(msgs, "reset: must be "+strings.Join(resets, ", "))
+	}
+	if _, err := time.LoadLocation(l.Timezone); err != nil || l.Timezone == "" || l.Timezone == "Local" {
+		msgs = append(msgs, fmt.Sprintf("timezone: unknown time zone %q", l.Timezone))
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// validateScores validates submitted scores.
+func validateScores(scores []*api.Score) error {
+	if len(scores) == 0 {
+		return fmt.Errorf("%w: scores: at least one is required", api.ErrInvalidArgument)
+	}
+	if len(scores) > maxScores {
+		return fmt.Errorf("%w: scores: at most %d are allowed", api.ErrInvalidArgument, maxScores)
+	}
+
+	var msgs []string
+	for idx, score := range scores {
+		if score == nil {
+			msgs = append(msgs, fmt.Sprintf("scores[%d]: is required", idx))
+			continue
+		}
+		if strings.TrimSpace(score.PlayerID) == "" {
+			msgs = append(msgs, fmt.Sprintf("scores[%d].playerID: is required", idx))
+		}
+		if strings.TrimSpace(score.Metric) == "" {
+			msgs = append(msgs, fmt.Sprintf("scores[%d].metric: is required", idx))
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+func toAPILeaderboardPeriod(p *entity.LeaderboardPeriod) *api.LeaderboardPeriod {
+	return &api.LeaderboardPeriod{Key: p.Key, Start: p.Start, End: p.End}
+}
+
+func toAPILeaderboardEntries(entries []*entity.LeaderboardEntry) []*api.LeaderboardEntry {
+	res := make([]*api.LeaderboardEntry, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, &api.LeaderboardEntry{Rank: e.Rank, PlayerID: e.PlayerID, Score: e.Score})
+	}
+
+	return res
+}
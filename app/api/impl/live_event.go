@@ -0,0 +1,378 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	// Embeds the time zone database, for hosts without one.
+	_ "time/tzdata"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// liveEventLocalLayouts are the layouts of local start and end times, in the
+// time zone of the event.
+var liveEventLocalLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// LiveEventService implements all use cases of live events.
+type LiveEventService struct {
+	liveEventRepo repo.LiveEventRepo
+	itemRepo      repo.ItemRepo
+	catalogRepo   repo.CatalogRepo
+	now           func() time.Time
+}
+
+// liveEventServicePermissions declares the permission each LiveEventService
+// method requires. Events are read by designers, as they reveal upcoming
+// items, and changed by publishers, as changes go live without review.
+var liveEventServicePermissions = struct {
+	Read  string
+	Write string
+}{
+	Read:  auth.PermissionCatalogWrite,
+	Write: auth.PermissionCatalogPublish,
+}
+
+// liveEventServiceTx declares the transaction each LiveEventService method
+// runs in, writes run in default transactions.
+var liveEventServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewLiveEventService creates and returns new instance of LiveEventService,
+// its methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by liveEventServicePermissions, in
+// the transactions declared by liveEventServiceTx.
+func NewLiveEventService(
+	liveEventRepo repo.LiveEventRepo,
+	itemRepo repo.ItemRepo,
+	catalogRepo repo.CatalogRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.LiveEventService, error) {
+	svc := &LiveEventService{
+		liveEventRepo: liveEventRepo,
+		itemRepo:      itemRepo,
+		catalogRepo:   catalogRepo,
+		now:           time.Now,
+	}
+	perms, tx := liveEventServicePermissions, liveEventServiceTx
+
+	create, err := middleware(endpoints, "events.create", policy, perms.Write, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "events.list", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "events.get", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	update, err := middleware(endpoints, "events.update", policy, perms.Write, client)
+	if err != nil {
+		return nil, err
+	}
+	del, err := middleware(endpoints, "events.delete", policy, perms.Write, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txLiveEventService{
+		createLiveEvent: wrap(svc.CreateLiveEvent, create),
+		listLiveEvents:  wrap(svc.ListLiveEvents, list),
+		getLiveEvent:    wrap(svc.GetLiveEvent, get),
+		updateLiveEvent: wrap(svc.UpdateLiveEvent, update),
+		deleteLiveEvent: wrap(svc.DeleteLiveEvent, del),
+	}, nil
+}
+
+// txLiveEventService runs the methods of LiveEventService through their
+// middlewares.
+type txLiveEventService struct {
+	createLiveEvent func(context.Context, *api.CreateLiveEventRequest) (*api.LiveEvent, error)
+	listLiveEvents  func(context.Context, *api.ListLiveEventsRequest) (*api.ListLiveEventsResponse, error)
+	getLiveEvent    func(context.Context, *api.LiveEventRequest) (*api.LiveEvent, error)
+	updateLiveEvent func(context.Context, *api.UpdateLiveEventRequest) (*api.LiveEvent, error)
+	deleteLiveEvent func(context.Context, *api.LiveEventRequest) (*api.LiveEvent, error)
+}
+
+// CreateLiveEvent implements api.LiveEventService.
+func (s *txLiveEventService) CreateLiveEvent(ctx context.Context, req *api.CreateLiveEventRequest) (*api.LiveEvent, error) {
+	return s.createLiveEvent(ctx, req)
+}
+
+// ListLiveEvents implements api.LiveEventService.
+func (s *txLiveEventService) ListLiveEvents(ctx context.Context, req *api.ListLiveEventsRequest) (*api.ListLiveEventsResponse, error) {
+	return s.listLiveEvents(ctx, req)
+}
+
+// GetLiveEvent implements api.LiveEventService.
+func (s *txLiveEventService) GetLiveEvent(ctx context.Context, req *api.LiveEventRequest) (*api.LiveEvent, error) {
+	return s.getLiveEvent(ctx, req)
+}
+
+// UpdateLiveEvent implements api.LiveEventService.
+func (s *txLiveEventService) UpdateLiveEvent(ctx context.Context, req *api.UpdateLiveEventRequest) (*api.LiveEvent, error) {
+	return s.updateLiveEvent(ctx, req)
+}
+
+// DeleteLiveEvent implements api.LiveEventService.
+func (s *txLiveEventService) DeleteLiveEvent(ctx context.Context, req *api.LiveEventRequest) (*api.LiveEvent, error) {
+	return s.deleteLiveEvent(ctx, req)
+}
+
+// CreateLiveEvent creates a live event, published as a new version of the
+// catalog.
+func (s *LiveEventService) CreateLiveEvent(ctx context.Context, req *api.CreateLiveEventRequest) (*api.LiveEvent, error) {
+	_, err := s.liveEventRepo.FindByID(ctx, req.ID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: event %q already exists", api.ErrConflict, req.ID)
+	}
+	if !errors.Is(err, repo.ErrNotFound) {
+		return nil, err
+	}
+
+	e := &entity.LiveEvent{
+		ID:          req.ID,
+		Kind:        req.Kind,
+		Name:        req.Name,
+		Description: req.Description,
+		Timezone:    req.Timezone,
+		ItemIDs:     req.ItemIDs,
+		CreatedBy:   principalID(ctx),
+	}
+	if e.Kind == "" {
+		e.Kind = entity.LiveEventKindEvent
+	}
+	if e.Timezone == "" {
+		e.Timezone = "UTC"
+	}
+	if err := s.validate(ctx, e, &req.StartAt, &req.EndAt); err != nil {
+		return nil, err
+	}
+
+	now := s.now().Unix()
+	e.CreatedAt, e.UpdatedAt = now, now
+
+	return s.publish(ctx, &repo.CatalogChanges{UpsertLiveEvents: []*entity.LiveEvent{e}}, e)
+}
+
+// ListLiveEvents lists the live events ordered by start, or those active now
+// or at req.At.
+func (s *LiveEventService) ListLiveEvents(ctx context.Context, req *api.ListLiveEventsRequest) (*api.ListLiveEventsResponse, error) {
+	events, err := s.liveEventRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	at := s.now()
+	res := &api.ListLiveEventsResponse{Items: make([]*api.LiveEvent, 0, len(events))}
+	if !req.At.IsZero() {
+		at = req.At
+		res.At = at.Unix()
+	}
+	for _, e := range events {
+		if !req.Active || e.ActiveAt(at) {
+			res.Items = append(res.Items, toAPILiveEvent(e, at))
+		}
+	}
+
+	return res, nil
+}
+
+// GetLiveEvent returns a live event.
+func (s *LiveEventService) GetLiveEvent(ctx context.Context, req *api.LiveEventRequest) (*api.LiveEvent, error) {
+	e, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPILiveEvent(e, s.now()), nil
+}
+
+// UpdateLiveEvent updates the fields set of a live event, published as a new
+// version of the catalog.
+func (s *LiveEventService) UpdateLiveEvent(ctx context.Context, req *api.UpdateLiveEventRequest) (*api.LiveEvent, error) {
+	e, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Kind != nil {
+		e.Kind = *req.Kind
+	}
+	if req.Name != nil {
+		e.Name = *req.Name
+	}
+	if req.Description != nil {
+		e.Description = *req.Description
+	}
+	if req.Timezone != nil {
+		e.Timezone = *req.Timezone
+	}
+	if req.ItemIDs != nil {
+		e.ItemIDs = req.ItemIDs
+	}
+	if err := s.validate(ctx, e, req.StartAt, req.EndAt); err != nil {
+		return nil, err
+	}
+	e.UpdatedAt = s.now().Unix()
+
+	return s.publish(ctx, &repo.CatalogChanges{UpsertLiveEvents: []*entity.LiveEvent{e}}, e)
+}
+
+// DeleteLiveEvent deletes a live event, published as a new version of the
+// catalog. Its items no longer are limited to it, so those of no other event
+// are available right away.
+func (s *LiveEventService) DeleteLiveEvent(ctx context.Context, req *api.LiveEventRequest) (*api.LiveEvent, error) {
+	e, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.publish(ctx, &repo.CatalogChanges{DeleteLiveEventIDs: []string{e.ID}}, e)
+}
+
+// publish applies changes of the event e as a new version of the catalog.
+func (s *LiveEventService) publish(ctx context.Context, changes *repo.CatalogChanges, e *entity.LiveEvent) (*api.LiveEvent, error) {
+	changes.PublishedBy = principalID(ctx)
+	version, err := s.catalogRepo.Apply(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toAPILiveEvent(e, s.now())
+	res.CatalogVersion = version
+
+	return res, nil
+}
+
+// find returns the live event of id, or api.ErrNotFound.
+func (s *LiveEventService) find(ctx context.Context, id string) (*entity.LiveEvent, error) {
+	e, err := s.liveEventRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: event %q", api.ErrNotFound, id)
+	}
+
+	return e, err
+}
+
+// validate validates the event e, sets its start and end from the times set
+// of startAt and endAt, and deduplicates its items which must exist.
+func (s *LiveEventService) validate(ctx context.Context, e *entity.LiveEvent, startAt, endAt *string) error {
+	var msgs []string
+	if strings.TrimSpace(e.ID) == "" {
+		msgs = append(msgs, "id: is required")
+	}
+	if strings.TrimSpace(e.Name) == "" {
+		msgs = append(msgs, "name: is required")
+	}
+	if e.Kind != entity.LiveEventKindEvent && e.Kind != entity.LiveEventKindSeason {
+		msgs = append(msgs, fmt.Sprintf("kind: must be %s or %s", entity.LiveEventKindEvent, entity.LiveEventKindSeason))
+	}
+
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil || e.Timezone == "" || e.Timezone == "Local" {
+		msgs = append(msgs, fmt.Sprintf("timezone: unknown time zone %q", e.Timezone))
+		loc = time.UTC
+	}
+	if startAt != nil {
+		if e.StartAt, err = parseLiveEventTime(*startAt, loc); err != nil {
+			msgs = append(msgs, "startAt: "+err.Error())
+		}
+	}
+	if endAt != nil {
+		if e.EndAt, err = parseLiveEventTime(*endAt, loc); err != nil {
+			msgs = append(msgs, "endAt: "+err.Error())
+		}
+	}
+	if e.StartAt != 0 && e.EndAt != 0 && e.EndAt <= e.StartAt {
+		msgs = append(msgs, "endAt: must be after startAt")
+	}
+
+	ids := make([]string, 0, len(e.ItemIDs))
+	for _, id := range e.ItemIDs {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	e.ItemIDs = ids
+	if len(ids) > 0 {
+		items, err := s.itemRepo.FindByItemIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if !slices.ContainsFunc(items, func(i *entity.Item) bool { return i.ID == id }) {
+				msgs = append(msgs, fmt.Sprintf("itemIDs: unknown item %q", id))
+			}
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// parseLiveEventTime parses an RFC 3339 time, or a local time in loc, into
+// unix seconds.
+func parseLiveEventTime(v string, loc *time.Location) (int64, error) {
+	if v == "" {
+		return 0, errors.New("is required")
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.Unix(), nil
+	}
+	for _, layout := range liveEventLocalLayouts {
+		if t, err := time.ParseInLocation(layout, v, loc); err == nil {
+			return t.Unix(), nil
+		}
+	}
+
+	return 0, fmt.Errorf("must be an RFC 3339 or local time, got %q", v)
+}
+
+// toAPILiveEvent converts a live event to its rest resource, active or not
+// at t.
+func toAPILiveEvent(e *entity.LiveEvent, t time.Time) *api.LiveEvent {
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return &api.LiveEvent{
+		ID:          e.ID,
+		Kind:        e.Kind,
+		Name:        e.Name,
+		Description: e.Description,
+		Timezone:    e.Timezone,
+		StartAt:     time.Unix(e.StartAt, 0).In(loc).Format(time.RFC3339),
+		EndAt:       time.Unix(e.EndAt, 0).In(loc).Format(time.RFC3339),
+		ItemIDs:     e.ItemIDs,
+		Active:      e.ActiveAt(t),
+		CreatedBy:   e.CreatedBy,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
@@ -0,0 +1,59 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLiveEventTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		value   string
+		loc     *time.Location
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "TC01 - RFC 3339 - should keep its offset",
+			value: "2026-12-01T00:00:00+09:00",
+			loc:   newYork,
+			want:  time.Date(2026, 11, 30, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "TC02 - local time in summer - should be in daylight saving time",
+			value: "2026-07-01T09:30",
+			loc:   newYork,
+			want:  time.Date(2026, 7, 1, 13, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "TC03 - local date in winter - should be in standard time",
+			value: "2026-12-24",
+			loc:   newYork,
+			want:  time.Date(2026, 12, 24, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "TC04 - invalid - should fail",
+			value:   "next friday",
+			loc:     time.UTC,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLiveEventTime(tt.value, tt.loc)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.Unix(), got)
+		})
+	}
+}
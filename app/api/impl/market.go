@@ -0,0 +1,533 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// Limits of market orders and candles.
+const (
+	// maxMarketPrice and maxMarketQuantity bound orders, so that their
+	// price never overflows.
+	maxMarketPrice    = 1_000_000_000
+	maxMarketQuantity = 1_000_000
+	// defaultCandleInterval and defaultCandles are the interval and number
+	// of candles when not requested, maxCandles the maximum number of
+	// intervals of a request.
+	defaultCandleInterval = time.Hour
+	minCandleInterval     = time.Minute
+	maxCandleInterval     = 7 * 24 * time.Hour
+	maxCandles            = 1000
+	defaultCandles        = 100
+)
+
+// MarketService implements all use cases of the player marketplace.
+type MarketService struct {
+	marketRepo repo.MarketRepo
+	playerRepo repo.PlayerRepo
+	itemRepo   repo.ItemRepo
+	policy     *auth.Policy
+	now        func() time.Time
+
+	// currency is the catalog currency of prices, feeBps the fee charged to
+	// sellers in basis points, untradable the categories of items not
+	// traded.
+	currency   string
+	feeBps     int64
+	untradable []string
+}
+
+// marketServicePermissions declares the permission each MarketService
+// method requires. Players trade and browse with Self, the orders of others
+// require Admin.
+var marketServicePermissions = struct {
+	Self  string
+	Admin string
+}{
+	Self:  auth.PermissionCatalogRead,
+	Admin: auth.PermissionEconomyAdmin,
+}
+
+// marketServiceTx declares the transaction each MarketService method runs
+// in, writes run in default transactions, matching locking the orders it
+// fills.
+var marketServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewMarketService creates and returns new instance of MarketService, its
+// methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by marketServicePermissions, in
+// the transactions declared by marketServiceTx. It is configured through
+// viper by following keys under "market":
+//
+//   - currency
+//     catalog item of the currency category prices are in, gold by default.
+//   - fee_bps
+//     fee charged to sellers on each fill in basis points of its price,
+//     between 0 and 10000.
+//   - untradable_categories
+//     categories of the catalog items not traded, besides currencies.
+func NewMarketService(
+	v *viper.Viper,
+	marketRepo repo.MarketRepo,
+	playerRepo repo.PlayerRepo,
+	itemRepo repo.ItemRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.MarketService, error) {
+	cfg := struct {
+		Market struct {
+			Currency             string   `mapstructure:"currency"`
+			FeeBps               int64    `mapstructure:"fee_bps"`
+			UntradableCategories []string `mapstructure:"untradable_categories"`
+		} `mapstructure:"market"`
+	}{}
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Market.Currency == "" {
+		cfg.Market.Currency = "gold"
+	}
+	if cfg.Market.FeeBps < 0 || cfg.Market.FeeBps > 10000 {
+		return nil, fmt.Errorf("market: fee_bps must be between 0 and 10000, got %d", cfg.Market.FeeBps)
+	}
+
+	svc := &MarketService{
+		marketRepo: marketRepo,
+		playerRepo: playerRepo,
+		itemRepo:   itemRepo,
+		policy:     policy,
+		now:        time.Now,
+		currency:   cfg.Market.Currency,
+		feeBps:     cfg.Market.FeeBps,
+		untradable: cfg.Market.UntradableCategories,
+	}
+	perms, tx := marketServicePermissions, marketServiceTx
+
+	place, err := middleware(endpoints, "market.place", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "market.list", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	listPlayer, err := middleware(endpoints, "market.list_player", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "market.get", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	cancel, err := middleware(endpoints, "market.cancel", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	fills, err := middleware(endpoints, "market.fills", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	candles, err := middleware(endpoints, "market.candles", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txMarketService{
+		placeMarketOrder:       wrap(svc.PlaceMarketOrder, place),
+		listMarketOrders:       wrap(svc.ListMarketOrders, list),
+		listPlayerMarketOrders: wrap(svc.ListPlayerMarketOrders, listPlayer),
+		getMarketOrder:         wrap(svc.GetMarketOrder, get),
+		cancelMarketOrder:      wrap(svc.CancelMarketOrder, cancel),
+		listMarketFills:        wrap(svc.ListMarketFills, fills),
+		listMarketCandles:      wrap(svc.ListMarketCandles, candles),
+	}, nil
+}
+
+// txMarketService runs the methods of MarketService through their
+// middlewares.
+type txMarketService struct {
+	placeMarketOrder       func(context.Context, *api.PlaceMarketOrderRequest) (*api.PlaceMarketOrderResponse, error)
+	listMarketOrders       func(context.Context, *api.ListMarketOrdersRequest) (*api.ListMarketOrdersResponse, error)
+	listPlayerMarketOrders func(context.Context, *api.ListPlayerMarketOrdersRequest) (*api.ListMarketOrdersResponse, error)
+	getMarketOrder         func(context.Context, *api.MarketOrderRequest) (*api.MarketOrder, error)
+	cancelMarketOrder      func(context.Context, *api.MarketOrderRequest) (*api.MarketOrder, error)
+	listMarketFills        func(context.Context, *api.ListMarketFillsRequest) (*api.ListMarketFillsResponse, error)
+	listMarketCandles      func(context.Context, *api.ListMarketCandlesRequest) (*api.ListMarketCandlesResponse, error)
+}
+
+// PlaceMarketOrder implements api.MarketService.
+func (s *txMarketService) PlaceMarketOrder(ctx context.Context, req *api.PlaceMarketOrderRequest) (*api.PlaceMarketOrderResponse, error) {
+	return s.placeMarketOrder(ctx, req)
+}
+
+// ListMarketOrders implements api.MarketService.
+func (s *txMarketService) ListMarketOrders(ctx context.Context, req *api.ListMarketOrdersRequest) (*api.ListMarketOrdersResponse, error) {
+	return s.listMarketOrders(ctx, req)
+}
+
+// ListPlayerMarketOrders implements api.MarketService.
+func (s *txMarketService) ListPlayerMarketOrders(ctx context.Context, req *api.ListPlayerMarketOrdersRequest) (*api.ListMarketOrdersResponse, error) {
+	return s.listPlayerMarketOrders(ctx, req)
+}
+
+// GetMarketOrder implements api.MarketService.
+func (s *txMarketService) GetMarketOrder(ctx context.Context, req *api.MarketOrderRequest) (*api.MarketOrder, error) {
+	return s.getMarketOrder(ctx, req)
+}
+
+// CancelMarketOrder implements api.MarketService.
+func (s *txMarketService) CancelMarketOrder(ctx context.Context, req *api.MarketOrderRequest) (*api.MarketOrder, error) {
+	return s.cancelMarketOrder(ctx, req)
+}
+
+// ListMarketFills implements api.MarketService.
+func (s *txMarketService) ListMarketFills(ctx context.Context, req *api.ListMarketFillsRequest) (*api.ListMarketFillsResponse, error) {
+	return s.listMarketFills(ctx, req)
+}
+
+// ListMarketCandles implements api.MarketService.
+func (s *txMarketService) ListMarketCandles(ctx context.Context, req *api.ListMarketCandlesRequest) (*api.ListMarketCandlesResponse, error) {
+	return s.listMarketCandles(ctx, req)
+}
+
+// PlaceMarketOrder places an order of the calling player, holds its items
+// or its price in escrow, and matches it against the open orders of the
+// other side.
+func (s *MarketService) PlaceMarketOrder(ctx context.Context, req *api.PlaceMarketOrderRequest) (*api.PlaceMarketOrderResponse, error) {
+	playerID, err := resolvePlayer(ctx, playerMe)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateOrder(ctx, req); err != nil {
+		return nil, err
+	}
+
+	p, err := s.playerRepo.FindByID(ctx, playerID)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: player %q", api.ErrNotFound, playerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if p.BannedAt(s.now()) {
+		return nil, fmt.Errorf("%w: player %q is banned", api.ErrConflict, playerID)
+	}
+
+	o, fills, err := s.marketRepo.Place(ctx, &entity.MarketOrder{
+		PlayerID: playerID,
+		Side:     req.Side,
+		ItemID:   req.ItemID,
+		Currency: s.currency,
+		Price:    req.Price,
+		Quantity: req.Quantity,
+	}, s.feeBps)
+	if errors.Is(err, repo.ErrInsufficientFunds) {
+		return nil, fmt.Errorf("%w: player %q lacks the %s of the order", api.ErrConflict, playerID, escrowName(req.Side))
+	}
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: matched orders changed, retry", api.ErrConflict)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.PlaceMarketOrderResponse{Order: toAPIMarketOrder(o), Fills: make([]*api.MarketFill, 0, len(fills))}
+	for _, f := range fills {
+		res.Fills = append(res.Fills, toAPIMarketFill(f))
+	}
+
+	return res, nil
+}
+
+// ListMarketOrders browses the open orders of a side by price.
+func (s *MarketService) ListMarketOrders(ctx context.Context, req *api.ListMarketOrdersRequest) (*api.ListMarketOrdersResponse, error) {
+	filter := repo.MarketOrderFilter{
+		ItemID: req.ItemID,
+		Side:   req.Side,
+		Status: entity.MarketOrderStatusOpen,
+		Sort:   req.Sort,
+	}
+	if filter.Side == "" {
+		filter.Side = entity.MarketSideSell
+	}
+
+	var msgs []string
+	if filter.Side != entity.MarketSideSell && filter.Side != entity.MarketSideBuy {
+		msgs = append(msgs, fmt.Sprintf("side: must be %s or %s", entity.MarketSideSell, entity.MarketSideBuy))
+	}
+	switch filter.Sort {
+	case repo.MarketSortPrice, repo.MarketSortPriceDesc:
+	case "":
+		// the best prices first, the cheapest listings and the dearest buy
+		// orders.
+		filter.Sort = repo.MarketSortPrice
+		if filter.Side == entity.MarketSideBuy {
+			filter.Sort = repo.MarketSortPriceDesc
+		}
+	default:
+		msgs = append(msgs, fmt.Sprintf("sort: must be %s or %s", repo.MarketSortPrice, repo.MarketSortPriceDesc))
+	}
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return s.listOrders(ctx, filter, req.Limit, req.Offset)
+}
+
+// ListPlayerMarketOrders lists the orders of a player from the latest.
+func (s *MarketService) ListPlayerMarketOrders(ctx context.Context, req *api.ListPlayerMarketOrdersRequest) (*api.ListMarketOrdersResponse, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, marketServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []string
+	if req.Side != "" && req.Side != entity.MarketSideSell && req.Side != entity.MarketSideBuy {
+		msgs = append(msgs, fmt.Sprintf("side: must be %s or %s", entity.MarketSideSell, entity.MarketSideBuy))
+	}
+	statuses := []string{entity.MarketOrderStatusOpen, entity.MarketOrderStatusFilled, entity.MarketOrderStatusCancelled}
+	if req.Status != "" && !slices.Contains(statuses, req.Status) {
+		msgs = append(msgs, fmt.Sprintf("status: must be %s", strings.Join(statuses, ", ")))
+	}
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	filter := repo.MarketOrderFilter{PlayerID: playerID, Side: req.Side, Status: req.Status}
+	return s.listOrders(ctx, filter, req.Limit, req.Offset)
+}
+
+// GetMarketOrder returns an open order, or a closed one to its player or to
+// admins.
+func (s *MarketService) GetMarketOrder(ctx context.Context, req *api.MarketOrderRequest) (*api.MarketOrder, error) {
+	o, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if o.Status != entity.MarketOrderStatusOpen && !isPlayer(ctx, o.PlayerID) {
+		if err := s.policy.Authorize(ctx, marketServicePermissions.Admin); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAPIMarketOrder(o), nil
+}
+
+// CancelMarketOrder cancels an open order of the calling player, or of any
+// player for admins, releasing the escrow of its remaining quantity.
+func (s *MarketService) CancelMarketOrder(ctx context.Context, req *api.MarketOrderRequest) (*api.MarketOrder, error) {
+	o, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPlayer(ctx, o.PlayerID) {
+		if err := s.policy.Authorize(ctx, marketServicePermissions.Admin); err != nil {
+			return nil, err
+		}
+	}
+	if o.Status != entity.MarketOrderStatusOpen {
+		return nil, fmt.Errorf("%w: market order %d is %s", api.ErrConflict, o.ID, o.Status)
+	}
+
+	err = s.marketRepo.Cancel(ctx, o.ID)
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: market order %d is no longer open", api.ErrConflict, o.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	o, err = s.marketRepo.FindOrderByID(ctx, o.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIMarketOrder(o), nil
+}
+
+// ListMarketFills lists the fills of an item from the latest.
+func (s *MarketService) ListMarketFills(ctx context.Context, req *api.ListMarketFillsRequest) (*api.ListMarketFillsResponse, error) {
+	result, err := s.marketRepo.FindFills(ctx, req.ItemID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListMarketFillsResponse{Items: make([]*api.MarketFill, 0, len(result.Fills)), Metadata: result.Metadata}
+	for _, f := range result.Fills {
+		res.Items = append(res.Items, toAPIMarketFill(f))
+	}
+
+	return res, nil
+}
+
+// ListMarketCandles aggregates the fills of an item into candles of an
+// interval, from the candle of from to the candle of to.
+func (s *MarketService) ListMarketCandles(ctx context.Context, req *api.ListMarketCandlesRequest) (*api.ListMarketCandlesResponse, error) {
+	interval, from, to := req.Interval, req.From, req.To
+	if interval == 0 {
+		interval = defaultCandleInterval
+	}
+	if to.IsZero() {
+		to = s.now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultCandles * interval)
+	}
+	if interval < minCandleInterval || interval > maxCandleInterval || interval%minCandleInterval != 0 {
+		return nil, fmt.Errorf("%w: interval: must be whole minutes between 1m and 168h", api.ErrInvalidArgument)
+	}
+	if from.After(to) {
+		return nil, fmt.Errorf("%w: from: must not be after to", api.ErrInvalidArgument)
+	}
+
+	// candles start at multiples of the interval since the unix epoch, from
+	// the candle of from to the candle of to.
+	secs := int64(interval / time.Second)
+	start := from.Unix() - from.Unix()%secs
+	end := to.Unix() - to.Unix()%secs + secs
+	if (end-start)/secs > maxCandles {
+		return nil, fmt.Errorf("%w: from: at most %d intervals are allowed", api.ErrInvalidArgument, maxCandles)
+	}
+
+	candles, err := s.marketRepo.FindCandles(ctx, req.ItemID, s.currency, start, end, secs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListMarketCandlesResponse{
+		ItemID:   req.ItemID,
+		Currency: s.currency,
+		Interval: interval.String(),
+		Items:    make([]*api.MarketCandle, 0, len(candles)),
+	}
+	for _, c := range candles {
+		res.Items = append(res.Items, &api.MarketCandle{
+			Start:  c.Start,
+			Open:   c.Open,
+			High:   c.High,
+			Low:    c.Low,
+			Close:  c.Close,
+			Volume: c.Volume,
+			Fills:  c.Fills,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *MarketService) listOrders(ctx context.Context, filter repo.MarketOrderFilter, limit, offset int) (*api.ListMarketOrdersResponse, error) {
+	result, err := s.marketRepo.FindOrders(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListMarketOrdersResponse{Items: make([]*api.MarketOrder, 0, len(result.Orders)), Metadata: result.Metadata}
+	for _, o := range result.Orders {
+		res.Items = append(res.Items, toAPIMarketOrder(o))
+	}
+
+	return res, nil
+}
+
+func (s *MarketService) find(ctx context.Context, id int64) (*entity.MarketOrder, error) {
+	o, err := s.marketRepo.FindOrderByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: market order %d", api.ErrNotFound, id)
+	}
+
+	return o, err
+}
+
+// validateOrder validates an order, its item being a tradable item of the
+// catalog.
+func (s *MarketService) validateOrder(ctx context.Context, req *api.PlaceMarketOrderRequest) error {
+	var msgs []string
+	if req.Side != entity.MarketSideSell && req.Side != entity.MarketSideBuy {
+		msgs = append(msgs, fmt.Sprintf("side: must be %s or %s", entity.MarketSideSell, entity.MarketSideBuy))
+	}
+	if req.Price < 1 || req.Price > maxMarketPrice {
+		msgs = append(msgs, fmt.Sprintf("price: must be between 1 and %d", maxMarketPrice))
+	}
+	if req.Quantity < 1 || req.Quantity > maxMarketQuantity {
+		msgs = append(msgs, fmt.Sprintf("quantity: must be between 1 and %d", maxMarketQuantity))
+	}
+
+	if req.ItemID == "" {
+		msgs = append(msgs, "itemID: is required")
+	} else {
+		items, err := s.itemRepo.FindByItemIDs(ctx, []string{req.ItemID})
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(items) == 0:
+			msgs = append(msgs, fmt.Sprintf("itemID: unknown item %q", req.ItemID))
+		case items[0].Category == entity.CategoryCurrency || slices.Contains(s.untradable, items[0].Category):
+			msgs = append(msgs, fmt.Sprintf("itemID: %q is not tradable", req.ItemID))
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// escrowName names what an order of a side holds in escrow.
+func escrowName(side string) string {
+	if side == entity.MarketSideSell {
+		return "items"
+	}
+
+	return "price"
+}
+
+func toAPIMarketOrder(o *entity.MarketOrder) *api.MarketOrder {
+	return &api.MarketOrder{
+		ID:        o.ID,
+		PlayerID:  o.PlayerID,
+		Side:      o.Side,
+		ItemID:    o.ItemID,
+		Currency:  o.Currency,
+		Price:     o.Price,
+		Quantity:  o.Quantity,
+		Remaining: o.Remaining,
+		Status:    o.Status,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+func toAPIMarketFill(f *entity.MarketFill) *api.MarketFill {
+	return &api.MarketFill{
+		ID:        f.ID,
+		ItemID:    f.ItemID,
+		Currency:  f.Currency,
+		Price:     f.Price,
+		Quantity:  f.Quantity,
+		Fee:       f.Fee,
+		CreatedAt: f.CreatedAt,
+	}
+}
@@ -0,0 +1,190 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+)
+
+func TestMarketService_PlaceMarketOrder(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	items := map[string]*entity.Item{
+		"sw":   {ID: "sw", Category: "weapon"},
+		"gold": {ID: "gold", Category: entity.CategoryCurrency},
+		"bp":   {ID: "bp", Category: "blueprint"},
+	}
+	alice := &auth.Principal{ID: "alice", Type: auth.PrincipalPlayer}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		req       *api.PlaceMarketOrderRequest
+		banned    bool
+		place     error
+		want      *entity.MarketOrder
+		wantErr   error
+	}{
+		{
+			name:      "TC01 - valid listing - should be placed in the market currency with the fee",
+			principal: alice,
+			req:       &api.PlaceMarketOrderRequest{Side: entity.MarketSideSell, ItemID: "sw", Price: 100, Quantity: 2},
+			want: &entity.MarketOrder{
+				PlayerID: "alice",
+				Side:     entity.MarketSideSell,
+				ItemID:   "sw",
+				Currency: "gold",
+				Price:    100,
+				Quantity: 2,
+			},
+		},
+		{
+			name:      "TC02 - unknown side and zero price - should be invalid",
+			principal: alice,
+			req:       &api.PlaceMarketOrderRequest{Side: "bid", ItemID: "sw", Price: 0, Quantity: 1},
+			wantErr:   api.ErrInvalidArgument,
+		},
+		{
+			name:      "TC03 - item of an untradable category - should be invalid",
+			principal: alice,
+			req:       &api.PlaceMarketOrderRequest{Side: entity.MarketSideBuy, ItemID: "bp", Price: 1, Quantity: 1},
+			wantErr:   api.ErrInvalidArgument,
+		},
+		{
+			name:      "TC04 - banned player - should conflict",
+			principal: alice,
+			req:       &api.PlaceMarketOrderRequest{Side: entity.MarketSideBuy, ItemID: "sw", Price: 10, Quantity: 1},
+			banned:    true,
+			wantErr:   api.ErrConflict,
+		},
+		{
+			name:      "TC05 - price not owned - should conflict",
+			principal: alice,
+			req:       &api.PlaceMarketOrderRequest{Side: entity.MarketSideBuy, ItemID: "sw", Price: 10, Quantity: 1},
+			place:     repo.ErrInsufficientFunds,
+			wantErr:   api.ErrConflict,
+		},
+		{
+			name:      "TC06 - service caller - should be invalid",
+			principal: &auth.Principal{ID: "ops", Type: auth.PrincipalService},
+			req:       &api.PlaceMarketOrderRequest{Side: entity.MarketSideSell, ItemID: "sw", Price: 10, Quantity: 1},
+			wantErr:   api.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), tt.principal)
+			marketRepo := repo.NewMockMarketRepo(t)
+			playerRepo := repo.NewMockPlayerRepo(t)
+			itemRepo := repo.NewMockItemRepo(t)
+
+			itemRepo.EXPECT().FindByItemIDs(ctx, []string{tt.req.ItemID}).Return([]*entity.Item{items[tt.req.ItemID]}, nil).Maybe()
+			playerRepo.EXPECT().FindByID(ctx, "alice").Return(&entity.Player{ID: "alice", Banned: tt.banned}, nil).Maybe()
+			if tt.want != nil || tt.place != nil {
+				marketRepo.EXPECT().Place(ctx, mock.Anything, int64(250)).RunAndReturn(
+					func(_ context.Context, o *entity.MarketOrder, _ int64) (*entity.MarketOrder, []*entity.MarketFill, error) {
+						if tt.place != nil {
+							return nil, nil, tt.place
+						}
+						assert.Equal(t, tt.want, o)
+						return o, nil, nil
+					}).Once()
+			}
+
+			svc := &MarketService{
+				marketRepo: marketRepo,
+				playerRepo: playerRepo,
+				itemRepo:   itemRepo,
+				now:        func() time.Time { return now },
+				currency:   "gold",
+				feeBps:     250,
+				untradable: []string{"blueprint"},
+			}
+
+			res, err := svc.PlaceMarketOrder(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, toAPIMarketOrder(tt.want), res.Order)
+			assert.Empty(t, res.Fills)
+		})
+	}
+}
+
+func TestMarketService_ListMarketCandles(t *testing.T) {
+	now := time.Unix(1700000030, 0)
+
+	tests := []struct {
+		name      string
+		req       *api.ListMarketCandlesRequest
+		wantRange []int64
+		wantErr   error
+	}{
+		{
+			name:      "TC01 - defaults - should cover 100 hours up to the candle of now",
+			req:       &api.ListMarketCandlesRequest{ItemID: "sw"},
+			wantRange: []int64{1699639200, 1700002800, 3600},
+		},
+		{
+			name: "TC02 - range within intervals - should be rounded to whole candles",
+			req: &api.ListMarketCandlesRequest{
+				ItemID:   "sw",
+				Interval: 5 * time.Minute,
+				From:     time.Unix(1699999999, 0),
+				To:       time.Unix(1700000000, 0),
+			},
+			wantRange: []int64{1699999800, 1700000100, 300},
+		},
+		{
+			name:    "TC03 - interval of seconds - should be invalid",
+			req:     &api.ListMarketCandlesRequest{ItemID: "sw", Interval: 90 * time.Second},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name: "TC04 - too many intervals - should be invalid",
+			req: &api.ListMarketCandlesRequest{
+				ItemID:   "sw",
+				Interval: time.Minute,
+				From:     now.Add(-1000 * time.Minute),
+			},
+			wantErr: api.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			marketRepo := repo.NewMockMarketRepo(t)
+			if tt.wantRange != nil {
+				marketRepo.EXPECT().FindCandles(ctx, "sw", "gold", tt.wantRange[0], tt.wantRange[1], tt.wantRange[2]).
+					Return([]*entity.MarketCandle{}, nil).Once()
+			}
+
+			svc := &MarketService{
+				marketRepo: marketRepo,
+				now:        func() time.Time { return now },
+				currency:   "gold",
+			}
+
+			res, err := svc.ListMarketCandles(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "gold", res.Currency)
+		})
+	}
+}
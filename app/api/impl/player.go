@@ -0,0 +1,505 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// oauthProviderPrefix prefixes the providers of OAuth subjects, followed by
+// the issuer.
+const oauthProviderPrefix = "oauth:"
+
+// roninAddress matches Ronin wallet addresses, "ronin:" or "0x" followed by
+// 40 hex digits.
+var roninAddress = regexp.MustCompile(`^(?:ronin:|0x)([0-9a-fA-F]{40})$`)
+
+// PlayerService implements all use cases of player profiles.
+type PlayerService struct {
+	playerRepo  repo.PlayerRepo
+	accountRepo repo.PlayerAccountRepo
+	policy      *auth.Policy
+	now         func() time.Time
+}
+
+// playerServicePermissions declares the permission each PlayerService method
+// requires. Players manage their own profile and accounts with Self, the
+// profiles of others require Read or Admin.
+var playerServicePermissions = struct {
+	Self  string
+	Read  string
+	Admin string
+}{
+	Self:  auth.PermissionCatalogRead,
+	Read:  auth.PermissionPlayerRead,
+	Admin: auth.PermissionPlayerAdmin,
+}
+
+// playerServiceTx declares the transaction each PlayerService method runs
+// in, writes run in default transactions.
+var playerServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewPlayerService creates and returns new instance of PlayerService, its
+// methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by playerServicePermissions, in
+// the transactions declared by playerServiceTx.
+func NewPlayerService(
+	playerRepo repo.PlayerRepo,
+	accountRepo repo.PlayerAccountRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.PlayerService, error) {
+	svc := &PlayerService{
+		playerRepo:  playerRepo,
+		accountRepo: accountRepo,
+		policy:      policy,
+		now:         time.Now,
+	}
+	perms, tx := playerServicePermissions, playerServiceTx
+
+	create, err := middleware(endpoints, "players.create", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "players.list", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "players.get", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	update, err := middleware(endpoints, "players.update", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	del, err := middleware(endpoints, "players.delete", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := middleware(endpoints, "players.accounts", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	link, err := middleware(endpoints, "players.link", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	unlink, err := middleware(endpoints, "players.unlink", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	lookup, err := middleware(endpoints, "players.lookup", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txPlayerService{
+		createPlayer:        wrap(svc.CreatePlayer, create),
+		listPlayers:         wrap(svc.ListPlayers, list),
+		getPlayer:           wrap(svc.GetPlayer, get),
+		updatePlayer:        wrap(svc.UpdatePlayer, update),
+		deletePlayer:        wrap(svc.DeletePlayer, del),
+		listPlayerAccounts:  wrap(svc.ListPlayerAccounts, accounts),
+		linkPlayerAccount:   wrap(svc.LinkPlayerAccount, link),
+		unlinkPlayerAccount: wrap(svc.UnlinkPlayerAccount, unlink),
+		lookupPlayer:        wrap(svc.LookupPlayer, lookup),
+	}, nil
+}
+
+// txPlayerService runs the methods of PlayerService through their
+// middlewares.
+type txPlayerService struct {
+	createPlayer        func(context.Context, *api.CreatePlayerRequest) (*api.Player, error)
+	listPlayers         func(context.Context, *api.ListPlayersRequest) (*api.ListPlayersResponse, error)
+	getPlayer           func(context.Context, *api.PlayerRequest) (*api.Player, error)
+	updatePlayer        func(context.Context, *api.UpdatePlayerRequest) (*api.Player, error)
+	deletePlayer        func(context.Context, *api.PlayerRequest) (*api.Player, error)
+	listPlayerAccounts  func(context.Context, *api.PlayerRequest) (*api.ListPlayerAccountsResponse, error)
+	linkPlayerAccount   func(context.Context, *api.LinkPlayerAccountRequest) (*api.PlayerAccount, error)
+	unlinkPlayerAccount func(context.Context, *api.UnlinkPlayerAccountRequest) (*api.PlayerAccount, error)
+	lookupPlayer        func(context.Context, *api.LookupPlayerRequest) (*api.Player, error)
+}
+
+// CreatePlayer implements api.PlayerService.
+func (s *txPlayerService) CreatePlayer(ctx context.Context, req *api.CreatePlayerRequest) (*api.Player, error) {
+	return s.createPlayer(ctx, req)
+}
+
+// ListPlayers implements api.PlayerService.
+func (s *txPlayerService) ListPlayers(ctx context.Context, req *api.ListPlayersRequest) (*api.ListPlayersResponse, error) {
+	return s.listPlayers(ctx, req)
+}
+
+// GetPlayer implements api.PlayerService.
+func (s *txPlayerService) GetPlayer(ctx context.Context, req *api.PlayerRequest) (*api.Player, error) {
+	return s.getPlayer(ctx, req)
+}
+
+// UpdatePlayer implements api.PlayerService.
+func (s *txPlayerService) UpdatePlayer(ctx context.Context, req *api.UpdatePlayerRequest) (*api.Player, error) {
+	return s.updatePlayer(ctx, req)
+}
+
+// DeletePlayer implements api.PlayerService.
+func (s *txPlayerService) DeletePlayer(ctx context.Context, req *api.PlayerRequest) (*api.Player, error) {
+	return s.deletePlayer(ctx, req)
+}
+
+// ListPlayerAccounts implements api.PlayerService.
+func (s *txPlayerService) ListPlayerAccounts(ctx context.Context, req *api.PlayerRequest) (*api.ListPlayerAccountsResponse, error) {
+	return s.listPlayerAccounts(ctx, req)
+}
+
+// LinkPlayerAccount implements api.PlayerService.
+func (s *txPlayerService) LinkPlayerAccount(ctx context.Context, req *api.LinkPlayerAccountRequest) (*api.PlayerAccount, error) {
+	return s.linkPlayerAccount(ctx, req)
+}
+
+// UnlinkPlayerAccount implements api.PlayerService.
+func (s *txPlayerService) UnlinkPlayerAccount(ctx context.Context, req *api.UnlinkPlayerAccountRequest) (*api.PlayerAccount, error) {
+	return s.unlinkPlayerAccount(ctx, req)
+}
+
+// LookupPlayer implements api.PlayerService.
+func (s *txPlayerService) LookupPlayer(ctx context.Context, req *api.LookupPlayerRequest) (*api.Player, error) {
+	return s.lookupPlayer(ctx, req)
+}
+
+// CreatePlayer creates the profile of the calling player, or of any player
+// for admins.
+func (s *PlayerService) CreatePlayer(ctx context.Context, req *api.CreatePlayerRequest) (*api.Player, error) {
+	if p := auth.FromContext(ctx); p != nil && p.Type == auth.PrincipalPlayer && (req.ID == "" || req.ID == p.ID) {
+		req.ID = p.ID
+	} else if err := s.policy.Authorize(ctx, playerServicePermissions.Admin); err != nil {
+		return nil, err
+	}
+
+	p := &entity.Player{
+		ID:          strings.TrimSpace(req.ID),
+		DisplayName: strings.TrimSpace(req.DisplayName),
+		Region:      req.Region,
+	}
+	if err := validatePlayer(p); err != nil {
+		return nil, err
+	}
+
+	p, err := s.playerRepo.Create(ctx, p)
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: player %q already exists", api.ErrConflict, req.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIPlayer(p), nil
+}
+
+// ListPlayers lists the players ordered by id, of a region and ban status
+// when set.
+func (s *PlayerService) ListPlayers(ctx context.Context, req *api.ListPlayersRequest) (*api.ListPlayersResponse, error) {
+	filter := &repo.PlayerFilter{Region: req.Region, Banned: req.Banned}
+	result, err := s.playerRepo.FindAll(ctx, filter, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListPlayersResponse{Items: make([]*api.Player, 0, len(result.Players)), Metadata: result.Metadata}
+	for _, p := range result.Players {
+		res.Items = append(res.Items, toAPIPlayer(p))
+	}
+
+	return res, nil
+}
+
+// GetPlayer returns the profile of a player.
+func (s *PlayerService) GetPlayer(ctx context.Context, req *api.PlayerRequest) (*api.Player, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.ID, playerServicePermissions.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.find(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIPlayer(p), nil
+}
+
+// UpdatePlayer updates the fields set of the profile of a player, banning or
+// unbanning requires Admin.
+func (s *PlayerService) UpdatePlayer(ctx context.Context, req *api.UpdatePlayerRequest) (*api.Player, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.ID, playerServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if req.Banned != nil || req.BanReason != nil || req.BannedUntil != nil {
+		if err := s.policy.Authorize(ctx, playerServicePermissions.Admin); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := s.find(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DisplayName != nil {
+		p.DisplayName = strings.TrimSpace(*req.DisplayName)
+	}
+	if req.Region != nil {
+		p.Region = *req.Region
+	}
+	if req.Banned != nil {
+		p.Banned = *req.Banned
+	}
+	if req.BanReason != nil {
+		p.BanReason = *req.BanReason
+	}
+	if req.BannedUntil != nil {
+		p.BannedUntil = *req.BannedUntil
+	}
+	if !p.Banned {
+		p.BanReason, p.BannedUntil = "", 0
+	}
+	if err := validatePlayer(p); err != nil {
+		return nil, err
+	}
+
+	if err := s.playerRepo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+	p.UpdatedAt = s.now().Unix()
+
+	return toAPIPlayer(p), nil
+}
+
+// DeletePlayer deletes a player along with its accounts, inventory, wallets,
+// ledger, trades, market orders and item instances.
+func (s *PlayerService) DeletePlayer(ctx context.Context, req *api.PlayerRequest) (*api.Player, error) {
+	playerID, err := resolvePlayer(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.find(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.playerRepo.Delete(ctx, p.ID); err != nil {
+		return nil, err
+	}
+
+	return toAPIPlayer(p), nil
+}
+
+// ListPlayerAccounts lists the accounts linked to a player ordered by
+// provider.
+func (s *PlayerService) ListPlayerAccounts(ctx context.Context, req *api.PlayerRequest) (*api.ListPlayerAccountsResponse, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.ID, playerServicePermissions.Read)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.find(ctx, playerID); err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.accountRepo.FindByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListPlayerAccountsResponse{Items: make([]*api.PlayerAccount, 0, len(accounts))}
+	for _, a := range accounts {
+		res.Items = append(res.Items, toAPIPlayerAccount(a))
+	}
+
+	return res, nil
+}
+
+// LinkPlayerAccount links an account to a player. An account is linked to
+// one player, and a player links one account of each provider.
+func (s *PlayerService) LinkPlayerAccount(ctx context.Context, req *api.LinkPlayerAccountRequest) (*api.PlayerAccount, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, playerServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+	provider, subject, err := normalizeAccount(req.Provider, req.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.find(ctx, playerID); err != nil {
+		return nil, err
+	}
+
+	a, err := s.accountRepo.Link(ctx, &entity.PlayerAccount{
+		PlayerID: playerID,
+		Provider: provider,
+		Subject:  subject,
+	})
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: %s account %q or the %s account of player %q is already linked",
+			api.ErrConflict, provider, subject, provider, playerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIPlayerAccount(a), nil
+}
+
+// UnlinkPlayerAccount unlinks the account of a provider from a player.
+func (s *PlayerService) UnlinkPlayerAccount(ctx context.Context, req *api.UnlinkPlayerAccountRequest) (*api.PlayerAccount, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, playerServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+	provider := strings.ToLower(strings.TrimSpace(req.Provider))
+
+	accounts, err := s.accountRepo.FindByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range accounts {
+		if a.Provider != provider {
+			continue
+		}
+		if err := s.accountRepo.Unlink(ctx, playerID, provider); err != nil {
+			return nil, err
+		}
+
+		return toAPIPlayerAccount(a), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s account of player %q", api.ErrNotFound, provider, playerID)
+}
+
+// LookupPlayer returns the player linked to an account.
+func (s *PlayerService) LookupPlayer(ctx context.Context, req *api.LookupPlayerRequest) (*api.Player, error) {
+	provider, subject, err := normalizeAccount(req.Provider, req.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.accountRepo.FindBySubject(ctx, provider, subject)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: no player linked to %s account %q", api.ErrNotFound, provider, subject)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.find(ctx, a.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIPlayer(p), nil
+}
+
+func (s *PlayerService) find(ctx context.Context, id string) (*entity.Player, error) {
+	p, err := s.playerRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: player %q", api.ErrNotFound, id)
+	}
+
+	return p, err
+}
+
+// validatePlayer validates the profile of a player.
+func validatePlayer(p *entity.Player) error {
+	var msgs []string
+	if p.ID == "" {
+		msgs = append(msgs, "id: is required")
+	}
+	if p.ID == playerMe {
+		msgs = append(msgs, fmt.Sprintf("id: %q is reserved", playerMe))
+	}
+	if p.DisplayName == "" {
+		msgs = append(msgs, "displayName: is required")
+	}
+	if len(p.DisplayName) > 64 {
+		msgs = append(msgs, "displayName: must be at most 64 characters")
+	}
+	if p.BannedUntil < 0 {
+		msgs = append(msgs, "bannedUntil: must not be negative")
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// normalizeAccount validates an account, and returns its provider and
+// subject in the form they are stored: providers in lower case, and Ronin
+// addresses in their lower case "0x" form.
+func normalizeAccount(provider, subject string) (string, string, error) {
+	provider = strings.ToLower(strings.TrimSpace(provider))
+	subject = strings.TrimSpace(subject)
+
+	var msgs []string
+	switch {
+	case provider == entity.PlayerAccountRonin:
+		m := roninAddress.FindStringSubmatch(subject)
+		if m == nil {
+			msgs = append(msgs, "subject: must be a Ronin address, 0x followed by 40 hex digits")
+			break
+		}
+		subject = "0x" + strings.ToLower(m[1])
+	case strings.HasPrefix(provider, oauthProviderPrefix) && len(provider) > len(oauthProviderPrefix):
+		if subject == "" {
+			msgs = append(msgs, "subject: is required")
+		}
+	default:
+		msgs = append(msgs, fmt.Sprintf("provider: must be %s or %s<issuer>", entity.PlayerAccountRonin, oauthProviderPrefix))
+	}
+
+	if len(msgs) > 0 {
+		return "", "", fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return provider, subject, nil
+}
+
+func toAPIPlayer(p *entity.Player) *api.Player {
+	return &api.Player{
+		ID:          p.ID,
+		DisplayName: p.DisplayName,
+		Region:      p.Region,
+		Banned:      p.Banned,
+		BanReason:   p.BanReason,
+		BannedUntil: p.BannedUntil,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+func toAPIPlayerAccount(a *entity.PlayerAccount) *api.PlayerAccount {
+	return &api.PlayerAccount{
+		PlayerID:  a.PlayerID,
+		Provider:  a.Provider,
+		Subject:   a.Subject,
+		CreatedAt: a.CreatedAt,
+	}
+}
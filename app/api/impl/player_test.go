@@ -0,0 +1,153 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+)
+
+func TestNormalizeAccount(t *testing.T) {
+	tests := []struct {
+		name         string
+		provider     string
+		subject      string
+		wantProvider string
+		wantSubject  string
+		wantErr      error
+	}{
+		{
+			name:         "TC01 - mixed case ronin address - should be lower cased",
+			provider:     "Ronin",
+			subject:      "0xAbCdEf0123456789aBcDeF0123456789AbCdEf01",
+			wantProvider: "ronin",
+			wantSubject:  "0xabcdef0123456789abcdef0123456789abcdef01",
+		},
+		{
+			name:         "TC02 - ronin: prefixed address - should be in 0x form",
+			provider:     "ronin",
+			subject:      "ronin:abcdef0123456789abcdef0123456789abcdef01",
+			wantProvider: "ronin",
+			wantSubject:  "0xabcdef0123456789abcdef0123456789abcdef01",
+		},
+		{
+			name:     "TC03 - short ronin address - should be invalid",
+			provider: "ronin",
+			subject:  "0xabc",
+			wantErr:  api.ErrInvalidArgument,
+		},
+		{
+			name:         "TC04 - oauth subject - should be kept",
+			provider:     "oauth:accounts.google.com",
+			subject:      "  1029384756 ",
+			wantProvider: "oauth:accounts.google.com",
+			wantSubject:  "1029384756",
+		},
+		{
+			name:     "TC05 - oauth provider without issuer - should be invalid",
+			provider: "oauth:",
+			subject:  "1029384756",
+			wantErr:  api.ErrInvalidArgument,
+		},
+		{
+			name:     "TC06 - unknown provider - should be invalid",
+			provider: "steam",
+			subject:  "76561198",
+			wantErr:  api.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, subject, err := normalizeAccount(tt.provider, tt.subject)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProvider, provider)
+			assert.Equal(t, tt.wantSubject, subject)
+		})
+	}
+}
+
+func TestPlayerService_UpdatePlayer(t *testing.T) {
+	policy := auth.NewPolicy(map[string][]string{
+		"moderator": {auth.PermissionPlayerAdmin},
+	})
+	player := &auth.Principal{ID: "p1", Type: auth.PrincipalPlayer}
+	moderator := &auth.Principal{ID: "mod", Type: auth.PrincipalService, Roles: []string{"moderator"}}
+	name, banned, reason := "Neo", true, "cheating"
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		req       *api.UpdatePlayerRequest
+		want      *api.Player
+		wantErr   error
+	}{
+		{
+			name:      "TC01 - player renaming themself - should be updated",
+			principal: player,
+			req:       &api.UpdatePlayerRequest{ID: playerMe, DisplayName: &name},
+			want:      &api.Player{ID: "p1", DisplayName: "Neo", UpdatedAt: 1700000000},
+		},
+		{
+			name:      "TC02 - player unbanning themself - should be denied",
+			principal: player,
+			req:       &api.UpdatePlayerRequest{ID: "p1", Banned: new(bool)},
+			wantErr:   auth.ErrPermissionDenied,
+		},
+		{
+			name:      "TC03 - player renaming another player - should be denied",
+			principal: &auth.Principal{ID: "p2", Type: auth.PrincipalPlayer},
+			req:       &api.UpdatePlayerRequest{ID: "p1", DisplayName: &name},
+			wantErr:   auth.ErrPermissionDenied,
+		},
+		{
+			name:      "TC04 - moderator banning a player - should be banned",
+			principal: moderator,
+			req:       &api.UpdatePlayerRequest{ID: "p1", Banned: &banned, BanReason: &reason},
+			want:      &api.Player{ID: "p1", DisplayName: "Trinity", Banned: true, BanReason: "cheating", UpdatedAt: 1700000000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), tt.principal)
+			playerRepo := repo.NewMockPlayerRepo(t)
+			if tt.wantErr == nil {
+				playerRepo.EXPECT().FindByID(ctx, "p1").Return(&entity.Player{ID: "p1", DisplayName: "Trinity"}, nil).Once()
+				playerRepo.EXPECT().Update(ctx, &entity.Player{
+					ID:          tt.want.ID,
+					DisplayName: tt.want.DisplayName,
+					Banned:      tt.want.Banned,
+					BanReason:   tt.want.BanReason,
+				}).Return(nil).Once()
+			}
+
+			svc := &PlayerService{
+				playerRepo:  playerRepo,
+				accountRepo: repo.NewMockPlayerAccountRepo(t),
+				policy:      policy,
+				now:         func() time.Time { return time.Unix(1700000000, 0) },
+			}
+
+			res, err := svc.UpdatePlayer(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
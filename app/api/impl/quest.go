@@ -0,0 +1,695 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// maxPlayerEvents is the maximum number of events of an ingestion request.
+const maxPlayerEvents = 500
+
+// questRewardSource is the source of the inventory grants of quest rewards,
+// formatted with the quest id.
+const questRewardSource = "quest:%s"
+
+// QuestService implements all use cases of quests and achievements.
+type QuestService struct {
+	questRepo       repo.QuestRepo
+	progressRepo    repo.QuestProgressRepo
+	playerEventRepo repo.PlayerEventRepo
+	inventoryRepo   repo.InventoryRepo
+	itemRepo        repo.ItemRepo
+	playerRepo      repo.PlayerRepo
+	policy          *auth.Policy
+	now             func() time.Time
+}
+
+// questServicePermissions declares the permission each QuestService method
+// requires. Players list their own quests with Read, the quests of others
+// require Admin.
+var questServicePermissions = struct {
+	Read   string
+	Admin  string
+	Ingest string
+}{
+	Read:   auth.PermissionCatalogRead,
+	Admin:  auth.PermissionQuestAdmin,
+	Ingest: auth.PermissionQuestIngest,
+}
+
+// questServiceTx declares the transaction each QuestService method runs in.
+// Ingestion is serializable so that concurrent events of a player cannot
+// overwrite each other's progress.
+var questServiceTx = struct {
+	Read   []database.TxOption
+	Ingest []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+	Ingest: []database.TxOption{
+		database.TxOptions.Isolation(sql.LevelSerializable),
+	},
+}
+
+// NewQuestService creates and returns new instance of QuestService, its
+// methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by questServicePermissions, in
+// the transactions declared by questServiceTx.
+func NewQuestService(
+	questRepo repo.QuestRepo,
+	progressRepo repo.QuestProgressRepo,
+	playerEventRepo repo.PlayerEventRepo,
+	inventoryRepo repo.InventoryRepo,
+	itemRepo repo.ItemRepo,
+	playerRepo repo.PlayerRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.QuestService, error) {
+	svc := &QuestService{
+		questRepo:       questRepo,
+		progressRepo:    progressRepo,
+		playerEventRepo: playerEventRepo,
+		inventoryRepo:   inventoryRepo,
+		itemRepo:        itemRepo,
+		playerRepo:      playerRepo,
+		policy:          policy,
+		now:             time.Now,
+	}
+	perms, tx := questServicePermissions, questServiceTx
+
+	create, err := middleware(endpoints, "quests.create", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "quests.list", policy, perms.Admin, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "quests.get", policy, perms.Admin, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	update, err := middleware(endpoints, "quests.update", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	del, err := middleware(endpoints, "quests.delete", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	ingest, err := middleware(endpoints, "quests.ingest", policy, perms.Ingest, client, tx.Ingest...)
+	if err != nil {
+		return nil, err
+	}
+	listPlayer, err := middleware(endpoints, "quests.list_player", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txQuestService{
+		createQuest:        wrap(svc.CreateQuest, create),
+		listQuests:         wrap(svc.ListQuests, list),
+		getQuest:           wrap(svc.GetQuest, get),
+		updateQuest:        wrap(svc.UpdateQuest, update),
+		deleteQuest:        wrap(svc.DeleteQuest, del),
+		ingestPlayerEvents: wrap(svc.IngestPlayerEvents, ingest),
+		listPlayerQuests:   wrap(svc.ListPlayerQuests, listPlayer),
+	}, nil
+}
+
+// txQuestService runs the methods of QuestService through their
+// middlewares.
+type txQuestService struct {
+	createQuest        func(context.Context, *api.CreateQuestRequest) (*api.Quest, error)
+	listQuests         func(context.Context, *api.ListQuestsRequest) (*api.ListQuestsResponse, error)
+	getQuest           func(context.Context, *api.QuestRequest) (*api.Quest, error)
+	updateQuest        func(context.Context, *api.UpdateQuestRequest) (*api.Quest, error)
+	deleteQuest        func(context.Context, *api.QuestRequest) (*api.Quest, error)
+	ingestPlayerEvents func(context.Context, *api.IngestPlayerEventsRequest) (*api.IngestPlayerEventsResponse, error)
+	listPlayerQuests   func(context.Context, *api.ListPlayerQuestsRequest) (*api.ListPlayerQuestsResponse, error)
+}
+
+// CreateQuest implements api.QuestService.
+func (s *txQuestService) CreateQuest(ctx context.Context, req *api.CreateQuestRequest) (*api.Quest, error) {
+	return s.createQuest(ctx, req)
+}
+
+// ListQuests implements api.QuestService.
+func (s *txQuestService) ListQuests(ctx context.Context, req *api.ListQuestsRequest) (*api.ListQuestsResponse, error) {
+	return s.listQuests(ctx, req)
+}
+
+// GetQuest implements api.QuestService.
+func (s *txQuestService) GetQuest(ctx context.Context, req *api.QuestRequest) (*api.Quest, error) {
+	return s.getQuest(ctx, req)
+}
+
+// UpdateQuest implements api.QuestService.
+func (s *txQuestService) UpdateQuest(ctx context.Context, req *api.UpdateQuestRequest) (*api.Quest, error) {
+	return s.updateQuest(ctx, req)
+}
+
+// DeleteQuest implements api.QuestService.
+func (s *txQuestService) DeleteQuest(ctx context.Context, req *api.QuestRequest) (*api.Quest, error) {
+	return s.deleteQuest(ctx, req)
+}
+
+// IngestPlayerEvents implements api.QuestService.
+func (s *txQuestService) IngestPlayerEvents(ctx context.Context, req *api.IngestPlayerEventsRequest) (*api.IngestPlayerEventsResponse, error) {
+	return s.ingestPlayerEvents(ctx, req)
+}
+
+// ListPlayerQuests implements api.QuestService.
+func (s *txQuestService) ListPlayerQuests(ctx context.Context, req *api.ListPlayerQuestsRequest) (*api.ListPlayerQuestsResponse, error) {
+	return s.listPlayerQuests(ctx, req)
+}
+
+// CreateQuest creates a quest.
+func (s *QuestService) CreateQuest(ctx context.Context, req *api.CreateQuestRequest) (*api.Quest, error) {
+	_, err := s.questRepo.FindByID(ctx, req.ID)
+	if err == nil {
+		return nil, fmt.Errorf("%w: quest %q already exists", api.ErrConflict, req.ID)
+	}
+	if !errors.Is(err, repo.ErrNotFound) {
+		return nil, err
+	}
+
+	q := &entity.Quest{
+		ID:          req.ID,
+		Kind:        req.Kind,
+		Name:        req.Name,
+		Description: req.Description,
+		Objectives:  toQuestObjectives(req.Objectives),
+		Rewards:     toQuestRewards(req.Rewards),
+		Active:      req.Active == nil || *req.Active,
+		CreatedBy:   principalID(ctx),
+	}
+	if q.Kind == "" {
+		q.Kind = entity.QuestKindQuest
+	}
+	if err := s.validate(ctx, q); err != nil {
+		return nil, err
+	}
+
+	q, err = s.questRepo.Create(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIQuest(q), nil
+}
+
+// ListQuests lists the quests ordered by id, or the active ones.
+func (s *QuestService) ListQuests(ctx context.Context, req *api.ListQuestsRequest) (*api.ListQuestsResponse, error) {
+	quests, err := s.questRepo.FindAll(ctx, req.Active)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListQuestsResponse{Items: make([]*api.Quest, 0, len(quests))}
+	for _, q := range quests {
+		res.Items = append(res.Items, toAPIQuest(q))
+	}
+
+	return res, nil
+}
+
+// GetQuest returns a quest.
+func (s *QuestService) GetQuest(ctx context.Context, req *api.QuestRequest) (*api.Quest, error) {
+	q, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIQuest(q), nil
+}
+
+// UpdateQuest updates the fields set of a quest. Progress of players is
+// kept, quests they completed stay completed.
+func (s *QuestService) UpdateQuest(ctx context.Context, req *api.UpdateQuestRequest) (*api.Quest, error) {
+	q, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Kind != nil {
+		q.Kind = *req.Kind
+	}
+	if req.Name != nil {
+		q.Name = *req.Name
+	}
+	if req.Description != nil {
+		q.Description = *req.Description
+	}
+	if req.Objectives != nil {
+		q.Objectives = toQuestObjectives(req.Objectives)
+	}
+	if req.Rewards != nil {
+		q.Rewards = toQuestRewards(req.Rewards)
+	}
+	if req.Active != nil {
+		q.Active = *req.Active
+	}
+	if err := s.validate(ctx, q); err != nil {
+		return nil, err
+	}
+
+	if err := s.questRepo.Update(ctx, q); err != nil {
+		return nil, err
+	}
+	q.UpdatedAt = s.now().Unix()
+
+	return toAPIQuest(q), nil
+}
+
+// DeleteQuest deletes a quest along with the progress of players on it,
+// rewards already granted are kept.
+func (s *QuestService) DeleteQuest(ctx context.Context, req *api.QuestRequest) (*api.Quest, error) {
+	q, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.questRepo.Delete(ctx, q.ID); err != nil {
+		return nil, err
+	}
+
+	return toAPIQuest(q), nil
+}
+
+// IngestPlayerEvents applies player events, in order, to the active quests.
+// Events already applied are skipped, so retries are safe. Quests whose
+// objectives become all met are completed and their rewards granted, once.
+func (s *QuestService) IngestPlayerEvents(ctx context.Context, req *api.IngestPlayerEventsRequest) (*api.IngestPlayerEventsResponse, error) {
+	events, err := toPlayerEvents(req.Events)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validatePlayers(ctx, events); err != nil {
+		return nil, err
+	}
+
+	quests, err := s.questRepo.FindAll(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.IngestPlayerEventsResponse{Completions: []*api.QuestCompletion{}}
+	// progress are the progress of players by quest, loaded on their first
+	// event, and changed those whose counts changed in order of change.
+	progress := make(map[string]map[string]*entity.QuestProgress)
+	var changed []*entity.QuestProgress
+	for _, e := range events {
+		recorded, err := s.playerEventRepo.Record(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+		if !recorded {
+			res.Duplicates++
+			continue
+		}
+		res.Applied++
+
+		byQuest, ok := progress[e.PlayerID]
+		if !ok {
+			if byQuest, err = s.findProgress(ctx, e.PlayerID); err != nil {
+				return nil, err
+			}
+			progress[e.PlayerID] = byQuest
+		}
+
+		for _, q := range quests {
+			p, ok := byQuest[q.ID]
+			if !ok {
+				p = &entity.QuestProgress{PlayerID: e.PlayerID, QuestID: q.ID, Status: entity.QuestStatusInProgress}
+				byQuest[q.ID] = p
+			}
+			if p.Status != entity.QuestStatusInProgress || !p.Progress(q, e) {
+				continue
+			}
+			if !slices.Contains(changed, p) {
+				changed = append(changed, p)
+			}
+		}
+	}
+
+	questsByID := make(map[string]*entity.Quest, len(quests))
+	for _, q := range quests {
+		questsByID[q.ID] = q
+	}
+	for _, p := range changed {
+		saved, err := s.progressRepo.Save(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		p.ID = saved.ID
+
+		q := questsByID[p.QuestID]
+		if !p.Done(q) {
+			continue
+		}
+
+		completion, err := s.complete(ctx, q, p)
+		if err != nil {
+			return nil, err
+		}
+		if completion != nil {
+			res.Completions = append(res.Completions, completion)
+		}
+	}
+
+	return res, nil
+}
+
+// complete completes the progress p of quest q and grants its rewards, or
+// returns nil if p was completed already.
+func (s *QuestService) complete(ctx context.Context, q *entity.Quest, p *entity.QuestProgress) (*api.QuestCompletion, error) {
+	p.CompletedAt = s.now().Unix()
+	completed, err := s.progressRepo.Complete(ctx, p, q.Rewards)
+	if err != nil || !completed {
+		return nil, err
+	}
+
+	// Rewards of the same item are granted as one.
+	var grants []*entity.InventoryGrant
+	for _, r := range q.Rewards {
+		idx := slices.IndexFunc(grants, func(g *entity.InventoryGrant) bool { return g.ItemID == r.ItemID })
+		if idx < 0 {
+			grants = append(grants, &entity.InventoryGrant{ItemID: r.ItemID, Quantity: r.Quantity})
+			continue
+		}
+		grants[idx].Quantity += r.Quantity
+	}
+	if err := s.inventoryRepo.Grant(ctx, p.PlayerID, grants, fmt.Sprintf(questRewardSource, q.ID)); err != nil {
+		return nil, err
+	}
+
+	return &api.QuestCompletion{
+		PlayerID:    p.PlayerID,
+		QuestID:     q.ID,
+		Rewards:     toAPIQuestRewards(q.Rewards),
+		CompletedAt: p.CompletedAt,
+	}, nil
+}
+
+// ListPlayerQuests lists the active quests along with the progress of a
+// player on them, and the inactive ones completed by the player, ordered by
+// quest.
+func (s *QuestService) ListPlayerQuests(ctx context.Context, req *api.ListPlayerQuestsRequest) (*api.ListPlayerQuestsResponse, error) {
+	if req.Status != "" && req.Status != entity.QuestStatusInProgress && req.Status != entity.QuestStatusCompleted {
+		return nil, fmt.Errorf("%w: status: must be %s or %s",
+			api.ErrInvalidArgument, entity.QuestStatusInProgress, entity.QuestStatusCompleted)
+	}
+
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, questServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	quests, err := s.questRepo.FindAll(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	byQuest, err := s.findProgress(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListPlayerQuestsResponse{PlayerID: playerID, Items: []*api.PlayerQuest{}}
+	for _, q := range quests {
+		p, ok := byQuest[q.ID]
+		if !ok {
+			p = &entity.QuestProgress{Status: entity.QuestStatusInProgress}
+		}
+		if !q.Active && p.Status != entity.QuestStatusCompleted {
+			continue
+		}
+		if (req.Status != "" && p.Status != req.Status) || (req.Kind != "" && q.Kind != req.Kind) {
+			continue
+		}
+
+		res.Items = append(res.Items, toAPIPlayerQuest(q, p))
+	}
+
+	return res, nil
+}
+
+// validatePlayers validates that the players of events exist, rewards being
+// granted to the inventories of players.
+func (s *QuestService) validatePlayers(ctx context.Context, events []*entity.PlayerEvent) error {
+	var ids []string
+	for _, e := range events {
+		if !slices.Contains(ids, e.PlayerID) {
+			ids = append(ids, e.PlayerID)
+		}
+	}
+
+	players, err := s.playerRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	for _, id := range ids {
+		if !slices.ContainsFunc(players, func(p *entity.Player) bool { return p.ID == id }) {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: events: unknown players %s", api.ErrInvalidArgument, strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// findProgress returns the progress of a player by quest.
+func (s *QuestService) findProgress(ctx context.Context, playerID string) (map[string]*entity.QuestProgress, error) {
+	progress, err := s.progressRepo.FindByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*entity.QuestProgress, len(progress))
+	for _, p := range progress {
+		res[p.QuestID] = p
+	}
+
+	return res, nil
+}
+
+// find returns the quest of id, or api.ErrNotFound.
+func (s *QuestService) find(ctx context.Context, id string) (*entity.Quest, error) {
+	q, err := s.questRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: quest %q", api.ErrNotFound, id)
+	}
+
+	return q, err
+}
+
+// validate validates the quest q, whose referenced items must exist in the
+// catalog.
+func (s *QuestService) validate(ctx context.Context, q *entity.Quest) error {
+	var msgs []string
+	if strings.TrimSpace(q.ID) == "" {
+		msgs = append(msgs, "id: is required")
+	}
+	if strings.TrimSpace(q.Name) == "" {
+		msgs = append(msgs, "name: is required")
+	}
+	if q.Kind != entity.QuestKindQuest && q.Kind != entity.QuestKindAchievement {
+		msgs = append(msgs, fmt.Sprintf("kind: must be %s or %s", entity.QuestKindQuest, entity.QuestKindAchievement))
+	}
+	if len(q.Objectives) == 0 {
+		msgs = append(msgs, "objectives: at least one is required")
+	}
+
+	var ids []string
+	for idx, o := range q.Objectives {
+		if strings.TrimSpace(o.Type) == "" {
+			msgs = append(msgs, fmt.Sprintf("objectives[%d].type: is required", idx))
+		}
+		if o.Count < 1 {
+			msgs = append(msgs, fmt.Sprintf("objectives[%d].count: must be at least 1", idx))
+		}
+		if o.ItemID != "" && !slices.Contains(ids, o.ItemID) {
+			ids = append(ids, o.ItemID)
+		}
+	}
+	for idx, r := range q.Rewards {
+		if r.ItemID == "" {
+			msgs = append(msgs, fmt.Sprintf("rewards[%d].itemID: is required", idx))
+		}
+		if r.Quantity < 1 {
+			msgs = append(msgs, fmt.Sprintf("rewards[%d].quantity: must be at least 1", idx))
+		}
+		if r.ItemID != "" && !slices.Contains(ids, r.ItemID) {
+			ids = append(ids, r.ItemID)
+		}
+	}
+
+	if len(ids) > 0 {
+		items, err := s.itemRepo.FindByItemIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if !slices.ContainsFunc(items, func(i *entity.Item) bool { return i.ID == id }) {
+				msgs = append(msgs, fmt.Sprintf("unknown item %q", id))
+			}
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// toPlayerEvents validates player events and converts them to entities.
+func toPlayerEvents(events []*api.PlayerEvent) ([]*entity.PlayerEvent, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: events: at least one is required", api.ErrInvalidArgument)
+	}
+	if len(events) > maxPlayerEvents {
+		return nil, fmt.Errorf("%w: events: at most %d are allowed", api.ErrInvalidArgument, maxPlayerEvents)
+	}
+
+	var msgs []string
+	res := make([]*entity.PlayerEvent, 0, len(events))
+	for idx, e := range events {
+		if e == nil {
+			msgs = append(msgs, fmt.Sprintf("events[%d]: is required", idx))
+			continue
+		}
+		if strings.TrimSpace(e.ID) == "" {
+			msgs = append(msgs, fmt.Sprintf("events[%d].id: is required", idx))
+		}
+		if strings.TrimSpace(e.PlayerID) == "" {
+			msgs = append(msgs, fmt.Sprintf("events[%d].playerID: is required", idx))
+		}
+		if strings.TrimSpace(e.Type) == "" {
+			msgs = append(msgs, fmt.Sprintf("events[%d].type: is required", idx))
+		}
+		quantity := e.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+		if quantity < 0 {
+			msgs = append(msgs, fmt.Sprintf("events[%d].quantity: must be positive", idx))
+		}
+
+		res = append(res, &entity.PlayerEvent{
+			ID:         e.ID,
+			PlayerID:   e.PlayerID,
+			Type:       e.Type,
+			ItemID:     e.ItemID,
+			Quantity:   quantity,
+			OccurredAt: e.OccurredAt,
+		})
+	}
+
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return res, nil
+}
+
+func toQuestObjectives(objectives []*api.QuestObjective) []*entity.QuestObjective {
+	res := make([]*entity.QuestObjective, 0, len(objectives))
+	for _, o := range objectives {
+		if o != nil {
+			res = append(res, &entity.QuestObjective{Type: o.Type, ItemID: o.ItemID, Count: o.Count})
+		}
+	}
+
+	return res
+}
+
+func toQuestRewards(rewards []*api.QuestReward) []*entity.QuestReward {
+	res := make([]*entity.QuestReward, 0, len(rewards))
+	for _, r := range rewards {
+		if r != nil {
+			res = append(res, &entity.QuestReward{ItemID: r.ItemID, Quantity: r.Quantity})
+		}
+	}
+
+	return res
+}
+
+func toAPIQuestRewards(rewards []*entity.QuestReward) []*api.QuestReward {
+	res := make([]*api.QuestReward, 0, len(rewards))
+	for _, r := range rewards {
+		res = append(res, &api.QuestReward{ItemID: r.ItemID, Quantity: r.Quantity})
+	}
+
+	return res
+}
+
+func toAPIQuest(q *entity.Quest) *api.Quest {
+	objectives := make([]*api.QuestObjective, 0, len(q.Objectives))
+	for _, o := range q.Objectives {
+		objectives = append(objectives, &api.QuestObjective{Type: o.Type, ItemID: o.ItemID, Count: o.Count})
+	}
+
+	return &api.Quest{
+		ID:          q.ID,
+		Kind:        q.Kind,
+		Name:        q.Name,
+		Description: q.Description,
+		Objectives:  objectives,
+		Rewards:     toAPIQuestRewards(q.Rewards),
+		Active:      q.Active,
+		CreatedBy:   q.CreatedBy,
+		CreatedAt:   q.CreatedAt,
+		UpdatedAt:   q.UpdatedAt,
+	}
+}
+
+// toAPIPlayerQuest converts a quest and the progress p of a player on it to
+// its rest resource. Objectives of completed quests are reported met.
+func toAPIPlayerQuest(q *entity.Quest, p *entity.QuestProgress) *api.PlayerQuest {
+	objectives := make([]*api.QuestObjective, 0, len(q.Objectives))
+	for i, o := range q.Objectives {
+		progress := o.Count
+		if p.Status != entity.QuestStatusCompleted {
+			progress = 0
+			if i < len(p.Counts) {
+				progress = min(p.Counts[i], o.Count)
+			}
+		}
+		objectives = append(objectives, &api.QuestObjective{
+			Type:     o.Type,
+			ItemID:   o.ItemID,
+			Count:    o.Count,
+			Progress: &progress,
+		})
+	}
+
+	return &api.PlayerQuest{
+		QuestID:     q.ID,
+		Kind:        q.Kind,
+		Name:        q.Name,
+		Description: q.Description,
+		Status:      p.Status,
+		Objectives:  objectives,
+		Rewards:     toAPIQuestRewards(q.Rewards),
+		CompletedAt: p.CompletedAt,
+	}
+}
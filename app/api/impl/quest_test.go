@@ -0,0 +1,155 @@
+package impl
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+)
+
+func TestQuestService_IngestPlayerEvents(t *testing.T) {
+	quest := &entity.Quest{
+		ID:   "gems",
+		Kind: entity.QuestKindQuest,
+		Objectives: []*entity.QuestObjective{
+			{Type: "collect", ItemID: "gem", Count: 10},
+			{Type: "craft", Count: 1},
+		},
+		Rewards: []*entity.QuestReward{{ItemID: "sw", Quantity: 1}, {ItemID: "sw", Quantity: 2}},
+		Active:  true,
+	}
+
+	tests := []struct {
+		name           string
+		progress       []*entity.QuestProgress
+		events         []*api.PlayerEvent
+		duplicates     []string
+		completedByRun bool
+		wantCounts     []int
+		want           *api.IngestPlayerEventsResponse
+		wantErr        error
+	}{
+		{
+			name: "TC01 - events matching objectives - should progress without completion",
+			events: []*api.PlayerEvent{
+				{ID: "e1", PlayerID: "p1", Type: "collect", ItemID: "gem", Quantity: 4},
+				{ID: "e2", PlayerID: "p1", Type: "collect", ItemID: "ore", Quantity: 4},
+				{ID: "e3", PlayerID: "p1", Type: "collect", ItemID: "gem"},
+			},
+			wantCounts: []int{5, 0},
+			want:       &api.IngestPlayerEventsResponse{Applied: 3, Completions: []*api.QuestCompletion{}},
+		},
+		{
+			name: "TC02 - events meeting all objectives - should complete and grant rewards",
+			progress: []*entity.QuestProgress{
+				{ID: 7, PlayerID: "p1", QuestID: "gems", Status: entity.QuestStatusInProgress, Counts: []int{8, 0}},
+			},
+			events: []*api.PlayerEvent{
+				{ID: "e1", PlayerID: "p1", Type: "collect", ItemID: "gem", Quantity: 5},
+				{ID: "e2", PlayerID: "p1", Type: "craft", ItemID: "sw"},
+			},
+			wantCounts: []int{10, 1},
+			want: &api.IngestPlayerEventsResponse{Applied: 2, Completions: []*api.QuestCompletion{{
+				PlayerID:    "p1",
+				QuestID:     "gems",
+				Rewards:     []*api.QuestReward{{ItemID: "sw", Quantity: 1}, {ItemID: "sw", Quantity: 2}},
+				CompletedAt: 1700000000,
+			}}},
+		},
+		{
+			name: "TC03 - completion by a concurrent request - should not grant rewards",
+			progress: []*entity.QuestProgress{
+				{ID: 7, PlayerID: "p1", QuestID: "gems", Status: entity.QuestStatusInProgress, Counts: []int{10, 0}},
+			},
+			events:         []*api.PlayerEvent{{ID: "e1", PlayerID: "p1", Type: "craft"}},
+			completedByRun: true,
+			wantCounts:     []int{10, 1},
+			want:           &api.IngestPlayerEventsResponse{Applied: 1, Completions: []*api.QuestCompletion{}},
+		},
+		{
+			name: "TC04 - completed quest and duplicate event - should be skipped",
+			progress: []*entity.QuestProgress{
+				{ID: 7, PlayerID: "p1", QuestID: "gems", Status: entity.QuestStatusCompleted, Counts: []int{10, 1}},
+			},
+			events: []*api.PlayerEvent{
+				{ID: "e1", PlayerID: "p1", Type: "collect", ItemID: "gem"},
+				{ID: "e2", PlayerID: "p1", Type: "craft"},
+			},
+			duplicates: []string{"e2"},
+			want:       &api.IngestPlayerEventsResponse{Applied: 1, Duplicates: 1, Completions: []*api.QuestCompletion{}},
+		},
+		{
+			name:    "TC05 - event without player - should be invalid",
+			events:  []*api.PlayerEvent{{ID: "e1", Type: "craft"}},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name:    "TC06 - event of unknown player - should be invalid",
+			events:  []*api.PlayerEvent{{ID: "e1", PlayerID: "p2", Type: "craft"}},
+			wantErr: api.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			questRepo := repo.NewMockQuestRepo(t)
+			progressRepo := repo.NewMockQuestProgressRepo(t)
+			playerEventRepo := repo.NewMockPlayerEventRepo(t)
+			inventoryRepo := repo.NewMockInventoryRepo(t)
+			playerRepo := repo.NewMockPlayerRepo(t)
+
+			if slices.ContainsFunc(tt.events, func(e *api.PlayerEvent) bool { return e.PlayerID != "" }) {
+				playerRepo.EXPECT().FindByIDs(ctx, mock.Anything).Return([]*entity.Player{{ID: "p1"}}, nil).Once()
+			}
+			if tt.wantErr == nil {
+				questRepo.EXPECT().FindAll(ctx, true).Return([]*entity.Quest{quest}, nil).Once()
+				playerEventRepo.EXPECT().Record(ctx, mock.Anything).RunAndReturn(
+					func(_ context.Context, e *entity.PlayerEvent) (bool, error) {
+						return !slices.Contains(tt.duplicates, e.ID), nil
+					})
+				progressRepo.EXPECT().FindByPlayer(ctx, "p1").Return(tt.progress, nil).Once()
+			}
+			if tt.wantCounts != nil {
+				progressRepo.EXPECT().Save(ctx, mock.Anything).RunAndReturn(
+					func(_ context.Context, p *entity.QuestProgress) (*entity.QuestProgress, error) {
+						assert.Equal(t, tt.wantCounts, p.Counts)
+						return &entity.QuestProgress{ID: 7}, nil
+					}).Once()
+			}
+			if tt.want != nil && (len(tt.want.Completions) > 0 || tt.completedByRun) {
+				progressRepo.EXPECT().Complete(ctx, mock.Anything, quest.Rewards).
+					Return(!tt.completedByRun, nil).Once()
+			}
+			if tt.want != nil && len(tt.want.Completions) > 0 {
+				inventoryRepo.EXPECT().Grant(ctx, "p1", []*entity.InventoryGrant{{ItemID: "sw", Quantity: 3}}, "quest:gems").
+					Return(nil).Once()
+			}
+
+			svc := &QuestService{
+				questRepo:       questRepo,
+				progressRepo:    progressRepo,
+				playerEventRepo: playerEventRepo,
+				inventoryRepo:   inventoryRepo,
+				playerRepo:      playerRepo,
+				now:             func() time.Time { return time.Unix(1700000000, 0) },
+			}
+
+			res, err := svc.IngestPlayerEvents(ctx, &api.IngestPlayerEventsRequest{Events: tt.events})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,488 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// Limits of trade proposals.
+const (
+	// maxTradeAssets is the maximum number of assets of each side of a trade.
+	maxTradeAssets = 20
+	// maxTradeAmount bounds the amount of each asset of a trade, merged
+	// amounts included, so that balances never overflow.
+	maxTradeAmount = 1_000_000_000
+	// defaultTradeTTL and maxTradeTTL are the default and maximum time from
+	// the proposal of a trade to its expiry.
+	defaultTradeTTL = 24 * time.Hour
+	maxTradeTTL     = 7 * 24 * time.Hour
+)
+
+// TradeService implements all use cases of trades between players.
+type TradeService struct {
+	tradeRepo  repo.TradeRepo
+	playerRepo repo.PlayerRepo
+	itemRepo   repo.ItemRepo
+	policy     *auth.Policy
+	now        func() time.Time
+}
+
+// tradeServicePermissions declares the permission each TradeService method
+// requires. Players trade with Self, the trades of others require Admin.
+var tradeServicePermissions = struct {
+	Self  string
+	Admin string
+}{
+	Self:  auth.PermissionCatalogRead,
+	Admin: auth.PermissionEconomyAdmin,
+}
+
+// tradeServiceTx declares the transaction each TradeService method runs in,
+// writes run in default transactions, escrow and swaps being conditional
+// updates of the rows they lock.
+var tradeServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewTradeService creates and returns new instance of TradeService, its
+// methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by tradeServicePermissions, in
+// the transactions declared by tradeServiceTx.
+func NewTradeService(
+	tradeRepo repo.TradeRepo,
+	playerRepo repo.PlayerRepo,
+	itemRepo repo.ItemRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.TradeService, error) {
+	svc := &TradeService{
+		tradeRepo:  tradeRepo,
+		playerRepo: playerRepo,
+		itemRepo:   itemRepo,
+		policy:     policy,
+		now:        time.Now,
+	}
+	perms, tx := tradeServicePermissions, tradeServiceTx
+
+	propose, err := middleware(endpoints, "trades.propose", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "trades.list", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "trades.get", policy, perms.Self, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	accept, err := middleware(endpoints, "trades.accept", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	reject, err := middleware(endpoints, "trades.reject", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+	cancel, err := middleware(endpoints, "trades.cancel", policy, perms.Self, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txTradeService{
+		proposeTrade:     wrap(svc.ProposeTrade, propose),
+		listPlayerTrades: wrap(svc.ListPlayerTrades, list),
+		getTrade:         wrap(svc.GetTrade, get),
+		acceptTrade:      wrap(svc.AcceptTrade, accept),
+		rejectTrade:      wrap(svc.RejectTrade, reject),
+		cancelTrade:      wrap(svc.CancelTrade, cancel),
+	}, nil
+}
+
+// txTradeService runs the methods of TradeService through their
+// middlewares.
+type txTradeService struct {
+	proposeTrade     func(context.Context, *api.ProposeTradeRequest) (*api.Trade, error)
+	listPlayerTrades func(context.Context, *api.ListPlayerTradesRequest) (*api.ListTradesResponse, error)
+	getTrade         func(context.Context, *api.TradeRequest) (*api.Trade, error)
+	acceptTrade      func(context.Context, *api.TradeRequest) (*api.Trade, error)
+	rejectTrade      func(context.Context, *api.TradeRequest) (*api.Trade, error)
+	cancelTrade      func(context.Context, *api.TradeRequest) (*api.Trade, error)
+}
+
+// ProposeTrade implements api.TradeService.
+func (s *txTradeService) ProposeTrade(ctx context.Context, req *api.ProposeTradeRequest) (*api.Trade, error) {
+	return s.proposeTrade(ctx, req)
+}
+
+// ListPlayerTrades implements api.TradeService.
+func (s *txTradeService) ListPlayerTrades(ctx context.Context, req *api.ListPlayerTradesRequest) (*api.ListTradesResponse, error) {
+	return s.listPlayerTrades(ctx, req)
+}
+
+// GetTrade implements api.TradeService.
+func (s *txTradeService) GetTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	return s.getTrade(ctx, req)
+}
+
+// AcceptTrade implements api.TradeService.
+func (s *txTradeService) AcceptTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	return s.acceptTrade(ctx, req)
+}
+
+// RejectTrade implements api.TradeService.
+func (s *txTradeService) RejectTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	return s.rejectTrade(ctx, req)
+}
+
+// CancelTrade implements api.TradeService.
+func (s *txTradeService) CancelTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	return s.cancelTrade(ctx, req)
+}
+
+// ProposeTrade proposes a trade of the calling player to a recipient, and
+// holds its offered assets in escrow.
+func (s *TradeService) ProposeTrade(ctx context.Context, req *api.ProposeTradeRequest) (*api.Trade, error) {
+	proposerID, err := resolvePlayer(ctx, playerMe)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	t := &entity.Trade{
+		ProposerID:  proposerID,
+		RecipientID: req.RecipientID,
+		ExpiresAt:   req.ExpiresAt,
+	}
+	if t.ExpiresAt == 0 {
+		t.ExpiresAt = now.Add(defaultTradeTTL).Unix()
+	}
+	if err := s.validateTrade(ctx, t, req, now); err != nil {
+		return nil, err
+	}
+	if err := s.checkTraders(ctx, t, now); err != nil {
+		return nil, err
+	}
+
+	t, err = s.tradeRepo.Propose(ctx, t)
+	if errors.Is(err, repo.ErrInsufficientFunds) {
+		return nil, fmt.Errorf("%w: player %q lacks offered assets", api.ErrConflict, proposerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPITrade(t), nil
+}
+
+// ListPlayerTrades lists the trades proposed to or by a player from the
+// latest.
+func (s *TradeService) ListPlayerTrades(ctx context.Context, req *api.ListPlayerTradesRequest) (*api.ListTradesResponse, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, tradeServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+	statuses := []string{
+		entity.TradeStatusPending, entity.TradeStatusAccepted, entity.TradeStatusRejected,
+		entity.TradeStatusCancelled, entity.TradeStatusExpired,
+	}
+	if req.Status != "" && !slices.Contains(statuses, req.Status) {
+		return nil, fmt.Errorf("%w: status: must be %s", api.ErrInvalidArgument, strings.Join(statuses, ", "))
+	}
+
+	result, err := s.tradeRepo.FindByPlayer(ctx, playerID, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListTradesResponse{Items: make([]*api.Trade, 0, len(result.Trades)), Metadata: result.Metadata}
+	for _, t := range result.Trades {
+		res.Items = append(res.Items, toAPITrade(t))
+	}
+
+	return res, nil
+}
+
+// GetTrade returns a trade to its players, or to admins.
+func (s *TradeService) GetTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	t, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPlayer(ctx, t.ProposerID) && !isPlayer(ctx, t.RecipientID) {
+		if err := s.policy.Authorize(ctx, tradeServicePermissions.Admin); err != nil {
+			return nil, err
+		}
+	}
+
+	return toAPITrade(t), nil
+}
+
+// AcceptTrade accepts a pending trade proposed to the calling player,
+// swapping its assets.
+func (s *TradeService) AcceptTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	t, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPlayer(ctx, t.RecipientID) {
+		return nil, fmt.Errorf("%w: trade %d is only accepted by its recipient", auth.ErrPermissionDenied, t.ID)
+	}
+
+	now := s.now()
+	if err := checkPending(t, now); err != nil {
+		return nil, err
+	}
+	if err := s.checkTraders(ctx, t, now); err != nil {
+		return nil, err
+	}
+
+	err = s.tradeRepo.Accept(ctx, t.ID, now.Unix())
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: trade %d is no longer pending", api.ErrConflict, t.ID)
+	}
+	if errors.Is(err, repo.ErrInsufficientFunds) {
+		return nil, fmt.Errorf("%w: player %q lacks requested assets", api.ErrConflict, t.RecipientID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.resolved(ctx, t.ID)
+}
+
+// RejectTrade rejects a pending trade proposed to the calling player,
+// releasing its escrow to its proposer.
+func (s *TradeService) RejectTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	t, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPlayer(ctx, t.RecipientID) {
+		return nil, fmt.Errorf("%w: trade %d is only rejected by its recipient", auth.ErrPermissionDenied, t.ID)
+	}
+
+	return s.release(ctx, t, entity.TradeStatusRejected)
+}
+
+// CancelTrade cancels a pending trade of the calling player, or of any
+// player for admins, releasing its escrow to its proposer.
+func (s *TradeService) CancelTrade(ctx context.Context, req *api.TradeRequest) (*api.Trade, error) {
+	t, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPlayer(ctx, t.ProposerID) {
+		if err := s.policy.Authorize(ctx, tradeServicePermissions.Admin); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.release(ctx, t, entity.TradeStatusCancelled)
+}
+
+func (s *TradeService) release(ctx context.Context, t *entity.Trade, status string) (*api.Trade, error) {
+	if t.Status != entity.TradeStatusPending {
+		return nil, fmt.Errorf("%w: trade %d is %s", api.ErrConflict, t.ID, t.Status)
+	}
+
+	err := s.tradeRepo.Release(ctx, t.ID, status, s.now().Unix())
+	if errors.Is(err, repo.ErrConflict) {
+		return nil, fmt.Errorf("%w: trade %d is no longer pending", api.ErrConflict, t.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.resolved(ctx, t.ID)
+}
+
+// resolved returns a trade after its resolution.
+func (s *TradeService) resolved(ctx context.Context, id int64) (*api.Trade, error) {
+	t, err := s.tradeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPITrade(t), nil
+}
+
+func (s *TradeService) find(ctx context.Context, id int64) (*entity.Trade, error) {
+	t, err := s.tradeRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: trade %d", api.ErrNotFound, id)
+	}
+
+	return t, err
+}
+
+// checkTraders checks that both players of a trade exist and are not
+// banned at now.
+func (s *TradeService) checkTraders(ctx context.Context, t *entity.Trade, now time.Time) error {
+	players, err := s.playerRepo.FindByIDs(ctx, []string{t.ProposerID, t.RecipientID})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range []string{t.ProposerID, t.RecipientID} {
+		idx := slices.IndexFunc(players, func(p *entity.Player) bool { return p.ID == id })
+		if idx < 0 {
+			return fmt.Errorf("%w: player %q", api.ErrNotFound, id)
+		}
+		if players[idx].BannedAt(now) {
+			return fmt.Errorf("%w: player %q is banned", api.ErrConflict, id)
+		}
+	}
+
+	return nil
+}
+
+// validateTrade validates a proposal, and sets the merged assets of t.
+func (s *TradeService) validateTrade(ctx context.Context, t *entity.Trade, req *api.ProposeTradeRequest, now time.Time) error {
+	var msgs []string
+	if t.RecipientID == "" {
+		msgs = append(msgs, "recipientID: is required")
+	}
+	if t.RecipientID == t.ProposerID {
+		msgs = append(msgs, "recipientID: must not be the proposer")
+	}
+	if t.ExpiresAt <= now.Unix() || t.ExpiresAt > now.Add(maxTradeTTL).Unix() {
+		msgs = append(msgs, fmt.Sprintf("expiresAt: must be within %s", maxTradeTTL))
+	}
+	if len(req.Offered) == 0 {
+		msgs = append(msgs, "offered: at least one asset is required")
+	}
+
+	var assetMsgs []string
+	t.Offered, assetMsgs = toTradeAssets("offered", req.Offered)
+	msgs = append(msgs, assetMsgs...)
+	t.Requested, assetMsgs = toTradeAssets("requested", req.Requested)
+	msgs = append(msgs, assetMsgs...)
+
+	// assets must be items of the catalog, currencies those of the currency
+	// category.
+	var ids []string
+	for _, a := range slices.Concat(t.Offered, t.Requested) {
+		if !slices.Contains(ids, a.Asset) {
+			ids = append(ids, a.Asset)
+		}
+	}
+	if len(ids) > 0 {
+		items, err := s.itemRepo.FindByItemIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, a := range slices.Concat(t.Offered, t.Requested) {
+			idx := slices.IndexFunc(items, func(i *entity.Item) bool { return i.ID == a.Asset })
+			switch {
+			case idx < 0:
+				msgs = append(msgs, fmt.Sprintf("%s: unknown item %q", a.AssetType, a.Asset))
+			case (items[idx].Category == entity.CategoryCurrency) != (a.AssetType == entity.AssetCurrency):
+				msgs = append(msgs, fmt.Sprintf("%s: %q is not a %s", a.AssetType, a.Asset, a.AssetType))
+			}
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+// toTradeAssets validates the assets of a side of a trade, and returns them
+// with the amounts of the same asset merged.
+func toTradeAssets(field string, assets []*api.TradeAsset) ([]*entity.TradeAsset, []string) {
+	if len(assets) > maxTradeAssets {
+		return nil, []string{fmt.Sprintf("%s: at most %d assets are allowed", field, maxTradeAssets)}
+	}
+
+	var msgs []string
+	res := []*entity.TradeAsset{}
+	for idx, a := range assets {
+		if a.AssetType != entity.AssetItem && a.AssetType != entity.AssetCurrency {
+			msgs = append(msgs, fmt.Sprintf("%s[%d].assetType: must be %s or %s", field, idx, entity.AssetItem, entity.AssetCurrency))
+			continue
+		}
+		if a.Asset == "" {
+			msgs = append(msgs, fmt.Sprintf("%s[%d].asset: is required", field, idx))
+			continue
+		}
+		if a.Amount < 1 || a.Amount > maxTradeAmount {
+			msgs = append(msgs, fmt.Sprintf("%s[%d].amount: must be between 1 and %d", field, idx, maxTradeAmount))
+			continue
+		}
+
+		i := slices.IndexFunc(res, func(r *entity.TradeAsset) bool {
+			return r.AssetType == a.AssetType && r.Asset == a.Asset
+		})
+		if i < 0 {
+			res = append(res, &entity.TradeAsset{AssetType: a.AssetType, Asset: a.Asset, Amount: a.Amount})
+			continue
+		}
+		if res[i].Amount > maxTradeAmount-a.Amount {
+			msgs = append(msgs, fmt.Sprintf("%s[%d].amount: total of %s %q must be at most %d", field, idx, a.AssetType, a.Asset, maxTradeAmount))
+			continue
+		}
+		res[i].Amount += a.Amount
+	}
+
+	return res, msgs
+}
+
+// checkPending checks that a trade is pending and not expired at now.
+func checkPending(t *entity.Trade, now time.Time) error {
+	if t.Status != entity.TradeStatusPending {
+		return fmt.Errorf("%w: trade %d is %s", api.ErrConflict, t.ID, t.Status)
+	}
+	if now.Unix() >= t.ExpiresAt {
+		return fmt.Errorf("%w: trade %d is expired", api.ErrConflict, t.ID)
+	}
+
+	return nil
+}
+
+// isPlayer reports whether the caller is the player of id.
+func isPlayer(ctx context.Context, id string) bool {
+	p := auth.FromContext(ctx)
+	return p != nil && p.Type == auth.PrincipalPlayer && p.ID == id
+}
+
+func toAPITrade(t *entity.Trade) *api.Trade {
+	res := &api.Trade{
+		ID:          t.ID,
+		ProposerID:  t.ProposerID,
+		RecipientID: t.RecipientID,
+		Offered:     make([]*api.TradeAsset, 0, len(t.Offered)),
+		Requested:   make([]*api.TradeAsset, 0, len(t.Requested)),
+		Status:      t.Status,
+		ExpiresAt:   t.ExpiresAt,
+		ResolvedAt:  t.ResolvedAt,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+	for _, a := range t.Offered {
+		res.Offered = append(res.Offered, &api.TradeAsset{AssetType: a.AssetType, Asset: a.Asset, Amount: a.Amount})
+	}
+	for _, a := range t.Requested {
+		res.Requested = append(res.Requested, &api.TradeAsset{AssetType: a.AssetType, Asset: a.Asset, Amount: a.Amount})
+	}
+
+	return res
+}
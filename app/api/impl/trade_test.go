@@ -0,0 +1,254 @@
+package impl
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+)
+
+func TestTradeService_ProposeTrade(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	items := []*entity.Item{
+		{ID: "sw", Category: "weapon"},
+		{ID: "gold", Category: entity.CategoryCurrency},
+	}
+	alice := &auth.Principal{ID: "alice", Type: auth.PrincipalPlayer}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		req       *api.ProposeTradeRequest
+		banned    bool
+		propose   error
+		want      *entity.Trade
+		wantErr   error
+	}{
+		{
+			name:      "TC01 - valid proposal - should merge assets and escrow them",
+			principal: alice,
+			req: &api.ProposeTradeRequest{
+				RecipientID: "bob",
+				Offered: []*api.TradeAsset{
+					{AssetType: entity.AssetItem, Asset: "sw", Amount: 1},
+					{AssetType: entity.AssetItem, Asset: "sw", Amount: 2},
+				},
+				Requested: []*api.TradeAsset{{AssetType: entity.AssetCurrency, Asset: "gold", Amount: 50}},
+			},
+			want: &entity.Trade{
+				ProposerID:  "alice",
+				RecipientID: "bob",
+				Offered:     []*entity.TradeAsset{{AssetType: entity.AssetItem, Asset: "sw", Amount: 3}},
+				Requested:   []*entity.TradeAsset{{AssetType: entity.AssetCurrency, Asset: "gold", Amount: 50}},
+				ExpiresAt:   now.Add(defaultTradeTTL).Unix(),
+			},
+		},
+		{
+			name:      "TC02 - currency offered as item and trade with self - should be invalid",
+			principal: alice,
+			req: &api.ProposeTradeRequest{
+				RecipientID: "alice",
+				Offered:     []*api.TradeAsset{{AssetType: entity.AssetItem, Asset: "gold", Amount: 1}},
+			},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name:      "TC03 - expiry beyond the maximum - should be invalid",
+			principal: alice,
+			req: &api.ProposeTradeRequest{
+				RecipientID: "bob",
+				Offered:     []*api.TradeAsset{{AssetType: entity.AssetItem, Asset: "sw", Amount: 1}},
+				ExpiresAt:   now.Add(maxTradeTTL + time.Second).Unix(),
+			},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name:      "TC04 - banned recipient - should conflict",
+			principal: alice,
+			req: &api.ProposeTradeRequest{
+				RecipientID: "bob",
+				Offered:     []*api.TradeAsset{{AssetType: entity.AssetItem, Asset: "sw", Amount: 1}},
+			},
+			banned:  true,
+			wantErr: api.ErrConflict,
+		},
+		{
+			name:      "TC05 - offered assets not owned - should conflict",
+			principal: alice,
+			req: &api.ProposeTradeRequest{
+				RecipientID: "bob",
+				Offered:     []*api.TradeAsset{{AssetType: entity.AssetItem, Asset: "sw", Amount: 1}},
+			},
+			propose: repo.ErrInsufficientFunds,
+			wantErr: api.ErrConflict,
+		},
+		{
+			name:      "TC06 - service caller - should be invalid",
+			principal: &auth.Principal{ID: "ops", Type: auth.PrincipalService},
+			req: &api.ProposeTradeRequest{
+				RecipientID: "bob",
+				Offered:     []*api.TradeAsset{{AssetType: entity.AssetItem, Asset: "sw", Amount: 1}},
+			},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name:      "TC07 - amount above the maximum - should be invalid",
+			principal: alice,
+			req: &api.ProposeTradeRequest{
+				RecipientID: "bob",
+				Offered:     []*api.TradeAsset{{AssetType: entity.AssetCurrency, Asset: "gold", Amount: math.MaxInt64}},
+			},
+			wantErr: api.ErrInvalidArgument,
+		},
+		{
+			name:      "TC08 - merged amounts above the maximum - should be invalid",
+			principal: alice,
+			req: &api.ProposeTradeRequest{
+				RecipientID: "bob",
+				Offered: []*api.TradeAsset{
+					{AssetType: entity.AssetCurrency, Asset: "gold", Amount: maxTradeAmount},
+					{AssetType: entity.AssetCurrency, Asset: "gold", Amount: 1},
+				},
+			},
+			wantErr: api.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), tt.principal)
+			tradeRepo := repo.NewMockTradeRepo(t)
+			playerRepo := repo.NewMockPlayerRepo(t)
+			itemRepo := repo.NewMockItemRepo(t)
+
+			itemRepo.EXPECT().FindByItemIDs(ctx, mock.Anything).Return(items, nil).Maybe()
+			playerRepo.EXPECT().FindByIDs(ctx, []string{"alice", "bob"}).Return([]*entity.Player{
+				{ID: "alice"},
+				{ID: "bob", Banned: tt.banned},
+			}, nil).Maybe()
+			if tt.want != nil || tt.propose != nil {
+				tradeRepo.EXPECT().Propose(ctx, mock.Anything).RunAndReturn(
+					func(_ context.Context, trade *entity.Trade) (*entity.Trade, error) {
+						if tt.propose != nil {
+							return nil, tt.propose
+						}
+						assert.Equal(t, tt.want, trade)
+						return trade, nil
+					}).Once()
+			}
+
+			svc := &TradeService{
+				tradeRepo:  tradeRepo,
+				playerRepo: playerRepo,
+				itemRepo:   itemRepo,
+				now:        func() time.Time { return now },
+			}
+
+			res, err := svc.ProposeTrade(ctx, tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, toAPITrade(tt.want), res)
+		})
+	}
+}
+
+func TestTradeService_AcceptTrade(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	pending := &entity.Trade{
+		ID:          7,
+		ProposerID:  "alice",
+		RecipientID: "bob",
+		Offered:     []*entity.TradeAsset{{AssetType: entity.AssetItem, Asset: "sw", Amount: 1}},
+		Requested:   []*entity.TradeAsset{{AssetType: entity.AssetCurrency, Asset: "gold", Amount: 50}},
+		Status:      entity.TradeStatusPending,
+		ExpiresAt:   now.Unix() + 60,
+	}
+
+	tests := []struct {
+		name    string
+		caller  string
+		trade   *entity.Trade
+		accept  error
+		wantErr error
+	}{
+		{
+			name:   "TC01 - recipient - should accept",
+			caller: "bob",
+			trade:  pending,
+		},
+		{
+			name:    "TC02 - proposer - should be denied",
+			caller:  "alice",
+			trade:   pending,
+			wantErr: auth.ErrPermissionDenied,
+		},
+		{
+			name:    "TC03 - expired trade - should conflict",
+			caller:  "bob",
+			trade:   &entity.Trade{ID: 7, ProposerID: "alice", RecipientID: "bob", Status: entity.TradeStatusPending, ExpiresAt: now.Unix()},
+			wantErr: api.ErrConflict,
+		},
+		{
+			name:    "TC04 - requested assets not owned - should conflict",
+			caller:  "bob",
+			trade:   pending,
+			accept:  repo.ErrInsufficientFunds,
+			wantErr: api.ErrConflict,
+		},
+		{
+			name:    "TC05 - trade resolved concurrently - should conflict",
+			caller:  "bob",
+			trade:   pending,
+			accept:  repo.ErrConflict,
+			wantErr: api.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), &auth.Principal{ID: tt.caller, Type: auth.PrincipalPlayer})
+			tradeRepo := repo.NewMockTradeRepo(t)
+			playerRepo := repo.NewMockPlayerRepo(t)
+
+			tradeRepo.EXPECT().FindByID(ctx, int64(7)).Return(tt.trade, nil).Once()
+			playerRepo.EXPECT().FindByIDs(ctx, []string{"alice", "bob"}).
+				Return([]*entity.Player{{ID: "alice"}, {ID: "bob"}}, nil).Maybe()
+			if tt.trade.ExpiresAt > now.Unix() && tt.caller == tt.trade.RecipientID {
+				tradeRepo.EXPECT().Accept(ctx, int64(7), now.Unix()).Return(tt.accept).Once()
+			}
+			if tt.wantErr == nil {
+				accepted := *tt.trade
+				accepted.Status, accepted.ResolvedAt = entity.TradeStatusAccepted, now.Unix()
+				tradeRepo.EXPECT().FindByID(ctx, int64(7)).Return(&accepted, nil).Once()
+			}
+
+			svc := &TradeService{
+				tradeRepo:  tradeRepo,
+				playerRepo: playerRepo,
+				now:        func() time.Time { return now },
+			}
+
+			res, err := svc.AcceptTrade(ctx, &api.TradeRequest{ID: 7})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.TradeStatusAccepted, res.Status)
+			assert.Equal(t, now.Unix(), res.ResolvedAt)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/i18n"
+)
+
+// TranslationService implements all use cases of item translations.
+type TranslationService struct {
+	itemRepo        repo.ItemRepo
+	translationRepo repo.ItemTranslationRepo
+}
+
+// NewTranslationService creates and returns new instance of TranslationService.
+func NewTranslationService(
+	itemRepo repo.ItemRepo,
+	translationRepo repo.ItemTranslationRepo,
+) api.TranslationService {
+	return &TranslationService{
+		itemRepo:        itemRepo,
+		translationRepo: translationRepo,
+	}
+}
+
+// GetTranslationCompleteness reports the translation completeness per locale.
+func (s *TranslationService) GetTranslationCompleteness(
+	ctx context.Context,
+	req *api.GetTranslationCompletenessRequest,
+) (*api.GetTranslationCompletenessResponse, error) {
+	items, err := s.itemRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.translationRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byLocale := make(map[string]map[string]*entity.ItemTranslation)
+	for _, t := range translations {
+		if byLocale[t.Locale] == nil {
+			byLocale[t.Locale] = make(map[string]*entity.ItemTranslation)
+		}
+		byLocale[t.Locale][t.ItemID] = t
+	}
+
+	locales := req.Locales
+	if len(locales) == 0 {
+		for l := range byLocale {
+			locales = append(locales, l)
+		}
+		sort.Strings(locales)
+	}
+
+	var res []*api.TranslationCompleteness
+	for _, l := range locales {
+		c := &api.TranslationCompleteness{
+			Locale: l,
+			Total:  len(items),
+		}
+
+		for _, item := range items {
+			t, ok := byLocale[l][item.ID]
+			if ok && t.Name != "" && (item.Description == "" || t.Description != "") {
+				c.Translated++
+			} else if req.IncludeMissing {
+				c.MissingItemIDs = append(c.MissingItemIDs, item.ID)
+			}
+		}
+
+		if c.Total > 0 {
+			c.Percent = math.Round(float64(c.Translated)*10000/float64(c.Total)) / 100
+		}
+
+		res = append(res, c)
+	}
+
+	return &api.GetTranslationCompletenessResponse{
+		Locales: res,
+	}, nil
+}
+
+// ExportTranslations writes translations in the requested format.
+func (s *TranslationService) ExportTranslations(ctx context.Context, req *api.ExportTranslationsRequest) error {
+	locale := i18n.Normalize(req.Locale)
+
+	switch req.Format {
+	case api.TranslationFormatCSV:
+		var translations []*entity.ItemTranslation
+		var err error
+		if locale == "" {
+			translations, err = s.translationRepo.FindAll(ctx)
+		} else {
+			translations, err = s.translationRepo.FindByLocale(ctx, locale)
+		}
+		if err != nil {
+			return err
+		}
+
+		return i18n.EncodeCSV(req.Writer, translations)
+	case api.TranslationFormatXLIFF:
+		if locale == "" {
+			return fmt.Errorf("locale is required for format %q", req.Format)
+		}
+
+		items, err := s.itemRepo.FindAll(ctx)
+		if err != nil {
+			return err
+		}
+
+		translations, err := s.translationRepo.FindByLocale(ctx, locale)
+		if err != nil {
+			return err
+		}
+
+		byItem := make(map[string]*entity.ItemTranslation)
+		for _, t := range translations {
+			byItem[t.ItemID] = t
+		}
+
+		return i18n.EncodeXLIFF(req.Writer, locale, items, byItem)
+	default:
+		return fmt.Errorf("unsupported translation format %q", req.Format)
+	}
+}
+
+// ImportTranslations validates and upserts translations read in the requested
+// format. Translations of unknown items or without a locale are rejected, rows
+// of the same item and locale are merged, later fields taking precedence.
+// Empty fields leave the existing translation unchanged, so new translations
+// require a name.
+func (s *TranslationService) ImportTranslations(
+	ctx context.Context,
+	req *api.ImportTranslationsRequest,
+) (*api.ImportTranslationsResponse, error) {
+	var translations []*entity.ItemTranslation
+	var err error
+	switch req.Format {
+	case api.TranslationFormatCSV:
+		translations, err = i18n.DecodeCSV(req.Reader)
+	case api.TranslationFormatXLIFF:
+		translations, err = i18n.DecodeXLIFF(req.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported translation format %q", req.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.itemRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	itemIDs := make(map[string]bool)
+	for _, item := range items {
+		itemIDs[item.ID] = true
+	}
+
+	existing, err := s.translationRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	named := make(map[[2]string]bool)
+	for _, t := range existing {
+		named[[2]string{t.ItemID, t.Locale}] = t.Name != ""
+	}
+
+	res := &api.ImportTranslationsResponse{}
+	merged := make(map[[2]string]*entity.ItemTranslation)
+	rows := make(map[[2]string]int)
+	var valid []*entity.ItemTranslation
+	for i, t := range translations {
+		switch {
+		case t.Locale == "":
+			res.Errors = append(res.Errors, fmt.Sprintf("#%d: missing locale", i+1))
+			continue
+		case t.Locale == i18n.DefaultLocale:
+			res.Errors = append(res.Errors, fmt.Sprintf("#%d: locale %q is the default locale, edit the item instead", i+1, t.Locale))
+			continue
+		case !itemIDs[t.ItemID]:
+			res.Errors = append(res.Errors, fmt.Sprintf("#%d: unknown item %q", i+1, t.ItemID))
+			continue
+		}
+
+		key := [2]string{t.ItemID, t.Locale}
+		m, ok := merged[key]
+		if !ok {
+			m = &entity.ItemTranslation{ItemID: t.ItemID, Locale: t.Locale}
+			merged[key], rows[key] = m, i+1
+			valid = append(valid, m)
+		}
+		if t.Name != "" {
+			m.Name = t.Name
+		}
+		if t.Description != "" {
+			m.Description = t.Description
+		}
+	}
+
+	// rows only translating the description of an item keep the existing
+	// name, there must be one.
+	n := 0
+	for _, t := range valid {
+		key := [2]string{t.ItemID, t.Locale}
+		if t.Name == "" && !named[key] {
+			res.Errors = append(res.Errors, fmt.Sprintf("#%d: missing name of item %q in locale %q", rows[key], t.ItemID, t.Locale))
+			continue
+		}
+		valid[n] = t
+		n++
+	}
+	valid = valid[:n]
+
+	if err := s.translationRepo.Upsert(ctx, valid); err != nil {
+		return nil, err
+	}
+
+	res.Imported = len(valid)
+
+	return res, nil
+}
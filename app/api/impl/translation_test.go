@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+)
+
+func TestTranslationService_ImportTranslations(t *testing.T) {
+	tests := []struct {
+		name       string
+		csv        string
+		wantUpsert []*entity.ItemTranslation
+		wantErrors []string
+	}{
+		{
+			name: "TC01 - rows of the same item and locale - should be merged",
+			csv: "item_id,locale,name,description\n" +
+				"sw,fr,Épée,\n" +
+				"sw,fr,,Tranchante\n" +
+				"sw,de,Schwert,Scharf\n" +
+				"sw,de,Klinge,\n",
+			wantUpsert: []*entity.ItemTranslation{
+				{ItemID: "sw", Locale: "fr", Name: "Épée", Description: "Tranchante"},
+				{ItemID: "sw", Locale: "de", Name: "Klinge", Description: "Scharf"},
+			},
+		},
+		{
+			name: "TC02 - description only - should keep an existing name and reject a new translation",
+			csv: "item_id,locale,name,description\n" +
+				"sw,es,,Afilada\n" +
+				"sw,it,,Affilata\n",
+			wantUpsert: []*entity.ItemTranslation{
+				{ItemID: "sw", Locale: "es", Description: "Afilada"},
+			},
+			wantErrors: []string{`#2: missing name of item "sw" in locale "it"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			itemRepo := repo.NewMockItemRepo(t)
+			translationRepo := repo.NewMockItemTranslationRepo(t)
+
+			itemRepo.EXPECT().FindAll(ctx).Return([]*entity.Item{{ID: "sw"}}, nil).Once()
+			translationRepo.EXPECT().FindAll(ctx).Return([]*entity.ItemTranslation{
+				{ItemID: "sw", Locale: "es", Name: "Espada"},
+			}, nil).Once()
+			translationRepo.EXPECT().Upsert(ctx, tt.wantUpsert).Return(nil).Once()
+
+			svc := NewTranslationService(itemRepo, translationRepo)
+			res, err := svc.ImportTranslations(ctx, &api.ImportTranslationsRequest{
+				Format: api.TranslationFormatCSV,
+				Reader: strings.NewReader(tt.csv),
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.wantUpsert), res.Imported)
+			assert.Equal(t, tt.wantErrors, res.Errors)
+		})
+	}
+}
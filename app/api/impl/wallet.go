@@ -0,0 +1,221 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// WalletService implements all use cases of player wallets.
+type WalletService struct {
+	walletRepo repo.WalletRepo
+	ledgerRepo repo.LedgerRepo
+	playerRepo repo.PlayerRepo
+	itemRepo   repo.ItemRepo
+	policy     *auth.Policy
+}
+
+// walletServicePermissions declares the permission each WalletService method
+// requires. Players read their own wallet and ledger with Read, those of
+// others and adjustments require Admin.
+var walletServicePermissions = struct {
+	Read  string
+	Admin string
+}{
+	Read:  auth.PermissionCatalogRead,
+	Admin: auth.PermissionEconomyAdmin,
+}
+
+// walletServiceTx declares the transaction each WalletService method runs
+// in, adjustments run in default transactions.
+var walletServiceTx = struct {
+	Read []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+}
+
+// NewWalletService creates and returns new instance of WalletService, its
+// methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by walletServicePermissions, in
+// the transactions declared by walletServiceTx.
+func NewWalletService(
+	walletRepo repo.WalletRepo,
+	ledgerRepo repo.LedgerRepo,
+	playerRepo repo.PlayerRepo,
+	itemRepo repo.ItemRepo,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.WalletService, error) {
+	svc := &WalletService{
+		walletRepo: walletRepo,
+		ledgerRepo: ledgerRepo,
+		playerRepo: playerRepo,
+		itemRepo:   itemRepo,
+		policy:     policy,
+	}
+	perms, tx := walletServicePermissions, walletServiceTx
+
+	get, err := middleware(endpoints, "wallets.get", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	adjust, err := middleware(endpoints, "wallets.adjust", policy, perms.Admin, client)
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := middleware(endpoints, "wallets.ledger", policy, perms.Read, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txWalletService{
+		getWallet:    wrap(svc.GetWallet, get),
+		adjustWallet: wrap(svc.AdjustWallet, adjust),
+		listLedger:   wrap(svc.ListLedger, ledger),
+	}, nil
+}
+
+// txWalletService runs the methods of WalletService through their
+// middlewares.
+type txWalletService struct {
+	getWallet    func(context.Context, *api.WalletRequest) (*api.Wallet, error)
+	adjustWallet func(context.Context, *api.AdjustWalletRequest) (*api.WalletBalance, error)
+	listLedger   func(context.Context, *api.ListLedgerRequest) (*api.ListLedgerResponse, error)
+}
+
+// GetWallet implements api.WalletService.
+func (s *txWalletService) GetWallet(ctx context.Context, req *api.WalletRequest) (*api.Wallet, error) {
+	return s.getWallet(ctx, req)
+}
+
+// AdjustWallet implements api.WalletService.
+func (s *txWalletService) AdjustWallet(ctx context.Context, req *api.AdjustWalletRequest) (*api.WalletBalance, error) {
+	return s.adjustWallet(ctx, req)
+}
+
+// ListLedger implements api.WalletService.
+func (s *txWalletService) ListLedger(ctx context.Context, req *api.ListLedgerRequest) (*api.ListLedgerResponse, error) {
+	return s.listLedger(ctx, req)
+}
+
+// GetWallet returns the balances of a player ordered by currency.
+func (s *WalletService) GetWallet(ctx context.Context, req *api.WalletRequest) (*api.Wallet, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, walletServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	wallets, err := s.walletRepo.FindByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.Wallet{PlayerID: playerID, Balances: make([]*api.WalletBalance, 0, len(wallets))}
+	for _, w := range wallets {
+		res.Balances = append(res.Balances, toAPIWalletBalance(w))
+	}
+
+	return res, nil
+}
+
+// AdjustWallet adds an amount to the balance of a currency of a player,
+// debits failing rather than making the balance negative.
+func (s *WalletService) AdjustWallet(ctx context.Context, req *api.AdjustWalletRequest) (*api.WalletBalance, error) {
+	playerID, err := resolvePlayer(ctx, req.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateAdjustment(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.playerRepo.FindByID(ctx, playerID); errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: player %q", api.ErrNotFound, playerID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	w, err := s.walletRepo.Adjust(ctx, playerID, req.Currency, req.Amount, entity.LedgerReasonAdjustment, req.Reference)
+	if errors.Is(err, repo.ErrInsufficientFunds) {
+		return nil, fmt.Errorf("%w: balance of %s of player %q is below %d", api.ErrConflict, req.Currency, playerID, -req.Amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIWalletBalance(w), nil
+}
+
+// ListLedger lists the ledger entries of a player from the latest.
+func (s *WalletService) ListLedger(ctx context.Context, req *api.ListLedgerRequest) (*api.ListLedgerResponse, error) {
+	playerID, err := authorizePlayer(ctx, s.policy, req.PlayerID, walletServicePermissions.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.ledgerRepo.FindByPlayer(ctx, playerID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListLedgerResponse{Items: make([]*api.LedgerEntry, 0, len(result.Entries)), Metadata: result.Metadata}
+	for _, e := range result.Entries {
+		res.Items = append(res.Items, &api.LedgerEntry{
+			ID:        e.ID,
+			AssetType: e.AssetType,
+			Asset:     e.Asset,
+			Delta:     e.Delta,
+			Balance:   e.Balance,
+			Reason:    e.Reason,
+			Reference: e.Reference,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// validateAdjustment validates an adjustment, its currency being an item of
+// the currency category.
+func (s *WalletService) validateAdjustment(ctx context.Context, req *api.AdjustWalletRequest) error {
+	var msgs []string
+	if req.Amount == 0 {
+		msgs = append(msgs, "amount: must not be 0")
+	}
+	if req.Currency == "" {
+		msgs = append(msgs, "currency: is required")
+	} else {
+		items, err := s.itemRepo.FindByItemIDs(ctx, []string{req.Currency})
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 || items[0].Category != entity.CategoryCurrency {
+			msgs = append(msgs, fmt.Sprintf("currency: %q is not an item of category %s", req.Currency, entity.CategoryCurrency))
+		}
+	}
+
+	if len(msgs) > 0 {
+		return fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+func toAPIWalletBalance(w *entity.Wallet) *api.WalletBalance {
+	return &api.WalletBalance{
+		Currency:  w.Currency,
+		Balance:   w.Balance,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
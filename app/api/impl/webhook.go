@@ -0,0 +1,389 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+	"github.com/nhatquangsin/game-service/infra/webhook"
+)
+
+// WebhookService implements all use cases of outbound webhooks.
+type WebhookService struct {
+	subscriptionRepo repo.WebhookSubscriptionRepo
+	deliveryRepo     repo.WebhookDeliveryRepo
+	deliverer        *webhook.Deliverer
+	now              func() time.Time
+}
+
+// webhookServicePermissions declares the permission each WebhookService
+// method requires, all of them manage webhooks.
+var webhookServicePermissions = struct {
+	All string
+}{
+	All: auth.PermissionWebhookAdmin,
+}
+
+// webhookServiceTx declares the transaction each WebhookService method runs
+// in, writes run in default transactions. Redeliveries post to the webhook in
+// their transaction, so they are not retried.
+var webhookServiceTx = struct {
+	Read                     []database.TxOption
+	RedeliverWebhookDelivery []database.TxOption
+}{
+	Read: []database.TxOption{
+		database.TxOptions.ReadOnly(),
+	},
+	RedeliverWebhookDelivery: []database.TxOption{
+		database.TxOptions.MaxRetries(0),
+	},
+}
+
+// NewWebhookService creates and returns new instance of WebhookService, its
+// methods run through the endpoint middlewares configured for them, for
+// callers granted the permissions declared by webhookServicePermissions, in
+// the transactions declared by webhookServiceTx.
+func NewWebhookService(
+	subscriptionRepo repo.WebhookSubscriptionRepo,
+	deliveryRepo repo.WebhookDeliveryRepo,
+	deliverer *webhook.Deliverer,
+	client database.Client,
+	endpoints *endpoint.Factory,
+	policy *auth.Policy,
+) (api.WebhookService, error) {
+	svc := &WebhookService{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		deliverer:        deliverer,
+		now:              time.Now,
+	}
+	perm, tx := webhookServicePermissions.All, webhookServiceTx
+
+	create, err := middleware(endpoints, "webhooks.create", policy, perm, client)
+	if err != nil {
+		return nil, err
+	}
+	list, err := middleware(endpoints, "webhooks.list", policy, perm, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	get, err := middleware(endpoints, "webhooks.get", policy, perm, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	update, err := middleware(endpoints, "webhooks.update", policy, perm, client)
+	if err != nil {
+		return nil, err
+	}
+	del, err := middleware(endpoints, "webhooks.delete", policy, perm, client)
+	if err != nil {
+		return nil, err
+	}
+	listDeliveries, err := middleware(endpoints, "webhooks.list_deliveries", policy, perm, client, tx.Read...)
+	if err != nil {
+		return nil, err
+	}
+	redeliver, err := middleware(endpoints, "webhooks.redeliver", policy, perm, client, tx.RedeliverWebhookDelivery...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txWebhookService{
+		createWebhook:            wrap(svc.CreateWebhook, create),
+		listWebhooks:             wrap(svc.ListWebhooks, list),
+		getWebhook:               wrap(svc.GetWebhook, get),
+		updateWebhook:            wrap(svc.UpdateWebhook, update),
+		deleteWebhook:            wrap(svc.DeleteWebhook, del),
+		listWebhookDeliveries:    wrap(svc.ListWebhookDeliveries, listDeliveries),
+		redeliverWebhookDelivery: wrap(svc.RedeliverWebhookDelivery, redeliver),
+	}, nil
+}
+
+// txWebhookService runs the methods of WebhookService through their
+// middlewares.
+type txWebhookService struct {
+	createWebhook            func(context.Context, *api.CreateWebhookRequest) (*api.Webhook, error)
+	listWebhooks             func(context.Context, *api.ListWebhooksRequest) (*api.ListWebhooksResponse, error)
+	getWebhook               func(context.Context, *api.WebhookRequest) (*api.Webhook, error)
+	updateWebhook            func(context.Context, *api.UpdateWebhookRequest) (*api.Webhook, error)
+	deleteWebhook            func(context.Context, *api.WebhookRequest) (*api.Webhook, error)
+	listWebhookDeliveries    func(context.Context, *api.ListWebhookDeliveriesRequest) (*api.ListWebhookDeliveriesResponse, error)
+	redeliverWebhookDelivery func(context.Context, *api.WebhookDeliveryRequest) (*api.WebhookDelivery, error)
+}
+
+// CreateWebhook implements api.WebhookService.
+func (s *txWebhookService) CreateWebhook(ctx context.Context, req *api.CreateWebhookRequest) (*api.Webhook, error) {
+	return s.createWebhook(ctx, req)
+}
+
+// ListWebhooks implements api.WebhookService.
+func (s *txWebhookService) ListWebhooks(ctx context.Context, req *api.ListWebhooksRequest) (*api.ListWebhooksResponse, error) {
+	return s.listWebhooks(ctx, req)
+}
+
+// GetWebhook implements api.WebhookService.
+func (s *txWebhookService) GetWebhook(ctx context.Context, req *api.WebhookRequest) (*api.Webhook, error) {
+	return s.getWebhook(ctx, req)
+}
+
+// UpdateWebhook implements api.WebhookService.
+func (s *txWebhookService) UpdateWebhook(ctx context.Context, req *api.UpdateWebhookRequest) (*api.Webhook, error) {
+	return s.updateWebhook(ctx, req)
+}
+
+// DeleteWebhook implements api.WebhookService.
+func (s *txWebhookService) DeleteWebhook(ctx context.Context, req *api.WebhookRequest) (*api.Webhook, error) {
+	return s.deleteWebhook(ctx, req)
+}
+
+// ListWebhookDeliveries implements api.WebhookService.
+func (s *txWebhookService) ListWebhookDeliveries(ctx context.Context, req *api.ListWebhookDeliveriesRequest) (*api.ListWebhookDeliveriesResponse, error) {
+	return s.listWebhookDeliveries(ctx, req)
+}
+
+// RedeliverWebhookDelivery implements api.WebhookService.
+func (s *txWebhookService) RedeliverWebhookDelivery(ctx context.Context, req *api.WebhookDeliveryRequest) (*api.WebhookDelivery, error) {
+	return s.redeliverWebhookDelivery(ctx, req)
+}
+
+// CreateWebhook creates an active webhook, and returns it with its secret.
+func (s *WebhookService) CreateWebhook(ctx context.Context, req *api.CreateWebhookRequest) (*api.Webhook, error) {
+	events, err := validateWebhook(req.URL, req.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	sub, err := s.subscriptionRepo.Create(ctx, &entity.WebhookSubscription{
+		URL:         req.URL,
+		Events:      events,
+		Secret:      secret,
+		Description: req.Description,
+		Active:      true,
+		CreatedBy:   principalID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := toAPIWebhook(sub)
+	res.Secret = sub.Secret
+
+	return res, nil
+}
+
+// ListWebhooks lists all webhooks.
+func (s *WebhookService) ListWebhooks(ctx context.Context, req *api.ListWebhooksRequest) (*api.ListWebhooksResponse, error) {
+	subs, err := s.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListWebhooksResponse{Items: make([]*api.Webhook, 0, len(subs))}
+	for _, sub := range subs {
+		res.Items = append(res.Items, toAPIWebhook(sub))
+	}
+
+	return res, nil
+}
+
+// GetWebhook returns a webhook without its secret.
+func (s *WebhookService) GetWebhook(ctx context.Context, req *api.WebhookRequest) (*api.Webhook, error) {
+	sub, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAPIWebhook(sub), nil
+}
+
+// UpdateWebhook updates the fields set of a webhook, the new secret is
+// returned when it is rotated. Deliveries already created keep being
+// delivered to the new url.
+func (s *WebhookService) UpdateWebhook(ctx context.Context, req *api.UpdateWebhookRequest) (*api.Webhook, error) {
+	sub, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		sub.URL = *req.URL
+	}
+	if req.Events != nil {
+		sub.Events = req.Events
+	}
+	if sub.Events, err = validateWebhook(sub.URL, sub.Events); err != nil {
+		return nil, err
+	}
+	if req.Description != nil {
+		sub.Description = *req.Description
+	}
+	if req.Active != nil {
+		sub.Active = *req.Active
+	}
+	if req.RotateSecret {
+		if sub.Secret, err = webhook.NewSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.subscriptionRepo.Update(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	res := toAPIWebhook(sub)
+	if req.RotateSecret {
+		res.Secret = sub.Secret
+	}
+
+	return res, nil
+}
+
+// DeleteWebhook deletes a webhook along with its deliveries, and returns it.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, req *api.WebhookRequest) (*api.Webhook, error) {
+	sub, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.subscriptionRepo.Delete(ctx, sub.ID); err != nil {
+		return nil, err
+	}
+
+	return toAPIWebhook(sub), nil
+}
+
+// ListWebhookDeliveries lists the deliveries of a webhook from the latest.
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *api.ListWebhookDeliveriesRequest) (*api.ListWebhookDeliveriesResponse, error) {
+	if _, err := s.find(ctx, req.ID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.deliveryRepo.FindBySubscription(ctx, req.ID, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListWebhookDeliveriesResponse{
+		Items:    make([]*api.WebhookDelivery, 0, len(result.Deliveries)),
+		Metadata: result.Metadata,
+	}
+	for _, d := range result.Deliveries {
+		res.Items = append(res.Items, toAPIWebhookDelivery(d))
+	}
+
+	return res, nil
+}
+
+// RedeliverWebhookDelivery attempts a delivery of a webhook right away,
+// whatever its status. Its attempts start over, so a failed redelivery is
+// retried with backoff as a new delivery would be.
+func (s *WebhookService) RedeliverWebhookDelivery(ctx context.Context, req *api.WebhookDeliveryRequest) (*api.WebhookDelivery, error) {
+	sub, err := s.find(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := s.deliveryRepo.FindByID(ctx, req.DeliveryID)
+	if errors.Is(err, repo.ErrNotFound) || (err == nil && d.SubscriptionID != sub.ID) {
+		return nil, fmt.Errorf("%w: delivery %d of webhook %d", api.ErrNotFound, req.DeliveryID, sub.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d.Attempts = 0
+	s.deliverer.Attempt(ctx, sub, d, s.now())
+	if err := s.deliveryRepo.Update(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return toAPIWebhookDelivery(d), nil
+}
+
+// find returns the webhook subscription of id, or api.ErrNotFound.
+func (s *WebhookService) find(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	sub, err := s.subscriptionRepo.FindByID(ctx, id)
+	if errors.Is(err, repo.ErrNotFound) {
+		return nil, fmt.Errorf("%w: webhook %d", api.ErrNotFound, id)
+	}
+
+	return sub, err
+}
+
+// validateWebhook validates the url and events of a webhook, and returns the
+// deduplicated events.
+func validateWebhook(rawURL string, events []string) ([]string, error) {
+	var msgs []string
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		msgs = append(msgs, "url: must be an absolute http or https url")
+	}
+
+	res := make([]string, 0, len(events))
+	for _, e := range events {
+		if e != entity.WebhookEventsAll && !slices.Contains(api.WebhookEventTypes, e) {
+			msgs = append(msgs, fmt.Sprintf("events: unknown event type %q", e))
+			continue
+		}
+		if !slices.Contains(res, e) {
+			res = append(res, e)
+		}
+	}
+	if len(events) == 0 {
+		msgs = append(msgs, "events: is required")
+	}
+
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("%w: %s", api.ErrInvalidArgument, strings.Join(msgs, "; "))
+	}
+
+	return res, nil
+}
+
+func toAPIWebhook(sub *entity.WebhookSubscription) *api.Webhook {
+	return &api.Webhook{
+		ID:          sub.ID,
+		URL:         sub.URL,
+		Events:      sub.Events,
+		Description: sub.Description,
+		Active:      sub.Active,
+		CreatedBy:   sub.CreatedBy,
+		CreatedAt:   sub.CreatedAt,
+		UpdatedAt:   sub.UpdatedAt,
+	}
+}
+
+func toAPIWebhookDelivery(d *entity.WebhookDelivery) *api.WebhookDelivery {
+	return &api.WebhookDelivery{
+		ID:             d.ID,
+		WebhookID:      d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
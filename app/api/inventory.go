@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// InventoryService exposes all available use cases of player inventories:
+// the quantities of catalog items owned by players.
+type InventoryService interface {
+	GetInventory(ctx context.Context, req *InventoryRequest) (*Inventory, error)
+}
+
+// Inventory rest resource.
+//
+// +smkit:rest:resource=true
+type Inventory struct {
+	PlayerID string           `json:"playerID"`
+	Items    []*InventoryItem `json:"_items"`
+}
+
+// InventoryItem is the quantity of an item owned by a player.
+type InventoryItem struct {
+	ItemID    string `json:"itemID"`
+	Quantity  int    `json:"quantity"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// InventoryRequest represents a request on the inventory of the player of
+// the path, "me" being the calling player.
+type InventoryRequest struct {
+	PlayerID string `json:"-"`
+}
+
+func (i *InventoryRequest) Bind(r *http.Request) error {
+	i.PlayerID = chi.URLParam(r, "playerID")
+	return nil
+}
+
+func (i *Inventory) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
@@ -2,7 +2,10 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/render"
 	"github.com/nhatquangsin/game-service/infra/utils"
@@ -28,19 +31,59 @@ type ListItemsRequest struct {
 	ItemIDs []string `json:"-" query:"itemIDs" validate:"max=100"`
 	Offset  int      `json:"-" query:"offset" field:"offset" validate:"gte=0"`
 	Limit   int      `json:"-" query:"limit" field:"limit" validate:"gte=1,lte=100"`
+
+	// Locales is the fallback chain of locales to translate items into,
+	// resolved from ?locale= or the Accept-Language header.
+	Locales []string `json:"-"`
+
+	// Changeset previews the catalog with the changes of a changeset
+	// applied, the published catalog is listed when 0.
+	Changeset int64 `json:"-" query:"changeset"`
+
+	// At previews the items available at a time, by their live events,
+	// instead of now when zero.
+	At time.Time `json:"-" query:"at"`
 }
 
 // ListItemsResponse represents a response for list item.
 type ListItemsResponse struct {
 	Items    []*Item            `field:"_items" json:"_items,omitempty"`
 	Metadata utils.PageMetadata `field:"_metadata" json:"_metadata,omitempty"`
+
+	// Version is the version of the listed catalog, and Changeset the
+	// previewed changeset if any.
+	Version   int64 `field:"_version" json:"_version"`
+	Changeset int64 `field:"_changeset" json:"_changeset,omitempty"`
+	// At is the previewed time if any.
+	At int64 `field:"_at" json:"_at,omitempty"`
 }
 
 func (l *ListItemsRequest) Bind(r *http.Request) error {
-	panic("unimplemented")
+	var err error
+	if l.Offset, l.Limit, err = pagination(r); err != nil {
+		return err
+	}
+
+	l.ItemIDs = queryStrings(r, "itemIDs")
+	if len(l.ItemIDs) > maxLimit {
+		return fmt.Errorf("itemIDs: must contain at most %d ids", maxLimit)
+	}
+
+	l.Locales = locales(r)
+
+	changeset, err := queryInt(r, "changeset", 0)
+	if err != nil {
+		return err
+	}
+	l.Changeset = int64(changeset)
+
+	l.At, err = queryTime(r, "at")
+	return err
 }
 
 func (l *ListItemsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Add("Vary", "Accept-Language")
+	w.Header().Set("X-Catalog-Version", strconv.FormatInt(l.Version, 10))
 	render.Status(r, 200)
-	panic("unimplemented")
+	return nil
 }
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// ItemInstanceService exposes all available use cases of item instances:
+// non fungible copies of catalog items, e.g. weapons with random rolls,
+// minted to players by game servers, transferred between players and
+// burned, with the history of their owners.
+type ItemInstanceService interface {
+	MintItemInstance(ctx context.Context, req *MintItemInstanceRequest) (*ItemInstance, error)
+	ListItemInstances(ctx context.Context, req *ListItemInstancesRequest) (*ListItemInstancesResponse, error)
+	GetItemInstance(ctx context.Context, req *ItemInstanceRequest) (*ItemInstance, error)
+	TransferItemInstance(ctx context.Context, req *TransferItemInstanceRequest) (*ItemInstance, error)
+	BurnItemInstance(ctx context.Context, req *BurnItemInstanceRequest) (*ItemInstance, error)
+	ListItemInstanceHistory(ctx context.Context, req *ItemInstanceRequest) (*ListItemInstanceHistoryResponse, error)
+}
+
+// ItemInstance rest resource.
+//
+// +smkit:rest:resource=true
+type ItemInstance struct {
+	ID            string         `json:"id"`
+	ItemID        string         `json:"itemID"`
+	OwnerID       string         `json:"ownerID"`
+	Durability    int            `json:"durability"`
+	MaxDurability int            `json:"maxDurability"`
+	Stats         map[string]any `json:"stats,omitempty"`
+	// Status is active or burned.
+	Status    string `json:"status"`
+	BurnedAt  int64  `json:"burnedAt,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// ItemInstanceHistory is the mint, a transfer or the burn of an item
+// instance.
+type ItemInstanceHistory struct {
+	ID int64 `json:"id"`
+	// Action is mint, transfer or burn.
+	Action       string `json:"action"`
+	FromPlayerID string `json:"fromPlayerID,omitempty"`
+	ToPlayerID   string `json:"toPlayerID,omitempty"`
+	Actor        string `json:"actor"`
+	Reason       string `json:"reason,omitempty"`
+	CreatedAt    int64  `json:"createdAt"`
+}
+
+// ItemInstanceRequest represents a request on the item instance of the path.
+type ItemInstanceRequest struct {
+	ID string `json:"-"`
+}
+
+// MintItemInstanceRequest represents a request for minting an instance of a
+// catalog item to a player. Durability defaults to MaxDurability, instances
+// without MaxDurability do not wear.
+type MintItemInstanceRequest struct {
+	ItemID        string         `json:"itemID"`
+	OwnerID       string         `json:"ownerID"`
+	Durability    *int           `json:"durability"`
+	MaxDurability int            `json:"maxDurability"`
+	Stats         map[string]any `json:"stats"`
+	Reason        string         `json:"reason"`
+}
+
+// ListItemInstancesRequest represents a request for listing the instances of
+// an owner, "me" being the calling player, or of a catalog item from the
+// latest minted, of a status when set.
+type ListItemInstancesRequest struct {
+	OwnerID string `json:"-" query:"ownerID"`
+	ItemID  string `json:"-" query:"itemID"`
+	Status  string `json:"-" query:"status"`
+	Offset  int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit   int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// TransferItemInstanceRequest represents a request for transferring the
+// item instance of the path to another player.
+type TransferItemInstanceRequest struct {
+	ID         string `json:"-"`
+	ToPlayerID string `json:"toPlayerID"`
+	Reason     string `json:"reason"`
+}
+
+// BurnItemInstanceRequest represents a request for burning the item instance
+// of the path.
+type BurnItemInstanceRequest struct {
+	ID     string `json:"-"`
+	Reason string `json:"reason"`
+}
+
+// ListItemInstancesResponse represents a response for listing item
+// instances.
+type ListItemInstancesResponse struct {
+	Items    []*ItemInstance    `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+// ListItemInstanceHistoryResponse represents a response for the history of
+// an item instance in order.
+type ListItemInstanceHistoryResponse struct {
+	InstanceID string                 `json:"instanceID"`
+	Items      []*ItemInstanceHistory `json:"_items"`
+}
+
+func (i *ItemInstanceRequest) Bind(r *http.Request) error {
+	i.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (m *MintItemInstanceRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (l *ListItemInstancesRequest) Bind(r *http.Request) error {
+	var err error
+	l.OwnerID = r.URL.Query().Get("ownerID")
+	l.ItemID = r.URL.Query().Get("itemID")
+	l.Status = r.URL.Query().Get("status")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (t *TransferItemInstanceRequest) Bind(r *http.Request) error {
+	t.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (b *BurnItemInstanceRequest) Bind(r *http.Request) error {
+	b.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (i *ItemInstance) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListItemInstancesResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListItemInstanceHistoryResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
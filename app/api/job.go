@@ -0,0 +1,49 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/job"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// ListJobsResponse represents a response for listing the background jobs
+// with their status.
+type ListJobsResponse struct {
+	Items []*job.Status `json:"_items"`
+}
+
+// ListJobRunsRequest represents a request for listing the runs of a job from
+// the latest.
+type ListJobRunsRequest struct {
+	Name   string `json:"-"`
+	Offset int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit  int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListJobRunsResponse represents a response for listing the runs of a job.
+type ListJobRunsResponse struct {
+	Items    []*entity.JobRun   `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+func (l *ListJobRunsRequest) Bind(r *http.Request) error {
+	var err error
+	l.Name = chi.URLParam(r, "name")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (l *ListJobsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListJobRunsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
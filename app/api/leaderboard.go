@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// maxLeaderboardRadius is the maximum radius of around-me queries.
+const maxLeaderboardRadius = 50
+
+// LeaderboardService exposes all available use cases of leaderboards:
+// rankings of players by the scores of a metric game servers submit, reset
+// daily, weekly or every season. Final rankings of ended periods are kept as
+// snapshots.
+type LeaderboardService interface {
+	CreateLeaderboard(ctx context.Context, req *CreateLeaderboardRequest) (*Leaderboard, error)
+	ListLeaderboards(ctx context.Context, req *ListLeaderboardsRequest) (*ListLeaderboardsResponse, error)
+	GetLeaderboard(ctx context.Context, req *LeaderboardRequest) (*Leaderboard, error)
+	UpdateLeaderboard(ctx context.Context, req *UpdateLeaderboardRequest) (*Leaderboard, error)
+	DeleteLeaderboard(ctx context.Context, req *LeaderboardRequest) (*Leaderboard, error)
+	SubmitScores(ctx context.Context, req *SubmitScoresRequest) (*SubmitScoresResponse, error)
+	GetLeaderboardTop(ctx context.Context, req *LeaderboardTopRequest) (*LeaderboardEntriesResponse, error)
+	GetLeaderboardAround(ctx context.Context, req *LeaderboardAroundRequest) (*LeaderboardEntriesResponse, error)
+	ListLeaderboardSnapshots(ctx context.Context, req *ListLeaderboardSnapshotsRequest) (*ListLeaderboardSnapshotsResponse, error)
+}
+
+// Leaderboard rest resource.
+//
+// +smkit:rest:resource=true
+type Leaderboard struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Metric   string `json:"metric"`
+	Policy   string `json:"policy"`
+	Reset    string `json:"reset"`
+	Timezone string `json:"timezone"`
+	// Period is the current period, none for seasonal leaderboards out of
+	// seasons.
+	Period    *LeaderboardPeriod `json:"period,omitempty"`
+	CreatedBy string             `json:"createdBy,omitempty"`
+	CreatedAt int64              `json:"createdAt"`
+	UpdatedAt int64              `json:"updatedAt"`
+}
+
+// LeaderboardPeriod is a period of a leaderboard, from Start included to End
+// excluded in unix seconds.
+type LeaderboardPeriod struct {
+	Key   string `json:"key"`
+	Start int64  `json:"start"`
+	End   int64  `json:"end"`
+}
+
+// LeaderboardEntry is the score and rank of a player.
+type LeaderboardEntry struct {
+	Rank     int    `json:"rank"`
+	PlayerID string `json:"playerID"`
+	Score    int64  `json:"score"`
+}
+
+// LeaderboardRequest represents a request on the leaderboard of the path.
+type LeaderboardRequest struct {
+	ID string `json:"-"`
+}
+
+// CreateLeaderboardRequest represents a request for creating a leaderboard.
+// Policy is best, sum or latest, best by default. Reset is daily, weekly or
+// season, daily and weekly periods starting at midnight in the time zone,
+// UTC by default.
+type CreateLeaderboardRequest struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Metric   string `json:"metric"`
+	Policy   string `json:"policy"`
+	Reset    string `json:"reset"`
+	Timezone string `json:"timezone"`
+}
+
+// UpdateLeaderboardRequest represents a request for updating the fields set
+// of a leaderboard. Changes of reset or time zone apply from the next
+// submission, scores of the current period are kept.
+type UpdateLeaderboardRequest struct {
+	ID       string  `json:"-"`
+	Name     *string `json:"name"`
+	Metric   *string `json:"metric"`
+	Policy   *string `json:"policy"`
+	Reset    *string `json:"reset"`
+	Timezone *string `json:"timezone"`
+}
+
+// ListLeaderboardsRequest represents a request for listing leaderboards, of
+// a metric when set.
+type ListLeaderboardsRequest struct {
+	Metric string `json:"-" query:"metric"`
+}
+
+// ListLeaderboardsResponse represents a response for listing leaderboards
+// ordered by id.
+type ListLeaderboardsResponse struct {
+	Items []*Leaderboard `json:"_items"`
+}
+
+// Score is a value of a metric reached by a player, submitted to the
+// leaderboards of the metric.
+type Score struct {
+	PlayerID string `json:"playerID"`
+	Metric   string `json:"metric"`
+	Value    int64  `json:"value"`
+}
+
+// SubmitScoresRequest represents a request for submitting scores, in order.
+type SubmitScoresRequest struct {
+	Scores []*Score `json:"scores"`
+}
+
+// SubmitScoresResponse represents a response for submitting scores.
+type SubmitScoresResponse struct {
+	Items []*ScoreResult `json:"_items"`
+}
+
+// ScoreResult is the score of a player in the current period of a
+// leaderboard after a submission.
+type ScoreResult struct {
+	PlayerID      string `json:"playerID"`
+	LeaderboardID string `json:"leaderboardID"`
+	Period        string `json:"period"`
+	Score         int64  `json:"score"`
+}
+
+// LeaderboardTopRequest represents a request for the entries of a period of
+// a leaderboard from the top, the current period unless Period is set.
+type LeaderboardTopRequest struct {
+	ID     string `json:"-"`
+	Period string `json:"-" query:"period"`
+	Offset int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit  int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// LeaderboardAroundRequest represents a request for the entries of a period
+// of a leaderboard ranked within Radius of a player, "me" being the calling
+// player.
+type LeaderboardAroundRequest struct {
+	ID       string `json:"-"`
+	PlayerID string `json:"-"`
+	Period   string `json:"-" query:"period"`
+	Radius   int    `json:"-" query:"radius" validate:"gte=0,lte=50"`
+}
+
+// LeaderboardEntriesResponse represents a response for entries of a period
+// of a leaderboard ordered by rank.
+type LeaderboardEntriesResponse struct {
+	LeaderboardID string             `json:"leaderboardID"`
+	Period        *LeaderboardPeriod `json:"period,omitempty"`
+	// Final reports whether entries are those of the snapshot of an ended
+	// period.
+	Final    bool                `json:"final"`
+	Items    []*LeaderboardEntry `json:"_items"`
+	Metadata utils.PageMetadata  `json:"_metadata,omitempty"`
+}
+
+// ListLeaderboardSnapshotsRequest represents a request for listing the
+// snapshots of the ended periods of a leaderboard from the latest.
+type ListLeaderboardSnapshotsRequest struct {
+	ID     string `json:"-"`
+	Offset int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit  int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// LeaderboardSnapshot is the snapshot of an ended period, its entries are
+// served by the top and around queries of the period.
+type LeaderboardSnapshot struct {
+	Period    *LeaderboardPeriod `json:"period"`
+	CreatedAt int64              `json:"createdAt"`
+}
+
+// ListLeaderboardSnapshotsResponse represents a response for listing the
+// snapshots of a leaderboard.
+type ListLeaderboardSnapshotsResponse struct {
+	Items    []*LeaderboardSnapshot `json:"_items"`
+	Metadata utils.PageMetadata     `json:"_metadata,omitempty"`
+}
+
+func (l *LeaderboardRequest) Bind(r *http.Request) error {
+	l.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (c *CreateLeaderboardRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (u *UpdateLeaderboardRequest) Bind(r *http.Request) error {
+	u.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (l *ListLeaderboardsRequest) Bind(r *http.Request) error {
+	l.Metric = r.URL.Query().Get("metric")
+	return nil
+}
+
+func (s *SubmitScoresRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (l *LeaderboardTopRequest) Bind(r *http.Request) error {
+	var err error
+	l.ID = chi.URLParam(r, "id")
+	l.Period = r.URL.Query().Get("period")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (l *LeaderboardAroundRequest) Bind(r *http.Request) error {
+	var err error
+	l.ID = chi.URLParam(r, "id")
+	l.PlayerID = chi.URLParam(r, "playerID")
+	l.Period = r.URL.Query().Get("period")
+	if l.Radius, err = queryInt(r, "radius", 5); err != nil {
+		return err
+	}
+	if l.Radius < 0 || l.Radius > maxLeaderboardRadius {
+		return fmt.Errorf("radius: must be between 0 and %d", maxLeaderboardRadius)
+	}
+
+	return nil
+}
+
+func (l *ListLeaderboardSnapshotsRequest) Bind(r *http.Request) error {
+	var err error
+	l.ID = chi.URLParam(r, "id")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (l *Leaderboard) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListLeaderboardsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (s *SubmitScoresResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *LeaderboardEntriesResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListLeaderboardSnapshotsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
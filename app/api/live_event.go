@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// LiveEventService exposes all available use cases of live events: events
+// and seasons scheduled in a time zone, whose items are only listed while
+// they run. Changes of events are published as new versions of the catalog.
+type LiveEventService interface {
+	CreateLiveEvent(ctx context.Context, req *CreateLiveEventRequest) (*LiveEvent, error)
+	ListLiveEvents(ctx context.Context, req *ListLiveEventsRequest) (*ListLiveEventsResponse, error)
+	GetLiveEvent(ctx context.Context, req *LiveEventRequest) (*LiveEvent, error)
+	UpdateLiveEvent(ctx context.Context, req *UpdateLiveEventRequest) (*LiveEvent, error)
+	DeleteLiveEvent(ctx context.Context, req *LiveEventRequest) (*LiveEvent, error)
+}
+
+// LiveEvent rest resource, its start and end are RFC 3339 times in its time
+// zone.
+//
+// +smkit:rest:resource=true
+type LiveEvent struct {
+	ID          string   `json:"id"`
+	Kind        string   `json:"kind"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Timezone    string   `json:"timezone"`
+	StartAt     string   `json:"startAt"`
+	EndAt       string   `json:"endAt"`
+	ItemIDs     []string `json:"itemIDs"`
+	// Active reports whether the event runs now, or at the previewed time.
+	Active    bool   `json:"active"`
+	CreatedBy string `json:"createdBy,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+	// CatalogVersion is the version of the catalog a change of the event
+	// was published as.
+	CatalogVersion int64 `json:"catalogVersion,omitempty"`
+}
+
+// LiveEventRequest represents a request on the live event of the path.
+type LiveEventRequest struct {
+	ID string `json:"-"`
+}
+
+// CreateLiveEventRequest represents a request for creating a live event.
+// Start and end are RFC 3339 times, or local times "2006-01-02T15:04:05",
+// "2006-01-02T15:04" and "2006-01-02" in the time zone, UTC by default.
+type CreateLiveEventRequest struct {
+	ID          string   `json:"id"`
+	Kind        string   `json:"kind"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Timezone    string   `json:"timezone"`
+	StartAt     string   `json:"startAt"`
+	EndAt       string   `json:"endAt"`
+	ItemIDs     []string `json:"itemIDs"`
+}
+
+// UpdateLiveEventRequest represents a request for updating the fields set of
+// a live event. Local start and end times are in the new time zone if set.
+type UpdateLiveEventRequest struct {
+	ID          string   `json:"-"`
+	Kind        *string  `json:"kind"`
+	Name        *string  `json:"name"`
+	Description *string  `json:"description"`
+	Timezone    *string  `json:"timezone"`
+	StartAt     *string  `json:"startAt"`
+	EndAt       *string  `json:"endAt"`
+	ItemIDs     []string `json:"itemIDs"`
+}
+
+// ListLiveEventsRequest represents a request for listing live events, only
+// the active ones when Active is set. Events are active now, or at At.
+type ListLiveEventsRequest struct {
+	Active bool      `json:"-" query:"active"`
+	At     time.Time `json:"-" query:"at"`
+}
+
+// ListLiveEventsResponse represents a response for listing live events
+// ordered by start.
+type ListLiveEventsResponse struct {
+	Items []*LiveEvent `json:"_items"`
+	// At is the previewed time if any.
+	At int64 `json:"_at,omitempty"`
+}
+
+func (lr *LiveEventRequest) Bind(r *http.Request) error {
+	lr.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (c *CreateLiveEventRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (u *UpdateLiveEventRequest) Bind(r *http.Request) error {
+	u.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (l *ListLiveEventsRequest) Bind(r *http.Request) error {
+	var err error
+	if l.Active, err = queryBool(r, "active"); err != nil {
+		return err
+	}
+
+	l.At, err = queryTime(r, "at")
+	return err
+}
+
+func (e *LiveEvent) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListLiveEventsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
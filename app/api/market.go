@@ -0,0 +1,231 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// MarketService exposes all available use cases of the player marketplace:
+// players list items for sale and place buy orders at a price, matched
+// against the open orders of the other side, and browse the open orders and
+// the price history of items.
+type MarketService interface {
+	PlaceMarketOrder(ctx context.Context, req *PlaceMarketOrderRequest) (*PlaceMarketOrderResponse, error)
+	ListMarketOrders(ctx context.Context, req *ListMarketOrdersRequest) (*ListMarketOrdersResponse, error)
+	ListPlayerMarketOrders(ctx context.Context, req *ListPlayerMarketOrdersRequest) (*ListMarketOrdersResponse, error)
+	GetMarketOrder(ctx context.Context, req *MarketOrderRequest) (*MarketOrder, error)
+	CancelMarketOrder(ctx context.Context, req *MarketOrderRequest) (*MarketOrder, error)
+	ListMarketFills(ctx context.Context, req *ListMarketFillsRequest) (*ListMarketFillsResponse, error)
+	ListMarketCandles(ctx context.Context, req *ListMarketCandlesRequest) (*ListMarketCandlesResponse, error)
+}
+
+// MarketOrder rest resource.
+//
+// +smkit:rest:resource=true
+type MarketOrder struct {
+	ID       int64  `json:"id"`
+	PlayerID string `json:"playerID"`
+	// Side is sell or buy.
+	Side     string `json:"side"`
+	ItemID   string `json:"itemID"`
+	Currency string `json:"currency"`
+	// Price is per unit.
+	Price     int64 `json:"price"`
+	Quantity  int64 `json:"quantity"`
+	Remaining int64 `json:"remaining"`
+	// Status is open, filled or cancelled.
+	Status    string `json:"status"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// MarketFill is a quantity of an item sold at a price per unit, the fee
+// being charged to the seller.
+type MarketFill struct {
+	ID        int64  `json:"id"`
+	ItemID    string `json:"itemID"`
+	Currency  string `json:"currency"`
+	Price     int64  `json:"price"`
+	Quantity  int64  `json:"quantity"`
+	Fee       int64  `json:"fee"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// MarketCandle aggregates the fills of an interval starting at Start, in
+// unix seconds.
+type MarketCandle struct {
+	Start  int64 `json:"start"`
+	Open   int64 `json:"open"`
+	High   int64 `json:"high"`
+	Low    int64 `json:"low"`
+	Close  int64 `json:"close"`
+	Volume int64 `json:"volume"`
+	Fills  int   `json:"fills"`
+}
+
+// MarketOrderRequest represents a request on the market order of the path.
+type MarketOrderRequest struct {
+	ID int64 `json:"-"`
+}
+
+// PlaceMarketOrderRequest represents a request of the calling player for
+// selling or buying a quantity of an item at a price per unit in the
+// currency of the marketplace. Sold items, or the price of bought ones, are
+// held in escrow until filled or cancelled.
+type PlaceMarketOrderRequest struct {
+	Side     string `json:"side"`
+	ItemID   string `json:"itemID"`
+	Price    int64  `json:"price"`
+	Quantity int64  `json:"quantity"`
+}
+
+// PlaceMarketOrderResponse represents a response for placing a market
+// order, with the fills of its matching.
+type PlaceMarketOrderResponse struct {
+	Order *MarketOrder  `json:"order"`
+	Fills []*MarketFill `json:"fills"`
+}
+
+// ListMarketOrdersRequest represents a request for browsing the open orders
+// of a side, sell listings by default, of an item when set. Sort is "price"
+// or "-price", defaulting to the best price for the other side, the
+// cheapest listings and the dearest buy orders first.
+type ListMarketOrdersRequest struct {
+	ItemID string `json:"-" query:"itemID"`
+	Side   string `json:"-" query:"side"`
+	Sort   string `json:"-" query:"sort"`
+	Offset int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit  int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListPlayerMarketOrdersRequest represents a request for listing the market
+// orders of the player of the path from the latest, of a side and a status
+// when set.
+type ListPlayerMarketOrdersRequest struct {
+	PlayerID string `json:"-"`
+	Side     string `json:"-" query:"side"`
+	Status   string `json:"-" query:"status"`
+	Offset   int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit    int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListMarketOrdersResponse represents a response for listing market orders.
+type ListMarketOrdersResponse struct {
+	Items    []*MarketOrder     `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+// ListMarketFillsRequest represents a request for listing the fills of the
+// item of the path from the latest.
+type ListMarketFillsRequest struct {
+	ItemID string `json:"-"`
+	Offset int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit  int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListMarketFillsResponse represents a response for listing market fills.
+type ListMarketFillsResponse struct {
+	Items    []*MarketFill      `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+// ListMarketCandlesRequest represents a request for the candles of the item
+// of the path from From to To, in RFC 3339 or unix seconds, by Interval, a
+// duration such as 1h. To defaults to now, From to 100 intervals before To.
+type ListMarketCandlesRequest struct {
+	ItemID   string        `json:"-"`
+	Interval time.Duration `json:"-" query:"interval"`
+	From     time.Time     `json:"-" query:"from"`
+	To       time.Time     `json:"-" query:"to"`
+}
+
+// ListMarketCandlesResponse represents a response for the candles of an
+// item, intervals without fills have no candle.
+type ListMarketCandlesResponse struct {
+	ItemID   string          `json:"itemID"`
+	Currency string          `json:"currency"`
+	Interval string          `json:"interval"`
+	Items    []*MarketCandle `json:"_items"`
+}
+
+func (m *MarketOrderRequest) Bind(r *http.Request) error {
+	var err error
+	m.ID, err = pathInt64(r, "id")
+	return err
+}
+
+func (p *PlaceMarketOrderRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (l *ListMarketOrdersRequest) Bind(r *http.Request) error {
+	var err error
+	l.ItemID = r.URL.Query().Get("itemID")
+	l.Side = r.URL.Query().Get("side")
+	l.Sort = r.URL.Query().Get("sort")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (l *ListPlayerMarketOrdersRequest) Bind(r *http.Request) error {
+	var err error
+	l.PlayerID = chi.URLParam(r, "playerID")
+	l.Side = r.URL.Query().Get("side")
+	l.Status = r.URL.Query().Get("status")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (l *ListMarketFillsRequest) Bind(r *http.Request) error {
+	var err error
+	l.ItemID = chi.URLParam(r, "itemID")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (l *ListMarketCandlesRequest) Bind(r *http.Request) error {
+	var err error
+	l.ItemID = chi.URLParam(r, "itemID")
+	if v := r.URL.Query().Get("interval"); v != "" {
+		if l.Interval, err = time.ParseDuration(v); err != nil {
+			return fmt.Errorf("interval: must be a duration such as 1h")
+		}
+	}
+	if l.From, err = queryTime(r, "from"); err != nil {
+		return err
+	}
+	l.To, err = queryTime(r, "to")
+	return err
+}
+
+func (p *PlaceMarketOrderResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (m *MarketOrder) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListMarketOrdersResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListMarketFillsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListMarketCandlesResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// PlayerService exposes all available use cases of player profiles and the
+// external accounts linked to them, e.g. Ronin wallet addresses or OAuth
+// subjects.
+type PlayerService interface {
+	CreatePlayer(ctx context.Context, req *CreatePlayerRequest) (*Player, error)
+	ListPlayers(ctx context.Context, req *ListPlayersRequest) (*ListPlayersResponse, error)
+	GetPlayer(ctx context.Context, req *PlayerRequest) (*Player, error)
+	UpdatePlayer(ctx context.Context, req *UpdatePlayerRequest) (*Player, error)
+	DeletePlayer(ctx context.Context, req *PlayerRequest) (*Player, error)
+	ListPlayerAccounts(ctx context.Context, req *PlayerRequest) (*ListPlayerAccountsResponse, error)
+	LinkPlayerAccount(ctx context.Context, req *LinkPlayerAccountRequest) (*PlayerAccount, error)
+	UnlinkPlayerAccount(ctx context.Context, req *UnlinkPlayerAccountRequest) (*PlayerAccount, error)
+	LookupPlayer(ctx context.Context, req *LookupPlayerRequest) (*Player, error)
+}
+
+// Player rest resource.
+//
+// +smkit:rest:resource=true
+type Player struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Region      string `json:"region,omitempty"`
+	Banned      bool   `json:"banned"`
+	BanReason   string `json:"banReason,omitempty"`
+	// BannedUntil is the end of the ban in unix seconds, 0 for permanent
+	// bans.
+	BannedUntil int64 `json:"bannedUntil,omitempty"`
+	CreatedAt   int64 `json:"createdAt"`
+	UpdatedAt   int64 `json:"updatedAt"`
+}
+
+// PlayerAccount is an external account linked to a player.
+type PlayerAccount struct {
+	PlayerID  string `json:"playerID"`
+	Provider  string `json:"provider"`
+	Subject   string `json:"subject"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// PlayerRequest represents a request on the player of the path, "me" being
+// the calling player.
+type PlayerRequest struct {
+	ID string `json:"-"`
+}
+
+// CreatePlayerRequest represents a request for creating a player. Players
+// create their own profile, ID defaulting to theirs.
+type CreatePlayerRequest struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Region      string `json:"region"`
+}
+
+// UpdatePlayerRequest represents a request for updating the fields set of a
+// player. Ban fields are only updated by admins.
+type UpdatePlayerRequest struct {
+	ID          string  `json:"-"`
+	DisplayName *string `json:"displayName"`
+	Region      *string `json:"region"`
+	Banned      *bool   `json:"banned"`
+	BanReason   *string `json:"banReason"`
+	BannedUntil *int64  `json:"bannedUntil"`
+}
+
+// ListPlayersRequest represents a request for listing players, of a region
+// and ban status when set.
+type ListPlayersRequest struct {
+	Region string `json:"-" query:"region"`
+	Banned *bool  `json:"-" query:"banned"`
+	Offset int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit  int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListPlayersResponse represents a response for listing players ordered by
+// id.
+type ListPlayersResponse struct {
+	Items    []*Player          `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+// ListPlayerAccountsResponse represents a response for listing the accounts
+// linked to a player ordered by provider.
+type ListPlayerAccountsResponse struct {
+	Items []*PlayerAccount `json:"_items"`
+}
+
+// LinkPlayerAccountRequest represents a request for linking an account to
+// the player of the path. Provider is "ronin", Subject being a wallet
+// address, or "oauth:<issuer>", Subject being the subject of the issuer.
+type LinkPlayerAccountRequest struct {
+	PlayerID string `json:"-"`
+	Provider string `json:"provider"`
+	Subject  string `json:"subject"`
+}
+
+// UnlinkPlayerAccountRequest represents a request for unlinking the account
+// of a provider from the player of the path.
+type UnlinkPlayerAccountRequest struct {
+	PlayerID string `json:"-"`
+	Provider string `json:"-"`
+}
+
+// LookupPlayerRequest represents a request for the player linked to an
+// account.
+type LookupPlayerRequest struct {
+	Provider string `json:"-" query:"provider"`
+	Subject  string `json:"-" query:"subject"`
+}
+
+func (p *PlayerRequest) Bind(r *http.Request) error {
+	p.ID = chi.URLParam(r, "playerID")
+	return nil
+}
+
+func (c *CreatePlayerRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (u *UpdatePlayerRequest) Bind(r *http.Request) error {
+	u.ID = chi.URLParam(r, "playerID")
+	return nil
+}
+
+func (l *ListPlayersRequest) Bind(r *http.Request) error {
+	var err error
+	l.Region = r.URL.Query().Get("region")
+	if r.URL.Query().Get("banned") != "" {
+		banned, err := queryBool(r, "banned")
+		if err != nil {
+			return err
+		}
+		l.Banned = &banned
+	}
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (l *LinkPlayerAccountRequest) Bind(r *http.Request) error {
+	l.PlayerID = chi.URLParam(r, "playerID")
+	return nil
+}
+
+func (u *UnlinkPlayerAccountRequest) Bind(r *http.Request) error {
+	u.PlayerID = chi.URLParam(r, "playerID")
+	u.Provider = chi.URLParam(r, "provider")
+	return nil
+}
+
+func (l *LookupPlayerRequest) Bind(r *http.Request) error {
+	l.Provider = r.URL.Query().Get("provider")
+	l.Subject = r.URL.Query().Get("subject")
+	return nil
+}
+
+func (p *Player) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (p *PlayerAccount) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListPlayersResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListPlayerAccountsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
@@ -0,0 +1,213 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// QuestService exposes all available use cases of quests and achievements:
+// definitions whose objectives are progressed by the player events game
+// servers report, and whose rewards are granted to the inventory of players
+// once on completion.
+type QuestService interface {
+	CreateQuest(ctx context.Context, req *CreateQuestRequest) (*Quest, error)
+	ListQuests(ctx context.Context, req *ListQuestsRequest) (*ListQuestsResponse, error)
+	GetQuest(ctx context.Context, req *QuestRequest) (*Quest, error)
+	UpdateQuest(ctx context.Context, req *UpdateQuestRequest) (*Quest, error)
+	DeleteQuest(ctx context.Context, req *QuestRequest) (*Quest, error)
+	IngestPlayerEvents(ctx context.Context, req *IngestPlayerEventsRequest) (*IngestPlayerEventsResponse, error)
+	ListPlayerQuests(ctx context.Context, req *ListPlayerQuestsRequest) (*ListPlayerQuestsResponse, error)
+}
+
+// Quest rest resource.
+//
+// +smkit:rest:resource=true
+type Quest struct {
+	ID          string            `json:"id"`
+	Kind        string            `json:"kind"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Objectives  []*QuestObjective `json:"objectives"`
+	Rewards     []*QuestReward    `json:"rewards"`
+	Active      bool              `json:"active"`
+	CreatedBy   string            `json:"createdBy,omitempty"`
+	CreatedAt   int64             `json:"createdAt"`
+	UpdatedAt   int64             `json:"updatedAt"`
+}
+
+// QuestObjective is met once Count is reached by the quantities of the
+// player events of type Type, and of item ItemID unless empty, e.g.
+// {"type": "collect", "itemID": "gem", "count": 10}.
+type QuestObjective struct {
+	Type   string `json:"type"`
+	ItemID string `json:"itemID,omitempty"`
+	Count  int    `json:"count"`
+	// Progress is the count reached by a player, listed with their quests.
+	Progress *int `json:"progress,omitempty"`
+}
+
+// QuestReward is a quantity of a catalog item granted on completion.
+type QuestReward struct {
+	ItemID   string `json:"itemID"`
+	Quantity int    `json:"quantity"`
+}
+
+// QuestRequest represents a request on the quest of the path.
+type QuestRequest struct {
+	ID string `json:"-"`
+}
+
+// CreateQuestRequest represents a request for creating a quest, of kind
+// "quest" by default and active unless Active is false.
+type CreateQuestRequest struct {
+	ID          string            `json:"id"`
+	Kind        string            `json:"kind"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Objectives  []*QuestObjective `json:"objectives"`
+	Rewards     []*QuestReward    `json:"rewards"`
+	Active      *bool             `json:"active"`
+}
+
+// UpdateQuestRequest represents a request for updating the fields set of a
+// quest. Counts reached on replaced objectives are kept by index.
+type UpdateQuestRequest struct {
+	ID          string            `json:"-"`
+	Kind        *string           `json:"kind"`
+	Name        *string           `json:"name"`
+	Description *string           `json:"description"`
+	Objectives  []*QuestObjective `json:"objectives"`
+	Rewards     []*QuestReward    `json:"rewards"`
+	Active      *bool             `json:"active"`
+}
+
+// ListQuestsRequest represents a request for listing quests, only the
+// active ones when Active is set.
+type ListQuestsRequest struct {
+	Active bool `json:"-" query:"active"`
+}
+
+// ListQuestsResponse represents a response for listing quests ordered by
+// id.
+type ListQuestsResponse struct {
+	Items []*Quest `json:"_items"`
+}
+
+// PlayerEvent is a gameplay event of a player, e.g. {"type": "collect",
+// "itemID": "gem", "quantity": 3}. Events are applied once by player and
+// ID, so game servers retry them safely.
+type PlayerEvent struct {
+	ID       string `json:"id"`
+	PlayerID string `json:"playerID"`
+	Type     string `json:"type"`
+	ItemID   string `json:"itemID,omitempty"`
+	// Quantity is 1 by default.
+	Quantity   int   `json:"quantity"`
+	OccurredAt int64 `json:"occurredAt,omitempty"`
+}
+
+// IngestPlayerEventsRequest represents a request for applying player events
+// to the active quests, in order.
+type IngestPlayerEventsRequest struct {
+	Events []*PlayerEvent `json:"events"`
+}
+
+// IngestPlayerEventsResponse represents a response for applying player
+// events.
+type IngestPlayerEventsResponse struct {
+	// Applied is the number of events applied, Duplicates the number of
+	// events applied by former requests and skipped.
+	Applied     int                `json:"applied"`
+	Duplicates  int                `json:"duplicates"`
+	Completions []*QuestCompletion `json:"completions"`
+}
+
+// QuestCompletion is a quest completed by a player, whose rewards were
+// granted.
+type QuestCompletion struct {
+	PlayerID    string         `json:"playerID"`
+	QuestID     string         `json:"questID"`
+	Rewards     []*QuestReward `json:"rewards"`
+	CompletedAt int64          `json:"completedAt"`
+}
+
+// ListPlayerQuestsRequest represents a request for listing the quests of a
+// player, "me" being the calling player, by status when set.
+type ListPlayerQuestsRequest struct {
+	PlayerID string `json:"-"`
+	Status   string `json:"-" query:"status"`
+	Kind     string `json:"-" query:"kind"`
+}
+
+// PlayerQuest is a quest along with the progress of a player on it.
+type PlayerQuest struct {
+	QuestID     string            `json:"questID"`
+	Kind        string            `json:"kind"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Status      string            `json:"status"`
+	Objectives  []*QuestObjective `json:"objectives"`
+	Rewards     []*QuestReward    `json:"rewards"`
+	CompletedAt int64             `json:"completedAt,omitempty"`
+}
+
+// ListPlayerQuestsResponse represents a response for listing the quests of a
+// player: the active ones, and those completed by the player.
+type ListPlayerQuestsResponse struct {
+	PlayerID string         `json:"playerID"`
+	Items    []*PlayerQuest `json:"_items"`
+}
+
+func (q *QuestRequest) Bind(r *http.Request) error {
+	q.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (c *CreateQuestRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (u *UpdateQuestRequest) Bind(r *http.Request) error {
+	u.ID = chi.URLParam(r, "id")
+	return nil
+}
+
+func (l *ListQuestsRequest) Bind(r *http.Request) error {
+	var err error
+	l.Active, err = queryBool(r, "active")
+	return err
+}
+
+func (i *IngestPlayerEventsRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (l *ListPlayerQuestsRequest) Bind(r *http.Request) error {
+	l.PlayerID = chi.URLParam(r, "playerID")
+	l.Status = r.URL.Query().Get("status")
+	l.Kind = r.URL.Query().Get("kind")
+	return nil
+}
+
+func (q *Quest) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListQuestsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (i *IngestPlayerEventsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListPlayerQuestsResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
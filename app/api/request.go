@@ -0,0 +1,122 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/nhatquangsin/game-service/infra/i18n"
+)
+
+// Defaults of pagination query parameters.
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// queryStrings returns the values of a query parameter, accepting both repeated
+// (?k=a&k=b) and comma separated (?k=a,b) forms.
+func queryStrings(r *http.Request, key string) []string {
+	var res []string
+	for _, v := range r.URL.Query()[key] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				res = append(res, s)
+			}
+		}
+	}
+
+	return res
+}
+
+// queryInt returns the integer value of a query parameter, or def if absent.
+func queryInt(r *http.Request, key string, def int) (int, error) {
+	v := r.URL.Query().Get(key)
+	if v == "" {
+		return def, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s: must be an integer", key)
+	}
+
+	return n, nil
+}
+
+// pathInt64 returns the integer value of a parameter of the route path.
+func pathInt64(r *http.Request, key string) (int64, error) {
+	n, err := strconv.ParseInt(chi.URLParam(r, key), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: must be an integer", key)
+	}
+
+	return n, nil
+}
+
+// queryBool returns the boolean value of a query parameter, or false if absent.
+func queryBool(r *http.Request, key string) (bool, error) {
+	v := r.URL.Query().Get(key)
+	if v == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%s: must be a boolean", key)
+	}
+
+	return b, nil
+}
+
+// queryTime returns the time of a query parameter in RFC 3339 or unix
+// seconds, or the zero time if absent.
+func queryTime(r *http.Request, key string) (time.Time, error) {
+	v := r.URL.Query().Get(key)
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(n, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: must be an RFC 3339 time or unix seconds", key)
+	}
+
+	return t, nil
+}
+
+// pagination binds and validates offset and limit query parameters.
+func pagination(r *http.Request) (offset, limit int, err error) {
+	if offset, err = queryInt(r, "offset", 0); err != nil {
+		return 0, 0, err
+	}
+	if offset < 0 {
+		return 0, 0, fmt.Errorf("offset: must be greater than or equal to 0")
+	}
+
+	if limit, err = queryInt(r, "limit", defaultLimit); err != nil {
+		return 0, 0, err
+	}
+	if limit < 1 || limit > maxLimit {
+		return 0, 0, fmt.Errorf("limit: must be between 1 and %d", maxLimit)
+	}
+
+	return offset, limit, nil
+}
+
+// locales returns the locale fallback chain requested by ?locale= or, if it is
+// absent, by the Accept-Language header.
+func locales(r *http.Request) []string {
+	if l := r.URL.Query().Get("locale"); l != "" {
+		return i18n.Chain(l)
+	}
+
+	return i18n.Chain(i18n.ParseAcceptLanguage(r.Header.Get("Accept-Language"))...)
+}
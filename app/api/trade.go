@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// TradeService exposes all available use cases of trades between players:
+// a player proposes items and currencies, held in escrow, in exchange of
+// items and currencies of another player, who accepts or rejects them.
+type TradeService interface {
+	ProposeTrade(ctx context.Context, req *ProposeTradeRequest) (*Trade, error)
+	ListPlayerTrades(ctx context.Context, req *ListPlayerTradesRequest) (*ListTradesResponse, error)
+	GetTrade(ctx context.Context, req *TradeRequest) (*Trade, error)
+	AcceptTrade(ctx context.Context, req *TradeRequest) (*Trade, error)
+	RejectTrade(ctx context.Context, req *TradeRequest) (*Trade, error)
+	CancelTrade(ctx context.Context, req *TradeRequest) (*Trade, error)
+}
+
+// Trade rest resource.
+//
+// +smkit:rest:resource=true
+type Trade struct {
+	ID          int64         `json:"id"`
+	ProposerID  string        `json:"proposerID"`
+	RecipientID string        `json:"recipientID"`
+	Offered     []*TradeAsset `json:"offered"`
+	Requested   []*TradeAsset `json:"requested"`
+	// Status is pending, accepted, rejected, cancelled or expired.
+	Status     string `json:"status"`
+	ExpiresAt  int64  `json:"expiresAt"`
+	ResolvedAt int64  `json:"resolvedAt,omitempty"`
+	CreatedAt  int64  `json:"createdAt"`
+	UpdatedAt  int64  `json:"updatedAt"`
+}
+
+// TradeAsset is an amount of an item or a currency of a trade.
+type TradeAsset struct {
+	// AssetType is item or currency.
+	AssetType string `json:"assetType"`
+	Asset     string `json:"asset"`
+	Amount    int64  `json:"amount"`
+}
+
+// TradeRequest represents a request on the trade of the path.
+type TradeRequest struct {
+	ID int64 `json:"-"`
+}
+
+// ProposeTradeRequest represents a request of the calling player for
+// proposing a trade to a recipient. Offered assets are held in escrow until
+// the trade is resolved, at ExpiresAt at the latest, in unix seconds,
+// defaulting to a day after the proposal.
+type ProposeTradeRequest struct {
+	RecipientID string        `json:"recipientID"`
+	Offered     []*TradeAsset `json:"offered"`
+	Requested   []*TradeAsset `json:"requested"`
+	ExpiresAt   int64         `json:"expiresAt"`
+}
+
+// ListPlayerTradesRequest represents a request for listing the trades
+// proposed to or by the player of the path from the latest, of a status when
+// set.
+type ListPlayerTradesRequest struct {
+	PlayerID string `json:"-"`
+	Status   string `json:"-" query:"status"`
+	Offset   int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit    int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListTradesResponse represents a response for listing trades.
+type ListTradesResponse struct {
+	Items    []*Trade           `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+func (t *TradeRequest) Bind(r *http.Request) error {
+	var err error
+	t.ID, err = pathInt64(r, "id")
+	return err
+}
+
+func (p *ProposeTradeRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (l *ListPlayerTradesRequest) Bind(r *http.Request) error {
+	var err error
+	l.PlayerID = chi.URLParam(r, "playerID")
+	l.Status = r.URL.Query().Get("status")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (t *Trade) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListTradesResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/i18n"
+)
+
+// Supported formats of translation files.
+const (
+	TranslationFormatCSV   = "csv"
+	TranslationFormatXLIFF = "xliff"
+)
+
+// TranslationService exposes all available use cases of item translations.
+type TranslationService interface {
+	GetTranslationCompleteness(ctx context.Context, req *GetTranslationCompletenessRequest) (*GetTranslationCompletenessResponse, error)
+	ExportTranslations(ctx context.Context, req *ExportTranslationsRequest) error
+	ImportTranslations(ctx context.Context, req *ImportTranslationsRequest) (*ImportTranslationsResponse, error)
+}
+
+// TranslationCompleteness reports how many items are fully translated in a
+// locale. An item is fully translated when it has a translated name and, if
+// the item has a description, a translated description.
+type TranslationCompleteness struct {
+	Locale         string   `json:"locale"`
+	Total          int      `json:"total"`
+	Translated     int      `json:"translated"`
+	Percent        float64  `json:"percent"`
+	MissingItemIDs []string `json:"missingItemIDs,omitempty"`
+}
+
+// GetTranslationCompletenessRequest represents a request for translation
+// completeness. All translated locales are reported when Locales is empty.
+type GetTranslationCompletenessRequest struct {
+	Locales        []string `json:"-" query:"locale"`
+	IncludeMissing bool     `json:"-" query:"includeMissing"`
+}
+
+// GetTranslationCompletenessResponse represents a response for translation
+// completeness.
+type GetTranslationCompletenessResponse struct {
+	Locales []*TranslationCompleteness `field:"_items" json:"_items"`
+}
+
+// ExportTranslationsRequest represents a request for exporting translations
+// into Writer. Locale is optional for CSV and required for XLIFF.
+type ExportTranslationsRequest struct {
+	Format string
+	Locale string
+	Writer io.Writer
+}
+
+// ImportTranslationsRequest represents a request for importing translations
+// from Reader.
+type ImportTranslationsRequest struct {
+	Format string
+	Reader io.Reader
+}
+
+// ImportTranslationsResponse represents a response for importing
+// translations. Rejected rows are reported in Errors and not imported.
+type ImportTranslationsResponse struct {
+	Imported int      `json:"imported"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+func (g *GetTranslationCompletenessRequest) Bind(r *http.Request) error {
+	for _, l := range queryStrings(r, "locale") {
+		g.Locales = append(g.Locales, i18n.Normalize(l))
+	}
+
+	var err error
+	g.IncludeMissing, err = queryBool(r, "includeMissing")
+
+	return err
+}
+
+func (g *GetTranslationCompletenessResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
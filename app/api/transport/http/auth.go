@@ -0,0 +1,86 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+// APIKeyHeader is the header carrying the API key of services.
+const APIKeyHeader = "X-API-Key"
+
+// Authenticate returns a http middleware placing the principal authenticated
+// by the bearer token or the API key of a request in its context, along with
+// the rate limit key of its caller for endpoints. Requests without
+// credentials go through anonymously, requests with invalid ones are rejected
+// with 401.
+func Authenticate(a *auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var (
+				p   *auth.Principal
+				err error
+			)
+			if key := r.Header.Get(APIKeyHeader); key != "" {
+				p, err = a.AuthenticateAPIKey(key)
+			} else if token, ok := bearerToken(r); ok {
+				p, err = a.AuthenticateToken(r.Context(), token)
+			}
+			if err != nil {
+				render.Render(w, r, ErrUnauthorized)
+				return
+			}
+
+			if p != nil {
+				r = r.WithContext(auth.NewContext(r.Context(), p))
+			}
+			r = r.WithContext(endpoint.WithRateLimitKey(r.Context(), callerKey(r)))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequirePrincipal returns a http middleware rejecting anonymous requests
+// with 401, and with 403 the requests of principals not of one of types when
+// types are given.
+func RequirePrincipal(types ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p := auth.FromContext(r.Context())
+			if p == nil {
+				render.Render(w, r, ErrUnauthorized)
+				return
+			}
+
+			if len(types) > 0 && !contains(types, p.Type) {
+				render.Render(w, r, ErrForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	h := r.Header.Get("Authorization")
+	if len(h) < 7 || !strings.EqualFold(h[:7], "Bearer ") {
+		return "", false
+	}
+
+	return strings.TrimSpace(h[7:]), true
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
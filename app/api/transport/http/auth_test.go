@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+)
+
+func TestAuthenticate(t *testing.T) {
+	a, err := auth.New(auth.Options.APIKeys(auth.APIKey{Name: "ops", Hash: auth.HashAPIKey("k3y")}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		apiKey     string
+		wantStatus int
+		wantKey    string
+	}{
+		{
+			name:       "TC01 - api key - should be rate limited as the service",
+			apiKey:     "k3y",
+			wantStatus: http.StatusOK,
+			wantKey:    auth.PrincipalService + ":ops",
+		},
+		{
+			name:       "TC02 - anonymous - should be rate limited by ip",
+			wantStatus: http.StatusOK,
+			wantKey:    "ip:192.0.2.1",
+		},
+		{
+			name:       "TC03 - invalid api key - should be unauthorized",
+			apiKey:     "nope",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotKey string
+			h := Authenticate(a)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotKey = endpoint.RateLimitKey(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/items", nil)
+			if tt.apiKey != "" {
+				req.Header.Set(APIKeyHeader, tt.apiKey)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantKey, gotKey)
+		})
+	}
+}
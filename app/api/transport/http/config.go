@@ -0,0 +1,77 @@
+package http
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// ServerConfig is the configuration of the http server.
+type ServerConfig struct {
+	// TrustedProxies are the networks of the proxies whose forwarded client
+	// addresses are trusted.
+	TrustedProxies []*net.IPNet
+	// ReadMasterWindow is how long the reads of a client are routed to master
+	// after it writes.
+	ReadMasterWindow time.Duration
+	// ReadMasterSecret signs the cookie pinning clients to master, it must be
+	// shared by the replicas of the service.
+	ReadMasterSecret []byte
+}
+
+// NewServerConfig creates and returns new instance of ServerConfig. It is
+// configured through viper by following keys under "http":
+//
+//   - trusted_proxies
+//     CIDRs or IPs of the proxies in front of the service, none by default,
+//     so clients are known by the address of their connection.
+//   - read_your_writes.window
+//     5s by default.
+//   - read_your_writes.secret
+//     a random secret of the process by default.
+func NewServerConfig(v *viper.Viper) (*ServerConfig, error) {
+	cfg := struct {
+		HTTP struct {
+			TrustedProxies []string `mapstructure:"trusted_proxies"`
+			ReadYourWrites struct {
+				Window time.Duration `mapstructure:"window"`
+				Secret string        `mapstructure:"secret"`
+			} `mapstructure:"read_your_writes"`
+		} `mapstructure:"http"`
+	}{}
+
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	c := &ServerConfig{
+		ReadMasterWindow: cfg.HTTP.ReadYourWrites.Window,
+		ReadMasterSecret: []byte(cfg.HTTP.ReadYourWrites.Secret),
+	}
+	if c.ReadMasterWindow <= 0 {
+		c.ReadMasterWindow = 5 * time.Second
+	}
+
+	for _, p := range cfg.HTTP.TrustedProxies {
+		if !strings.Contains(p, "/") {
+			if ip := net.ParseIP(p); ip != nil && ip.To4() != nil {
+				p += "/32"
+			} else {
+				p += "/128"
+			}
+		}
+
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("http: trusted proxy %q: %w", p, err)
+		}
+		c.TrustedProxies = append(c.TrustedProxies, n)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,346 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+
+	"github.com/nhatquangsin/game-service/infra/auth"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// Rate limit backends.
+const (
+	RateLimitBackendMemory = "memory"
+	RateLimitBackendRedis  = "redis"
+)
+
+// Quota is the max number of requests of a caller within a sliding window.
+type Quota struct {
+	Limit  int           `mapstructure:"limit"`
+	Window time.Duration `mapstructure:"window"`
+}
+
+// RateLimitResult is the outcome of counting a request.
+type RateLimitResult struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the quota is fully restored.
+	Reset time.Duration
+	// RetryAfter is how long until a denied request may be allowed.
+	RetryAfter time.Duration
+}
+
+// RateLimitStore counts requests by key in sliding windows.
+type RateLimitStore interface {
+	Allow(ctx context.Context, key string, quota Quota) (*RateLimitResult, error)
+}
+
+// RateLimiter limits requests per caller and route. Callers are identified by
+// their principal, else their IP, so it must run after Authenticate.
+type RateLimiter struct {
+	store  RateLimitStore
+	quota  Quota
+	routes map[string]Quota
+	now    func() time.Time
+}
+
+// NewRateLimiter creates and returns new instance of RateLimiter, or nil
+// when rate limiting is disabled. It is configured through viper by following
+// keys under "http.rate_limit":
+//
+//   - enable
+//   - backend
+//     "memory" (default) or "redis", the redis backend shares quotas between
+//     replicas through the default redis client.
+//   - default.limit, default.window
+//     quota of routes without their own.
+//   - routes.<METHOD /pattern>.limit, routes.<METHOD /pattern>.window
+//     quota of a route, e.g. routes["GET /items"].
+func NewRateLimiter(v *viper.Viper, rdb redis.UniversalClient) (*RateLimiter, error) {
+	cfg := struct {
+		HTTP struct {
+			RateLimit struct {
+				Enable  bool             `mapstructure:"enable"`
+				Backend string           `mapstructure:"backend"`
+				Default Quota            `mapstructure:"default"`
+				Routes  map[string]Quota `mapstructure:"routes"`
+			} `mapstructure:"rate_limit"`
+		} `mapstructure:"http"`
+		Service struct {
+			Namespace string `mapstructure:"namespace"`
+		} `mapstructure:"service"`
+	}{}
+
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	rl := cfg.HTTP.RateLimit
+	if !rl.Enable {
+		return nil, nil
+	}
+
+	l := &RateLimiter{
+		quota:  rl.Default,
+		routes: make(map[string]Quota, len(rl.Routes)),
+		now:    time.Now,
+	}
+	for route, q := range rl.Routes {
+		l.routes[strings.ToLower(route)] = q
+	}
+
+	switch rl.Backend {
+	case "", RateLimitBackendMemory:
+		l.store = NewMemoryRateLimitStore()
+	case RateLimitBackendRedis:
+		l.store = NewRedisRateLimitStore(rdb, cfg.Service.Namespace+":game-service:ratelimit:")
+	default:
+		return nil, fmt.Errorf("http: unsupported rate limit backend %q", rl.Backend)
+	}
+
+	return l, nil
+}
+
+// Handler returns a http middleware rejecting requests beyond the quota of
+// their caller and route with 429. It sets the RateLimit-Limit,
+// RateLimit-Remaining and RateLimit-Reset headers, and Retry-After on
+// rejected requests. Requests are allowed when the store fails.
+func (l *RateLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := routePattern(r)
+		quota, ok := l.routes[strings.ToLower(route)]
+		if !ok {
+			quota = l.quota
+		}
+		if quota.Limit <= 0 || quota.Window <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		res, err := l.store.Allow(r.Context(), route+"|"+callerKey(r), quota)
+		if err != nil {
+			log.Printf("http: rate limiting %s: %v", route, err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+		h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		h.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.Reset)))
+		if !res.Allowed {
+			h.Set("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+			render.Render(w, r, ErrTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routePattern returns "<METHOD> <pattern>" of the route matching r, or
+// "<METHOD> *" when none does.
+func routePattern(r *http.Request) string {
+	pattern := "*"
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.Routes != nil {
+		tctx := chi.NewRouteContext()
+		if rctx.Routes.Match(tctx, r.Method, r.URL.Path) {
+			pattern = tctx.RoutePattern()
+		}
+	}
+
+	return r.Method + " " + pattern
+}
+
+// callerKey identifies the caller of r.
+func callerKey(r *http.Request) string {
+	if p := auth.FromContext(r.Context()); p != nil {
+		return p.Type + ":" + p.ID
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return "ip:" + ip
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// slidingWindow decides on a request from the counts of the previous and the
+// current fixed windows before it. The requests of the sliding window are
+// estimated by weighting the previous window by its part still in the
+// sliding window, elapsed being the elapsed part of the current window.
+func slidingWindow(prev, cur int, elapsed float64, quota Quota) *RateLimitResult {
+	window := float64(quota.Window)
+	res := &RateLimitResult{Limit: quota.Limit}
+
+	estimate := float64(prev)*(1-elapsed) + float64(cur)
+	if estimate+1 <= float64(quota.Limit) {
+		res.Allowed = true
+		res.Remaining = int(math.Floor(float64(quota.Limit) - estimate - 1))
+		cur++
+	} else {
+		// wait for the weight of the previous window to drop enough for one
+		// more request, or for the next window when the current one is full.
+		wait := 1 - elapsed
+		if prev > 0 && cur+1 <= quota.Limit {
+			wait = 1 - float64(quota.Limit-cur-1)/float64(prev) - elapsed
+		}
+		res.RetryAfter = time.Duration(math.Max(wait, 0) * window)
+	}
+
+	switch {
+	case cur > 0:
+		res.Reset = time.Duration((2 - elapsed) * window)
+	case prev > 0:
+		res.Reset = time.Duration((1 - elapsed) * window)
+	}
+
+	return res
+}
+
+// MemoryRateLimitStore is a RateLimitStore local to the process.
+type MemoryRateLimitStore struct {
+	mu       sync.Mutex
+	counters map[string]*windowCounter
+	swept    time.Time
+	now      func() time.Time
+}
+
+type windowCounter struct {
+	window time.Duration
+	index  int64
+	prev   int
+	cur    int
+}
+
+// NewMemoryRateLimitStore creates and returns new instance of
+// MemoryRateLimitStore.
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		counters: make(map[string]*windowCounter),
+		now:      time.Now,
+	}
+}
+
+// Allow implements RateLimitStore.
+func (s *MemoryRateLimitStore) Allow(_ context.Context, key string, quota Quota) (*RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	index := now.UnixNano() / int64(quota.Window)
+	c, ok := s.counters[key]
+	if !ok {
+		c = &windowCounter{window: quota.Window, index: index}
+		s.counters[key] = c
+	}
+	switch {
+	case c.index == index-1:
+		c.prev, c.cur = c.cur, 0
+	case c.index < index-1:
+		c.prev, c.cur = 0, 0
+	}
+	c.index = index
+
+	elapsed := float64(now.UnixNano()%int64(quota.Window)) / float64(quota.Window)
+	res := slidingWindow(c.prev, c.cur, elapsed, quota)
+	if res.Allowed {
+		c.cur++
+	}
+
+	return res, nil
+}
+
+// sweep drops counters older than two windows.
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.swept) < time.Minute {
+		return
+	}
+	s.swept = now
+
+	for key, c := range s.counters {
+		if now.UnixNano()/int64(c.window) > c.index+1 {
+			delete(s.counters, key)
+		}
+	}
+}
+
+// redisSlidingWindow counts a request in the current window if the sliding
+// window has room, it returns the counts of the previous and current windows
+// before counting.
+var redisSlidingWindow = redis.NewScript(`
+local prev = tonumber(redis.call("GET", KEYS[1]) or "0")
+local cur = tonumber(redis.call("GET", KEYS[2]) or "0")
+if prev * (1 - tonumber(ARGV[2])) + cur + 1 <= tonumber(ARGV[1]) then
+	redis.call("INCR", KEYS[2])
+	redis.call("PEXPIRE", KEYS[2], ARGV[3])
+end
+return {prev, cur}
+`)
+
+// RedisRateLimitStore is a RateLimitStore shared by all replicas.
+type RedisRateLimitStore struct {
+	rdb    redis.UniversalClient
+	prefix string
+	now    func() time.Time
+}
+
+// NewRedisRateLimitStore creates and returns new instance of
+// RedisRateLimitStore, its keys are prefixed by prefix.
+func NewRedisRateLimitStore(rdb redis.UniversalClient, prefix string) *RedisRateLimitStore {
+	return &RedisRateLimitStore{
+		rdb:    rdb,
+		prefix: prefix,
+		now:    time.Now,
+	}
+}
+
+// Allow implements RateLimitStore.
+func (s *RedisRateLimitStore) Allow(ctx context.Context, key string, quota Quota) (*RateLimitResult, error) {
+	now := s.now()
+	index := now.UnixNano() / int64(quota.Window)
+	elapsed := float64(now.UnixNano()%int64(quota.Window)) / float64(quota.Window)
+
+	// the hash tag keeps both windows of a key on the same cluster slot.
+	base := s.prefix + "{" + key + "}:"
+	res, err := redisSlidingWindow.Run(
+		ctx,
+		s.rdb,
+		[]string{base + strconv.FormatInt(index-1, 10), base + strconv.FormatInt(index, 10)},
+		quota.Limit,
+		elapsed,
+		(2 * quota.Window).Milliseconds(),
+	).Result()
+	if err != nil {
+		return nil, err
+	}
+	counts, ok := res.([]interface{})
+	if !ok || len(counts) != 2 {
+		return nil, fmt.Errorf("http: unexpected rate limit counts %v", counts)
+	}
+	prev, _ := counts[0].(int64)
+	cur, _ := counts[1].(int64)
+
+	return slidingWindow(int(prev), int(cur), elapsed, quota), nil
+}
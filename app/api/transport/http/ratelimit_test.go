@@ -0,0 +1,148 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/infra/auth"
+)
+
+func TestRateLimitStore_Allow(t *testing.T) {
+	quota := Quota{Limit: 2, Window: time.Minute}
+	start := time.Unix(600, 0)
+
+	tests := []struct {
+		name string
+		// offsets of requests from start.
+		offsets       []time.Duration
+		wantAllowed   []bool
+		wantRemaining int
+		wantRetry     time.Duration
+	}{
+		{
+			name:          "TC01 - within quota - should allow",
+			offsets:       []time.Duration{0, time.Second},
+			wantAllowed:   []bool{true, true},
+			wantRemaining: 0,
+		},
+		{
+			name:        "TC02 - beyond quota - should deny until next window",
+			offsets:     []time.Duration{0, 0, 30 * time.Second},
+			wantAllowed: []bool{true, true, false},
+			wantRetry:   30 * time.Second,
+		},
+		{
+			name:          "TC03 - previous window weighted - should allow once its weight dropped",
+			offsets:       []time.Duration{0, 0, 75 * time.Second, 90 * time.Second},
+			wantAllowed:   []bool{true, true, false, true},
+			wantRemaining: 0,
+		},
+	}
+
+	stores := map[string]func(t *testing.T, now func() time.Time) RateLimitStore{
+		"memory": func(_ *testing.T, now func() time.Time) RateLimitStore {
+			s := NewMemoryRateLimitStore()
+			s.now = now
+			return s
+		},
+		"redis": func(t *testing.T, now func() time.Time) RateLimitStore {
+			mr := miniredis.RunT(t)
+			s := NewRedisRateLimitStore(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "test:")
+			s.now = now
+			return s
+		},
+	}
+
+	for backend, newStore := range stores {
+		for _, tt := range tests {
+			t.Run(backend+"/"+tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				now := start
+				s := newStore(t, func() time.Time { return now })
+
+				var res *RateLimitResult
+				for i, offset := range tt.offsets {
+					now = start.Add(offset)
+
+					var err error
+					res, err = s.Allow(ctx, "GET /items|ip:127.0.0.1", quota)
+					require.NoError(t, err)
+					assert.Equal(t, tt.wantAllowed[i], res.Allowed, "request %d", i)
+				}
+
+				assert.Equal(t, tt.wantRemaining, res.Remaining)
+				assert.Equal(t, tt.wantRetry, res.RetryAfter)
+			})
+		}
+	}
+}
+
+func TestRateLimiter_Handler(t *testing.T) {
+	l := &RateLimiter{
+		store: NewMemoryRateLimitStore(),
+		quota: Quota{Limit: 100, Window: time.Minute},
+		routes: map[string]Quota{
+			"get /items": {Limit: 1, Window: time.Minute},
+		},
+	}
+
+	r := chi.NewRouter()
+	r.Use(l.Handler)
+	r.Get("/items", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name          string
+		playerID      string
+		wantStatus    int
+		wantRemaining string
+	}{
+		{
+			name:          "TC01 - first request - should pass with rate limit headers",
+			playerID:      "player_1",
+			wantStatus:    http.StatusOK,
+			wantRemaining: "0",
+		},
+		{
+			name:          "TC02 - second request of the player - should be rejected",
+			playerID:      "player_1",
+			wantStatus:    http.StatusTooManyRequests,
+			wantRemaining: "0",
+		},
+		{
+			name:          "TC03 - request of another player - should pass",
+			playerID:      "player_2",
+			wantStatus:    http.StatusOK,
+			wantRemaining: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/items", nil)
+			req = req.WithContext(auth.NewContext(req.Context(), &auth.Principal{
+				ID:   tt.playerID,
+				Type: auth.PrincipalPlayer,
+			}))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, "1", w.Header().Get("RateLimit-Limit"))
+			assert.Equal(t, tt.wantRemaining, w.Header().Get("RateLimit-Remaining"))
+			if tt.wantStatus == http.StatusTooManyRequests {
+				assert.NotEmpty(t, w.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// RealIP returns a http middleware setting the RemoteAddr of requests to the
+// address of their client, as forwarded by the proxies in front of the
+// service. X-Forwarded-For and X-Real-IP are only trusted when sent by one of
+// proxies, the client being the rightmost address of X-Forwarded-For which is
+// not one of proxies. Requests of other peers keep their RemoteAddr, so
+// clients cannot choose the address they are known by.
+func RealIP(proxies []*net.IPNet) func(http.Handler) http.Handler {
+	trusted := func(ip net.IP) bool {
+		for _, n := range proxies {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				host = r.RemoteAddr
+			}
+
+			if peer := net.ParseIP(host); peer != nil && trusted(peer) {
+				if ip := forwardedFor(r, trusted); ip != "" {
+					r.RemoteAddr = ip
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedFor returns the client of r forwarded by trusted proxies, or ""
+// when none is.
+func forwardedFor(r *http.Request, trusted func(net.IP) bool) string {
+	if xff := r.Header.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				return ""
+			}
+			if !trusted(ip) {
+				return ip.String()
+			}
+		}
+		return ""
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+
+	return ""
+}
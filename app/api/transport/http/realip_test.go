@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealIP(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        string
+		xRealIP    string
+		want       string
+	}{
+		{
+			name:       "TC01 - headers of a client - should be ignored",
+			remoteAddr: "203.0.113.7:1234",
+			xff:        "198.51.100.1",
+			xRealIP:    "198.51.100.2",
+			want:       "203.0.113.7:1234",
+		},
+		{
+			name:       "TC02 - forwarded by a proxy - should be the rightmost untrusted address",
+			remoteAddr: "10.0.0.2:1234",
+			xff:        "198.51.100.1, 203.0.113.7, 10.0.0.3",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "TC03 - real ip of a proxy - should be trusted",
+			remoteAddr: "10.0.0.2:1234",
+			xRealIP:    "203.0.113.7",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "TC04 - malformed forwarded address - should keep the proxy",
+			remoteAddr: "10.0.0.2:1234",
+			xff:        "203.0.113.7, junk",
+			want:       "10.0.0.2:1234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			h := RealIP([]*net.IPNet{proxies})(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = r.RemoteAddr
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/items", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				r.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if tt.xRealIP != "" {
+				r.Header.Set("X-Real-IP", tt.xRealIP)
+			}
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,7 +1,16 @@
 package http
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -10,50 +19,538 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/infra/auth"
+	"github.com/nhatquangsin/game-service/infra/job"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
 )
 
 // ServerFXModule represents a FX module for http server.
 var ServerFXModule = fx.Options(
+	fx.Provide(
+		NewServerConfig,
+		NewRateLimiter,
+	),
 	fx.Invoke(
 		SetupHTTPServer,
 	),
 )
 
-func SetupHTTPServer(itemService api.ItemService) {
+// SetupHTTPServer serves the routes of the service on :8000 while the app
+// runs, requests in progress are drained when it stops.
+func SetupHTTPServer(
+	lc fx.Lifecycle,
+	itemService api.ItemService,
+	translationService api.TranslationService,
+	changesetService api.ChangesetService,
+	catalogSnapshotService api.CatalogSnapshotService,
+	webhookService api.WebhookService,
+	liveEventService api.LiveEventService,
+	questService api.QuestService,
+	inventoryService api.InventoryService,
+	leaderboardService api.LeaderboardService,
+	playerService api.PlayerService,
+	walletService api.WalletService,
+	tradeService api.TradeService,
+	marketService api.MarketService,
+	itemInstanceService api.ItemInstanceService,
+	dbClient database.Client,
+	jobs *job.Registry,
+	cfg *ServerConfig,
+	rateLimiter *RateLimiter,
+	authenticator *auth.Authenticator,
+	policy *auth.Policy,
+) {
 	r := chi.NewRouter()
 
 	// A good base middleware stack
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(RealIP(cfg.TrustedProxies))
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
+	r.Use(Authenticate(authenticator))
+	if rateLimiter != nil {
+		r.Use(rateLimiter.Handler)
+	}
 
 	// Set a timeout value on the request context (ctx), that will signal
 	// through ctx.Done() that the request has timed out and further
 	// processing should be stopped.
 	r.Use(middleware.Timeout(10 * time.Second))
 
+	// Route the reads of writing clients to master for a while, so they do
+	// not read stale data from a lagging replica. Only services may route
+	// any read to master.
+	r.Use(database.ReadYourWrites(cfg.ReadMasterWindow, cfg.ReadMasterSecret, func(r *http.Request) bool {
+		p := auth.FromContext(r.Context())
+		return p != nil && p.Type == auth.PrincipalService
+	}))
+
 	r.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("pong"))
 	})
 
+	// Admin and diagnostics routes are reserved to services and admins
+	// authenticated by API keys.
+	r.Group(func(r chi.Router) {
+		r.Use(RequirePrincipal(auth.PrincipalService))
+
+		r.Get("/debug/db/replicas", func(w http.ResponseWriter, r *http.Request) {
+			render.JSON(w, r, dbClient.ReplicaStats())
+		})
+
+		r.Post("/admin/cache/flush", func(w http.ResponseWriter, r *http.Request) {
+			if err := dbClient.FlushQueryCache(r.Context()); err != nil {
+				render.Render(w, r, ErrInternalServer)
+				return
+			}
+
+			render.NoContent(w, r)
+		})
+
+		r.Get("/admin/jobs", func(w http.ResponseWriter, r *http.Request) {
+			status, err := jobs.Status(r.Context(), time.Now())
+			if err != nil {
+				render.Render(w, r, ErrInternalServer)
+				return
+			}
+
+			render.Render(w, r, &api.ListJobsResponse{Items: status})
+		})
+
+		r.Get("/admin/jobs/{name}/runs", func(w http.ResponseWriter, r *http.Request) {
+			req := &api.ListJobRunsRequest{}
+			if err := bind(r, req); err != nil {
+				render.Render(w, r, ErrInvalidRequest(err))
+				return
+			}
+
+			res, err := jobs.Runs(r.Context(), req.Name, req.Limit, req.Offset)
+			switch {
+			case errors.Is(err, job.ErrUnknownJob):
+				render.Render(w, r, ErrNotFound)
+				return
+			case err != nil:
+				render.Render(w, r, ErrInternalServer)
+				return
+			}
+
+			render.Render(w, r, &api.ListJobRunsResponse{Items: res.Runs, Metadata: res.Metadata})
+		})
+	})
+
+	r.With(RequirePrincipal()).Get("/me/permissions", func(w http.ResponseWriter, r *http.Request) {
+		p := auth.FromContext(r.Context())
+		render.JSON(w, r, &PermissionsResponse{
+			ID:          p.ID,
+			Type:        p.Type,
+			Roles:       p.Roles,
+			Permissions: policy.Permissions(p),
+		})
+	})
+
 	r.Get("/items", func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		req := &api.ListItemsRequest{}
-		if err := render.Bind(r, req); err != nil {
+		if err := bind(r, req); err != nil {
 			render.Render(w, r, ErrInvalidRequest(err))
 			return
 		}
 
 		res, err := itemService.ListItems(ctx, req)
 		if err != nil {
-			render.Render(w, r, ErrInternalServer)
+			render.Render(w, r, ErrService(err))
+			return
 		}
 
 		render.Render(w, r, res)
 	})
 
-	http.ListenAndServe(":8000", r)
+	r.Get("/translations/completeness", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		req := &api.GetTranslationCompletenessRequest{}
+		if err := bind(r, req); err != nil {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+
+		res, err := translationService.GetTranslationCompleteness(ctx, req)
+		if err != nil {
+			render.Render(w, r, ErrService(err))
+			return
+		}
+
+		render.Render(w, r, res)
+	})
+
+	// Catalog downloads of game clients, the snapshots of versions never
+	// change so they are cached for long.
+	r.Get("/catalog/manifest", handle(func() *api.GetCatalogManifestRequest {
+		return &api.GetCatalogManifestRequest{}
+	}, catalogSnapshotService.GetCatalogManifest))
+	r.Get("/catalog/{version}", func(w http.ResponseWriter, r *http.Request) {
+		req := &api.GetCatalogSnapshotRequest{}
+		if err := bind(r, req); err != nil {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+
+		blob, err := catalogSnapshotService.GetCatalogSnapshot(r.Context(), req)
+		if err != nil {
+			render.Render(w, r, ErrService(err))
+			return
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		renderBlob(w, r, blob)
+	})
+
+	// Staged catalog publishing, permissions are checked by the service.
+	r.Route("/changesets", func(r chi.Router) {
+		r.Post("/", handle(func() *api.CreateChangesetRequest {
+			return &api.CreateChangesetRequest{}
+		}, changesetService.CreateChangeset))
+		r.Get("/{id}", handle(func() *api.ChangesetRequest {
+			return &api.ChangesetRequest{}
+		}, changesetService.GetChangeset))
+		r.Put("/{id}/changes", handle(func() *api.PutChangesetChangesRequest {
+			return &api.PutChangesetChangesRequest{}
+		}, changesetService.PutChangesetChanges))
+		r.Post("/{id}/submit", handle(func() *api.ChangesetRequest {
+			return &api.ChangesetRequest{}
+		}, changesetService.SubmitChangeset))
+		r.Post("/{id}/review", handle(func() *api.ReviewChangesetRequest {
+			return &api.ReviewChangesetRequest{}
+		}, changesetService.ReviewChangeset))
+		r.Post("/{id}/publish", handle(func() *api.ChangesetRequest {
+			return &api.ChangesetRequest{}
+		}, changesetService.PublishChangeset))
+	})
+
+	// Outbound webhooks of partner teams, permissions are checked by the
+	// service.
+	r.Route("/webhooks", func(r chi.Router) {
+		r.Post("/", handle(func() *api.CreateWebhookRequest {
+			return &api.CreateWebhookRequest{}
+		}, webhookService.CreateWebhook))
+		r.Get("/", handle(func() *api.ListWebhooksRequest {
+			return &api.ListWebhooksRequest{}
+		}, webhookService.ListWebhooks))
+		r.Get("/{id}", handle(func() *api.WebhookRequest {
+			return &api.WebhookRequest{}
+		}, webhookService.GetWebhook))
+		r.Patch("/{id}", handle(func() *api.UpdateWebhookRequest {
+			return &api.UpdateWebhookRequest{}
+		}, webhookService.UpdateWebhook))
+		r.Delete("/{id}", handle(func() *api.WebhookRequest {
+			return &api.WebhookRequest{}
+		}, webhookService.DeleteWebhook))
+		r.Get("/{id}/deliveries", handle(func() *api.ListWebhookDeliveriesRequest {
+			return &api.ListWebhookDeliveriesRequest{}
+		}, webhookService.ListWebhookDeliveries))
+		r.Post("/{id}/deliveries/{deliveryID}/redeliver", handle(func() *api.WebhookDeliveryRequest {
+			return &api.WebhookDeliveryRequest{}
+		}, webhookService.RedeliverWebhookDelivery))
+	})
+
+	// Live events and seasons, their items are listed on GET /items while
+	// they run. Permissions are checked by the service.
+	r.Route("/events", func(r chi.Router) {
+		r.Post("/", handle(func() *api.CreateLiveEventRequest {
+			return &api.CreateLiveEventRequest{}
+		}, liveEventService.CreateLiveEvent))
+		r.Get("/", handle(func() *api.ListLiveEventsRequest {
+			return &api.ListLiveEventsRequest{}
+		}, liveEventService.ListLiveEvents))
+		r.Get("/{id}", handle(func() *api.LiveEventRequest {
+			return &api.LiveEventRequest{}
+		}, liveEventService.GetLiveEvent))
+		r.Patch("/{id}", handle(func() *api.UpdateLiveEventRequest {
+			return &api.UpdateLiveEventRequest{}
+		}, liveEventService.UpdateLiveEvent))
+		r.Delete("/{id}", handle(func() *api.LiveEventRequest {
+			return &api.LiveEventRequest{}
+		}, liveEventService.DeleteLiveEvent))
+	})
+
+	// Quests and achievements, progressed by the player events game servers
+	// post. Permissions are checked by the service.
+	r.Route("/quests", func(r chi.Router) {
+		r.Post("/", handle(func() *api.CreateQuestRequest {
+			return &api.CreateQuestRequest{}
+		}, questService.CreateQuest))
+		r.Get("/", handle(func() *api.ListQuestsRequest {
+			return &api.ListQuestsRequest{}
+		}, questService.ListQuests))
+		r.Post("/events", handle(func() *api.IngestPlayerEventsRequest {
+			return &api.IngestPlayerEventsRequest{}
+		}, questService.IngestPlayerEvents))
+		r.Get("/{id}", handle(func() *api.QuestRequest {
+			return &api.QuestRequest{}
+		}, questService.GetQuest))
+		r.Patch("/{id}", handle(func() *api.UpdateQuestRequest {
+			return &api.UpdateQuestRequest{}
+		}, questService.UpdateQuest))
+		r.Delete("/{id}", handle(func() *api.QuestRequest {
+			return &api.QuestRequest{}
+		}, questService.DeleteQuest))
+	})
+
+	// Players, their linked accounts, quests, inventories, wallets, ledger,
+	// trades and market orders, "me" being the calling player. Permissions
+	// are checked by the services.
+	r.Route("/players", func(r chi.Router) {
+		r.Post("/", handle(func() *api.CreatePlayerRequest {
+			return &api.CreatePlayerRequest{}
+		}, playerService.CreatePlayer))
+		r.Get("/", handle(func() *api.ListPlayersRequest {
+			return &api.ListPlayersRequest{}
+		}, playerService.ListPlayers))
+		r.Get("/lookup", handle(func() *api.LookupPlayerRequest {
+			return &api.LookupPlayerRequest{}
+		}, playerService.LookupPlayer))
+		r.Route("/{playerID}", func(r chi.Router) {
+			r.Get("/", handle(func() *api.PlayerRequest {
+				return &api.PlayerRequest{}
+			}, playerService.GetPlayer))
+			r.Patch("/", handle(func() *api.UpdatePlayerRequest {
+				return &api.UpdatePlayerRequest{}
+			}, playerService.UpdatePlayer))
+			r.Delete("/", handle(func() *api.PlayerRequest {
+				return &api.PlayerRequest{}
+			}, playerService.DeletePlayer))
+			r.Get("/accounts", handle(func() *api.PlayerRequest {
+				return &api.PlayerRequest{}
+			}, playerService.ListPlayerAccounts))
+			r.Post("/accounts", handle(func() *api.LinkPlayerAccountRequest {
+				return &api.LinkPlayerAccountRequest{}
+			}, playerService.LinkPlayerAccount))
+			r.Delete("/accounts/{provider}", handle(func() *api.UnlinkPlayerAccountRequest {
+				return &api.UnlinkPlayerAccountRequest{}
+			}, playerService.UnlinkPlayerAccount))
+			r.Get("/quests", handle(func() *api.ListPlayerQuestsRequest {
+				return &api.ListPlayerQuestsRequest{}
+			}, questService.ListPlayerQuests))
+			r.Get("/inventory", handle(func() *api.InventoryRequest {
+				return &api.InventoryRequest{}
+			}, inventoryService.GetInventory))
+			r.Get("/wallet", handle(func() *api.WalletRequest {
+				return &api.WalletRequest{}
+			}, walletService.GetWallet))
+			r.Post("/wallet/adjust", handle(func() *api.AdjustWalletRequest {
+				return &api.AdjustWalletRequest{}
+			}, walletService.AdjustWallet))
+			r.Get("/ledger", handle(func() *api.ListLedgerRequest {
+				return &api.ListLedgerRequest{}
+			}, walletService.ListLedger))
+			r.Get("/trades", handle(func() *api.ListPlayerTradesRequest {
+				return &api.ListPlayerTradesRequest{}
+			}, tradeService.ListPlayerTrades))
+			r.Get("/market/orders", handle(func() *api.ListPlayerMarketOrdersRequest {
+				return &api.ListPlayerMarketOrdersRequest{}
+			}, marketService.ListPlayerMarketOrders))
+		})
+	})
+
+	// Trades between players, proposed by the calling player with the
+	// offered assets held in escrow until the recipient accepts or rejects
+	// them. Permissions are checked by the service.
+	r.Route("/trades", func(r chi.Router) {
+		r.Post("/", handle(func() *api.ProposeTradeRequest {
+			return &api.ProposeTradeRequest{}
+		}, tradeService.ProposeTrade))
+		r.Get("/{id}", handle(func() *api.TradeRequest {
+			return &api.TradeRequest{}
+		}, tradeService.GetTrade))
+		r.Post("/{id}/accept", handle(func() *api.TradeRequest {
+			return &api.TradeRequest{}
+		}, tradeService.AcceptTrade))
+		r.Post("/{id}/reject", handle(func() *api.TradeRequest {
+			return &api.TradeRequest{}
+		}, tradeService.RejectTrade))
+		r.Post("/{id}/cancel", handle(func() *api.TradeRequest {
+			return &api.TradeRequest{}
+		}, tradeService.CancelTrade))
+	})
+
+	// Item instances, non fungible copies of catalog items minted to players
+	// by game servers, transferred and burned along with their history.
+	// Permissions are checked by the service.
+	r.Route("/instances", func(r chi.Router) {
+		r.Post("/", handle(func() *api.MintItemInstanceRequest {
+			return &api.MintItemInstanceRequest{}
+		}, itemInstanceService.MintItemInstance))
+		r.Get("/", handle(func() *api.ListItemInstancesRequest {
+			return &api.ListItemInstancesRequest{}
+		}, itemInstanceService.ListItemInstances))
+		r.Get("/{id}", handle(func() *api.ItemInstanceRequest {
+			return &api.ItemInstanceRequest{}
+		}, itemInstanceService.GetItemInstance))
+		r.Post("/{id}/transfer", handle(func() *api.TransferItemInstanceRequest {
+			return &api.TransferItemInstanceRequest{}
+		}, itemInstanceService.TransferItemInstance))
+		r.Post("/{id}/burn", handle(func() *api.BurnItemInstanceRequest {
+			return &api.BurnItemInstanceRequest{}
+		}, itemInstanceService.BurnItemInstance))
+		r.Get("/{id}/history", handle(func() *api.ItemInstanceRequest {
+			return &api.ItemInstanceRequest{}
+		}, itemInstanceService.ListItemInstanceHistory))
+	})
+
+	// Marketplace of the items of the catalog, sell listings and buy orders
+	// placed by the calling player with their items or price held in escrow,
+	// matched by best price then time. Fills are the price history of items.
+	// Permissions are checked by the service.
+	r.Route("/market", func(r chi.Router) {
+		r.Get("/orders", handle(func() *api.ListMarketOrdersRequest {
+			return &api.ListMarketOrdersRequest{}
+		}, marketService.ListMarketOrders))
+		r.Post("/orders", handle(func() *api.PlaceMarketOrderRequest {
+			return &api.PlaceMarketOrderRequest{}
+		}, marketService.PlaceMarketOrder))
+		r.Get("/orders/{id}", handle(func() *api.MarketOrderRequest {
+			return &api.MarketOrderRequest{}
+		}, marketService.GetMarketOrder))
+		r.Post("/orders/{id}/cancel", handle(func() *api.MarketOrderRequest {
+			return &api.MarketOrderRequest{}
+		}, marketService.CancelMarketOrder))
+		r.Get("/items/{itemID}/fills", handle(func() *api.ListMarketFillsRequest {
+			return &api.ListMarketFillsRequest{}
+		}, marketService.ListMarketFills))
+		r.Get("/items/{itemID}/candles", handle(func() *api.ListMarketCandlesRequest {
+			return &api.ListMarketCandlesRequest{}
+		}, marketService.ListMarketCandles))
+	})
+
+	// Leaderboards, ranking players by the scores game servers post, reset
+	// daily, weekly or every season. Permissions are checked by the service.
+	r.Route("/leaderboards", func(r chi.Router) {
+		r.Post("/", handle(func() *api.CreateLeaderboardRequest {
+			return &api.CreateLeaderboardRequest{}
+		}, leaderboardService.CreateLeaderboard))
+		r.Get("/", handle(func() *api.ListLeaderboardsRequest {
+			return &api.ListLeaderboardsRequest{}
+		}, leaderboardService.ListLeaderboards))
+		r.Post("/scores", handle(func() *api.SubmitScoresRequest {
+			return &api.SubmitScoresRequest{}
+		}, leaderboardService.SubmitScores))
+		r.Get("/{id}", handle(func() *api.LeaderboardRequest {
+			return &api.LeaderboardRequest{}
+		}, leaderboardService.GetLeaderboard))
+		r.Patch("/{id}", handle(func() *api.UpdateLeaderboardRequest {
+			return &api.UpdateLeaderboardRequest{}
+		}, leaderboardService.UpdateLeaderboard))
+		r.Delete("/{id}", handle(func() *api.LeaderboardRequest {
+			return &api.LeaderboardRequest{}
+		}, leaderboardService.DeleteLeaderboard))
+		r.Get("/{id}/top", handle(func() *api.LeaderboardTopRequest {
+			return &api.LeaderboardTopRequest{}
+		}, leaderboardService.GetLeaderboardTop))
+		r.Get("/{id}/around/{playerID}", handle(func() *api.LeaderboardAroundRequest {
+			return &api.LeaderboardAroundRequest{}
+		}, leaderboardService.GetLeaderboardAround))
+		r.Get("/{id}/snapshots", handle(func() *api.ListLeaderboardSnapshotsRequest {
+			return &api.ListLeaderboardSnapshotsRequest{}
+		}, leaderboardService.ListLeaderboardSnapshots))
+	})
+
+	server := &http.Server{Addr: ":8000", Handler: r}
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			ln, err := net.Listen("tcp", server.Addr)
+			if err != nil {
+				return err
+			}
+
+			go func() {
+				if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					log.Printf("http: serving: %v", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return server.Shutdown(ctx)
+		},
+	})
+}
+
+// handle returns a handler binding requests created by newReq, and rendering
+// the responses of method.
+func handle[Req render.Binder, Res render.Renderer](
+	newReq func() Req,
+	method func(context.Context, Req) (Res, error),
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := newReq()
+		if err := bind(r, req); err != nil {
+			render.Render(w, r, ErrInvalidRequest(err))
+			return
+		}
+
+		res, err := method(r.Context(), req)
+		if err != nil {
+			render.Render(w, r, ErrService(err))
+			return
+		}
+
+		render.Render(w, r, res)
+	}
+}
+
+// renderBlob writes a gzip compressed blob, compressed if the client accepts
+// it and decompressed otherwise, or 304 when the client has it already.
+func renderBlob(w http.ResponseWriter, r *http.Request, blob *api.CatalogSnapshotBlob) {
+	// representations of different encodings have different strong etags.
+	gzipped := strings.Contains(r.Header.Get("Accept-Encoding"), "gzip")
+	etag := `"` + blob.ETag + `"`
+	if gzipped {
+		etag = `"` + blob.ETag + `-gzip"`
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Add("Vary", "Accept, Accept-Encoding")
+	if match := r.Header.Get("If-None-Match"); match != "" && (match == "*" || strings.Contains(match, etag)) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	data := blob.Data
+	if gzipped {
+		w.Header().Set("Content-Encoding", "gzip")
+	} else {
+		zr, err := gzip.NewReader(bytes.NewReader(blob.Data))
+		if err == nil {
+			data, err = io.ReadAll(zr)
+		}
+		if err != nil {
+			render.Render(w, r, ErrInternalServer)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", blob.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// bind binds the query of GET requests and of requests without body, and the
+// decoded body of others.
+func bind(r *http.Request, v render.Binder) error {
+	if r.Method == http.MethodGet || r.ContentLength == 0 {
+		return v.Bind(r)
+	}
+
+	return render.Bind(r, v)
+}
+
+// PermissionsResponse describes the caller and the permissions it is granted.
+type PermissionsResponse struct {
+	ID          string   `json:"id"`
+	Type        string   `json:"type"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
 }
 
 // ErrResponse renderer type for handling all sorts of errors.
@@ -93,5 +590,31 @@ func ErrRender(err error) render.Renderer {
 	}
 }
 
+// ErrService returns the response of an error returned by a service.
+func ErrService(err error) render.Renderer {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return ErrUnauthorized
+	case errors.Is(err, auth.ErrPermissionDenied):
+		return ErrForbidden
+	case errors.Is(err, endpoint.ErrRateLimited):
+		return ErrTooManyRequests
+	case errors.Is(err, endpoint.ErrCircuitOpen):
+		return ErrServiceUnavailable
+	case errors.Is(err, api.ErrInvalidArgument):
+		return ErrInvalidRequest(err)
+	case errors.Is(err, api.ErrNotFound):
+		return &ErrResponse{Err: err, HTTPStatusCode: 404, StatusText: "Resource not found.", ErrorText: err.Error()}
+	case errors.Is(err, api.ErrConflict):
+		return &ErrResponse{Err: err, HTTPStatusCode: 409, StatusText: "Conflict.", ErrorText: err.Error()}
+	}
+
+	return ErrInternalServer
+}
+
 var ErrNotFound = &ErrResponse{HTTPStatusCode: 404, StatusText: "Resource not found."}
 var ErrInternalServer = &ErrResponse{HTTPStatusCode: 500, StatusText: "Internal server error."}
+var ErrTooManyRequests = &ErrResponse{HTTPStatusCode: 429, StatusText: "Too many requests."}
+var ErrServiceUnavailable = &ErrResponse{HTTPStatusCode: 503, StatusText: "Service unavailable."}
+var ErrUnauthorized = &ErrResponse{HTTPStatusCode: 401, StatusText: "Unauthorized."}
+var ErrForbidden = &ErrResponse{HTTPStatusCode: 403, StatusText: "Forbidden."}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// WalletService exposes all available use cases of player wallets: the
+// balances of the currencies of the catalog owned by players, and the
+// ledger of every change of their items and currencies.
+type WalletService interface {
+	GetWallet(ctx context.Context, req *WalletRequest) (*Wallet, error)
+	AdjustWallet(ctx context.Context, req *AdjustWalletRequest) (*WalletBalance, error)
+	ListLedger(ctx context.Context, req *ListLedgerRequest) (*ListLedgerResponse, error)
+}
+
+// Wallet rest resource.
+//
+// +smkit:rest:resource=true
+type Wallet struct {
+	PlayerID string           `json:"playerID"`
+	Balances []*WalletBalance `json:"_items"`
+}
+
+// WalletBalance is the balance of a currency owned by a player.
+type WalletBalance struct {
+	Currency  string `json:"currency"`
+	Balance   int64  `json:"balance"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// LedgerEntry is a change of the quantity of an item or the balance of a
+// currency of a player.
+type LedgerEntry struct {
+	ID        int64  `json:"id"`
+	AssetType string `json:"assetType"`
+	Asset     string `json:"asset"`
+	Delta     int64  `json:"delta"`
+	Balance   int64  `json:"balance"`
+	Reason    string `json:"reason"`
+	Reference string `json:"reference,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+// WalletRequest represents a request on the wallet of the player of the
+// path, "me" being the calling player.
+type WalletRequest struct {
+	PlayerID string `json:"-"`
+}
+
+// AdjustWalletRequest represents a request for adding Amount, negative for
+// debits, to the balance of a currency of the player of the path.
+type AdjustWalletRequest struct {
+	PlayerID  string `json:"-"`
+	Currency  string `json:"currency"`
+	Amount    int64  `json:"amount"`
+	Reference string `json:"reference"`
+}
+
+// ListLedgerRequest represents a request for listing the ledger of the
+// player of the path from the latest entry.
+type ListLedgerRequest struct {
+	PlayerID string `json:"-"`
+	Offset   int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit    int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListLedgerResponse represents a response for listing the ledger of a
+// player.
+type ListLedgerResponse struct {
+	Items    []*LedgerEntry     `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+func (w *WalletRequest) Bind(r *http.Request) error {
+	w.PlayerID = chi.URLParam(r, "playerID")
+	return nil
+}
+
+func (a *AdjustWalletRequest) Bind(r *http.Request) error {
+	a.PlayerID = chi.URLParam(r, "playerID")
+	return nil
+}
+
+func (l *ListLedgerRequest) Bind(r *http.Request) error {
+	var err error
+	l.PlayerID = chi.URLParam(r, "playerID")
+	l.Offset, l.Limit, err = pagination(r)
+	return err
+}
+
+func (wa *Wallet) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (b *WalletBalance) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListLedgerResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/render"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// WebhookEventTypes are the event types webhooks subscribe to, besides "*"
+// subscribing to all of them.
+var WebhookEventTypes = []string{
+	entity.EventItemCreated,
+	entity.EventItemUpdated,
+	entity.EventItemDeleted,
+	entity.EventCatalogPublished,
+	entity.EventQuestCompleted,
+	entity.EventInventoryGranted,
+	entity.EventItemInstanceMinted,
+	entity.EventItemInstanceTransferred,
+	entity.EventItemInstanceBurned,
+}
+
+// WebhookService exposes all available use cases of outbound webhooks:
+// subscriptions receive the events of their types as signed JSON posts,
+// retried with backoff, and their deliveries are logged.
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*Webhook, error)
+	ListWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	GetWebhook(ctx context.Context, req *WebhookRequest) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, req *WebhookRequest) (*Webhook, error)
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhookDelivery(ctx context.Context, req *WebhookDeliveryRequest) (*WebhookDelivery, error)
+}
+
+// Webhook rest resource.
+//
+// +smkit:rest:resource=true
+type Webhook struct {
+	ID          int64    `json:"id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description,omitempty"`
+	Active      bool     `json:"active"`
+	// Secret signing the deliveries, only returned when it is created or
+	// rotated.
+	Secret    string `json:"secret,omitempty"`
+	CreatedBy string `json:"createdBy"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// WebhookDelivery rest resource, the delivery of an event and the outcome of
+// its last attempt.
+//
+// +smkit:rest:resource=true
+type WebhookDelivery struct {
+	ID             int64           `json:"id"`
+	WebhookID      int64           `json:"webhookID"`
+	EventID        int64           `json:"eventID"`
+	EventType      string          `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  int64           `json:"nextAttemptAt,omitempty"`
+	ResponseStatus int             `json:"responseStatus,omitempty"`
+	LastError      string          `json:"lastError,omitempty"`
+	DeliveredAt    int64           `json:"deliveredAt,omitempty"`
+	CreatedAt      int64           `json:"createdAt"`
+	UpdatedAt      int64           `json:"updatedAt"`
+}
+
+// WebhookRequest represents a request on the webhook of the path.
+type WebhookRequest struct {
+	ID int64 `json:"-"`
+}
+
+// CreateWebhookRequest represents a request for creating a webhook, its
+// secret is generated unless set.
+type CreateWebhookRequest struct {
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+	Secret      string   `json:"secret"`
+}
+
+// UpdateWebhookRequest represents a request for updating the fields set of a
+// webhook, and rotating its secret.
+type UpdateWebhookRequest struct {
+	ID           int64    `json:"-"`
+	URL          *string  `json:"url"`
+	Events       []string `json:"events"`
+	Description  *string  `json:"description"`
+	Active       *bool    `json:"active"`
+	RotateSecret bool     `json:"rotateSecret"`
+}
+
+// ListWebhooksRequest represents a request for listing all webhooks.
+type ListWebhooksRequest struct{}
+
+// ListWebhooksResponse represents a response for listing all webhooks.
+type ListWebhooksResponse struct {
+	Items []*Webhook `json:"_items"`
+}
+
+// ListWebhookDeliveriesRequest represents a request for listing the
+// deliveries of a webhook from the latest, of a status unless empty.
+type ListWebhookDeliveriesRequest struct {
+	ID     int64  `json:"-"`
+	Status string `json:"-" query:"status"`
+	Offset int    `json:"-" query:"offset" validate:"gte=0"`
+	Limit  int    `json:"-" query:"limit" validate:"gte=1,lte=100"`
+}
+
+// ListWebhookDeliveriesResponse represents a response for listing the
+// deliveries of a webhook.
+type ListWebhookDeliveriesResponse struct {
+	Items    []*WebhookDelivery `json:"_items"`
+	Metadata utils.PageMetadata `json:"_metadata,omitempty"`
+}
+
+// WebhookDeliveryRequest represents a request on the delivery of the path.
+type WebhookDeliveryRequest struct {
+	ID         int64 `json:"-"`
+	DeliveryID int64 `json:"-"`
+}
+
+func (wr *WebhookRequest) Bind(r *http.Request) error {
+	var err error
+	wr.ID, err = pathInt64(r, "id")
+	return err
+}
+
+func (c *CreateWebhookRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (u *UpdateWebhookRequest) Bind(r *http.Request) error {
+	var err error
+	u.ID, err = pathInt64(r, "id")
+	return err
+}
+
+func (l *ListWebhooksRequest) Bind(r *http.Request) error {
+	return nil
+}
+
+func (l *ListWebhookDeliveriesRequest) Bind(r *http.Request) error {
+	var err error
+	if l.ID, err = pathInt64(r, "id"); err != nil {
+		return err
+	}
+	if l.Offset, l.Limit, err = pagination(r); err != nil {
+		return err
+	}
+
+	l.Status = r.URL.Query().Get("status")
+	statuses := []string{
+		entity.WebhookDeliveryStatusPending,
+		entity.WebhookDeliveryStatusSucceeded,
+		entity.WebhookDeliveryStatusFailed,
+	}
+	if l.Status != "" && !slices.Contains(statuses, l.Status) {
+		return fmt.Errorf("status: must be one of %v", statuses)
+	}
+
+	return nil
+}
+
+func (wr *WebhookDeliveryRequest) Bind(r *http.Request) error {
+	var err error
+	if wr.ID, err = pathInt64(r, "id"); err != nil {
+		return err
+	}
+	wr.DeliveryID, err = pathInt64(r, "deliveryID")
+	return err
+}
+
+func (wh *Webhook) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListWebhooksResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (l *ListWebhookDeliveriesResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
+
+func (d *WebhookDelivery) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, 200)
+	return nil
+}
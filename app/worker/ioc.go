@@ -0,0 +1,23 @@
+package worker
+
+import (
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/infra/job"
+	"github.com/nhatquangsin/game-service/infra/outbox"
+)
+
+// FXModule represents a FX module for the worker component, its workers run
+// while the app runs.
+var FXModule = fx.Options(
+	outbox.FXModule,
+	fx.Provide(
+		NewWebhookFanout,
+		StartOutboxRelay,
+		StartWebhookDispatcher,
+		job.StartRunner,
+	),
+	fx.Invoke(
+		func(*OutboxRelay, *WebhookDispatcher, *job.Runner) {},
+	),
+)
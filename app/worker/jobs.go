@@ -0,0 +1,217 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/job"
+	"github.com/nhatquangsin/game-service/infra/leaderboard"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// JobsFXModule represents a FX module for the background jobs of the
+// service. It is part of every component so that the api serves their
+// status, while only the worker component runs them.
+var JobsFXModule = fx.Options(
+	job.FXModule,
+	fx.Provide(
+		job.AsCronJob(NewJobsPurgeJob),
+		job.AsCronJob(NewOutboxPurgeJob),
+		job.AsCronJob(NewPlayerEventsPurgeJob),
+		job.AsCronJob(NewLeaderboardsSnapshotJob),
+		job.AsCronJob(NewTradesExpireJob),
+	),
+)
+
+// NewJobsPurgeJob creates the job deleting the runs of jobs and the finished
+// tasks older than jobs.history_retention, 30 days by default.
+func NewJobsPurgeJob(
+	v *viper.Viper,
+	runRepo repo.JobRunRepo,
+	taskRepo repo.JobTaskRepo,
+) (job.CronJob, error) {
+	cfg := struct {
+		Jobs struct {
+			HistoryRetention time.Duration `mapstructure:"history_retention"`
+		} `mapstructure:"jobs"`
+	}{}
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return job.CronJob{}, err
+	}
+	retention := cfg.Jobs.HistoryRetention
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+
+	return job.CronJob{
+		Name:     "jobs-purge",
+		Schedule: "@daily",
+		Timeout:  10 * time.Minute,
+		Run: func(ctx context.Context) error {
+			before := time.Now().Add(-retention).Unix()
+			runs, err := runRepo.DeleteBefore(ctx, before)
+			if err != nil {
+				return err
+			}
+			tasks, err := taskRepo.DeleteFinishedBefore(ctx, before)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("jobs purge: deleted %d runs and %d tasks", runs, tasks)
+			return nil
+		},
+	}, nil
+}
+
+// NewOutboxPurgeJob creates the job deleting the events published longer
+// than outbox.retention ago, 7 days by default.
+func NewOutboxPurgeJob(v *viper.Viper, outboxRepo repo.OutboxRepo) (job.CronJob, error) {
+	cfg := struct {
+		Outbox struct {
+			Retention time.Duration `mapstructure:"retention"`
+		} `mapstructure:"outbox"`
+	}{}
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return job.CronJob{}, err
+	}
+	retention := cfg.Outbox.Retention
+	if retention <= 0 {
+		retention = 7 * 24 * time.Hour
+	}
+
+	return job.CronJob{
+		Name:     "outbox-purge",
+		Schedule: "@hourly",
+		Timeout:  10 * time.Minute,
+		Run: func(ctx context.Context) error {
+			n, err := outboxRepo.DeletePublishedBefore(ctx, time.Now().Add(-retention).Unix())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("outbox purge: deleted %d events", n)
+			return nil
+		},
+	}, nil
+}
+
+// NewPlayerEventsPurgeJob creates the job deleting the ids of the player
+// events applied longer than quests.event_retention ago, 30 days by default.
+func NewPlayerEventsPurgeJob(v *viper.Viper, playerEventRepo repo.PlayerEventRepo) (job.CronJob, error) {
+	cfg := struct {
+		Quests struct {
+			EventRetention time.Duration `mapstructure:"event_retention"`
+		} `mapstructure:"quests"`
+	}{}
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return job.CronJob{}, err
+	}
+	retention := cfg.Quests.EventRetention
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+
+	return job.CronJob{
+		Name:     "player-events-purge",
+		Schedule: "@daily",
+		Timeout:  10 * time.Minute,
+		Run: func(ctx context.Context) error {
+			n, err := playerEventRepo.DeleteBefore(ctx, time.Now().Add(-retention).Unix())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("player events purge: deleted %d events", n)
+			return nil
+		},
+	}, nil
+}
+
+// NewLeaderboardsSnapshotJob creates the job snapshotting the ended periods
+// of leaderboards to Postgres, and deleting their scores from the store.
+func NewLeaderboardsSnapshotJob(
+	store leaderboard.Store,
+	leaderboardRepo repo.LeaderboardRepo,
+	snapshotRepo repo.LeaderboardSnapshotRepo,
+) job.CronJob {
+	return job.CronJob{
+		Name:     "leaderboards-snapshot",
+		Schedule: "*/5 * * * *",
+		Timeout:  10 * time.Minute,
+		Run: func(ctx context.Context) error {
+			n, err := leaderboard.SnapshotEnded(ctx, store, leaderboardRepo, snapshotRepo, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if n > 0 {
+				log.Printf("leaderboards snapshot: snapshotted %d periods", n)
+			}
+			return nil
+		},
+	}
+}
+
+// NewTradesExpireJob creates the job expiring the pending trades past their
+// expiry, releasing their escrow to their proposers.
+func NewTradesExpireJob(tradeRepo repo.TradeRepo) job.CronJob {
+	return job.CronJob{
+		Name:     "trades-expire",
+		Schedule: "* * * * *",
+		Timeout:  5 * time.Minute,
+		Run: func(ctx context.Context) error {
+			n, err := expireTrades(ctx, tradeRepo, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if n > 0 {
+				log.Printf("trades expire: expired %d trades", n)
+			}
+			return nil
+		},
+	}
+}
+
+// expireBatchSize is the number of trades expired per query.
+const expireBatchSize = 100
+
+// expireTrades expires the pending trades expired at now, each in its own
+// transaction, and returns the number of expired trades. Trades resolved
+// concurrently are skipped.
+func expireTrades(ctx context.Context, tradeRepo repo.TradeRepo, now time.Time) (int, error) {
+	n := 0
+	for {
+		trades, err := tradeRepo.FindExpired(ctx, now.Unix(), expireBatchSize)
+		if err != nil {
+			return n, err
+		}
+
+		expired := 0
+		for _, t := range trades {
+			err := tradeRepo.Release(ctx, t.ID, entity.TradeStatusExpired, now.Unix())
+			if errors.Is(err, repo.ErrConflict) {
+				continue
+			}
+			if err != nil {
+				return n, err
+			}
+			expired++
+		}
+		n += expired
+
+		// a batch of trades all resolved concurrently may be read again from
+		// a lagging replica.
+		if len(trades) < expireBatchSize || expired == 0 {
+			return n, nil
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/outbox"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// relayLock is the name of the lock held by the replica relaying events.
+const relayLock = "outbox-relay"
+
+// RelayConfig configures the OutboxRelay.
+type RelayConfig struct {
+	// PollInterval is how often pending events are polled.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// BatchSize is the max number of events relayed by a poll.
+	BatchSize int `mapstructure:"batch_size"`
+	// MaxAttempts is the number of failed attempts after which an event is
+	// dead.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Backoff is the delay before the second attempt of an event, doubled by
+	// each failure up to MaxBackoff.
+	Backoff    time.Duration `mapstructure:"backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+}
+
+// OutboxRelay publishes pending outbox events to a sink, at least once and in
+// order by aggregate. Replicas may all run it, a lock lets a single one relay
+// at a time.
+type OutboxRelay struct {
+	cfg        RelayConfig
+	client     database.Client
+	outboxRepo repo.OutboxRepo
+	sink       outbox.Sink
+	now        func() time.Time
+}
+
+// NewOutboxRelay creates and returns new instance of OutboxRelay, zero values
+// of cfg are defaulted.
+func NewOutboxRelay(
+	cfg RelayConfig,
+	client database.Client,
+	outboxRepo repo.OutboxRepo,
+	sink outbox.Sink,
+) *OutboxRelay {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 10 * time.Minute
+	}
+
+	return &OutboxRelay{
+		cfg:        cfg,
+		client:     client,
+		outboxRepo: outboxRepo,
+		sink:       sink,
+		now:        time.Now,
+	}
+}
+
+// StartOutboxRelay creates the OutboxRelay configured by the "outbox" keys
+// of v, relaying events to sink and to webhooks, and runs it while the app
+// runs.
+func StartOutboxRelay(
+	lc fx.Lifecycle,
+	v *viper.Viper,
+	client database.Client,
+	outboxRepo repo.OutboxRepo,
+	sink outbox.Sink,
+	webhooks *WebhookFanout,
+) (*OutboxRelay, error) {
+	cfg := struct {
+		Outbox RelayConfig `mapstructure:"outbox"`
+	}{}
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	r := NewOutboxRelay(cfg.Outbox, client, outboxRepo, outbox.MultiSink{sink, webhooks})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				r.Run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+
+	return r, nil
+}
+
+// Run relays events every poll interval until ctx is done, full batches are
+// followed by the next one right away.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	for {
+		n, err := r.RelayBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("outbox relay: %v", err)
+		}
+
+		wait := r.cfg.PollInterval
+		if err == nil && n >= r.cfg.BatchSize {
+			wait = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// RelayBatch publishes a batch of due events, and returns the number of
+// events it attempted. Nothing is relayed while another replica holds the
+// lock, which is held on a connection of its own rather than in a
+// transaction, since publishing to sinks may be slow. Each event is updated
+// right after it is published, so at worst an event is published again.
+//
+// Events are taken in order of id. Once an event of an aggregate fails, or
+// waits for its next attempt, later events of the aggregate wait as well so
+// that consumers see the events of an aggregate in order. Dead events no
+// longer hold their aggregate.
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	unlock, locked, err := database.TrySessionLock(ctx, r.client, relayLock)
+	if err != nil || !locked {
+		return 0, err
+	}
+	defer unlock()
+
+	events, err := r.outboxRepo.FindDue(ctx, r.now().Unix(), r.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	held := make(map[string]bool)
+	for _, e := range events {
+		key := e.AggregateType + "/" + e.AggregateID
+		if held[key] {
+			continue
+		}
+
+		n++
+		now := r.now()
+		if err := r.sink.Publish(ctx, e); err != nil {
+			e.Attempts++
+			e.LastError = err.Error()
+			if e.Attempts >= r.cfg.MaxAttempts {
+				e.Status = entity.OutboxStatusDead
+				log.Printf("outbox relay: event %d %s of %s is dead: %v", e.ID, e.Type, key, err)
+			} else {
+				e.NextAttemptAt = now.Add(utils.Backoff(r.cfg.Backoff, r.cfg.MaxBackoff, e.Attempts)).Unix()
+				held[key] = true
+			}
+		} else {
+			e.Status = entity.OutboxStatusPublished
+			e.PublishedAt = now.Unix()
+		}
+
+		if err := r.outboxRepo.Update(ctx, e); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
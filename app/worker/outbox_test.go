@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+)
+
+type sinkFunc func(ctx context.Context, event *entity.OutboxEvent) error
+
+func (f sinkFunc) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+func TestOutboxRelay_RelayBatch(t *testing.T) {
+	now := time.Unix(1000, 0)
+	errDown := errors.New("sink down")
+
+	tests := []struct {
+		name          string
+		events        []*entity.OutboxEvent
+		failing       map[int64]bool
+		wantPublished []int64
+		wantUpdated   map[int64]*entity.OutboxEvent
+	}{
+		{
+			name: "TC01 - all published - should mark them published in order",
+			events: []*entity.OutboxEvent{
+				{ID: 1, AggregateType: "item", AggregateID: "a"},
+				{ID: 2, AggregateType: "item", AggregateID: "b"},
+				{ID: 3, AggregateType: "item", AggregateID: "a"},
+			},
+			wantPublished: []int64{1, 2, 3},
+			wantUpdated: map[int64]*entity.OutboxEvent{
+				1: {Status: entity.OutboxStatusPublished, PublishedAt: 1000},
+				2: {Status: entity.OutboxStatusPublished, PublishedAt: 1000},
+				3: {Status: entity.OutboxStatusPublished, PublishedAt: 1000},
+			},
+		},
+		{
+			name: "TC02 - failure - should retry later and hold later events of the aggregate",
+			events: []*entity.OutboxEvent{
+				{ID: 1, AggregateType: "item", AggregateID: "a", Attempts: 1},
+				{ID: 2, AggregateType: "item", AggregateID: "b"},
+				{ID: 3, AggregateType: "item", AggregateID: "a"},
+			},
+			failing:       map[int64]bool{1: true},
+			wantPublished: []int64{1, 2},
+			wantUpdated: map[int64]*entity.OutboxEvent{
+				1: {Status: entity.OutboxStatusPending, Attempts: 2, NextAttemptAt: 1002, LastError: "sink down"},
+				2: {Status: entity.OutboxStatusPublished, PublishedAt: 1000},
+			},
+		},
+		{
+			name: "TC03 - failures of several aggregates - should count only attempted events",
+			events: []*entity.OutboxEvent{
+				{ID: 1, AggregateType: "item", AggregateID: "a"},
+				{ID: 2, AggregateType: "item", AggregateID: "b"},
+				{ID: 3, AggregateType: "item", AggregateID: "a"},
+				{ID: 4, AggregateType: "item", AggregateID: "b"},
+			},
+			failing:       map[int64]bool{1: true, 2: true},
+			wantPublished: []int64{1, 2},
+			wantUpdated: map[int64]*entity.OutboxEvent{
+				1: {Status: entity.OutboxStatusPending, Attempts: 1, NextAttemptAt: 1001, LastError: "sink down"},
+				2: {Status: entity.OutboxStatusPending, Attempts: 1, NextAttemptAt: 1001, LastError: "sink down"},
+			},
+		},
+		{
+			name: "TC04 - last attempt failed - should be dead and release the aggregate",
+			events: []*entity.OutboxEvent{
+				{ID: 1, AggregateType: "item", AggregateID: "a", Attempts: 2},
+				{ID: 2, AggregateType: "item", AggregateID: "a"},
+			},
+			failing:       map[int64]bool{1: true},
+			wantPublished: []int64{1, 2},
+			wantUpdated: map[int64]*entity.OutboxEvent{
+				1: {Status: entity.OutboxStatusDead, Attempts: 3, LastError: "sink down"},
+				2: {Status: entity.OutboxStatusPublished, PublishedAt: 1000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := database.New(
+				database.Options.Driver(database.DriverSQLite),
+				database.Options.MasterURI("file:"+t.Name()+"?mode=memory&cache=shared&_fk=1"),
+			)
+			require.NoError(t, err)
+			defer client.Close()
+
+			for _, e := range tt.events {
+				e.Status = entity.OutboxStatusPending
+			}
+
+			outboxRepo := repo.NewMockOutboxRepo(t)
+			outboxRepo.EXPECT().FindDue(mock.Anything, int64(1000), 100).Return(tt.events, nil).Once()
+
+			updated := map[int64]*entity.OutboxEvent{}
+			outboxRepo.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, e *entity.OutboxEvent) error {
+				updated[e.ID] = &entity.OutboxEvent{
+					Status:        e.Status,
+					Attempts:      e.Attempts,
+					NextAttemptAt: e.NextAttemptAt,
+					LastError:     e.LastError,
+					PublishedAt:   e.PublishedAt,
+				}
+				return nil
+			}).Maybe()
+
+			var published []int64
+			sink := sinkFunc(func(_ context.Context, e *entity.OutboxEvent) error {
+				published = append(published, e.ID)
+				if tt.failing[e.ID] {
+					return errDown
+				}
+				return nil
+			})
+
+			r := NewOutboxRelay(RelayConfig{MaxAttempts: 3, Backoff: time.Second}, client, outboxRepo, sink)
+			r.now = func() time.Time { return now }
+
+			n, err := r.RelayBatch(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.wantPublished), n)
+			assert.Equal(t, tt.wantPublished, published)
+			if tt.wantUpdated == nil {
+				tt.wantUpdated = map[int64]*entity.OutboxEvent{}
+			}
+			assert.Equal(t, tt.wantUpdated, updated)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/outbox"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/webhook"
+)
+
+// dispatcherLock is the name of the lock held by the replica dispatching
+// webhook deliveries.
+const dispatcherLock = "webhook-dispatcher"
+
+// WebhookFanout is an outbox.Sink creating the deliveries of events to the
+// webhook subscriptions of their type. Relayed in the transaction of the
+// relay, deliveries are created along with the event being published.
+type WebhookFanout struct {
+	subscriptionRepo repo.WebhookSubscriptionRepo
+	deliveryRepo     repo.WebhookDeliveryRepo
+}
+
+// NewWebhookFanout creates and returns new instance of WebhookFanout.
+func NewWebhookFanout(
+	subscriptionRepo repo.WebhookSubscriptionRepo,
+	deliveryRepo repo.WebhookDeliveryRepo,
+) *WebhookFanout {
+	return &WebhookFanout{
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+	}
+}
+
+// Publish creates the deliveries of event.
+func (f *WebhookFanout) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	subs, err := f.subscriptionRepo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	var deliveries []*entity.WebhookDelivery
+	for _, s := range subs {
+		if !s.Matches(event.Type) {
+			continue
+		}
+
+		if payload == nil {
+			if payload, err = json.Marshal(outbox.NewEnvelope(event)); err != nil {
+				return err
+			}
+		}
+		deliveries = append(deliveries, &entity.WebhookDelivery{
+			SubscriptionID: s.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return f.deliveryRepo.Create(ctx, deliveries)
+}
+
+// WebhookDispatcher attempts due webhook deliveries. Replicas may all run it,
+// a lock lets a single one dispatch at a time.
+type WebhookDispatcher struct {
+	client           database.Client
+	subscriptionRepo repo.WebhookSubscriptionRepo
+	deliveryRepo     repo.WebhookDeliveryRepo
+	deliverer        *webhook.Deliverer
+	now              func() time.Time
+}
+
+// NewWebhookDispatcher creates and returns new instance of WebhookDispatcher.
+func NewWebhookDispatcher(
+	client database.Client,
+	subscriptionRepo repo.WebhookSubscriptionRepo,
+	deliveryRepo repo.WebhookDeliveryRepo,
+	deliverer *webhook.Deliverer,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		client:           client,
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		deliverer:        deliverer,
+		now:              time.Now,
+	}
+}
+
+// StartWebhookDispatcher creates the WebhookDispatcher, and runs it while the
+// app runs.
+func StartWebhookDispatcher(
+	lc fx.Lifecycle,
+	client database.Client,
+	subscriptionRepo repo.WebhookSubscriptionRepo,
+	deliveryRepo repo.WebhookDeliveryRepo,
+	deliverer *webhook.Deliverer,
+) *WebhookDispatcher {
+	d := NewWebhookDispatcher(client, subscriptionRepo, deliveryRepo, deliverer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				d.Run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+
+	return d
+}
+
+// Run dispatches deliveries every poll interval until ctx is done, full
+// batches are followed by the next one right away.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	cfg := d.deliverer.Config()
+	for {
+		n, err := d.DispatchBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Printf("webhook dispatcher: %v", err)
+		}
+
+		wait := cfg.PollInterval
+		if err == nil && n >= cfg.BatchSize {
+			wait = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// DispatchBatch attempts a batch of due deliveries, and returns the number of
+// deliveries attempted. Nothing is dispatched while another replica holds the
+// lock. Deliveries of subscriptions deactivated or deleted meanwhile fail
+// without being attempted.
+func (d *WebhookDispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	var n int
+	err := database.RunInTx(ctx, d.client, func(ctx context.Context) error {
+		locked, err := database.TryLock(ctx, d.client, dispatcherLock)
+		if err != nil || !locked {
+			return err
+		}
+
+		deliveries, err := d.deliveryRepo.FindDue(ctx, d.now().Unix(), d.deliverer.Config().BatchSize)
+		if err != nil {
+			return err
+		}
+		n = len(deliveries)
+
+		subs := make(map[int64]*entity.WebhookSubscription)
+		for _, delivery := range deliveries {
+			sub, ok := subs[delivery.SubscriptionID]
+			if !ok {
+				sub, err = d.subscriptionRepo.FindByID(ctx, delivery.SubscriptionID)
+				if err != nil && !errors.Is(err, repo.ErrNotFound) {
+					return err
+				}
+				subs[delivery.SubscriptionID] = sub
+			}
+
+			if sub == nil || !sub.Active {
+				delivery.Status = entity.WebhookDeliveryStatusFailed
+				delivery.LastError = "subscription is inactive"
+			} else {
+				d.deliverer.Attempt(ctx, sub, delivery, d.now())
+			}
+
+			if err := d.deliveryRepo.Update(ctx, delivery); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return n, err
+}
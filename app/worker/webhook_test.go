@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/webhook"
+)
+
+func TestWebhookDispatcher_DispatchBatch(t *testing.T) {
+	var received []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(webhook.HeaderID))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client, err := database.New(
+		database.Options.Driver(database.DriverSQLite),
+		database.Options.MasterURI("file:"+t.Name()+"?mode=memory&cache=shared&_fk=1"),
+	)
+	require.NoError(t, err)
+	defer client.Close()
+
+	subscriptionRepo := repo.NewMockWebhookSubscriptionRepo(t)
+	deliveryRepo := repo.NewMockWebhookDeliveryRepo(t)
+
+	deliveryRepo.EXPECT().FindDue(mock.Anything, int64(1000), 50).Return([]*entity.WebhookDelivery{
+		{ID: 1, SubscriptionID: 1, Status: entity.WebhookDeliveryStatusPending, Payload: []byte(`{}`)},
+		{ID: 2, SubscriptionID: 2, Status: entity.WebhookDeliveryStatusPending, Payload: []byte(`{}`)},
+		{ID: 3, SubscriptionID: 1, Status: entity.WebhookDeliveryStatusPending, Payload: []byte(`{}`)},
+	}, nil).Once()
+	subscriptionRepo.EXPECT().FindByID(mock.Anything, int64(1)).Return(&entity.WebhookSubscription{
+		ID: 1, URL: srv.URL, Secret: "s3cr3t", Active: true,
+	}, nil).Once()
+	subscriptionRepo.EXPECT().FindByID(mock.Anything, int64(2)).Return(&entity.WebhookSubscription{
+		ID: 2, URL: srv.URL, Secret: "s3cr3t", Active: false,
+	}, nil).Once()
+
+	statuses := map[int64]string{}
+	deliveryRepo.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, d *entity.WebhookDelivery) error {
+		statuses[d.ID] = d.Status
+		return nil
+	}).Times(3)
+
+	d := NewWebhookDispatcher(client, subscriptionRepo, deliveryRepo, webhook.NewDeliverer(webhook.Config{}))
+	d.now = func() time.Time { return time.Unix(1000, 0) }
+
+	n, err := d.DispatchBatch(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"1", "3"}, received)
+	assert.Equal(t, map[int64]string{
+		1: entity.WebhookDeliveryStatusSucceeded,
+		2: entity.WebhookDeliveryStatusFailed,
+		3: entity.WebhookDeliveryStatusSucceeded,
+	}, statuses)
+}
@@ -0,0 +1,157 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+)
+
+// Catalog holds the CachedItems of the published catalog, reloaded when a
+// newer version of the catalog is published. Changes of live events are
+// published as new versions as well.
+type Catalog struct {
+	current atomic.Pointer[CachedItems]
+	// mu serializes reloads and guards onLoad.
+	mu     sync.Mutex
+	onLoad []func(context.Context, *CachedItems)
+
+	client          database.Client
+	itemRepo        repo.ItemRepo
+	translationRepo repo.ItemTranslationRepo
+	liveEventRepo   repo.LiveEventRepo
+	catalogRepo     repo.CatalogRepo
+}
+
+// NewCatalog creates and returns new instance of Catalog holding items, it
+// is never reloaded.
+func NewCatalog(items *CachedItems) *Catalog {
+	c := &Catalog{}
+	c.current.Store(items)
+
+	return c
+}
+
+// LoadCatalog loads the published catalog, and checks for newer versions
+// every catalog.refresh_interval, 5s by default, while the app runs.
+func LoadCatalog(
+	lc fx.Lifecycle,
+	v *viper.Viper,
+	client database.Client,
+	itemRepo repo.ItemRepo,
+	translationRepo repo.ItemTranslationRepo,
+	liveEventRepo repo.LiveEventRepo,
+	catalogRepo repo.CatalogRepo,
+) (*Catalog, error) {
+	c := &Catalog{
+		client:          client,
+		itemRepo:        itemRepo,
+		translationRepo: translationRepo,
+		liveEventRepo:   liveEventRepo,
+		catalogRepo:     catalogRepo,
+	}
+
+	items, err := c.load(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	c.current.Store(items)
+
+	interval := v.GetDuration("catalog.refresh_interval")
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go c.watch(ctx, interval)
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+
+	return c, nil
+}
+
+// OnLoad registers fn to be called with the items of each version of the
+// catalog loaded by Refresh, e.g. to generate artifacts of new versions.
+func (c *Catalog) OnLoad(fn func(ctx context.Context, items *CachedItems)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.onLoad = append(c.onLoad, fn)
+}
+
+// Items returns the items of the published catalog.
+func (c *Catalog) Items() *CachedItems {
+	return c.current.Load()
+}
+
+// Refresh reloads the items when a newer version of the catalog is
+// published. Reads bypass the query cache, and reloads evict the items from
+// it.
+func (c *Catalog) Refresh(ctx context.Context) error {
+	if c.catalogRepo == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	version, err := c.catalogRepo.Version(database.SkipQueryCache(ctx))
+	if err != nil || version <= c.Items().Version {
+		return err
+	}
+
+	items, err := c.load(database.EvictQueryCache(ctx))
+	if err != nil {
+		return err
+	}
+	c.current.Store(items)
+
+	for _, fn := range c.onLoad {
+		fn(ctx, items)
+	}
+
+	return nil
+}
+
+// load loads the items of the current version in a read only transaction.
+func (c *Catalog) load(ctx context.Context) (*CachedItems, error) {
+	var items *CachedItems
+	err := database.RunInTx(ctx, c.client, func(ctx context.Context) error {
+		var err error
+		items, err = LoadAllItems(ctx, c.itemRepo, c.translationRepo, c.liveEventRepo, c.catalogRepo)
+		return err
+	}, database.TxOptions.ReadOnly(), database.TxOptions.Isolation(sql.LevelRepeatableRead))
+
+	return items, err
+}
+
+func (c *Catalog) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Refresh(ctx); err != nil {
+				log.Printf("cache: refreshing catalog: %v", err)
+			}
+		}
+	}
+}
@@ -4,5 +4,5 @@ import "go.uber.org/fx"
 
 // FXModule represents a FX module for cache service.
 var FXModule = fx.Provide(
-	LoadAllItems,
+	LoadCatalog,
 )
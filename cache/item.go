@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"time"
 
 	"github.com/nhatquangsin/game-service/domain/entity"
 	"github.com/nhatquangsin/game-service/domain/repo"
@@ -9,15 +10,34 @@ import (
 
 // CachedItems represent for all items load from db.
 type CachedItems struct {
+	// Version is the version of the catalog the items were loaded at.
+	Version  int64
 	ItemsMap map[string]*entity.Item
 	Items    []*entity.Item
+
+	// Translations maps item id to locale to the translation of the item.
+	Translations map[string]map[string]*entity.ItemTranslation
+
+	// LiveEvents are the live events of the catalog ordered by start.
+	LiveEvents []*entity.LiveEvent
 }
 
-// LoadAllItems load all items from db.
-func LoadAllItems(itemRepo repo.ItemRepo) (*CachedItems, error) {
+// LoadAllItems load all items from db, in a repeatable read transaction of
+// ctx for their version to be the loaded one.
+func LoadAllItems(
+	ctx context.Context,
+	itemRepo repo.ItemRepo,
+	translationRepo repo.ItemTranslationRepo,
+	liveEventRepo repo.LiveEventRepo,
+	catalogRepo repo.CatalogRepo,
+) (*CachedItems, error) {
+	version, err := catalogRepo.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	innerMap := make(map[string]*entity.Item)
 	var inner []*entity.Item
-	ctx := context.Background()
 	items, err := itemRepo.FindAll(ctx)
 	if err != nil {
 		return nil, err
@@ -26,8 +46,153 @@ func LoadAllItems(itemRepo repo.ItemRepo) (*CachedItems, error) {
 		innerMap[item.ID] = item
 		inner = append(inner, item)
 	}
+
+	translations, err := translationRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	translationsMap := make(map[string]map[string]*entity.ItemTranslation)
+	for _, t := range translations {
+		if translationsMap[t.ItemID] == nil {
+			translationsMap[t.ItemID] = make(map[string]*entity.ItemTranslation)
+		}
+		translationsMap[t.ItemID][t.Locale] = t
+	}
+
+	liveEvents, err := liveEventRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CachedItems{
-		ItemsMap: innerMap,
-		Items:    inner,
+		Version:      version,
+		ItemsMap:     innerMap,
+		Items:        inner,
+		Translations: translationsMap,
+		LiveEvents:   liveEvents,
 	}, nil
 }
+
+// Localize returns a copy of the item whose name and description are taken,
+// field by field, from the first locale of the chain that translates them.
+// Untranslated fields keep the item's own value.
+func (c *CachedItems) Localize(item *entity.Item, locales []string) *entity.Item {
+	res := *item
+	byLocale := c.Translations[item.ID]
+	var hasName, hasDescription bool
+	for _, l := range locales {
+		t, ok := byLocale[l]
+		if !ok {
+			continue
+		}
+
+		if !hasName && t.Name != "" {
+			res.Name, hasName = t.Name, true
+		}
+		if !hasDescription && t.Description != "" {
+			res.Description, hasDescription = t.Description, true
+		}
+	}
+
+	return &res
+}
+
+// UnavailableAt returns the ids of the items unavailable at t: items of live
+// events none of which is active at t. Other items are always available.
+func (c *CachedItems) UnavailableAt(t time.Time) map[string]bool {
+	res := make(map[string]bool)
+	active := make(map[string]bool)
+	for _, e := range c.LiveEvents {
+		on := e.ActiveAt(t)
+		for _, id := range e.ItemIDs {
+			if on {
+				active[id] = true
+				delete(res, id)
+			} else if !active[id] {
+				res[id] = true
+			}
+		}
+	}
+
+	return res
+}
+
+// Without returns a copy of the items without those of ids.
+func (c *CachedItems) Without(ids map[string]bool) *CachedItems {
+	if len(ids) == 0 {
+		return c
+	}
+
+	res := *c
+	res.ItemsMap = make(map[string]*entity.Item, len(c.ItemsMap))
+	res.Items = make([]*entity.Item, 0, len(c.Items))
+	for _, i := range c.Items {
+		if !ids[i.ID] {
+			res.ItemsMap[i.ID] = i
+			res.Items = append(res.Items, i)
+		}
+	}
+
+	return &res
+}
+
+// WithChanges returns a copy of the items with the changes of a changeset
+// applied, to preview the changeset. Updated items keep their position,
+// created ones are appended.
+func (c *CachedItems) WithChanges(changes []*entity.ChangesetChange) *CachedItems {
+	res := &CachedItems{
+		Version:      c.Version,
+		ItemsMap:     make(map[string]*entity.Item, len(c.ItemsMap)),
+		Translations: make(map[string]map[string]*entity.ItemTranslation, len(c.Translations)),
+		LiveEvents:   c.LiveEvents,
+	}
+	for id, t := range c.Translations {
+		res.Translations[id] = t
+	}
+
+	byID := make(map[string]*entity.ChangesetChange, len(changes))
+	for _, change := range changes {
+		byID[change.ItemID] = change
+	}
+
+	add := func(i *entity.Item) {
+		res.ItemsMap[i.ID] = i
+		res.Items = append(res.Items, i)
+	}
+	for _, i := range c.Items {
+		change, ok := byID[i.ID]
+		if !ok {
+			add(i)
+			continue
+		}
+
+		delete(byID, i.ID)
+		if change.Op == entity.ChangeOpDelete {
+			delete(res.Translations, i.ID)
+			continue
+		}
+		add(change.Item)
+		res.setTranslations(change)
+	}
+
+	for _, change := range changes {
+		if _, ok := byID[change.ItemID]; ok && change.Op == entity.ChangeOpUpsert {
+			add(change.Item)
+			res.setTranslations(change)
+		}
+	}
+
+	return res
+}
+
+func (c *CachedItems) setTranslations(change *entity.ChangesetChange) {
+	if change.Translations == nil {
+		return
+	}
+
+	byLocale := make(map[string]*entity.ItemTranslation, len(change.Translations))
+	for _, t := range change.Translations {
+		byLocale[t.Locale] = t
+	}
+	c.Translations[change.ItemID] = byLocale
+}
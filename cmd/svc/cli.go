@@ -1,9 +1,20 @@
 package main
 
 import (
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/urfave/cli/v2"
+
+	"github.com/nhatquangsin/game-service/app/api"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/migration"
+	"github.com/nhatquangsin/game-service/infra/repo/seed"
 )
 
 // List of common keys used in service.
@@ -30,6 +41,11 @@ func newApp() *cli.App {
 	}
 	app.Commands = []*cli.Command{
 		runCmd,
+		translationsCmd,
+		catalogCmd,
+		migrateCmd,
+		seedCmd,
+		outboxCmd,
 	}
 
 	return app
@@ -40,6 +56,7 @@ var runCmd = &cli.Command{
 	Usage: "Runs a component in service",
 	Subcommands: []*cli.Command{
 		runAPICmd,
+		runWorkerCmd,
 	},
 }
 
@@ -57,3 +74,518 @@ var runAPICmd = &cli.Command{
 		return nil
 	},
 }
+
+var runWorkerCmd = &cli.Command{
+	Name:  "worker",
+	Usage: "Runs worker component in service, it relays outbox events",
+	Flags: []cli.Flag{},
+	Action: func(c *cli.Context) error {
+		if err := os.Setenv(EnvKeyServiceComponent, "worker"); err != nil {
+			return err
+		}
+		app := newWorkerApp()
+		app.Run()
+
+		return nil
+	},
+}
+
+var translationsCmd = &cli.Command{
+	Name:  "translations",
+	Usage: "Imports or exports item translations",
+	Subcommands: []*cli.Command{
+		translationsExportCmd,
+		translationsImportCmd,
+	},
+}
+
+var translationsExportCmd = &cli.Command{
+	Name:  "export",
+	Usage: "Exports item translations as CSV or XLIFF",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "File format: csv, xliff",
+			Value: api.TranslationFormatCSV,
+		},
+		&cli.StringFlag{
+			Name:  "locale",
+			Usage: "Locale to export, required for xliff",
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "File to write to, defaults to stdout",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var svc api.TranslationService
+		app, err := newCommandApp(c.Context, &svc)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		var w io.Writer = os.Stdout
+		if path := c.String("output"); path != "" {
+			f, err := os.Create(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+
+		return svc.ExportTranslations(c.Context, &api.ExportTranslationsRequest{
+			Format: c.String("format"),
+			Locale: c.String("locale"),
+			Writer: w,
+		})
+	},
+}
+
+var translationsImportCmd = &cli.Command{
+	Name:  "import",
+	Usage: "Imports item translations from CSV or XLIFF",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "File format: csv, xliff",
+			Value: api.TranslationFormatCSV,
+		},
+		&cli.StringFlag{
+			Name:     "input",
+			Usage:    "File to read from",
+			Required: true,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var svc api.TranslationService
+		app, err := newCommandApp(c.Context, &svc)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		f, err := os.Open(c.String("input"))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		res, err := svc.ImportTranslations(c.Context, &api.ImportTranslationsRequest{
+			Format: c.String("format"),
+			Reader: f,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range res.Errors {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		fmt.Printf("imported %d translations, rejected %d\n", res.Imported, len(res.Errors))
+
+		return nil
+	},
+}
+
+var catalogCmd = &cli.Command{
+	Name:  "catalog",
+	Usage: "Imports or exports the item catalog",
+	Subcommands: []*cli.Command{
+		catalogExportCmd,
+		catalogImportCmd,
+	},
+}
+
+var catalogExportCmd = &cli.Command{
+	Name:  "export",
+	Usage: "Exports all items as JSON Lines, CSV or YAML",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "File format: jsonl, csv, yaml",
+			Value: api.CatalogFormatJSONL,
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "File to write to, defaults to stdout",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var svc api.CatalogService
+		app, err := newCommandApp(c.Context, &svc)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		var w io.Writer = os.Stdout
+		if path := c.String("output"); path != "" {
+			f, err := os.Create(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+
+		return svc.ExportCatalog(c.Context, &api.ExportCatalogRequest{
+			Format: c.String("format"),
+			Writer: w,
+		})
+	},
+}
+
+var catalogImportCmd = &cli.Command{
+	Name:  "import",
+	Usage: "Imports items from JSON Lines, CSV or YAML",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "File format: jsonl, csv, yaml",
+			Value: api.CatalogFormatJSONL,
+		},
+		&cli.StringFlag{
+			Name:     "input",
+			Usage:    "File to read from",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "mode",
+			Usage: "upsert keeps items missing from the file, replace deletes them",
+			Value: api.CatalogImportModeUpsert,
+		},
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "Prints the changes without applying them",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var svc api.CatalogService
+		app, err := newCommandApp(c.Context, &svc)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		f, err := os.Open(c.String("input"))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		res, err := svc.ImportCatalog(c.Context, &api.ImportCatalogRequest{
+			Format: c.String("format"),
+			Mode:   c.String("mode"),
+			DryRun: c.Bool("dry-run"),
+			Reader: f,
+		})
+		if err != nil {
+			return err
+		}
+
+		printCatalogImport(res)
+		if len(res.Errors) > 0 {
+			return cli.Exit(fmt.Sprintf("%d invalid records, nothing applied", len(res.Errors)), 1)
+		}
+
+		return nil
+	},
+}
+
+// printCatalogImport prints the diff and validation report of a catalog import.
+func printCatalogImport(res *api.ImportCatalogResponse) {
+	symbols := map[string]string{
+		api.CatalogChangeCreate: "+",
+		api.CatalogChangeUpdate: "~",
+		api.CatalogChangeDelete: "-",
+	}
+	for _, ch := range res.Changes {
+		fmt.Printf("%s %s\n", symbols[ch.Op], ch.ItemID)
+		for _, f := range ch.Fields {
+			fmt.Printf("    %s: %q -> %q\n", f.Field, f.Old, f.New)
+		}
+	}
+
+	for _, e := range res.Errors {
+		fmt.Fprintf(os.Stderr, "record %d (%s): %s\n", e.Record, e.ItemID, e.Message)
+	}
+
+	fmt.Printf(
+		"created %d, updated %d, deleted %d, unchanged %d, applied %t\n",
+		res.Created, res.Updated, res.Deleted, res.Unchanged, res.Applied,
+	)
+}
+
+var migrateCmd = &cli.Command{
+	Name:  "migrate",
+	Usage: "Manages the database schema with versioned migrations",
+	Subcommands: []*cli.Command{
+		migrateUpCmd,
+		migrateDownCmd,
+		migrateStatusCmd,
+		migrateDiffCmd,
+	},
+}
+
+var migrateUpCmd = &cli.Command{
+	Name:  "up",
+	Usage: "Applies pending migrations",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "n",
+			Usage: "Number of migrations to apply, all pending when 0",
+		},
+		&cli.StringFlag{
+			Name:  "baseline",
+			Usage: "Version matching the schema of a database migrated by hand",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var m *migration.Migrator
+		app, err := newCommandApp(c.Context, &m)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		files, err := m.Up(c.Context, c.Int("n"), c.String("baseline"))
+		if err != nil {
+			return err
+		}
+
+		for _, f := range files {
+			fmt.Printf("applied %s\n", f.Name())
+		}
+		fmt.Printf("%d migrations applied\n", len(files))
+
+		return nil
+	},
+}
+
+var migrateDownCmd = &cli.Command{
+	Name:  "down",
+	Usage: "Reverts the last applied migrations",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "n",
+			Usage: "Number of migrations to revert",
+			Value: 1,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var m *migration.Migrator
+		app, err := newCommandApp(c.Context, &m)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		revs, err := m.Down(c.Context, c.Int("n"))
+		if err != nil {
+			return err
+		}
+
+		for _, r := range revs {
+			fmt.Printf("reverted %s_%s\n", r.Version, r.Description)
+		}
+		fmt.Printf("%d migrations reverted\n", len(revs))
+
+		return nil
+	},
+}
+
+var migrateStatusCmd = &cli.Command{
+	Name:  "status",
+	Usage: "Prints applied and pending migrations",
+	Action: func(c *cli.Context) error {
+		var m *migration.Migrator
+		app, err := newCommandApp(c.Context, &m)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		status, err := m.Status(c.Context)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range status.Applied {
+			fmt.Printf("applied  %s_%s at %s\n", r.Version, r.Description, r.ExecutedAt.Format(time.RFC3339))
+		}
+		for _, f := range status.Pending {
+			fmt.Printf("pending  %s\n", f.Name())
+		}
+		if status.Dirty {
+			fmt.Println("database has tables but no applied migration, run \"migrate up --baseline <version>\"")
+		}
+		fmt.Printf("current version: %q, %d pending\n", status.Current, len(status.Pending))
+
+		return nil
+	},
+}
+
+var migrateDiffCmd = &cli.Command{
+	Name:      "diff",
+	Usage:     "Writes a migration file for the changes of the ent schema",
+	ArgsUsage: "<name>",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "dev-url",
+			Usage:    "URL of an empty database to replay migrations on",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "dir",
+			Usage: "Directory of the migration files",
+			Value: "./migrations",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		if c.NArg() != 1 {
+			return cli.Exit("migration name is required", 1)
+		}
+
+		m, err := migration.New(nil, migration.Options.Dir(c.String("dir")))
+		if err != nil {
+			return err
+		}
+
+		return m.Diff(c.Context, c.String("dev-url"), c.Args().First())
+	},
+}
+
+var seedCmd = &cli.Command{
+	Name:  "seed",
+	Usage: "Loads fixture data for local and test environments",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "profile",
+			Usage: "Fixture profile: minimal, demo, load-test",
+			Value: seed.ProfileMinimal,
+		},
+		&cli.StringFlag{
+			Name:  "file",
+			Usage: "YAML fixture file to load instead of a profile",
+		},
+		&cli.IntFlag{
+			Name:  "count",
+			Usage: "Number of items generated by the load-test profile",
+			Value: 1000,
+		},
+		&cli.BoolFlag{
+			Name:  "truncate",
+			Usage: "Deletes existing data before loading",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var f *seed.Fixture
+		var err error
+		if path := c.String("file"); path != "" {
+			f, err = seed.LoadFile(path)
+		} else {
+			f, err = seed.Profile(c.String("profile"), c.Int("count"))
+		}
+		if err != nil {
+			return err
+		}
+
+		var client database.Client
+		app, err := newCommandApp(c.Context, &client)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		if c.Bool("truncate") {
+			if err := seed.Truncate(c.Context, client.Master(c.Context)); err != nil {
+				return err
+			}
+		}
+
+		if err := seed.Load(c.Context, client.Master(c.Context), f); err != nil {
+			return err
+		}
+		fmt.Printf("seeded %d items\n", len(f.Items))
+
+		return nil
+	},
+}
+
+var outboxCmd = &cli.Command{
+	Name:  "outbox",
+	Usage: "Inspects and requeues outbox events",
+	Subcommands: []*cli.Command{
+		outboxListCmd,
+		outboxRequeueCmd,
+	},
+}
+
+var outboxListCmd = &cli.Command{
+	Name:  "list",
+	Usage: "Lists outbox events by status",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "status",
+			Usage: "Status of events: pending, published, dead",
+			Value: entity.OutboxStatusDead,
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Usage: "Max number of events",
+			Value: 100,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		var outboxRepo repo.OutboxRepo
+		app, err := newCommandApp(c.Context, &outboxRepo)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		events, err := outboxRepo.FindByStatus(c.Context, c.String("status"), c.Int("limit"))
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			fmt.Printf("%d\t%s\t%s/%s\tattempts=%d\t%s\n", e.ID, e.Type, e.AggregateType, e.AggregateID, e.Attempts, e.LastError)
+		}
+
+		return nil
+	},
+}
+
+var outboxRequeueCmd = &cli.Command{
+	Name:      "requeue",
+	Usage:     "Makes dead outbox events pending again, all of them without ids",
+	ArgsUsage: "[id...]",
+	Action: func(c *cli.Context) error {
+		ids := make([]int64, 0, c.NArg())
+		for _, arg := range c.Args().Slice() {
+			id, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid event id %q", arg)
+			}
+			ids = append(ids, id)
+		}
+
+		var outboxRepo repo.OutboxRepo
+		app, err := newCommandApp(c.Context, &outboxRepo)
+		if err != nil {
+			return err
+		}
+		defer app.Stop(c.Context)
+
+		n, err := outboxRepo.Requeue(c.Context, ids)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d events requeued\n", n)
+
+		return nil
+	},
+}
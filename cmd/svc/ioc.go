@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
+
 	"go.uber.org/fx"
 
 	"github.com/nhatquangsin/game-service/app/api/impl"
 	transporthttp "github.com/nhatquangsin/game-service/app/api/transport/http"
+	"github.com/nhatquangsin/game-service/app/worker"
 	"github.com/nhatquangsin/game-service/cache"
+	"github.com/nhatquangsin/game-service/infra/auth"
 	"github.com/nhatquangsin/game-service/infra/config"
+	"github.com/nhatquangsin/game-service/infra/leaderboard"
 	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/migration"
 	"github.com/nhatquangsin/game-service/infra/repo/repoimpl"
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+	redisutil "github.com/nhatquangsin/game-service/infra/utils/redis"
 	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+	"github.com/nhatquangsin/game-service/infra/webhook"
 )
 
 // svcFXModule represents a FX module for the service.
@@ -17,17 +26,55 @@ var svcFXModule = fx.Options(
 	config.FXModule,
 	repoimpl.FXModule,
 	database.FXModule,
+	migration.FXModule,
 	impl.FXModule,
 	viperutil.FXModule,
-	transporthttp.ServerFXModule,
 	cache.FXModule,
+	endpoint.FXModule,
+	redisutil.FXModule,
+	auth.FXModule,
+	webhook.FXModule,
+	leaderboard.FXModule,
+	worker.JobsFXModule,
 )
 
 func newAPIApp() *fx.App {
 	app := fx.New(
 		svcFXModule,
 		// Add API dependencies here.
+		migration.AutoMigrateFXModule,
+		transporthttp.ServerFXModule,
+	)
+
+	return app
+}
+
+func newWorkerApp() *fx.App {
+	app := fx.New(
+		svcFXModule,
+		// Add worker dependencies here.
+		worker.FXModule,
 	)
 
 	return app
 }
+
+// newCommandApp creates an app for one-off commands, it populates targets
+// with dependencies of the service and starts the app. The caller must stop
+// the returned app once done.
+func newCommandApp(ctx context.Context, targets ...interface{}) (*fx.App, error) {
+	app := fx.New(
+		svcFXModule,
+		fx.NopLogger,
+		fx.Populate(targets...),
+	)
+	if err := app.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := app.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	return app, nil
+}
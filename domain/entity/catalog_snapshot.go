@@ -0,0 +1,11 @@
+package entity
+
+// CatalogSnapshot defines data model for the full catalog of a version.
+type CatalogSnapshot struct {
+	Version int64 `json:"version"`
+	// Hash is the hex encoded SHA-256 of the uncompressed JSON snapshot.
+	Hash string `json:"hash"`
+	// Data is the gzip compressed JSON snapshot.
+	Data      []byte `json:"-"`
+	CreatedAt int64  `json:"createdAt"`
+}
@@ -0,0 +1,48 @@
+package entity
+
+// Statuses of a Changeset, changesets go from draft to in review, then are
+// approved and published, or rejected and edited again as drafts.
+const (
+	ChangesetStatusDraft     = "draft"
+	ChangesetStatusInReview  = "in_review"
+	ChangesetStatusApproved  = "approved"
+	ChangesetStatusRejected  = "rejected"
+	ChangesetStatusPublished = "published"
+)
+
+// Operations of a ChangesetChange.
+const (
+	ChangeOpUpsert = "upsert"
+	ChangeOpDelete = "delete"
+)
+
+// Changeset defines data model for a set of catalog changes reviewed and
+// published together.
+type Changeset struct {
+	ID     int64  `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	Author string `json:"author"`
+	// Editors are the principals who edited the changeset, the author first,
+	// none of them can review it.
+	Editors  []string `json:"editors,omitempty"`
+	Reviewer string   `json:"reviewer,omitempty"`
+	// CatalogVersion is the version of the catalog the changeset was
+	// published as.
+	CatalogVersion int64              `json:"catalogVersion,omitempty"`
+	Changes        []*ChangesetChange `json:"changes,omitempty"`
+	CreatedAt      int64              `json:"createdAt"`
+	UpdatedAt      int64              `json:"updatedAt"`
+}
+
+// ChangesetChange defines data model for the change of an item in a
+// Changeset.
+type ChangesetChange struct {
+	ItemID string `json:"itemID"`
+	Op     string `json:"op"`
+	// Item is the new version of the item of upserts.
+	Item *Item `json:"item,omitempty"`
+	// Translations replace those of the item of upserts, they are left
+	// untouched when nil.
+	Translations []*ItemTranslation `json:"translations,omitempty"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+// InventoryItem defines data model for the quantity of an item owned by a
+// player.
+type InventoryItem struct {
+	PlayerID  string `json:"playerID"`
+	ItemID    string `json:"itemID"`
+	Quantity  int    `json:"quantity"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// InventoryGrant is a quantity of an item granted to a player.
+type InventoryGrant struct {
+	ItemID   string `json:"itemID"`
+	Quantity int    `json:"quantity"`
+}
@@ -0,0 +1,48 @@
+package entity
+
+// Statuses of an ItemInstance.
+const (
+	ItemInstanceStatusActive = "active"
+	ItemInstanceStatusBurned = "burned"
+)
+
+// Actions of an ItemInstanceHistory.
+const (
+	ItemInstanceActionMint     = "mint"
+	ItemInstanceActionTransfer = "transfer"
+	ItemInstanceActionBurn     = "burn"
+)
+
+// ItemInstance defines data model for a non fungible copy of an item of the
+// catalog, e.g. a weapon with random rolls, owned by a player. Burned
+// instances are kept along with their history.
+type ItemInstance struct {
+	// ID is a UUID unique to the instance.
+	ID            string `json:"id"`
+	ItemID        string `json:"itemID"`
+	OwnerID       string `json:"ownerID"`
+	Durability    int    `json:"durability"`
+	MaxDurability int    `json:"maxDurability"`
+	// Stats are the properties rolled when the instance was minted.
+	Stats  map[string]any `json:"stats,omitempty"`
+	Status string         `json:"status"`
+	// BurnedAt is 0 while the instance is active.
+	BurnedAt  int64 `json:"burnedAt,omitempty"`
+	CreatedAt int64 `json:"createdAt"`
+	UpdatedAt int64 `json:"updatedAt"`
+}
+
+// ItemInstanceHistory defines data model for the mint, a transfer or the
+// burn of an item instance. FromPlayerID is empty for mints, ToPlayerID for
+// burns.
+type ItemInstanceHistory struct {
+	ID           int64  `json:"id"`
+	InstanceID   string `json:"instanceID"`
+	Action       string `json:"action"`
+	FromPlayerID string `json:"fromPlayerID,omitempty"`
+	ToPlayerID   string `json:"toPlayerID,omitempty"`
+	// Actor is the principal which made the change.
+	Actor     string `json:"actor"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
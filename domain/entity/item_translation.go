@@ -0,0 +1,10 @@
+package entity
+
+// ItemTranslation defines data model for a localized name and description of
+// an Item in a specific locale.
+type ItemTranslation struct {
+	ItemID      string `json:"itemID"`
+	Locale      string `json:"locale"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,55 @@
+package entity
+
+// Triggers of a JobRun.
+const (
+	JobTriggerCron  = "cron"
+	JobTriggerQueue = "queue"
+)
+
+// Statuses of a JobRun.
+const (
+	JobRunStatusRunning   = "running"
+	JobRunStatusSucceeded = "succeeded"
+	JobRunStatusFailed    = "failed"
+)
+
+// Statuses of a JobTask.
+const (
+	JobTaskStatusPending = "pending"
+	JobTaskStatusDone    = "done"
+	// JobTaskStatusFailed is the status of tasks which failed too many
+	// times.
+	JobTaskStatusFailed = "failed"
+)
+
+// JobRun defines data model for a run of a background job.
+type JobRun struct {
+	ID      int64  `json:"id"`
+	Job     string `json:"job"`
+	Trigger string `json:"trigger"`
+	// TaskID is the task handled by queue runs.
+	TaskID int64  `json:"taskID,omitempty"`
+	Status string `json:"status"`
+	// Node is the replica the job ran on.
+	Node string `json:"node"`
+	// ScheduledAt is the time cron runs were scheduled at.
+	ScheduledAt int64  `json:"scheduledAt,omitempty"`
+	StartedAt   int64  `json:"startedAt"`
+	FinishedAt  int64  `json:"finishedAt,omitempty"`
+	DurationMs  int64  `json:"durationMs"`
+	Error       string `json:"error,omitempty"`
+}
+
+// JobTask defines data model for a task enqueued for a queue job, it is
+// handled at least once from RunAt.
+type JobTask struct {
+	ID        int64  `json:"id"`
+	Queue     string `json:"queue"`
+	Payload   []byte `json:"-"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	RunAt     int64  `json:"runAt"`
+	LastError string `json:"lastError,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Score policies of a Leaderboard.
+const (
+	// LeaderboardPolicyBest keeps the best score submitted by a player.
+	LeaderboardPolicyBest = "best"
+	// LeaderboardPolicySum adds up the scores submitted by a player.
+	LeaderboardPolicySum = "sum"
+	// LeaderboardPolicyLatest keeps the latest score submitted by a player.
+	LeaderboardPolicyLatest = "latest"
+)
+
+// Resets of a Leaderboard.
+const (
+	LeaderboardResetDaily  = "daily"
+	LeaderboardResetWeekly = "weekly"
+	// LeaderboardResetSeason periods are the live events of kind season.
+	LeaderboardResetSeason = "season"
+)
+
+// Leaderboard defines data model for the ranking of players by the scores
+// of a metric, reset every period.
+type Leaderboard struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Metric string `json:"metric"`
+	Policy string `json:"policy"`
+	Reset  string `json:"reset"`
+	// Timezone is the IANA time zone daily and weekly periods start at
+	// midnight in, weeks starting on Monday.
+	Timezone  string `json:"timezone"`
+	CreatedBy string `json:"createdBy,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// LeaderboardPeriod is a period of a leaderboard, from Start included to End
+// excluded in unix seconds.
+type LeaderboardPeriod struct {
+	// Key identifies the period in its leaderboard, e.g. "2026-10-19",
+	// "2026-W42" or the id of a season.
+	Key   string `json:"key"`
+	Start int64  `json:"start"`
+	End   int64  `json:"end"`
+}
+
+// PeriodAt returns the period of the leaderboard at t, seasons being the
+// live events to find the season of t in. It returns false for seasonal
+// leaderboards out of seasons.
+func (l *Leaderboard) PeriodAt(t time.Time, seasons []*LiveEvent) (*LeaderboardPeriod, bool) {
+	loc, err := time.LoadLocation(l.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	switch l.Reset {
+	case LeaderboardResetDaily:
+		return &LeaderboardPeriod{
+			Key:   midnight.Format(time.DateOnly),
+			Start: midnight.Unix(),
+			End:   midnight.AddDate(0, 0, 1).Unix(),
+		}, true
+	case LeaderboardResetWeekly:
+		monday := midnight.AddDate(0, 0, -(int(midnight.Weekday())+6)%7)
+		year, week := monday.ISOWeek()
+		return &LeaderboardPeriod{
+			Key:   fmt.Sprintf("%d-W%02d", year, week),
+			Start: monday.Unix(),
+			End:   monday.AddDate(0, 0, 7).Unix(),
+		}, true
+	case LeaderboardResetSeason:
+		var season *LiveEvent
+		for _, e := range seasons {
+			if e.Kind == LiveEventKindSeason && e.ActiveAt(t) && (season == nil || e.StartAt > season.StartAt) {
+				season = e
+			}
+		}
+		if season == nil {
+			return nil, false
+		}
+		return &LeaderboardPeriod{Key: season.ID, Start: season.StartAt, End: season.EndAt}, true
+	}
+
+	return nil, false
+}
+
+// LeaderboardEntry is the score and rank of a player in a period of a
+// leaderboard, ranks starting at 1.
+type LeaderboardEntry struct {
+	Rank     int    `json:"rank"`
+	PlayerID string `json:"playerID"`
+	Score    int64  `json:"score"`
+}
+
+// LeaderboardSnapshot defines data model for the final ranking of an ended
+// period of a leaderboard.
+type LeaderboardSnapshot struct {
+	ID            int64               `json:"id"`
+	LeaderboardID string              `json:"leaderboardID"`
+	Period        *LeaderboardPeriod  `json:"period"`
+	Entries       []*LeaderboardEntry `json:"entries"`
+	CreatedAt     int64               `json:"createdAt"`
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderboard_PeriodAt(t *testing.T) {
+	seasons := []*LiveEvent{
+		{ID: "s1", Kind: LiveEventKindSeason, StartAt: 1790000000, EndAt: 1795000000},
+		{ID: "e1", Kind: LiveEventKindEvent, StartAt: 1790000000, EndAt: 1795000000},
+	}
+
+	tests := []struct {
+		name    string
+		reset   string
+		tz      string
+		at      time.Time
+		want    *LeaderboardPeriod
+		wantNil bool
+	}{
+		{
+			name:  "TC01 - daily in Tokyo before UTC midnight - should start at Tokyo midnight",
+			reset: LeaderboardResetDaily,
+			tz:    "Asia/Tokyo",
+			at:    time.Date(2026, 10, 19, 16, 30, 0, 0, time.UTC),
+			want: &LeaderboardPeriod{
+				Key:   "2026-10-20",
+				Start: time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC).Unix(),
+				End:   time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC).Unix(),
+			},
+		},
+		{
+			name:  "TC02 - daily on a DST change - should last 25 hours",
+			reset: LeaderboardResetDaily,
+			tz:    "America/New_York",
+			at:    time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC),
+			want: &LeaderboardPeriod{
+				Key:   "2026-11-01",
+				Start: time.Date(2026, 11, 1, 4, 0, 0, 0, time.UTC).Unix(),
+				End:   time.Date(2026, 11, 2, 5, 0, 0, 0, time.UTC).Unix(),
+			},
+		},
+		{
+			name:  "TC03 - weekly on Sunday - should start on Monday",
+			reset: LeaderboardResetWeekly,
+			tz:    "UTC",
+			at:    time.Date(2026, 10, 25, 23, 0, 0, 0, time.UTC),
+			want: &LeaderboardPeriod{
+				Key:   "2026-W43",
+				Start: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC).Unix(),
+				End:   time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC).Unix(),
+			},
+		},
+		{
+			name:  "TC04 - season - should be the active season",
+			reset: LeaderboardResetSeason,
+			tz:    "UTC",
+			at:    time.Unix(1792000000, 0),
+			want:  &LeaderboardPeriod{Key: "s1", Start: 1790000000, End: 1795000000},
+		},
+		{
+			name:    "TC05 - out of seasons - should have no period",
+			reset:   LeaderboardResetSeason,
+			tz:      "UTC",
+			at:      time.Unix(1796000000, 0),
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &Leaderboard{Reset: tt.reset, Timezone: tt.tz}
+			got, ok := l.PeriodAt(tt.at, seasons)
+			if tt.wantNil {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// Kinds of a LiveEvent.
+const (
+	LiveEventKindEvent  = "event"
+	LiveEventKindSeason = "season"
+)
+
+// LiveEvent defines data model for a live-ops event or season, its items are
+// only available from StartAt until EndAt.
+type LiveEvent struct {
+	ID          string `json:"id"`
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Timezone is the IANA time zone the event is scheduled in.
+	Timezone  string   `json:"timezone"`
+	StartAt   int64    `json:"startAt"`
+	EndAt     int64    `json:"endAt"`
+	ItemIDs   []string `json:"itemIDs"`
+	CreatedBy string   `json:"createdBy,omitempty"`
+	CreatedAt int64    `json:"createdAt"`
+	UpdatedAt int64    `json:"updatedAt"`
+}
+
+// ActiveAt reports whether the event runs at t, its end is excluded.
+func (e *LiveEvent) ActiveAt(t time.Time) bool {
+	return e.StartAt <= t.Unix() && t.Unix() < e.EndAt
+}
@@ -0,0 +1,71 @@
+package entity
+
+import "fmt"
+
+// Sides of a MarketOrder.
+const (
+	MarketSideSell = "sell"
+	MarketSideBuy  = "buy"
+)
+
+// Statuses of a MarketOrder.
+const (
+	MarketOrderStatusOpen      = "open"
+	MarketOrderStatusFilled    = "filled"
+	MarketOrderStatusCancelled = "cancelled"
+)
+
+// MarketOrder defines data model for an order of a player on the
+// marketplace: a sell listing of an item, or a buy order, at a price per
+// unit in the currency of the marketplace. The listed items of sell orders,
+// and the price of the remaining quantity of buy orders, are held in escrow
+// while the order is open.
+type MarketOrder struct {
+	ID       int64  `json:"id"`
+	PlayerID string `json:"playerID"`
+	// Side is sell or buy.
+	Side     string `json:"side"`
+	ItemID   string `json:"itemID"`
+	Currency string `json:"currency"`
+	Price    int64  `json:"price"`
+	Quantity int64  `json:"quantity"`
+	// Remaining is the quantity not filled yet.
+	Remaining int64  `json:"remaining"`
+	Status    string `json:"status"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// Reference returns the reference of the ledger entries of the order.
+func (o *MarketOrder) Reference() string {
+	return fmt.Sprintf("market_order:%d", o.ID)
+}
+
+// MarketFill defines data model for a match of a sell and a buy order of
+// an item, executed at the price of the order placed first.
+type MarketFill struct {
+	ID       int64  `json:"id"`
+	ItemID   string `json:"itemID"`
+	Currency string `json:"currency"`
+	Price    int64  `json:"price"`
+	Quantity int64  `json:"quantity"`
+	// Fee is charged to the seller out of the price of the fill.
+	Fee         int64  `json:"fee"`
+	SellOrderID int64  `json:"sellOrderID"`
+	BuyOrderID  int64  `json:"buyOrderID"`
+	SellerID    string `json:"sellerID"`
+	BuyerID     string `json:"buyerID"`
+	CreatedAt   int64  `json:"createdAt"`
+}
+
+// MarketCandle defines data model for the fills of an item within an
+// interval starting at Start, in unix seconds.
+type MarketCandle struct {
+	Start  int64 `json:"start"`
+	Open   int64 `json:"open"`
+	High   int64 `json:"high"`
+	Low    int64 `json:"low"`
+	Close  int64 `json:"close"`
+	Volume int64 `json:"volume"`
+	Fills  int   `json:"fills"`
+}
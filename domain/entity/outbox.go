@@ -0,0 +1,87 @@
+package entity
+
+// Types of the aggregates of domain events.
+const (
+	AggregateItem         = "item"
+	AggregateCatalog      = "catalog"
+	AggregatePlayer       = "player"
+	AggregateItemInstance = "item_instance"
+)
+
+// Types of domain events.
+const (
+	EventItemCreated             = "ItemCreated"
+	EventItemUpdated             = "ItemUpdated"
+	EventItemDeleted             = "ItemDeleted"
+	EventCatalogPublished        = "CatalogPublished"
+	EventQuestCompleted          = "QuestCompleted"
+	EventInventoryGranted        = "InventoryGranted"
+	EventItemInstanceMinted      = "ItemInstanceMinted"
+	EventItemInstanceTransferred = "ItemInstanceTransferred"
+	EventItemInstanceBurned      = "ItemInstanceBurned"
+)
+
+// Statuses of an OutboxEvent.
+const (
+	OutboxStatusPending   = "pending"
+	OutboxStatusPublished = "published"
+	// OutboxStatusDead is the status of events which failed to be published
+	// too many times, they are kept for inspection and can be requeued.
+	OutboxStatusDead = "dead"
+)
+
+// OutboxEvent defines data model for a domain event written in the
+// transaction of its change, and relayed to sinks at least once. Events of an
+// aggregate are relayed in order.
+type OutboxEvent struct {
+	ID            int64  `json:"id"`
+	AggregateType string `json:"aggregateType"`
+	AggregateID   string `json:"aggregateID"`
+	Type          string `json:"type"`
+	// Payload is the JSON encoded event.
+	Payload       []byte `json:"-"`
+	Status        string `json:"status"`
+	Attempts      int    `json:"attempts"`
+	NextAttemptAt int64  `json:"nextAttemptAt,omitempty"`
+	LastError     string `json:"lastError,omitempty"`
+	CreatedAt     int64  `json:"createdAt"`
+	PublishedAt   int64  `json:"publishedAt,omitempty"`
+}
+
+// ItemEvent is the payload of item events, Translations are set when they
+// changed.
+type ItemEvent struct {
+	Item         *Item              `json:"item"`
+	Translations []*ItemTranslation `json:"translations,omitempty"`
+}
+
+// CatalogPublishedEvent is the payload of CatalogPublished events.
+type CatalogPublishedEvent struct {
+	Version     int64  `json:"version"`
+	ChangesetID int64  `json:"changesetID,omitempty"`
+	PublishedBy string `json:"publishedBy,omitempty"`
+}
+
+// QuestCompletedEvent is the payload of QuestCompleted events.
+type QuestCompletedEvent struct {
+	PlayerID    string         `json:"playerID"`
+	QuestID     string         `json:"questID"`
+	Rewards     []*QuestReward `json:"rewards,omitempty"`
+	CompletedAt int64          `json:"completedAt"`
+}
+
+// InventoryGrantedEvent is the payload of InventoryGranted events, Source
+// tells what granted the items, e.g. "quest:<id>".
+type InventoryGrantedEvent struct {
+	PlayerID string            `json:"playerID"`
+	Items    []*InventoryGrant `json:"items"`
+	Source   string            `json:"source"`
+}
+
+// ItemInstanceEvent is the payload of ItemInstanceMinted,
+// ItemInstanceTransferred and ItemInstanceBurned events, with the instance
+// after the change.
+type ItemInstanceEvent struct {
+	Instance *ItemInstance        `json:"instance"`
+	History  *ItemInstanceHistory `json:"history"`
+}
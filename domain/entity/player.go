@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// Providers of a PlayerAccount.
+const (
+	// PlayerAccountRonin accounts are Ronin wallet addresses, "0x" followed
+	// by 40 lower case hex digits.
+	PlayerAccountRonin = "ronin"
+)
+
+// Player defines data model for the profile of a player.
+type Player struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Region      string `json:"region,omitempty"`
+	Banned      bool   `json:"banned"`
+	BanReason   string `json:"banReason,omitempty"`
+	// BannedUntil is the end of the ban, 0 for permanent bans.
+	BannedUntil int64 `json:"bannedUntil,omitempty"`
+	CreatedAt   int64 `json:"createdAt"`
+	UpdatedAt   int64 `json:"updatedAt"`
+}
+
+// BannedAt reports whether the player is banned at t.
+func (p *Player) BannedAt(t time.Time) bool {
+	return p.Banned && (p.BannedUntil == 0 || t.Unix() < p.BannedUntil)
+}
+
+// PlayerAccount defines data model for an external account linked to a
+// player, at most one by provider. An account is linked to one player.
+type PlayerAccount struct {
+	ID       int64  `json:"id"`
+	PlayerID string `json:"playerID"`
+	// Provider is e.g. "ronin", or "oauth:<issuer>" for OAuth subjects.
+	Provider  string `json:"provider"`
+	Subject   string `json:"subject"`
+	CreatedAt int64  `json:"createdAt"`
+}
@@ -0,0 +1,106 @@
+package entity
+
+// Kinds of a Quest.
+const (
+	QuestKindQuest       = "quest"
+	QuestKindAchievement = "achievement"
+)
+
+// Statuses of a QuestProgress.
+const (
+	QuestStatusInProgress = "in_progress"
+	// QuestStatusCompleted is the status of quests whose objectives are all
+	// met, their rewards are granted once along with the completion.
+	QuestStatusCompleted = "completed"
+)
+
+// Quest defines data model for a quest or achievement, completed by a player
+// once all its objectives are met.
+type Quest struct {
+	ID          string            `json:"id"`
+	Kind        string            `json:"kind"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Objectives  []*QuestObjective `json:"objectives"`
+	Rewards     []*QuestReward    `json:"rewards"`
+	// Active quests are progressed by player events.
+	Active    bool   `json:"active"`
+	CreatedBy string `json:"createdBy,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// QuestObjective is met once Count is reached by the quantities of the
+// player events of type Type, and of item ItemID unless empty.
+type QuestObjective struct {
+	Type   string `json:"type"`
+	ItemID string `json:"itemID,omitempty"`
+	Count  int    `json:"count"`
+}
+
+// Matches reports whether the player event e progresses the objective.
+func (o *QuestObjective) Matches(e *PlayerEvent) bool {
+	return o.Type == e.Type && (o.ItemID == "" || o.ItemID == e.ItemID)
+}
+
+// QuestReward is a quantity of an item granted on completion.
+type QuestReward struct {
+	ItemID   string `json:"itemID"`
+	Quantity int    `json:"quantity"`
+}
+
+// QuestProgress defines data model for the progress of a player on a quest.
+type QuestProgress struct {
+	ID       int64  `json:"id"`
+	PlayerID string `json:"playerID"`
+	QuestID  string `json:"questID"`
+	Status   string `json:"status"`
+	// Counts are the counts reached by the objectives of the quest, by index.
+	Counts      []int `json:"counts"`
+	CompletedAt int64 `json:"completedAt,omitempty"`
+	CreatedAt   int64 `json:"createdAt"`
+	UpdatedAt   int64 `json:"updatedAt"`
+}
+
+// Progress adds the player event e to the counts of the objectives of q it
+// matches, capped at their count, and reports whether counts changed.
+func (p *QuestProgress) Progress(q *Quest, e *PlayerEvent) bool {
+	for len(p.Counts) < len(q.Objectives) {
+		p.Counts = append(p.Counts, 0)
+	}
+
+	var changed bool
+	for i, o := range q.Objectives {
+		if !o.Matches(e) || p.Counts[i] >= o.Count {
+			continue
+		}
+		p.Counts[i] = min(p.Counts[i]+e.Quantity, o.Count)
+		changed = true
+	}
+
+	return changed
+}
+
+// Done reports whether the objectives of q are all met.
+func (p *QuestProgress) Done(q *Quest) bool {
+	for i, o := range q.Objectives {
+		if i >= len(p.Counts) || p.Counts[i] < o.Count {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PlayerEvent defines data model for a gameplay event of a player reported
+// by game servers, e.g. items collected or crafted. Events are applied once
+// by ID.
+type PlayerEvent struct {
+	ID       string `json:"id"`
+	PlayerID string `json:"playerID"`
+	Type     string `json:"type"`
+	ItemID   string `json:"itemID,omitempty"`
+	Quantity int    `json:"quantity"`
+	// OccurredAt is when the event happened in game.
+	OccurredAt int64 `json:"occurredAt,omitempty"`
+}
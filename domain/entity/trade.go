@@ -0,0 +1,43 @@
+package entity
+
+import "fmt"
+
+// Statuses of a Trade.
+const (
+	TradeStatusPending   = "pending"
+	TradeStatusAccepted  = "accepted"
+	TradeStatusRejected  = "rejected"
+	TradeStatusCancelled = "cancelled"
+	TradeStatusExpired   = "expired"
+)
+
+// Trade defines data model for a trade offer between two players. The
+// offered assets are held in escrow from the proposal until the recipient
+// accepts them in exchange of the requested assets, or the trade is
+// rejected, cancelled or expired and they are released to the proposer.
+type Trade struct {
+	ID          int64         `json:"id"`
+	ProposerID  string        `json:"proposerID"`
+	RecipientID string        `json:"recipientID"`
+	Offered     []*TradeAsset `json:"offered"`
+	Requested   []*TradeAsset `json:"requested"`
+	Status      string        `json:"status"`
+	ExpiresAt   int64         `json:"expiresAt"`
+	// ResolvedAt is when the trade left the pending status, 0 while pending.
+	ResolvedAt int64 `json:"resolvedAt,omitempty"`
+	CreatedAt  int64 `json:"createdAt"`
+	UpdatedAt  int64 `json:"updatedAt"`
+}
+
+// Reference returns the reference of the ledger entries of the trade.
+func (t *Trade) Reference() string {
+	return fmt.Sprintf("trade:%d", t.ID)
+}
+
+// TradeAsset is an amount of an item or a currency of a trade.
+type TradeAsset struct {
+	// AssetType is item or currency.
+	AssetType string `json:"assetType"`
+	Asset     string `json:"asset"`
+	Amount    int64  `json:"amount"`
+}
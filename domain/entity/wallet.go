@@ -0,0 +1,58 @@
+package entity
+
+// CategoryCurrency is the category of the items of the catalog which are
+// currencies, held in wallets rather than inventories.
+const CategoryCurrency = "currency"
+
+// Types of the assets of a LedgerEntry.
+const (
+	AssetItem     = "item"
+	AssetCurrency = "currency"
+)
+
+// Reasons of a LedgerEntry.
+const (
+	LedgerReasonGrant      = "grant"
+	LedgerReasonAdjustment = "adjustment"
+	// LedgerReasonTradeEscrow entries move the offered assets of a trade
+	// from its proposer to escrow, and LedgerReasonTradeRelease entries
+	// back from escrow when the trade is not accepted.
+	LedgerReasonTradeEscrow  = "trade_escrow"
+	LedgerReasonTradeRelease = "trade_release"
+	// LedgerReasonTrade entries move the assets of an accepted trade to
+	// their new owner.
+	LedgerReasonTrade = "trade"
+	// LedgerReasonMarketEscrow entries move the listed items of sell orders
+	// and the price of buy orders to escrow, and LedgerReasonMarketRelease
+	// entries back from escrow when orders are cancelled, or filled below
+	// their price.
+	LedgerReasonMarketEscrow  = "market_escrow"
+	LedgerReasonMarketRelease = "market_release"
+	// LedgerReasonMarket entries move the assets of fills to their new
+	// owner, net of fees.
+	LedgerReasonMarket = "market"
+)
+
+// Wallet defines data model for the balance of a currency owned by a player.
+type Wallet struct {
+	PlayerID  string `json:"playerID"`
+	Currency  string `json:"currency"`
+	Balance   int64  `json:"balance"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// LedgerEntry defines data model for a change of an item quantity or a
+// currency balance of a player, written along with the change.
+type LedgerEntry struct {
+	ID        int64  `json:"id"`
+	PlayerID  string `json:"playerID"`
+	AssetType string `json:"assetType"`
+	// Asset is the item id or the currency.
+	Asset string `json:"asset"`
+	Delta int64  `json:"delta"`
+	// Balance is the quantity or balance after the change.
+	Balance   int64  `json:"balance"`
+	Reason    string `json:"reason"`
+	Reference string `json:"reference,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
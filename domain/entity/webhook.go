@@ -0,0 +1,54 @@
+package entity
+
+import "slices"
+
+// WebhookEventsAll subscribes a WebhookSubscription to all event types.
+const WebhookEventsAll = "*"
+
+// Statuses of a WebhookDelivery.
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	// WebhookDeliveryStatusFailed is the status of deliveries which failed
+	// too many times, they can be redelivered by hand.
+	WebhookDeliveryStatusFailed = "failed"
+)
+
+// WebhookSubscription defines data model for a webhook receiving the outbox
+// events of its event types, signed with its secret.
+type WebhookSubscription struct {
+	ID          int64    `json:"id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Secret      string   `json:"-"`
+	Description string   `json:"description,omitempty"`
+	Active      bool     `json:"active"`
+	CreatedBy   string   `json:"createdBy"`
+	CreatedAt   int64    `json:"createdAt"`
+	UpdatedAt   int64    `json:"updatedAt"`
+}
+
+// Matches reports whether events of type eventType are delivered to the
+// subscription.
+func (s *WebhookSubscription) Matches(eventType string) bool {
+	return s.Active && (slices.Contains(s.Events, WebhookEventsAll) || slices.Contains(s.Events, eventType))
+}
+
+// WebhookDelivery defines data model for the delivery of an outbox event to a
+// WebhookSubscription, it logs the outcome of the last attempt.
+type WebhookDelivery struct {
+	ID             int64  `json:"id"`
+	SubscriptionID int64  `json:"subscriptionID"`
+	EventID        int64  `json:"eventID"`
+	EventType      string `json:"eventType"`
+	// Payload is the delivered body.
+	Payload        []byte `json:"-"`
+	Status         string `json:"status"`
+	Attempts       int    `json:"attempts"`
+	NextAttemptAt  int64  `json:"nextAttemptAt,omitempty"`
+	ResponseStatus int    `json:"responseStatus,omitempty"`
+	LastError      string `json:"lastError,omitempty"`
+	DeliveredAt    int64  `json:"deliveredAt,omitempty"`
+	CreatedAt      int64  `json:"createdAt"`
+	UpdatedAt      int64  `json:"updatedAt"`
+}
@@ -0,0 +1,40 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// CatalogRepo exposed all function apply changes on the whole catalog.
+type CatalogRepo interface {
+	// Apply applies changes as a new version of the catalog, and returns the
+	// version.
+	Apply(ctx context.Context, changes *CatalogChanges) (int64, error)
+	// Version returns the current version of the catalog, 0 until a first
+	// version is applied.
+	Version(ctx context.Context) (int64, error)
+}
+
+// CatalogChanges is a set of changes on the catalog applied in one
+// transaction.
+type CatalogChanges struct {
+	// ChangesetID is the changeset published by the changes, 0 for imports.
+	ChangesetID int64
+	// PublishedBy identifies who applies the changes.
+	PublishedBy string
+
+	// UpsertItems are created or updated by id.
+	UpsertItems []*entity.Item
+	// ReplaceTranslations maps item id to the new set of translations of the
+	// item, all other translations of the item are deleted.
+	ReplaceTranslations map[string][]*entity.ItemTranslation
+	// DeleteItemIDs are deleted along with their translations.
+	DeleteItemIDs []string
+
+	// UpsertLiveEvents are created or updated by id.
+	UpsertLiveEvents []*entity.LiveEvent
+	// DeleteLiveEventIDs are deleted, their items are no longer limited to
+	// them.
+	DeleteLiveEventIDs []string
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// CatalogSnapshotRepo exposed all function interact with catalog snapshots
+// data.
+type CatalogSnapshotRepo interface {
+	// Save saves a snapshot, snapshots are immutable so saving a version
+	// twice keeps the first one.
+	Save(ctx context.Context, snapshot *entity.CatalogSnapshot) error
+	// FindByVersion returns the snapshot of a version, or ErrNotFound.
+	FindByVersion(ctx context.Context, version int64) (*entity.CatalogSnapshot, error)
+}
@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// ChangesetRepo exposed all function interact with changesets data.
+type ChangesetRepo interface {
+	// Create creates a changeset with its changes, and returns it with its
+	// id.
+	Create(ctx context.Context, cs *entity.Changeset) (*entity.Changeset, error)
+	// FindByID returns a changeset with its changes, or ErrNotFound.
+	FindByID(ctx context.Context, id int64) (*entity.Changeset, error)
+	// PutChanges creates or replaces the changes of the changeset by item
+	// id.
+	PutChanges(ctx context.Context, id int64, changes []*entity.ChangesetChange) error
+	// Update updates the status, reviewer and catalog version of a
+	// changeset.
+	Update(ctx context.Context, cs *entity.Changeset) error
+}
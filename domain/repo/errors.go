@@ -0,0 +1,14 @@
+package repo
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when the requested record does not exist.
+	ErrNotFound = errors.New("repo: not found")
+	// ErrConflict is returned when a record violates a uniqueness
+	// constraint.
+	ErrConflict = errors.New("repo: conflict")
+	// ErrInsufficientFunds is returned when a change would make a balance
+	// or a quantity negative.
+	ErrInsufficientFunds = errors.New("repo: insufficient funds")
+)
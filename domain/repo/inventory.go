@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// InventoryRepo exposed all function interact with the inventories of
+// players.
+type InventoryRepo interface {
+	// FindByPlayer lists the items owned by a player ordered by item.
+	FindByPlayer(ctx context.Context, playerID string) ([]*entity.InventoryItem, error)
+	// Grant adds the quantities of grants to the inventory of a player, and
+	// appends an InventoryGranted event of source to the outbox.
+	Grant(ctx context.Context, playerID string, grants []*entity.InventoryGrant, source string) error
+}
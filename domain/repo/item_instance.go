@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// ItemInstanceRepo exposed all function interact with the non fungible
+// instances of items owned by players and their history.
+type ItemInstanceRepo interface {
+	// Mint creates an active instance with a unique id along with its mint
+	// history and an ItemInstanceMinted event, by actor for reason.
+	Mint(ctx context.Context, inst *entity.ItemInstance, actor, reason string) (*entity.ItemInstance, error)
+	// Transfer moves the active instance of id from its owner fromID to toID
+	// along with its history and an ItemInstanceTransferred event, by actor
+	// for reason. It returns ErrConflict if the instance is burned or no
+	// longer owned by fromID.
+	Transfer(ctx context.Context, id, fromID, toID, actor, reason string) (*entity.ItemInstance, error)
+	// Burn burns the active instance of id at now along with its history and
+	// an ItemInstanceBurned event, by actor for reason. It returns
+	// ErrConflict if the instance is already burned.
+	Burn(ctx context.Context, id, actor, reason string, now int64) (*entity.ItemInstance, error)
+	// FindByID returns the instance of id, or ErrNotFound.
+	FindByID(ctx context.Context, id string) (*entity.ItemInstance, error)
+	// FindAll lists the instances matching filter from the latest minted.
+	FindAll(ctx context.Context, filter ItemInstanceFilter, limit, offset int) (*ListItemInstanceResult, error)
+	// FindHistory returns the history of the instance of id in order.
+	FindHistory(ctx context.Context, id string) ([]*entity.ItemInstanceHistory, error)
+}
+
+// ItemInstanceFilter filters the instances of FindAll, on the fields set.
+type ItemInstanceFilter struct {
+	OwnerID string
+	ItemID  string
+	Status  string
+}
+
+type ListItemInstanceResult struct {
+	Instances []*entity.ItemInstance
+	Metadata  utils.PageMetadata
+}
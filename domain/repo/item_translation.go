@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// ItemTranslationRepo exposed all function interact with item translations data.
+type ItemTranslationRepo interface {
+	FindAll(ctx context.Context) ([]*entity.ItemTranslation, error)
+	FindByLocale(ctx context.Context, locale string) ([]*entity.ItemTranslation, error)
+	Upsert(ctx context.Context, translations []*entity.ItemTranslation) error
+}
@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// JobRunRepo exposed all function interact with the history of background
+// jobs.
+type JobRunRepo interface {
+	// Create creates a run, and returns it with its id.
+	Create(ctx context.Context, run *entity.JobRun) (*entity.JobRun, error)
+	// Update updates the status, end, duration and error of a run.
+	Update(ctx context.Context, run *entity.JobRun) error
+	// FindLast returns the latest run of a job, or ErrNotFound.
+	FindLast(ctx context.Context, job string) (*entity.JobRun, error)
+	// FindByJob lists the runs of a job from the latest.
+	FindByJob(ctx context.Context, job string, limit, offset int) (*ListJobRunResult, error)
+	// DeleteBefore deletes the runs started before a time, and returns the
+	// number of deleted runs.
+	DeleteBefore(ctx context.Context, before int64) (int, error)
+}
+
+// JobTaskRepo exposed all function interact with the tasks of queue jobs.
+type JobTaskRepo interface {
+	// Create creates a pending task, and returns it with its id.
+	Create(ctx context.Context, task *entity.JobTask) (*entity.JobTask, error)
+	// FindDue returns the pending tasks of a queue due at now ordered by id.
+	FindDue(ctx context.Context, queue string, now int64, limit int) ([]*entity.JobTask, error)
+	// Update updates the status, attempts, run time and error of a task.
+	Update(ctx context.Context, task *entity.JobTask) error
+	// CountByStatus returns the number of tasks of a queue by status.
+	CountByStatus(ctx context.Context, queue string) (map[string]int, error)
+	// DeleteFinishedBefore deletes the done and failed tasks last updated
+	// before a time, and returns the number of deleted tasks.
+	DeleteFinishedBefore(ctx context.Context, before int64) (int, error)
+}
+
+type ListJobRunResult struct {
+	Runs     []*entity.JobRun
+	Metadata utils.PageMetadata
+}
@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// LeaderboardRepo exposed all function interact with leaderboard definitions
+// data.
+type LeaderboardRepo interface {
+	// Create creates a leaderboard.
+	Create(ctx context.Context, l *entity.Leaderboard) (*entity.Leaderboard, error)
+	// FindAll lists the leaderboards ordered by id.
+	FindAll(ctx context.Context) ([]*entity.Leaderboard, error)
+	// FindByMetric lists the leaderboards of a metric ordered by id.
+	FindByMetric(ctx context.Context, metric string) ([]*entity.Leaderboard, error)
+	// FindByID returns the leaderboard of id, or ErrNotFound.
+	FindByID(ctx context.Context, id string) (*entity.Leaderboard, error)
+	// Update updates a leaderboard, or returns ErrNotFound.
+	Update(ctx context.Context, l *entity.Leaderboard) error
+	// Delete deletes the leaderboard of id along with its snapshots.
+	Delete(ctx context.Context, id string) error
+}
+
+// LeaderboardSnapshotRepo exposed all function interact with the snapshots
+// of ended leaderboard periods.
+type LeaderboardSnapshotRepo interface {
+	// Create creates a snapshot, and reports whether it was not created yet
+	// for its leaderboard and period.
+	Create(ctx context.Context, s *entity.LeaderboardSnapshot) (bool, error)
+	// FindByPeriod returns the snapshot of a period of a leaderboard, or
+	// ErrNotFound.
+	FindByPeriod(ctx context.Context, leaderboardID, period string) (*entity.LeaderboardSnapshot, error)
+	// FindByLeaderboard lists the snapshots of a leaderboard without their
+	// entries, from the latest period.
+	FindByLeaderboard(ctx context.Context, leaderboardID string, limit, offset int) (*ListLeaderboardSnapshotResult, error)
+}
+
+type ListLeaderboardSnapshotResult struct {
+	Snapshots []*entity.LeaderboardSnapshot
+	Metadata  utils.PageMetadata
+}
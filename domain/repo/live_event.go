@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// LiveEventRepo exposed all function interact with live events data, they are
+// written by CatalogRepo.Apply as part of the catalog.
+type LiveEventRepo interface {
+	// FindAll lists all events ordered by start.
+	FindAll(ctx context.Context) ([]*entity.LiveEvent, error)
+	// FindByID returns the event of id, or ErrNotFound.
+	FindByID(ctx context.Context, id string) (*entity.LiveEvent, error)
+}
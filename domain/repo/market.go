@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// Sorts of MarketOrderFilter.
+const (
+	MarketSortPrice     = "price"
+	MarketSortPriceDesc = "-price"
+)
+
+// MarketRepo exposed all function interact with the orders of the
+// marketplace, their matching and their fills.
+type MarketRepo interface {
+	// Place creates an open order, moves its listed items or its price to
+	// escrow, and matches it against the open orders of the other side of
+	// its item and currency by best price then time, except those of its
+	// player. Fills are executed at the price of the matched orders, feeBps
+	// basis points of their price being charged to the seller, along with
+	// their ledger entries. It returns the order after matching and its
+	// fills, or ErrInsufficientFunds if the player lacks the escrowed asset.
+	Place(ctx context.Context, o *entity.MarketOrder, feeBps int64) (*entity.MarketOrder, []*entity.MarketFill, error)
+	// Cancel cancels the open order of id, and moves the escrow of its
+	// remaining quantity back to its player along with their ledger
+	// entries. It returns ErrConflict if the order is not open.
+	Cancel(ctx context.Context, id int64) error
+	// FindOrderByID returns the order of id, or ErrNotFound.
+	FindOrderByID(ctx context.Context, id int64) (*entity.MarketOrder, error)
+	// FindOrders lists the orders matching filter, from the latest or by
+	// the sort of filter.
+	FindOrders(ctx context.Context, filter MarketOrderFilter, limit, offset int) (*ListMarketOrderResult, error)
+	// FindFills lists the fills of an item from the latest.
+	FindFills(ctx context.Context, itemID string, limit, offset int) (*ListMarketFillResult, error)
+	// FindCandles aggregates the fills of an item of a currency from from to
+	// to, in unix seconds, into candles of interval seconds in order of
+	// time. Intervals without fills have no candle.
+	FindCandles(ctx context.Context, itemID, currency string, from, to, interval int64) ([]*entity.MarketCandle, error)
+}
+
+// MarketOrderFilter filters the orders of FindOrders, on the fields set.
+type MarketOrderFilter struct {
+	PlayerID string
+	ItemID   string
+	Side     string
+	Status   string
+	// Sort is empty for the latest first, MarketSortPrice or
+	// MarketSortPriceDesc for the cheapest or dearest first, then the oldest.
+	Sort string
+}
+
+type ListMarketOrderResult struct {
+	Orders   []*entity.MarketOrder
+	Metadata utils.PageMetadata
+}
+
+type ListMarketFillResult struct {
+	Fills    []*entity.MarketFill
+	Metadata utils.PageMetadata
+}
@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// OutboxRepo exposed all function interact with outbox events data.
+type OutboxRepo interface {
+	// Append appends pending events in the transaction of ctx.
+	Append(ctx context.Context, events []*entity.OutboxEvent) error
+	// FindByStatus lists up to limit events of a status ordered by id.
+	FindByStatus(ctx context.Context, status string, limit int) ([]*entity.OutboxEvent, error)
+	// FindDue lists up to limit pending events due at now ordered by id,
+	// leaving out the events of aggregates with an earlier pending event not
+	// due yet.
+	FindDue(ctx context.Context, now int64, limit int) ([]*entity.OutboxEvent, error)
+	// Update updates the status, attempts, next attempt, last error and
+	// publication time of an event.
+	Update(ctx context.Context, event *entity.OutboxEvent) error
+	// Requeue makes dead events of ids pending again, with no attempt, and
+	// returns how many were requeued. All dead events are requeued when ids
+	// is empty.
+	Requeue(ctx context.Context, ids []int64) (int, error)
+	// DeletePublishedBefore deletes the events published before a time, and
+	// returns the number of deleted events.
+	DeletePublishedBefore(ctx context.Context, before int64) (int, error)
+}
@@ -0,0 +1,57 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// PlayerRepo exposed all function interact with player profiles data.
+type PlayerRepo interface {
+	// Create creates a player, or returns ErrConflict if its id is taken.
+	Create(ctx context.Context, p *entity.Player) (*entity.Player, error)
+	// FindByID returns the player of id, or ErrNotFound.
+	FindByID(ctx context.Context, id string) (*entity.Player, error)
+	// FindByIDs returns the players of ids which exist.
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.Player, error)
+	// FindAll lists the players matching a filter ordered by id.
+	FindAll(ctx context.Context, filter *PlayerFilter, limit, offset int) (*ListPlayerResult, error)
+	// Update updates the profile and ban status of a player, or returns
+	// ErrNotFound.
+	Update(ctx context.Context, p *entity.Player) error
+	// Delete deletes the player of id along with its accounts, inventory,
+	// wallets, ledger, trades, market orders and item instances, releasing
+	// the escrow of the pending trades proposed to the player. Market fills
+	// are kept.
+	Delete(ctx context.Context, id string) error
+}
+
+// PlayerAccountRepo exposed all function interact with the external accounts
+// linked to players.
+type PlayerAccountRepo interface {
+	// Link links an account to a player, or returns ErrConflict if the
+	// account or the provider of the player is already linked.
+	Link(ctx context.Context, a *entity.PlayerAccount) (*entity.PlayerAccount, error)
+	// Unlink unlinks the account of a provider from a player, or returns
+	// ErrNotFound.
+	Unlink(ctx context.Context, playerID, provider string) error
+	// FindByPlayer lists the accounts linked to a player ordered by
+	// provider.
+	FindByPlayer(ctx context.Context, playerID string) ([]*entity.PlayerAccount, error)
+	// FindBySubject returns the account of a provider and subject, or
+	// ErrNotFound.
+	FindBySubject(ctx context.Context, provider, subject string) (*entity.PlayerAccount, error)
+}
+
+// PlayerFilter filters the players of PlayerRepo.FindAll, empty fields match
+// any player.
+type PlayerFilter struct {
+	Region string
+	Banned *bool
+}
+
+type ListPlayerResult struct {
+	Players  []*entity.Player
+	Metadata utils.PageMetadata
+}
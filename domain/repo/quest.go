@@ -0,0 +1,48 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// QuestRepo exposed all function interact with quest definitions.
+type QuestRepo interface {
+	// Create creates a quest.
+	Create(ctx context.Context, q *entity.Quest) (*entity.Quest, error)
+	// FindAll lists the quests ordered by id, only the active ones when
+	// activeOnly is set.
+	FindAll(ctx context.Context, activeOnly bool) ([]*entity.Quest, error)
+	// FindByID returns the quest of id, or ErrNotFound.
+	FindByID(ctx context.Context, id string) (*entity.Quest, error)
+	// Update updates all fields of a quest but its id and creator.
+	Update(ctx context.Context, q *entity.Quest) error
+	// Delete deletes the quest of id along with the progress on it.
+	Delete(ctx context.Context, id string) error
+}
+
+// QuestProgressRepo exposed all function interact with the progress of
+// players on quests.
+type QuestProgressRepo interface {
+	// FindByPlayer lists the progress of a player ordered by quest.
+	FindByPlayer(ctx context.Context, playerID string) ([]*entity.QuestProgress, error)
+	// Save creates the progress when its id is 0, else updates its counts,
+	// and returns it with its id.
+	Save(ctx context.Context, p *entity.QuestProgress) (*entity.QuestProgress, error)
+	// Complete marks the progress completed at p.CompletedAt unless it
+	// already is, and appends a QuestCompleted event of rewards to the
+	// outbox. It reports whether the progress was completed by the call, so
+	// that rewards are granted once.
+	Complete(ctx context.Context, p *entity.QuestProgress, rewards []*entity.QuestReward) (bool, error)
+}
+
+// PlayerEventRepo exposed all function interact with the player events
+// applied to quests.
+type PlayerEventRepo interface {
+	// Record records the id of a player event, and reports whether it was
+	// not recorded yet.
+	Record(ctx context.Context, e *entity.PlayerEvent) (bool, error)
+	// DeleteBefore deletes the events recorded before a time, and returns
+	// the number of deleted events.
+	DeleteBefore(ctx context.Context, before int64) (int, error)
+}
@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// TradeRepo exposed all function interact with trade offers between players
+// and their escrow.
+type TradeRepo interface {
+	// Propose creates a pending trade, and moves its offered assets from its
+	// proposer to escrow along with their ledger entries. It returns
+	// ErrInsufficientFunds if the proposer lacks an offered asset.
+	Propose(ctx context.Context, t *entity.Trade) (*entity.Trade, error)
+	// Accept accepts the trade of id pending and not expired at now, moving
+	// its requested assets from its recipient to its proposer and its
+	// offered assets from escrow to its recipient, along with their ledger
+	// entries. It returns ErrConflict if the trade is not pending or
+	// expired, and ErrInsufficientFunds if the recipient lacks a requested
+	// asset.
+	Accept(ctx context.Context, id int64, now int64) error
+	// Release resolves the trade of id pending with status, rejected,
+	// cancelled or expired, and moves its offered assets from escrow back to
+	// its proposer along with their ledger entries. It returns ErrConflict if
+	// the trade is not pending.
+	Release(ctx context.Context, id int64, status string, now int64) error
+	// FindByID returns the trade of id, or ErrNotFound.
+	FindByID(ctx context.Context, id int64) (*entity.Trade, error)
+	// FindByPlayer lists the trades proposed to or by a player from the
+	// latest, of a status when set.
+	FindByPlayer(ctx context.Context, playerID, status string, limit, offset int) (*ListTradeResult, error)
+	// FindExpired returns the pending trades expired at now in order of
+	// expiry.
+	FindExpired(ctx context.Context, now int64, limit int) ([]*entity.Trade, error)
+}
+
+type ListTradeResult struct {
+	Trades   []*entity.Trade
+	Metadata utils.PageMetadata
+}
@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// WalletRepo exposed all function interact with the currency balances of
+// players.
+type WalletRepo interface {
+	// FindByPlayer lists the wallets of a player ordered by currency.
+	FindByPlayer(ctx context.Context, playerID string) ([]*entity.Wallet, error)
+	// Adjust adds amount, which may be negative, to the balance of a
+	// currency of a player along with its ledger entry, and returns the
+	// wallet. It returns ErrInsufficientFunds if the balance would be
+	// negative.
+	Adjust(ctx context.Context, playerID, currency string, amount int64, reason, reference string) (*entity.Wallet, error)
+}
+
+// LedgerRepo exposed all function interact with the ledger of players.
+type LedgerRepo interface {
+	// FindByPlayer lists the ledger entries of a player from the latest.
+	FindByPlayer(ctx context.Context, playerID string, limit, offset int) (*ListLedgerEntryResult, error)
+}
+
+type ListLedgerEntryResult struct {
+	Entries  []*entity.LedgerEntry
+	Metadata utils.PageMetadata
+}
@@ -0,0 +1,46 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// WebhookSubscriptionRepo exposed all function interact with webhook
+// subscriptions data.
+type WebhookSubscriptionRepo interface {
+	// Create creates a subscription, and returns it with its id.
+	Create(ctx context.Context, s *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+	// FindAll returns all subscriptions ordered by id.
+	FindAll(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	// FindByID returns a subscription, or ErrNotFound.
+	FindByID(ctx context.Context, id int64) (*entity.WebhookSubscription, error)
+	// Update updates the url, events, secret, description and active flag of
+	// a subscription.
+	Update(ctx context.Context, s *entity.WebhookSubscription) error
+	// Delete deletes a subscription along with its deliveries.
+	Delete(ctx context.Context, id int64) error
+}
+
+// WebhookDeliveryRepo exposed all function interact with webhook deliveries
+// data.
+type WebhookDeliveryRepo interface {
+	// Create creates pending deliveries, skipping those of an event already
+	// delivered to the subscription.
+	Create(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+	// FindByID returns a delivery, or ErrNotFound.
+	FindByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+	// FindBySubscription lists the deliveries of a subscription from the
+	// latest, of a status unless empty.
+	FindBySubscription(ctx context.Context, subscriptionID int64, status string, limit, offset int) (*ListWebhookDeliveryResult, error)
+	// FindDue returns pending deliveries due at now ordered by id.
+	FindDue(ctx context.Context, now int64, limit int) ([]*entity.WebhookDelivery, error)
+	// Update updates the state and log of a delivery.
+	Update(ctx context.Context, d *entity.WebhookDelivery) error
+}
+
+type ListWebhookDeliveryResult struct {
+	Deliveries []*entity.WebhookDelivery
+	Metadata   utils.PageMetadata
+}
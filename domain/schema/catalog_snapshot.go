@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// CatalogSnapshot holds the schema definition for the CatalogSnapshot
+// entity, the full catalog of a version as downloaded by game clients, its id
+// being the version.
+type CatalogSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the CatalogSnapshot.
+func (CatalogSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		// hash is the hex encoded SHA-256 of the uncompressed JSON snapshot.
+		field.String("hash"),
+		// data is the gzip compressed JSON snapshot.
+		field.Bytes("data"),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
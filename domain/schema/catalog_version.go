@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// CatalogVersion holds the schema definition for the CatalogVersion entity,
+// one is created each time the catalog is published, its id being the
+// version.
+type CatalogVersion struct {
+	ent.Schema
+}
+
+// Fields of the CatalogVersion.
+func (CatalogVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("changeset_id").Default(0),
+		field.String("published_by").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
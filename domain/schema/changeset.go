@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Changeset holds the schema definition for the Changeset entity.
+type Changeset struct {
+	ent.Schema
+}
+
+// Fields of the Changeset.
+func (Changeset) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("title"),
+		field.String("status"),
+		field.String("author"),
+		// editors are the principals who put changes, including the author.
+		field.JSON("editors", []string{}).Optional(),
+		field.String("reviewer").Default(""),
+		field.Int64("catalog_version").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the Changeset.
+func (Changeset) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("changes", ChangesetChange.Type),
+	}
+}
+
+// Indexes of the Changeset.
+func (Changeset) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// ChangesetChange holds the schema definition for the ChangesetChange entity.
+type ChangesetChange struct {
+	ent.Schema
+}
+
+// Fields of the ChangesetChange.
+func (ChangesetChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("changeset_id"),
+		field.String("item_id"),
+		field.String("op"),
+		field.String("name").Default(""),
+		field.String("category").Default(""),
+		field.String("description").Default(""),
+		// translations replace those of the item, they are left untouched
+		// when null.
+		field.JSON("translations", []*entity.ItemTranslation{}).Optional(),
+	}
+}
+
+// Edges of the ChangesetChange.
+func (ChangesetChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("changeset", Changeset.Type).
+			Ref("changes").
+			Field("changeset_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ChangesetChange.
+func (ChangesetChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("changeset_id", "item_id").Unique(),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InventoryItem holds the schema definition for the InventoryItem entity, the
+// quantity of an item owned by a player.
+type InventoryItem struct {
+	ent.Schema
+}
+
+// Fields of the InventoryItem.
+func (InventoryItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("player_id"),
+		field.String("item_id"),
+		field.Int("quantity").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the InventoryItem.
+func (InventoryItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("inventory").
+			Field("player_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the InventoryItem.
+func (InventoryItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("player_id", "item_id").Unique(),
+	}
+}
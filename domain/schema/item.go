@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -31,3 +32,10 @@ func (Item) Fields() []ent.Field {
 			}),
 	}
 }
+
+// Edges of the Item.
+func (Item) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("translations", ItemTranslation.Type),
+	}
+}
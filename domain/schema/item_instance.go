@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ItemInstance holds the schema definition for the ItemInstance entity, a
+// non fungible copy of an item of the catalog owned by a player.
+type ItemInstance struct {
+	ent.Schema
+}
+
+// Fields of the ItemInstance.
+func (ItemInstance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.NewString()
+			}).
+			Immutable(),
+		field.String("item_id").Immutable(),
+		field.String("owner_id"),
+		field.Int("durability").Default(0),
+		field.Int("max_durability").Default(0),
+		field.JSON("stats", map[string]any{}).Optional(),
+		// status is active or burned.
+		field.String("status").Default("active"),
+		field.Int64("burned_at").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the ItemInstance.
+func (ItemInstance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Player.Type).
+			Ref("item_instances").
+			Field("owner_id").
+			Unique().
+			Required(),
+		edge.To("history", ItemInstanceHistory.Type),
+	}
+}
+
+// Indexes of the ItemInstance.
+func (ItemInstance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "status"),
+		index.Fields("item_id", "status"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ItemInstanceHistory holds the schema definition for the
+// ItemInstanceHistory entity, the mint, a transfer or the burn of an item
+// instance.
+type ItemInstanceHistory struct {
+	ent.Schema
+}
+
+// Fields of the ItemInstanceHistory.
+func (ItemInstanceHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("instance_id"),
+		// action is mint, transfer or burn.
+		field.String("action"),
+		field.String("from_player_id").Default(""),
+		field.String("to_player_id").Default(""),
+		field.String("actor"),
+		field.String("reason").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
+
+// Edges of the ItemInstanceHistory.
+func (ItemInstanceHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("instance", ItemInstance.Type).
+			Ref("history").
+			Field("instance_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ItemInstanceHistory.
+func (ItemInstanceHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("instance_id"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ItemTranslation holds the schema definition for the ItemTranslation entity.
+type ItemTranslation struct {
+	ent.Schema
+}
+
+// Fields of the ItemTranslation.
+func (ItemTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("item_id"),
+		field.String("locale"),
+		field.String("name"),
+		field.String("description"),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the ItemTranslation.
+func (ItemTranslation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("translations").
+			Field("item_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ItemTranslation.
+func (ItemTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "locale").Unique(),
+		index.Fields("locale"),
+	}
+}
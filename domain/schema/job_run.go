@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobRun holds the schema definition for the JobRun entity, the history of
+// background jobs.
+type JobRun struct {
+	ent.Schema
+}
+
+// Fields of the JobRun.
+func (JobRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("job"),
+		field.String("trigger"),
+		// task_id is the task handled by queue runs.
+		field.Int64("task_id").Default(0),
+		field.String("status"),
+		// node is the replica the job ran on.
+		field.String("node"),
+		// scheduled_at is the time cron runs were scheduled at.
+		field.Int64("scheduled_at").Default(0),
+		field.Int64("started_at"),
+		field.Int64("finished_at").Default(0),
+		field.Int64("duration_ms").Default(0),
+		field.String("error").Default(""),
+	}
+}
+
+// Indexes of the JobRun.
+func (JobRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job"),
+		index.Fields("started_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// JobTask holds the schema definition for the JobTask entity, tasks enqueued
+// for queue jobs.
+type JobTask struct {
+	ent.Schema
+}
+
+// Fields of the JobTask.
+func (JobTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("queue"),
+		field.Bytes("payload"),
+		field.String("status"),
+		field.Int("attempts").Default(0),
+		field.Int64("run_at"),
+		field.String("last_error").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Indexes of the JobTask.
+func (JobTask) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("queue", "status", "run_at"),
+		index.Fields("updated_at"),
+	}
+}
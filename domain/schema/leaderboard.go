@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Leaderboard holds the schema definition for the Leaderboard entity, the
+// ranking of players by a metric over periods. Scores of current periods are
+// kept in the leaderboard store, ended periods are snapshotted to
+// LeaderboardSnapshot.
+type Leaderboard struct {
+	ent.Schema
+}
+
+// Fields of the Leaderboard.
+func (Leaderboard) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("name"),
+		// metric is the metric of the scores submitted to the leaderboard.
+		field.String("metric"),
+		// policy is best, sum or latest.
+		field.String("policy").Default("best"),
+		// reset is daily, weekly or season, daily and weekly periods start at
+		// midnight in the timezone.
+		field.String("reset"),
+		field.String("timezone").Default("UTC"),
+		field.String("created_by").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Indexes of the Leaderboard.
+func (Leaderboard) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("metric"),
+	}
+}
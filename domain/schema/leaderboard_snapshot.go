@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// LeaderboardSnapshot holds the schema definition for the LeaderboardSnapshot
+// entity, the final ranking of an ended period of a leaderboard.
+type LeaderboardSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the LeaderboardSnapshot.
+func (LeaderboardSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("leaderboard_id"),
+		field.String("period"),
+		field.Int64("period_start"),
+		field.Int64("period_end"),
+		// entries are ordered by rank.
+		field.JSON("entries", []*entity.LeaderboardEntry{}),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
+
+// Indexes of the LeaderboardSnapshot.
+func (LeaderboardSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("leaderboard_id", "period").Unique(),
+		index.Fields("leaderboard_id", "period_end"),
+	}
+}
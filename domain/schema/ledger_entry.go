@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LedgerEntry holds the schema definition for the LedgerEntry entity, a
+// change of the quantity of an item or the balance of a currency of a
+// player. Entries are append only.
+type LedgerEntry struct {
+	ent.Schema
+}
+
+// Fields of the LedgerEntry.
+func (LedgerEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("player_id"),
+		// asset_type is item or currency, asset the item id or currency.
+		field.String("asset_type"),
+		field.String("asset"),
+		field.Int64("delta"),
+		// balance is the quantity or balance after the change.
+		field.Int64("balance"),
+		// reason is what changed the asset, e.g. grant, and reference the
+		// record of the change, e.g. the source of the grant.
+		field.String("reason"),
+		field.String("reference").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
+
+// Edges of the LedgerEntry.
+func (LedgerEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("ledger").
+			Field("player_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the LedgerEntry.
+func (LedgerEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("player_id", "id"),
+	}
+}
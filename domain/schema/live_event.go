@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LiveEvent holds the schema definition for the LiveEvent entity, a live-ops
+// event or season during which its items are available.
+type LiveEvent struct {
+	ent.Schema
+}
+
+// Fields of the LiveEvent.
+func (LiveEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		// kind is event or season.
+		field.String("kind").Default("event"),
+		field.String("name"),
+		field.String("description").Default(""),
+		// timezone is the IANA time zone the event is scheduled in, start_at
+		// and end_at are unix times.
+		field.String("timezone").Default("UTC"),
+		field.Int64("start_at"),
+		field.Int64("end_at"),
+		// item_ids are the items only available during the event.
+		field.JSON("item_ids", []string{}),
+		field.String("created_by").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Indexes of the LiveEvent.
+func (LiveEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("start_at", "end_at"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MarketFill holds the schema definition for the MarketFill entity, a match
+// of a sell and a buy order of the marketplace. Fills are the price history
+// of items, they are kept when their orders or players are deleted.
+type MarketFill struct {
+	ent.Schema
+}
+
+// Fields of the MarketFill.
+func (MarketFill) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("item_id"),
+		field.String("currency"),
+		field.Int64("price"),
+		field.Int64("quantity"),
+		field.Int64("fee").Default(0),
+		field.Int64("sell_order_id"),
+		field.Int64("buy_order_id"),
+		field.String("seller_id"),
+		field.String("buyer_id"),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
+
+// Indexes of the MarketFill.
+func (MarketFill) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MarketOrder holds the schema definition for the MarketOrder entity, a
+// sell listing or a buy order of an item on the marketplace whose listed
+// items or price are held in escrow while it is open.
+type MarketOrder struct {
+	ent.Schema
+}
+
+// Fields of the MarketOrder.
+func (MarketOrder) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("player_id"),
+		// side is sell or buy.
+		field.String("side"),
+		field.String("item_id"),
+		field.String("currency"),
+		field.Int64("price"),
+		field.Int64("quantity"),
+		field.Int64("remaining"),
+		// status is open, filled or cancelled.
+		field.String("status").Default("open"),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the MarketOrder.
+func (MarketOrder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("market_orders").
+			Field("player_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MarketOrder.
+func (MarketOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		// open orders of an item are matched and browsed by price.
+		index.Fields("item_id", "side", "status", "price"),
+		index.Fields("player_id", "status"),
+	}
+}
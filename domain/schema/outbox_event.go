@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity, domain
+// events written in the transaction of their change and relayed to sinks.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("aggregate_type"),
+		field.String("aggregate_id"),
+		field.String("type"),
+		field.Bytes("payload"),
+		field.String("status"),
+		field.Int("attempts").Default(0),
+		field.Int64("next_attempt_at").Default(0),
+		field.String("last_error").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("published_at").Default(0),
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("aggregate_type", "aggregate_id"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Player holds the schema definition for the Player entity, the profile of a
+// player owning inventory items and wallets. Its id is the subject of the
+// tokens of the player.
+type Player struct {
+	ent.Schema
+}
+
+// Fields of the Player.
+func (Player) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		field.String("display_name"),
+		field.String("region").Default(""),
+		field.Bool("banned").Default(false),
+		field.String("ban_reason").Default(""),
+		// banned_until is the end of a ban in unix seconds, 0 for permanent
+		// bans.
+		field.Int64("banned_until").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the Player.
+func (Player) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("accounts", PlayerAccount.Type),
+		edge.To("inventory", InventoryItem.Type),
+		edge.To("wallets", Wallet.Type),
+		edge.To("ledger", LedgerEntry.Type),
+		edge.To("proposed_trades", Trade.Type),
+		edge.To("received_trades", Trade.Type),
+		edge.To("market_orders", MarketOrder.Type),
+		edge.To("item_instances", ItemInstance.Type),
+	}
+}
+
+// Indexes of the Player.
+func (Player) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("region"),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PlayerAccount holds the schema definition for the PlayerAccount entity, an
+// external account linked to a player, e.g. a Ronin wallet address or the
+// subject of an OAuth provider.
+type PlayerAccount struct {
+	ent.Schema
+}
+
+// Fields of the PlayerAccount.
+func (PlayerAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("player_id"),
+		field.String("provider"),
+		field.String("subject"),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
+
+// Edges of the PlayerAccount.
+func (PlayerAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("accounts").
+			Field("player_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PlayerAccount.
+func (PlayerAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		// an account is linked to one player, and a player links one account
+		// of each provider.
+		index.Fields("provider", "subject").Unique(),
+		index.Fields("player_id", "provider").Unique(),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PlayerEvent holds the schema definition for the PlayerEvent entity, the
+// ids of the player events already applied to quests so that retried
+// reports are applied once.
+type PlayerEvent struct {
+	ent.Schema
+}
+
+// Fields of the PlayerEvent.
+func (PlayerEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("player_id"),
+		field.String("event_id"),
+		field.String("type"),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+	}
+}
+
+// Indexes of the PlayerEvent.
+func (PlayerEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("player_id", "event_id").Unique(),
+		index.Fields("created_at"),
+	}
+}
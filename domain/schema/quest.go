@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// Quest holds the schema definition for the Quest entity, quests and
+// achievements of players.
+type Quest struct {
+	ent.Schema
+}
+
+// Fields of the Quest.
+func (Quest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id"),
+		// kind is quest or achievement.
+		field.String("kind").Default("quest"),
+		field.String("name"),
+		field.String("description").Default(""),
+		field.JSON("objectives", []*entity.QuestObjective{}),
+		field.JSON("rewards", []*entity.QuestReward{}),
+		field.Bool("active").Default(true),
+		field.String("created_by").Default(""),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// QuestProgress holds the schema definition for the QuestProgress entity, the
+// progress of a player on a quest.
+type QuestProgress struct {
+	ent.Schema
+}
+
+// Fields of the QuestProgress.
+func (QuestProgress) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("player_id"),
+		// quest_id is not a foreign key, progress outlives deleted quests.
+		field.String("quest_id"),
+		field.String("status"),
+		// counts are the counts reached by the objectives of the quest.
+		field.JSON("counts", []int{}),
+		field.Int64("completed_at").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Indexes of the QuestProgress.
+func (QuestProgress) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("player_id", "quest_id").Unique(),
+	}
+}
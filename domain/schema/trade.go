@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// Trade holds the schema definition for the Trade entity, a trade offer
+// between two players whose offered assets are held in escrow while it is
+// pending.
+type Trade struct {
+	ent.Schema
+}
+
+// Fields of the Trade.
+func (Trade) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("proposer_id"),
+		field.String("recipient_id"),
+		field.JSON("offered", []*entity.TradeAsset{}),
+		field.JSON("requested", []*entity.TradeAsset{}),
+		// status is pending, accepted, rejected, cancelled or expired.
+		field.String("status").Default("pending"),
+		field.Int64("expires_at"),
+		field.Int64("resolved_at").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the Trade.
+func (Trade) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("proposer", Player.Type).
+			Ref("proposed_trades").
+			Field("proposer_id").
+			Unique().
+			Required(),
+		edge.From("recipient", Player.Type).
+			Ref("received_trades").
+			Field("recipient_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Trade.
+func (Trade) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("proposer_id", "status"),
+		index.Fields("recipient_id", "status"),
+		// pending trades are expired in order of expiry.
+		index.Fields("status", "expires_at"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Wallet holds the schema definition for the Wallet entity, the balance of a
+// currency owned by a player.
+type Wallet struct {
+	ent.Schema
+}
+
+// Fields of the Wallet.
+func (Wallet) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("player_id"),
+		field.String("currency"),
+		field.Int64("balance").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the Wallet.
+func (Wallet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("wallets").
+			Field("player_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Wallet.
+func (Wallet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("player_id", "currency").Unique(),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity,
+// the delivery of an outbox event to a webhook subscription and its log.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("subscription_id"),
+		field.Int64("event_id"),
+		field.String("event_type"),
+		field.Bytes("payload"),
+		field.String("status"),
+		field.Int("attempts").Default(0),
+		field.Int64("next_attempt_at").Default(0),
+		field.Int("response_status").Default(0),
+		field.String("last_error").Default(""),
+		field.Int64("delivered_at").Default(0),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", WebhookSubscription.Type).
+			Ref("deliveries").
+			Field("subscription_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		// an event is delivered once to a subscription, though it may be relayed
+		// more than once.
+		index.Fields("subscription_id", "event_id").Unique(),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookSubscription holds the schema definition for the WebhookSubscription
+// entity.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+// Fields of the WebhookSubscription.
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("url"),
+		// events are the event types delivered, "*" for all of them.
+		field.JSON("events", []string{}),
+		field.String("secret").Sensitive(),
+		field.String("description").Default(""),
+		field.Bool("active").Default(true),
+		field.String("created_by"),
+		field.Int64("created_at").DefaultFunc(func() int64 {
+			return time.Now().Unix()
+		}).Immutable(),
+		field.Int64("updated_at").
+			DefaultFunc(func() int64 {
+				return time.Now().Unix()
+			}).
+			UpdateDefault(func() int64 {
+				return time.Now().Unix()
+			}),
+	}
+}
+
+// Edges of the WebhookSubscription.
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
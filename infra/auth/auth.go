@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// ErrInvalidAPIKey is returned for unknown API keys.
+var ErrInvalidAPIKey = errors.New("auth: invalid api key")
+
+// FXModule represents a FX module for the Authenticator and the Policy.
+var FXModule = fx.Provide(
+	func(v *viper.Viper) (*Authenticator, error) {
+		return New(Options.ConfigLoader(v))
+	},
+	LoadPolicy,
+)
+
+// APIKey is an API key known by its SHA-256 hash.
+type APIKey struct {
+	Name string `mapstructure:"name"`
+	// Hash is the hex encoded SHA-256 of the key.
+	Hash  string   `mapstructure:"hash"`
+	Roles []string `mapstructure:"roles"`
+}
+
+// HashAPIKey returns the hex encoded SHA-256 of key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Option is a function that sets some option on the Authenticator.
+type Option func(*Authenticator) error
+
+// Options is a factory for all available Authenticator's options.
+var Options options
+
+type options struct{}
+
+// JWT is an Option to authenticate players by JWTs verified by v.
+func (options) JWT(v *JWTVerifier) Option {
+	return func(a *Authenticator) error {
+		a.jwt = v
+		return nil
+	}
+}
+
+// APIKeys is an Option to authenticate services by API keys.
+func (options) APIKeys(keys ...APIKey) Option {
+	return func(a *Authenticator) error {
+		for _, k := range keys {
+			hash, err := hex.DecodeString(k.Hash)
+			if err != nil || len(hash) != sha256.Size {
+				return fmt.Errorf("auth: api key %q: hash must be a hex encoded sha256", k.Name)
+			}
+			a.apiKeys = append(a.apiKeys, apiKey{APIKey: k, hash: hash})
+		}
+		return nil
+	}
+}
+
+// ConfigLoader is an Options to load all options that configured through viper.
+// Authenticator's options that are loaded from viper by following keys:
+//
+//   - auth.jwt.jwks_file, auth.jwt.jwks_url
+//     JWKS of the keys signing player tokens, JWTs are rejected without any.
+//   - auth.jwt.refresh_interval
+//     how often keys of the jwks url are reloaded, defaults to 10m.
+//   - auth.jwt.issuer, auth.jwt.audience, auth.jwt.leeway
+//     expected claims of player tokens and the clock skew tolerated.
+//   - auth.api_keys
+//     list of name, hash and roles of the API keys.
+func (options) ConfigLoader(v *viper.Viper) Option {
+	return func(a *Authenticator) error {
+		cfg := struct {
+			Auth struct {
+				JWT struct {
+					JWKSFile        string        `mapstructure:"jwks_file"`
+					JWKSURL         string        `mapstructure:"jwks_url"`
+					RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+					Issuer          string        `mapstructure:"issuer"`
+					Audience        string        `mapstructure:"audience"`
+					Leeway          time.Duration `mapstructure:"leeway"`
+				} `mapstructure:"jwt"`
+				APIKeys []APIKey `mapstructure:"api_keys"`
+			} `mapstructure:"auth"`
+		}{}
+
+		if err := viperutil.Unmarshal(v, &cfg); err != nil {
+			return err
+		}
+
+		jwt := cfg.Auth.JWT
+		var keys *KeySet
+		switch {
+		case jwt.JWKSFile != "":
+			keys = NewFileKeySet(jwt.JWKSFile)
+		case jwt.JWKSURL != "":
+			refresh := jwt.RefreshInterval
+			if refresh <= 0 {
+				refresh = 10 * time.Minute
+			}
+			keys = NewURLKeySet(jwt.JWKSURL, refresh)
+		}
+
+		opts := []Option{
+			Options.APIKeys(cfg.Auth.APIKeys...),
+		}
+		if keys != nil {
+			opts = append(opts, Options.JWT(NewJWTVerifier(keys, jwt.Issuer, jwt.Audience, jwt.Leeway)))
+		}
+
+		for _, opt := range opts {
+			if err := opt(a); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Authenticator authenticates players by JWT bearer tokens and services by
+// API keys.
+type Authenticator struct {
+	jwt     *JWTVerifier
+	apiKeys []apiKey
+}
+
+type apiKey struct {
+	APIKey
+	hash []byte
+}
+
+// New creates and returns new instance of Authenticator.
+func New(opts ...Option) (*Authenticator, error) {
+	a := &Authenticator{}
+	for _, opt := range opts {
+		if err := opt(a); err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+// AuthenticateToken authenticates a player by a JWT.
+func (a *Authenticator) AuthenticateToken(ctx context.Context, token string) (*Principal, error) {
+	if a.jwt == nil {
+		return nil, ErrInvalidToken
+	}
+
+	claims, err := a.jwt.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Principal{
+		ID:    claims.Subject,
+		Type:  PrincipalPlayer,
+		Roles: claims.Roles,
+	}, nil
+}
+
+// AuthenticateAPIKey authenticates a service by an API key.
+func (a *Authenticator) AuthenticateAPIKey(key string) (*Principal, error) {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(key)))
+	for _, k := range a.apiKeys {
+		if subtle.ConstantTimeCompare(sum[:], k.hash) == 1 {
+			return &Principal{
+				ID:    k.Name,
+				Type:  PrincipalService,
+				Roles: k.Roles,
+			}, nil
+		}
+	}
+
+	return nil, ErrInvalidAPIKey
+}
@@ -0,0 +1,275 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator_AuthenticateToken(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwksOf(t, map[string]crypto.PublicKey{
+		"rsa": &rsaKey.PublicKey,
+		"ec":  &ecKey.PublicKey,
+	}), 0o600))
+
+	now := time.Unix(1_800_000_000, 0)
+	claims := func(mod func(c map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{
+			"sub":   "player_1",
+			"iss":   "https://auth.example.com",
+			"aud":   []string{"game-service"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"roles": []string{"player"},
+		}
+		if mod != nil {
+			mod(c)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    *Principal
+		wantErr bool
+	}{
+		{
+			name:  "TC01 - RS256 token - should authenticate the player",
+			token: sign(t, "RS256", "rsa", rsaKey, claims(nil)),
+			want:  &Principal{ID: "player_1", Type: PrincipalPlayer, Roles: []string{"player"}},
+		},
+		{
+			name:  "TC02 - ES256 token - should authenticate the player",
+			token: sign(t, "ES256", "ec", ecKey, claims(nil)),
+			want:  &Principal{ID: "player_1", Type: PrincipalPlayer, Roles: []string{"player"}},
+		},
+		{
+			name: "TC03 - expired token - should fail",
+			token: sign(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) {
+				c["exp"] = now.Add(-time.Hour).Unix()
+			})),
+			wantErr: true,
+		},
+		{
+			name: "TC04 - unexpected audience - should fail",
+			token: sign(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) {
+				c["aud"] = "other-service"
+			})),
+			wantErr: true,
+		},
+		{
+			name:    "TC05 - algorithm not matching the key - should fail",
+			token:   sign(t, "ES256", "rsa", ecKey, claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "TC06 - unknown key - should fail",
+			token:   sign(t, "RS256", "other", rsaKey, claims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "TC07 - unsigned token - should fail",
+			token:   segment(t, map[string]string{"alg": "none", "kid": "rsa"}) + "." + segment(t, claims(nil)) + ".",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewJWTVerifier(NewFileKeySet(path), "https://auth.example.com", "game-service", 0)
+			v.now = func() time.Time { return now }
+			a, err := New(Options.JWT(v))
+			require.NoError(t, err)
+
+			got, err := a.AuthenticateToken(context.Background(), tt.token)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidToken)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestKeySet_Key(t *testing.T) {
+	first, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rotated, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keys := map[string]crypto.PublicKey{"first": &first.PublicKey}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(jwksOf(t, keys))
+	}))
+	defer srv.Close()
+
+	now := time.Unix(1_800_000_000, 0)
+	s := NewURLKeySet(srv.URL, time.Hour)
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	_, err = s.Key(ctx, "first")
+	require.NoError(t, err)
+
+	keys["rotated"] = &rotated.PublicKey
+	_, err = s.Key(ctx, "rotated")
+	assert.ErrorIs(t, err, ErrUnknownKey, "should not reload within a minute")
+
+	now = now.Add(2 * time.Minute)
+	_, err = s.Key(ctx, "rotated")
+	assert.NoError(t, err, "should reload on unknown key")
+}
+
+func TestKeySet_Key_Outage(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	var down atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(jwksOf(t, map[string]crypto.PublicKey{"first": &key.PublicKey}))
+	}))
+	defer srv.Close()
+
+	var mu sync.Mutex
+	now := time.Unix(1_800_000_000, 0)
+	s := NewURLKeySet(srv.URL, time.Hour)
+	s.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	settled := func() bool {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return s.loading == nil
+	}
+	ctx := context.Background()
+
+	_, err = s.Key(ctx, "first")
+	require.NoError(t, err)
+
+	down.Store(true)
+	advance(2 * time.Hour)
+	got, err := s.Key(ctx, "first")
+	assert.NoError(t, err, "should serve the cached key while reloading")
+	assert.Equal(t, &key.PublicKey, got)
+	assert.Eventually(t, func() bool { return fetches.Load() == 2 && settled() }, time.Second, time.Millisecond)
+
+	_, err = s.Key(ctx, "first")
+	assert.NoError(t, err)
+	_, err = s.Key(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, int32(2), fetches.Load(), "should back off after a failed reload")
+
+	advance(2 * time.Second)
+	_, err = s.Key(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, int32(3), fetches.Load(), "should reload once backed off")
+}
+
+func TestAuthenticator_AuthenticateAPIKey(t *testing.T) {
+	a, err := New(Options.APIKeys(APIKey{
+		Name:  "backoffice",
+		Hash:  HashAPIKey("s3cr3t"),
+		Roles: []string{"admin"},
+	}))
+	require.NoError(t, err)
+
+	got, err := a.AuthenticateAPIKey("s3cr3t")
+	assert.NoError(t, err)
+	assert.Equal(t, &Principal{ID: "backoffice", Type: PrincipalService, Roles: []string{"admin"}}, got)
+
+	_, err = a.AuthenticateAPIKey("guess")
+	assert.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	_, err = New(Options.APIKeys(APIKey{Name: "clear", Hash: "s3cr3t"}))
+	assert.Error(t, err, "should reject keys not given by their hash")
+}
+
+func jwksOf(t *testing.T, keys map[string]crypto.PublicKey) []byte {
+	enc := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+
+	var set []map[string]string
+	for kid, key := range keys {
+		switch k := key.(type) {
+		case *rsa.PublicKey:
+			set = append(set, map[string]string{
+				"kty": "RSA", "kid": kid, "use": "sig",
+				"n": enc(k.N), "e": enc(big.NewInt(int64(k.E))),
+			})
+		case *ecdsa.PublicKey:
+			set = append(set, map[string]string{
+				"kty": "EC", "kid": kid, "crv": "P-256",
+				"x": enc(k.X), "y": enc(k.Y),
+			})
+		}
+	}
+
+	b, err := json.Marshal(map[string]interface{}{"keys": set})
+	require.NoError(t, err)
+
+	return b
+}
+
+func sign(t *testing.T, alg, kid string, key crypto.Signer, claims interface{}) string {
+	input := segment(t, map[string]string{"alg": alg, "kid": kid, "typ": "JWT"}) + "." + segment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		require.NoError(t, err)
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func segment(t *testing.T, v interface{}) string {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,261 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrUnknownKey is returned when no key of the key set matches a token.
+var ErrUnknownKey = errors.New("auth: unknown signing key")
+
+// jwks is a JSON Web Key Set, see RFC 7517.
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Alg string `json:"alg"`
+		Use string `json:"use"`
+		// RSA keys.
+		N string `json:"n"`
+		E string `json:"e"`
+		// EC keys.
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	} `json:"keys"`
+}
+
+// maxRefreshBackoff is the max delay between failed refreshes of a KeySet.
+const maxRefreshBackoff = time.Minute
+
+// KeySet holds the public keys verifying JWTs by key id, loaded from a JWKS
+// file or URL. Keys loaded from a URL are reloaded every refresh interval,
+// and on unknown key ids at most once a minute. Concurrent reloads are
+// deduplicated, failed ones are retried after a backoff while the keys
+// already loaded are still served.
+type KeySet struct {
+	mu      sync.RWMutex
+	load    func(context.Context) ([]byte, error)
+	refresh time.Duration
+	keys    map[string]crypto.PublicKey
+	loaded  time.Time
+	// loading is closed once the reload in flight is done, nil when none is.
+	loading chan struct{}
+	// failures counts the consecutive failed reloads, the next one is not
+	// tried before retryAt.
+	failures int
+	retryAt  time.Time
+	lastErr  error
+	now      func() time.Time
+}
+
+// NewFileKeySet creates a KeySet loaded from a JWKS file.
+func NewFileKeySet(path string) *KeySet {
+	return &KeySet{
+		load: func(context.Context) ([]byte, error) {
+			return os.ReadFile(path)
+		},
+		now: time.Now,
+	}
+}
+
+// NewURLKeySet creates a KeySet loaded from a JWKS URL.
+func NewURLKeySet(url string, refresh time.Duration) *KeySet {
+	client := &http.Client{Timeout: 10 * time.Second}
+	return &KeySet{
+		load: func(ctx context.Context) ([]byte, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				return nil, err
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				return nil, fmt.Errorf("auth: fetching jwks: %s", res.Status)
+			}
+
+			return io.ReadAll(io.LimitReader(res.Body, 1<<20))
+		},
+		refresh: refresh,
+		now:     time.Now,
+	}
+}
+
+// Key returns the public key of id. Known keys of a stale key set are served
+// while it reloads in background, unknown ones wait for the reload.
+func (s *KeySet) Key(ctx context.Context, id string) (crypto.PublicKey, error) {
+	s.mu.RLock()
+	now := s.now()
+	key, ok := s.keys[id]
+	loaded := s.keys != nil
+	stale := !loaded ||
+		(s.refresh > 0 && now.Sub(s.loaded) > s.refresh) ||
+		(!ok && s.refresh > 0 && now.Sub(s.loaded) > time.Minute)
+	inFlight := s.loading != nil
+	due := !now.Before(s.retryAt)
+	lastErr := s.lastErr
+	s.mu.RUnlock()
+
+	switch {
+	case !stale:
+	case ok:
+		if due && !inFlight {
+			go func() {
+				if err := s.reload(context.WithoutCancel(ctx)); err != nil {
+					log.Printf("auth: serving cached jwks: %v", err)
+				}
+			}()
+		}
+	case due || inFlight:
+		err := s.reload(ctx)
+		if err != nil && !loaded {
+			return nil, err
+		}
+
+		s.mu.RLock()
+		key, ok = s.keys[id]
+		s.mu.RUnlock()
+	case !loaded:
+		return nil, lastErr
+	}
+
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+// reload loads the keys of the key set, joining the load in flight if any.
+// Failed loads back off from a second, doubling up to maxRefreshBackoff.
+func (s *KeySet) reload(ctx context.Context) error {
+	s.mu.Lock()
+	if loading := s.loading; loading != nil {
+		s.mu.Unlock()
+		select {
+		case <-loading:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return s.lastErr
+	}
+	loading := make(chan struct{})
+	s.loading = loading
+	s.mu.Unlock()
+
+	err := s.Load(ctx)
+
+	s.mu.Lock()
+	s.loading = nil
+	s.lastErr = err
+	if err != nil {
+		s.failures++
+		s.retryAt = s.now().Add(min(time.Second<<min(s.failures-1, 10), maxRefreshBackoff))
+	} else {
+		s.failures = 0
+		s.retryAt = time.Time{}
+	}
+	s.mu.Unlock()
+	close(loading)
+
+	return err
+}
+
+// Load loads the keys of the key set.
+func (s *KeySet) Load(ctx context.Context) error {
+	b, err := s.load(ctx)
+	if err != nil {
+		return fmt.Errorf("auth: loading jwks: %w", err)
+	}
+
+	keys, err := parseJWKS(b)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = keys
+	s.loaded = s.now()
+
+	return nil
+}
+
+// parseJWKS parses the RSA and P-256 EC signing keys of a JWKS.
+func parseJWKS(b []byte) (map[string]crypto.PublicKey, error) {
+	var set jwks
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("auth: parsing jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		switch k.Kty {
+		case "RSA":
+			n, err := decodeBigInt(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("auth: key %s: %w", k.Kid, err)
+			}
+			e, err := decodeBigInt(k.E)
+			if err != nil {
+				return nil, fmt.Errorf("auth: key %s: %w", k.Kid, err)
+			}
+			keys[k.Kid] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case "EC":
+			if k.Crv != "P-256" {
+				continue
+			}
+			x, err := decodeBigInt(k.X)
+			if err != nil {
+				return nil, fmt.Errorf("auth: key %s: %w", k.Kid, err)
+			}
+			y, err := decodeBigInt(k.Y)
+			if err != nil {
+				return nil, fmt.Errorf("auth: key %s: %w", k.Kid, err)
+			}
+			key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+			if !key.Curve.IsOnCurve(x, y) { //nolint:staticcheck // validates untrusted input.
+				return nil, fmt.Errorf("auth: key %s: point is not on curve", k.Kid)
+			}
+			keys[k.Kid] = key
+		}
+	}
+
+	return keys, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
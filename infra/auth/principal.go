@@ -0,0 +1,33 @@
+// Package auth authenticates the callers of the service.
+package auth
+
+import "context"
+
+// Principal types.
+const (
+	// PrincipalPlayer is a player authenticated by a JWT.
+	PrincipalPlayer = "player"
+	// PrincipalService is a service or an admin authenticated by an API key.
+	PrincipalService = "service"
+)
+
+// Principal is an authenticated caller.
+type Principal struct {
+	// ID is the subject of the JWT of a player, or the name of an API key.
+	ID    string
+	Type  string
+	Roles []string
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying p.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of ctx, or nil for anonymous callers.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
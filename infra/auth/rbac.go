@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/nhatquangsin/game-service/infra/utils/endpoint"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// Permissions of catalog, economy, webhook, quest, leaderboard and player
+// operations.
+const (
+	PermissionCatalogRead       = "catalog:read"
+	PermissionCatalogWrite      = "catalog:write"
+	PermissionCatalogPublish    = "catalog:publish"
+	PermissionEconomyAdmin      = "economy:admin"
+	PermissionWebhookAdmin      = "webhook:admin"
+	PermissionQuestAdmin        = "quest:admin"
+	PermissionQuestIngest       = "quest:ingest"
+	PermissionLeaderboardAdmin  = "leaderboard:admin"
+	PermissionLeaderboardSubmit = "leaderboard:submit"
+	PermissionPlayerRead        = "player:read"
+	PermissionPlayerAdmin       = "player:admin"
+	PermissionInstanceMint      = "instance:mint"
+)
+
+// AllPermissions lists all permissions, in the order they are reported.
+var AllPermissions = []string{
+	PermissionCatalogRead,
+	PermissionCatalogWrite,
+	PermissionCatalogPublish,
+	PermissionEconomyAdmin,
+	PermissionWebhookAdmin,
+	PermissionQuestAdmin,
+	PermissionQuestIngest,
+	PermissionLeaderboardAdmin,
+	PermissionLeaderboardSubmit,
+	PermissionPlayerRead,
+	PermissionPlayerAdmin,
+	PermissionInstanceMint,
+}
+
+var (
+	// ErrUnauthenticated is returned when anonymous callers lack a
+	// permission.
+	ErrUnauthenticated = errors.New("auth: unauthenticated")
+	// ErrPermissionDenied is returned when principals lack a permission.
+	ErrPermissionDenied = errors.New("auth: permission denied")
+)
+
+// DefaultRoles are the roles of a Policy not configured otherwise.
+var DefaultRoles = map[string][]string{
+	"viewer":     {PermissionCatalogRead},
+	"designer":   {PermissionCatalogRead, PermissionCatalogWrite},
+	"publisher":  {PermissionCatalogRead, PermissionCatalogWrite, PermissionCatalogPublish},
+	"economist":  {PermissionCatalogRead, PermissionEconomyAdmin},
+	"gameserver": {PermissionCatalogRead, PermissionQuestIngest, PermissionLeaderboardSubmit, PermissionPlayerRead, PermissionInstanceMint},
+	"admin":      {"*"},
+}
+
+// Policy grants permissions to principals by their roles.
+//
+// Granted permissions are either a permission, "<resource>:*" granting all
+// permissions of a resource, or "*" granting all permissions.
+type Policy struct {
+	roles map[string][]string
+	// anonymous are the roles of every caller, authenticated or not.
+	anonymous []string
+}
+
+// NewPolicy creates and returns new instance of Policy granting every caller
+// the roles anonymous.
+func NewPolicy(roles map[string][]string, anonymous ...string) *Policy {
+	return &Policy{
+		roles:     roles,
+		anonymous: anonymous,
+	}
+}
+
+// LoadPolicy loads the Policy configured through viper by the keys:
+//
+//   - auth.rbac.roles
+//     permissions by role, defaults to DefaultRoles.
+//   - auth.rbac.anonymous_roles
+//     roles of every caller, defaults to viewer.
+func LoadPolicy(v *viper.Viper) (*Policy, error) {
+	cfg := struct {
+		Auth struct {
+			RBAC struct {
+				Roles          map[string][]string `mapstructure:"roles"`
+				AnonymousRoles []string            `mapstructure:"anonymous_roles"`
+			} `mapstructure:"rbac"`
+		} `mapstructure:"auth"`
+	}{}
+
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	rbac := cfg.Auth.RBAC
+	if rbac.Roles == nil {
+		rbac.Roles = DefaultRoles
+	}
+	if !v.IsSet("auth.rbac.anonymous_roles") {
+		rbac.AnonymousRoles = []string{"viewer"}
+	}
+
+	for role, perms := range rbac.Roles {
+		for _, perm := range perms {
+			if !validGrant(perm) {
+				return nil, fmt.Errorf("auth: role %q: unknown permission %q", role, perm)
+			}
+		}
+	}
+
+	return NewPolicy(rbac.Roles, rbac.AnonymousRoles...), nil
+}
+
+// Allowed reports whether p, nil for anonymous callers, is granted
+// permission.
+func (pol *Policy) Allowed(p *Principal, permission string) bool {
+	for _, role := range pol.rolesOf(p) {
+		for _, grant := range pol.roles[role] {
+			if grants(grant, permission) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Permissions returns the permissions of AllPermissions granted to p, nil
+// for anonymous callers.
+func (pol *Policy) Permissions(p *Principal) []string {
+	perms := []string{}
+	for _, perm := range AllPermissions {
+		if pol.Allowed(p, perm) {
+			perms = append(perms, perm)
+		}
+	}
+
+	return perms
+}
+
+func (pol *Policy) rolesOf(p *Principal) []string {
+	if p == nil {
+		return pol.anonymous
+	}
+
+	return append(append([]string{}, pol.anonymous...), p.Roles...)
+}
+
+// Authorize returns nil when the principal of ctx is granted permission,
+// else ErrUnauthenticated for anonymous callers and ErrPermissionDenied for
+// others.
+func (pol *Policy) Authorize(ctx context.Context, permission string) error {
+	p := FromContext(ctx)
+	if pol.Allowed(p, permission) {
+		return nil
+	}
+
+	if p == nil {
+		return fmt.Errorf("%w: %s required", ErrUnauthenticated, permission)
+	}
+
+	return fmt.Errorf("%w: %s required", ErrPermissionDenied, permission)
+}
+
+// RequirePermission returns an endpoint.Middleware rejecting the calls not
+// authorized for permission by policy.
+func RequirePermission(policy *Policy, permission string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if err := policy.Authorize(ctx, permission); err != nil {
+				return nil, err
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+func grants(grant, permission string) bool {
+	if grant == "*" || grant == permission {
+		return true
+	}
+
+	resource, ok := strings.CutSuffix(grant, ":*")
+	return ok && strings.HasPrefix(permission, resource+":")
+}
+
+func validGrant(grant string) bool {
+	for _, perm := range AllPermissions {
+		if grants(grant, perm) {
+			return true
+		}
+	}
+
+	return false
+}
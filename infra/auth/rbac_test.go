@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequirePermission(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+auth:
+  rbac:
+    anonymous_roles: []
+    roles:
+      viewer: [catalog:read]
+      designer: ["catalog:*"]
+      admin: ["*"]
+`)))
+
+	policy, err := LoadPolicy(v)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		principal  *Principal
+		permission string
+		wantErr    error
+	}{
+		{
+			name:       "TC01 - anonymous caller - should be unauthenticated",
+			permission: PermissionCatalogRead,
+			wantErr:    ErrUnauthenticated,
+		},
+		{
+			name:       "TC02 - role granting the permission - should be allowed",
+			principal:  &Principal{ID: "p1", Type: PrincipalPlayer, Roles: []string{"viewer"}},
+			permission: PermissionCatalogRead,
+		},
+		{
+			name:       "TC03 - role not granting the permission - should be denied",
+			principal:  &Principal{ID: "p1", Type: PrincipalPlayer, Roles: []string{"viewer"}},
+			permission: PermissionCatalogWrite,
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "TC04 - role granting all permissions of the resource - should be allowed",
+			principal:  &Principal{ID: "ui", Type: PrincipalService, Roles: []string{"designer"}},
+			permission: PermissionCatalogPublish,
+		},
+		{
+			name:       "TC05 - role granting all permissions of another resource - should be denied",
+			principal:  &Principal{ID: "ui", Type: PrincipalService, Roles: []string{"designer"}},
+			permission: PermissionEconomyAdmin,
+			wantErr:    ErrPermissionDenied,
+		},
+		{
+			name:       "TC06 - unknown role - should be denied",
+			principal:  &Principal{ID: "ui", Type: PrincipalService, Roles: []string{"ghost"}},
+			permission: PermissionCatalogRead,
+			wantErr:    ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = NewContext(ctx, tt.principal)
+			}
+
+			called := false
+			e := RequirePermission(policy, tt.permission)(func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			})
+
+			_, err := e(ctx, nil)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, called)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, called)
+		})
+	}
+
+	assert.Equal(t, AllPermissions, policy.Permissions(&Principal{Roles: []string{"admin"}}))
+	assert.Equal(t, []string{}, policy.Permissions(nil))
+}
+
+func TestLoadPolicy(t *testing.T) {
+	policy, err := LoadPolicy(viper.New())
+	require.NoError(t, err)
+	assert.Equal(t, []string{PermissionCatalogRead}, policy.Permissions(nil), "should default to viewers")
+
+	v := viper.New()
+	v.Set("auth.rbac.roles", map[string][]string{"designer": {"catalog:delete"}})
+	_, err = LoadPolicy(v)
+	assert.Error(t, err, "should reject unknown permissions")
+}
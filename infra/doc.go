@@ -1,3 +1,3 @@
 package entc
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/modifier --feature sql/upsert --target ./entc ../../domain/schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/modifier --feature sql/upsert --feature sql/versioned-migration --feature sql/execquery --target ./repo/entc ../domain/schema
@@ -0,0 +1,80 @@
+package i18n
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// csvHeader is the header row of translation CSV files.
+var csvHeader = []string{"item_id", "locale", "name", "description"}
+
+// EncodeCSV writes translations as CSV with a header row.
+func EncodeCSV(w io.Writer, translations []*entity.ItemTranslation) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, t := range translations {
+		if err := cw.Write([]string{t.ItemID, t.Locale, t.Name, t.Description}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// DecodeCSV reads translations from CSV written by EncodeCSV. Columns are
+// matched by the header row so they may appear in any order.
+func DecodeCSV(r io.Reader) ([]*entity.ItemTranslation, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cols := make(map[string]int)
+	for i, h := range header {
+		cols[h] = i
+	}
+	for _, h := range csvHeader[:3] {
+		if _, ok := cols[h]; !ok {
+			return nil, fmt.Errorf("csv: missing column %q", h)
+		}
+	}
+
+	get := func(record []string, col string) string {
+		if i, ok := cols[col]; ok && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	var res []*entity.ItemTranslation
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &entity.ItemTranslation{
+			ItemID:      get(record, "item_id"),
+			Locale:      Normalize(get(record, "locale")),
+			Name:        get(record, "name"),
+			Description: get(record, "description"),
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is the locale of the name and description stored directly on
+// items. Every fallback chain ends with it.
+const DefaultLocale = "en"
+
+// Normalize returns the canonical form of a BCP 47 language tag, e.g.
+// "pt_br" => "pt-BR", "zh-hant-tw" => "zh-Hant-TW".
+func Normalize(tag string) string {
+	tag = strings.TrimSpace(strings.ReplaceAll(tag, "_", "-"))
+	if tag == "" || tag == "*" {
+		return ""
+	}
+
+	parts := strings.Split(tag, "-")
+	for i, p := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(p)
+		case len(p) == 2:
+			parts[i] = strings.ToUpper(p)
+		case len(p) == 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:])
+		default:
+			parts[i] = strings.ToLower(p)
+		}
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// Fallbacks returns the fallback chain of a locale by removing subtags from
+// the right, e.g. "zh-Hant-TW" => ["zh-Hant-TW", "zh-Hant", "zh"].
+func Fallbacks(locale string) []string {
+	locale = Normalize(locale)
+	if locale == "" {
+		return nil
+	}
+
+	var res []string
+	for {
+		res = append(res, locale)
+		idx := strings.LastIndexByte(locale, '-')
+		if idx < 0 {
+			return res
+		}
+		locale = locale[:idx]
+	}
+}
+
+// Chain builds the full fallback chain of the preferred locales in order,
+// terminated by DefaultLocale, e.g. ["pt-BR", "fr"] => ["pt-BR", "pt", "fr", "en"].
+func Chain(preferred ...string) []string {
+	seen := make(map[string]bool)
+	var res []string
+	for _, p := range preferred {
+		for _, l := range Fallbacks(p) {
+			if !seen[l] {
+				seen[l] = true
+				res = append(res, l)
+			}
+		}
+	}
+
+	if !seen[DefaultLocale] {
+		res = append(res, DefaultLocale)
+	}
+
+	return res
+}
+
+// ParseAcceptLanguage parses the value of an Accept-Language header and
+// returns the normalized locales ordered by their quality value. Locales with
+// q=0 and the wildcard are dropped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var ws []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		locale := Normalize(fields[0])
+		if locale == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			v, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+			if err == nil {
+				q = v
+			}
+		}
+
+		if q <= 0 {
+			continue
+		}
+
+		ws = append(ws, weighted{locale: locale, q: q})
+	}
+
+	sort.SliceStable(ws, func(i, j int) bool {
+		return ws[i].q > ws[j].q
+	})
+
+	res := make([]string, 0, len(ws))
+	for _, w := range ws {
+		res = append(res, w.locale)
+	}
+
+	return res
+}
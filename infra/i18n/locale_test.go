@@ -0,0 +1,71 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name      string
+		preferred []string
+		want      []string
+	}{
+		{
+			name: "TC01 - no preferred locale - should return default locale",
+			want: []string{"en"},
+		},
+		{
+			name:      "TC02 - region locale - should fall back to language then default",
+			preferred: []string{"pt-BR"},
+			want:      []string{"pt-BR", "pt", "en"},
+		},
+		{
+			name:      "TC03 - multiple locales - should keep order and deduplicate",
+			preferred: []string{"pt_br", "pt-PT", "en-GB"},
+			want:      []string{"pt-BR", "pt", "pt-PT", "en-GB", "en"},
+		},
+		{
+			name:      "TC04 - script subtag - should normalize case",
+			preferred: []string{"ZH-hant-tw"},
+			want:      []string{"zh-Hant-TW", "zh-Hant", "zh", "en"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Chain(tt.preferred...))
+		})
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   []string
+	}{
+		{
+			name:   "TC01 - empty header - should return no locale",
+			header: "",
+			want:   []string{},
+		},
+		{
+			name:   "TC02 - weighted locales - should order by quality",
+			header: "fr;q=0.5, pt-BR, en;q=0.8",
+			want:   []string{"pt-BR", "en", "fr"},
+		},
+		{
+			name:   "TC03 - wildcard and q=0 - should be dropped",
+			header: "*, de;q=0, vi-vn;q=0.9",
+			want:   []string{"vi-VN"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseAcceptLanguage(tt.header))
+		})
+	}
+}
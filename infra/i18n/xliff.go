@@ -0,0 +1,138 @@
+package i18n
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// Suffixes of XLIFF trans-unit ids, the unit id of an item's name is
+// "<itemID>.name".
+const (
+	xliffUnitName        = ".name"
+	xliffUnitDescription = ".description"
+)
+
+type xliffDoc struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr"`
+	Datatype       string      `xml:"datatype,attr"`
+	Original       string      `xml:"original,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+type xliffUnit struct {
+	ID     string `xml:"id,attr"`
+	Source string `xml:"source"`
+	Target string `xml:"target,omitempty"`
+}
+
+// EncodeXLIFF writes an XLIFF 1.2 document for the target locale. Each item
+// contributes a name unit and, when it has a description, a description unit.
+// Existing translations are filled in as targets.
+func EncodeXLIFF(
+	w io.Writer,
+	locale string,
+	items []*entity.Item,
+	translations map[string]*entity.ItemTranslation,
+) error {
+	doc := xliffDoc{
+		Version: "1.2",
+		File: xliffFile{
+			SourceLanguage: DefaultLocale,
+			TargetLanguage: Normalize(locale),
+			Datatype:       "plaintext",
+			Original:       "items",
+		},
+	}
+
+	for _, item := range items {
+		var t entity.ItemTranslation
+		if tr, ok := translations[item.ID]; ok {
+			t = *tr
+		}
+
+		doc.File.Units = append(doc.File.Units, xliffUnit{
+			ID:     item.ID + xliffUnitName,
+			Source: item.Name,
+			Target: t.Name,
+		})
+		if item.Description != "" {
+			doc.File.Units = append(doc.File.Units, xliffUnit{
+				ID:     item.ID + xliffUnitDescription,
+				Source: item.Description,
+				Target: t.Description,
+			})
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// DecodeXLIFF reads translations from an XLIFF 1.2 document. Units without a
+// target are skipped.
+func DecodeXLIFF(r io.Reader) ([]*entity.ItemTranslation, error) {
+	var doc xliffDoc
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	locale := Normalize(doc.File.TargetLanguage)
+	if locale == "" {
+		return nil, fmt.Errorf("xliff: missing target-language")
+	}
+
+	byItem := make(map[string]*entity.ItemTranslation)
+	var res []*entity.ItemTranslation
+	for _, u := range doc.File.Units {
+		if u.Target == "" {
+			continue
+		}
+
+		var itemID string
+		var isName bool
+		switch {
+		case strings.HasSuffix(u.ID, xliffUnitName):
+			itemID, isName = strings.TrimSuffix(u.ID, xliffUnitName), true
+		case strings.HasSuffix(u.ID, xliffUnitDescription):
+			itemID = strings.TrimSuffix(u.ID, xliffUnitDescription)
+		default:
+			return nil, fmt.Errorf("xliff: unknown trans-unit id %q", u.ID)
+		}
+
+		t, ok := byItem[itemID]
+		if !ok {
+			t = &entity.ItemTranslation{ItemID: itemID, Locale: locale}
+			byItem[itemID] = t
+			res = append(res, t)
+		}
+
+		if isName {
+			t.Name = u.Target
+		} else {
+			t.Description = u.Target
+		}
+	}
+
+	return res, nil
+}
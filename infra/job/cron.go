@@ -0,0 +1,183 @@
+package job
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron schedule.
+type Schedule interface {
+	// Next returns the first time of the schedule after t.
+	Next(t time.Time) time.Time
+}
+
+// ParseSchedule parses a standard cron expression of five fields, minute hour
+// day-of-month month day-of-week, supporting "*", ranges "1-5", steps "*/15"
+// and lists "1,15". Descriptors @yearly, @monthly, @weekly, @daily, @hourly
+// and "@every <duration>" are supported as well. Times are in UTC.
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if every, ok := strings.CutPrefix(spec, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(every))
+		if err != nil || d < time.Second {
+			return nil, fmt.Errorf("job: schedule %q: invalid interval", spec)
+		}
+		return everySchedule(d.Truncate(time.Second)), nil
+	}
+
+	switch spec {
+	case "@yearly", "@annually":
+		spec = "0 0 1 1 *"
+	case "@monthly":
+		spec = "0 0 1 * *"
+	case "@weekly":
+		spec = "0 0 * * 0"
+	case "@daily", "@midnight":
+		spec = "0 0 * * *"
+	case "@hourly":
+		spec = "0 * * * *"
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("job: schedule %q: expected 5 fields", spec)
+	}
+
+	var s cronSchedule
+	var err error
+	bounds := []struct {
+		dst      *uint64
+		min, max int
+	}{
+		{&s.minute, 0, 59},
+		{&s.hour, 0, 23},
+		{&s.dom, 1, 31},
+		{&s.month, 1, 12},
+		{&s.dow, 0, 7},
+	}
+	for i, b := range bounds {
+		if *b.dst, err = parseField(fields[i], b.min, b.max); err != nil {
+			return nil, fmt.Errorf("job: schedule %q: %w", spec, err)
+		}
+	}
+
+	// 7 is another name of sunday.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domAll = fields[2] == "*"
+	s.dowAll = fields[4] == "*"
+
+	return &s, nil
+}
+
+// parseField returns the bit set of the values of a field.
+func parseField(field string, min, max int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+
+		lo, hi := min, max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = strconv.Atoi(loStr); err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(hiStr); err != nil {
+					return 0, fmt.Errorf("invalid value %q", part)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("value %q out of range %d-%d", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+
+	return set, nil
+}
+
+// cronSchedule holds the bit sets of the values of each field.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// day of month and day of week restrict days together only when both
+	// are set, else the set one does.
+	domAll, dowAll bool
+}
+
+// Next implements Schedule.
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	// no schedule is more than 4 years apart, e.g. on february 29th.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Duration(nextBit(s.minute, t.Minute())-t.Minute()) * time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.domAll && s.dowAll:
+		return true
+	case s.domAll:
+		return dow
+	case s.dowAll:
+		return dom
+	}
+
+	return dom || dow
+}
+
+// nextBit returns the lowest bit of set above from, or 60 past the last
+// minute.
+func nextBit(set uint64, from int) int {
+	rest := set >> uint(from) << uint(from)
+	if rest == 0 {
+		return 60
+	}
+
+	return bits.TrailingZeros64(rest)
+}
+
+// everySchedule runs at fixed intervals, times are multiples of the interval
+// so that replicas agree on them.
+type everySchedule time.Duration
+
+// Next implements Schedule.
+func (e everySchedule) Next(t time.Time) time.Time {
+	d := time.Duration(e)
+	return t.Truncate(d).Add(d)
+}
@@ -0,0 +1,83 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	from := time.Date(2026, 10, 19, 10, 17, 30, 0, time.UTC) // a Monday
+
+	tests := []struct {
+		name    string
+		spec    string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "TC01 - every minute - should be the next minute",
+			spec: "* * * * *",
+			want: time.Date(2026, 10, 19, 10, 18, 0, 0, time.UTC),
+		},
+		{
+			name: "TC02 - step - should be the next multiple",
+			spec: "*/15 * * * *",
+			want: time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "TC03 - hourly - should be the next hour",
+			spec: "@hourly",
+			want: time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "TC04 - list and range - should be the next matching day",
+			spec: "0 3 * * 6,0",
+			want: time.Date(2026, 10, 24, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "TC05 - day of month and week - should match either",
+			spec: "0 0 1 * 3",
+			want: time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "TC06 - past this year - should be next year",
+			spec: "30 8 1-5 2 *",
+			want: time.Date(2027, 2, 1, 8, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "TC07 - every interval - should be the next multiple",
+			spec: "@every 10m",
+			want: time.Date(2026, 10, 19, 10, 20, 0, 0, time.UTC),
+		},
+		{
+			name:    "TC08 - missing field - should fail",
+			spec:    "* * * *",
+			wantErr: true,
+		},
+		{
+			name:    "TC09 - out of range - should fail",
+			spec:    "60 * * * *",
+			wantErr: true,
+		},
+		{
+			name:    "TC10 - interval under a second - should fail",
+			spec:    "@every 10ms",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseSchedule(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Next(from))
+		})
+	}
+}
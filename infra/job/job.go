@@ -0,0 +1,276 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// Kinds of jobs.
+const (
+	KindCron  = "cron"
+	KindQueue = "queue"
+)
+
+// ErrUnknownJob is returned for jobs and queues not registered.
+var ErrUnknownJob = errors.New("job: unknown job")
+
+// FXModule represents a FX module for the Registry of the jobs provided with
+// AsCronJob and AsQueueJob.
+var FXModule = fx.Provide(
+	NewRegistry,
+)
+
+// CronJob is a job run on a schedule, by one replica at a time.
+type CronJob struct {
+	Name string
+	// Schedule is the cron expression of the job, see ParseSchedule. It is
+	// overridden by jobs.schedules.<name>.
+	Schedule string
+	// Timeout cancels runs lasting longer, runs are not canceled when 0.
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// QueueJob is a job handling the tasks enqueued to the queue of its name, at
+// least once and by one replica at a time. Failed tasks are retried with
+// backoff.
+type QueueJob struct {
+	Name   string
+	Handle func(ctx context.Context, task *entity.JobTask) error
+}
+
+// AsCronJob annotates the constructor f of a CronJob to register it.
+func AsCronJob(f any) any {
+	return fx.Annotate(f, fx.ResultTags(`group:"cron_jobs"`))
+}
+
+// AsQueueJob annotates the constructor f of a QueueJob to register it.
+func AsQueueJob(f any) any {
+	return fx.Annotate(f, fx.ResultTags(`group:"queue_jobs"`))
+}
+
+// Config configures jobs.
+type Config struct {
+	// Schedules overrides the schedules of cron jobs by name.
+	Schedules map[string]string `mapstructure:"schedules"`
+	// Disabled are the names of jobs not run.
+	Disabled []string `mapstructure:"disabled"`
+	Queue    struct {
+		// PollInterval is how often queues are polled, and BatchSize the
+		// max number of tasks handled by a poll.
+		PollInterval time.Duration `mapstructure:"poll_interval"`
+		BatchSize    int           `mapstructure:"batch_size"`
+		// MaxAttempts is the number of failed attempts after which a task
+		// fails. Backoff is the delay before its second attempt, doubled by
+		// each failure up to MaxBackoff.
+		MaxAttempts int           `mapstructure:"max_attempts"`
+		Backoff     time.Duration `mapstructure:"backoff"`
+		MaxBackoff  time.Duration `mapstructure:"max_backoff"`
+	} `mapstructure:"queue"`
+}
+
+// Registry holds the jobs of the service, it enqueues tasks and reports the
+// status of jobs. Jobs are run by the Runner of the worker component.
+type Registry struct {
+	cfg       Config
+	cronJobs  []*cronEntry
+	queueJobs []QueueJob
+	runRepo   repo.JobRunRepo
+	taskRepo  repo.JobTaskRepo
+}
+
+type cronEntry struct {
+	CronJob
+	schedule Schedule
+}
+
+// RegistryParams are the dependencies of the Registry.
+type RegistryParams struct {
+	fx.In
+
+	V         *viper.Viper
+	CronJobs  []CronJob  `group:"cron_jobs"`
+	QueueJobs []QueueJob `group:"queue_jobs"`
+	RunRepo   repo.JobRunRepo
+	TaskRepo  repo.JobTaskRepo
+}
+
+// NewRegistry creates the Registry of the jobs of p, configured by the keys:
+//
+//   - jobs.schedules
+//     schedules of cron jobs by name, overriding their default.
+//   - jobs.disabled
+//     names of the jobs not run.
+//   - jobs.queue.poll_interval, jobs.queue.batch_size
+//     how often queues are polled, and the max number of tasks per poll.
+//   - jobs.queue.max_attempts, jobs.queue.backoff, jobs.queue.max_backoff
+//     retries of failed tasks.
+func NewRegistry(p RegistryParams) (*Registry, error) {
+	cfg := struct {
+		Jobs Config `mapstructure:"jobs"`
+	}{}
+	if err := viperutil.Unmarshal(p.V, &cfg); err != nil {
+		return nil, err
+	}
+
+	return newRegistry(cfg.Jobs, p.CronJobs, p.QueueJobs, p.RunRepo, p.TaskRepo)
+}
+
+func newRegistry(
+	cfg Config,
+	cronJobs []CronJob,
+	queueJobs []QueueJob,
+	runRepo repo.JobRunRepo,
+	taskRepo repo.JobTaskRepo,
+) (*Registry, error) {
+	q := &cfg.Queue
+	if q.PollInterval <= 0 {
+		q.PollInterval = time.Second
+	}
+	if q.BatchSize <= 0 {
+		q.BatchSize = 20
+	}
+	if q.MaxAttempts <= 0 {
+		q.MaxAttempts = 5
+	}
+	if q.Backoff <= 0 {
+		q.Backoff = 10 * time.Second
+	}
+	if q.MaxBackoff <= 0 {
+		q.MaxBackoff = 10 * time.Minute
+	}
+
+	r := &Registry{
+		cfg:      cfg,
+		runRepo:  runRepo,
+		taskRepo: taskRepo,
+	}
+
+	names := make(map[string]bool)
+	for _, j := range cronJobs {
+		if names[j.Name] {
+			return nil, fmt.Errorf("job: %q registered twice", j.Name)
+		}
+		names[j.Name] = true
+
+		if spec, ok := cfg.Schedules[j.Name]; ok {
+			j.Schedule = spec
+		}
+		schedule, err := ParseSchedule(j.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("job: %q: %w", j.Name, err)
+		}
+		r.cronJobs = append(r.cronJobs, &cronEntry{CronJob: j, schedule: schedule})
+	}
+	for _, j := range queueJobs {
+		if names[j.Name] {
+			return nil, fmt.Errorf("job: %q registered twice", j.Name)
+		}
+		names[j.Name] = true
+		r.queueJobs = append(r.queueJobs, j)
+	}
+
+	return r, nil
+}
+
+// Enabled reports whether the job name is run.
+func (r *Registry) Enabled(name string) bool {
+	return !slices.Contains(r.cfg.Disabled, name)
+}
+
+// Enqueue enqueues a task for the queue job name, handled from at or right
+// away when at is zero. The payload is JSON encoded. Enqueued in the
+// transaction of ctx if any, tasks are handled only if it commits.
+func (r *Registry) Enqueue(ctx context.Context, name string, payload any, at time.Time) (*entity.JobTask, error) {
+	if !slices.ContainsFunc(r.queueJobs, func(j QueueJob) bool { return j.Name == name }) {
+		return nil, fmt.Errorf("%w: queue %q", ErrUnknownJob, name)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	return r.taskRepo.Create(ctx, &entity.JobTask{
+		Queue:   name,
+		Payload: data,
+		RunAt:   at.Unix(),
+	})
+}
+
+// Status is the status of a job.
+type Status struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Enabled  bool   `json:"enabled"`
+	Schedule string `json:"schedule,omitempty"`
+	// NextRunAt is the next time cron jobs are scheduled at.
+	NextRunAt int64 `json:"nextRunAt,omitempty"`
+	// Tasks are the numbers of tasks of queue jobs by status.
+	Tasks   map[string]int `json:"tasks,omitempty"`
+	LastRun *entity.JobRun `json:"lastRun,omitempty"`
+}
+
+// Status returns the status of all jobs.
+func (r *Registry) Status(ctx context.Context, now time.Time) ([]*Status, error) {
+	res := make([]*Status, 0, len(r.cronJobs)+len(r.queueJobs))
+	for _, j := range r.cronJobs {
+		s := &Status{
+			Name:     j.Name,
+			Kind:     KindCron,
+			Enabled:  r.Enabled(j.Name),
+			Schedule: j.Schedule,
+		}
+		if s.Enabled {
+			s.NextRunAt = j.schedule.Next(now).Unix()
+		}
+		res = append(res, s)
+	}
+	for _, j := range r.queueJobs {
+		tasks, err := r.taskRepo.CountByStatus(ctx, j.Name)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &Status{
+			Name:    j.Name,
+			Kind:    KindQueue,
+			Enabled: r.Enabled(j.Name),
+			Tasks:   tasks,
+		})
+	}
+
+	for _, s := range res {
+		run, err := r.runRepo.FindLast(ctx, s.Name)
+		if err != nil && !errors.Is(err, repo.ErrNotFound) {
+			return nil, err
+		}
+		s.LastRun = run
+	}
+
+	return res, nil
+}
+
+// Runs lists the runs of the job name from the latest.
+func (r *Registry) Runs(ctx context.Context, name string, limit, offset int) (*repo.ListJobRunResult, error) {
+	known := slices.ContainsFunc(r.cronJobs, func(j *cronEntry) bool { return j.Name == name }) ||
+		slices.ContainsFunc(r.queueJobs, func(j QueueJob) bool { return j.Name == name })
+	if !known {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownJob, name)
+	}
+
+	return r.runRepo.FindByJob(ctx, name, limit, offset)
+}
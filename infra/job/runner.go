@@ -0,0 +1,281 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// Runner runs the jobs of a Registry. Replicas may all run it, locks let a
+// single one run each job at a time.
+type Runner struct {
+	registry *Registry
+	client   database.Client
+	node     string
+	now      func() time.Time
+
+	mu      sync.Mutex
+	running map[string]bool
+	wg      sync.WaitGroup
+}
+
+// NewRunner creates and returns new instance of Runner.
+func NewRunner(registry *Registry, client database.Client) *Runner {
+	node, _ := os.Hostname()
+	if node == "" {
+		node = "unknown"
+	}
+
+	return &Runner{
+		registry: registry,
+		client:   client,
+		node:     fmt.Sprintf("%s-%d", node, os.Getpid()),
+		now:      time.Now,
+		running:  make(map[string]bool),
+	}
+}
+
+// StartRunner creates the Runner of registry and runs it while the app runs.
+func StartRunner(lc fx.Lifecycle, registry *Registry, client database.Client) *Runner {
+	r := NewRunner(registry, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				r.Run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+
+	return r
+}
+
+// Run runs cron jobs on schedule and polls queues until ctx is done, then
+// waits for the runs in progress. Runs of a job do not overlap, a cron job
+// still running at its next scheduled time skips it.
+func (r *Runner) Run(ctx context.Context) {
+	defer r.wg.Wait()
+
+	now := r.now()
+	next := make(map[string]time.Time, len(r.registry.cronJobs))
+	for _, j := range r.registry.cronJobs {
+		next[j.Name] = j.schedule.Next(now)
+	}
+	var nextPoll time.Time
+
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+	for {
+		now := r.now()
+		for _, j := range r.registry.cronJobs {
+			at := next[j.Name]
+			if now.Before(at) {
+				continue
+			}
+			next[j.Name] = j.schedule.Next(now)
+			if r.registry.Enabled(j.Name) {
+				r.spawn(ctx, j.Name, func(ctx context.Context) error {
+					return r.RunCron(ctx, j.CronJob, at)
+				})
+			}
+		}
+
+		if !now.Before(nextPoll) {
+			nextPoll = now.Add(r.registry.cfg.Queue.PollInterval)
+			for _, j := range r.registry.queueJobs {
+				if r.registry.Enabled(j.Name) {
+					r.spawn(ctx, j.Name, func(ctx context.Context) error {
+						_, err := r.RunQueue(ctx, j)
+						return err
+					})
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// spawn runs f in the background unless the job name is already running.
+func (r *Runner) spawn(ctx context.Context, name string, f func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running[name] {
+		return
+	}
+	r.running[name] = true
+
+	r.wg.Add(1)
+	go func() {
+		defer func() {
+			r.mu.Lock()
+			delete(r.running, name)
+			r.mu.Unlock()
+			r.wg.Done()
+		}()
+
+		if err := f(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("job %s: %v", name, err)
+		}
+	}()
+}
+
+// RunCron runs job for its time scheduledAt, and records the run. Nothing is
+// run while another replica holds the lock of the job, or once a run was
+// recorded for scheduledAt or later. Errors of the job are recorded, not
+// returned.
+func (r *Runner) RunCron(ctx context.Context, job CronJob, scheduledAt time.Time) error {
+	unlock, locked, err := database.TrySessionLock(ctx, r.client, "job:"+job.Name)
+	if err != nil || !locked {
+		return err
+	}
+	defer unlock()
+
+	last, err := r.registry.runRepo.FindLast(ctx, job.Name)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return err
+	}
+	if last != nil && last.ScheduledAt >= scheduledAt.Unix() {
+		return nil
+	}
+
+	started := r.now()
+	run, err := r.start(ctx, job.Name, entity.JobTriggerCron, 0, scheduledAt.Unix(), started)
+	if err != nil {
+		return err
+	}
+
+	runCtx := ctx
+	if job.Timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, job.Timeout)
+		defer cancel()
+	}
+
+	return r.finish(ctx, run, started, safeRun(func() error { return job.Run(runCtx) }))
+}
+
+// RunQueue handles a batch of due tasks of job, records a run for each, and
+// returns the number of tasks handled. Nothing is handled while another
+// replica holds the lock of the queue. Failed tasks are retried with backoff,
+// and fail after the max attempts.
+func (r *Runner) RunQueue(ctx context.Context, job QueueJob) (int, error) {
+	unlock, locked, err := database.TrySessionLock(ctx, r.client, "job-queue:"+job.Name)
+	if err != nil || !locked {
+		return 0, err
+	}
+	defer unlock()
+
+	cfg := r.registry.cfg.Queue
+	tasks, err := r.registry.taskRepo.FindDue(ctx, job.Name, r.now().Unix(), cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, task := range tasks {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+
+		started := r.now()
+		run, err := r.start(ctx, job.Name, entity.JobTriggerQueue, task.ID, 0, started)
+		if err != nil {
+			return i, err
+		}
+
+		jobErr := safeRun(func() error { return job.Handle(ctx, task) })
+		if jobErr == nil {
+			task.Status = entity.JobTaskStatusDone
+			task.LastError = ""
+		} else {
+			task.Attempts++
+			task.LastError = jobErr.Error()
+			if task.Attempts >= cfg.MaxAttempts {
+				task.Status = entity.JobTaskStatusFailed
+			} else {
+				task.RunAt = r.now().Add(utils.Backoff(cfg.Backoff, cfg.MaxBackoff, task.Attempts)).Unix()
+			}
+		}
+		if err := r.registry.taskRepo.Update(ctx, task); err != nil {
+			return i, err
+		}
+
+		if err := r.finish(ctx, run, started, jobErr); err != nil {
+			return i, err
+		}
+	}
+
+	return len(tasks), nil
+}
+
+func (r *Runner) start(
+	ctx context.Context,
+	name, trigger string,
+	taskID, scheduledAt int64,
+	started time.Time,
+) (*entity.JobRun, error) {
+	return r.registry.runRepo.Create(ctx, &entity.JobRun{
+		Job:         name,
+		Trigger:     trigger,
+		TaskID:      taskID,
+		Status:      entity.JobRunStatusRunning,
+		Node:        r.node,
+		ScheduledAt: scheduledAt,
+		StartedAt:   started.Unix(),
+	})
+}
+
+// finish records the end of run started at started, failed with jobErr if
+// not nil.
+func (r *Runner) finish(ctx context.Context, run *entity.JobRun, started time.Time, jobErr error) error {
+	now := r.now()
+	run.FinishedAt = now.Unix()
+	run.DurationMs = now.Sub(started).Milliseconds()
+	run.Status = entity.JobRunStatusSucceeded
+	if jobErr != nil {
+		run.Status = entity.JobRunStatusFailed
+		run.Error = jobErr.Error()
+		log.Printf("job %s: run %d failed: %v", run.Job, run.ID, jobErr)
+	}
+
+	// the run is recorded even when ctx was canceled during the job.
+	return r.registry.runRepo.Update(context.WithoutCancel(ctx), run)
+}
+
+// safeRun calls f, and turns its panics into errors.
+func safeRun(f func() error) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+
+	return f()
+}
@@ -0,0 +1,174 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+)
+
+func TestRunner_RunCron(t *testing.T) {
+	scheduledAt := time.Unix(1000, 0)
+
+	tests := []struct {
+		name       string
+		last       *entity.JobRun
+		jobErr     error
+		wantCalled bool
+		wantRun    *entity.JobRun
+	}{
+		{
+			name:       "TC01 - never run - should run and record it",
+			wantCalled: true,
+			wantRun: &entity.JobRun{
+				Job: "purge", Trigger: entity.JobTriggerCron, Status: entity.JobRunStatusSucceeded,
+				Node: "node", ScheduledAt: 1000, StartedAt: 1000, FinishedAt: 1000,
+			},
+		},
+		{
+			name:       "TC02 - job fails - should record the error",
+			last:       &entity.JobRun{ScheduledAt: 940},
+			jobErr:     errors.New("boom"),
+			wantCalled: true,
+			wantRun: &entity.JobRun{
+				Job: "purge", Trigger: entity.JobTriggerCron, Status: entity.JobRunStatusFailed,
+				Node: "node", ScheduledAt: 1000, StartedAt: 1000, FinishedAt: 1000, Error: "boom",
+			},
+		},
+		{
+			name: "TC03 - already run by another replica - should skip",
+			last: &entity.JobRun{ScheduledAt: 1000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := database.New(
+				database.Options.Driver(database.DriverSQLite),
+				database.Options.MasterURI("file:"+t.Name()+"?mode=memory&cache=shared&_fk=1"),
+			)
+			require.NoError(t, err)
+			defer client.Close()
+
+			runRepo := repo.NewMockJobRunRepo(t)
+			if tt.last != nil {
+				runRepo.EXPECT().FindLast(mock.Anything, "purge").Return(tt.last, nil).Once()
+			} else {
+				runRepo.EXPECT().FindLast(mock.Anything, "purge").Return(nil, repo.ErrNotFound).Once()
+			}
+
+			var recorded *entity.JobRun
+			runRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, run *entity.JobRun) (*entity.JobRun, error) {
+				assert.Equal(t, entity.JobRunStatusRunning, run.Status)
+				return run, nil
+			}).Maybe()
+			runRepo.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, run *entity.JobRun) error {
+				recorded = run
+				return nil
+			}).Maybe()
+
+			var called bool
+			job := CronJob{Name: "purge", Schedule: "@every 1m", Run: func(context.Context) error {
+				called = true
+				return tt.jobErr
+			}}
+			registry, err := newRegistry(Config{}, []CronJob{job}, nil, runRepo, repo.NewMockJobTaskRepo(t))
+			require.NoError(t, err)
+
+			r := NewRunner(registry, client)
+			r.node = "node"
+			r.now = func() time.Time { return scheduledAt }
+
+			assert.NoError(t, r.RunCron(context.Background(), job, scheduledAt))
+			assert.Equal(t, tt.wantCalled, called)
+			assert.Equal(t, tt.wantRun, recorded)
+		})
+	}
+}
+
+func TestRunner_RunQueue(t *testing.T) {
+	now := time.Unix(1000, 0)
+	errFail := errors.New("fail")
+
+	tests := []struct {
+		name     string
+		task     *entity.JobTask
+		jobErr   error
+		wantTask *entity.JobTask
+		wantRun  string
+	}{
+		{
+			name:     "TC01 - handled - should be done",
+			task:     &entity.JobTask{ID: 1, Queue: "mail", Status: entity.JobTaskStatusPending},
+			wantTask: &entity.JobTask{ID: 1, Queue: "mail", Status: entity.JobTaskStatusDone},
+			wantRun:  entity.JobRunStatusSucceeded,
+		},
+		{
+			name:   "TC02 - failed - should be retried with backoff",
+			task:   &entity.JobTask{ID: 1, Queue: "mail", Status: entity.JobTaskStatusPending, Attempts: 1},
+			jobErr: errFail,
+			wantTask: &entity.JobTask{
+				ID: 1, Queue: "mail", Status: entity.JobTaskStatusPending, Attempts: 2, RunAt: 1020, LastError: "fail",
+			},
+			wantRun: entity.JobRunStatusFailed,
+		},
+		{
+			name:   "TC03 - last attempt failed - should fail",
+			task:   &entity.JobTask{ID: 1, Queue: "mail", Status: entity.JobTaskStatusPending, Attempts: 2},
+			jobErr: errFail,
+			wantTask: &entity.JobTask{
+				ID: 1, Queue: "mail", Status: entity.JobTaskStatusFailed, Attempts: 3, LastError: "fail",
+			},
+			wantRun: entity.JobRunStatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := database.New(
+				database.Options.Driver(database.DriverSQLite),
+				database.Options.MasterURI("file:"+t.Name()+"?mode=memory&cache=shared&_fk=1"),
+			)
+			require.NoError(t, err)
+			defer client.Close()
+
+			taskRepo := repo.NewMockJobTaskRepo(t)
+			taskRepo.EXPECT().FindDue(mock.Anything, "mail", int64(1000), 20).Return([]*entity.JobTask{tt.task}, nil).Once()
+			taskRepo.EXPECT().Update(mock.Anything, tt.wantTask).Return(nil).Once()
+
+			runRepo := repo.NewMockJobRunRepo(t)
+			runRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, run *entity.JobRun) (*entity.JobRun, error) {
+				assert.Equal(t, int64(1), run.TaskID)
+				assert.Equal(t, entity.JobTriggerQueue, run.Trigger)
+				return run, nil
+			}).Once()
+			runRepo.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, run *entity.JobRun) error {
+				assert.Equal(t, tt.wantRun, run.Status)
+				return nil
+			}).Once()
+
+			job := QueueJob{Name: "mail", Handle: func(context.Context, *entity.JobTask) error {
+				return tt.jobErr
+			}}
+			cfg := Config{}
+			cfg.Queue.MaxAttempts = 3
+			registry, err := newRegistry(cfg, nil, []QueueJob{job}, runRepo, taskRepo)
+			require.NoError(t, err)
+
+			r := NewRunner(registry, client)
+			r.now = func() time.Time { return now }
+
+			n, err := r.RunQueue(context.Background(), job)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package leaderboard
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// MemoryStore is a Store local to the process, for tests and single replica
+// deployments.
+type MemoryStore struct {
+	mu sync.Mutex
+	// boards are the periods of leaderboards by id.
+	boards map[string]map[string]*memoryPeriod
+}
+
+type memoryPeriod struct {
+	period *entity.LeaderboardPeriod
+	scores map[string]int64
+}
+
+// NewMemoryStore creates and returns new instance of MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		boards: make(map[string]map[string]*memoryPeriod),
+	}
+}
+
+// Submit implements Store.
+func (s *MemoryStore) Submit(_ context.Context, l *entity.Leaderboard, period *entity.LeaderboardPeriod, playerID string, score int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	periods, ok := s.boards[l.ID]
+	if !ok {
+		periods = make(map[string]*memoryPeriod)
+		s.boards[l.ID] = periods
+	}
+	p, ok := periods[period.Key]
+	if !ok {
+		p = &memoryPeriod{period: period, scores: make(map[string]int64)}
+		periods[period.Key] = p
+	}
+
+	cur, ok := p.scores[playerID]
+	switch {
+	case l.Policy == entity.LeaderboardPolicySum:
+		cur += score
+	case l.Policy == entity.LeaderboardPolicyBest && ok:
+		cur = max(cur, score)
+	default:
+		cur = score
+	}
+	p.scores[playerID] = cur
+
+	return cur, nil
+}
+
+// Top implements Store.
+func (s *MemoryStore) Top(_ context.Context, leaderboardID, period string, offset, limit int) ([]*entity.LeaderboardEntry, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := s.ranked(leaderboardID, period)
+	total := len(entries)
+	if offset >= total {
+		return []*entity.LeaderboardEntry{}, total, nil
+	}
+	entries = entries[offset:]
+	if limit > 0 && limit < len(entries) {
+		entries = entries[:limit]
+	}
+
+	return entries, total, nil
+}
+
+// Around implements Store.
+func (s *MemoryStore) Around(_ context.Context, leaderboardID, period, playerID string, radius int) ([]*entity.LeaderboardEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := s.ranked(leaderboardID, period)
+	for i, e := range entries {
+		if e.PlayerID == playerID {
+			return entries[max(i-radius, 0):min(i+radius+1, len(entries))], nil
+		}
+	}
+
+	return []*entity.LeaderboardEntry{}, nil
+}
+
+// Periods implements Store.
+func (s *MemoryStore) Periods(_ context.Context, leaderboardID string) ([]*entity.LeaderboardPeriod, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]*entity.LeaderboardPeriod, 0, len(s.boards[leaderboardID]))
+	for _, p := range s.boards[leaderboardID] {
+		res = append(res, p.period)
+	}
+	sortPeriods(res)
+
+	return res, nil
+}
+
+// DeletePeriod implements Store.
+func (s *MemoryStore) DeletePeriod(_ context.Context, leaderboardID, period string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.boards[leaderboardID], period)
+	if len(s.boards[leaderboardID]) == 0 {
+		delete(s.boards, leaderboardID)
+	}
+
+	return nil
+}
+
+// ranked returns the ranked entries of a period.
+func (s *MemoryStore) ranked(leaderboardID, period string) []*entity.LeaderboardEntry {
+	p, ok := s.boards[leaderboardID][period]
+	if !ok {
+		return []*entity.LeaderboardEntry{}
+	}
+
+	res := make([]*entity.LeaderboardEntry, 0, len(p.scores))
+	for playerID, score := range p.scores {
+		res = append(res, &entity.LeaderboardEntry{PlayerID: playerID, Score: score})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].PlayerID > res[j].PlayerID
+	})
+	for i, e := range res {
+		e.Rank = i + 1
+	}
+
+	return res
+}
@@ -0,0 +1,156 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// RedisStore is a Store shared by all replicas, keeping the scores of each
+// period in a sorted set and the periods of each leaderboard in a hash. Keys
+// of a leaderboard share a hash tag, so they live in the same cluster slot.
+type RedisStore struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+// NewRedisStore creates and returns new instance of RedisStore, its keys are
+// prefixed by prefix.
+func NewRedisStore(rdb redis.UniversalClient, prefix string) *RedisStore {
+	return &RedisStore{
+		rdb:    rdb,
+		prefix: prefix,
+	}
+}
+
+// Submit implements Store.
+func (s *RedisStore) Submit(ctx context.Context, l *entity.Leaderboard, period *entity.LeaderboardPeriod, playerID string, score int64) (int64, error) {
+	key := s.scoresKey(l.ID, period.Key)
+
+	var res *redis.FloatCmd
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		member := &redis.Z{Score: float64(score), Member: playerID}
+		switch l.Policy {
+		case entity.LeaderboardPolicySum:
+			pipe.ZIncrBy(ctx, key, float64(score), playerID)
+		case entity.LeaderboardPolicyBest:
+			pipe.ZAddArgs(ctx, key, redis.ZAddArgs{GT: true, Members: []redis.Z{*member}})
+		default:
+			pipe.ZAdd(ctx, key, member)
+		}
+		pipe.HSet(ctx, s.periodsKey(l.ID), period.Key, fmt.Sprintf("%d:%d", period.Start, period.End))
+		res = pipe.ZScore(ctx, key, playerID)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(res.Val()), nil
+}
+
+// Top implements Store.
+func (s *RedisStore) Top(ctx context.Context, leaderboardID, period string, offset, limit int) ([]*entity.LeaderboardEntry, int, error) {
+	key := s.scoresKey(leaderboardID, period)
+	stop := int64(-1)
+	if limit > 0 {
+		stop = int64(offset + limit - 1)
+	}
+
+	var card *redis.IntCmd
+	var members *redis.ZSliceCmd
+	_, err := s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		card = pipe.ZCard(ctx, key)
+		members = pipe.ZRevRangeWithScores(ctx, key, int64(offset), stop)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return toEntries(members.Val(), offset), int(card.Val()), nil
+}
+
+// Around implements Store.
+func (s *RedisStore) Around(ctx context.Context, leaderboardID, period, playerID string, radius int) ([]*entity.LeaderboardEntry, error) {
+	key := s.scoresKey(leaderboardID, period)
+	rank, err := s.rdb.ZRevRank(ctx, key, playerID).Result()
+	if errors.Is(err, redis.Nil) {
+		return []*entity.LeaderboardEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	start := max(rank-int64(radius), 0)
+	members, err := s.rdb.ZRevRangeWithScores(ctx, key, start, rank+int64(radius)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntries(members, int(start)), nil
+}
+
+// Periods implements Store.
+func (s *RedisStore) Periods(ctx context.Context, leaderboardID string) ([]*entity.LeaderboardPeriod, error) {
+	values, err := s.rdb.HGetAll(ctx, s.periodsKey(leaderboardID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.LeaderboardPeriod, 0, len(values))
+	for key, v := range values {
+		start, end, _ := strings.Cut(v, ":")
+		p := &entity.LeaderboardPeriod{Key: key}
+		if p.Start, err = strconv.ParseInt(start, 10, 64); err != nil {
+			return nil, fmt.Errorf("leaderboard: period %q of %q: %w", key, leaderboardID, err)
+		}
+		if p.End, err = strconv.ParseInt(end, 10, 64); err != nil {
+			return nil, fmt.Errorf("leaderboard: period %q of %q: %w", key, leaderboardID, err)
+		}
+		res = append(res, p)
+	}
+	sortPeriods(res)
+
+	return res, nil
+}
+
+// DeletePeriod implements Store.
+func (s *RedisStore) DeletePeriod(ctx context.Context, leaderboardID, period string) error {
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, s.scoresKey(leaderboardID, period))
+		pipe.HDel(ctx, s.periodsKey(leaderboardID), period)
+		return nil
+	})
+
+	return err
+}
+
+func (s *RedisStore) scoresKey(leaderboardID, period string) string {
+	return s.prefix + "{" + leaderboardID + "}:scores:" + period
+}
+
+func (s *RedisStore) periodsKey(leaderboardID string) string {
+	return s.prefix + "{" + leaderboardID + "}:periods"
+}
+
+// toEntries converts the members of a sorted set from rank offset+1 to
+// entries.
+func toEntries(members []redis.Z, offset int) []*entity.LeaderboardEntry {
+	res := make([]*entity.LeaderboardEntry, 0, len(members))
+	for i, m := range members {
+		res = append(res, &entity.LeaderboardEntry{
+			Rank:     offset + i + 1,
+			PlayerID: m.Member.(string),
+			Score:    int64(m.Score),
+		})
+	}
+
+	return res
+}
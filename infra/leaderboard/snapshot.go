@@ -0,0 +1,61 @@
+package leaderboard
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+)
+
+// SnapshotEnded snapshots the periods of the leaderboards ended at now to
+// Postgres, then deletes their scores from the store, and returns the
+// number of snapshotted periods. Periods are deleted once snapshotted, so
+// an interrupted run is resumed by the next one.
+func SnapshotEnded(
+	ctx context.Context,
+	store Store,
+	leaderboardRepo repo.LeaderboardRepo,
+	snapshotRepo repo.LeaderboardSnapshotRepo,
+	now time.Time,
+) (int, error) {
+	boards, err := leaderboardRepo.FindAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, l := range boards {
+		periods, err := store.Periods(ctx, l.ID)
+		if err != nil {
+			return n, err
+		}
+
+		for _, p := range periods {
+			if p.End > now.Unix() {
+				break
+			}
+
+			entries, _, err := store.Top(ctx, l.ID, p.Key, 0, 0)
+			if err != nil {
+				return n, err
+			}
+			created, err := snapshotRepo.Create(ctx, &entity.LeaderboardSnapshot{
+				LeaderboardID: l.ID,
+				Period:        p,
+				Entries:       entries,
+			})
+			if err != nil {
+				return n, err
+			}
+			if err := store.DeletePeriod(ctx, l.ID, p.Key); err != nil {
+				return n, err
+			}
+			if created {
+				n++
+			}
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,53 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+)
+
+func TestSnapshotEnded(t *testing.T) {
+	ctx := context.Background()
+	l := &entity.Leaderboard{ID: "kills", Policy: entity.LeaderboardPolicySum}
+	ended := &entity.LeaderboardPeriod{Key: "2026-10-18", Start: 1000, End: 2000}
+	current := &entity.LeaderboardPeriod{Key: "2026-10-19", Start: 2000, End: 3000}
+
+	store := NewMemoryStore()
+	for _, p := range []*entity.LeaderboardPeriod{ended, current} {
+		_, err := store.Submit(ctx, l, p, "p1", 5)
+		require.NoError(t, err)
+	}
+	_, err := store.Submit(ctx, l, ended, "p2", 8)
+	require.NoError(t, err)
+
+	leaderboardRepo := repo.NewMockLeaderboardRepo(t)
+	snapshotRepo := repo.NewMockLeaderboardSnapshotRepo(t)
+	leaderboardRepo.EXPECT().FindAll(ctx).Return([]*entity.Leaderboard{l}, nil).Once()
+	snapshotRepo.EXPECT().Create(ctx, mock.Anything).RunAndReturn(
+		func(_ context.Context, s *entity.LeaderboardSnapshot) (bool, error) {
+			assert.Equal(t, &entity.LeaderboardSnapshot{
+				LeaderboardID: "kills",
+				Period:        ended,
+				Entries: []*entity.LeaderboardEntry{
+					{Rank: 1, PlayerID: "p2", Score: 8},
+					{Rank: 2, PlayerID: "p1", Score: 5},
+				},
+			}, s)
+			return true, nil
+		}).Once()
+
+	n, err := SnapshotEnded(ctx, store, leaderboardRepo, snapshotRepo, time.Unix(2500, 0))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	periods, err := store.Periods(ctx, l.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.LeaderboardPeriod{current}, periods)
+}
@@ -0,0 +1,85 @@
+// Package leaderboard provides the stores of the scores of the current
+// leaderboard periods, and their snapshots to Postgres once ended.
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// Store backends.
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+// FXModule represents a FX module for the Store.
+var FXModule = fx.Provide(
+	NewStore,
+)
+
+// Store keeps the scores of the periods of leaderboards. Players of equal
+// scores are ranked by descending player id.
+type Store interface {
+	// Submit submits a score of a player to a period of the leaderboard l
+	// by its policy, and returns the resulting score of the player.
+	Submit(ctx context.Context, l *entity.Leaderboard, period *entity.LeaderboardPeriod, playerID string, score int64) (int64, error)
+	// Top returns the entries of a period from rank offset+1, all of them
+	// when limit is 0, and the number of entries of the period.
+	Top(ctx context.Context, leaderboardID, period string, offset, limit int) ([]*entity.LeaderboardEntry, int, error)
+	// Around returns the entries of a period ranked within radius of a
+	// player, none when the player has no score.
+	Around(ctx context.Context, leaderboardID, period, playerID string, radius int) ([]*entity.LeaderboardEntry, error)
+	// Periods returns the periods of a leaderboard with scores ordered by
+	// end.
+	Periods(ctx context.Context, leaderboardID string) ([]*entity.LeaderboardPeriod, error)
+	// DeletePeriod deletes the scores of a period.
+	DeletePeriod(ctx context.Context, leaderboardID, period string) error
+}
+
+// NewStore creates the Store configured through viper by following keys:
+//
+//   - leaderboards.backend
+//     "memory" (default) or "redis", the redis backend keeps sorted sets in
+//     the default redis client shared by all replicas.
+func NewStore(v *viper.Viper, rdb redis.UniversalClient) (Store, error) {
+	cfg := struct {
+		Leaderboards struct {
+			Backend string `mapstructure:"backend"`
+		} `mapstructure:"leaderboards"`
+		Service struct {
+			Namespace string `mapstructure:"namespace"`
+		} `mapstructure:"service"`
+	}{}
+
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Leaderboards.Backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendRedis:
+		return NewRedisStore(rdb, cfg.Service.Namespace+":game-service:leaderboard:"), nil
+	default:
+		return nil, fmt.Errorf("leaderboard: unsupported backend %q", cfg.Leaderboards.Backend)
+	}
+}
+
+// sortPeriods sorts periods by end.
+func sortPeriods(periods []*entity.LeaderboardPeriod) {
+	sort.Slice(periods, func(i, j int) bool {
+		if periods[i].End != periods[j].End {
+			return periods[i].End < periods[j].End
+		}
+		return periods[i].Key < periods[j].Key
+	})
+}
@@ -0,0 +1,125 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+func TestStore(t *testing.T) {
+	period := &entity.LeaderboardPeriod{Key: "2026-10-19", Start: 1792368000, End: 1792454400}
+	type submit struct {
+		playerID string
+		score    int64
+	}
+
+	tests := []struct {
+		name       string
+		policy     string
+		submits    []submit
+		wantScores []int64
+		wantTop    []*entity.LeaderboardEntry
+		wantAround []*entity.LeaderboardEntry
+	}{
+		{
+			name:       "TC01 - best policy - should keep the best score",
+			policy:     entity.LeaderboardPolicyBest,
+			submits:    []submit{{"p1", 50}, {"p2", 70}, {"p1", 30}, {"p3", 60}, {"p1", 90}},
+			wantScores: []int64{50, 70, 50, 60, 90},
+			wantTop: []*entity.LeaderboardEntry{
+				{Rank: 1, PlayerID: "p1", Score: 90},
+				{Rank: 2, PlayerID: "p2", Score: 70},
+			},
+			wantAround: []*entity.LeaderboardEntry{
+				{Rank: 2, PlayerID: "p2", Score: 70},
+				{Rank: 3, PlayerID: "p3", Score: 60},
+			},
+		},
+		{
+			name:       "TC02 - sum policy - should add up scores",
+			policy:     entity.LeaderboardPolicySum,
+			submits:    []submit{{"p1", 50}, {"p2", 70}, {"p1", 30}, {"p3", 60}},
+			wantScores: []int64{50, 70, 80, 60},
+			wantTop: []*entity.LeaderboardEntry{
+				{Rank: 1, PlayerID: "p1", Score: 80},
+				{Rank: 2, PlayerID: "p2", Score: 70},
+			},
+			wantAround: []*entity.LeaderboardEntry{
+				{Rank: 2, PlayerID: "p2", Score: 70},
+				{Rank: 3, PlayerID: "p3", Score: 60},
+			},
+		},
+		{
+			name:       "TC03 - latest policy and ties - should keep the latest score and rank ties by player",
+			policy:     entity.LeaderboardPolicyLatest,
+			submits:    []submit{{"p1", 50}, {"p2", 70}, {"p1", 30}, {"p3", 70}},
+			wantScores: []int64{50, 70, 30, 70},
+			wantTop: []*entity.LeaderboardEntry{
+				{Rank: 1, PlayerID: "p3", Score: 70},
+				{Rank: 2, PlayerID: "p2", Score: 70},
+			},
+			wantAround: []*entity.LeaderboardEntry{
+				{Rank: 1, PlayerID: "p3", Score: 70},
+				{Rank: 2, PlayerID: "p2", Score: 70},
+			},
+		},
+	}
+
+	stores := map[string]func(t *testing.T) Store{
+		"memory": func(_ *testing.T) Store {
+			return NewMemoryStore()
+		},
+		"redis": func(t *testing.T) Store {
+			mr := miniredis.RunT(t)
+			return NewRedisStore(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "test:")
+		},
+	}
+
+	for backend, newStore := range stores {
+		for _, tt := range tests {
+			t.Run(backend+"/"+tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				s := newStore(t)
+				l := &entity.Leaderboard{ID: "kills", Policy: tt.policy}
+
+				for i, sub := range tt.submits {
+					score, err := s.Submit(ctx, l, period, sub.playerID, sub.score)
+					require.NoError(t, err)
+					assert.Equal(t, tt.wantScores[i], score, "submit %d", i)
+				}
+
+				top, total, err := s.Top(ctx, l.ID, period.Key, 0, 2)
+				require.NoError(t, err)
+				assert.Equal(t, 3, total)
+				assert.Equal(t, tt.wantTop, top)
+
+				around, err := s.Around(ctx, l.ID, period.Key, "p3", 1)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAround, around)
+
+				around, err = s.Around(ctx, l.ID, period.Key, "unknown", 1)
+				require.NoError(t, err)
+				assert.Empty(t, around)
+
+				periods, err := s.Periods(ctx, l.ID)
+				require.NoError(t, err)
+				assert.Equal(t, []*entity.LeaderboardPeriod{period}, periods)
+
+				require.NoError(t, s.DeletePeriod(ctx, l.ID, period.Key))
+				top, total, err = s.Top(ctx, l.ID, period.Key, 0, 0)
+				require.NoError(t, err)
+				assert.Equal(t, 0, total)
+				assert.Empty(t, top)
+				periods, err = s.Periods(ctx, l.ID)
+				require.NoError(t, err)
+				assert.Empty(t, periods)
+			})
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// Kinds of sinks.
+const (
+	SinkLog  = "log"
+	SinkHTTP = "http"
+	SinkNATS = "nats"
+)
+
+// FXModule represents a FX module for the Sink.
+var FXModule = fx.Provide(
+	LoadSink,
+)
+
+// LoadSink creates the Sink configured through viper by following keys:
+//
+//   - outbox.sinks
+//     kinds of the sinks events are published to: log, http, nats. Defaults
+//     to log.
+//   - outbox.http.url, outbox.http.timeout
+//     webhook of the http sink.
+//   - outbox.nats.url, outbox.nats.subject_prefix, outbox.nats.timeout
+//     server of the nats sink, and the prefix of subjects.
+func LoadSink(lc fx.Lifecycle, v *viper.Viper) (Sink, error) {
+	cfg := struct {
+		Outbox struct {
+			Sinks []string `mapstructure:"sinks"`
+			HTTP  struct {
+				URL     string        `mapstructure:"url"`
+				Timeout time.Duration `mapstructure:"timeout"`
+			} `mapstructure:"http"`
+			NATS struct {
+				URL           string        `mapstructure:"url"`
+				SubjectPrefix string        `mapstructure:"subject_prefix"`
+				Timeout       time.Duration `mapstructure:"timeout"`
+			} `mapstructure:"nats"`
+		} `mapstructure:"outbox"`
+	}{}
+
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	kinds := cfg.Outbox.Sinks
+	if len(kinds) == 0 {
+		kinds = []string{SinkLog}
+	}
+
+	var sinks MultiSink
+	for _, kind := range kinds {
+		switch kind {
+		case SinkLog:
+			sinks = append(sinks, LogSink{})
+		case SinkHTTP:
+			c := cfg.Outbox.HTTP
+			if c.URL == "" {
+				return nil, fmt.Errorf("outbox: http sink: url is required")
+			}
+			sinks = append(sinks, NewHTTPSink(c.URL, withDefault(c.Timeout, 5*time.Second)))
+		case SinkNATS:
+			c := cfg.Outbox.NATS
+			s, err := NewNATSSink(c.URL, c.SubjectPrefix, withDefault(c.Timeout, 5*time.Second))
+			if err != nil {
+				return nil, err
+			}
+			lc.Append(fx.Hook{
+				OnStop: func(context.Context) error {
+					return s.Close()
+				},
+			})
+			sinks = append(sinks, s)
+		default:
+			return nil, fmt.Errorf("outbox: unknown sink %q", kind)
+		}
+	}
+
+	if len(sinks) == 1 {
+		return sinks[0], nil
+	}
+
+	return sinks, nil
+}
+
+func withDefault(d, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+
+	return d
+}
@@ -0,0 +1,117 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+// Sink publishes outbox events to other services. Events may be published
+// more than once, consumers deduplicate them by the ID of their Envelope.
+type Sink interface {
+	Publish(ctx context.Context, event *entity.OutboxEvent) error
+}
+
+// Envelope is the JSON document published for an event.
+type Envelope struct {
+	ID            int64           `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateID"`
+	OccurredAt    int64           `json:"occurredAt"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEnvelope returns the Envelope of event.
+func NewEnvelope(event *entity.OutboxEvent) *Envelope {
+	return &Envelope{
+		ID:            event.ID,
+		Type:          event.Type,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		OccurredAt:    event.CreatedAt,
+		Payload:       event.Payload,
+	}
+}
+
+// LogSink is a Sink logging events, for development.
+type LogSink struct{}
+
+// Publish logs event.
+func (LogSink) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	data, err := json.Marshal(NewEnvelope(event))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("outbox: %s", data)
+	return nil
+}
+
+// HTTPSink is a Sink posting the Envelope of events to a webhook, responses
+// other than 2xx are failures.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSink creates and returns new instance of HTTPSink posting to url.
+func NewHTTPSink(url string, timeout time.Duration) *HTTPSink {
+	return &HTTPSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Publish posts event to the webhook.
+func (s *HTTPSink) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	data, err := json.Marshal(NewEnvelope(event))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-ID", fmt.Sprint(event.ID))
+	req.Header.Set("X-Event-Type", event.Type)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("outbox: webhook responded %s", res.Status)
+	}
+
+	return nil
+}
+
+// MultiSink publishes events to all of its sinks. An event failing on any
+// sink is published again to all of them.
+type MultiSink []Sink
+
+// Publish publishes event to all sinks.
+func (m MultiSink) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	var errs []error
+	for _, s := range m {
+		if err := s.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
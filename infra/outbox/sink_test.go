@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+func TestHTTPSink_Publish(t *testing.T) {
+	event := &entity.OutboxEvent{
+		ID:            7,
+		AggregateType: entity.AggregateItem,
+		AggregateID:   "item_1",
+		Type:          entity.EventItemCreated,
+		Payload:       []byte(`{"item":{"id":"item_1"}}`),
+		CreatedAt:     1000,
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "TC01 - accepted - should succeed",
+			status: http.StatusAccepted,
+		},
+		{
+			name:    "TC02 - server error - should fail",
+			status:  http.StatusServiceUnavailable,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Envelope
+			var gotType string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotType = r.Header.Get("X-Event-Type")
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			err := NewHTTPSink(srv.URL, time.Second).Publish(context.Background(), event)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, entity.EventItemCreated, gotType)
+			assert.Equal(t, Envelope{
+				ID:            7,
+				Type:          entity.EventItemCreated,
+				AggregateType: entity.AggregateItem,
+				AggregateID:   "item_1",
+				OccurredAt:    1000,
+				Payload:       json.RawMessage(`{"item":{"id":"item_1"}}`),
+			}, got)
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package database
+
+import (
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"ariga.io/entcache"
+	"entgo.io/ent/dialect"
+	"github.com/go-redis/redis/v8"
+)
+
+// Query cache levels.
+const (
+	CacheLevelLRU   = "lru"
+	CacheLevelRedis = "redis"
+)
+
+// Query cache modes.
+const (
+	// CacheModeAll caches all queries except those opted out by SkipQueryCache.
+	CacheModeAll = "all"
+	// CacheModeOptIn caches only queries opted in by WithQueryCache.
+	CacheModeOptIn = "opt_in"
+)
+
+type queryCacheKey struct{}
+
+// WithQueryCache returns a copy of ctx whose queries are cached when the
+// query cache runs in CacheModeOptIn.
+func WithQueryCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryCacheKey{}, true)
+}
+
+// SkipQueryCache returns a copy of ctx whose queries bypass the query cache.
+func SkipQueryCache(ctx context.Context) context.Context {
+	return entcache.Skip(ctx)
+}
+
+// EvictQueryCache returns a copy of ctx whose queries are read from the
+// database and evicted from the query cache.
+func EvictQueryCache(ctx context.Context) context.Context {
+	return entcache.Evict(ctx)
+}
+
+// RedisCacheConfig configures the redis level of the query cache.
+type RedisCacheConfig struct {
+	// Addrs is a single address, or the seed addresses of a cluster or of
+	// sentinels when MasterName is set.
+	Addrs      []string
+	Username   string
+	Password   string
+	DB         int
+	MasterName string
+	Cluster    bool
+	TLS        *tls.Config
+}
+
+// QueryCacheConfig configures the query cache.
+type QueryCacheConfig struct {
+	Enable bool
+	TTL    time.Duration
+	// Mode defaults to CacheModeAll.
+	Mode string
+	// Levels are the cache levels from the fastest to the slowest, defaults
+	// to an LRU in front of redis.
+	Levels []string
+	// LRUSize defaults to 256 entries.
+	LRUSize int
+	// KeyPrefix defaults to "game-service:entcache:".
+	KeyPrefix string
+	Redis     RedisCacheConfig
+}
+
+// QueryCache caches ent queries on multiple levels, shared by all pools of
+// the client.
+type QueryCache struct {
+	cfg    QueryCacheConfig
+	lru    *lruLevel
+	redis  redis.UniversalClient
+	levels []entcache.AddGetDeleter
+}
+
+// NewQueryCache creates and returns new instance of QueryCache, or nil when
+// the cache is disabled.
+func NewQueryCache(cfg QueryCacheConfig) (*QueryCache, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = CacheModeAll
+	case CacheModeAll, CacheModeOptIn:
+	default:
+		return nil, fmt.Errorf("database: unsupported query cache mode %q", cfg.Mode)
+	}
+
+	if len(cfg.Levels) == 0 {
+		cfg.Levels = []string{CacheLevelLRU, CacheLevelRedis}
+	}
+	if cfg.LRUSize == 0 {
+		cfg.LRUSize = 256
+	}
+	if cfg.KeyPrefix == "" {
+		cfg.KeyPrefix = "game-service:entcache:"
+	}
+
+	q := &QueryCache{cfg: cfg}
+	for _, level := range cfg.Levels {
+		switch level {
+		case CacheLevelLRU:
+			q.lru = &lruLevel{size: cfg.LRUSize, lru: entcache.NewLRU(cfg.LRUSize)}
+			q.levels = append(q.levels, q.lru)
+		case CacheLevelRedis:
+			q.redis = newRedisClient(cfg.Redis)
+			q.levels = append(q.levels, entcache.NewRedis(q.redis))
+		default:
+			return nil, fmt.Errorf("database: unsupported query cache level %q", level)
+		}
+	}
+	if len(q.levels) == 0 {
+		return nil, fmt.Errorf("database: query cache has no level")
+	}
+
+	return q, nil
+}
+
+// newRedisClient creates a single node, sentinel or cluster redis client.
+func newRedisClient(cfg RedisCacheConfig) redis.UniversalClient {
+	opts := &redis.UniversalOptions{
+		Addrs:      cfg.Addrs,
+		Username:   cfg.Username,
+		Password:   cfg.Password,
+		DB:         cfg.DB,
+		MasterName: cfg.MasterName,
+		TLSConfig:  cfg.TLS,
+	}
+	if cfg.Cluster {
+		return redis.NewClusterClient(opts.Cluster())
+	}
+
+	return redis.NewUniversalClient(opts)
+}
+
+// wrap wraps drv so its queries go through the cache.
+func (q *QueryCache) wrap(drv dialect.Driver) dialect.Driver {
+	prefix := q.cfg.KeyPrefix
+	return &cacheDriver{
+		optIn: q.cfg.Mode == CacheModeOptIn,
+		Driver: entcache.NewDriver(
+			drv,
+			entcache.TTL(q.cfg.TTL),
+			entcache.Levels(q.levels...),
+			entcache.Hash(func(query string, args []any) (entcache.Key, error) {
+				key, err := entcache.DefaultHash(query, args)
+				if err != nil {
+					return nil, err
+				}
+				return fmt.Sprintf("%s%v", prefix, key), nil
+			}),
+		),
+	}
+}
+
+// Flush deletes all entries of the cache. Entries of the redis level are
+// matched by key prefix, so other services sharing the redis are untouched.
+func (q *QueryCache) Flush(ctx context.Context) error {
+	if q.lru != nil {
+		q.lru.flush()
+	}
+
+	if q.redis == nil {
+		return nil
+	}
+
+	flush := func(ctx context.Context, c *redis.Client) error {
+		iter := c.Scan(ctx, 0, q.cfg.KeyPrefix+"*", 1000).Iterator()
+		var keys []string
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+			if len(keys) == 1000 {
+				if err := c.Del(ctx, keys...).Err(); err != nil {
+					return err
+				}
+				keys = keys[:0]
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+
+		return c.Del(ctx, keys...).Err()
+	}
+
+	switch c := q.redis.(type) {
+	case *redis.ClusterClient:
+		return c.ForEachMaster(ctx, flush)
+	case *redis.Client:
+		return flush(ctx, c)
+	default:
+		return fmt.Errorf("database: flushing %T is not supported", c)
+	}
+}
+
+// Close closes the redis client of the cache.
+func (q *QueryCache) Close() error {
+	if q.redis == nil {
+		return nil
+	}
+
+	return q.redis.Close()
+}
+
+// cacheDriver is an entcache.Driver honoring the opt-in mode. Reads routed
+// to master by WithMaster bypass the cache, they must observe preceding
+// writes.
+type cacheDriver struct {
+	*entcache.Driver
+	optIn bool
+}
+
+// Query implements the dialect.Driver interface.
+func (d *cacheDriver) Query(ctx context.Context, query string, args, v any) error {
+	if ReadsFromMaster(ctx) {
+		ctx = entcache.Skip(ctx)
+	} else if d.optIn {
+		if v, _ := ctx.Value(queryCacheKey{}).(bool); !v {
+			ctx = entcache.Skip(ctx)
+		}
+	}
+
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// BeginTx starts a transaction with options on the underlying driver, queries
+// of transactions bypass the cache.
+func (d *cacheDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("database: %T does not support transaction options", d.Driver.Driver)
+	}
+
+	return drv.BeginTx(ctx, opts)
+}
+
+// lruLevel is an entcache.LRU that can be flushed.
+type lruLevel struct {
+	mu   sync.RWMutex
+	size int
+	lru  *entcache.LRU
+}
+
+// Add implements the entcache.AddGetDeleter interface.
+func (l *lruLevel) Add(ctx context.Context, k entcache.Key, e *entcache.Entry, ttl time.Duration) error {
+	return l.current().Add(ctx, k, e, ttl)
+}
+
+// Get implements the entcache.AddGetDeleter interface.
+func (l *lruLevel) Get(ctx context.Context, k entcache.Key) (*entcache.Entry, error) {
+	return l.current().Get(ctx, k)
+}
+
+// Del implements the entcache.AddGetDeleter interface.
+func (l *lruLevel) Del(ctx context.Context, k entcache.Key) error {
+	return l.current().Del(ctx, k)
+}
+
+func (l *lruLevel) current() *entcache.LRU {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.lru
+}
+
+// flush replaces the LRU by an empty one.
+func (l *lruLevel) flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lru = entcache.NewLRU(l.size)
+}
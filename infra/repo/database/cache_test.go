@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"ariga.io/entcache"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+)
+
+func TestQueryCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     string
+		ctx      func(context.Context) context.Context
+		flush    bool
+		wantHits uint64
+	}{
+		{
+			name:     "TC01 - cache all - should hit the cache on the second query",
+			mode:     CacheModeAll,
+			wantHits: 1,
+		},
+		{
+			name:     "TC02 - cache all with skip - should not hit the cache",
+			mode:     CacheModeAll,
+			ctx:      SkipQueryCache,
+			wantHits: 0,
+		},
+		{
+			name:     "TC03 - opt in without context - should not hit the cache",
+			mode:     CacheModeOptIn,
+			wantHits: 0,
+		},
+		{
+			name:     "TC04 - opt in with context - should hit the cache on the second query",
+			mode:     CacheModeOptIn,
+			ctx:      WithQueryCache,
+			wantHits: 1,
+		},
+		{
+			name:     "TC05 - flushed - should not hit the cache",
+			mode:     CacheModeAll,
+			flush:    true,
+			wantHits: 0,
+		},
+		{
+			name:     "TC06 - opt in with reads routed to master - should not hit the cache",
+			mode:     CacheModeOptIn,
+			ctx:      func(ctx context.Context) context.Context { return WithMaster(WithQueryCache(ctx)) },
+			wantHits: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ctx != nil {
+				ctx = tt.ctx(ctx)
+			}
+
+			q, err := NewQueryCache(QueryCacheConfig{
+				Enable: true,
+				Mode:   tt.mode,
+				Levels: []string{CacheLevelLRU},
+			})
+			require.NoError(t, err)
+
+			db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+			require.NoError(t, err)
+			drv := q.wrap(entsql.OpenDB(dialect.SQLite, db))
+			client := entc.NewClient(entc.Driver(drv))
+			defer client.Close()
+			require.NoError(t, client.Schema.Create(SkipQueryCache(ctx)))
+
+			_, err = client.Item.Query().All(ctx)
+			require.NoError(t, err)
+			if tt.flush {
+				require.NoError(t, q.Flush(ctx))
+			}
+			_, err = client.Item.Query().All(ctx)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantHits, drv.(*cacheDriver).Stats().Hits)
+		})
+	}
+}
+
+func TestNewQueryCache(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     QueryCacheConfig
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:    "TC01 - disabled - should return no cache",
+			wantNil: true,
+		},
+		{
+			name: "TC02 - unknown level - should fail",
+			cfg: QueryCacheConfig{
+				Enable: true,
+				Levels: []string{"memcached"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "TC03 - unknown mode - should fail",
+			cfg: QueryCacheConfig{
+				Enable: true,
+				Mode:   "sometimes",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewQueryCache(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantNil, q == nil)
+		})
+	}
+}
+
+var _ entcache.AddGetDeleter = (*lruLevel)(nil)
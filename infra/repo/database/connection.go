@@ -2,14 +2,16 @@ package database
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
+	"fmt"
+	"sync/atomic"
 	"time"
 
-	"ariga.io/entcache"
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
-	"github.com/go-redis/redis/v8"
 	_ "github.com/jackc/pgx/v4/stdlib"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/spf13/viper"
 	"go.uber.org/fx"
 
@@ -26,16 +28,8 @@ var FXModule = fx.Provide(
 		}
 
 		lc.Append(fx.Hook{
-			OnStop: func(ctx context.Context) error {
-				if err := d.MasterDB(ctx).Close(); err != nil {
-					return err
-				}
-
-				if err := d.Master(ctx).Close(); err != nil {
-					return err
-				}
-
-				return d.Slave(ctx).Close()
+			OnStop: func(context.Context) error {
+				return d.Close()
 			},
 		})
 
@@ -43,6 +37,18 @@ var FXModule = fx.Provide(
 	},
 )
 
+// Supported database drivers.
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Supported replica balancers.
+const (
+	BalancerRoundRobin       = "round_robin"
+	BalancerLeastConnections = "least_connections"
+)
+
 // Option is a function that sets some option on the DB Conn.
 type Option func(*client) error
 
@@ -51,6 +57,22 @@ var Options options
 
 type options struct{}
 
+// Driver is an Option to set the database driver of client, one of
+// DriverPostgres or DriverSQLite.
+func (options) Driver(driver string) Option {
+	return func(c *client) error {
+		switch driver {
+		case "":
+			c.driver = DriverPostgres
+		case DriverPostgres, DriverSQLite:
+			c.driver = driver
+		default:
+			return fmt.Errorf("database: unsupported driver %q", driver)
+		}
+		return nil
+	}
+}
+
 // MasterURI is an Option to set the master uri of client.
 func (options) MasterURI(uri string) Option {
 	return func(c *client) error {
@@ -59,10 +81,35 @@ func (options) MasterURI(uri string) Option {
 	}
 }
 
-// SlaveURI is an Option to set the slave uri of client.
+// SlaveURI is an Option to add a slave uri to client, empty uris are ignored.
 func (options) SlaveURI(uri string) Option {
+	return Options.SlaveURIs(uri)
+}
+
+// SlaveURIs is an Option to add slave uris to client, empty uris are ignored.
+func (options) SlaveURIs(uris ...string) Option {
 	return func(c *client) error {
-		c.slave = uri
+		for _, uri := range uris {
+			if uri != "" {
+				c.slaves = append(c.slaves, uri)
+			}
+		}
+		return nil
+	}
+}
+
+// Balancer is an Option to set how reads are spread over healthy replicas,
+// one of BalancerRoundRobin or BalancerLeastConnections.
+func (options) Balancer(balancer string) Option {
+	return func(c *client) error {
+		switch balancer {
+		case "":
+			c.balancer = BalancerRoundRobin
+		case BalancerRoundRobin, BalancerLeastConnections:
+			c.balancer = balancer
+		default:
+			return fmt.Errorf("database: unsupported balancer %q", balancer)
+		}
 		return nil
 	}
 }
@@ -91,26 +138,55 @@ func (options) MaxConnTimeout(maxTime time.Duration) Option {
 	}
 }
 
-// RedisCacheURI is an Option to set the uri of redis client for caching.
+// MaxReplicaLag is an Option to set the replica lag beyond which a replica
+// is ejected. Zero disables the lag check.
+func (options) MaxReplicaLag(lag time.Duration) Option {
+	return func(c *client) error {
+		c.maxReplicaLag = lag
+		return nil
+	}
+}
+
+// HealthCheckInterval is an Option to set how often replicas are checked.
+func (options) HealthCheckInterval(interval time.Duration) Option {
+	return func(c *client) error {
+		if interval > 0 {
+			c.healthCheckInterval = interval
+		}
+		return nil
+	}
+}
+
+// RedisCacheURI is an Option to set the address of redis client for caching.
 func (options) RedisCacheURI(uri string) Option {
 	return func(c *client) error {
-		c.redisCacheURI = uri
+		if uri != "" {
+			c.cache.Redis.Addrs = []string{uri}
+		}
 		return nil
 	}
 }
 
-// RedisCacheEnable is an Option to enable redis cache by query.
+// RedisCacheEnable is an Option to enable the query cache.
 func (options) RedisCacheEnable(enable bool) Option {
 	return func(c *client) error {
-		c.redisCacheEnable = enable
+		c.cache.Enable = enable
 		return nil
 	}
 }
 
-// RedisCacheTTL is an Option to set the cache ttl in case using query redis cache.
+// RedisCacheTTL is an Option to set the ttl of query cache entries.
 func (options) RedisCacheTTL(ttl time.Duration) Option {
 	return func(c *client) error {
-		c.redisCacheTTL = ttl
+		c.cache.TTL = ttl
+		return nil
+	}
+}
+
+// QueryCache is an Option to set the whole query cache configuration.
+func (options) QueryCache(cfg QueryCacheConfig) Option {
+	return func(c *client) error {
+		c.cache = cfg
 		return nil
 	}
 }
@@ -118,29 +194,64 @@ func (options) RedisCacheTTL(ttl time.Duration) Option {
 // ConfigLoader is an Options to load all options that configured through viper.
 // Client's options that are loaded from viper by following keys:
 //
+//   - postgres.driver
+//     database driver, "postgres" (default) or "sqlite".
 //   - postgres.master.uri
 //     db uri to connect with master connection type.
 //   - postgres.slave.uri
 //     db uri to connect with slave connection type.
+//   - postgres.slave.uris
+//     db uris of additional replicas.
+//   - postgres.slave.balancer
+//     "round_robin" (default) or "least_connections".
+//   - postgres.slave.max_lag
+//     replica lag beyond which a replica is ejected, 0 to disable.
+//   - postgres.slave.health_check_interval
+//     how often replicas are checked, defaults to 5s.
+//   - postgres.cache.*
+//     query cache, see the keys documented in config.yaml. Keys of the redis
+//     level are prefixed by "<service.namespace>:game-service:entcache:"
+//     unless postgres.cache.key_prefix is set.
 func (options) ConfigLoader(v *viper.Viper) Option {
 	return func(c *client) error {
 		cfg := struct {
 			Postgres struct {
+				Driver string `mapstructure:"driver"`
 				Master struct {
 					URI string `mapstructure:"uri"`
 				} `mapstructure:"master"`
 				Slave struct {
-					URI string `mapstructure:"uri"`
+					URI                 string        `mapstructure:"uri"`
+					URIs                []string      `mapstructure:"uris"`
+					Balancer            string        `mapstructure:"balancer"`
+					MaxLag              time.Duration `mapstructure:"max_lag"`
+					HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
 				} `mapstructure:"slave"`
 				MaxIdleConns   int           `mapstructure:"max_idle_conns"`
 				MaxActiveConns int           `mapstructure:"max_active_conns"`
 				MaxConnTimeout time.Duration `mapstructure:"max_conn_timeout"`
-				RedisCache     struct {
-					URI    string        `mapstructure:"uri"`
-					Enable bool          `mapstructure:"enable"`
-					TTL    time.Duration `mapstructure:"ttl"`
-				} `mapstructure:"redis_cache"`
+				Cache          struct {
+					Enable    bool          `mapstructure:"enable"`
+					TTL       time.Duration `mapstructure:"ttl"`
+					Mode      string        `mapstructure:"mode"`
+					Levels    []string      `mapstructure:"levels"`
+					LRUSize   int           `mapstructure:"lru_size"`
+					KeyPrefix string        `mapstructure:"key_prefix"`
+					Redis     struct {
+						Addrs              []string `mapstructure:"addrs"`
+						Username           string   `mapstructure:"username"`
+						Password           string   `mapstructure:"password"`
+						DB                 int      `mapstructure:"db"`
+						MasterName         string   `mapstructure:"master_name"`
+						Cluster            bool     `mapstructure:"cluster"`
+						TLS                bool     `mapstructure:"tls"`
+						InsecureSkipVerify bool     `mapstructure:"insecure_skip_verify"`
+					} `mapstructure:"redis"`
+				} `mapstructure:"cache"`
 			} `mapstructure:"postgres"`
+			Service struct {
+				Namespace string `mapstructure:"namespace"`
+			} `mapstructure:"service"`
 		}{}
 
 		if err := viperutil.Unmarshal(v, &cfg); err != nil {
@@ -150,16 +261,46 @@ func (options) ConfigLoader(v *viper.Viper) Option {
 		var opts []Option
 		opts = append(
 			opts,
+			Options.Driver(cfg.Postgres.Driver),
 			Options.MasterURI(cfg.Postgres.Master.URI),
 			Options.SlaveURI(cfg.Postgres.Slave.URI),
+			Options.SlaveURIs(cfg.Postgres.Slave.URIs...),
+			Options.Balancer(cfg.Postgres.Slave.Balancer),
+			Options.MaxReplicaLag(cfg.Postgres.Slave.MaxLag),
+			Options.HealthCheckInterval(cfg.Postgres.Slave.HealthCheckInterval),
 			Options.MaxActiveConns(cfg.Postgres.MaxActiveConns),
 			Options.MaxIdleConns(cfg.Postgres.MaxIdleConns),
 			Options.MaxConnTimeout(cfg.Postgres.MaxConnTimeout),
-			Options.RedisCacheURI(cfg.Postgres.RedisCache.URI),
-			Options.RedisCacheEnable(cfg.Postgres.RedisCache.Enable),
-			Options.RedisCacheTTL(cfg.Postgres.RedisCache.TTL),
 		)
 
+		cache := cfg.Postgres.Cache
+		cacheCfg := QueryCacheConfig{
+			Enable:    cache.Enable,
+			TTL:       cache.TTL,
+			Mode:      cache.Mode,
+			Levels:    cache.Levels,
+			LRUSize:   cache.LRUSize,
+			KeyPrefix: cache.KeyPrefix,
+			Redis: RedisCacheConfig{
+				Addrs:      cache.Redis.Addrs,
+				Username:   cache.Redis.Username,
+				Password:   cache.Redis.Password,
+				DB:         cache.Redis.DB,
+				MasterName: cache.Redis.MasterName,
+				Cluster:    cache.Redis.Cluster,
+			},
+		}
+		if cacheCfg.KeyPrefix == "" && cfg.Service.Namespace != "" {
+			cacheCfg.KeyPrefix = cfg.Service.Namespace + ":game-service:entcache:"
+		}
+		if cache.Redis.TLS {
+			cacheCfg.Redis.TLS = &tls.Config{
+				MinVersion:         tls.VersionTLS12,
+				InsecureSkipVerify: cache.Redis.InsecureSkipVerify, //nolint:gosec // opt-in for self-signed certs.
+			}
+		}
+		opts = append(opts, Options.QueryCache(cacheCfg))
+
 		for _, opt := range opts {
 			if err := opt(c); err != nil {
 				return err
@@ -177,103 +318,147 @@ type Client interface {
 	SlaveDB(context.Context) *sql.DB
 	Slave(context.Context) *entc.Client
 	DBName() string
+	Dialect() string
+	ReplicaStats() []ReplicaStats
+	FlushQueryCache(context.Context) error
+	Close() error
 }
 
 type client struct {
-	dbname           string
-	master           string
-	slave            string
-	masterDB         *sql.DB
-	slaveDB          *sql.DB
-	maxIdleConns     int
-	maxActiveConns   int
-	maxConnTimeout   time.Duration
-	redisCacheEnable bool
-	redisCacheTTL    time.Duration
-	redisCacheURI    string
-	MasterPool       *entc.Client
-	SlavePool        *entc.Client
+	driver         string
+	dbname         string
+	master         string
+	slaves         []string
+	masterDB       *sql.DB
+	maxIdleConns   int
+	maxActiveConns int
+	maxConnTimeout time.Duration
+	cache          QueryCacheConfig
+	queryCache     *QueryCache
+	// read replicas, next is the round robin cursor.
+	replicas            []*replica
+	balancer            string
+	next                atomic.Uint64
+	maxReplicaLag       time.Duration
+	healthCheckInterval time.Duration
+	stopMonitor         context.CancelFunc
+	MasterPool          *entc.Client
 }
 
 // New creates and return new instance of Client.
+//
+// Reads through Slave and SlaveDB are spread over the healthy replicas, and go
+// to master when the context is marked by WithMaster or no replica is healthy.
+// Replicas are checked in background, a replica that cannot be reached or
+// lags more than the MaxReplicaLag option is ejected until it recovers.
+//
+// With DriverSQLite the schema is created by ent auto migration, and reads go
+// to master unless a slave uri is set. In-memory databases
+// must be opened in shared cache mode, e.g. "file:game?mode=memory&cache=shared&_fk=1",
+// for all connections of the pool to see the same database.
 func New(opts ...Option) (Client, error) {
-	c := client{}
+	c := &client{
+		driver:              DriverPostgres,
+		balancer:            BalancerRoundRobin,
+		healthCheckInterval: 5 * time.Second,
+	}
 
 	for _, opt := range opts {
-		if err := opt(&c); err != nil {
+		if err := opt(c); err != nil {
 			return nil, err
 		}
 	}
 
-	masterDB, master, err := NewClient(c.master, c.maxIdleConns, c.maxActiveConns, c.maxConnTimeout, c.redisCacheEnable, c.redisCacheURI, c.redisCacheTTL)
+	queryCache, err := NewQueryCache(c.cache)
 	if err != nil {
 		return nil, err
 	}
+	c.queryCache = queryCache
 
-	// get db name and assign it to client.dbname
-	rows, err := masterDB.Query("SELECT current_database()")
+	masterDB, master, err := NewClient(c.driver, c.master, c.maxIdleConns, c.maxActiveConns, c.maxConnTimeout, c.queryCache)
 	if err != nil {
 		return nil, err
 	}
 
-	for rows.Next() {
-		var currentDB string
-		if err := rows.Scan(&currentDB); err != nil {
+	c.MasterPool = master
+	c.masterDB = masterDB
+
+	if c.driver == DriverSQLite {
+		c.dbname = "main"
+		// schema inspection queries must not go through the query cache.
+		if err := master.Schema.Create(SkipQueryCache(context.Background())); err != nil {
+			return nil, fmt.Errorf("database: auto migrating sqlite schema: %w", err)
+		}
+	} else {
+		// get db name and assign it to client.dbname
+		rows, err := masterDB.Query("SELECT current_database()")
+		if err != nil {
 			return nil, err
 		}
 
-		c.dbname = currentDB
+		for rows.Next() {
+			var currentDB string
+			if err := rows.Scan(&currentDB); err != nil {
+				return nil, err
+			}
+
+			c.dbname = currentDB
+		}
 	}
 
-	c.MasterPool = master
-	c.masterDB = masterDB
+	for _, uri := range c.slaves {
+		if uri == c.master {
+			continue
+		}
 
-	slaveDB, slave, err := NewClient(c.slave, c.maxIdleConns, c.maxActiveConns, c.maxConnTimeout, c.redisCacheEnable, c.redisCacheURI, c.redisCacheTTL)
-	if err != nil {
-		return nil, err
+		slaveDB, slave, err := NewClient(c.driver, uri, c.maxIdleConns, c.maxActiveConns, c.maxConnTimeout, c.queryCache)
+		if err != nil {
+			return nil, err
+		}
+
+		c.replicas = append(c.replicas, &replica{uri: uri, db: slaveDB, pool: slave})
 	}
 
-	c.SlavePool = slave
-	c.slaveDB = slaveDB
+	if len(c.replicas) > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		c.stopMonitor = cancel
+		c.checkReplicas(ctx)
+		go c.monitorReplicas(ctx)
+	}
 
-	return &c, nil
+	return c, nil
 }
 
-// NewClient initialize db connection.
+// NewClient initialize db connection, queries go through queryCache unless
+// it is nil.
 func NewClient(
+	driver string,
 	uri string,
 	maxIdleConns,
 	maxActiveConns int,
 	maxConnTimeout time.Duration,
-	redisCacheEnable bool,
-	redisCacheURI string,
-	redisCacheTTL time.Duration,
+	queryCache *QueryCache,
 ) (*sql.DB, *entc.Client, error) {
-	db, err := sql.Open("pgx", uri)
+	driverName, dialectName := "pgx", dialect.Postgres
+	if driver == DriverSQLite {
+		driverName, dialectName = "sqlite3", dialect.SQLite
+	}
+
+	db, err := sql.Open(driverName, uri)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	db.SetMaxIdleConns(maxIdleConns)
 	db.SetMaxOpenConns(maxActiveConns)
-	db.SetConnMaxLifetime(maxConnTimeout)
-
-	drv := entsql.OpenDB(dialect.Postgres, db)
-	if redisCacheEnable {
-		rdb := redis.NewClient(&redis.Options{
-			Addr: redisCacheURI,
-		})
-		drv := entcache.NewDriver(
-			drv,
-			entcache.TTL(redisCacheTTL),
-			entcache.Levels(
-				entcache.NewLRU(256),
-				entcache.NewRedis(rdb),
-			),
-		)
+	if driver != DriverSQLite {
+		// in-memory sqlite databases are dropped with their last connection.
+		db.SetConnMaxLifetime(maxConnTimeout)
+	}
 
-		client := entc.NewClient(entc.Driver(drv))
-		return db, client, nil
+	var drv dialect.Driver = entsql.OpenDB(dialectName, db)
+	if queryCache != nil {
+		drv = queryCache.wrap(drv)
 	}
 
 	client := entc.NewClient(entc.Driver(drv))
@@ -286,14 +471,37 @@ func (c *client) DBName() string {
 	return c.dbname
 }
 
-// Master to return master pool.
-func (c *client) Master(context.Context) *entc.Client {
+// Dialect to return the ent dialect of the db.
+func (c *client) Dialect() string {
+	if c.driver == DriverSQLite {
+		return dialect.SQLite
+	}
+
+	return dialect.Postgres
+}
+
+// Master to return master pool, or the client of the transaction of ctx.
+func (c *client) Master(ctx context.Context) *entc.Client {
+	if tx := entc.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
 	return c.MasterPool
 }
 
-// Slave to return slave pool.
-func (c *client) Slave(context.Context) *entc.Client {
-	return c.SlavePool
+// Slave to return the pool of a healthy replica, or master pool when reads of
+// ctx must observe its writes or no replica is healthy. Inside a transaction
+// it returns the client of the transaction.
+func (c *client) Slave(ctx context.Context) *entc.Client {
+	if tx := entc.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	if r := c.replica(ctx); r != nil {
+		return r.pool
+	}
+
+	return c.MasterPool
 }
 
 // MasterDB to return DB connection without ent.Client
@@ -301,7 +509,43 @@ func (c *client) MasterDB(context.Context) *sql.DB {
 	return c.masterDB
 }
 
-// SlaveDB to return DB connection without ent.Client
-func (c *client) SlaveDB(context.Context) *sql.DB {
-	return c.slaveDB
+// SlaveDB to return DB connection without ent.Client, routed as Slave.
+func (c *client) SlaveDB(ctx context.Context) *sql.DB {
+	if r := c.replica(ctx); r != nil {
+		return r.db
+	}
+
+	return c.masterDB
+}
+
+// Close stops checking replicas and closes all pools.
+func (c *client) Close() error {
+	if c.stopMonitor != nil {
+		c.stopMonitor()
+	}
+
+	if err := c.MasterPool.Close(); err != nil {
+		return err
+	}
+
+	for _, r := range c.replicas {
+		if err := r.pool.Close(); err != nil {
+			return err
+		}
+	}
+
+	if c.queryCache != nil {
+		return c.queryCache.Close()
+	}
+
+	return nil
+}
+
+// FlushQueryCache deletes all entries of the query cache.
+func (c *client) FlushQueryCache(ctx context.Context) error {
+	if c.queryCache == nil {
+		return nil
+	}
+
+	return c.queryCache.Flush(ctx)
 }
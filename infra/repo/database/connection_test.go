@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_SQLite(t *testing.T) {
+	tests := []struct {
+		name      string
+		slave     string
+		wantShare bool
+	}{
+		{
+			name:      "TC01 - without slave uri - should share the master pool",
+			wantShare: true,
+		},
+		{
+			name:  "TC02 - with slave uri - should open the slave pool",
+			slave: "file:" + t.Name() + "?mode=memory&cache=shared&_fk=1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c, err := New(
+				Options.Driver(DriverSQLite),
+				Options.MasterURI("file:"+t.Name()+"?mode=memory&cache=shared&_fk=1"),
+				Options.SlaveURI(tt.slave),
+				Options.MaxIdleConns(1),
+				Options.MaxActiveConns(2),
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, "main", c.DBName())
+			assert.Equal(t, "sqlite3", c.Dialect())
+			assert.Equal(t, tt.wantShare, c.Master(ctx) == c.Slave(ctx))
+
+			_, err = c.Master(ctx).Item.Create().SetID("item_1").SetName("Sword").SetCategory("weapon").SetDescription("").Save(ctx)
+			require.NoError(t, err)
+
+			n, err := c.Master(ctx).Item.Query().Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, n)
+		})
+	}
+}
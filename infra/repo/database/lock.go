@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+)
+
+// ForUpdate returns a query modifier locking the selected rows until the end
+// of the transaction. Like TryLock it only locks on Postgres, SQLite
+// serializing the transactions writing to a database.
+func ForUpdate(client Client) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if client.Dialect() == dialect.Postgres {
+			s.ForUpdate()
+		}
+	}
+}
+
+// TryLock tries to take the lock named name until the end of the transaction
+// of ctx, and reports whether it was taken. Postgres advisory locks are used,
+// other databases are used by a single process so the lock is always taken.
+func TryLock(ctx context.Context, client Client, name string) (bool, error) {
+	if client.Dialect() != dialect.Postgres {
+		return true, nil
+	}
+
+	tx := entc.TxFromContext(ctx)
+	if tx == nil {
+		return false, errors.New("database: lock out of a transaction")
+	}
+
+	rows, err := tx.Client().QueryContext(ctx, "SELECT pg_try_advisory_xact_lock(hashtext($1))", name)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var locked bool
+	if rows.Next() {
+		if err := rows.Scan(&locked); err != nil {
+			return false, err
+		}
+	}
+
+	return locked, rows.Err()
+}
+
+// TrySessionLock tries to take the lock named name on a connection of its
+// own, and reports whether it was taken. The lock is held until unlock is
+// called, or the connection is lost, so it suits work too long for a
+// transaction. Like TryLock it is always taken but on Postgres.
+func TrySessionLock(ctx context.Context, client Client, name string) (unlock func(), locked bool, err error) {
+	if client.Dialect() != dialect.Postgres {
+		return func() {}, true, nil
+	}
+
+	conn, err := client.MasterDB(ctx).Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", name).Scan(&locked)
+	if err != nil || !locked {
+		conn.Close()
+		return nil, false, err
+	}
+
+	unlock = func() {
+		// closing the connection would release the lock as well, but pooled
+		// connections are not closed.
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", name); err != nil {
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}
+
+	return unlock, true, nil
+}
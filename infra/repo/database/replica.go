@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+)
+
+// lagQuery measures how far a postgres replica is behind master. A replica
+// that has replayed all it received is not lagging, even if master has been
+// idle since its last transaction.
+const lagQuery = `SELECT CASE
+	WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END::float8`
+
+// ReplicaStats describes the state of a read replica for diagnostics.
+type ReplicaStats struct {
+	// URI is the uri of the replica without password.
+	URI     string      `json:"uri"`
+	Healthy bool        `json:"healthy"`
+	Lag     string      `json:"lag"`
+	Error   string      `json:"error,omitempty"`
+	Pool    sql.DBStats `json:"pool"`
+}
+
+// replica is a read replica, its state is updated by health checks.
+type replica struct {
+	uri     string
+	db      *sql.DB
+	pool    *entc.Client
+	healthy atomic.Bool
+	// lag is the last measured lag in nanoseconds.
+	lag atomic.Int64
+	err atomic.Value
+}
+
+// check checks whether the replica can be reached and lags at most maxLag.
+func (r *replica) check(ctx context.Context, driver string, maxLag time.Duration) {
+	var err error
+	if driver == DriverPostgres {
+		var seconds float64
+		err = r.db.QueryRowContext(ctx, lagQuery).Scan(&seconds)
+		r.lag.Store(int64(seconds * float64(time.Second)))
+	} else {
+		err = r.db.PingContext(ctx)
+	}
+
+	if err != nil {
+		r.err.Store(err.Error())
+		r.healthy.Store(false)
+		return
+	}
+
+	r.err.Store("")
+	r.healthy.Store(maxLag <= 0 || time.Duration(r.lag.Load()) <= maxLag)
+}
+
+// stats returns the diagnostics of the replica.
+func (r *replica) stats() ReplicaStats {
+	uri := r.uri
+	if u, err := url.Parse(r.uri); err == nil {
+		uri = u.Redacted()
+	}
+
+	errText, _ := r.err.Load().(string)
+
+	return ReplicaStats{
+		URI:     uri,
+		Healthy: r.healthy.Load(),
+		Lag:     time.Duration(r.lag.Load()).String(),
+		Error:   errText,
+		Pool:    r.db.Stats(),
+	}
+}
+
+// ReplicaStats to return the state of all replicas.
+func (c *client) ReplicaStats() []ReplicaStats {
+	res := make([]ReplicaStats, 0, len(c.replicas))
+	for _, r := range c.replicas {
+		res = append(res, r.stats())
+	}
+
+	return res
+}
+
+// replica picks a healthy replica to read from, or returns nil when reads of
+// ctx must go to master.
+func (c *client) replica(ctx context.Context) *replica {
+	if ReadsFromMaster(ctx) {
+		return nil
+	}
+
+	healthy := make([]*replica, 0, len(c.replicas))
+	for _, r := range c.replicas {
+		if r.healthy.Load() {
+			healthy = append(healthy, r)
+		}
+	}
+	if len(healthy) == 0 {
+		return nil
+	}
+
+	if c.balancer == BalancerLeastConnections {
+		res := healthy[0]
+		for _, r := range healthy[1:] {
+			if r.db.Stats().InUse < res.db.Stats().InUse {
+				res = r
+			}
+		}
+		return res
+	}
+
+	return healthy[(c.next.Add(1)-1)%uint64(len(healthy))]
+}
+
+// checkReplicas checks all replicas concurrently.
+func (c *client) checkReplicas(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.healthCheckInterval)
+	defer cancel()
+
+	done := make(chan struct{}, len(c.replicas))
+	for _, r := range c.replicas {
+		go func(r *replica) {
+			r.check(ctx, c.driver, c.maxReplicaLag)
+			done <- struct{}{}
+		}(r)
+	}
+	for range c.replicas {
+		<-done
+	}
+}
+
+// monitorReplicas checks all replicas every health check interval until ctx
+// is done.
+func (c *client) monitorReplicas(ctx context.Context) {
+	ticker := time.NewTicker(c.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkReplicas(ctx)
+		}
+	}
+}
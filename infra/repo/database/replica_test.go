@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+)
+
+func TestClient_Slave(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		balancer string
+		healthy  []bool
+		inUse    []int
+		want     []int
+	}{
+		{
+			name: "TC01 - no replica - should read from master",
+			ctx:  context.Background(),
+			want: []int{-1},
+		},
+		{
+			name:    "TC02 - context marked by WithMaster - should read from master",
+			ctx:     WithMaster(context.Background()),
+			healthy: []bool{true},
+			want:    []int{-1},
+		},
+		{
+			name:    "TC03 - round robin - should cycle over healthy replicas",
+			ctx:     context.Background(),
+			healthy: []bool{true, false, true},
+			want:    []int{0, 2, 0, 2},
+		},
+		{
+			name:    "TC04 - no healthy replica - should fall back to master",
+			ctx:     context.Background(),
+			healthy: []bool{false, false},
+			want:    []int{-1, -1},
+		},
+		{
+			name:     "TC05 - least connections - should pick the least busy replica",
+			ctx:      context.Background(),
+			balancer: BalancerLeastConnections,
+			healthy:  []bool{true, true, true},
+			inUse:    []int{2, 1, 1},
+			want:     []int{1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &client{
+				balancer:   tt.balancer,
+				MasterPool: entc.NewClient(),
+			}
+			for i, healthy := range tt.healthy {
+				db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory")
+				require.NoError(t, err)
+				t.Cleanup(func() { db.Close() })
+
+				if tt.inUse != nil {
+					for n := 0; n < tt.inUse[i]; n++ {
+						conn, err := db.Conn(tt.ctx)
+						require.NoError(t, err)
+						t.Cleanup(func() { conn.Close() })
+					}
+				}
+
+				r := &replica{db: db, pool: entc.NewClient()}
+				r.healthy.Store(healthy)
+				c.replicas = append(c.replicas, r)
+			}
+
+			for _, want := range tt.want {
+				if want < 0 {
+					assert.Same(t, c.MasterPool, c.Slave(tt.ctx))
+					continue
+				}
+				assert.Same(t, c.replicas[want].pool, c.Slave(tt.ctx))
+			}
+		})
+	}
+}
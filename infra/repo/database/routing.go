@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Names of the cookie and header routing the reads of a request to master.
+const (
+	ReadMasterCookie = "db_read_master"
+	ReadMasterHeader = "X-Read-Master"
+)
+
+type readMasterKey struct{}
+
+// WithMaster returns a copy of ctx whose reads through Client.Slave and
+// Client.SlaveDB are routed to master, so they observe preceding writes.
+func WithMaster(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readMasterKey{}, true)
+}
+
+// ReadsFromMaster reports whether reads of ctx are routed to master.
+func ReadsFromMaster(ctx context.Context) bool {
+	v, _ := ctx.Value(readMasterKey{}).(bool)
+	return v
+}
+
+// ReadYourWrites returns a http middleware routing reads to master for
+// requests that write, and for the requests following a write within window.
+// Clients are pinned by a cookie carrying its expiry signed with secret, a
+// random secret is generated when empty, which pins clients to the process
+// only. Requests for which trusted reports true, e.g. those of services, may
+// also opt in with the ReadMasterHeader.
+func ReadYourWrites(window time.Duration, secret []byte, trusted func(r *http.Request) bool) func(http.Handler) http.Handler {
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(fmt.Sprintf("database: generating read master secret: %v", err))
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			now := time.Now()
+			pinned := r.Header.Get(ReadMasterHeader) != "" && trusted != nil && trusted(r)
+			if c, err := r.Cookie(ReadMasterCookie); err == nil && verifyReadMaster(c.Value, secret, now) {
+				pinned = true
+			}
+
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+			default:
+				pinned = true
+				http.SetCookie(w, &http.Cookie{
+					Name:     ReadMasterCookie,
+					Value:    signReadMaster(now.Add(window).Unix(), secret),
+					Path:     "/",
+					MaxAge:   int(window / time.Second),
+					HttpOnly: true,
+				})
+			}
+
+			if pinned {
+				r = r.WithContext(WithMaster(r.Context()))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// signReadMaster returns the value of a read master cookie expiring at
+// expires, "<expires>.<signature>".
+func signReadMaster(expires int64, secret []byte) string {
+	exp := strconv.FormatInt(expires, 10)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(exp))
+
+	return exp + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyReadMaster reports whether value is a read master cookie signed with
+// secret which has not expired.
+func verifyReadMaster(value string, secret []byte, now time.Time) bool {
+	exp, _, ok := strings.Cut(value, ".")
+	if !ok {
+		return false
+	}
+	expires, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || now.Unix() >= expires {
+		return false
+	}
+
+	return hmac.Equal([]byte(value), []byte(signReadMaster(expires, secret)))
+}
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadYourWrites(t *testing.T) {
+	secret := []byte("s3cr3t")
+	valid := signReadMaster(time.Now().Add(time.Minute).Unix(), secret)
+
+	tests := []struct {
+		name       string
+		method     string
+		header     bool
+		trusted    bool
+		cookie     string
+		wantMaster bool
+		wantCookie bool
+	}{
+		{
+			name:   "TC01 - plain read - should not route to master",
+			method: http.MethodGet,
+		},
+		{
+			name:       "TC02 - write - should route to master and pin the client",
+			method:     http.MethodPost,
+			wantMaster: true,
+			wantCookie: true,
+		},
+		{
+			name:       "TC03 - read of pinned client - should route to master",
+			method:     http.MethodGet,
+			cookie:     valid,
+			wantMaster: true,
+		},
+		{
+			name:       "TC04 - read with header of trusted caller - should route to master",
+			method:     http.MethodGet,
+			header:     true,
+			trusted:    true,
+			wantMaster: true,
+		},
+		{
+			name:   "TC05 - read with header of untrusted caller - should not route to master",
+			method: http.MethodGet,
+			header: true,
+		},
+		{
+			name:   "TC06 - forged cookie - should not route to master",
+			method: http.MethodGet,
+			cookie: "1",
+		},
+		{
+			name:   "TC07 - expired cookie - should not route to master",
+			method: http.MethodGet,
+			cookie: signReadMaster(time.Now().Add(-time.Second).Unix(), secret),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMaster bool
+			trusted := func(*http.Request) bool { return tt.trusted }
+			h := ReadYourWrites(5*time.Second, secret, trusted)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				gotMaster = ReadsFromMaster(r.Context())
+			}))
+
+			r := httptest.NewRequest(tt.method, "/items", nil)
+			if tt.header {
+				r.Header.Set(ReadMasterHeader, "1")
+			}
+			if tt.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: ReadMasterCookie, Value: tt.cookie})
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantMaster, gotMaster)
+			cookies := w.Result().Cookies()
+			assert.Equal(t, tt.wantCookie, len(cookies) > 0)
+			if tt.wantCookie {
+				assert.True(t, verifyReadMaster(cookies[0].Value, secret, time.Now()))
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgconn"
+
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+)
+
+// Postgres error codes of transactions that may succeed when retried.
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// TxOption is a function that sets some option on a transaction.
+type TxOption func(*txConfig)
+
+// TxOptions is a factory for all available transaction's options.
+var TxOptions txOptions
+
+type txOptions struct{}
+
+type txConfig struct {
+	readOnly   bool
+	isolation  sql.IsolationLevel
+	maxRetries int
+	backoff    time.Duration
+}
+
+// ReadOnly is a TxOption to run a read only transaction. Read only
+// transactions run on a replica, as reads through Slave do.
+func (txOptions) ReadOnly() TxOption {
+	return func(c *txConfig) {
+		c.readOnly = true
+	}
+}
+
+// Isolation is a TxOption to set the isolation level of the transaction,
+// defaults to the level of the database.
+func (txOptions) Isolation(level sql.IsolationLevel) TxOption {
+	return func(c *txConfig) {
+		c.isolation = level
+	}
+}
+
+// MaxRetries is a TxOption to set how many times a transaction failing on a
+// serialization failure or a deadlock is retried, defaults to 3.
+func (txOptions) MaxRetries(n int) TxOption {
+	return func(c *txConfig) {
+		c.maxRetries = n
+	}
+}
+
+// Backoff is a TxOption to set the base delay between retries, doubled on
+// each retry with jitter, defaults to 20ms.
+func (txOptions) Backoff(d time.Duration) TxOption {
+	return func(c *txConfig) {
+		c.backoff = d
+	}
+}
+
+// RunInTx runs fn in a transaction carried by its context, so that Master and
+// Slave of client return the transactional client. fn joins the transaction
+// of ctx if there is one, otherwise the transaction is committed when fn
+// succeeds, rolled back when it fails, and retried with backoff on
+// serialization failures and deadlocks.
+func RunInTx(ctx context.Context, client Client, fn func(ctx context.Context) error, opts ...TxOption) error {
+	if entc.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	cfg := txConfig{
+		maxRetries: 3,
+		backoff:    20 * time.Millisecond,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := runInTx(ctx, client, fn, &cfg)
+		if err == nil || attempt >= cfg.maxRetries || !IsRetryable(err) {
+			return err
+		}
+
+		// full jitter keeps concurrent retries of conflicting transactions apart.
+		delay := cfg.backoff << attempt
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1)) //nolint:gosec // jitter only.
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func runInTx(ctx context.Context, client Client, fn func(ctx context.Context) error, cfg *txConfig) (err error) {
+	pool := client.Master(ctx)
+	if cfg.readOnly {
+		pool = client.Slave(ctx)
+	}
+
+	tx, err := pool.BeginTx(ctx, &sql.TxOptions{
+		Isolation: cfg.isolation,
+		ReadOnly:  cfg.readOnly,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(entc.NewTxContext(ctx, tx)); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// IsRetryable reports whether err is a serialization failure or a deadlock,
+// so the transaction it failed may succeed when retried.
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected
+}
@@ -0,0 +1,107 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunInTx(t *testing.T) {
+	errFailed := errors.New("failed")
+	serializationFailure := &pgconn.PgError{Code: pgSerializationFailure}
+
+	tests := []struct {
+		name         string
+		errs         []error
+		nested       bool
+		wantErr      error
+		wantAttempts int
+		wantItems    int
+	}{
+		{
+			name:         "TC01 - success - should commit",
+			errs:         []error{nil},
+			wantAttempts: 1,
+			wantItems:    1,
+		},
+		{
+			name:         "TC02 - failure - should roll back",
+			errs:         []error{errFailed},
+			wantErr:      errFailed,
+			wantAttempts: 1,
+		},
+		{
+			name:         "TC03 - serialization failure - should retry and commit",
+			errs:         []error{serializationFailure, serializationFailure, nil},
+			wantAttempts: 3,
+			wantItems:    1,
+		},
+		{
+			name:         "TC04 - serialization failures beyond max retries - should fail",
+			errs:         []error{serializationFailure, serializationFailure, serializationFailure},
+			wantErr:      serializationFailure,
+			wantAttempts: 3,
+		},
+		{
+			name:         "TC05 - nested - should join the outer transaction",
+			errs:         []error{nil},
+			nested:       true,
+			wantAttempts: 1,
+			wantItems:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c, err := New(
+				Options.Driver(DriverSQLite),
+				Options.MasterURI("file:"+t.Name()+"?mode=memory&cache=shared&_fk=1"),
+				Options.MaxIdleConns(1),
+				Options.QueryCache(QueryCacheConfig{
+					Enable: true,
+					Levels: []string{CacheLevelLRU},
+				}),
+			)
+			require.NoError(t, err)
+			defer c.Close()
+
+			attempts := 0
+			fn := func(ctx context.Context) error {
+				err := tt.errs[attempts]
+				attempts++
+
+				_, cerr := c.Master(ctx).Item.Create().
+					SetID("item_1").
+					SetName("Sword").
+					SetCategory("weapon").
+					SetDescription("").
+					Save(ctx)
+				require.NoError(t, cerr)
+
+				return err
+			}
+			run := func(ctx context.Context) error {
+				return RunInTx(ctx, c, fn, TxOptions.MaxRetries(2), TxOptions.Backoff(0))
+			}
+			if tt.nested {
+				outer := run
+				run = func(ctx context.Context) error {
+					return RunInTx(ctx, c, outer)
+				}
+			}
+
+			err = run(ctx)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantAttempts, attempts)
+
+			n, err := c.Slave(ctx).Item.Query().Count(SkipQueryCache(ctx))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantItems, n)
+		})
+	}
+}
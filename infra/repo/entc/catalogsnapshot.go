@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+)
+
+// CatalogSnapshot is the model entity for the CatalogSnapshot schema.
+type CatalogSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// Data holds the value of the "data" field.
+	Data []byte `json:"data,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CatalogSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case catalogsnapshot.FieldData:
+			values[i] = new([]byte)
+		case catalogsnapshot.FieldID, catalogsnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case catalogsnapshot.FieldHash:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CatalogSnapshot fields.
+func (cs *CatalogSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case catalogsnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cs.ID = int64(value.Int64)
+		case catalogsnapshot.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				cs.Hash = value.String
+			}
+		case catalogsnapshot.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				cs.Data = *value
+			}
+		case catalogsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Int64
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CatalogSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (cs *CatalogSnapshot) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CatalogSnapshot.
+// Note that you need to call CatalogSnapshot.Unwrap() before calling this method if this CatalogSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *CatalogSnapshot) Update() *CatalogSnapshotUpdateOne {
+	return NewCatalogSnapshotClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the CatalogSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *CatalogSnapshot) Unwrap() *CatalogSnapshot {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: CatalogSnapshot is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *CatalogSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("CatalogSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(cs.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Data))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", cs.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CatalogSnapshots is a parsable slice of CatalogSnapshot.
+type CatalogSnapshots []*CatalogSnapshot
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package catalogsnapshot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldHash, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v []byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldData, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldContainsFold(FieldHash, v))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v []byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v []byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...[]byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...[]byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v []byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v []byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v []byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v []byte) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLTE(FieldData, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CatalogSnapshot) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CatalogSnapshot) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CatalogSnapshot) predicate.CatalogSnapshot {
+	return predicate.CatalogSnapshot(sql.NotPredicates(p))
+}
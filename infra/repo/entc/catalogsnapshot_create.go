@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+)
+
+// CatalogSnapshotCreate is the builder for creating a CatalogSnapshot entity.
+type CatalogSnapshotCreate struct {
+	config
+	mutation *CatalogSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetHash sets the "hash" field.
+func (csc *CatalogSnapshotCreate) SetHash(s string) *CatalogSnapshotCreate {
+	csc.mutation.SetHash(s)
+	return csc
+}
+
+// SetData sets the "data" field.
+func (csc *CatalogSnapshotCreate) SetData(b []byte) *CatalogSnapshotCreate {
+	csc.mutation.SetData(b)
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *CatalogSnapshotCreate) SetCreatedAt(i int64) *CatalogSnapshotCreate {
+	csc.mutation.SetCreatedAt(i)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *CatalogSnapshotCreate) SetNillableCreatedAt(i *int64) *CatalogSnapshotCreate {
+	if i != nil {
+		csc.SetCreatedAt(*i)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *CatalogSnapshotCreate) SetID(i int64) *CatalogSnapshotCreate {
+	csc.mutation.SetID(i)
+	return csc
+}
+
+// Mutation returns the CatalogSnapshotMutation object of the builder.
+func (csc *CatalogSnapshotCreate) Mutation() *CatalogSnapshotMutation {
+	return csc.mutation
+}
+
+// Save creates the CatalogSnapshot in the database.
+func (csc *CatalogSnapshotCreate) Save(ctx context.Context) (*CatalogSnapshot, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *CatalogSnapshotCreate) SaveX(ctx context.Context) *CatalogSnapshot {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *CatalogSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *CatalogSnapshotCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *CatalogSnapshotCreate) defaults() {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		v := catalogsnapshot.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *CatalogSnapshotCreate) check() error {
+	if _, ok := csc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`entc: missing required field "CatalogSnapshot.hash"`)}
+	}
+	if _, ok := csc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`entc: missing required field "CatalogSnapshot.data"`)}
+	}
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "CatalogSnapshot.created_at"`)}
+	}
+	return nil
+}
+
+func (csc *CatalogSnapshotCreate) sqlSave(ctx context.Context) (*CatalogSnapshot, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *CatalogSnapshotCreate) createSpec() (*CatalogSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CatalogSnapshot{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(catalogsnapshot.Table, sqlgraph.NewFieldSpec(catalogsnapshot.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = csc.conflict
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := csc.mutation.Hash(); ok {
+		_spec.SetField(catalogsnapshot.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := csc.mutation.Data(); ok {
+		_spec.SetField(catalogsnapshot.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.SetField(catalogsnapshot.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CatalogSnapshot.Create().
+//		SetHash(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CatalogSnapshotUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (csc *CatalogSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *CatalogSnapshotUpsertOne {
+	csc.conflict = opts
+	return &CatalogSnapshotUpsertOne{
+		create: csc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CatalogSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (csc *CatalogSnapshotCreate) OnConflictColumns(columns ...string) *CatalogSnapshotUpsertOne {
+	csc.conflict = append(csc.conflict, sql.ConflictColumns(columns...))
+	return &CatalogSnapshotUpsertOne{
+		create: csc,
+	}
+}
+
+type (
+	// CatalogSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one CatalogSnapshot node.
+	CatalogSnapshotUpsertOne struct {
+		create *CatalogSnapshotCreate
+	}
+
+	// CatalogSnapshotUpsert is the "OnConflict" setter.
+	CatalogSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetHash sets the "hash" field.
+func (u *CatalogSnapshotUpsert) SetHash(v string) *CatalogSnapshotUpsert {
+	u.Set(catalogsnapshot.FieldHash, v)
+	return u
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *CatalogSnapshotUpsert) UpdateHash() *CatalogSnapshotUpsert {
+	u.SetExcluded(catalogsnapshot.FieldHash)
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *CatalogSnapshotUpsert) SetData(v []byte) *CatalogSnapshotUpsert {
+	u.Set(catalogsnapshot.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *CatalogSnapshotUpsert) UpdateData() *CatalogSnapshotUpsert {
+	u.SetExcluded(catalogsnapshot.FieldData)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CatalogSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(catalogsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CatalogSnapshotUpsertOne) UpdateNewValues() *CatalogSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(catalogsnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(catalogsnapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CatalogSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CatalogSnapshotUpsertOne) Ignore() *CatalogSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CatalogSnapshotUpsertOne) DoNothing() *CatalogSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CatalogSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *CatalogSnapshotUpsertOne) Update(set func(*CatalogSnapshotUpsert)) *CatalogSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CatalogSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *CatalogSnapshotUpsertOne) SetHash(v string) *CatalogSnapshotUpsertOne {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *CatalogSnapshotUpsertOne) UpdateHash() *CatalogSnapshotUpsertOne {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *CatalogSnapshotUpsertOne) SetData(v []byte) *CatalogSnapshotUpsertOne {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *CatalogSnapshotUpsertOne) UpdateData() *CatalogSnapshotUpsertOne {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.UpdateData()
+	})
+}
+
+// Exec executes the query.
+func (u *CatalogSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for CatalogSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CatalogSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CatalogSnapshotUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CatalogSnapshotUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CatalogSnapshotCreateBulk is the builder for creating many CatalogSnapshot entities in bulk.
+type CatalogSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*CatalogSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CatalogSnapshot entities in the database.
+func (cscb *CatalogSnapshotCreateBulk) Save(ctx context.Context) ([]*CatalogSnapshot, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*CatalogSnapshot, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CatalogSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *CatalogSnapshotCreateBulk) SaveX(ctx context.Context) []*CatalogSnapshot {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *CatalogSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *CatalogSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CatalogSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CatalogSnapshotUpsert) {
+//			SetHash(v+v).
+//		}).
+//		Exec(ctx)
+func (cscb *CatalogSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *CatalogSnapshotUpsertBulk {
+	cscb.conflict = opts
+	return &CatalogSnapshotUpsertBulk{
+		create: cscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CatalogSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cscb *CatalogSnapshotCreateBulk) OnConflictColumns(columns ...string) *CatalogSnapshotUpsertBulk {
+	cscb.conflict = append(cscb.conflict, sql.ConflictColumns(columns...))
+	return &CatalogSnapshotUpsertBulk{
+		create: cscb,
+	}
+}
+
+// CatalogSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of CatalogSnapshot nodes.
+type CatalogSnapshotUpsertBulk struct {
+	create *CatalogSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CatalogSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(catalogsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CatalogSnapshotUpsertBulk) UpdateNewValues() *CatalogSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(catalogsnapshot.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(catalogsnapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CatalogSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CatalogSnapshotUpsertBulk) Ignore() *CatalogSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CatalogSnapshotUpsertBulk) DoNothing() *CatalogSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CatalogSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *CatalogSnapshotUpsertBulk) Update(set func(*CatalogSnapshotUpsert)) *CatalogSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CatalogSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetHash sets the "hash" field.
+func (u *CatalogSnapshotUpsertBulk) SetHash(v string) *CatalogSnapshotUpsertBulk {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.SetHash(v)
+	})
+}
+
+// UpdateHash sets the "hash" field to the value that was provided on create.
+func (u *CatalogSnapshotUpsertBulk) UpdateHash() *CatalogSnapshotUpsertBulk {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.UpdateHash()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *CatalogSnapshotUpsertBulk) SetData(v []byte) *CatalogSnapshotUpsertBulk {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *CatalogSnapshotUpsertBulk) UpdateData() *CatalogSnapshotUpsertBulk {
+	return u.Update(func(s *CatalogSnapshotUpsert) {
+		s.UpdateData()
+	})
+}
+
+// Exec executes the query.
+func (u *CatalogSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the CatalogSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for CatalogSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CatalogSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// CatalogSnapshotDelete is the builder for deleting a CatalogSnapshot entity.
+type CatalogSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *CatalogSnapshotMutation
+}
+
+// Where appends a list predicates to the CatalogSnapshotDelete builder.
+func (csd *CatalogSnapshotDelete) Where(ps ...predicate.CatalogSnapshot) *CatalogSnapshotDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *CatalogSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *CatalogSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *CatalogSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(catalogsnapshot.Table, sqlgraph.NewFieldSpec(catalogsnapshot.FieldID, field.TypeInt64))
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// CatalogSnapshotDeleteOne is the builder for deleting a single CatalogSnapshot entity.
+type CatalogSnapshotDeleteOne struct {
+	csd *CatalogSnapshotDelete
+}
+
+// Where appends a list predicates to the CatalogSnapshotDelete builder.
+func (csdo *CatalogSnapshotDeleteOne) Where(ps ...predicate.CatalogSnapshot) *CatalogSnapshotDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *CatalogSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{catalogsnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *CatalogSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
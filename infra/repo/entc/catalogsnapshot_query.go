@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// CatalogSnapshotQuery is the builder for querying CatalogSnapshot entities.
+type CatalogSnapshotQuery struct {
+	config
+	ctx        *QueryContext
+	order      []catalogsnapshot.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CatalogSnapshot
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CatalogSnapshotQuery builder.
+func (csq *CatalogSnapshotQuery) Where(ps ...predicate.CatalogSnapshot) *CatalogSnapshotQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit the number of records to be returned by this query.
+func (csq *CatalogSnapshotQuery) Limit(limit int) *CatalogSnapshotQuery {
+	csq.ctx.Limit = &limit
+	return csq
+}
+
+// Offset to start from.
+func (csq *CatalogSnapshotQuery) Offset(offset int) *CatalogSnapshotQuery {
+	csq.ctx.Offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *CatalogSnapshotQuery) Unique(unique bool) *CatalogSnapshotQuery {
+	csq.ctx.Unique = &unique
+	return csq
+}
+
+// Order specifies how the records should be ordered.
+func (csq *CatalogSnapshotQuery) Order(o ...catalogsnapshot.OrderOption) *CatalogSnapshotQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// First returns the first CatalogSnapshot entity from the query.
+// Returns a *NotFoundError when no CatalogSnapshot was found.
+func (csq *CatalogSnapshotQuery) First(ctx context.Context) (*CatalogSnapshot, error) {
+	nodes, err := csq.Limit(1).All(setContextOp(ctx, csq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{catalogsnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) FirstX(ctx context.Context) *CatalogSnapshot {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CatalogSnapshot ID from the query.
+// Returns a *NotFoundError when no CatalogSnapshot ID was found.
+func (csq *CatalogSnapshotQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = csq.Limit(1).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{catalogsnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CatalogSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CatalogSnapshot entity is found.
+// Returns a *NotFoundError when no CatalogSnapshot entities are found.
+func (csq *CatalogSnapshotQuery) Only(ctx context.Context) (*CatalogSnapshot, error) {
+	nodes, err := csq.Limit(2).All(setContextOp(ctx, csq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{catalogsnapshot.Label}
+	default:
+		return nil, &NotSingularError{catalogsnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) OnlyX(ctx context.Context) *CatalogSnapshot {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CatalogSnapshot ID in the query.
+// Returns a *NotSingularError when more than one CatalogSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *CatalogSnapshotQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = csq.Limit(2).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{catalogsnapshot.Label}
+	default:
+		err = &NotSingularError{catalogsnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CatalogSnapshots.
+func (csq *CatalogSnapshotQuery) All(ctx context.Context) ([]*CatalogSnapshot, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryAll)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CatalogSnapshot, *CatalogSnapshotQuery]()
+	return withInterceptors[[]*CatalogSnapshot](ctx, csq, qr, csq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) AllX(ctx context.Context) []*CatalogSnapshot {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CatalogSnapshot IDs.
+func (csq *CatalogSnapshotQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if csq.ctx.Unique == nil && csq.path != nil {
+		csq.Unique(true)
+	}
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryIDs)
+	if err = csq.Select(catalogsnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *CatalogSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryCount)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, csq, querierCount[*CatalogSnapshotQuery](), csq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *CatalogSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryExist)
+	switch _, err := csq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *CatalogSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CatalogSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *CatalogSnapshotQuery) Clone() *CatalogSnapshotQuery {
+	if csq == nil {
+		return nil
+	}
+	return &CatalogSnapshotQuery{
+		config:     csq.config,
+		ctx:        csq.ctx.Clone(),
+		order:      append([]catalogsnapshot.OrderOption{}, csq.order...),
+		inters:     append([]Interceptor{}, csq.inters...),
+		predicates: append([]predicate.CatalogSnapshot{}, csq.predicates...),
+		// clone intermediate query.
+		sql:       csq.sql.Clone(),
+		path:      csq.path,
+		modifiers: append([]func(*sql.Selector){}, csq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Hash string `json:"hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CatalogSnapshot.Query().
+//		GroupBy(catalogsnapshot.FieldHash).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (csq *CatalogSnapshotQuery) GroupBy(field string, fields ...string) *CatalogSnapshotGroupBy {
+	csq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CatalogSnapshotGroupBy{build: csq}
+	grbuild.flds = &csq.ctx.Fields
+	grbuild.label = catalogsnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Hash string `json:"hash,omitempty"`
+//	}
+//
+//	client.CatalogSnapshot.Query().
+//		Select(catalogsnapshot.FieldHash).
+//		Scan(ctx, &v)
+func (csq *CatalogSnapshotQuery) Select(fields ...string) *CatalogSnapshotSelect {
+	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
+	sbuild := &CatalogSnapshotSelect{CatalogSnapshotQuery: csq}
+	sbuild.label = catalogsnapshot.Label
+	sbuild.flds, sbuild.scan = &csq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CatalogSnapshotSelect configured with the given aggregations.
+func (csq *CatalogSnapshotQuery) Aggregate(fns ...AggregateFunc) *CatalogSnapshotSelect {
+	return csq.Select().Aggregate(fns...)
+}
+
+func (csq *CatalogSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range csq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, csq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range csq.ctx.Fields {
+		if !catalogsnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *CatalogSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CatalogSnapshot, error) {
+	var (
+		nodes = []*CatalogSnapshot{}
+		_spec = csq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CatalogSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CatalogSnapshot{config: csq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (csq *CatalogSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	_spec.Node.Columns = csq.ctx.Fields
+	if len(csq.ctx.Fields) > 0 {
+		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *CatalogSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(catalogsnapshot.Table, catalogsnapshot.Columns, sqlgraph.NewFieldSpec(catalogsnapshot.FieldID, field.TypeInt64))
+	_spec.From = csq.sql
+	if unique := csq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if csq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := csq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, catalogsnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != catalogsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *CatalogSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(catalogsnapshot.Table)
+	columns := csq.ctx.Fields
+	if len(columns) == 0 {
+		columns = catalogsnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.ctx.Unique != nil && *csq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range csq.modifiers {
+		m(selector)
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (csq *CatalogSnapshotQuery) Modify(modifiers ...func(s *sql.Selector)) *CatalogSnapshotSelect {
+	csq.modifiers = append(csq.modifiers, modifiers...)
+	return csq.Select()
+}
+
+// CatalogSnapshotGroupBy is the group-by builder for CatalogSnapshot entities.
+type CatalogSnapshotGroupBy struct {
+	selector
+	build *CatalogSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *CatalogSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *CatalogSnapshotGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csgb *CatalogSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csgb.build.ctx, ent.OpQueryGroupBy)
+	if err := csgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CatalogSnapshotQuery, *CatalogSnapshotGroupBy](ctx, csgb.build, csgb, csgb.build.inters, v)
+}
+
+func (csgb *CatalogSnapshotGroupBy) sqlScan(ctx context.Context, root *CatalogSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*csgb.flds)+len(csgb.fns))
+		for _, f := range *csgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*csgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CatalogSnapshotSelect is the builder for selecting fields of CatalogSnapshot entities.
+type CatalogSnapshotSelect struct {
+	*CatalogSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (css *CatalogSnapshotSelect) Aggregate(fns ...AggregateFunc) *CatalogSnapshotSelect {
+	css.fns = append(css.fns, fns...)
+	return css
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *CatalogSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, css.ctx, ent.OpQuerySelect)
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CatalogSnapshotQuery, *CatalogSnapshotSelect](ctx, css.CatalogSnapshotQuery, css, css.inters, v)
+}
+
+func (css *CatalogSnapshotSelect) sqlScan(ctx context.Context, root *CatalogSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(css.fns))
+	for _, fn := range css.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*css.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (css *CatalogSnapshotSelect) Modify(modifiers ...func(s *sql.Selector)) *CatalogSnapshotSelect {
+	css.modifiers = append(css.modifiers, modifiers...)
+	return css
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// CatalogSnapshotUpdate is the builder for updating CatalogSnapshot entities.
+type CatalogSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CatalogSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CatalogSnapshotUpdate builder.
+func (csu *CatalogSnapshotUpdate) Where(ps ...predicate.CatalogSnapshot) *CatalogSnapshotUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetHash sets the "hash" field.
+func (csu *CatalogSnapshotUpdate) SetHash(s string) *CatalogSnapshotUpdate {
+	csu.mutation.SetHash(s)
+	return csu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (csu *CatalogSnapshotUpdate) SetNillableHash(s *string) *CatalogSnapshotUpdate {
+	if s != nil {
+		csu.SetHash(*s)
+	}
+	return csu
+}
+
+// SetData sets the "data" field.
+func (csu *CatalogSnapshotUpdate) SetData(b []byte) *CatalogSnapshotUpdate {
+	csu.mutation.SetData(b)
+	return csu
+}
+
+// Mutation returns the CatalogSnapshotMutation object of the builder.
+func (csu *CatalogSnapshotUpdate) Mutation() *CatalogSnapshotMutation {
+	return csu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *CatalogSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *CatalogSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *CatalogSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *CatalogSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csu *CatalogSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CatalogSnapshotUpdate {
+	csu.modifiers = append(csu.modifiers, modifiers...)
+	return csu
+}
+
+func (csu *CatalogSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(catalogsnapshot.Table, catalogsnapshot.Columns, sqlgraph.NewFieldSpec(catalogsnapshot.FieldID, field.TypeInt64))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.Hash(); ok {
+		_spec.SetField(catalogsnapshot.FieldHash, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.Data(); ok {
+		_spec.SetField(catalogsnapshot.FieldData, field.TypeBytes, value)
+	}
+	_spec.AddModifiers(csu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{catalogsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// CatalogSnapshotUpdateOne is the builder for updating a single CatalogSnapshot entity.
+type CatalogSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CatalogSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetHash sets the "hash" field.
+func (csuo *CatalogSnapshotUpdateOne) SetHash(s string) *CatalogSnapshotUpdateOne {
+	csuo.mutation.SetHash(s)
+	return csuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (csuo *CatalogSnapshotUpdateOne) SetNillableHash(s *string) *CatalogSnapshotUpdateOne {
+	if s != nil {
+		csuo.SetHash(*s)
+	}
+	return csuo
+}
+
+// SetData sets the "data" field.
+func (csuo *CatalogSnapshotUpdateOne) SetData(b []byte) *CatalogSnapshotUpdateOne {
+	csuo.mutation.SetData(b)
+	return csuo
+}
+
+// Mutation returns the CatalogSnapshotMutation object of the builder.
+func (csuo *CatalogSnapshotUpdateOne) Mutation() *CatalogSnapshotMutation {
+	return csuo.mutation
+}
+
+// Where appends a list predicates to the CatalogSnapshotUpdate builder.
+func (csuo *CatalogSnapshotUpdateOne) Where(ps ...predicate.CatalogSnapshot) *CatalogSnapshotUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *CatalogSnapshotUpdateOne) Select(field string, fields ...string) *CatalogSnapshotUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated CatalogSnapshot entity.
+func (csuo *CatalogSnapshotUpdateOne) Save(ctx context.Context) (*CatalogSnapshot, error) {
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *CatalogSnapshotUpdateOne) SaveX(ctx context.Context) *CatalogSnapshot {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *CatalogSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *CatalogSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csuo *CatalogSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CatalogSnapshotUpdateOne {
+	csuo.modifiers = append(csuo.modifiers, modifiers...)
+	return csuo
+}
+
+func (csuo *CatalogSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *CatalogSnapshot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(catalogsnapshot.Table, catalogsnapshot.Columns, sqlgraph.NewFieldSpec(catalogsnapshot.FieldID, field.TypeInt64))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "CatalogSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, catalogsnapshot.FieldID)
+		for _, f := range fields {
+			if !catalogsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != catalogsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.Hash(); ok {
+		_spec.SetField(catalogsnapshot.FieldHash, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.Data(); ok {
+		_spec.SetField(catalogsnapshot.FieldData, field.TypeBytes, value)
+	}
+	_spec.AddModifiers(csuo.modifiers...)
+	_node = &CatalogSnapshot{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{catalogsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
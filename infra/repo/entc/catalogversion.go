@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+)
+
+// CatalogVersion is the model entity for the CatalogVersion schema.
+type CatalogVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// ChangesetID holds the value of the "changeset_id" field.
+	ChangesetID int64 `json:"changeset_id,omitempty"`
+	// PublishedBy holds the value of the "published_by" field.
+	PublishedBy string `json:"published_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CatalogVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case catalogversion.FieldID, catalogversion.FieldChangesetID, catalogversion.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case catalogversion.FieldPublishedBy:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CatalogVersion fields.
+func (cv *CatalogVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case catalogversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cv.ID = int64(value.Int64)
+		case catalogversion.FieldChangesetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changeset_id", values[i])
+			} else if value.Valid {
+				cv.ChangesetID = value.Int64
+			}
+		case catalogversion.FieldPublishedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field published_by", values[i])
+			} else if value.Valid {
+				cv.PublishedBy = value.String
+			}
+		case catalogversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cv.CreatedAt = value.Int64
+			}
+		default:
+			cv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CatalogVersion.
+// This includes values selected through modifiers, order, etc.
+func (cv *CatalogVersion) Value(name string) (ent.Value, error) {
+	return cv.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CatalogVersion.
+// Note that you need to call CatalogVersion.Unwrap() before calling this method if this CatalogVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cv *CatalogVersion) Update() *CatalogVersionUpdateOne {
+	return NewCatalogVersionClient(cv.config).UpdateOne(cv)
+}
+
+// Unwrap unwraps the CatalogVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cv *CatalogVersion) Unwrap() *CatalogVersion {
+	_tx, ok := cv.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: CatalogVersion is not a transactional entity")
+	}
+	cv.config.driver = _tx.drv
+	return cv
+}
+
+// String implements the fmt.Stringer.
+func (cv *CatalogVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("CatalogVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cv.ID))
+	builder.WriteString("changeset_id=")
+	builder.WriteString(fmt.Sprintf("%v", cv.ChangesetID))
+	builder.WriteString(", ")
+	builder.WriteString("published_by=")
+	builder.WriteString(cv.PublishedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", cv.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CatalogVersions is a parsable slice of CatalogVersion.
+type CatalogVersions []*CatalogVersion
@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package catalogversion
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the catalogversion type in the database.
+	Label = "catalog_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChangesetID holds the string denoting the changeset_id field in the database.
+	FieldChangesetID = "changeset_id"
+	// FieldPublishedBy holds the string denoting the published_by field in the database.
+	FieldPublishedBy = "published_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the catalogversion in the database.
+	Table = "catalog_versions"
+)
+
+// Columns holds all SQL columns for catalogversion fields.
+var Columns = []string{
+	FieldID,
+	FieldChangesetID,
+	FieldPublishedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultChangesetID holds the default value on creation for the "changeset_id" field.
+	DefaultChangesetID int64
+	// DefaultPublishedBy holds the default value on creation for the "published_by" field.
+	DefaultPublishedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+)
+
+// OrderOption defines the ordering options for the CatalogVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChangesetID orders the results by the changeset_id field.
+func ByChangesetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangesetID, opts...).ToFunc()
+}
+
+// ByPublishedBy orders the results by the published_by field.
+func ByPublishedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
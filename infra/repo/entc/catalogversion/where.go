@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package catalogversion
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLTE(FieldID, id))
+}
+
+// ChangesetID applies equality check predicate on the "changeset_id" field. It's identical to ChangesetIDEQ.
+func ChangesetID(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldChangesetID, v))
+}
+
+// PublishedBy applies equality check predicate on the "published_by" field. It's identical to PublishedByEQ.
+func PublishedBy(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldPublishedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChangesetIDEQ applies the EQ predicate on the "changeset_id" field.
+func ChangesetIDEQ(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldChangesetID, v))
+}
+
+// ChangesetIDNEQ applies the NEQ predicate on the "changeset_id" field.
+func ChangesetIDNEQ(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNEQ(FieldChangesetID, v))
+}
+
+// ChangesetIDIn applies the In predicate on the "changeset_id" field.
+func ChangesetIDIn(vs ...int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldIn(FieldChangesetID, vs...))
+}
+
+// ChangesetIDNotIn applies the NotIn predicate on the "changeset_id" field.
+func ChangesetIDNotIn(vs ...int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNotIn(FieldChangesetID, vs...))
+}
+
+// ChangesetIDGT applies the GT predicate on the "changeset_id" field.
+func ChangesetIDGT(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGT(FieldChangesetID, v))
+}
+
+// ChangesetIDGTE applies the GTE predicate on the "changeset_id" field.
+func ChangesetIDGTE(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGTE(FieldChangesetID, v))
+}
+
+// ChangesetIDLT applies the LT predicate on the "changeset_id" field.
+func ChangesetIDLT(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLT(FieldChangesetID, v))
+}
+
+// ChangesetIDLTE applies the LTE predicate on the "changeset_id" field.
+func ChangesetIDLTE(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLTE(FieldChangesetID, v))
+}
+
+// PublishedByEQ applies the EQ predicate on the "published_by" field.
+func PublishedByEQ(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldPublishedBy, v))
+}
+
+// PublishedByNEQ applies the NEQ predicate on the "published_by" field.
+func PublishedByNEQ(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNEQ(FieldPublishedBy, v))
+}
+
+// PublishedByIn applies the In predicate on the "published_by" field.
+func PublishedByIn(vs ...string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldIn(FieldPublishedBy, vs...))
+}
+
+// PublishedByNotIn applies the NotIn predicate on the "published_by" field.
+func PublishedByNotIn(vs ...string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNotIn(FieldPublishedBy, vs...))
+}
+
+// PublishedByGT applies the GT predicate on the "published_by" field.
+func PublishedByGT(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGT(FieldPublishedBy, v))
+}
+
+// PublishedByGTE applies the GTE predicate on the "published_by" field.
+func PublishedByGTE(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGTE(FieldPublishedBy, v))
+}
+
+// PublishedByLT applies the LT predicate on the "published_by" field.
+func PublishedByLT(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLT(FieldPublishedBy, v))
+}
+
+// PublishedByLTE applies the LTE predicate on the "published_by" field.
+func PublishedByLTE(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLTE(FieldPublishedBy, v))
+}
+
+// PublishedByContains applies the Contains predicate on the "published_by" field.
+func PublishedByContains(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldContains(FieldPublishedBy, v))
+}
+
+// PublishedByHasPrefix applies the HasPrefix predicate on the "published_by" field.
+func PublishedByHasPrefix(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldHasPrefix(FieldPublishedBy, v))
+}
+
+// PublishedByHasSuffix applies the HasSuffix predicate on the "published_by" field.
+func PublishedByHasSuffix(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldHasSuffix(FieldPublishedBy, v))
+}
+
+// PublishedByEqualFold applies the EqualFold predicate on the "published_by" field.
+func PublishedByEqualFold(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEqualFold(FieldPublishedBy, v))
+}
+
+// PublishedByContainsFold applies the ContainsFold predicate on the "published_by" field.
+func PublishedByContainsFold(v string) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldContainsFold(FieldPublishedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CatalogVersion) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CatalogVersion) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CatalogVersion) predicate.CatalogVersion {
+	return predicate.CatalogVersion(sql.NotPredicates(p))
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+)
+
+// CatalogVersionCreate is the builder for creating a CatalogVersion entity.
+type CatalogVersionCreate struct {
+	config
+	mutation *CatalogVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (cvc *CatalogVersionCreate) SetChangesetID(i int64) *CatalogVersionCreate {
+	cvc.mutation.SetChangesetID(i)
+	return cvc
+}
+
+// SetNillableChangesetID sets the "changeset_id" field if the given value is not nil.
+func (cvc *CatalogVersionCreate) SetNillableChangesetID(i *int64) *CatalogVersionCreate {
+	if i != nil {
+		cvc.SetChangesetID(*i)
+	}
+	return cvc
+}
+
+// SetPublishedBy sets the "published_by" field.
+func (cvc *CatalogVersionCreate) SetPublishedBy(s string) *CatalogVersionCreate {
+	cvc.mutation.SetPublishedBy(s)
+	return cvc
+}
+
+// SetNillablePublishedBy sets the "published_by" field if the given value is not nil.
+func (cvc *CatalogVersionCreate) SetNillablePublishedBy(s *string) *CatalogVersionCreate {
+	if s != nil {
+		cvc.SetPublishedBy(*s)
+	}
+	return cvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cvc *CatalogVersionCreate) SetCreatedAt(i int64) *CatalogVersionCreate {
+	cvc.mutation.SetCreatedAt(i)
+	return cvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cvc *CatalogVersionCreate) SetNillableCreatedAt(i *int64) *CatalogVersionCreate {
+	if i != nil {
+		cvc.SetCreatedAt(*i)
+	}
+	return cvc
+}
+
+// SetID sets the "id" field.
+func (cvc *CatalogVersionCreate) SetID(i int64) *CatalogVersionCreate {
+	cvc.mutation.SetID(i)
+	return cvc
+}
+
+// Mutation returns the CatalogVersionMutation object of the builder.
+func (cvc *CatalogVersionCreate) Mutation() *CatalogVersionMutation {
+	return cvc.mutation
+}
+
+// Save creates the CatalogVersion in the database.
+func (cvc *CatalogVersionCreate) Save(ctx context.Context) (*CatalogVersion, error) {
+	cvc.defaults()
+	return withHooks(ctx, cvc.sqlSave, cvc.mutation, cvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cvc *CatalogVersionCreate) SaveX(ctx context.Context) *CatalogVersion {
+	v, err := cvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvc *CatalogVersionCreate) Exec(ctx context.Context) error {
+	_, err := cvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvc *CatalogVersionCreate) ExecX(ctx context.Context) {
+	if err := cvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cvc *CatalogVersionCreate) defaults() {
+	if _, ok := cvc.mutation.ChangesetID(); !ok {
+		v := catalogversion.DefaultChangesetID
+		cvc.mutation.SetChangesetID(v)
+	}
+	if _, ok := cvc.mutation.PublishedBy(); !ok {
+		v := catalogversion.DefaultPublishedBy
+		cvc.mutation.SetPublishedBy(v)
+	}
+	if _, ok := cvc.mutation.CreatedAt(); !ok {
+		v := catalogversion.DefaultCreatedAt()
+		cvc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cvc *CatalogVersionCreate) check() error {
+	if _, ok := cvc.mutation.ChangesetID(); !ok {
+		return &ValidationError{Name: "changeset_id", err: errors.New(`entc: missing required field "CatalogVersion.changeset_id"`)}
+	}
+	if _, ok := cvc.mutation.PublishedBy(); !ok {
+		return &ValidationError{Name: "published_by", err: errors.New(`entc: missing required field "CatalogVersion.published_by"`)}
+	}
+	if _, ok := cvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "CatalogVersion.created_at"`)}
+	}
+	return nil
+}
+
+func (cvc *CatalogVersionCreate) sqlSave(ctx context.Context) (*CatalogVersion, error) {
+	if err := cvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	cvc.mutation.id = &_node.ID
+	cvc.mutation.done = true
+	return _node, nil
+}
+
+func (cvc *CatalogVersionCreate) createSpec() (*CatalogVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CatalogVersion{config: cvc.config}
+		_spec = sqlgraph.NewCreateSpec(catalogversion.Table, sqlgraph.NewFieldSpec(catalogversion.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = cvc.conflict
+	if id, ok := cvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cvc.mutation.ChangesetID(); ok {
+		_spec.SetField(catalogversion.FieldChangesetID, field.TypeInt64, value)
+		_node.ChangesetID = value
+	}
+	if value, ok := cvc.mutation.PublishedBy(); ok {
+		_spec.SetField(catalogversion.FieldPublishedBy, field.TypeString, value)
+		_node.PublishedBy = value
+	}
+	if value, ok := cvc.mutation.CreatedAt(); ok {
+		_spec.SetField(catalogversion.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CatalogVersion.Create().
+//		SetChangesetID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CatalogVersionUpsert) {
+//			SetChangesetID(v+v).
+//		}).
+//		Exec(ctx)
+func (cvc *CatalogVersionCreate) OnConflict(opts ...sql.ConflictOption) *CatalogVersionUpsertOne {
+	cvc.conflict = opts
+	return &CatalogVersionUpsertOne{
+		create: cvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CatalogVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cvc *CatalogVersionCreate) OnConflictColumns(columns ...string) *CatalogVersionUpsertOne {
+	cvc.conflict = append(cvc.conflict, sql.ConflictColumns(columns...))
+	return &CatalogVersionUpsertOne{
+		create: cvc,
+	}
+}
+
+type (
+	// CatalogVersionUpsertOne is the builder for "upsert"-ing
+	//  one CatalogVersion node.
+	CatalogVersionUpsertOne struct {
+		create *CatalogVersionCreate
+	}
+
+	// CatalogVersionUpsert is the "OnConflict" setter.
+	CatalogVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetChangesetID sets the "changeset_id" field.
+func (u *CatalogVersionUpsert) SetChangesetID(v int64) *CatalogVersionUpsert {
+	u.Set(catalogversion.FieldChangesetID, v)
+	return u
+}
+
+// UpdateChangesetID sets the "changeset_id" field to the value that was provided on create.
+func (u *CatalogVersionUpsert) UpdateChangesetID() *CatalogVersionUpsert {
+	u.SetExcluded(catalogversion.FieldChangesetID)
+	return u
+}
+
+// AddChangesetID adds v to the "changeset_id" field.
+func (u *CatalogVersionUpsert) AddChangesetID(v int64) *CatalogVersionUpsert {
+	u.Add(catalogversion.FieldChangesetID, v)
+	return u
+}
+
+// SetPublishedBy sets the "published_by" field.
+func (u *CatalogVersionUpsert) SetPublishedBy(v string) *CatalogVersionUpsert {
+	u.Set(catalogversion.FieldPublishedBy, v)
+	return u
+}
+
+// UpdatePublishedBy sets the "published_by" field to the value that was provided on create.
+func (u *CatalogVersionUpsert) UpdatePublishedBy() *CatalogVersionUpsert {
+	u.SetExcluded(catalogversion.FieldPublishedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CatalogVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(catalogversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CatalogVersionUpsertOne) UpdateNewValues() *CatalogVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(catalogversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(catalogversion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CatalogVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CatalogVersionUpsertOne) Ignore() *CatalogVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CatalogVersionUpsertOne) DoNothing() *CatalogVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CatalogVersionCreate.OnConflict
+// documentation for more info.
+func (u *CatalogVersionUpsertOne) Update(set func(*CatalogVersionUpsert)) *CatalogVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CatalogVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (u *CatalogVersionUpsertOne) SetChangesetID(v int64) *CatalogVersionUpsertOne {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.SetChangesetID(v)
+	})
+}
+
+// AddChangesetID adds v to the "changeset_id" field.
+func (u *CatalogVersionUpsertOne) AddChangesetID(v int64) *CatalogVersionUpsertOne {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.AddChangesetID(v)
+	})
+}
+
+// UpdateChangesetID sets the "changeset_id" field to the value that was provided on create.
+func (u *CatalogVersionUpsertOne) UpdateChangesetID() *CatalogVersionUpsertOne {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.UpdateChangesetID()
+	})
+}
+
+// SetPublishedBy sets the "published_by" field.
+func (u *CatalogVersionUpsertOne) SetPublishedBy(v string) *CatalogVersionUpsertOne {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.SetPublishedBy(v)
+	})
+}
+
+// UpdatePublishedBy sets the "published_by" field to the value that was provided on create.
+func (u *CatalogVersionUpsertOne) UpdatePublishedBy() *CatalogVersionUpsertOne {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.UpdatePublishedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *CatalogVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for CatalogVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CatalogVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CatalogVersionUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CatalogVersionUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CatalogVersionCreateBulk is the builder for creating many CatalogVersion entities in bulk.
+type CatalogVersionCreateBulk struct {
+	config
+	err      error
+	builders []*CatalogVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CatalogVersion entities in the database.
+func (cvcb *CatalogVersionCreateBulk) Save(ctx context.Context) ([]*CatalogVersion, error) {
+	if cvcb.err != nil {
+		return nil, cvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cvcb.builders))
+	nodes := make([]*CatalogVersion, len(cvcb.builders))
+	mutators := make([]Mutator, len(cvcb.builders))
+	for i := range cvcb.builders {
+		func(i int, root context.Context) {
+			builder := cvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CatalogVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvcb *CatalogVersionCreateBulk) SaveX(ctx context.Context) []*CatalogVersion {
+	v, err := cvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cvcb *CatalogVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvcb *CatalogVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := cvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CatalogVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CatalogVersionUpsert) {
+//			SetChangesetID(v+v).
+//		}).
+//		Exec(ctx)
+func (cvcb *CatalogVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CatalogVersionUpsertBulk {
+	cvcb.conflict = opts
+	return &CatalogVersionUpsertBulk{
+		create: cvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CatalogVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cvcb *CatalogVersionCreateBulk) OnConflictColumns(columns ...string) *CatalogVersionUpsertBulk {
+	cvcb.conflict = append(cvcb.conflict, sql.ConflictColumns(columns...))
+	return &CatalogVersionUpsertBulk{
+		create: cvcb,
+	}
+}
+
+// CatalogVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CatalogVersion nodes.
+type CatalogVersionUpsertBulk struct {
+	create *CatalogVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CatalogVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(catalogversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CatalogVersionUpsertBulk) UpdateNewValues() *CatalogVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(catalogversion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(catalogversion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CatalogVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CatalogVersionUpsertBulk) Ignore() *CatalogVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CatalogVersionUpsertBulk) DoNothing() *CatalogVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CatalogVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CatalogVersionUpsertBulk) Update(set func(*CatalogVersionUpsert)) *CatalogVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CatalogVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (u *CatalogVersionUpsertBulk) SetChangesetID(v int64) *CatalogVersionUpsertBulk {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.SetChangesetID(v)
+	})
+}
+
+// AddChangesetID adds v to the "changeset_id" field.
+func (u *CatalogVersionUpsertBulk) AddChangesetID(v int64) *CatalogVersionUpsertBulk {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.AddChangesetID(v)
+	})
+}
+
+// UpdateChangesetID sets the "changeset_id" field to the value that was provided on create.
+func (u *CatalogVersionUpsertBulk) UpdateChangesetID() *CatalogVersionUpsertBulk {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.UpdateChangesetID()
+	})
+}
+
+// SetPublishedBy sets the "published_by" field.
+func (u *CatalogVersionUpsertBulk) SetPublishedBy(v string) *CatalogVersionUpsertBulk {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.SetPublishedBy(v)
+	})
+}
+
+// UpdatePublishedBy sets the "published_by" field to the value that was provided on create.
+func (u *CatalogVersionUpsertBulk) UpdatePublishedBy() *CatalogVersionUpsertBulk {
+	return u.Update(func(s *CatalogVersionUpsert) {
+		s.UpdatePublishedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *CatalogVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the CatalogVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for CatalogVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CatalogVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
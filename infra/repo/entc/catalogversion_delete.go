@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// CatalogVersionDelete is the builder for deleting a CatalogVersion entity.
+type CatalogVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CatalogVersionMutation
+}
+
+// Where appends a list predicates to the CatalogVersionDelete builder.
+func (cvd *CatalogVersionDelete) Where(ps ...predicate.CatalogVersion) *CatalogVersionDelete {
+	cvd.mutation.Where(ps...)
+	return cvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cvd *CatalogVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cvd.sqlExec, cvd.mutation, cvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvd *CatalogVersionDelete) ExecX(ctx context.Context) int {
+	n, err := cvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cvd *CatalogVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(catalogversion.Table, sqlgraph.NewFieldSpec(catalogversion.FieldID, field.TypeInt64))
+	if ps := cvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cvd.mutation.done = true
+	return affected, err
+}
+
+// CatalogVersionDeleteOne is the builder for deleting a single CatalogVersion entity.
+type CatalogVersionDeleteOne struct {
+	cvd *CatalogVersionDelete
+}
+
+// Where appends a list predicates to the CatalogVersionDelete builder.
+func (cvdo *CatalogVersionDeleteOne) Where(ps ...predicate.CatalogVersion) *CatalogVersionDeleteOne {
+	cvdo.cvd.mutation.Where(ps...)
+	return cvdo
+}
+
+// Exec executes the deletion query.
+func (cvdo *CatalogVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := cvdo.cvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{catalogversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvdo *CatalogVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := cvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
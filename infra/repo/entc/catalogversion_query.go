@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// CatalogVersionQuery is the builder for querying CatalogVersion entities.
+type CatalogVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []catalogversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CatalogVersion
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CatalogVersionQuery builder.
+func (cvq *CatalogVersionQuery) Where(ps ...predicate.CatalogVersion) *CatalogVersionQuery {
+	cvq.predicates = append(cvq.predicates, ps...)
+	return cvq
+}
+
+// Limit the number of records to be returned by this query.
+func (cvq *CatalogVersionQuery) Limit(limit int) *CatalogVersionQuery {
+	cvq.ctx.Limit = &limit
+	return cvq
+}
+
+// Offset to start from.
+func (cvq *CatalogVersionQuery) Offset(offset int) *CatalogVersionQuery {
+	cvq.ctx.Offset = &offset
+	return cvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cvq *CatalogVersionQuery) Unique(unique bool) *CatalogVersionQuery {
+	cvq.ctx.Unique = &unique
+	return cvq
+}
+
+// Order specifies how the records should be ordered.
+func (cvq *CatalogVersionQuery) Order(o ...catalogversion.OrderOption) *CatalogVersionQuery {
+	cvq.order = append(cvq.order, o...)
+	return cvq
+}
+
+// First returns the first CatalogVersion entity from the query.
+// Returns a *NotFoundError when no CatalogVersion was found.
+func (cvq *CatalogVersionQuery) First(ctx context.Context) (*CatalogVersion, error) {
+	nodes, err := cvq.Limit(1).All(setContextOp(ctx, cvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{catalogversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) FirstX(ctx context.Context) *CatalogVersion {
+	node, err := cvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CatalogVersion ID from the query.
+// Returns a *NotFoundError when no CatalogVersion ID was found.
+func (cvq *CatalogVersionQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = cvq.Limit(1).IDs(setContextOp(ctx, cvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{catalogversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := cvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CatalogVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CatalogVersion entity is found.
+// Returns a *NotFoundError when no CatalogVersion entities are found.
+func (cvq *CatalogVersionQuery) Only(ctx context.Context) (*CatalogVersion, error) {
+	nodes, err := cvq.Limit(2).All(setContextOp(ctx, cvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{catalogversion.Label}
+	default:
+		return nil, &NotSingularError{catalogversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) OnlyX(ctx context.Context) *CatalogVersion {
+	node, err := cvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CatalogVersion ID in the query.
+// Returns a *NotSingularError when more than one CatalogVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cvq *CatalogVersionQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = cvq.Limit(2).IDs(setContextOp(ctx, cvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{catalogversion.Label}
+	default:
+		err = &NotSingularError{catalogversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := cvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CatalogVersions.
+func (cvq *CatalogVersionQuery) All(ctx context.Context) ([]*CatalogVersion, error) {
+	ctx = setContextOp(ctx, cvq.ctx, ent.OpQueryAll)
+	if err := cvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CatalogVersion, *CatalogVersionQuery]()
+	return withInterceptors[[]*CatalogVersion](ctx, cvq, qr, cvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) AllX(ctx context.Context) []*CatalogVersion {
+	nodes, err := cvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CatalogVersion IDs.
+func (cvq *CatalogVersionQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if cvq.ctx.Unique == nil && cvq.path != nil {
+		cvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cvq.ctx, ent.OpQueryIDs)
+	if err = cvq.Select(catalogversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := cvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cvq *CatalogVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cvq.ctx, ent.OpQueryCount)
+	if err := cvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cvq, querierCount[*CatalogVersionQuery](), cvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) CountX(ctx context.Context) int {
+	count, err := cvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cvq *CatalogVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cvq.ctx, ent.OpQueryExist)
+	switch _, err := cvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cvq *CatalogVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := cvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CatalogVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cvq *CatalogVersionQuery) Clone() *CatalogVersionQuery {
+	if cvq == nil {
+		return nil
+	}
+	return &CatalogVersionQuery{
+		config:     cvq.config,
+		ctx:        cvq.ctx.Clone(),
+		order:      append([]catalogversion.OrderOption{}, cvq.order...),
+		inters:     append([]Interceptor{}, cvq.inters...),
+		predicates: append([]predicate.CatalogVersion{}, cvq.predicates...),
+		// clone intermediate query.
+		sql:       cvq.sql.Clone(),
+		path:      cvq.path,
+		modifiers: append([]func(*sql.Selector){}, cvq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ChangesetID int64 `json:"changeset_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CatalogVersion.Query().
+//		GroupBy(catalogversion.FieldChangesetID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (cvq *CatalogVersionQuery) GroupBy(field string, fields ...string) *CatalogVersionGroupBy {
+	cvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CatalogVersionGroupBy{build: cvq}
+	grbuild.flds = &cvq.ctx.Fields
+	grbuild.label = catalogversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ChangesetID int64 `json:"changeset_id,omitempty"`
+//	}
+//
+//	client.CatalogVersion.Query().
+//		Select(catalogversion.FieldChangesetID).
+//		Scan(ctx, &v)
+func (cvq *CatalogVersionQuery) Select(fields ...string) *CatalogVersionSelect {
+	cvq.ctx.Fields = append(cvq.ctx.Fields, fields...)
+	sbuild := &CatalogVersionSelect{CatalogVersionQuery: cvq}
+	sbuild.label = catalogversion.Label
+	sbuild.flds, sbuild.scan = &cvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CatalogVersionSelect configured with the given aggregations.
+func (cvq *CatalogVersionQuery) Aggregate(fns ...AggregateFunc) *CatalogVersionSelect {
+	return cvq.Select().Aggregate(fns...)
+}
+
+func (cvq *CatalogVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cvq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cvq.ctx.Fields {
+		if !catalogversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if cvq.path != nil {
+		prev, err := cvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cvq.sql = prev
+	}
+	return nil
+}
+
+func (cvq *CatalogVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CatalogVersion, error) {
+	var (
+		nodes = []*CatalogVersion{}
+		_spec = cvq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CatalogVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CatalogVersion{config: cvq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(cvq.modifiers) > 0 {
+		_spec.Modifiers = cvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cvq *CatalogVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cvq.querySpec()
+	if len(cvq.modifiers) > 0 {
+		_spec.Modifiers = cvq.modifiers
+	}
+	_spec.Node.Columns = cvq.ctx.Fields
+	if len(cvq.ctx.Fields) > 0 {
+		_spec.Unique = cvq.ctx.Unique != nil && *cvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cvq.driver, _spec)
+}
+
+func (cvq *CatalogVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(catalogversion.Table, catalogversion.Columns, sqlgraph.NewFieldSpec(catalogversion.FieldID, field.TypeInt64))
+	_spec.From = cvq.sql
+	if unique := cvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, catalogversion.FieldID)
+		for i := range fields {
+			if fields[i] != catalogversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cvq *CatalogVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cvq.driver.Dialect())
+	t1 := builder.Table(catalogversion.Table)
+	columns := cvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = catalogversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cvq.sql != nil {
+		selector = cvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cvq.ctx.Unique != nil && *cvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cvq.modifiers {
+		m(selector)
+	}
+	for _, p := range cvq.predicates {
+		p(selector)
+	}
+	for _, p := range cvq.order {
+		p(selector)
+	}
+	if offset := cvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cvq *CatalogVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *CatalogVersionSelect {
+	cvq.modifiers = append(cvq.modifiers, modifiers...)
+	return cvq.Select()
+}
+
+// CatalogVersionGroupBy is the group-by builder for CatalogVersion entities.
+type CatalogVersionGroupBy struct {
+	selector
+	build *CatalogVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cvgb *CatalogVersionGroupBy) Aggregate(fns ...AggregateFunc) *CatalogVersionGroupBy {
+	cvgb.fns = append(cvgb.fns, fns...)
+	return cvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cvgb *CatalogVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CatalogVersionQuery, *CatalogVersionGroupBy](ctx, cvgb.build, cvgb, cvgb.build.inters, v)
+}
+
+func (cvgb *CatalogVersionGroupBy) sqlScan(ctx context.Context, root *CatalogVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cvgb.fns))
+	for _, fn := range cvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cvgb.flds)+len(cvgb.fns))
+		for _, f := range *cvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CatalogVersionSelect is the builder for selecting fields of CatalogVersion entities.
+type CatalogVersionSelect struct {
+	*CatalogVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cvs *CatalogVersionSelect) Aggregate(fns ...AggregateFunc) *CatalogVersionSelect {
+	cvs.fns = append(cvs.fns, fns...)
+	return cvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cvs *CatalogVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cvs.ctx, ent.OpQuerySelect)
+	if err := cvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CatalogVersionQuery, *CatalogVersionSelect](ctx, cvs.CatalogVersionQuery, cvs, cvs.inters, v)
+}
+
+func (cvs *CatalogVersionSelect) sqlScan(ctx context.Context, root *CatalogVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cvs.fns))
+	for _, fn := range cvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cvs *CatalogVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *CatalogVersionSelect {
+	cvs.modifiers = append(cvs.modifiers, modifiers...)
+	return cvs
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// CatalogVersionUpdate is the builder for updating CatalogVersion entities.
+type CatalogVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CatalogVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CatalogVersionUpdate builder.
+func (cvu *CatalogVersionUpdate) Where(ps ...predicate.CatalogVersion) *CatalogVersionUpdate {
+	cvu.mutation.Where(ps...)
+	return cvu
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (cvu *CatalogVersionUpdate) SetChangesetID(i int64) *CatalogVersionUpdate {
+	cvu.mutation.ResetChangesetID()
+	cvu.mutation.SetChangesetID(i)
+	return cvu
+}
+
+// SetNillableChangesetID sets the "changeset_id" field if the given value is not nil.
+func (cvu *CatalogVersionUpdate) SetNillableChangesetID(i *int64) *CatalogVersionUpdate {
+	if i != nil {
+		cvu.SetChangesetID(*i)
+	}
+	return cvu
+}
+
+// AddChangesetID adds i to the "changeset_id" field.
+func (cvu *CatalogVersionUpdate) AddChangesetID(i int64) *CatalogVersionUpdate {
+	cvu.mutation.AddChangesetID(i)
+	return cvu
+}
+
+// SetPublishedBy sets the "published_by" field.
+func (cvu *CatalogVersionUpdate) SetPublishedBy(s string) *CatalogVersionUpdate {
+	cvu.mutation.SetPublishedBy(s)
+	return cvu
+}
+
+// SetNillablePublishedBy sets the "published_by" field if the given value is not nil.
+func (cvu *CatalogVersionUpdate) SetNillablePublishedBy(s *string) *CatalogVersionUpdate {
+	if s != nil {
+		cvu.SetPublishedBy(*s)
+	}
+	return cvu
+}
+
+// Mutation returns the CatalogVersionMutation object of the builder.
+func (cvu *CatalogVersionUpdate) Mutation() *CatalogVersionMutation {
+	return cvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cvu *CatalogVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cvu.sqlSave, cvu.mutation, cvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvu *CatalogVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cvu *CatalogVersionUpdate) Exec(ctx context.Context) error {
+	_, err := cvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvu *CatalogVersionUpdate) ExecX(ctx context.Context) {
+	if err := cvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cvu *CatalogVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CatalogVersionUpdate {
+	cvu.modifiers = append(cvu.modifiers, modifiers...)
+	return cvu
+}
+
+func (cvu *CatalogVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(catalogversion.Table, catalogversion.Columns, sqlgraph.NewFieldSpec(catalogversion.FieldID, field.TypeInt64))
+	if ps := cvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cvu.mutation.ChangesetID(); ok {
+		_spec.SetField(catalogversion.FieldChangesetID, field.TypeInt64, value)
+	}
+	if value, ok := cvu.mutation.AddedChangesetID(); ok {
+		_spec.AddField(catalogversion.FieldChangesetID, field.TypeInt64, value)
+	}
+	if value, ok := cvu.mutation.PublishedBy(); ok {
+		_spec.SetField(catalogversion.FieldPublishedBy, field.TypeString, value)
+	}
+	_spec.AddModifiers(cvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{catalogversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cvu.mutation.done = true
+	return n, nil
+}
+
+// CatalogVersionUpdateOne is the builder for updating a single CatalogVersion entity.
+type CatalogVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CatalogVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (cvuo *CatalogVersionUpdateOne) SetChangesetID(i int64) *CatalogVersionUpdateOne {
+	cvuo.mutation.ResetChangesetID()
+	cvuo.mutation.SetChangesetID(i)
+	return cvuo
+}
+
+// SetNillableChangesetID sets the "changeset_id" field if the given value is not nil.
+func (cvuo *CatalogVersionUpdateOne) SetNillableChangesetID(i *int64) *CatalogVersionUpdateOne {
+	if i != nil {
+		cvuo.SetChangesetID(*i)
+	}
+	return cvuo
+}
+
+// AddChangesetID adds i to the "changeset_id" field.
+func (cvuo *CatalogVersionUpdateOne) AddChangesetID(i int64) *CatalogVersionUpdateOne {
+	cvuo.mutation.AddChangesetID(i)
+	return cvuo
+}
+
+// SetPublishedBy sets the "published_by" field.
+func (cvuo *CatalogVersionUpdateOne) SetPublishedBy(s string) *CatalogVersionUpdateOne {
+	cvuo.mutation.SetPublishedBy(s)
+	return cvuo
+}
+
+// SetNillablePublishedBy sets the "published_by" field if the given value is not nil.
+func (cvuo *CatalogVersionUpdateOne) SetNillablePublishedBy(s *string) *CatalogVersionUpdateOne {
+	if s != nil {
+		cvuo.SetPublishedBy(*s)
+	}
+	return cvuo
+}
+
+// Mutation returns the CatalogVersionMutation object of the builder.
+func (cvuo *CatalogVersionUpdateOne) Mutation() *CatalogVersionMutation {
+	return cvuo.mutation
+}
+
+// Where appends a list predicates to the CatalogVersionUpdate builder.
+func (cvuo *CatalogVersionUpdateOne) Where(ps ...predicate.CatalogVersion) *CatalogVersionUpdateOne {
+	cvuo.mutation.Where(ps...)
+	return cvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cvuo *CatalogVersionUpdateOne) Select(field string, fields ...string) *CatalogVersionUpdateOne {
+	cvuo.fields = append([]string{field}, fields...)
+	return cvuo
+}
+
+// Save executes the query and returns the updated CatalogVersion entity.
+func (cvuo *CatalogVersionUpdateOne) Save(ctx context.Context) (*CatalogVersion, error) {
+	return withHooks(ctx, cvuo.sqlSave, cvuo.mutation, cvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cvuo *CatalogVersionUpdateOne) SaveX(ctx context.Context) *CatalogVersion {
+	node, err := cvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cvuo *CatalogVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cvuo *CatalogVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := cvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cvuo *CatalogVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CatalogVersionUpdateOne {
+	cvuo.modifiers = append(cvuo.modifiers, modifiers...)
+	return cvuo
+}
+
+func (cvuo *CatalogVersionUpdateOne) sqlSave(ctx context.Context) (_node *CatalogVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(catalogversion.Table, catalogversion.Columns, sqlgraph.NewFieldSpec(catalogversion.FieldID, field.TypeInt64))
+	id, ok := cvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "CatalogVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, catalogversion.FieldID)
+		for _, f := range fields {
+			if !catalogversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != catalogversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cvuo.mutation.ChangesetID(); ok {
+		_spec.SetField(catalogversion.FieldChangesetID, field.TypeInt64, value)
+	}
+	if value, ok := cvuo.mutation.AddedChangesetID(); ok {
+		_spec.AddField(catalogversion.FieldChangesetID, field.TypeInt64, value)
+	}
+	if value, ok := cvuo.mutation.PublishedBy(); ok {
+		_spec.SetField(catalogversion.FieldPublishedBy, field.TypeString, value)
+	}
+	_spec.AddModifiers(cvuo.modifiers...)
+	_node = &CatalogVersion{config: cvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{catalogversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cvuo.mutation.done = true
+	return _node, nil
+}
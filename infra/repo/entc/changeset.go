@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+)
+
+// Changeset is the model entity for the Changeset schema.
+type Changeset struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// Editors holds the value of the "editors" field.
+	Editors []string `json:"editors,omitempty"`
+	// Reviewer holds the value of the "reviewer" field.
+	Reviewer string `json:"reviewer,omitempty"`
+	// CatalogVersion holds the value of the "catalog_version" field.
+	CatalogVersion int64 `json:"catalog_version,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChangesetQuery when eager-loading is set.
+	Edges        ChangesetEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChangesetEdges holds the relations/edges for other nodes in the graph.
+type ChangesetEdges struct {
+	// Changes holds the value of the changes edge.
+	Changes []*ChangesetChange `json:"changes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ChangesOrErr returns the Changes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChangesetEdges) ChangesOrErr() ([]*ChangesetChange, error) {
+	if e.loadedTypes[0] {
+		return e.Changes, nil
+	}
+	return nil, &NotLoadedError{edge: "changes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Changeset) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case changeset.FieldEditors:
+			values[i] = new([]byte)
+		case changeset.FieldID, changeset.FieldCatalogVersion, changeset.FieldCreatedAt, changeset.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case changeset.FieldTitle, changeset.FieldStatus, changeset.FieldAuthor, changeset.FieldReviewer:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Changeset fields.
+func (c *Changeset) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case changeset.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int64(value.Int64)
+		case changeset.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				c.Title = value.String
+			}
+		case changeset.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = value.String
+			}
+		case changeset.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				c.Author = value.String
+			}
+		case changeset.FieldEditors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field editors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Editors); err != nil {
+					return fmt.Errorf("unmarshal field editors: %w", err)
+				}
+			}
+		case changeset.FieldReviewer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer", values[i])
+			} else if value.Valid {
+				c.Reviewer = value.String
+			}
+		case changeset.FieldCatalogVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field catalog_version", values[i])
+			} else if value.Valid {
+				c.CatalogVersion = value.Int64
+			}
+		case changeset.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Int64
+			}
+		case changeset.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Int64
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Changeset.
+// This includes values selected through modifiers, order, etc.
+func (c *Changeset) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryChanges queries the "changes" edge of the Changeset entity.
+func (c *Changeset) QueryChanges() *ChangesetChangeQuery {
+	return NewChangesetClient(c.config).QueryChanges(c)
+}
+
+// Update returns a builder for updating this Changeset.
+// Note that you need to call Changeset.Unwrap() before calling this method if this Changeset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Changeset) Update() *ChangesetUpdateOne {
+	return NewChangesetClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Changeset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Changeset) Unwrap() *Changeset {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: Changeset is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Changeset) String() string {
+	var builder strings.Builder
+	builder.WriteString("Changeset(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("title=")
+	builder.WriteString(c.Title)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(c.Status)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(c.Author)
+	builder.WriteString(", ")
+	builder.WriteString("editors=")
+	builder.WriteString(fmt.Sprintf("%v", c.Editors))
+	builder.WriteString(", ")
+	builder.WriteString("reviewer=")
+	builder.WriteString(c.Reviewer)
+	builder.WriteString(", ")
+	builder.WriteString("catalog_version=")
+	builder.WriteString(fmt.Sprintf("%v", c.CatalogVersion))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", c.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", c.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Changesets is a parsable slice of Changeset.
+type Changesets []*Changeset
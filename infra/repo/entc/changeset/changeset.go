@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package changeset
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the changeset type in the database.
+	Label = "changeset"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAuthor holds the string denoting the author field in the database.
+	FieldAuthor = "author"
+	// FieldEditors holds the string denoting the editors field in the database.
+	FieldEditors = "editors"
+	// FieldReviewer holds the string denoting the reviewer field in the database.
+	FieldReviewer = "reviewer"
+	// FieldCatalogVersion holds the string denoting the catalog_version field in the database.
+	FieldCatalogVersion = "catalog_version"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeChanges holds the string denoting the changes edge name in mutations.
+	EdgeChanges = "changes"
+	// Table holds the table name of the changeset in the database.
+	Table = "changesets"
+	// ChangesTable is the table that holds the changes relation/edge.
+	ChangesTable = "changeset_changes"
+	// ChangesInverseTable is the table name for the ChangesetChange entity.
+	// It exists in this package in order to avoid circular dependency with the "changesetchange" package.
+	ChangesInverseTable = "changeset_changes"
+	// ChangesColumn is the table column denoting the changes relation/edge.
+	ChangesColumn = "changeset_id"
+)
+
+// Columns holds all SQL columns for changeset fields.
+var Columns = []string{
+	FieldID,
+	FieldTitle,
+	FieldStatus,
+	FieldAuthor,
+	FieldEditors,
+	FieldReviewer,
+	FieldCatalogVersion,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReviewer holds the default value on creation for the "reviewer" field.
+	DefaultReviewer string
+	// DefaultCatalogVersion holds the default value on creation for the "catalog_version" field.
+	DefaultCatalogVersion int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+)
+
+// OrderOption defines the ordering options for the Changeset queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAuthor orders the results by the author field.
+func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+}
+
+// ByReviewer orders the results by the reviewer field.
+func ByReviewer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewer, opts...).ToFunc()
+}
+
+// ByCatalogVersion orders the results by the catalog_version field.
+func ByCatalogVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatalogVersion, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByChangesCount orders the results by changes count.
+func ByChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChangesStep(), opts...)
+	}
+}
+
+// ByChanges orders the results by changes terms.
+func ByChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+	)
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package changeset
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldTitle, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldStatus, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Reviewer applies equality check predicate on the "reviewer" field. It's identical to ReviewerEQ.
+func Reviewer(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldReviewer, v))
+}
+
+// CatalogVersion applies equality check predicate on the "catalog_version" field. It's identical to CatalogVersionEQ.
+func CatalogVersion(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldCatalogVersion, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// EditorsIsNil applies the IsNil predicate on the "editors" field.
+func EditorsIsNil() predicate.Changeset {
+	return predicate.Changeset(sql.FieldIsNull(FieldEditors))
+}
+
+// EditorsNotNil applies the NotNil predicate on the "editors" field.
+func EditorsNotNil() predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotNull(FieldEditors))
+}
+
+// ReviewerEQ applies the EQ predicate on the "reviewer" field.
+func ReviewerEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldReviewer, v))
+}
+
+// ReviewerNEQ applies the NEQ predicate on the "reviewer" field.
+func ReviewerNEQ(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldReviewer, v))
+}
+
+// ReviewerIn applies the In predicate on the "reviewer" field.
+func ReviewerIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldReviewer, vs...))
+}
+
+// ReviewerNotIn applies the NotIn predicate on the "reviewer" field.
+func ReviewerNotIn(vs ...string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldReviewer, vs...))
+}
+
+// ReviewerGT applies the GT predicate on the "reviewer" field.
+func ReviewerGT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldReviewer, v))
+}
+
+// ReviewerGTE applies the GTE predicate on the "reviewer" field.
+func ReviewerGTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldReviewer, v))
+}
+
+// ReviewerLT applies the LT predicate on the "reviewer" field.
+func ReviewerLT(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldReviewer, v))
+}
+
+// ReviewerLTE applies the LTE predicate on the "reviewer" field.
+func ReviewerLTE(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldReviewer, v))
+}
+
+// ReviewerContains applies the Contains predicate on the "reviewer" field.
+func ReviewerContains(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContains(FieldReviewer, v))
+}
+
+// ReviewerHasPrefix applies the HasPrefix predicate on the "reviewer" field.
+func ReviewerHasPrefix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasPrefix(FieldReviewer, v))
+}
+
+// ReviewerHasSuffix applies the HasSuffix predicate on the "reviewer" field.
+func ReviewerHasSuffix(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldHasSuffix(FieldReviewer, v))
+}
+
+// ReviewerEqualFold applies the EqualFold predicate on the "reviewer" field.
+func ReviewerEqualFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEqualFold(FieldReviewer, v))
+}
+
+// ReviewerContainsFold applies the ContainsFold predicate on the "reviewer" field.
+func ReviewerContainsFold(v string) predicate.Changeset {
+	return predicate.Changeset(sql.FieldContainsFold(FieldReviewer, v))
+}
+
+// CatalogVersionEQ applies the EQ predicate on the "catalog_version" field.
+func CatalogVersionEQ(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldCatalogVersion, v))
+}
+
+// CatalogVersionNEQ applies the NEQ predicate on the "catalog_version" field.
+func CatalogVersionNEQ(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldCatalogVersion, v))
+}
+
+// CatalogVersionIn applies the In predicate on the "catalog_version" field.
+func CatalogVersionIn(vs ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldCatalogVersion, vs...))
+}
+
+// CatalogVersionNotIn applies the NotIn predicate on the "catalog_version" field.
+func CatalogVersionNotIn(vs ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldCatalogVersion, vs...))
+}
+
+// CatalogVersionGT applies the GT predicate on the "catalog_version" field.
+func CatalogVersionGT(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldCatalogVersion, v))
+}
+
+// CatalogVersionGTE applies the GTE predicate on the "catalog_version" field.
+func CatalogVersionGTE(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldCatalogVersion, v))
+}
+
+// CatalogVersionLT applies the LT predicate on the "catalog_version" field.
+func CatalogVersionLT(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldCatalogVersion, v))
+}
+
+// CatalogVersionLTE applies the LTE predicate on the "catalog_version" field.
+func CatalogVersionLTE(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldCatalogVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Changeset {
+	return predicate.Changeset(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasChanges applies the HasEdge predicate on the "changes" edge.
+func HasChanges() predicate.Changeset {
+	return predicate.Changeset(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChangesTable, ChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangesWith applies the HasEdge predicate on the "changes" edge with a given conditions (other predicates).
+func HasChangesWith(preds ...predicate.ChangesetChange) predicate.Changeset {
+	return predicate.Changeset(func(s *sql.Selector) {
+		step := newChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Changeset) predicate.Changeset {
+	return predicate.Changeset(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Changeset) predicate.Changeset {
+	return predicate.Changeset(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Changeset) predicate.Changeset {
+	return predicate.Changeset(sql.NotPredicates(p))
+}
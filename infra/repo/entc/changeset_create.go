@@ -0,0 +1,964 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+)
+
+// ChangesetCreate is the builder for creating a Changeset entity.
+type ChangesetCreate struct {
+	config
+	mutation *ChangesetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTitle sets the "title" field.
+func (cc *ChangesetCreate) SetTitle(s string) *ChangesetCreate {
+	cc.mutation.SetTitle(s)
+	return cc
+}
+
+// SetStatus sets the "status" field.
+func (cc *ChangesetCreate) SetStatus(s string) *ChangesetCreate {
+	cc.mutation.SetStatus(s)
+	return cc
+}
+
+// SetAuthor sets the "author" field.
+func (cc *ChangesetCreate) SetAuthor(s string) *ChangesetCreate {
+	cc.mutation.SetAuthor(s)
+	return cc
+}
+
+// SetEditors sets the "editors" field.
+func (cc *ChangesetCreate) SetEditors(s []string) *ChangesetCreate {
+	cc.mutation.SetEditors(s)
+	return cc
+}
+
+// SetReviewer sets the "reviewer" field.
+func (cc *ChangesetCreate) SetReviewer(s string) *ChangesetCreate {
+	cc.mutation.SetReviewer(s)
+	return cc
+}
+
+// SetNillableReviewer sets the "reviewer" field if the given value is not nil.
+func (cc *ChangesetCreate) SetNillableReviewer(s *string) *ChangesetCreate {
+	if s != nil {
+		cc.SetReviewer(*s)
+	}
+	return cc
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (cc *ChangesetCreate) SetCatalogVersion(i int64) *ChangesetCreate {
+	cc.mutation.SetCatalogVersion(i)
+	return cc
+}
+
+// SetNillableCatalogVersion sets the "catalog_version" field if the given value is not nil.
+func (cc *ChangesetCreate) SetNillableCatalogVersion(i *int64) *ChangesetCreate {
+	if i != nil {
+		cc.SetCatalogVersion(*i)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *ChangesetCreate) SetCreatedAt(i int64) *ChangesetCreate {
+	cc.mutation.SetCreatedAt(i)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *ChangesetCreate) SetNillableCreatedAt(i *int64) *ChangesetCreate {
+	if i != nil {
+		cc.SetCreatedAt(*i)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *ChangesetCreate) SetUpdatedAt(i int64) *ChangesetCreate {
+	cc.mutation.SetUpdatedAt(i)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *ChangesetCreate) SetNillableUpdatedAt(i *int64) *ChangesetCreate {
+	if i != nil {
+		cc.SetUpdatedAt(*i)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *ChangesetCreate) SetID(i int64) *ChangesetCreate {
+	cc.mutation.SetID(i)
+	return cc
+}
+
+// AddChangeIDs adds the "changes" edge to the ChangesetChange entity by IDs.
+func (cc *ChangesetCreate) AddChangeIDs(ids ...int) *ChangesetCreate {
+	cc.mutation.AddChangeIDs(ids...)
+	return cc
+}
+
+// AddChanges adds the "changes" edges to the ChangesetChange entity.
+func (cc *ChangesetCreate) AddChanges(c ...*ChangesetChange) *ChangesetCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddChangeIDs(ids...)
+}
+
+// Mutation returns the ChangesetMutation object of the builder.
+func (cc *ChangesetCreate) Mutation() *ChangesetMutation {
+	return cc.mutation
+}
+
+// Save creates the Changeset in the database.
+func (cc *ChangesetCreate) Save(ctx context.Context) (*Changeset, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *ChangesetCreate) SaveX(ctx context.Context) *Changeset {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *ChangesetCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *ChangesetCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *ChangesetCreate) defaults() {
+	if _, ok := cc.mutation.Reviewer(); !ok {
+		v := changeset.DefaultReviewer
+		cc.mutation.SetReviewer(v)
+	}
+	if _, ok := cc.mutation.CatalogVersion(); !ok {
+		v := changeset.DefaultCatalogVersion
+		cc.mutation.SetCatalogVersion(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := changeset.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := changeset.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *ChangesetCreate) check() error {
+	if _, ok := cc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`entc: missing required field "Changeset.title"`)}
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entc: missing required field "Changeset.status"`)}
+	}
+	if _, ok := cc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`entc: missing required field "Changeset.author"`)}
+	}
+	if _, ok := cc.mutation.Reviewer(); !ok {
+		return &ValidationError{Name: "reviewer", err: errors.New(`entc: missing required field "Changeset.reviewer"`)}
+	}
+	if _, ok := cc.mutation.CatalogVersion(); !ok {
+		return &ValidationError{Name: "catalog_version", err: errors.New(`entc: missing required field "Changeset.catalog_version"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "Changeset.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "Changeset.updated_at"`)}
+	}
+	return nil
+}
+
+func (cc *ChangesetCreate) sqlSave(ctx context.Context) (*Changeset, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *ChangesetCreate) createSpec() (*Changeset, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Changeset{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(changeset.Table, sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = cc.conflict
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cc.mutation.Title(); ok {
+		_spec.SetField(changeset.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(changeset.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.Author(); ok {
+		_spec.SetField(changeset.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := cc.mutation.Editors(); ok {
+		_spec.SetField(changeset.FieldEditors, field.TypeJSON, value)
+		_node.Editors = value
+	}
+	if value, ok := cc.mutation.Reviewer(); ok {
+		_spec.SetField(changeset.FieldReviewer, field.TypeString, value)
+		_node.Reviewer = value
+	}
+	if value, ok := cc.mutation.CatalogVersion(); ok {
+		_spec.SetField(changeset.FieldCatalogVersion, field.TypeInt64, value)
+		_node.CatalogVersion = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(changeset.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(changeset.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := cc.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   changeset.ChangesTable,
+			Columns: []string{changeset.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Changeset.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChangesetUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *ChangesetCreate) OnConflict(opts ...sql.ConflictOption) *ChangesetUpsertOne {
+	cc.conflict = opts
+	return &ChangesetUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Changeset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *ChangesetCreate) OnConflictColumns(columns ...string) *ChangesetUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &ChangesetUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// ChangesetUpsertOne is the builder for "upsert"-ing
+	//  one Changeset node.
+	ChangesetUpsertOne struct {
+		create *ChangesetCreate
+	}
+
+	// ChangesetUpsert is the "OnConflict" setter.
+	ChangesetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *ChangesetUpsert) SetTitle(v string) *ChangesetUpsert {
+	u.Set(changeset.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ChangesetUpsert) UpdateTitle() *ChangesetUpsert {
+	u.SetExcluded(changeset.FieldTitle)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ChangesetUpsert) SetStatus(v string) *ChangesetUpsert {
+	u.Set(changeset.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChangesetUpsert) UpdateStatus() *ChangesetUpsert {
+	u.SetExcluded(changeset.FieldStatus)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *ChangesetUpsert) SetAuthor(v string) *ChangesetUpsert {
+	u.Set(changeset.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *ChangesetUpsert) UpdateAuthor() *ChangesetUpsert {
+	u.SetExcluded(changeset.FieldAuthor)
+	return u
+}
+
+// SetEditors sets the "editors" field.
+func (u *ChangesetUpsert) SetEditors(v []string) *ChangesetUpsert {
+	u.Set(changeset.FieldEditors, v)
+	return u
+}
+
+// UpdateEditors sets the "editors" field to the value that was provided on create.
+func (u *ChangesetUpsert) UpdateEditors() *ChangesetUpsert {
+	u.SetExcluded(changeset.FieldEditors)
+	return u
+}
+
+// ClearEditors clears the value of the "editors" field.
+func (u *ChangesetUpsert) ClearEditors() *ChangesetUpsert {
+	u.SetNull(changeset.FieldEditors)
+	return u
+}
+
+// SetReviewer sets the "reviewer" field.
+func (u *ChangesetUpsert) SetReviewer(v string) *ChangesetUpsert {
+	u.Set(changeset.FieldReviewer, v)
+	return u
+}
+
+// UpdateReviewer sets the "reviewer" field to the value that was provided on create.
+func (u *ChangesetUpsert) UpdateReviewer() *ChangesetUpsert {
+	u.SetExcluded(changeset.FieldReviewer)
+	return u
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (u *ChangesetUpsert) SetCatalogVersion(v int64) *ChangesetUpsert {
+	u.Set(changeset.FieldCatalogVersion, v)
+	return u
+}
+
+// UpdateCatalogVersion sets the "catalog_version" field to the value that was provided on create.
+func (u *ChangesetUpsert) UpdateCatalogVersion() *ChangesetUpsert {
+	u.SetExcluded(changeset.FieldCatalogVersion)
+	return u
+}
+
+// AddCatalogVersion adds v to the "catalog_version" field.
+func (u *ChangesetUpsert) AddCatalogVersion(v int64) *ChangesetUpsert {
+	u.Add(changeset.FieldCatalogVersion, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChangesetUpsert) SetUpdatedAt(v int64) *ChangesetUpsert {
+	u.Set(changeset.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChangesetUpsert) UpdateUpdatedAt() *ChangesetUpsert {
+	u.SetExcluded(changeset.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChangesetUpsert) AddUpdatedAt(v int64) *ChangesetUpsert {
+	u.Add(changeset.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Changeset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(changeset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChangesetUpsertOne) UpdateNewValues() *ChangesetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(changeset.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(changeset.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Changeset.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChangesetUpsertOne) Ignore() *ChangesetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChangesetUpsertOne) DoNothing() *ChangesetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChangesetCreate.OnConflict
+// documentation for more info.
+func (u *ChangesetUpsertOne) Update(set func(*ChangesetUpsert)) *ChangesetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChangesetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ChangesetUpsertOne) SetTitle(v string) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ChangesetUpsertOne) UpdateTitle() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChangesetUpsertOne) SetStatus(v string) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChangesetUpsertOne) UpdateStatus() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *ChangesetUpsertOne) SetAuthor(v string) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *ChangesetUpsertOne) UpdateAuthor() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetEditors sets the "editors" field.
+func (u *ChangesetUpsertOne) SetEditors(v []string) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetEditors(v)
+	})
+}
+
+// UpdateEditors sets the "editors" field to the value that was provided on create.
+func (u *ChangesetUpsertOne) UpdateEditors() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateEditors()
+	})
+}
+
+// ClearEditors clears the value of the "editors" field.
+func (u *ChangesetUpsertOne) ClearEditors() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.ClearEditors()
+	})
+}
+
+// SetReviewer sets the "reviewer" field.
+func (u *ChangesetUpsertOne) SetReviewer(v string) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetReviewer(v)
+	})
+}
+
+// UpdateReviewer sets the "reviewer" field to the value that was provided on create.
+func (u *ChangesetUpsertOne) UpdateReviewer() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateReviewer()
+	})
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (u *ChangesetUpsertOne) SetCatalogVersion(v int64) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetCatalogVersion(v)
+	})
+}
+
+// AddCatalogVersion adds v to the "catalog_version" field.
+func (u *ChangesetUpsertOne) AddCatalogVersion(v int64) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.AddCatalogVersion(v)
+	})
+}
+
+// UpdateCatalogVersion sets the "catalog_version" field to the value that was provided on create.
+func (u *ChangesetUpsertOne) UpdateCatalogVersion() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateCatalogVersion()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChangesetUpsertOne) SetUpdatedAt(v int64) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChangesetUpsertOne) AddUpdatedAt(v int64) *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChangesetUpsertOne) UpdateUpdatedAt() *ChangesetUpsertOne {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChangesetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ChangesetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChangesetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChangesetUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChangesetUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChangesetCreateBulk is the builder for creating many Changeset entities in bulk.
+type ChangesetCreateBulk struct {
+	config
+	err      error
+	builders []*ChangesetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Changeset entities in the database.
+func (ccb *ChangesetCreateBulk) Save(ctx context.Context) ([]*Changeset, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Changeset, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChangesetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *ChangesetCreateBulk) SaveX(ctx context.Context) []*Changeset {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *ChangesetCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *ChangesetCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Changeset.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChangesetUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *ChangesetCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChangesetUpsertBulk {
+	ccb.conflict = opts
+	return &ChangesetUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Changeset.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *ChangesetCreateBulk) OnConflictColumns(columns ...string) *ChangesetUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &ChangesetUpsertBulk{
+		create: ccb,
+	}
+}
+
+// ChangesetUpsertBulk is the builder for "upsert"-ing
+// a bulk of Changeset nodes.
+type ChangesetUpsertBulk struct {
+	create *ChangesetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Changeset.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(changeset.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChangesetUpsertBulk) UpdateNewValues() *ChangesetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(changeset.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(changeset.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Changeset.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChangesetUpsertBulk) Ignore() *ChangesetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChangesetUpsertBulk) DoNothing() *ChangesetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChangesetCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChangesetUpsertBulk) Update(set func(*ChangesetUpsert)) *ChangesetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChangesetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ChangesetUpsertBulk) SetTitle(v string) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ChangesetUpsertBulk) UpdateTitle() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChangesetUpsertBulk) SetStatus(v string) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChangesetUpsertBulk) UpdateStatus() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *ChangesetUpsertBulk) SetAuthor(v string) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *ChangesetUpsertBulk) UpdateAuthor() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetEditors sets the "editors" field.
+func (u *ChangesetUpsertBulk) SetEditors(v []string) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetEditors(v)
+	})
+}
+
+// UpdateEditors sets the "editors" field to the value that was provided on create.
+func (u *ChangesetUpsertBulk) UpdateEditors() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateEditors()
+	})
+}
+
+// ClearEditors clears the value of the "editors" field.
+func (u *ChangesetUpsertBulk) ClearEditors() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.ClearEditors()
+	})
+}
+
+// SetReviewer sets the "reviewer" field.
+func (u *ChangesetUpsertBulk) SetReviewer(v string) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetReviewer(v)
+	})
+}
+
+// UpdateReviewer sets the "reviewer" field to the value that was provided on create.
+func (u *ChangesetUpsertBulk) UpdateReviewer() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateReviewer()
+	})
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (u *ChangesetUpsertBulk) SetCatalogVersion(v int64) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetCatalogVersion(v)
+	})
+}
+
+// AddCatalogVersion adds v to the "catalog_version" field.
+func (u *ChangesetUpsertBulk) AddCatalogVersion(v int64) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.AddCatalogVersion(v)
+	})
+}
+
+// UpdateCatalogVersion sets the "catalog_version" field to the value that was provided on create.
+func (u *ChangesetUpsertBulk) UpdateCatalogVersion() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateCatalogVersion()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChangesetUpsertBulk) SetUpdatedAt(v int64) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ChangesetUpsertBulk) AddUpdatedAt(v int64) *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChangesetUpsertBulk) UpdateUpdatedAt() *ChangesetUpsertBulk {
+	return u.Update(func(s *ChangesetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChangesetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the ChangesetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ChangesetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChangesetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
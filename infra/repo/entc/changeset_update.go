@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ChangesetUpdate is the builder for updating Changeset entities.
+type ChangesetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ChangesetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ChangesetUpdate builder.
+func (cu *ChangesetUpdate) Where(ps ...predicate.Changeset) *ChangesetUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetTitle sets the "title" field.
+func (cu *ChangesetUpdate) SetTitle(s string) *ChangesetUpdate {
+	cu.mutation.SetTitle(s)
+	return cu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cu *ChangesetUpdate) SetNillableTitle(s *string) *ChangesetUpdate {
+	if s != nil {
+		cu.SetTitle(*s)
+	}
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *ChangesetUpdate) SetStatus(s string) *ChangesetUpdate {
+	cu.mutation.SetStatus(s)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *ChangesetUpdate) SetNillableStatus(s *string) *ChangesetUpdate {
+	if s != nil {
+		cu.SetStatus(*s)
+	}
+	return cu
+}
+
+// SetAuthor sets the "author" field.
+func (cu *ChangesetUpdate) SetAuthor(s string) *ChangesetUpdate {
+	cu.mutation.SetAuthor(s)
+	return cu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (cu *ChangesetUpdate) SetNillableAuthor(s *string) *ChangesetUpdate {
+	if s != nil {
+		cu.SetAuthor(*s)
+	}
+	return cu
+}
+
+// SetEditors sets the "editors" field.
+func (cu *ChangesetUpdate) SetEditors(s []string) *ChangesetUpdate {
+	cu.mutation.SetEditors(s)
+	return cu
+}
+
+// AppendEditors appends s to the "editors" field.
+func (cu *ChangesetUpdate) AppendEditors(s []string) *ChangesetUpdate {
+	cu.mutation.AppendEditors(s)
+	return cu
+}
+
+// ClearEditors clears the value of the "editors" field.
+func (cu *ChangesetUpdate) ClearEditors() *ChangesetUpdate {
+	cu.mutation.ClearEditors()
+	return cu
+}
+
+// SetReviewer sets the "reviewer" field.
+func (cu *ChangesetUpdate) SetReviewer(s string) *ChangesetUpdate {
+	cu.mutation.SetReviewer(s)
+	return cu
+}
+
+// SetNillableReviewer sets the "reviewer" field if the given value is not nil.
+func (cu *ChangesetUpdate) SetNillableReviewer(s *string) *ChangesetUpdate {
+	if s != nil {
+		cu.SetReviewer(*s)
+	}
+	return cu
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (cu *ChangesetUpdate) SetCatalogVersion(i int64) *ChangesetUpdate {
+	cu.mutation.ResetCatalogVersion()
+	cu.mutation.SetCatalogVersion(i)
+	return cu
+}
+
+// SetNillableCatalogVersion sets the "catalog_version" field if the given value is not nil.
+func (cu *ChangesetUpdate) SetNillableCatalogVersion(i *int64) *ChangesetUpdate {
+	if i != nil {
+		cu.SetCatalogVersion(*i)
+	}
+	return cu
+}
+
+// AddCatalogVersion adds i to the "catalog_version" field.
+func (cu *ChangesetUpdate) AddCatalogVersion(i int64) *ChangesetUpdate {
+	cu.mutation.AddCatalogVersion(i)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *ChangesetUpdate) SetUpdatedAt(i int64) *ChangesetUpdate {
+	cu.mutation.ResetUpdatedAt()
+	cu.mutation.SetUpdatedAt(i)
+	return cu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (cu *ChangesetUpdate) AddUpdatedAt(i int64) *ChangesetUpdate {
+	cu.mutation.AddUpdatedAt(i)
+	return cu
+}
+
+// AddChangeIDs adds the "changes" edge to the ChangesetChange entity by IDs.
+func (cu *ChangesetUpdate) AddChangeIDs(ids ...int) *ChangesetUpdate {
+	cu.mutation.AddChangeIDs(ids...)
+	return cu
+}
+
+// AddChanges adds the "changes" edges to the ChangesetChange entity.
+func (cu *ChangesetUpdate) AddChanges(c ...*ChangesetChange) *ChangesetUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddChangeIDs(ids...)
+}
+
+// Mutation returns the ChangesetMutation object of the builder.
+func (cu *ChangesetUpdate) Mutation() *ChangesetMutation {
+	return cu.mutation
+}
+
+// ClearChanges clears all "changes" edges to the ChangesetChange entity.
+func (cu *ChangesetUpdate) ClearChanges() *ChangesetUpdate {
+	cu.mutation.ClearChanges()
+	return cu
+}
+
+// RemoveChangeIDs removes the "changes" edge to ChangesetChange entities by IDs.
+func (cu *ChangesetUpdate) RemoveChangeIDs(ids ...int) *ChangesetUpdate {
+	cu.mutation.RemoveChangeIDs(ids...)
+	return cu
+}
+
+// RemoveChanges removes "changes" edges to ChangesetChange entities.
+func (cu *ChangesetUpdate) RemoveChanges(c ...*ChangesetChange) *ChangesetUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveChangeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ChangesetUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ChangesetUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ChangesetUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ChangesetUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *ChangesetUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := changeset.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *ChangesetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChangesetUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *ChangesetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(changeset.Table, changeset.Columns, sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Title(); ok {
+		_spec.SetField(changeset.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(changeset.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Author(); ok {
+		_spec.SetField(changeset.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Editors(); ok {
+		_spec.SetField(changeset.FieldEditors, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedEditors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, changeset.FieldEditors, value)
+		})
+	}
+	if cu.mutation.EditorsCleared() {
+		_spec.ClearField(changeset.FieldEditors, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Reviewer(); ok {
+		_spec.SetField(changeset.FieldReviewer, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.CatalogVersion(); ok {
+		_spec.SetField(changeset.FieldCatalogVersion, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedCatalogVersion(); ok {
+		_spec.AddField(changeset.FieldCatalogVersion, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(changeset.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(changeset.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if cu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   changeset.ChangesTable,
+			Columns: []string{changeset.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedChangesIDs(); len(nodes) > 0 && !cu.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   changeset.ChangesTable,
+			Columns: []string{changeset.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   changeset.ChangesTable,
+			Columns: []string{changeset.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{changeset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// ChangesetUpdateOne is the builder for updating a single Changeset entity.
+type ChangesetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChangesetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTitle sets the "title" field.
+func (cuo *ChangesetUpdateOne) SetTitle(s string) *ChangesetUpdateOne {
+	cuo.mutation.SetTitle(s)
+	return cuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (cuo *ChangesetUpdateOne) SetNillableTitle(s *string) *ChangesetUpdateOne {
+	if s != nil {
+		cuo.SetTitle(*s)
+	}
+	return cuo
+}
+
+// SetStatus sets the "status" field.
+func (cuo *ChangesetUpdateOne) SetStatus(s string) *ChangesetUpdateOne {
+	cuo.mutation.SetStatus(s)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *ChangesetUpdateOne) SetNillableStatus(s *string) *ChangesetUpdateOne {
+	if s != nil {
+		cuo.SetStatus(*s)
+	}
+	return cuo
+}
+
+// SetAuthor sets the "author" field.
+func (cuo *ChangesetUpdateOne) SetAuthor(s string) *ChangesetUpdateOne {
+	cuo.mutation.SetAuthor(s)
+	return cuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (cuo *ChangesetUpdateOne) SetNillableAuthor(s *string) *ChangesetUpdateOne {
+	if s != nil {
+		cuo.SetAuthor(*s)
+	}
+	return cuo
+}
+
+// SetEditors sets the "editors" field.
+func (cuo *ChangesetUpdateOne) SetEditors(s []string) *ChangesetUpdateOne {
+	cuo.mutation.SetEditors(s)
+	return cuo
+}
+
+// AppendEditors appends s to the "editors" field.
+func (cuo *ChangesetUpdateOne) AppendEditors(s []string) *ChangesetUpdateOne {
+	cuo.mutation.AppendEditors(s)
+	return cuo
+}
+
+// ClearEditors clears the value of the "editors" field.
+func (cuo *ChangesetUpdateOne) ClearEditors() *ChangesetUpdateOne {
+	cuo.mutation.ClearEditors()
+	return cuo
+}
+
+// SetReviewer sets the "reviewer" field.
+func (cuo *ChangesetUpdateOne) SetReviewer(s string) *ChangesetUpdateOne {
+	cuo.mutation.SetReviewer(s)
+	return cuo
+}
+
+// SetNillableReviewer sets the "reviewer" field if the given value is not nil.
+func (cuo *ChangesetUpdateOne) SetNillableReviewer(s *string) *ChangesetUpdateOne {
+	if s != nil {
+		cuo.SetReviewer(*s)
+	}
+	return cuo
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (cuo *ChangesetUpdateOne) SetCatalogVersion(i int64) *ChangesetUpdateOne {
+	cuo.mutation.ResetCatalogVersion()
+	cuo.mutation.SetCatalogVersion(i)
+	return cuo
+}
+
+// SetNillableCatalogVersion sets the "catalog_version" field if the given value is not nil.
+func (cuo *ChangesetUpdateOne) SetNillableCatalogVersion(i *int64) *ChangesetUpdateOne {
+	if i != nil {
+		cuo.SetCatalogVersion(*i)
+	}
+	return cuo
+}
+
+// AddCatalogVersion adds i to the "catalog_version" field.
+func (cuo *ChangesetUpdateOne) AddCatalogVersion(i int64) *ChangesetUpdateOne {
+	cuo.mutation.AddCatalogVersion(i)
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *ChangesetUpdateOne) SetUpdatedAt(i int64) *ChangesetUpdateOne {
+	cuo.mutation.ResetUpdatedAt()
+	cuo.mutation.SetUpdatedAt(i)
+	return cuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (cuo *ChangesetUpdateOne) AddUpdatedAt(i int64) *ChangesetUpdateOne {
+	cuo.mutation.AddUpdatedAt(i)
+	return cuo
+}
+
+// AddChangeIDs adds the "changes" edge to the ChangesetChange entity by IDs.
+func (cuo *ChangesetUpdateOne) AddChangeIDs(ids ...int) *ChangesetUpdateOne {
+	cuo.mutation.AddChangeIDs(ids...)
+	return cuo
+}
+
+// AddChanges adds the "changes" edges to the ChangesetChange entity.
+func (cuo *ChangesetUpdateOne) AddChanges(c ...*ChangesetChange) *ChangesetUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddChangeIDs(ids...)
+}
+
+// Mutation returns the ChangesetMutation object of the builder.
+func (cuo *ChangesetUpdateOne) Mutation() *ChangesetMutation {
+	return cuo.mutation
+}
+
+// ClearChanges clears all "changes" edges to the ChangesetChange entity.
+func (cuo *ChangesetUpdateOne) ClearChanges() *ChangesetUpdateOne {
+	cuo.mutation.ClearChanges()
+	return cuo
+}
+
+// RemoveChangeIDs removes the "changes" edge to ChangesetChange entities by IDs.
+func (cuo *ChangesetUpdateOne) RemoveChangeIDs(ids ...int) *ChangesetUpdateOne {
+	cuo.mutation.RemoveChangeIDs(ids...)
+	return cuo
+}
+
+// RemoveChanges removes "changes" edges to ChangesetChange entities.
+func (cuo *ChangesetUpdateOne) RemoveChanges(c ...*ChangesetChange) *ChangesetUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveChangeIDs(ids...)
+}
+
+// Where appends a list predicates to the ChangesetUpdate builder.
+func (cuo *ChangesetUpdateOne) Where(ps ...predicate.Changeset) *ChangesetUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *ChangesetUpdateOne) Select(field string, fields ...string) *ChangesetUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Changeset entity.
+func (cuo *ChangesetUpdateOne) Save(ctx context.Context) (*Changeset, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ChangesetUpdateOne) SaveX(ctx context.Context) *Changeset {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ChangesetUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ChangesetUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *ChangesetUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := changeset.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *ChangesetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChangesetUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *ChangesetUpdateOne) sqlSave(ctx context.Context) (_node *Changeset, err error) {
+	_spec := sqlgraph.NewUpdateSpec(changeset.Table, changeset.Columns, sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "Changeset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, changeset.FieldID)
+		for _, f := range fields {
+			if !changeset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != changeset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Title(); ok {
+		_spec.SetField(changeset.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(changeset.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Author(); ok {
+		_spec.SetField(changeset.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Editors(); ok {
+		_spec.SetField(changeset.FieldEditors, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedEditors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, changeset.FieldEditors, value)
+		})
+	}
+	if cuo.mutation.EditorsCleared() {
+		_spec.ClearField(changeset.FieldEditors, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Reviewer(); ok {
+		_spec.SetField(changeset.FieldReviewer, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.CatalogVersion(); ok {
+		_spec.SetField(changeset.FieldCatalogVersion, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedCatalogVersion(); ok {
+		_spec.AddField(changeset.FieldCatalogVersion, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(changeset.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(changeset.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if cuo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   changeset.ChangesTable,
+			Columns: []string{changeset.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedChangesIDs(); len(nodes) > 0 && !cuo.mutation.ChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   changeset.ChangesTable,
+			Columns: []string{changeset.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   changeset.ChangesTable,
+			Columns: []string{changeset.ChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Changeset{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{changeset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+)
+
+// ChangesetChange is the model entity for the ChangesetChange schema.
+type ChangesetChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ChangesetID holds the value of the "changeset_id" field.
+	ChangesetID int64 `json:"changeset_id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// Op holds the value of the "op" field.
+	Op string `json:"op,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Translations holds the value of the "translations" field.
+	Translations []*entity.ItemTranslation `json:"translations,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChangesetChangeQuery when eager-loading is set.
+	Edges        ChangesetChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChangesetChangeEdges holds the relations/edges for other nodes in the graph.
+type ChangesetChangeEdges struct {
+	// Changeset holds the value of the changeset edge.
+	Changeset *Changeset `json:"changeset,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ChangesetOrErr returns the Changeset value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChangesetChangeEdges) ChangesetOrErr() (*Changeset, error) {
+	if e.Changeset != nil {
+		return e.Changeset, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: changeset.Label}
+	}
+	return nil, &NotLoadedError{edge: "changeset"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChangesetChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case changesetchange.FieldTranslations:
+			values[i] = new([]byte)
+		case changesetchange.FieldID, changesetchange.FieldChangesetID:
+			values[i] = new(sql.NullInt64)
+		case changesetchange.FieldItemID, changesetchange.FieldOp, changesetchange.FieldName, changesetchange.FieldCategory, changesetchange.FieldDescription:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChangesetChange fields.
+func (cc *ChangesetChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case changesetchange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cc.ID = int(value.Int64)
+		case changesetchange.FieldChangesetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changeset_id", values[i])
+			} else if value.Valid {
+				cc.ChangesetID = value.Int64
+			}
+		case changesetchange.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				cc.ItemID = value.String
+			}
+		case changesetchange.FieldOp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field op", values[i])
+			} else if value.Valid {
+				cc.Op = value.String
+			}
+		case changesetchange.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cc.Name = value.String
+			}
+		case changesetchange.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				cc.Category = value.String
+			}
+		case changesetchange.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				cc.Description = value.String
+			}
+		case changesetchange.FieldTranslations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field translations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cc.Translations); err != nil {
+					return fmt.Errorf("unmarshal field translations: %w", err)
+				}
+			}
+		default:
+			cc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChangesetChange.
+// This includes values selected through modifiers, order, etc.
+func (cc *ChangesetChange) Value(name string) (ent.Value, error) {
+	return cc.selectValues.Get(name)
+}
+
+// QueryChangeset queries the "changeset" edge of the ChangesetChange entity.
+func (cc *ChangesetChange) QueryChangeset() *ChangesetQuery {
+	return NewChangesetChangeClient(cc.config).QueryChangeset(cc)
+}
+
+// Update returns a builder for updating this ChangesetChange.
+// Note that you need to call ChangesetChange.Unwrap() before calling this method if this ChangesetChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *ChangesetChange) Update() *ChangesetChangeUpdateOne {
+	return NewChangesetChangeClient(cc.config).UpdateOne(cc)
+}
+
+// Unwrap unwraps the ChangesetChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *ChangesetChange) Unwrap() *ChangesetChange {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: ChangesetChange is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *ChangesetChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChangesetChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("changeset_id=")
+	builder.WriteString(fmt.Sprintf("%v", cc.ChangesetID))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(cc.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("op=")
+	builder.WriteString(cc.Op)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(cc.Category)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(cc.Description)
+	builder.WriteString(", ")
+	builder.WriteString("translations=")
+	builder.WriteString(fmt.Sprintf("%v", cc.Translations))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChangesetChanges is a parsable slice of ChangesetChange.
+type ChangesetChanges []*ChangesetChange
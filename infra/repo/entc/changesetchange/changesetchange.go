@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package changesetchange
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the changesetchange type in the database.
+	Label = "changeset_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChangesetID holds the string denoting the changeset_id field in the database.
+	FieldChangesetID = "changeset_id"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldOp holds the string denoting the op field in the database.
+	FieldOp = "op"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldTranslations holds the string denoting the translations field in the database.
+	FieldTranslations = "translations"
+	// EdgeChangeset holds the string denoting the changeset edge name in mutations.
+	EdgeChangeset = "changeset"
+	// Table holds the table name of the changesetchange in the database.
+	Table = "changeset_changes"
+	// ChangesetTable is the table that holds the changeset relation/edge.
+	ChangesetTable = "changeset_changes"
+	// ChangesetInverseTable is the table name for the Changeset entity.
+	// It exists in this package in order to avoid circular dependency with the "changeset" package.
+	ChangesetInverseTable = "changesets"
+	// ChangesetColumn is the table column denoting the changeset relation/edge.
+	ChangesetColumn = "changeset_id"
+)
+
+// Columns holds all SQL columns for changesetchange fields.
+var Columns = []string{
+	FieldID,
+	FieldChangesetID,
+	FieldItemID,
+	FieldOp,
+	FieldName,
+	FieldCategory,
+	FieldDescription,
+	FieldTranslations,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// DefaultCategory holds the default value on creation for the "category" field.
+	DefaultCategory string
+	// DefaultDescription holds the default value on creation for the "description" field.
+	DefaultDescription string
+)
+
+// OrderOption defines the ordering options for the ChangesetChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChangesetID orders the results by the changeset_id field.
+func ByChangesetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangesetID, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByOp orders the results by the op field.
+func ByOp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOp, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByChangesetField orders the results by changeset field.
+func ByChangesetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChangesetStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newChangesetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChangesetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ChangesetTable, ChangesetColumn),
+	)
+}
@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package changesetchange
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLTE(FieldID, id))
+}
+
+// ChangesetID applies equality check predicate on the "changeset_id" field. It's identical to ChangesetIDEQ.
+func ChangesetID(v int64) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldChangesetID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldItemID, v))
+}
+
+// Op applies equality check predicate on the "op" field. It's identical to OpEQ.
+func Op(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldOp, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldName, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldCategory, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldDescription, v))
+}
+
+// ChangesetIDEQ applies the EQ predicate on the "changeset_id" field.
+func ChangesetIDEQ(v int64) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldChangesetID, v))
+}
+
+// ChangesetIDNEQ applies the NEQ predicate on the "changeset_id" field.
+func ChangesetIDNEQ(v int64) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNEQ(FieldChangesetID, v))
+}
+
+// ChangesetIDIn applies the In predicate on the "changeset_id" field.
+func ChangesetIDIn(vs ...int64) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIn(FieldChangesetID, vs...))
+}
+
+// ChangesetIDNotIn applies the NotIn predicate on the "changeset_id" field.
+func ChangesetIDNotIn(vs ...int64) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotIn(FieldChangesetID, vs...))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// OpEQ applies the EQ predicate on the "op" field.
+func OpEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldOp, v))
+}
+
+// OpNEQ applies the NEQ predicate on the "op" field.
+func OpNEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNEQ(FieldOp, v))
+}
+
+// OpIn applies the In predicate on the "op" field.
+func OpIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIn(FieldOp, vs...))
+}
+
+// OpNotIn applies the NotIn predicate on the "op" field.
+func OpNotIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotIn(FieldOp, vs...))
+}
+
+// OpGT applies the GT predicate on the "op" field.
+func OpGT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGT(FieldOp, v))
+}
+
+// OpGTE applies the GTE predicate on the "op" field.
+func OpGTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGTE(FieldOp, v))
+}
+
+// OpLT applies the LT predicate on the "op" field.
+func OpLT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLT(FieldOp, v))
+}
+
+// OpLTE applies the LTE predicate on the "op" field.
+func OpLTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLTE(FieldOp, v))
+}
+
+// OpContains applies the Contains predicate on the "op" field.
+func OpContains(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContains(FieldOp, v))
+}
+
+// OpHasPrefix applies the HasPrefix predicate on the "op" field.
+func OpHasPrefix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasPrefix(FieldOp, v))
+}
+
+// OpHasSuffix applies the HasSuffix predicate on the "op" field.
+func OpHasSuffix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasSuffix(FieldOp, v))
+}
+
+// OpEqualFold applies the EqualFold predicate on the "op" field.
+func OpEqualFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEqualFold(FieldOp, v))
+}
+
+// OpContainsFold applies the ContainsFold predicate on the "op" field.
+func OpContainsFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContainsFold(FieldOp, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContainsFold(FieldName, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TranslationsIsNil applies the IsNil predicate on the "translations" field.
+func TranslationsIsNil() predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldIsNull(FieldTranslations))
+}
+
+// TranslationsNotNil applies the NotNil predicate on the "translations" field.
+func TranslationsNotNil() predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.FieldNotNull(FieldTranslations))
+}
+
+// HasChangeset applies the HasEdge predicate on the "changeset" edge.
+func HasChangeset() predicate.ChangesetChange {
+	return predicate.ChangesetChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChangesetTable, ChangesetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChangesetWith applies the HasEdge predicate on the "changeset" edge with a given conditions (other predicates).
+func HasChangesetWith(preds ...predicate.Changeset) predicate.ChangesetChange {
+	return predicate.ChangesetChange(func(s *sql.Selector) {
+		step := newChangesetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChangesetChange) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChangesetChange) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChangesetChange) predicate.ChangesetChange {
+	return predicate.ChangesetChange(sql.NotPredicates(p))
+}
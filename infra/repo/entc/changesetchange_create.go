@@ -0,0 +1,854 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+)
+
+// ChangesetChangeCreate is the builder for creating a ChangesetChange entity.
+type ChangesetChangeCreate struct {
+	config
+	mutation *ChangesetChangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (ccc *ChangesetChangeCreate) SetChangesetID(i int64) *ChangesetChangeCreate {
+	ccc.mutation.SetChangesetID(i)
+	return ccc
+}
+
+// SetItemID sets the "item_id" field.
+func (ccc *ChangesetChangeCreate) SetItemID(s string) *ChangesetChangeCreate {
+	ccc.mutation.SetItemID(s)
+	return ccc
+}
+
+// SetOp sets the "op" field.
+func (ccc *ChangesetChangeCreate) SetOp(s string) *ChangesetChangeCreate {
+	ccc.mutation.SetOpField(s)
+	return ccc
+}
+
+// SetName sets the "name" field.
+func (ccc *ChangesetChangeCreate) SetName(s string) *ChangesetChangeCreate {
+	ccc.mutation.SetName(s)
+	return ccc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ccc *ChangesetChangeCreate) SetNillableName(s *string) *ChangesetChangeCreate {
+	if s != nil {
+		ccc.SetName(*s)
+	}
+	return ccc
+}
+
+// SetCategory sets the "category" field.
+func (ccc *ChangesetChangeCreate) SetCategory(s string) *ChangesetChangeCreate {
+	ccc.mutation.SetCategory(s)
+	return ccc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (ccc *ChangesetChangeCreate) SetNillableCategory(s *string) *ChangesetChangeCreate {
+	if s != nil {
+		ccc.SetCategory(*s)
+	}
+	return ccc
+}
+
+// SetDescription sets the "description" field.
+func (ccc *ChangesetChangeCreate) SetDescription(s string) *ChangesetChangeCreate {
+	ccc.mutation.SetDescription(s)
+	return ccc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ccc *ChangesetChangeCreate) SetNillableDescription(s *string) *ChangesetChangeCreate {
+	if s != nil {
+		ccc.SetDescription(*s)
+	}
+	return ccc
+}
+
+// SetTranslations sets the "translations" field.
+func (ccc *ChangesetChangeCreate) SetTranslations(et []*entity.ItemTranslation) *ChangesetChangeCreate {
+	ccc.mutation.SetTranslations(et)
+	return ccc
+}
+
+// SetChangeset sets the "changeset" edge to the Changeset entity.
+func (ccc *ChangesetChangeCreate) SetChangeset(c *Changeset) *ChangesetChangeCreate {
+	return ccc.SetChangesetID(c.ID)
+}
+
+// Mutation returns the ChangesetChangeMutation object of the builder.
+func (ccc *ChangesetChangeCreate) Mutation() *ChangesetChangeMutation {
+	return ccc.mutation
+}
+
+// Save creates the ChangesetChange in the database.
+func (ccc *ChangesetChangeCreate) Save(ctx context.Context) (*ChangesetChange, error) {
+	ccc.defaults()
+	return withHooks(ctx, ccc.sqlSave, ccc.mutation, ccc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *ChangesetChangeCreate) SaveX(ctx context.Context) *ChangesetChange {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *ChangesetChangeCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *ChangesetChangeCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccc *ChangesetChangeCreate) defaults() {
+	if _, ok := ccc.mutation.Name(); !ok {
+		v := changesetchange.DefaultName
+		ccc.mutation.SetName(v)
+	}
+	if _, ok := ccc.mutation.Category(); !ok {
+		v := changesetchange.DefaultCategory
+		ccc.mutation.SetCategory(v)
+	}
+	if _, ok := ccc.mutation.Description(); !ok {
+		v := changesetchange.DefaultDescription
+		ccc.mutation.SetDescription(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *ChangesetChangeCreate) check() error {
+	if _, ok := ccc.mutation.ChangesetID(); !ok {
+		return &ValidationError{Name: "changeset_id", err: errors.New(`entc: missing required field "ChangesetChange.changeset_id"`)}
+	}
+	if _, ok := ccc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`entc: missing required field "ChangesetChange.item_id"`)}
+	}
+	if _, ok := ccc.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`entc: missing required field "ChangesetChange.op"`)}
+	}
+	if _, ok := ccc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entc: missing required field "ChangesetChange.name"`)}
+	}
+	if _, ok := ccc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`entc: missing required field "ChangesetChange.category"`)}
+	}
+	if _, ok := ccc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`entc: missing required field "ChangesetChange.description"`)}
+	}
+	if len(ccc.mutation.ChangesetIDs()) == 0 {
+		return &ValidationError{Name: "changeset", err: errors.New(`entc: missing required edge "ChangesetChange.changeset"`)}
+	}
+	return nil
+}
+
+func (ccc *ChangesetChangeCreate) sqlSave(ctx context.Context) (*ChangesetChange, error) {
+	if err := ccc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ccc.mutation.id = &_node.ID
+	ccc.mutation.done = true
+	return _node, nil
+}
+
+func (ccc *ChangesetChangeCreate) createSpec() (*ChangesetChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChangesetChange{config: ccc.config}
+		_spec = sqlgraph.NewCreateSpec(changesetchange.Table, sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ccc.conflict
+	if value, ok := ccc.mutation.ItemID(); ok {
+		_spec.SetField(changesetchange.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := ccc.mutation.GetOp(); ok {
+		_spec.SetField(changesetchange.FieldOp, field.TypeString, value)
+		_node.Op = value
+	}
+	if value, ok := ccc.mutation.Name(); ok {
+		_spec.SetField(changesetchange.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ccc.mutation.Category(); ok {
+		_spec.SetField(changesetchange.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := ccc.mutation.Description(); ok {
+		_spec.SetField(changesetchange.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ccc.mutation.Translations(); ok {
+		_spec.SetField(changesetchange.FieldTranslations, field.TypeJSON, value)
+		_node.Translations = value
+	}
+	if nodes := ccc.mutation.ChangesetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   changesetchange.ChangesetTable,
+			Columns: []string{changesetchange.ChangesetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChangesetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChangesetChange.Create().
+//		SetChangesetID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChangesetChangeUpsert) {
+//			SetChangesetID(v+v).
+//		}).
+//		Exec(ctx)
+func (ccc *ChangesetChangeCreate) OnConflict(opts ...sql.ConflictOption) *ChangesetChangeUpsertOne {
+	ccc.conflict = opts
+	return &ChangesetChangeUpsertOne{
+		create: ccc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChangesetChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccc *ChangesetChangeCreate) OnConflictColumns(columns ...string) *ChangesetChangeUpsertOne {
+	ccc.conflict = append(ccc.conflict, sql.ConflictColumns(columns...))
+	return &ChangesetChangeUpsertOne{
+		create: ccc,
+	}
+}
+
+type (
+	// ChangesetChangeUpsertOne is the builder for "upsert"-ing
+	//  one ChangesetChange node.
+	ChangesetChangeUpsertOne struct {
+		create *ChangesetChangeCreate
+	}
+
+	// ChangesetChangeUpsert is the "OnConflict" setter.
+	ChangesetChangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetChangesetID sets the "changeset_id" field.
+func (u *ChangesetChangeUpsert) SetChangesetID(v int64) *ChangesetChangeUpsert {
+	u.Set(changesetchange.FieldChangesetID, v)
+	return u
+}
+
+// UpdateChangesetID sets the "changeset_id" field to the value that was provided on create.
+func (u *ChangesetChangeUpsert) UpdateChangesetID() *ChangesetChangeUpsert {
+	u.SetExcluded(changesetchange.FieldChangesetID)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ChangesetChangeUpsert) SetItemID(v string) *ChangesetChangeUpsert {
+	u.Set(changesetchange.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ChangesetChangeUpsert) UpdateItemID() *ChangesetChangeUpsert {
+	u.SetExcluded(changesetchange.FieldItemID)
+	return u
+}
+
+// SetOp sets the "op" field.
+func (u *ChangesetChangeUpsert) SetOp(v string) *ChangesetChangeUpsert {
+	u.Set(changesetchange.FieldOp, v)
+	return u
+}
+
+// UpdateOp sets the "op" field to the value that was provided on create.
+func (u *ChangesetChangeUpsert) UpdateOp() *ChangesetChangeUpsert {
+	u.SetExcluded(changesetchange.FieldOp)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ChangesetChangeUpsert) SetName(v string) *ChangesetChangeUpsert {
+	u.Set(changesetchange.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChangesetChangeUpsert) UpdateName() *ChangesetChangeUpsert {
+	u.SetExcluded(changesetchange.FieldName)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *ChangesetChangeUpsert) SetCategory(v string) *ChangesetChangeUpsert {
+	u.Set(changesetchange.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *ChangesetChangeUpsert) UpdateCategory() *ChangesetChangeUpsert {
+	u.SetExcluded(changesetchange.FieldCategory)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ChangesetChangeUpsert) SetDescription(v string) *ChangesetChangeUpsert {
+	u.Set(changesetchange.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ChangesetChangeUpsert) UpdateDescription() *ChangesetChangeUpsert {
+	u.SetExcluded(changesetchange.FieldDescription)
+	return u
+}
+
+// SetTranslations sets the "translations" field.
+func (u *ChangesetChangeUpsert) SetTranslations(v []*entity.ItemTranslation) *ChangesetChangeUpsert {
+	u.Set(changesetchange.FieldTranslations, v)
+	return u
+}
+
+// UpdateTranslations sets the "translations" field to the value that was provided on create.
+func (u *ChangesetChangeUpsert) UpdateTranslations() *ChangesetChangeUpsert {
+	u.SetExcluded(changesetchange.FieldTranslations)
+	return u
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (u *ChangesetChangeUpsert) ClearTranslations() *ChangesetChangeUpsert {
+	u.SetNull(changesetchange.FieldTranslations)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ChangesetChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ChangesetChangeUpsertOne) UpdateNewValues() *ChangesetChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChangesetChange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChangesetChangeUpsertOne) Ignore() *ChangesetChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChangesetChangeUpsertOne) DoNothing() *ChangesetChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChangesetChangeCreate.OnConflict
+// documentation for more info.
+func (u *ChangesetChangeUpsertOne) Update(set func(*ChangesetChangeUpsert)) *ChangesetChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChangesetChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (u *ChangesetChangeUpsertOne) SetChangesetID(v int64) *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetChangesetID(v)
+	})
+}
+
+// UpdateChangesetID sets the "changeset_id" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertOne) UpdateChangesetID() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateChangesetID()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ChangesetChangeUpsertOne) SetItemID(v string) *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertOne) UpdateItemID() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetOp sets the "op" field.
+func (u *ChangesetChangeUpsertOne) SetOp(v string) *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetOp(v)
+	})
+}
+
+// UpdateOp sets the "op" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertOne) UpdateOp() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateOp()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ChangesetChangeUpsertOne) SetName(v string) *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertOne) UpdateName() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *ChangesetChangeUpsertOne) SetCategory(v string) *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertOne) UpdateCategory() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ChangesetChangeUpsertOne) SetDescription(v string) *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertOne) UpdateDescription() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetTranslations sets the "translations" field.
+func (u *ChangesetChangeUpsertOne) SetTranslations(v []*entity.ItemTranslation) *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetTranslations(v)
+	})
+}
+
+// UpdateTranslations sets the "translations" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertOne) UpdateTranslations() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateTranslations()
+	})
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (u *ChangesetChangeUpsertOne) ClearTranslations() *ChangesetChangeUpsertOne {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.ClearTranslations()
+	})
+}
+
+// Exec executes the query.
+func (u *ChangesetChangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ChangesetChangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChangesetChangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChangesetChangeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChangesetChangeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChangesetChangeCreateBulk is the builder for creating many ChangesetChange entities in bulk.
+type ChangesetChangeCreateBulk struct {
+	config
+	err      error
+	builders []*ChangesetChangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChangesetChange entities in the database.
+func (cccb *ChangesetChangeCreateBulk) Save(ctx context.Context) ([]*ChangesetChange, error) {
+	if cccb.err != nil {
+		return nil, cccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*ChangesetChange, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChangesetChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *ChangesetChangeCreateBulk) SaveX(ctx context.Context) []*ChangesetChange {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *ChangesetChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *ChangesetChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChangesetChange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChangesetChangeUpsert) {
+//			SetChangesetID(v+v).
+//		}).
+//		Exec(ctx)
+func (cccb *ChangesetChangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChangesetChangeUpsertBulk {
+	cccb.conflict = opts
+	return &ChangesetChangeUpsertBulk{
+		create: cccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChangesetChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cccb *ChangesetChangeCreateBulk) OnConflictColumns(columns ...string) *ChangesetChangeUpsertBulk {
+	cccb.conflict = append(cccb.conflict, sql.ConflictColumns(columns...))
+	return &ChangesetChangeUpsertBulk{
+		create: cccb,
+	}
+}
+
+// ChangesetChangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChangesetChange nodes.
+type ChangesetChangeUpsertBulk struct {
+	create *ChangesetChangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChangesetChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ChangesetChangeUpsertBulk) UpdateNewValues() *ChangesetChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChangesetChange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChangesetChangeUpsertBulk) Ignore() *ChangesetChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChangesetChangeUpsertBulk) DoNothing() *ChangesetChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChangesetChangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChangesetChangeUpsertBulk) Update(set func(*ChangesetChangeUpsert)) *ChangesetChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChangesetChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (u *ChangesetChangeUpsertBulk) SetChangesetID(v int64) *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetChangesetID(v)
+	})
+}
+
+// UpdateChangesetID sets the "changeset_id" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertBulk) UpdateChangesetID() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateChangesetID()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ChangesetChangeUpsertBulk) SetItemID(v string) *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertBulk) UpdateItemID() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetOp sets the "op" field.
+func (u *ChangesetChangeUpsertBulk) SetOp(v string) *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetOp(v)
+	})
+}
+
+// UpdateOp sets the "op" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertBulk) UpdateOp() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateOp()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ChangesetChangeUpsertBulk) SetName(v string) *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertBulk) UpdateName() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *ChangesetChangeUpsertBulk) SetCategory(v string) *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertBulk) UpdateCategory() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ChangesetChangeUpsertBulk) SetDescription(v string) *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertBulk) UpdateDescription() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetTranslations sets the "translations" field.
+func (u *ChangesetChangeUpsertBulk) SetTranslations(v []*entity.ItemTranslation) *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.SetTranslations(v)
+	})
+}
+
+// UpdateTranslations sets the "translations" field to the value that was provided on create.
+func (u *ChangesetChangeUpsertBulk) UpdateTranslations() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.UpdateTranslations()
+	})
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (u *ChangesetChangeUpsertBulk) ClearTranslations() *ChangesetChangeUpsertBulk {
+	return u.Update(func(s *ChangesetChangeUpsert) {
+		s.ClearTranslations()
+	})
+}
+
+// Exec executes the query.
+func (u *ChangesetChangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the ChangesetChangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ChangesetChangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChangesetChangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ChangesetChangeDelete is the builder for deleting a ChangesetChange entity.
+type ChangesetChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChangesetChangeMutation
+}
+
+// Where appends a list predicates to the ChangesetChangeDelete builder.
+func (ccd *ChangesetChangeDelete) Where(ps ...predicate.ChangesetChange) *ChangesetChangeDelete {
+	ccd.mutation.Where(ps...)
+	return ccd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ccd *ChangesetChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccd.sqlExec, ccd.mutation, ccd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccd *ChangesetChangeDelete) ExecX(ctx context.Context) int {
+	n, err := ccd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ccd *ChangesetChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(changesetchange.Table, sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt))
+	if ps := ccd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ccd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ccd.mutation.done = true
+	return affected, err
+}
+
+// ChangesetChangeDeleteOne is the builder for deleting a single ChangesetChange entity.
+type ChangesetChangeDeleteOne struct {
+	ccd *ChangesetChangeDelete
+}
+
+// Where appends a list predicates to the ChangesetChangeDelete builder.
+func (ccdo *ChangesetChangeDeleteOne) Where(ps ...predicate.ChangesetChange) *ChangesetChangeDeleteOne {
+	ccdo.ccd.mutation.Where(ps...)
+	return ccdo
+}
+
+// Exec executes the deletion query.
+func (ccdo *ChangesetChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := ccdo.ccd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{changesetchange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccdo *ChangesetChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := ccdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
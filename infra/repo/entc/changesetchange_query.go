@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ChangesetChangeQuery is the builder for querying ChangesetChange entities.
+type ChangesetChangeQuery struct {
+	config
+	ctx           *QueryContext
+	order         []changesetchange.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.ChangesetChange
+	withChangeset *ChangesetQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChangesetChangeQuery builder.
+func (ccq *ChangesetChangeQuery) Where(ps ...predicate.ChangesetChange) *ChangesetChangeQuery {
+	ccq.predicates = append(ccq.predicates, ps...)
+	return ccq
+}
+
+// Limit the number of records to be returned by this query.
+func (ccq *ChangesetChangeQuery) Limit(limit int) *ChangesetChangeQuery {
+	ccq.ctx.Limit = &limit
+	return ccq
+}
+
+// Offset to start from.
+func (ccq *ChangesetChangeQuery) Offset(offset int) *ChangesetChangeQuery {
+	ccq.ctx.Offset = &offset
+	return ccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccq *ChangesetChangeQuery) Unique(unique bool) *ChangesetChangeQuery {
+	ccq.ctx.Unique = &unique
+	return ccq
+}
+
+// Order specifies how the records should be ordered.
+func (ccq *ChangesetChangeQuery) Order(o ...changesetchange.OrderOption) *ChangesetChangeQuery {
+	ccq.order = append(ccq.order, o...)
+	return ccq
+}
+
+// QueryChangeset chains the current query on the "changeset" edge.
+func (ccq *ChangesetChangeQuery) QueryChangeset() *ChangesetQuery {
+	query := (&ChangesetClient{config: ccq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ccq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ccq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(changesetchange.Table, changesetchange.FieldID, selector),
+			sqlgraph.To(changeset.Table, changeset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, changesetchange.ChangesetTable, changesetchange.ChangesetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ccq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ChangesetChange entity from the query.
+// Returns a *NotFoundError when no ChangesetChange was found.
+func (ccq *ChangesetChangeQuery) First(ctx context.Context) (*ChangesetChange, error) {
+	nodes, err := ccq.Limit(1).All(setContextOp(ctx, ccq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{changesetchange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) FirstX(ctx context.Context) *ChangesetChange {
+	node, err := ccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChangesetChange ID from the query.
+// Returns a *NotFoundError when no ChangesetChange ID was found.
+func (ccq *ChangesetChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(1).IDs(setContextOp(ctx, ccq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{changesetchange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := ccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChangesetChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChangesetChange entity is found.
+// Returns a *NotFoundError when no ChangesetChange entities are found.
+func (ccq *ChangesetChangeQuery) Only(ctx context.Context) (*ChangesetChange, error) {
+	nodes, err := ccq.Limit(2).All(setContextOp(ctx, ccq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{changesetchange.Label}
+	default:
+		return nil, &NotSingularError{changesetchange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) OnlyX(ctx context.Context) *ChangesetChange {
+	node, err := ccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChangesetChange ID in the query.
+// Returns a *NotSingularError when more than one ChangesetChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccq *ChangesetChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ccq.Limit(2).IDs(setContextOp(ctx, ccq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{changesetchange.Label}
+	default:
+		err = &NotSingularError{changesetchange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChangesetChanges.
+func (ccq *ChangesetChangeQuery) All(ctx context.Context) ([]*ChangesetChange, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryAll)
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ChangesetChange, *ChangesetChangeQuery]()
+	return withInterceptors[[]*ChangesetChange](ctx, ccq, qr, ccq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) AllX(ctx context.Context) []*ChangesetChange {
+	nodes, err := ccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChangesetChange IDs.
+func (ccq *ChangesetChangeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ccq.ctx.Unique == nil && ccq.path != nil {
+		ccq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryIDs)
+	if err = ccq.Select(changesetchange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := ccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccq *ChangesetChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryCount)
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ccq, querierCount[*ChangesetChangeQuery](), ccq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) CountX(ctx context.Context) int {
+	count, err := ccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccq *ChangesetChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ccq.ctx, ent.OpQueryExist)
+	switch _, err := ccq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccq *ChangesetChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChangesetChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccq *ChangesetChangeQuery) Clone() *ChangesetChangeQuery {
+	if ccq == nil {
+		return nil
+	}
+	return &ChangesetChangeQuery{
+		config:        ccq.config,
+		ctx:           ccq.ctx.Clone(),
+		order:         append([]changesetchange.OrderOption{}, ccq.order...),
+		inters:        append([]Interceptor{}, ccq.inters...),
+		predicates:    append([]predicate.ChangesetChange{}, ccq.predicates...),
+		withChangeset: ccq.withChangeset.Clone(),
+		// clone intermediate query.
+		sql:       ccq.sql.Clone(),
+		path:      ccq.path,
+		modifiers: append([]func(*sql.Selector){}, ccq.modifiers...),
+	}
+}
+
+// WithChangeset tells the query-builder to eager-load the nodes that are connected to
+// the "changeset" edge. The optional arguments are used to configure the query builder of the edge.
+func (ccq *ChangesetChangeQuery) WithChangeset(opts ...func(*ChangesetQuery)) *ChangesetChangeQuery {
+	query := (&ChangesetClient{config: ccq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ccq.withChangeset = query
+	return ccq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ChangesetID int64 `json:"changeset_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChangesetChange.Query().
+//		GroupBy(changesetchange.FieldChangesetID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (ccq *ChangesetChangeQuery) GroupBy(field string, fields ...string) *ChangesetChangeGroupBy {
+	ccq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ChangesetChangeGroupBy{build: ccq}
+	grbuild.flds = &ccq.ctx.Fields
+	grbuild.label = changesetchange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ChangesetID int64 `json:"changeset_id,omitempty"`
+//	}
+//
+//	client.ChangesetChange.Query().
+//		Select(changesetchange.FieldChangesetID).
+//		Scan(ctx, &v)
+func (ccq *ChangesetChangeQuery) Select(fields ...string) *ChangesetChangeSelect {
+	ccq.ctx.Fields = append(ccq.ctx.Fields, fields...)
+	sbuild := &ChangesetChangeSelect{ChangesetChangeQuery: ccq}
+	sbuild.label = changesetchange.Label
+	sbuild.flds, sbuild.scan = &ccq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ChangesetChangeSelect configured with the given aggregations.
+func (ccq *ChangesetChangeQuery) Aggregate(fns ...AggregateFunc) *ChangesetChangeSelect {
+	return ccq.Select().Aggregate(fns...)
+}
+
+func (ccq *ChangesetChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ccq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ccq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ccq.ctx.Fields {
+		if !changesetchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if ccq.path != nil {
+		prev, err := ccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccq.sql = prev
+	}
+	return nil
+}
+
+func (ccq *ChangesetChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChangesetChange, error) {
+	var (
+		nodes       = []*ChangesetChange{}
+		_spec       = ccq.querySpec()
+		loadedTypes = [1]bool{
+			ccq.withChangeset != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ChangesetChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ChangesetChange{config: ccq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ccq.modifiers) > 0 {
+		_spec.Modifiers = ccq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ccq.withChangeset; query != nil {
+		if err := ccq.loadChangeset(ctx, query, nodes, nil,
+			func(n *ChangesetChange, e *Changeset) { n.Edges.Changeset = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ccq *ChangesetChangeQuery) loadChangeset(ctx context.Context, query *ChangesetQuery, nodes []*ChangesetChange, init func(*ChangesetChange), assign func(*ChangesetChange, *Changeset)) error {
+	ids := make([]int64, 0, len(nodes))
+	nodeids := make(map[int64][]*ChangesetChange)
+	for i := range nodes {
+		fk := nodes[i].ChangesetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(changeset.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "changeset_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ccq *ChangesetChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccq.querySpec()
+	if len(ccq.modifiers) > 0 {
+		_spec.Modifiers = ccq.modifiers
+	}
+	_spec.Node.Columns = ccq.ctx.Fields
+	if len(ccq.ctx.Fields) > 0 {
+		_spec.Unique = ccq.ctx.Unique != nil && *ccq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ccq.driver, _spec)
+}
+
+func (ccq *ChangesetChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(changesetchange.Table, changesetchange.Columns, sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt))
+	_spec.From = ccq.sql
+	if unique := ccq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ccq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ccq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, changesetchange.FieldID)
+		for i := range fields {
+			if fields[i] != changesetchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ccq.withChangeset != nil {
+			_spec.Node.AddColumnOnce(changesetchange.FieldChangesetID)
+		}
+	}
+	if ps := ccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccq *ChangesetChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccq.driver.Dialect())
+	t1 := builder.Table(changesetchange.Table)
+	columns := ccq.ctx.Fields
+	if len(columns) == 0 {
+		columns = changesetchange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccq.sql != nil {
+		selector = ccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccq.ctx.Unique != nil && *ccq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ccq.modifiers {
+		m(selector)
+	}
+	for _, p := range ccq.predicates {
+		p(selector)
+	}
+	for _, p := range ccq.order {
+		p(selector)
+	}
+	if offset := ccq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ccq *ChangesetChangeQuery) Modify(modifiers ...func(s *sql.Selector)) *ChangesetChangeSelect {
+	ccq.modifiers = append(ccq.modifiers, modifiers...)
+	return ccq.Select()
+}
+
+// ChangesetChangeGroupBy is the group-by builder for ChangesetChange entities.
+type ChangesetChangeGroupBy struct {
+	selector
+	build *ChangesetChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccgb *ChangesetChangeGroupBy) Aggregate(fns ...AggregateFunc) *ChangesetChangeGroupBy {
+	ccgb.fns = append(ccgb.fns, fns...)
+	return ccgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccgb *ChangesetChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ccgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChangesetChangeQuery, *ChangesetChangeGroupBy](ctx, ccgb.build, ccgb, ccgb.build.inters, v)
+}
+
+func (ccgb *ChangesetChangeGroupBy) sqlScan(ctx context.Context, root *ChangesetChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ccgb.fns))
+	for _, fn := range ccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ccgb.flds)+len(ccgb.fns))
+		for _, f := range *ccgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ccgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ChangesetChangeSelect is the builder for selecting fields of ChangesetChange entities.
+type ChangesetChangeSelect struct {
+	*ChangesetChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ccs *ChangesetChangeSelect) Aggregate(fns ...AggregateFunc) *ChangesetChangeSelect {
+	ccs.fns = append(ccs.fns, fns...)
+	return ccs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccs *ChangesetChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ccs.ctx, ent.OpQuerySelect)
+	if err := ccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChangesetChangeQuery, *ChangesetChangeSelect](ctx, ccs.ChangesetChangeQuery, ccs, ccs.inters, v)
+}
+
+func (ccs *ChangesetChangeSelect) sqlScan(ctx context.Context, root *ChangesetChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ccs.fns))
+	for _, fn := range ccs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ccs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ccs *ChangesetChangeSelect) Modify(modifiers ...func(s *sql.Selector)) *ChangesetChangeSelect {
+	ccs.modifiers = append(ccs.modifiers, modifiers...)
+	return ccs
+}
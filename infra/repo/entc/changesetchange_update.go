@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ChangesetChangeUpdate is the builder for updating ChangesetChange entities.
+type ChangesetChangeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ChangesetChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ChangesetChangeUpdate builder.
+func (ccu *ChangesetChangeUpdate) Where(ps ...predicate.ChangesetChange) *ChangesetChangeUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (ccu *ChangesetChangeUpdate) SetChangesetID(i int64) *ChangesetChangeUpdate {
+	ccu.mutation.SetChangesetID(i)
+	return ccu
+}
+
+// SetNillableChangesetID sets the "changeset_id" field if the given value is not nil.
+func (ccu *ChangesetChangeUpdate) SetNillableChangesetID(i *int64) *ChangesetChangeUpdate {
+	if i != nil {
+		ccu.SetChangesetID(*i)
+	}
+	return ccu
+}
+
+// SetItemID sets the "item_id" field.
+func (ccu *ChangesetChangeUpdate) SetItemID(s string) *ChangesetChangeUpdate {
+	ccu.mutation.SetItemID(s)
+	return ccu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (ccu *ChangesetChangeUpdate) SetNillableItemID(s *string) *ChangesetChangeUpdate {
+	if s != nil {
+		ccu.SetItemID(*s)
+	}
+	return ccu
+}
+
+// SetOp sets the "op" field.
+func (ccu *ChangesetChangeUpdate) SetOp(s string) *ChangesetChangeUpdate {
+	ccu.mutation.SetOpField(s)
+	return ccu
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (ccu *ChangesetChangeUpdate) SetNillableOp(s *string) *ChangesetChangeUpdate {
+	if s != nil {
+		ccu.SetOp(*s)
+	}
+	return ccu
+}
+
+// SetName sets the "name" field.
+func (ccu *ChangesetChangeUpdate) SetName(s string) *ChangesetChangeUpdate {
+	ccu.mutation.SetName(s)
+	return ccu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ccu *ChangesetChangeUpdate) SetNillableName(s *string) *ChangesetChangeUpdate {
+	if s != nil {
+		ccu.SetName(*s)
+	}
+	return ccu
+}
+
+// SetCategory sets the "category" field.
+func (ccu *ChangesetChangeUpdate) SetCategory(s string) *ChangesetChangeUpdate {
+	ccu.mutation.SetCategory(s)
+	return ccu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (ccu *ChangesetChangeUpdate) SetNillableCategory(s *string) *ChangesetChangeUpdate {
+	if s != nil {
+		ccu.SetCategory(*s)
+	}
+	return ccu
+}
+
+// SetDescription sets the "description" field.
+func (ccu *ChangesetChangeUpdate) SetDescription(s string) *ChangesetChangeUpdate {
+	ccu.mutation.SetDescription(s)
+	return ccu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ccu *ChangesetChangeUpdate) SetNillableDescription(s *string) *ChangesetChangeUpdate {
+	if s != nil {
+		ccu.SetDescription(*s)
+	}
+	return ccu
+}
+
+// SetTranslations sets the "translations" field.
+func (ccu *ChangesetChangeUpdate) SetTranslations(et []*entity.ItemTranslation) *ChangesetChangeUpdate {
+	ccu.mutation.SetTranslations(et)
+	return ccu
+}
+
+// AppendTranslations appends et to the "translations" field.
+func (ccu *ChangesetChangeUpdate) AppendTranslations(et []*entity.ItemTranslation) *ChangesetChangeUpdate {
+	ccu.mutation.AppendTranslations(et)
+	return ccu
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (ccu *ChangesetChangeUpdate) ClearTranslations() *ChangesetChangeUpdate {
+	ccu.mutation.ClearTranslations()
+	return ccu
+}
+
+// SetChangeset sets the "changeset" edge to the Changeset entity.
+func (ccu *ChangesetChangeUpdate) SetChangeset(c *Changeset) *ChangesetChangeUpdate {
+	return ccu.SetChangesetID(c.ID)
+}
+
+// Mutation returns the ChangesetChangeMutation object of the builder.
+func (ccu *ChangesetChangeUpdate) Mutation() *ChangesetChangeMutation {
+	return ccu.mutation
+}
+
+// ClearChangeset clears the "changeset" edge to the Changeset entity.
+func (ccu *ChangesetChangeUpdate) ClearChangeset() *ChangesetChangeUpdate {
+	ccu.mutation.ClearChangeset()
+	return ccu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *ChangesetChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ccu.sqlSave, ccu.mutation, ccu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *ChangesetChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *ChangesetChangeUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *ChangesetChangeUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccu *ChangesetChangeUpdate) check() error {
+	if ccu.mutation.ChangesetCleared() && len(ccu.mutation.ChangesetIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ChangesetChange.changeset"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ccu *ChangesetChangeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChangesetChangeUpdate {
+	ccu.modifiers = append(ccu.modifiers, modifiers...)
+	return ccu
+}
+
+func (ccu *ChangesetChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ccu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(changesetchange.Table, changesetchange.Columns, sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt))
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccu.mutation.ItemID(); ok {
+		_spec.SetField(changesetchange.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.GetOp(); ok {
+		_spec.SetField(changesetchange.FieldOp, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.Name(); ok {
+		_spec.SetField(changesetchange.FieldName, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.Category(); ok {
+		_spec.SetField(changesetchange.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.Description(); ok {
+		_spec.SetField(changesetchange.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := ccu.mutation.Translations(); ok {
+		_spec.SetField(changesetchange.FieldTranslations, field.TypeJSON, value)
+	}
+	if value, ok := ccu.mutation.AppendedTranslations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, changesetchange.FieldTranslations, value)
+		})
+	}
+	if ccu.mutation.TranslationsCleared() {
+		_spec.ClearField(changesetchange.FieldTranslations, field.TypeJSON)
+	}
+	if ccu.mutation.ChangesetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   changesetchange.ChangesetTable,
+			Columns: []string{changesetchange.ChangesetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ccu.mutation.ChangesetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   changesetchange.ChangesetTable,
+			Columns: []string{changesetchange.ChangesetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ccu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{changesetchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ccu.mutation.done = true
+	return n, nil
+}
+
+// ChangesetChangeUpdateOne is the builder for updating a single ChangesetChange entity.
+type ChangesetChangeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChangesetChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetChangesetID sets the "changeset_id" field.
+func (ccuo *ChangesetChangeUpdateOne) SetChangesetID(i int64) *ChangesetChangeUpdateOne {
+	ccuo.mutation.SetChangesetID(i)
+	return ccuo
+}
+
+// SetNillableChangesetID sets the "changeset_id" field if the given value is not nil.
+func (ccuo *ChangesetChangeUpdateOne) SetNillableChangesetID(i *int64) *ChangesetChangeUpdateOne {
+	if i != nil {
+		ccuo.SetChangesetID(*i)
+	}
+	return ccuo
+}
+
+// SetItemID sets the "item_id" field.
+func (ccuo *ChangesetChangeUpdateOne) SetItemID(s string) *ChangesetChangeUpdateOne {
+	ccuo.mutation.SetItemID(s)
+	return ccuo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (ccuo *ChangesetChangeUpdateOne) SetNillableItemID(s *string) *ChangesetChangeUpdateOne {
+	if s != nil {
+		ccuo.SetItemID(*s)
+	}
+	return ccuo
+}
+
+// SetOp sets the "op" field.
+func (ccuo *ChangesetChangeUpdateOne) SetOp(s string) *ChangesetChangeUpdateOne {
+	ccuo.mutation.SetOpField(s)
+	return ccuo
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (ccuo *ChangesetChangeUpdateOne) SetNillableOp(s *string) *ChangesetChangeUpdateOne {
+	if s != nil {
+		ccuo.SetOp(*s)
+	}
+	return ccuo
+}
+
+// SetName sets the "name" field.
+func (ccuo *ChangesetChangeUpdateOne) SetName(s string) *ChangesetChangeUpdateOne {
+	ccuo.mutation.SetName(s)
+	return ccuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ccuo *ChangesetChangeUpdateOne) SetNillableName(s *string) *ChangesetChangeUpdateOne {
+	if s != nil {
+		ccuo.SetName(*s)
+	}
+	return ccuo
+}
+
+// SetCategory sets the "category" field.
+func (ccuo *ChangesetChangeUpdateOne) SetCategory(s string) *ChangesetChangeUpdateOne {
+	ccuo.mutation.SetCategory(s)
+	return ccuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (ccuo *ChangesetChangeUpdateOne) SetNillableCategory(s *string) *ChangesetChangeUpdateOne {
+	if s != nil {
+		ccuo.SetCategory(*s)
+	}
+	return ccuo
+}
+
+// SetDescription sets the "description" field.
+func (ccuo *ChangesetChangeUpdateOne) SetDescription(s string) *ChangesetChangeUpdateOne {
+	ccuo.mutation.SetDescription(s)
+	return ccuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ccuo *ChangesetChangeUpdateOne) SetNillableDescription(s *string) *ChangesetChangeUpdateOne {
+	if s != nil {
+		ccuo.SetDescription(*s)
+	}
+	return ccuo
+}
+
+// SetTranslations sets the "translations" field.
+func (ccuo *ChangesetChangeUpdateOne) SetTranslations(et []*entity.ItemTranslation) *ChangesetChangeUpdateOne {
+	ccuo.mutation.SetTranslations(et)
+	return ccuo
+}
+
+// AppendTranslations appends et to the "translations" field.
+func (ccuo *ChangesetChangeUpdateOne) AppendTranslations(et []*entity.ItemTranslation) *ChangesetChangeUpdateOne {
+	ccuo.mutation.AppendTranslations(et)
+	return ccuo
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (ccuo *ChangesetChangeUpdateOne) ClearTranslations() *ChangesetChangeUpdateOne {
+	ccuo.mutation.ClearTranslations()
+	return ccuo
+}
+
+// SetChangeset sets the "changeset" edge to the Changeset entity.
+func (ccuo *ChangesetChangeUpdateOne) SetChangeset(c *Changeset) *ChangesetChangeUpdateOne {
+	return ccuo.SetChangesetID(c.ID)
+}
+
+// Mutation returns the ChangesetChangeMutation object of the builder.
+func (ccuo *ChangesetChangeUpdateOne) Mutation() *ChangesetChangeMutation {
+	return ccuo.mutation
+}
+
+// ClearChangeset clears the "changeset" edge to the Changeset entity.
+func (ccuo *ChangesetChangeUpdateOne) ClearChangeset() *ChangesetChangeUpdateOne {
+	ccuo.mutation.ClearChangeset()
+	return ccuo
+}
+
+// Where appends a list predicates to the ChangesetChangeUpdate builder.
+func (ccuo *ChangesetChangeUpdateOne) Where(ps ...predicate.ChangesetChange) *ChangesetChangeUpdateOne {
+	ccuo.mutation.Where(ps...)
+	return ccuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *ChangesetChangeUpdateOne) Select(field string, fields ...string) *ChangesetChangeUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated ChangesetChange entity.
+func (ccuo *ChangesetChangeUpdateOne) Save(ctx context.Context) (*ChangesetChange, error) {
+	return withHooks(ctx, ccuo.sqlSave, ccuo.mutation, ccuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *ChangesetChangeUpdateOne) SaveX(ctx context.Context) *ChangesetChange {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *ChangesetChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *ChangesetChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccuo *ChangesetChangeUpdateOne) check() error {
+	if ccuo.mutation.ChangesetCleared() && len(ccuo.mutation.ChangesetIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ChangesetChange.changeset"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ccuo *ChangesetChangeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChangesetChangeUpdateOne {
+	ccuo.modifiers = append(ccuo.modifiers, modifiers...)
+	return ccuo
+}
+
+func (ccuo *ChangesetChangeUpdateOne) sqlSave(ctx context.Context) (_node *ChangesetChange, err error) {
+	if err := ccuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(changesetchange.Table, changesetchange.Columns, sqlgraph.NewFieldSpec(changesetchange.FieldID, field.TypeInt))
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "ChangesetChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, changesetchange.FieldID)
+		for _, f := range fields {
+			if !changesetchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != changesetchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccuo.mutation.ItemID(); ok {
+		_spec.SetField(changesetchange.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.GetOp(); ok {
+		_spec.SetField(changesetchange.FieldOp, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.Name(); ok {
+		_spec.SetField(changesetchange.FieldName, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.Category(); ok {
+		_spec.SetField(changesetchange.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.Description(); ok {
+		_spec.SetField(changesetchange.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := ccuo.mutation.Translations(); ok {
+		_spec.SetField(changesetchange.FieldTranslations, field.TypeJSON, value)
+	}
+	if value, ok := ccuo.mutation.AppendedTranslations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, changesetchange.FieldTranslations, value)
+		})
+	}
+	if ccuo.mutation.TranslationsCleared() {
+		_spec.ClearField(changesetchange.FieldTranslations, field.TypeJSON)
+	}
+	if ccuo.mutation.ChangesetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   changesetchange.ChangesetTable,
+			Columns: []string{changesetchange.ChangesetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ccuo.mutation.ChangesetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   changesetchange.ChangesetTable,
+			Columns: []string{changesetchange.ChangesetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(changeset.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ccuo.modifiers...)
+	_node = &ChangesetChange{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{changesetchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ccuo.mutation.done = true
+	return _node, nil
+}
@@ -14,7 +14,36 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
 	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboard"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboardsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/outboxevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhookdelivery"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhooksubscription"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +51,60 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// CatalogSnapshot is the client for interacting with the CatalogSnapshot builders.
+	CatalogSnapshot *CatalogSnapshotClient
+	// CatalogVersion is the client for interacting with the CatalogVersion builders.
+	CatalogVersion *CatalogVersionClient
+	// Changeset is the client for interacting with the Changeset builders.
+	Changeset *ChangesetClient
+	// ChangesetChange is the client for interacting with the ChangesetChange builders.
+	ChangesetChange *ChangesetChangeClient
+	// InventoryItem is the client for interacting with the InventoryItem builders.
+	InventoryItem *InventoryItemClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemInstance is the client for interacting with the ItemInstance builders.
+	ItemInstance *ItemInstanceClient
+	// ItemInstanceHistory is the client for interacting with the ItemInstanceHistory builders.
+	ItemInstanceHistory *ItemInstanceHistoryClient
+	// ItemTranslation is the client for interacting with the ItemTranslation builders.
+	ItemTranslation *ItemTranslationClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// JobTask is the client for interacting with the JobTask builders.
+	JobTask *JobTaskClient
+	// Leaderboard is the client for interacting with the Leaderboard builders.
+	Leaderboard *LeaderboardClient
+	// LeaderboardSnapshot is the client for interacting with the LeaderboardSnapshot builders.
+	LeaderboardSnapshot *LeaderboardSnapshotClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// LiveEvent is the client for interacting with the LiveEvent builders.
+	LiveEvent *LiveEventClient
+	// MarketFill is the client for interacting with the MarketFill builders.
+	MarketFill *MarketFillClient
+	// MarketOrder is the client for interacting with the MarketOrder builders.
+	MarketOrder *MarketOrderClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// Player is the client for interacting with the Player builders.
+	Player *PlayerClient
+	// PlayerAccount is the client for interacting with the PlayerAccount builders.
+	PlayerAccount *PlayerAccountClient
+	// PlayerEvent is the client for interacting with the PlayerEvent builders.
+	PlayerEvent *PlayerEventClient
+	// Quest is the client for interacting with the Quest builders.
+	Quest *QuestClient
+	// QuestProgress is the client for interacting with the QuestProgress builders.
+	QuestProgress *QuestProgressClient
+	// Trade is the client for interacting with the Trade builders.
+	Trade *TradeClient
+	// Wallet is the client for interacting with the Wallet builders.
+	Wallet *WalletClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +116,33 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.CatalogSnapshot = NewCatalogSnapshotClient(c.config)
+	c.CatalogVersion = NewCatalogVersionClient(c.config)
+	c.Changeset = NewChangesetClient(c.config)
+	c.ChangesetChange = NewChangesetChangeClient(c.config)
+	c.InventoryItem = NewInventoryItemClient(c.config)
 	c.Item = NewItemClient(c.config)
+	c.ItemInstance = NewItemInstanceClient(c.config)
+	c.ItemInstanceHistory = NewItemInstanceHistoryClient(c.config)
+	c.ItemTranslation = NewItemTranslationClient(c.config)
+	c.JobRun = NewJobRunClient(c.config)
+	c.JobTask = NewJobTaskClient(c.config)
+	c.Leaderboard = NewLeaderboardClient(c.config)
+	c.LeaderboardSnapshot = NewLeaderboardSnapshotClient(c.config)
+	c.LedgerEntry = NewLedgerEntryClient(c.config)
+	c.LiveEvent = NewLiveEventClient(c.config)
+	c.MarketFill = NewMarketFillClient(c.config)
+	c.MarketOrder = NewMarketOrderClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
+	c.Player = NewPlayerClient(c.config)
+	c.PlayerAccount = NewPlayerAccountClient(c.config)
+	c.PlayerEvent = NewPlayerEventClient(c.config)
+	c.Quest = NewQuestClient(c.config)
+	c.QuestProgress = NewQuestProgressClient(c.config)
+	c.Trade = NewTradeClient(c.config)
+	c.Wallet = NewWalletClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookSubscription = NewWebhookSubscriptionClient(c.config)
 }
 
 type (
@@ -126,9 +233,35 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Item:   NewItemClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		CatalogSnapshot:     NewCatalogSnapshotClient(cfg),
+		CatalogVersion:      NewCatalogVersionClient(cfg),
+		Changeset:           NewChangesetClient(cfg),
+		ChangesetChange:     NewChangesetChangeClient(cfg),
+		InventoryItem:       NewInventoryItemClient(cfg),
+		Item:                NewItemClient(cfg),
+		ItemInstance:        NewItemInstanceClient(cfg),
+		ItemInstanceHistory: NewItemInstanceHistoryClient(cfg),
+		ItemTranslation:     NewItemTranslationClient(cfg),
+		JobRun:              NewJobRunClient(cfg),
+		JobTask:             NewJobTaskClient(cfg),
+		Leaderboard:         NewLeaderboardClient(cfg),
+		LeaderboardSnapshot: NewLeaderboardSnapshotClient(cfg),
+		LedgerEntry:         NewLedgerEntryClient(cfg),
+		LiveEvent:           NewLiveEventClient(cfg),
+		MarketFill:          NewMarketFillClient(cfg),
+		MarketOrder:         NewMarketOrderClient(cfg),
+		OutboxEvent:         NewOutboxEventClient(cfg),
+		Player:              NewPlayerClient(cfg),
+		PlayerAccount:       NewPlayerAccountClient(cfg),
+		PlayerEvent:         NewPlayerEventClient(cfg),
+		Quest:               NewQuestClient(cfg),
+		QuestProgress:       NewQuestProgressClient(cfg),
+		Trade:               NewTradeClient(cfg),
+		Wallet:              NewWalletClient(cfg),
+		WebhookDelivery:     NewWebhookDeliveryClient(cfg),
+		WebhookSubscription: NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -146,16 +279,42 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Item:   NewItemClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		CatalogSnapshot:     NewCatalogSnapshotClient(cfg),
+		CatalogVersion:      NewCatalogVersionClient(cfg),
+		Changeset:           NewChangesetClient(cfg),
+		ChangesetChange:     NewChangesetChangeClient(cfg),
+		InventoryItem:       NewInventoryItemClient(cfg),
+		Item:                NewItemClient(cfg),
+		ItemInstance:        NewItemInstanceClient(cfg),
+		ItemInstanceHistory: NewItemInstanceHistoryClient(cfg),
+		ItemTranslation:     NewItemTranslationClient(cfg),
+		JobRun:              NewJobRunClient(cfg),
+		JobTask:             NewJobTaskClient(cfg),
+		Leaderboard:         NewLeaderboardClient(cfg),
+		LeaderboardSnapshot: NewLeaderboardSnapshotClient(cfg),
+		LedgerEntry:         NewLedgerEntryClient(cfg),
+		LiveEvent:           NewLiveEventClient(cfg),
+		MarketFill:          NewMarketFillClient(cfg),
+		MarketOrder:         NewMarketOrderClient(cfg),
+		OutboxEvent:         NewOutboxEventClient(cfg),
+		Player:              NewPlayerClient(cfg),
+		PlayerAccount:       NewPlayerAccountClient(cfg),
+		PlayerEvent:         NewPlayerEventClient(cfg),
+		Quest:               NewQuestClient(cfg),
+		QuestProgress:       NewQuestProgressClient(cfg),
+		Trade:               NewTradeClient(cfg),
+		Wallet:              NewWalletClient(cfg),
+		WebhookDelivery:     NewWebhookDeliveryClient(cfg),
+		WebhookSubscription: NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Item.
+//		CatalogSnapshot.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,126 +336,196 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Item.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.CatalogSnapshot, c.CatalogVersion, c.Changeset, c.ChangesetChange,
+		c.InventoryItem, c.Item, c.ItemInstance, c.ItemInstanceHistory,
+		c.ItemTranslation, c.JobRun, c.JobTask, c.Leaderboard, c.LeaderboardSnapshot,
+		c.LedgerEntry, c.LiveEvent, c.MarketFill, c.MarketOrder, c.OutboxEvent,
+		c.Player, c.PlayerAccount, c.PlayerEvent, c.Quest, c.QuestProgress, c.Trade,
+		c.Wallet, c.WebhookDelivery, c.WebhookSubscription,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Item.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.CatalogSnapshot, c.CatalogVersion, c.Changeset, c.ChangesetChange,
+		c.InventoryItem, c.Item, c.ItemInstance, c.ItemInstanceHistory,
+		c.ItemTranslation, c.JobRun, c.JobTask, c.Leaderboard, c.LeaderboardSnapshot,
+		c.LedgerEntry, c.LiveEvent, c.MarketFill, c.MarketOrder, c.OutboxEvent,
+		c.Player, c.PlayerAccount, c.PlayerEvent, c.Quest, c.QuestProgress, c.Trade,
+		c.Wallet, c.WebhookDelivery, c.WebhookSubscription,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *CatalogSnapshotMutation:
+		return c.CatalogSnapshot.mutate(ctx, m)
+	case *CatalogVersionMutation:
+		return c.CatalogVersion.mutate(ctx, m)
+	case *ChangesetMutation:
+		return c.Changeset.mutate(ctx, m)
+	case *ChangesetChangeMutation:
+		return c.ChangesetChange.mutate(ctx, m)
+	case *InventoryItemMutation:
+		return c.InventoryItem.mutate(ctx, m)
 	case *ItemMutation:
 		return c.Item.mutate(ctx, m)
+	case *ItemInstanceMutation:
+		return c.ItemInstance.mutate(ctx, m)
+	case *ItemInstanceHistoryMutation:
+		return c.ItemInstanceHistory.mutate(ctx, m)
+	case *ItemTranslationMutation:
+		return c.ItemTranslation.mutate(ctx, m)
+	case *JobRunMutation:
+		return c.JobRun.mutate(ctx, m)
+	case *JobTaskMutation:
+		return c.JobTask.mutate(ctx, m)
+	case *LeaderboardMutation:
+		return c.Leaderboard.mutate(ctx, m)
+	case *LeaderboardSnapshotMutation:
+		return c.LeaderboardSnapshot.mutate(ctx, m)
+	case *LedgerEntryMutation:
+		return c.LedgerEntry.mutate(ctx, m)
+	case *LiveEventMutation:
+		return c.LiveEvent.mutate(ctx, m)
+	case *MarketFillMutation:
+		return c.MarketFill.mutate(ctx, m)
+	case *MarketOrderMutation:
+		return c.MarketOrder.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
+	case *PlayerMutation:
+		return c.Player.mutate(ctx, m)
+	case *PlayerAccountMutation:
+		return c.PlayerAccount.mutate(ctx, m)
+	case *PlayerEventMutation:
+		return c.PlayerEvent.mutate(ctx, m)
+	case *QuestMutation:
+		return c.Quest.mutate(ctx, m)
+	case *QuestProgressMutation:
+		return c.QuestProgress.mutate(ctx, m)
+	case *TradeMutation:
+		return c.Trade.mutate(ctx, m)
+	case *WalletMutation:
+		return c.Wallet.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookSubscriptionMutation:
+		return c.WebhookSubscription.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("entc: unknown mutation type %T", m)
 	}
 }
 
-// ItemClient is a client for the Item schema.
-type ItemClient struct {
+// CatalogSnapshotClient is a client for the CatalogSnapshot schema.
+type CatalogSnapshotClient struct {
 	config
 }
 
-// NewItemClient returns a client for the Item from the given config.
-func NewItemClient(c config) *ItemClient {
-	return &ItemClient{config: c}
+// NewCatalogSnapshotClient returns a client for the CatalogSnapshot from the given config.
+func NewCatalogSnapshotClient(c config) *CatalogSnapshotClient {
+	return &CatalogSnapshotClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
-func (c *ItemClient) Use(hooks ...Hook) {
-	c.hooks.Item = append(c.hooks.Item, hooks...)
+// A call to `Use(f, g, h)` equals to `catalogsnapshot.Hooks(f(g(h())))`.
+func (c *CatalogSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.CatalogSnapshot = append(c.hooks.CatalogSnapshot, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
-func (c *ItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Item = append(c.inters.Item, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `catalogsnapshot.Intercept(f(g(h())))`.
+func (c *CatalogSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CatalogSnapshot = append(c.inters.CatalogSnapshot, interceptors...)
 }
 
-// Create returns a builder for creating a Item entity.
-func (c *ItemClient) Create() *ItemCreate {
-	mutation := newItemMutation(c.config, OpCreate)
-	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CatalogSnapshot entity.
+func (c *CatalogSnapshotClient) Create() *CatalogSnapshotCreate {
+	mutation := newCatalogSnapshotMutation(c.config, OpCreate)
+	return &CatalogSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Item entities.
-func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
-	return &ItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CatalogSnapshot entities.
+func (c *CatalogSnapshotClient) CreateBulk(builders ...*CatalogSnapshotCreate) *CatalogSnapshotCreateBulk {
+	return &CatalogSnapshotCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
+func (c *CatalogSnapshotClient) MapCreateBulk(slice any, setFunc func(*CatalogSnapshotCreate, int)) *CatalogSnapshotCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CatalogSnapshotCreateBulk{err: fmt.Errorf("calling to CatalogSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ItemCreate, rv.Len())
+	builders := make([]*CatalogSnapshotCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ItemCreateBulk{config: c.config, builders: builders}
+	return &CatalogSnapshotCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Item.
-func (c *ItemClient) Update() *ItemUpdate {
-	mutation := newItemMutation(c.config, OpUpdate)
-	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CatalogSnapshot.
+func (c *CatalogSnapshotClient) Update() *CatalogSnapshotUpdate {
+	mutation := newCatalogSnapshotMutation(c.config, OpUpdate)
+	return &CatalogSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ItemClient) UpdateOne(i *Item) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItem(i))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CatalogSnapshotClient) UpdateOne(cs *CatalogSnapshot) *CatalogSnapshotUpdateOne {
+	mutation := newCatalogSnapshotMutation(c.config, OpUpdateOne, withCatalogSnapshot(cs))
+	return &CatalogSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ItemClient) UpdateOneID(id string) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CatalogSnapshotClient) UpdateOneID(id int64) *CatalogSnapshotUpdateOne {
+	mutation := newCatalogSnapshotMutation(c.config, OpUpdateOne, withCatalogSnapshotID(id))
+	return &CatalogSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Item.
-func (c *ItemClient) Delete() *ItemDelete {
-	mutation := newItemMutation(c.config, OpDelete)
-	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CatalogSnapshot.
+func (c *CatalogSnapshotClient) Delete() *CatalogSnapshotDelete {
+	mutation := newCatalogSnapshotMutation(c.config, OpDelete)
+	return &CatalogSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ItemClient) DeleteOne(i *Item) *ItemDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *CatalogSnapshotClient) DeleteOne(cs *CatalogSnapshot) *CatalogSnapshotDeleteOne {
+	return c.DeleteOneID(cs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ItemClient) DeleteOneID(id string) *ItemDeleteOne {
-	builder := c.Delete().Where(item.ID(id))
+func (c *CatalogSnapshotClient) DeleteOneID(id int64) *CatalogSnapshotDeleteOne {
+	builder := c.Delete().Where(catalogsnapshot.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ItemDeleteOne{builder}
+	return &CatalogSnapshotDeleteOne{builder}
 }
 
-// Query returns a query builder for Item.
-func (c *ItemClient) Query() *ItemQuery {
-	return &ItemQuery{
+// Query returns a query builder for CatalogSnapshot.
+func (c *CatalogSnapshotClient) Query() *CatalogSnapshotQuery {
+	return &CatalogSnapshotQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeItem},
+		ctx:    &QueryContext{Type: TypeCatalogSnapshot},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Item entity by its id.
-func (c *ItemClient) Get(ctx context.Context, id string) (*Item, error) {
-	return c.Query().Where(item.ID(id)).Only(ctx)
+// Get returns a CatalogSnapshot entity by its id.
+func (c *CatalogSnapshotClient) Get(ctx context.Context, id int64) (*CatalogSnapshot, error) {
+	return c.Query().Where(catalogsnapshot.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ItemClient) GetX(ctx context.Context, id string) *Item {
+func (c *CatalogSnapshotClient) GetX(ctx context.Context, id int64) *CatalogSnapshot {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -305,36 +534,3911 @@ func (c *ItemClient) GetX(ctx context.Context, id string) *Item {
 }
 
 // Hooks returns the client hooks.
-func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+func (c *CatalogSnapshotClient) Hooks() []Hook {
+	return c.hooks.CatalogSnapshot
 }
 
 // Interceptors returns the client interceptors.
-func (c *ItemClient) Interceptors() []Interceptor {
-	return c.inters.Item
+func (c *CatalogSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.CatalogSnapshot
 }
 
-func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+func (c *CatalogSnapshotClient) mutate(ctx context.Context, m *CatalogSnapshotMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CatalogSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CatalogSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CatalogSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CatalogSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entc: unknown Item mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entc: unknown CatalogSnapshot mutation op: %q", m.Op())
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		Item []ent.Hook
+// CatalogVersionClient is a client for the CatalogVersion schema.
+type CatalogVersionClient struct {
+	config
+}
+
+// NewCatalogVersionClient returns a client for the CatalogVersion from the given config.
+func NewCatalogVersionClient(c config) *CatalogVersionClient {
+	return &CatalogVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `catalogversion.Hooks(f(g(h())))`.
+func (c *CatalogVersionClient) Use(hooks ...Hook) {
+	c.hooks.CatalogVersion = append(c.hooks.CatalogVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `catalogversion.Intercept(f(g(h())))`.
+func (c *CatalogVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CatalogVersion = append(c.inters.CatalogVersion, interceptors...)
+}
+
+// Create returns a builder for creating a CatalogVersion entity.
+func (c *CatalogVersionClient) Create() *CatalogVersionCreate {
+	mutation := newCatalogVersionMutation(c.config, OpCreate)
+	return &CatalogVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CatalogVersion entities.
+func (c *CatalogVersionClient) CreateBulk(builders ...*CatalogVersionCreate) *CatalogVersionCreateBulk {
+	return &CatalogVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CatalogVersionClient) MapCreateBulk(slice any, setFunc func(*CatalogVersionCreate, int)) *CatalogVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CatalogVersionCreateBulk{err: fmt.Errorf("calling to CatalogVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		Item []ent.Interceptor
+	builders := make([]*CatalogVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
 	}
-)
+	return &CatalogVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CatalogVersion.
+func (c *CatalogVersionClient) Update() *CatalogVersionUpdate {
+	mutation := newCatalogVersionMutation(c.config, OpUpdate)
+	return &CatalogVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CatalogVersionClient) UpdateOne(cv *CatalogVersion) *CatalogVersionUpdateOne {
+	mutation := newCatalogVersionMutation(c.config, OpUpdateOne, withCatalogVersion(cv))
+	return &CatalogVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CatalogVersionClient) UpdateOneID(id int64) *CatalogVersionUpdateOne {
+	mutation := newCatalogVersionMutation(c.config, OpUpdateOne, withCatalogVersionID(id))
+	return &CatalogVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CatalogVersion.
+func (c *CatalogVersionClient) Delete() *CatalogVersionDelete {
+	mutation := newCatalogVersionMutation(c.config, OpDelete)
+	return &CatalogVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CatalogVersionClient) DeleteOne(cv *CatalogVersion) *CatalogVersionDeleteOne {
+	return c.DeleteOneID(cv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CatalogVersionClient) DeleteOneID(id int64) *CatalogVersionDeleteOne {
+	builder := c.Delete().Where(catalogversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CatalogVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for CatalogVersion.
+func (c *CatalogVersionClient) Query() *CatalogVersionQuery {
+	return &CatalogVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCatalogVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CatalogVersion entity by its id.
+func (c *CatalogVersionClient) Get(ctx context.Context, id int64) (*CatalogVersion, error) {
+	return c.Query().Where(catalogversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CatalogVersionClient) GetX(ctx context.Context, id int64) *CatalogVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CatalogVersionClient) Hooks() []Hook {
+	return c.hooks.CatalogVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *CatalogVersionClient) Interceptors() []Interceptor {
+	return c.inters.CatalogVersion
+}
+
+func (c *CatalogVersionClient) mutate(ctx context.Context, m *CatalogVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CatalogVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CatalogVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CatalogVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CatalogVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown CatalogVersion mutation op: %q", m.Op())
+	}
+}
+
+// ChangesetClient is a client for the Changeset schema.
+type ChangesetClient struct {
+	config
+}
+
+// NewChangesetClient returns a client for the Changeset from the given config.
+func NewChangesetClient(c config) *ChangesetClient {
+	return &ChangesetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `changeset.Hooks(f(g(h())))`.
+func (c *ChangesetClient) Use(hooks ...Hook) {
+	c.hooks.Changeset = append(c.hooks.Changeset, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `changeset.Intercept(f(g(h())))`.
+func (c *ChangesetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Changeset = append(c.inters.Changeset, interceptors...)
+}
+
+// Create returns a builder for creating a Changeset entity.
+func (c *ChangesetClient) Create() *ChangesetCreate {
+	mutation := newChangesetMutation(c.config, OpCreate)
+	return &ChangesetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Changeset entities.
+func (c *ChangesetClient) CreateBulk(builders ...*ChangesetCreate) *ChangesetCreateBulk {
+	return &ChangesetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChangesetClient) MapCreateBulk(slice any, setFunc func(*ChangesetCreate, int)) *ChangesetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChangesetCreateBulk{err: fmt.Errorf("calling to ChangesetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChangesetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChangesetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Changeset.
+func (c *ChangesetClient) Update() *ChangesetUpdate {
+	mutation := newChangesetMutation(c.config, OpUpdate)
+	return &ChangesetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChangesetClient) UpdateOne(ch *Changeset) *ChangesetUpdateOne {
+	mutation := newChangesetMutation(c.config, OpUpdateOne, withChangeset(ch))
+	return &ChangesetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChangesetClient) UpdateOneID(id int64) *ChangesetUpdateOne {
+	mutation := newChangesetMutation(c.config, OpUpdateOne, withChangesetID(id))
+	return &ChangesetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Changeset.
+func (c *ChangesetClient) Delete() *ChangesetDelete {
+	mutation := newChangesetMutation(c.config, OpDelete)
+	return &ChangesetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChangesetClient) DeleteOne(ch *Changeset) *ChangesetDeleteOne {
+	return c.DeleteOneID(ch.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChangesetClient) DeleteOneID(id int64) *ChangesetDeleteOne {
+	builder := c.Delete().Where(changeset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChangesetDeleteOne{builder}
+}
+
+// Query returns a query builder for Changeset.
+func (c *ChangesetClient) Query() *ChangesetQuery {
+	return &ChangesetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChangeset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Changeset entity by its id.
+func (c *ChangesetClient) Get(ctx context.Context, id int64) (*Changeset, error) {
+	return c.Query().Where(changeset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChangesetClient) GetX(ctx context.Context, id int64) *Changeset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChanges queries the changes edge of a Changeset.
+func (c *ChangesetClient) QueryChanges(ch *Changeset) *ChangesetChangeQuery {
+	query := (&ChangesetChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(changeset.Table, changeset.FieldID, id),
+			sqlgraph.To(changesetchange.Table, changesetchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, changeset.ChangesTable, changeset.ChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChangesetClient) Hooks() []Hook {
+	return c.hooks.Changeset
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChangesetClient) Interceptors() []Interceptor {
+	return c.inters.Changeset
+}
+
+func (c *ChangesetClient) mutate(ctx context.Context, m *ChangesetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChangesetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChangesetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChangesetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChangesetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown Changeset mutation op: %q", m.Op())
+	}
+}
+
+// ChangesetChangeClient is a client for the ChangesetChange schema.
+type ChangesetChangeClient struct {
+	config
+}
+
+// NewChangesetChangeClient returns a client for the ChangesetChange from the given config.
+func NewChangesetChangeClient(c config) *ChangesetChangeClient {
+	return &ChangesetChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `changesetchange.Hooks(f(g(h())))`.
+func (c *ChangesetChangeClient) Use(hooks ...Hook) {
+	c.hooks.ChangesetChange = append(c.hooks.ChangesetChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `changesetchange.Intercept(f(g(h())))`.
+func (c *ChangesetChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChangesetChange = append(c.inters.ChangesetChange, interceptors...)
+}
+
+// Create returns a builder for creating a ChangesetChange entity.
+func (c *ChangesetChangeClient) Create() *ChangesetChangeCreate {
+	mutation := newChangesetChangeMutation(c.config, OpCreate)
+	return &ChangesetChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChangesetChange entities.
+func (c *ChangesetChangeClient) CreateBulk(builders ...*ChangesetChangeCreate) *ChangesetChangeCreateBulk {
+	return &ChangesetChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChangesetChangeClient) MapCreateBulk(slice any, setFunc func(*ChangesetChangeCreate, int)) *ChangesetChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChangesetChangeCreateBulk{err: fmt.Errorf("calling to ChangesetChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChangesetChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChangesetChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChangesetChange.
+func (c *ChangesetChangeClient) Update() *ChangesetChangeUpdate {
+	mutation := newChangesetChangeMutation(c.config, OpUpdate)
+	return &ChangesetChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChangesetChangeClient) UpdateOne(cc *ChangesetChange) *ChangesetChangeUpdateOne {
+	mutation := newChangesetChangeMutation(c.config, OpUpdateOne, withChangesetChange(cc))
+	return &ChangesetChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChangesetChangeClient) UpdateOneID(id int) *ChangesetChangeUpdateOne {
+	mutation := newChangesetChangeMutation(c.config, OpUpdateOne, withChangesetChangeID(id))
+	return &ChangesetChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChangesetChange.
+func (c *ChangesetChangeClient) Delete() *ChangesetChangeDelete {
+	mutation := newChangesetChangeMutation(c.config, OpDelete)
+	return &ChangesetChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChangesetChangeClient) DeleteOne(cc *ChangesetChange) *ChangesetChangeDeleteOne {
+	return c.DeleteOneID(cc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChangesetChangeClient) DeleteOneID(id int) *ChangesetChangeDeleteOne {
+	builder := c.Delete().Where(changesetchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChangesetChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for ChangesetChange.
+func (c *ChangesetChangeClient) Query() *ChangesetChangeQuery {
+	return &ChangesetChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChangesetChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChangesetChange entity by its id.
+func (c *ChangesetChangeClient) Get(ctx context.Context, id int) (*ChangesetChange, error) {
+	return c.Query().Where(changesetchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChangesetChangeClient) GetX(ctx context.Context, id int) *ChangesetChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChangeset queries the changeset edge of a ChangesetChange.
+func (c *ChangesetChangeClient) QueryChangeset(cc *ChangesetChange) *ChangesetQuery {
+	query := (&ChangesetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(changesetchange.Table, changesetchange.FieldID, id),
+			sqlgraph.To(changeset.Table, changeset.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, changesetchange.ChangesetTable, changesetchange.ChangesetColumn),
+		)
+		fromV = sqlgraph.Neighbors(cc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChangesetChangeClient) Hooks() []Hook {
+	return c.hooks.ChangesetChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChangesetChangeClient) Interceptors() []Interceptor {
+	return c.inters.ChangesetChange
+}
+
+func (c *ChangesetChangeClient) mutate(ctx context.Context, m *ChangesetChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChangesetChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChangesetChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChangesetChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChangesetChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown ChangesetChange mutation op: %q", m.Op())
+	}
+}
+
+// InventoryItemClient is a client for the InventoryItem schema.
+type InventoryItemClient struct {
+	config
+}
+
+// NewInventoryItemClient returns a client for the InventoryItem from the given config.
+func NewInventoryItemClient(c config) *InventoryItemClient {
+	return &InventoryItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inventoryitem.Hooks(f(g(h())))`.
+func (c *InventoryItemClient) Use(hooks ...Hook) {
+	c.hooks.InventoryItem = append(c.hooks.InventoryItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inventoryitem.Intercept(f(g(h())))`.
+func (c *InventoryItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InventoryItem = append(c.inters.InventoryItem, interceptors...)
+}
+
+// Create returns a builder for creating a InventoryItem entity.
+func (c *InventoryItemClient) Create() *InventoryItemCreate {
+	mutation := newInventoryItemMutation(c.config, OpCreate)
+	return &InventoryItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InventoryItem entities.
+func (c *InventoryItemClient) CreateBulk(builders ...*InventoryItemCreate) *InventoryItemCreateBulk {
+	return &InventoryItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InventoryItemClient) MapCreateBulk(slice any, setFunc func(*InventoryItemCreate, int)) *InventoryItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InventoryItemCreateBulk{err: fmt.Errorf("calling to InventoryItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InventoryItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InventoryItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InventoryItem.
+func (c *InventoryItemClient) Update() *InventoryItemUpdate {
+	mutation := newInventoryItemMutation(c.config, OpUpdate)
+	return &InventoryItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InventoryItemClient) UpdateOne(ii *InventoryItem) *InventoryItemUpdateOne {
+	mutation := newInventoryItemMutation(c.config, OpUpdateOne, withInventoryItem(ii))
+	return &InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InventoryItemClient) UpdateOneID(id int64) *InventoryItemUpdateOne {
+	mutation := newInventoryItemMutation(c.config, OpUpdateOne, withInventoryItemID(id))
+	return &InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InventoryItem.
+func (c *InventoryItemClient) Delete() *InventoryItemDelete {
+	mutation := newInventoryItemMutation(c.config, OpDelete)
+	return &InventoryItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InventoryItemClient) DeleteOne(ii *InventoryItem) *InventoryItemDeleteOne {
+	return c.DeleteOneID(ii.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InventoryItemClient) DeleteOneID(id int64) *InventoryItemDeleteOne {
+	builder := c.Delete().Where(inventoryitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InventoryItemDeleteOne{builder}
+}
+
+// Query returns a query builder for InventoryItem.
+func (c *InventoryItemClient) Query() *InventoryItemQuery {
+	return &InventoryItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInventoryItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InventoryItem entity by its id.
+func (c *InventoryItemClient) Get(ctx context.Context, id int64) (*InventoryItem, error) {
+	return c.Query().Where(inventoryitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InventoryItemClient) GetX(ctx context.Context, id int64) *InventoryItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a InventoryItem.
+func (c *InventoryItemClient) QueryPlayer(ii *InventoryItem) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ii.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(inventoryitem.Table, inventoryitem.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, inventoryitem.PlayerTable, inventoryitem.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InventoryItemClient) Hooks() []Hook {
+	return c.hooks.InventoryItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *InventoryItemClient) Interceptors() []Interceptor {
+	return c.inters.InventoryItem
+}
+
+func (c *InventoryItemClient) mutate(ctx context.Context, m *InventoryItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InventoryItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InventoryItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InventoryItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InventoryItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown InventoryItem mutation op: %q", m.Op())
+	}
+}
+
+// ItemClient is a client for the Item schema.
+type ItemClient struct {
+	config
+}
+
+// NewItemClient returns a client for the Item from the given config.
+func NewItemClient(c config) *ItemClient {
+	return &ItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
+func (c *ItemClient) Use(hooks ...Hook) {
+	c.hooks.Item = append(c.hooks.Item, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
+// Create returns a builder for creating a Item entity.
+func (c *ItemClient) Create() *ItemCreate {
+	mutation := newItemMutation(c.config, OpCreate)
+	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Item entities.
+func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Item.
+func (c *ItemClient) Update() *ItemUpdate {
+	mutation := newItemMutation(c.config, OpUpdate)
+	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemClient) UpdateOne(i *Item) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItem(i))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemClient) UpdateOneID(id string) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Item.
+func (c *ItemClient) Delete() *ItemDelete {
+	mutation := newItemMutation(c.config, OpDelete)
+	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemClient) DeleteOne(i *Item) *ItemDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemClient) DeleteOneID(id string) *ItemDeleteOne {
+	builder := c.Delete().Where(item.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemDeleteOne{builder}
+}
+
+// Query returns a query builder for Item.
+func (c *ItemClient) Query() *ItemQuery {
+	return &ItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Item entity by its id.
+func (c *ItemClient) Get(ctx context.Context, id string) (*Item, error) {
+	return c.Query().Where(item.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemClient) GetX(ctx context.Context, id string) *Item {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTranslations queries the translations edge of a Item.
+func (c *ItemClient) QueryTranslations(i *Item) *ItemTranslationQuery {
+	query := (&ItemTranslationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(itemtranslation.Table, itemtranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.TranslationsTable, item.TranslationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemClient) Hooks() []Hook {
+	return c.hooks.Item
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
+func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown Item mutation op: %q", m.Op())
+	}
+}
+
+// ItemInstanceClient is a client for the ItemInstance schema.
+type ItemInstanceClient struct {
+	config
+}
+
+// NewItemInstanceClient returns a client for the ItemInstance from the given config.
+func NewItemInstanceClient(c config) *ItemInstanceClient {
+	return &ItemInstanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `iteminstance.Hooks(f(g(h())))`.
+func (c *ItemInstanceClient) Use(hooks ...Hook) {
+	c.hooks.ItemInstance = append(c.hooks.ItemInstance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `iteminstance.Intercept(f(g(h())))`.
+func (c *ItemInstanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemInstance = append(c.inters.ItemInstance, interceptors...)
+}
+
+// Create returns a builder for creating a ItemInstance entity.
+func (c *ItemInstanceClient) Create() *ItemInstanceCreate {
+	mutation := newItemInstanceMutation(c.config, OpCreate)
+	return &ItemInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemInstance entities.
+func (c *ItemInstanceClient) CreateBulk(builders ...*ItemInstanceCreate) *ItemInstanceCreateBulk {
+	return &ItemInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemInstanceClient) MapCreateBulk(slice any, setFunc func(*ItemInstanceCreate, int)) *ItemInstanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemInstanceCreateBulk{err: fmt.Errorf("calling to ItemInstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemInstanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemInstance.
+func (c *ItemInstanceClient) Update() *ItemInstanceUpdate {
+	mutation := newItemInstanceMutation(c.config, OpUpdate)
+	return &ItemInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemInstanceClient) UpdateOne(ii *ItemInstance) *ItemInstanceUpdateOne {
+	mutation := newItemInstanceMutation(c.config, OpUpdateOne, withItemInstance(ii))
+	return &ItemInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemInstanceClient) UpdateOneID(id string) *ItemInstanceUpdateOne {
+	mutation := newItemInstanceMutation(c.config, OpUpdateOne, withItemInstanceID(id))
+	return &ItemInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemInstance.
+func (c *ItemInstanceClient) Delete() *ItemInstanceDelete {
+	mutation := newItemInstanceMutation(c.config, OpDelete)
+	return &ItemInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemInstanceClient) DeleteOne(ii *ItemInstance) *ItemInstanceDeleteOne {
+	return c.DeleteOneID(ii.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemInstanceClient) DeleteOneID(id string) *ItemInstanceDeleteOne {
+	builder := c.Delete().Where(iteminstance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemInstanceDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemInstance.
+func (c *ItemInstanceClient) Query() *ItemInstanceQuery {
+	return &ItemInstanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemInstance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemInstance entity by its id.
+func (c *ItemInstanceClient) Get(ctx context.Context, id string) (*ItemInstance, error) {
+	return c.Query().Where(iteminstance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemInstanceClient) GetX(ctx context.Context, id string) *ItemInstance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a ItemInstance.
+func (c *ItemInstanceClient) QueryOwner(ii *ItemInstance) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ii.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(iteminstance.Table, iteminstance.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, iteminstance.OwnerTable, iteminstance.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHistory queries the history edge of a ItemInstance.
+func (c *ItemInstanceClient) QueryHistory(ii *ItemInstance) *ItemInstanceHistoryQuery {
+	query := (&ItemInstanceHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ii.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(iteminstance.Table, iteminstance.FieldID, id),
+			sqlgraph.To(iteminstancehistory.Table, iteminstancehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, iteminstance.HistoryTable, iteminstance.HistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemInstanceClient) Hooks() []Hook {
+	return c.hooks.ItemInstance
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemInstanceClient) Interceptors() []Interceptor {
+	return c.inters.ItemInstance
+}
+
+func (c *ItemInstanceClient) mutate(ctx context.Context, m *ItemInstanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown ItemInstance mutation op: %q", m.Op())
+	}
+}
+
+// ItemInstanceHistoryClient is a client for the ItemInstanceHistory schema.
+type ItemInstanceHistoryClient struct {
+	config
+}
+
+// NewItemInstanceHistoryClient returns a client for the ItemInstanceHistory from the given config.
+func NewItemInstanceHistoryClient(c config) *ItemInstanceHistoryClient {
+	return &ItemInstanceHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `iteminstancehistory.Hooks(f(g(h())))`.
+func (c *ItemInstanceHistoryClient) Use(hooks ...Hook) {
+	c.hooks.ItemInstanceHistory = append(c.hooks.ItemInstanceHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `iteminstancehistory.Intercept(f(g(h())))`.
+func (c *ItemInstanceHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemInstanceHistory = append(c.inters.ItemInstanceHistory, interceptors...)
+}
+
+// Create returns a builder for creating a ItemInstanceHistory entity.
+func (c *ItemInstanceHistoryClient) Create() *ItemInstanceHistoryCreate {
+	mutation := newItemInstanceHistoryMutation(c.config, OpCreate)
+	return &ItemInstanceHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemInstanceHistory entities.
+func (c *ItemInstanceHistoryClient) CreateBulk(builders ...*ItemInstanceHistoryCreate) *ItemInstanceHistoryCreateBulk {
+	return &ItemInstanceHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemInstanceHistoryClient) MapCreateBulk(slice any, setFunc func(*ItemInstanceHistoryCreate, int)) *ItemInstanceHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemInstanceHistoryCreateBulk{err: fmt.Errorf("calling to ItemInstanceHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemInstanceHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemInstanceHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemInstanceHistory.
+func (c *ItemInstanceHistoryClient) Update() *ItemInstanceHistoryUpdate {
+	mutation := newItemInstanceHistoryMutation(c.config, OpUpdate)
+	return &ItemInstanceHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemInstanceHistoryClient) UpdateOne(iih *ItemInstanceHistory) *ItemInstanceHistoryUpdateOne {
+	mutation := newItemInstanceHistoryMutation(c.config, OpUpdateOne, withItemInstanceHistory(iih))
+	return &ItemInstanceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemInstanceHistoryClient) UpdateOneID(id int64) *ItemInstanceHistoryUpdateOne {
+	mutation := newItemInstanceHistoryMutation(c.config, OpUpdateOne, withItemInstanceHistoryID(id))
+	return &ItemInstanceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemInstanceHistory.
+func (c *ItemInstanceHistoryClient) Delete() *ItemInstanceHistoryDelete {
+	mutation := newItemInstanceHistoryMutation(c.config, OpDelete)
+	return &ItemInstanceHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemInstanceHistoryClient) DeleteOne(iih *ItemInstanceHistory) *ItemInstanceHistoryDeleteOne {
+	return c.DeleteOneID(iih.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemInstanceHistoryClient) DeleteOneID(id int64) *ItemInstanceHistoryDeleteOne {
+	builder := c.Delete().Where(iteminstancehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemInstanceHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemInstanceHistory.
+func (c *ItemInstanceHistoryClient) Query() *ItemInstanceHistoryQuery {
+	return &ItemInstanceHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemInstanceHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemInstanceHistory entity by its id.
+func (c *ItemInstanceHistoryClient) Get(ctx context.Context, id int64) (*ItemInstanceHistory, error) {
+	return c.Query().Where(iteminstancehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemInstanceHistoryClient) GetX(ctx context.Context, id int64) *ItemInstanceHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInstance queries the instance edge of a ItemInstanceHistory.
+func (c *ItemInstanceHistoryClient) QueryInstance(iih *ItemInstanceHistory) *ItemInstanceQuery {
+	query := (&ItemInstanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := iih.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(iteminstancehistory.Table, iteminstancehistory.FieldID, id),
+			sqlgraph.To(iteminstance.Table, iteminstance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, iteminstancehistory.InstanceTable, iteminstancehistory.InstanceColumn),
+		)
+		fromV = sqlgraph.Neighbors(iih.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemInstanceHistoryClient) Hooks() []Hook {
+	return c.hooks.ItemInstanceHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemInstanceHistoryClient) Interceptors() []Interceptor {
+	return c.inters.ItemInstanceHistory
+}
+
+func (c *ItemInstanceHistoryClient) mutate(ctx context.Context, m *ItemInstanceHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemInstanceHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemInstanceHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemInstanceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemInstanceHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown ItemInstanceHistory mutation op: %q", m.Op())
+	}
+}
+
+// ItemTranslationClient is a client for the ItemTranslation schema.
+type ItemTranslationClient struct {
+	config
+}
+
+// NewItemTranslationClient returns a client for the ItemTranslation from the given config.
+func NewItemTranslationClient(c config) *ItemTranslationClient {
+	return &ItemTranslationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemtranslation.Hooks(f(g(h())))`.
+func (c *ItemTranslationClient) Use(hooks ...Hook) {
+	c.hooks.ItemTranslation = append(c.hooks.ItemTranslation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemtranslation.Intercept(f(g(h())))`.
+func (c *ItemTranslationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemTranslation = append(c.inters.ItemTranslation, interceptors...)
+}
+
+// Create returns a builder for creating a ItemTranslation entity.
+func (c *ItemTranslationClient) Create() *ItemTranslationCreate {
+	mutation := newItemTranslationMutation(c.config, OpCreate)
+	return &ItemTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemTranslation entities.
+func (c *ItemTranslationClient) CreateBulk(builders ...*ItemTranslationCreate) *ItemTranslationCreateBulk {
+	return &ItemTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemTranslationClient) MapCreateBulk(slice any, setFunc func(*ItemTranslationCreate, int)) *ItemTranslationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemTranslationCreateBulk{err: fmt.Errorf("calling to ItemTranslationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemTranslationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemTranslationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemTranslation.
+func (c *ItemTranslationClient) Update() *ItemTranslationUpdate {
+	mutation := newItemTranslationMutation(c.config, OpUpdate)
+	return &ItemTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemTranslationClient) UpdateOne(it *ItemTranslation) *ItemTranslationUpdateOne {
+	mutation := newItemTranslationMutation(c.config, OpUpdateOne, withItemTranslation(it))
+	return &ItemTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemTranslationClient) UpdateOneID(id int) *ItemTranslationUpdateOne {
+	mutation := newItemTranslationMutation(c.config, OpUpdateOne, withItemTranslationID(id))
+	return &ItemTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemTranslation.
+func (c *ItemTranslationClient) Delete() *ItemTranslationDelete {
+	mutation := newItemTranslationMutation(c.config, OpDelete)
+	return &ItemTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemTranslationClient) DeleteOne(it *ItemTranslation) *ItemTranslationDeleteOne {
+	return c.DeleteOneID(it.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemTranslationClient) DeleteOneID(id int) *ItemTranslationDeleteOne {
+	builder := c.Delete().Where(itemtranslation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemTranslationDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemTranslation.
+func (c *ItemTranslationClient) Query() *ItemTranslationQuery {
+	return &ItemTranslationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemTranslation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemTranslation entity by its id.
+func (c *ItemTranslationClient) Get(ctx context.Context, id int) (*ItemTranslation, error) {
+	return c.Query().Where(itemtranslation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemTranslationClient) GetX(ctx context.Context, id int) *ItemTranslation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a ItemTranslation.
+func (c *ItemTranslationClient) QueryItem(it *ItemTranslation) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := it.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtranslation.Table, itemtranslation.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtranslation.ItemTable, itemtranslation.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(it.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemTranslationClient) Hooks() []Hook {
+	return c.hooks.ItemTranslation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemTranslationClient) Interceptors() []Interceptor {
+	return c.inters.ItemTranslation
+}
+
+func (c *ItemTranslationClient) mutate(ctx context.Context, m *ItemTranslationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemTranslationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemTranslationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemTranslationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemTranslationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown ItemTranslation mutation op: %q", m.Op())
+	}
+}
+
+// JobRunClient is a client for the JobRun schema.
+type JobRunClient struct {
+	config
+}
+
+// NewJobRunClient returns a client for the JobRun from the given config.
+func NewJobRunClient(c config) *JobRunClient {
+	return &JobRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobrun.Hooks(f(g(h())))`.
+func (c *JobRunClient) Use(hooks ...Hook) {
+	c.hooks.JobRun = append(c.hooks.JobRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobrun.Intercept(f(g(h())))`.
+func (c *JobRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobRun = append(c.inters.JobRun, interceptors...)
+}
+
+// Create returns a builder for creating a JobRun entity.
+func (c *JobRunClient) Create() *JobRunCreate {
+	mutation := newJobRunMutation(c.config, OpCreate)
+	return &JobRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobRun entities.
+func (c *JobRunClient) CreateBulk(builders ...*JobRunCreate) *JobRunCreateBulk {
+	return &JobRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobRunClient) MapCreateBulk(slice any, setFunc func(*JobRunCreate, int)) *JobRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobRunCreateBulk{err: fmt.Errorf("calling to JobRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobRun.
+func (c *JobRunClient) Update() *JobRunUpdate {
+	mutation := newJobRunMutation(c.config, OpUpdate)
+	return &JobRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobRunClient) UpdateOne(jr *JobRun) *JobRunUpdateOne {
+	mutation := newJobRunMutation(c.config, OpUpdateOne, withJobRun(jr))
+	return &JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobRunClient) UpdateOneID(id int64) *JobRunUpdateOne {
+	mutation := newJobRunMutation(c.config, OpUpdateOne, withJobRunID(id))
+	return &JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobRun.
+func (c *JobRunClient) Delete() *JobRunDelete {
+	mutation := newJobRunMutation(c.config, OpDelete)
+	return &JobRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobRunClient) DeleteOne(jr *JobRun) *JobRunDeleteOne {
+	return c.DeleteOneID(jr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobRunClient) DeleteOneID(id int64) *JobRunDeleteOne {
+	builder := c.Delete().Where(jobrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobRunDeleteOne{builder}
+}
+
+// Query returns a query builder for JobRun.
+func (c *JobRunClient) Query() *JobRunQuery {
+	return &JobRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobRun entity by its id.
+func (c *JobRunClient) Get(ctx context.Context, id int64) (*JobRun, error) {
+	return c.Query().Where(jobrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobRunClient) GetX(ctx context.Context, id int64) *JobRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobRunClient) Hooks() []Hook {
+	return c.hooks.JobRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobRunClient) Interceptors() []Interceptor {
+	return c.inters.JobRun
+}
+
+func (c *JobRunClient) mutate(ctx context.Context, m *JobRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown JobRun mutation op: %q", m.Op())
+	}
+}
+
+// JobTaskClient is a client for the JobTask schema.
+type JobTaskClient struct {
+	config
+}
+
+// NewJobTaskClient returns a client for the JobTask from the given config.
+func NewJobTaskClient(c config) *JobTaskClient {
+	return &JobTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobtask.Hooks(f(g(h())))`.
+func (c *JobTaskClient) Use(hooks ...Hook) {
+	c.hooks.JobTask = append(c.hooks.JobTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobtask.Intercept(f(g(h())))`.
+func (c *JobTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobTask = append(c.inters.JobTask, interceptors...)
+}
+
+// Create returns a builder for creating a JobTask entity.
+func (c *JobTaskClient) Create() *JobTaskCreate {
+	mutation := newJobTaskMutation(c.config, OpCreate)
+	return &JobTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobTask entities.
+func (c *JobTaskClient) CreateBulk(builders ...*JobTaskCreate) *JobTaskCreateBulk {
+	return &JobTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobTaskClient) MapCreateBulk(slice any, setFunc func(*JobTaskCreate, int)) *JobTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobTaskCreateBulk{err: fmt.Errorf("calling to JobTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobTask.
+func (c *JobTaskClient) Update() *JobTaskUpdate {
+	mutation := newJobTaskMutation(c.config, OpUpdate)
+	return &JobTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobTaskClient) UpdateOne(jt *JobTask) *JobTaskUpdateOne {
+	mutation := newJobTaskMutation(c.config, OpUpdateOne, withJobTask(jt))
+	return &JobTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobTaskClient) UpdateOneID(id int64) *JobTaskUpdateOne {
+	mutation := newJobTaskMutation(c.config, OpUpdateOne, withJobTaskID(id))
+	return &JobTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobTask.
+func (c *JobTaskClient) Delete() *JobTaskDelete {
+	mutation := newJobTaskMutation(c.config, OpDelete)
+	return &JobTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobTaskClient) DeleteOne(jt *JobTask) *JobTaskDeleteOne {
+	return c.DeleteOneID(jt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobTaskClient) DeleteOneID(id int64) *JobTaskDeleteOne {
+	builder := c.Delete().Where(jobtask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for JobTask.
+func (c *JobTaskClient) Query() *JobTaskQuery {
+	return &JobTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobTask entity by its id.
+func (c *JobTaskClient) Get(ctx context.Context, id int64) (*JobTask, error) {
+	return c.Query().Where(jobtask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobTaskClient) GetX(ctx context.Context, id int64) *JobTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobTaskClient) Hooks() []Hook {
+	return c.hooks.JobTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobTaskClient) Interceptors() []Interceptor {
+	return c.inters.JobTask
+}
+
+func (c *JobTaskClient) mutate(ctx context.Context, m *JobTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown JobTask mutation op: %q", m.Op())
+	}
+}
+
+// LeaderboardClient is a client for the Leaderboard schema.
+type LeaderboardClient struct {
+	config
+}
+
+// NewLeaderboardClient returns a client for the Leaderboard from the given config.
+func NewLeaderboardClient(c config) *LeaderboardClient {
+	return &LeaderboardClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaderboard.Hooks(f(g(h())))`.
+func (c *LeaderboardClient) Use(hooks ...Hook) {
+	c.hooks.Leaderboard = append(c.hooks.Leaderboard, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaderboard.Intercept(f(g(h())))`.
+func (c *LeaderboardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Leaderboard = append(c.inters.Leaderboard, interceptors...)
+}
+
+// Create returns a builder for creating a Leaderboard entity.
+func (c *LeaderboardClient) Create() *LeaderboardCreate {
+	mutation := newLeaderboardMutation(c.config, OpCreate)
+	return &LeaderboardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Leaderboard entities.
+func (c *LeaderboardClient) CreateBulk(builders ...*LeaderboardCreate) *LeaderboardCreateBulk {
+	return &LeaderboardCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaderboardClient) MapCreateBulk(slice any, setFunc func(*LeaderboardCreate, int)) *LeaderboardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaderboardCreateBulk{err: fmt.Errorf("calling to LeaderboardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaderboardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaderboardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Leaderboard.
+func (c *LeaderboardClient) Update() *LeaderboardUpdate {
+	mutation := newLeaderboardMutation(c.config, OpUpdate)
+	return &LeaderboardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaderboardClient) UpdateOne(l *Leaderboard) *LeaderboardUpdateOne {
+	mutation := newLeaderboardMutation(c.config, OpUpdateOne, withLeaderboard(l))
+	return &LeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaderboardClient) UpdateOneID(id string) *LeaderboardUpdateOne {
+	mutation := newLeaderboardMutation(c.config, OpUpdateOne, withLeaderboardID(id))
+	return &LeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Leaderboard.
+func (c *LeaderboardClient) Delete() *LeaderboardDelete {
+	mutation := newLeaderboardMutation(c.config, OpDelete)
+	return &LeaderboardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaderboardClient) DeleteOne(l *Leaderboard) *LeaderboardDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaderboardClient) DeleteOneID(id string) *LeaderboardDeleteOne {
+	builder := c.Delete().Where(leaderboard.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaderboardDeleteOne{builder}
+}
+
+// Query returns a query builder for Leaderboard.
+func (c *LeaderboardClient) Query() *LeaderboardQuery {
+	return &LeaderboardQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaderboard},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Leaderboard entity by its id.
+func (c *LeaderboardClient) Get(ctx context.Context, id string) (*Leaderboard, error) {
+	return c.Query().Where(leaderboard.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaderboardClient) GetX(ctx context.Context, id string) *Leaderboard {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaderboardClient) Hooks() []Hook {
+	return c.hooks.Leaderboard
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaderboardClient) Interceptors() []Interceptor {
+	return c.inters.Leaderboard
+}
+
+func (c *LeaderboardClient) mutate(ctx context.Context, m *LeaderboardMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaderboardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaderboardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaderboardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaderboardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown Leaderboard mutation op: %q", m.Op())
+	}
+}
+
+// LeaderboardSnapshotClient is a client for the LeaderboardSnapshot schema.
+type LeaderboardSnapshotClient struct {
+	config
+}
+
+// NewLeaderboardSnapshotClient returns a client for the LeaderboardSnapshot from the given config.
+func NewLeaderboardSnapshotClient(c config) *LeaderboardSnapshotClient {
+	return &LeaderboardSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaderboardsnapshot.Hooks(f(g(h())))`.
+func (c *LeaderboardSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.LeaderboardSnapshot = append(c.hooks.LeaderboardSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaderboardsnapshot.Intercept(f(g(h())))`.
+func (c *LeaderboardSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaderboardSnapshot = append(c.inters.LeaderboardSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a LeaderboardSnapshot entity.
+func (c *LeaderboardSnapshotClient) Create() *LeaderboardSnapshotCreate {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpCreate)
+	return &LeaderboardSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaderboardSnapshot entities.
+func (c *LeaderboardSnapshotClient) CreateBulk(builders ...*LeaderboardSnapshotCreate) *LeaderboardSnapshotCreateBulk {
+	return &LeaderboardSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaderboardSnapshotClient) MapCreateBulk(slice any, setFunc func(*LeaderboardSnapshotCreate, int)) *LeaderboardSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaderboardSnapshotCreateBulk{err: fmt.Errorf("calling to LeaderboardSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaderboardSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaderboardSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaderboardSnapshot.
+func (c *LeaderboardSnapshotClient) Update() *LeaderboardSnapshotUpdate {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpUpdate)
+	return &LeaderboardSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaderboardSnapshotClient) UpdateOne(ls *LeaderboardSnapshot) *LeaderboardSnapshotUpdateOne {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpUpdateOne, withLeaderboardSnapshot(ls))
+	return &LeaderboardSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaderboardSnapshotClient) UpdateOneID(id int64) *LeaderboardSnapshotUpdateOne {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpUpdateOne, withLeaderboardSnapshotID(id))
+	return &LeaderboardSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaderboardSnapshot.
+func (c *LeaderboardSnapshotClient) Delete() *LeaderboardSnapshotDelete {
+	mutation := newLeaderboardSnapshotMutation(c.config, OpDelete)
+	return &LeaderboardSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaderboardSnapshotClient) DeleteOne(ls *LeaderboardSnapshot) *LeaderboardSnapshotDeleteOne {
+	return c.DeleteOneID(ls.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaderboardSnapshotClient) DeleteOneID(id int64) *LeaderboardSnapshotDeleteOne {
+	builder := c.Delete().Where(leaderboardsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaderboardSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaderboardSnapshot.
+func (c *LeaderboardSnapshotClient) Query() *LeaderboardSnapshotQuery {
+	return &LeaderboardSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaderboardSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaderboardSnapshot entity by its id.
+func (c *LeaderboardSnapshotClient) Get(ctx context.Context, id int64) (*LeaderboardSnapshot, error) {
+	return c.Query().Where(leaderboardsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaderboardSnapshotClient) GetX(ctx context.Context, id int64) *LeaderboardSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaderboardSnapshotClient) Hooks() []Hook {
+	return c.hooks.LeaderboardSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaderboardSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.LeaderboardSnapshot
+}
+
+func (c *LeaderboardSnapshotClient) mutate(ctx context.Context, m *LeaderboardSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaderboardSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaderboardSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaderboardSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaderboardSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown LeaderboardSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// LedgerEntryClient is a client for the LedgerEntry schema.
+type LedgerEntryClient struct {
+	config
+}
+
+// NewLedgerEntryClient returns a client for the LedgerEntry from the given config.
+func NewLedgerEntryClient(c config) *LedgerEntryClient {
+	return &LedgerEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ledgerentry.Hooks(f(g(h())))`.
+func (c *LedgerEntryClient) Use(hooks ...Hook) {
+	c.hooks.LedgerEntry = append(c.hooks.LedgerEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ledgerentry.Intercept(f(g(h())))`.
+func (c *LedgerEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LedgerEntry = append(c.inters.LedgerEntry, interceptors...)
+}
+
+// Create returns a builder for creating a LedgerEntry entity.
+func (c *LedgerEntryClient) Create() *LedgerEntryCreate {
+	mutation := newLedgerEntryMutation(c.config, OpCreate)
+	return &LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LedgerEntry entities.
+func (c *LedgerEntryClient) CreateBulk(builders ...*LedgerEntryCreate) *LedgerEntryCreateBulk {
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LedgerEntryClient) MapCreateBulk(slice any, setFunc func(*LedgerEntryCreate, int)) *LedgerEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LedgerEntryCreateBulk{err: fmt.Errorf("calling to LedgerEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LedgerEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LedgerEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LedgerEntry.
+func (c *LedgerEntryClient) Update() *LedgerEntryUpdate {
+	mutation := newLedgerEntryMutation(c.config, OpUpdate)
+	return &LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LedgerEntryClient) UpdateOne(le *LedgerEntry) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntry(le))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LedgerEntryClient) UpdateOneID(id int64) *LedgerEntryUpdateOne {
+	mutation := newLedgerEntryMutation(c.config, OpUpdateOne, withLedgerEntryID(id))
+	return &LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LedgerEntry.
+func (c *LedgerEntryClient) Delete() *LedgerEntryDelete {
+	mutation := newLedgerEntryMutation(c.config, OpDelete)
+	return &LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LedgerEntryClient) DeleteOne(le *LedgerEntry) *LedgerEntryDeleteOne {
+	return c.DeleteOneID(le.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LedgerEntryClient) DeleteOneID(id int64) *LedgerEntryDeleteOne {
+	builder := c.Delete().Where(ledgerentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LedgerEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for LedgerEntry.
+func (c *LedgerEntryClient) Query() *LedgerEntryQuery {
+	return &LedgerEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLedgerEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LedgerEntry entity by its id.
+func (c *LedgerEntryClient) Get(ctx context.Context, id int64) (*LedgerEntry, error) {
+	return c.Query().Where(ledgerentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LedgerEntryClient) GetX(ctx context.Context, id int64) *LedgerEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a LedgerEntry.
+func (c *LedgerEntryClient) QueryPlayer(le *LedgerEntry) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := le.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ledgerentry.Table, ledgerentry.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ledgerentry.PlayerTable, ledgerentry.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(le.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LedgerEntryClient) Hooks() []Hook {
+	return c.hooks.LedgerEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *LedgerEntryClient) Interceptors() []Interceptor {
+	return c.inters.LedgerEntry
+}
+
+func (c *LedgerEntryClient) mutate(ctx context.Context, m *LedgerEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LedgerEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LedgerEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LedgerEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LedgerEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown LedgerEntry mutation op: %q", m.Op())
+	}
+}
+
+// LiveEventClient is a client for the LiveEvent schema.
+type LiveEventClient struct {
+	config
+}
+
+// NewLiveEventClient returns a client for the LiveEvent from the given config.
+func NewLiveEventClient(c config) *LiveEventClient {
+	return &LiveEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `liveevent.Hooks(f(g(h())))`.
+func (c *LiveEventClient) Use(hooks ...Hook) {
+	c.hooks.LiveEvent = append(c.hooks.LiveEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `liveevent.Intercept(f(g(h())))`.
+func (c *LiveEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiveEvent = append(c.inters.LiveEvent, interceptors...)
+}
+
+// Create returns a builder for creating a LiveEvent entity.
+func (c *LiveEventClient) Create() *LiveEventCreate {
+	mutation := newLiveEventMutation(c.config, OpCreate)
+	return &LiveEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LiveEvent entities.
+func (c *LiveEventClient) CreateBulk(builders ...*LiveEventCreate) *LiveEventCreateBulk {
+	return &LiveEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LiveEventClient) MapCreateBulk(slice any, setFunc func(*LiveEventCreate, int)) *LiveEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LiveEventCreateBulk{err: fmt.Errorf("calling to LiveEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LiveEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LiveEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LiveEvent.
+func (c *LiveEventClient) Update() *LiveEventUpdate {
+	mutation := newLiveEventMutation(c.config, OpUpdate)
+	return &LiveEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LiveEventClient) UpdateOne(le *LiveEvent) *LiveEventUpdateOne {
+	mutation := newLiveEventMutation(c.config, OpUpdateOne, withLiveEvent(le))
+	return &LiveEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LiveEventClient) UpdateOneID(id string) *LiveEventUpdateOne {
+	mutation := newLiveEventMutation(c.config, OpUpdateOne, withLiveEventID(id))
+	return &LiveEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LiveEvent.
+func (c *LiveEventClient) Delete() *LiveEventDelete {
+	mutation := newLiveEventMutation(c.config, OpDelete)
+	return &LiveEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LiveEventClient) DeleteOne(le *LiveEvent) *LiveEventDeleteOne {
+	return c.DeleteOneID(le.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LiveEventClient) DeleteOneID(id string) *LiveEventDeleteOne {
+	builder := c.Delete().Where(liveevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LiveEventDeleteOne{builder}
+}
+
+// Query returns a query builder for LiveEvent.
+func (c *LiveEventClient) Query() *LiveEventQuery {
+	return &LiveEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLiveEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LiveEvent entity by its id.
+func (c *LiveEventClient) Get(ctx context.Context, id string) (*LiveEvent, error) {
+	return c.Query().Where(liveevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LiveEventClient) GetX(ctx context.Context, id string) *LiveEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LiveEventClient) Hooks() []Hook {
+	return c.hooks.LiveEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *LiveEventClient) Interceptors() []Interceptor {
+	return c.inters.LiveEvent
+}
+
+func (c *LiveEventClient) mutate(ctx context.Context, m *LiveEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LiveEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LiveEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LiveEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LiveEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown LiveEvent mutation op: %q", m.Op())
+	}
+}
+
+// MarketFillClient is a client for the MarketFill schema.
+type MarketFillClient struct {
+	config
+}
+
+// NewMarketFillClient returns a client for the MarketFill from the given config.
+func NewMarketFillClient(c config) *MarketFillClient {
+	return &MarketFillClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `marketfill.Hooks(f(g(h())))`.
+func (c *MarketFillClient) Use(hooks ...Hook) {
+	c.hooks.MarketFill = append(c.hooks.MarketFill, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `marketfill.Intercept(f(g(h())))`.
+func (c *MarketFillClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MarketFill = append(c.inters.MarketFill, interceptors...)
+}
+
+// Create returns a builder for creating a MarketFill entity.
+func (c *MarketFillClient) Create() *MarketFillCreate {
+	mutation := newMarketFillMutation(c.config, OpCreate)
+	return &MarketFillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MarketFill entities.
+func (c *MarketFillClient) CreateBulk(builders ...*MarketFillCreate) *MarketFillCreateBulk {
+	return &MarketFillCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MarketFillClient) MapCreateBulk(slice any, setFunc func(*MarketFillCreate, int)) *MarketFillCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MarketFillCreateBulk{err: fmt.Errorf("calling to MarketFillClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MarketFillCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MarketFillCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MarketFill.
+func (c *MarketFillClient) Update() *MarketFillUpdate {
+	mutation := newMarketFillMutation(c.config, OpUpdate)
+	return &MarketFillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MarketFillClient) UpdateOne(mf *MarketFill) *MarketFillUpdateOne {
+	mutation := newMarketFillMutation(c.config, OpUpdateOne, withMarketFill(mf))
+	return &MarketFillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MarketFillClient) UpdateOneID(id int64) *MarketFillUpdateOne {
+	mutation := newMarketFillMutation(c.config, OpUpdateOne, withMarketFillID(id))
+	return &MarketFillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MarketFill.
+func (c *MarketFillClient) Delete() *MarketFillDelete {
+	mutation := newMarketFillMutation(c.config, OpDelete)
+	return &MarketFillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MarketFillClient) DeleteOne(mf *MarketFill) *MarketFillDeleteOne {
+	return c.DeleteOneID(mf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MarketFillClient) DeleteOneID(id int64) *MarketFillDeleteOne {
+	builder := c.Delete().Where(marketfill.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MarketFillDeleteOne{builder}
+}
+
+// Query returns a query builder for MarketFill.
+func (c *MarketFillClient) Query() *MarketFillQuery {
+	return &MarketFillQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMarketFill},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MarketFill entity by its id.
+func (c *MarketFillClient) Get(ctx context.Context, id int64) (*MarketFill, error) {
+	return c.Query().Where(marketfill.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MarketFillClient) GetX(ctx context.Context, id int64) *MarketFill {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MarketFillClient) Hooks() []Hook {
+	return c.hooks.MarketFill
+}
+
+// Interceptors returns the client interceptors.
+func (c *MarketFillClient) Interceptors() []Interceptor {
+	return c.inters.MarketFill
+}
+
+func (c *MarketFillClient) mutate(ctx context.Context, m *MarketFillMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MarketFillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MarketFillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MarketFillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MarketFillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown MarketFill mutation op: %q", m.Op())
+	}
+}
+
+// MarketOrderClient is a client for the MarketOrder schema.
+type MarketOrderClient struct {
+	config
+}
+
+// NewMarketOrderClient returns a client for the MarketOrder from the given config.
+func NewMarketOrderClient(c config) *MarketOrderClient {
+	return &MarketOrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `marketorder.Hooks(f(g(h())))`.
+func (c *MarketOrderClient) Use(hooks ...Hook) {
+	c.hooks.MarketOrder = append(c.hooks.MarketOrder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `marketorder.Intercept(f(g(h())))`.
+func (c *MarketOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MarketOrder = append(c.inters.MarketOrder, interceptors...)
+}
+
+// Create returns a builder for creating a MarketOrder entity.
+func (c *MarketOrderClient) Create() *MarketOrderCreate {
+	mutation := newMarketOrderMutation(c.config, OpCreate)
+	return &MarketOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MarketOrder entities.
+func (c *MarketOrderClient) CreateBulk(builders ...*MarketOrderCreate) *MarketOrderCreateBulk {
+	return &MarketOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MarketOrderClient) MapCreateBulk(slice any, setFunc func(*MarketOrderCreate, int)) *MarketOrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MarketOrderCreateBulk{err: fmt.Errorf("calling to MarketOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MarketOrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MarketOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MarketOrder.
+func (c *MarketOrderClient) Update() *MarketOrderUpdate {
+	mutation := newMarketOrderMutation(c.config, OpUpdate)
+	return &MarketOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MarketOrderClient) UpdateOne(mo *MarketOrder) *MarketOrderUpdateOne {
+	mutation := newMarketOrderMutation(c.config, OpUpdateOne, withMarketOrder(mo))
+	return &MarketOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MarketOrderClient) UpdateOneID(id int64) *MarketOrderUpdateOne {
+	mutation := newMarketOrderMutation(c.config, OpUpdateOne, withMarketOrderID(id))
+	return &MarketOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MarketOrder.
+func (c *MarketOrderClient) Delete() *MarketOrderDelete {
+	mutation := newMarketOrderMutation(c.config, OpDelete)
+	return &MarketOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MarketOrderClient) DeleteOne(mo *MarketOrder) *MarketOrderDeleteOne {
+	return c.DeleteOneID(mo.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MarketOrderClient) DeleteOneID(id int64) *MarketOrderDeleteOne {
+	builder := c.Delete().Where(marketorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MarketOrderDeleteOne{builder}
+}
+
+// Query returns a query builder for MarketOrder.
+func (c *MarketOrderClient) Query() *MarketOrderQuery {
+	return &MarketOrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMarketOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MarketOrder entity by its id.
+func (c *MarketOrderClient) Get(ctx context.Context, id int64) (*MarketOrder, error) {
+	return c.Query().Where(marketorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MarketOrderClient) GetX(ctx context.Context, id int64) *MarketOrder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a MarketOrder.
+func (c *MarketOrderClient) QueryPlayer(mo *MarketOrder) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(marketorder.Table, marketorder.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, marketorder.PlayerTable, marketorder.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(mo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MarketOrderClient) Hooks() []Hook {
+	return c.hooks.MarketOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *MarketOrderClient) Interceptors() []Interceptor {
+	return c.inters.MarketOrder
+}
+
+func (c *MarketOrderClient) mutate(ctx context.Context, m *MarketOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MarketOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MarketOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MarketOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MarketOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown MarketOrder mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(oe *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(oe))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id int64) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(oe *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(oe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id int64) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id int64) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id int64) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
+// PlayerClient is a client for the Player schema.
+type PlayerClient struct {
+	config
+}
+
+// NewPlayerClient returns a client for the Player from the given config.
+func NewPlayerClient(c config) *PlayerClient {
+	return &PlayerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `player.Hooks(f(g(h())))`.
+func (c *PlayerClient) Use(hooks ...Hook) {
+	c.hooks.Player = append(c.hooks.Player, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `player.Intercept(f(g(h())))`.
+func (c *PlayerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Player = append(c.inters.Player, interceptors...)
+}
+
+// Create returns a builder for creating a Player entity.
+func (c *PlayerClient) Create() *PlayerCreate {
+	mutation := newPlayerMutation(c.config, OpCreate)
+	return &PlayerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Player entities.
+func (c *PlayerClient) CreateBulk(builders ...*PlayerCreate) *PlayerCreateBulk {
+	return &PlayerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlayerClient) MapCreateBulk(slice any, setFunc func(*PlayerCreate, int)) *PlayerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlayerCreateBulk{err: fmt.Errorf("calling to PlayerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlayerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlayerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Player.
+func (c *PlayerClient) Update() *PlayerUpdate {
+	mutation := newPlayerMutation(c.config, OpUpdate)
+	return &PlayerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayerClient) UpdateOne(pl *Player) *PlayerUpdateOne {
+	mutation := newPlayerMutation(c.config, OpUpdateOne, withPlayer(pl))
+	return &PlayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlayerClient) UpdateOneID(id string) *PlayerUpdateOne {
+	mutation := newPlayerMutation(c.config, OpUpdateOne, withPlayerID(id))
+	return &PlayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Player.
+func (c *PlayerClient) Delete() *PlayerDelete {
+	mutation := newPlayerMutation(c.config, OpDelete)
+	return &PlayerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlayerClient) DeleteOne(pl *Player) *PlayerDeleteOne {
+	return c.DeleteOneID(pl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlayerClient) DeleteOneID(id string) *PlayerDeleteOne {
+	builder := c.Delete().Where(player.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlayerDeleteOne{builder}
+}
+
+// Query returns a query builder for Player.
+func (c *PlayerClient) Query() *PlayerQuery {
+	return &PlayerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlayer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Player entity by its id.
+func (c *PlayerClient) Get(ctx context.Context, id string) (*Player, error) {
+	return c.Query().Where(player.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlayerClient) GetX(ctx context.Context, id string) *Player {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccounts queries the accounts edge of a Player.
+func (c *PlayerClient) QueryAccounts(pl *Player) *PlayerAccountQuery {
+	query := (&PlayerAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(playeraccount.Table, playeraccount.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.AccountsTable, player.AccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInventory queries the inventory edge of a Player.
+func (c *PlayerClient) QueryInventory(pl *Player) *InventoryItemQuery {
+	query := (&InventoryItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(inventoryitem.Table, inventoryitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.InventoryTable, player.InventoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWallets queries the wallets edge of a Player.
+func (c *PlayerClient) QueryWallets(pl *Player) *WalletQuery {
+	query := (&WalletClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.WalletsTable, player.WalletsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLedger queries the ledger edge of a Player.
+func (c *PlayerClient) QueryLedger(pl *Player) *LedgerEntryQuery {
+	query := (&LedgerEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(ledgerentry.Table, ledgerentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.LedgerTable, player.LedgerColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProposedTrades queries the proposed_trades edge of a Player.
+func (c *PlayerClient) QueryProposedTrades(pl *Player) *TradeQuery {
+	query := (&TradeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(trade.Table, trade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.ProposedTradesTable, player.ProposedTradesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceivedTrades queries the received_trades edge of a Player.
+func (c *PlayerClient) QueryReceivedTrades(pl *Player) *TradeQuery {
+	query := (&TradeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(trade.Table, trade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.ReceivedTradesTable, player.ReceivedTradesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMarketOrders queries the market_orders edge of a Player.
+func (c *PlayerClient) QueryMarketOrders(pl *Player) *MarketOrderQuery {
+	query := (&MarketOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(marketorder.Table, marketorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.MarketOrdersTable, player.MarketOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemInstances queries the item_instances edge of a Player.
+func (c *PlayerClient) QueryItemInstances(pl *Player) *ItemInstanceQuery {
+	query := (&ItemInstanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(iteminstance.Table, iteminstance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.ItemInstancesTable, player.ItemInstancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlayerClient) Hooks() []Hook {
+	return c.hooks.Player
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlayerClient) Interceptors() []Interceptor {
+	return c.inters.Player
+}
+
+func (c *PlayerClient) mutate(ctx context.Context, m *PlayerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlayerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlayerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlayerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlayerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown Player mutation op: %q", m.Op())
+	}
+}
+
+// PlayerAccountClient is a client for the PlayerAccount schema.
+type PlayerAccountClient struct {
+	config
+}
+
+// NewPlayerAccountClient returns a client for the PlayerAccount from the given config.
+func NewPlayerAccountClient(c config) *PlayerAccountClient {
+	return &PlayerAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playeraccount.Hooks(f(g(h())))`.
+func (c *PlayerAccountClient) Use(hooks ...Hook) {
+	c.hooks.PlayerAccount = append(c.hooks.PlayerAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playeraccount.Intercept(f(g(h())))`.
+func (c *PlayerAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlayerAccount = append(c.inters.PlayerAccount, interceptors...)
+}
+
+// Create returns a builder for creating a PlayerAccount entity.
+func (c *PlayerAccountClient) Create() *PlayerAccountCreate {
+	mutation := newPlayerAccountMutation(c.config, OpCreate)
+	return &PlayerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlayerAccount entities.
+func (c *PlayerAccountClient) CreateBulk(builders ...*PlayerAccountCreate) *PlayerAccountCreateBulk {
+	return &PlayerAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlayerAccountClient) MapCreateBulk(slice any, setFunc func(*PlayerAccountCreate, int)) *PlayerAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlayerAccountCreateBulk{err: fmt.Errorf("calling to PlayerAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlayerAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlayerAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlayerAccount.
+func (c *PlayerAccountClient) Update() *PlayerAccountUpdate {
+	mutation := newPlayerAccountMutation(c.config, OpUpdate)
+	return &PlayerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayerAccountClient) UpdateOne(pa *PlayerAccount) *PlayerAccountUpdateOne {
+	mutation := newPlayerAccountMutation(c.config, OpUpdateOne, withPlayerAccount(pa))
+	return &PlayerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlayerAccountClient) UpdateOneID(id int64) *PlayerAccountUpdateOne {
+	mutation := newPlayerAccountMutation(c.config, OpUpdateOne, withPlayerAccountID(id))
+	return &PlayerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlayerAccount.
+func (c *PlayerAccountClient) Delete() *PlayerAccountDelete {
+	mutation := newPlayerAccountMutation(c.config, OpDelete)
+	return &PlayerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlayerAccountClient) DeleteOne(pa *PlayerAccount) *PlayerAccountDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlayerAccountClient) DeleteOneID(id int64) *PlayerAccountDeleteOne {
+	builder := c.Delete().Where(playeraccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlayerAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for PlayerAccount.
+func (c *PlayerAccountClient) Query() *PlayerAccountQuery {
+	return &PlayerAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlayerAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlayerAccount entity by its id.
+func (c *PlayerAccountClient) Get(ctx context.Context, id int64) (*PlayerAccount, error) {
+	return c.Query().Where(playeraccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlayerAccountClient) GetX(ctx context.Context, id int64) *PlayerAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a PlayerAccount.
+func (c *PlayerAccountClient) QueryPlayer(pa *PlayerAccount) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playeraccount.Table, playeraccount.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playeraccount.PlayerTable, playeraccount.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlayerAccountClient) Hooks() []Hook {
+	return c.hooks.PlayerAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlayerAccountClient) Interceptors() []Interceptor {
+	return c.inters.PlayerAccount
+}
+
+func (c *PlayerAccountClient) mutate(ctx context.Context, m *PlayerAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlayerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlayerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlayerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlayerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown PlayerAccount mutation op: %q", m.Op())
+	}
+}
+
+// PlayerEventClient is a client for the PlayerEvent schema.
+type PlayerEventClient struct {
+	config
+}
+
+// NewPlayerEventClient returns a client for the PlayerEvent from the given config.
+func NewPlayerEventClient(c config) *PlayerEventClient {
+	return &PlayerEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playerevent.Hooks(f(g(h())))`.
+func (c *PlayerEventClient) Use(hooks ...Hook) {
+	c.hooks.PlayerEvent = append(c.hooks.PlayerEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playerevent.Intercept(f(g(h())))`.
+func (c *PlayerEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlayerEvent = append(c.inters.PlayerEvent, interceptors...)
+}
+
+// Create returns a builder for creating a PlayerEvent entity.
+func (c *PlayerEventClient) Create() *PlayerEventCreate {
+	mutation := newPlayerEventMutation(c.config, OpCreate)
+	return &PlayerEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlayerEvent entities.
+func (c *PlayerEventClient) CreateBulk(builders ...*PlayerEventCreate) *PlayerEventCreateBulk {
+	return &PlayerEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlayerEventClient) MapCreateBulk(slice any, setFunc func(*PlayerEventCreate, int)) *PlayerEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlayerEventCreateBulk{err: fmt.Errorf("calling to PlayerEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlayerEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlayerEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlayerEvent.
+func (c *PlayerEventClient) Update() *PlayerEventUpdate {
+	mutation := newPlayerEventMutation(c.config, OpUpdate)
+	return &PlayerEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlayerEventClient) UpdateOne(pe *PlayerEvent) *PlayerEventUpdateOne {
+	mutation := newPlayerEventMutation(c.config, OpUpdateOne, withPlayerEvent(pe))
+	return &PlayerEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlayerEventClient) UpdateOneID(id int64) *PlayerEventUpdateOne {
+	mutation := newPlayerEventMutation(c.config, OpUpdateOne, withPlayerEventID(id))
+	return &PlayerEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlayerEvent.
+func (c *PlayerEventClient) Delete() *PlayerEventDelete {
+	mutation := newPlayerEventMutation(c.config, OpDelete)
+	return &PlayerEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlayerEventClient) DeleteOne(pe *PlayerEvent) *PlayerEventDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlayerEventClient) DeleteOneID(id int64) *PlayerEventDeleteOne {
+	builder := c.Delete().Where(playerevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlayerEventDeleteOne{builder}
+}
+
+// Query returns a query builder for PlayerEvent.
+func (c *PlayerEventClient) Query() *PlayerEventQuery {
+	return &PlayerEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlayerEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlayerEvent entity by its id.
+func (c *PlayerEventClient) Get(ctx context.Context, id int64) (*PlayerEvent, error) {
+	return c.Query().Where(playerevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlayerEventClient) GetX(ctx context.Context, id int64) *PlayerEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PlayerEventClient) Hooks() []Hook {
+	return c.hooks.PlayerEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlayerEventClient) Interceptors() []Interceptor {
+	return c.inters.PlayerEvent
+}
+
+func (c *PlayerEventClient) mutate(ctx context.Context, m *PlayerEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlayerEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlayerEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlayerEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlayerEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown PlayerEvent mutation op: %q", m.Op())
+	}
+}
+
+// QuestClient is a client for the Quest schema.
+type QuestClient struct {
+	config
+}
+
+// NewQuestClient returns a client for the Quest from the given config.
+func NewQuestClient(c config) *QuestClient {
+	return &QuestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quest.Hooks(f(g(h())))`.
+func (c *QuestClient) Use(hooks ...Hook) {
+	c.hooks.Quest = append(c.hooks.Quest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quest.Intercept(f(g(h())))`.
+func (c *QuestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Quest = append(c.inters.Quest, interceptors...)
+}
+
+// Create returns a builder for creating a Quest entity.
+func (c *QuestClient) Create() *QuestCreate {
+	mutation := newQuestMutation(c.config, OpCreate)
+	return &QuestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Quest entities.
+func (c *QuestClient) CreateBulk(builders ...*QuestCreate) *QuestCreateBulk {
+	return &QuestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestClient) MapCreateBulk(slice any, setFunc func(*QuestCreate, int)) *QuestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestCreateBulk{err: fmt.Errorf("calling to QuestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Quest.
+func (c *QuestClient) Update() *QuestUpdate {
+	mutation := newQuestMutation(c.config, OpUpdate)
+	return &QuestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestClient) UpdateOne(q *Quest) *QuestUpdateOne {
+	mutation := newQuestMutation(c.config, OpUpdateOne, withQuest(q))
+	return &QuestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestClient) UpdateOneID(id string) *QuestUpdateOne {
+	mutation := newQuestMutation(c.config, OpUpdateOne, withQuestID(id))
+	return &QuestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Quest.
+func (c *QuestClient) Delete() *QuestDelete {
+	mutation := newQuestMutation(c.config, OpDelete)
+	return &QuestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestClient) DeleteOne(q *Quest) *QuestDeleteOne {
+	return c.DeleteOneID(q.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestClient) DeleteOneID(id string) *QuestDeleteOne {
+	builder := c.Delete().Where(quest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestDeleteOne{builder}
+}
+
+// Query returns a query builder for Quest.
+func (c *QuestClient) Query() *QuestQuery {
+	return &QuestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Quest entity by its id.
+func (c *QuestClient) Get(ctx context.Context, id string) (*Quest, error) {
+	return c.Query().Where(quest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestClient) GetX(ctx context.Context, id string) *Quest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QuestClient) Hooks() []Hook {
+	return c.hooks.Quest
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestClient) Interceptors() []Interceptor {
+	return c.inters.Quest
+}
+
+func (c *QuestClient) mutate(ctx context.Context, m *QuestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown Quest mutation op: %q", m.Op())
+	}
+}
+
+// QuestProgressClient is a client for the QuestProgress schema.
+type QuestProgressClient struct {
+	config
+}
+
+// NewQuestProgressClient returns a client for the QuestProgress from the given config.
+func NewQuestProgressClient(c config) *QuestProgressClient {
+	return &QuestProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `questprogress.Hooks(f(g(h())))`.
+func (c *QuestProgressClient) Use(hooks ...Hook) {
+	c.hooks.QuestProgress = append(c.hooks.QuestProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `questprogress.Intercept(f(g(h())))`.
+func (c *QuestProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestProgress = append(c.inters.QuestProgress, interceptors...)
+}
+
+// Create returns a builder for creating a QuestProgress entity.
+func (c *QuestProgressClient) Create() *QuestProgressCreate {
+	mutation := newQuestProgressMutation(c.config, OpCreate)
+	return &QuestProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuestProgress entities.
+func (c *QuestProgressClient) CreateBulk(builders ...*QuestProgressCreate) *QuestProgressCreateBulk {
+	return &QuestProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestProgressClient) MapCreateBulk(slice any, setFunc func(*QuestProgressCreate, int)) *QuestProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestProgressCreateBulk{err: fmt.Errorf("calling to QuestProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuestProgress.
+func (c *QuestProgressClient) Update() *QuestProgressUpdate {
+	mutation := newQuestProgressMutation(c.config, OpUpdate)
+	return &QuestProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestProgressClient) UpdateOne(qp *QuestProgress) *QuestProgressUpdateOne {
+	mutation := newQuestProgressMutation(c.config, OpUpdateOne, withQuestProgress(qp))
+	return &QuestProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestProgressClient) UpdateOneID(id int64) *QuestProgressUpdateOne {
+	mutation := newQuestProgressMutation(c.config, OpUpdateOne, withQuestProgressID(id))
+	return &QuestProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuestProgress.
+func (c *QuestProgressClient) Delete() *QuestProgressDelete {
+	mutation := newQuestProgressMutation(c.config, OpDelete)
+	return &QuestProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestProgressClient) DeleteOne(qp *QuestProgress) *QuestProgressDeleteOne {
+	return c.DeleteOneID(qp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestProgressClient) DeleteOneID(id int64) *QuestProgressDeleteOne {
+	builder := c.Delete().Where(questprogress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestProgressDeleteOne{builder}
+}
+
+// Query returns a query builder for QuestProgress.
+func (c *QuestProgressClient) Query() *QuestProgressQuery {
+	return &QuestProgressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestProgress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuestProgress entity by its id.
+func (c *QuestProgressClient) Get(ctx context.Context, id int64) (*QuestProgress, error) {
+	return c.Query().Where(questprogress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestProgressClient) GetX(ctx context.Context, id int64) *QuestProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QuestProgressClient) Hooks() []Hook {
+	return c.hooks.QuestProgress
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestProgressClient) Interceptors() []Interceptor {
+	return c.inters.QuestProgress
+}
+
+func (c *QuestProgressClient) mutate(ctx context.Context, m *QuestProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown QuestProgress mutation op: %q", m.Op())
+	}
+}
+
+// TradeClient is a client for the Trade schema.
+type TradeClient struct {
+	config
+}
+
+// NewTradeClient returns a client for the Trade from the given config.
+func NewTradeClient(c config) *TradeClient {
+	return &TradeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `trade.Hooks(f(g(h())))`.
+func (c *TradeClient) Use(hooks ...Hook) {
+	c.hooks.Trade = append(c.hooks.Trade, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `trade.Intercept(f(g(h())))`.
+func (c *TradeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Trade = append(c.inters.Trade, interceptors...)
+}
+
+// Create returns a builder for creating a Trade entity.
+func (c *TradeClient) Create() *TradeCreate {
+	mutation := newTradeMutation(c.config, OpCreate)
+	return &TradeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Trade entities.
+func (c *TradeClient) CreateBulk(builders ...*TradeCreate) *TradeCreateBulk {
+	return &TradeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TradeClient) MapCreateBulk(slice any, setFunc func(*TradeCreate, int)) *TradeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TradeCreateBulk{err: fmt.Errorf("calling to TradeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TradeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TradeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Trade.
+func (c *TradeClient) Update() *TradeUpdate {
+	mutation := newTradeMutation(c.config, OpUpdate)
+	return &TradeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TradeClient) UpdateOne(t *Trade) *TradeUpdateOne {
+	mutation := newTradeMutation(c.config, OpUpdateOne, withTrade(t))
+	return &TradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TradeClient) UpdateOneID(id int64) *TradeUpdateOne {
+	mutation := newTradeMutation(c.config, OpUpdateOne, withTradeID(id))
+	return &TradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Trade.
+func (c *TradeClient) Delete() *TradeDelete {
+	mutation := newTradeMutation(c.config, OpDelete)
+	return &TradeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TradeClient) DeleteOne(t *Trade) *TradeDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TradeClient) DeleteOneID(id int64) *TradeDeleteOne {
+	builder := c.Delete().Where(trade.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TradeDeleteOne{builder}
+}
+
+// Query returns a query builder for Trade.
+func (c *TradeClient) Query() *TradeQuery {
+	return &TradeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTrade},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Trade entity by its id.
+func (c *TradeClient) Get(ctx context.Context, id int64) (*Trade, error) {
+	return c.Query().Where(trade.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TradeClient) GetX(ctx context.Context, id int64) *Trade {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProposer queries the proposer edge of a Trade.
+func (c *TradeClient) QueryProposer(t *Trade) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(trade.Table, trade.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trade.ProposerTable, trade.ProposerColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipient queries the recipient edge of a Trade.
+func (c *TradeClient) QueryRecipient(t *Trade) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(trade.Table, trade.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trade.RecipientTable, trade.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TradeClient) Hooks() []Hook {
+	return c.hooks.Trade
+}
+
+// Interceptors returns the client interceptors.
+func (c *TradeClient) Interceptors() []Interceptor {
+	return c.inters.Trade
+}
+
+func (c *TradeClient) mutate(ctx context.Context, m *TradeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TradeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TradeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TradeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown Trade mutation op: %q", m.Op())
+	}
+}
+
+// WalletClient is a client for the Wallet schema.
+type WalletClient struct {
+	config
+}
+
+// NewWalletClient returns a client for the Wallet from the given config.
+func NewWalletClient(c config) *WalletClient {
+	return &WalletClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wallet.Hooks(f(g(h())))`.
+func (c *WalletClient) Use(hooks ...Hook) {
+	c.hooks.Wallet = append(c.hooks.Wallet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wallet.Intercept(f(g(h())))`.
+func (c *WalletClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Wallet = append(c.inters.Wallet, interceptors...)
+}
+
+// Create returns a builder for creating a Wallet entity.
+func (c *WalletClient) Create() *WalletCreate {
+	mutation := newWalletMutation(c.config, OpCreate)
+	return &WalletCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Wallet entities.
+func (c *WalletClient) CreateBulk(builders ...*WalletCreate) *WalletCreateBulk {
+	return &WalletCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WalletClient) MapCreateBulk(slice any, setFunc func(*WalletCreate, int)) *WalletCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WalletCreateBulk{err: fmt.Errorf("calling to WalletClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WalletCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WalletCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Wallet.
+func (c *WalletClient) Update() *WalletUpdate {
+	mutation := newWalletMutation(c.config, OpUpdate)
+	return &WalletUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WalletClient) UpdateOne(w *Wallet) *WalletUpdateOne {
+	mutation := newWalletMutation(c.config, OpUpdateOne, withWallet(w))
+	return &WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WalletClient) UpdateOneID(id int64) *WalletUpdateOne {
+	mutation := newWalletMutation(c.config, OpUpdateOne, withWalletID(id))
+	return &WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Wallet.
+func (c *WalletClient) Delete() *WalletDelete {
+	mutation := newWalletMutation(c.config, OpDelete)
+	return &WalletDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WalletClient) DeleteOne(w *Wallet) *WalletDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WalletClient) DeleteOneID(id int64) *WalletDeleteOne {
+	builder := c.Delete().Where(wallet.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WalletDeleteOne{builder}
+}
+
+// Query returns a query builder for Wallet.
+func (c *WalletClient) Query() *WalletQuery {
+	return &WalletQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWallet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Wallet entity by its id.
+func (c *WalletClient) Get(ctx context.Context, id int64) (*Wallet, error) {
+	return c.Query().Where(wallet.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WalletClient) GetX(ctx context.Context, id int64) *Wallet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a Wallet.
+func (c *WalletClient) QueryPlayer(w *Wallet) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wallet.PlayerTable, wallet.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WalletClient) Hooks() []Hook {
+	return c.hooks.Wallet
+}
+
+// Interceptors returns the client interceptors.
+func (c *WalletClient) Interceptors() []Interceptor {
+	return c.inters.Wallet
+}
+
+func (c *WalletClient) mutate(ctx context.Context, m *WalletMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WalletCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WalletUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WalletDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown Wallet mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int64) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int64) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int64) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int64) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscription queries the subscription edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QuerySubscription(wd *WebhookDelivery) *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.SubscriptionTable, webhookdelivery.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookSubscriptionClient is a client for the WebhookSubscription schema.
+type WebhookSubscriptionClient struct {
+	config
+}
+
+// NewWebhookSubscriptionClient returns a client for the WebhookSubscription from the given config.
+func NewWebhookSubscriptionClient(c config) *WebhookSubscriptionClient {
+	return &WebhookSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhooksubscription.Hooks(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebhookSubscription = append(c.hooks.WebhookSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhooksubscription.Intercept(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookSubscription = append(c.inters.WebhookSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookSubscription entity.
+func (c *WebhookSubscriptionClient) Create() *WebhookSubscriptionCreate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpCreate)
+	return &WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookSubscription entities.
+func (c *WebhookSubscriptionClient) CreateBulk(builders ...*WebhookSubscriptionCreate) *WebhookSubscriptionCreateBulk {
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookSubscriptionClient) MapCreateBulk(slice any, setFunc func(*WebhookSubscriptionCreate, int)) *WebhookSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookSubscriptionCreateBulk{err: fmt.Errorf("calling to WebhookSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Update() *WebhookSubscriptionUpdate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdate)
+	return &WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookSubscriptionClient) UpdateOne(ws *WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscription(ws))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookSubscriptionClient) UpdateOneID(id int64) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscriptionID(id))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Delete() *WebhookSubscriptionDelete {
+	mutation := newWebhookSubscriptionMutation(c.config, OpDelete)
+	return &WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookSubscriptionClient) DeleteOne(ws *WebhookSubscription) *WebhookSubscriptionDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookSubscriptionClient) DeleteOneID(id int64) *WebhookSubscriptionDeleteOne {
+	builder := c.Delete().Where(webhooksubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Query() *WebhookSubscriptionQuery {
+	return &WebhookSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookSubscription entity by its id.
+func (c *WebhookSubscriptionClient) Get(ctx context.Context, id int64) (*WebhookSubscription, error) {
+	return c.Query().Where(webhooksubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookSubscriptionClient) GetX(ctx context.Context, id int64) *WebhookSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a WebhookSubscription.
+func (c *WebhookSubscriptionClient) QueryDeliveries(ws *WebhookSubscription) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ws.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhooksubscription.Table, webhooksubscription.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhooksubscription.DeliveriesTable, webhooksubscription.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookSubscriptionClient) Hooks() []Hook {
+	return c.hooks.WebhookSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.WebhookSubscription
+}
+
+func (c *WebhookSubscriptionClient) mutate(ctx context.Context, m *WebhookSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entc: unknown WebhookSubscription mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		CatalogSnapshot, CatalogVersion, Changeset, ChangesetChange, InventoryItem,
+		Item, ItemInstance, ItemInstanceHistory, ItemTranslation, JobRun, JobTask,
+		Leaderboard, LeaderboardSnapshot, LedgerEntry, LiveEvent, MarketFill,
+		MarketOrder, OutboxEvent, Player, PlayerAccount, PlayerEvent, Quest,
+		QuestProgress, Trade, Wallet, WebhookDelivery, WebhookSubscription []ent.Hook
+	}
+	inters struct {
+		CatalogSnapshot, CatalogVersion, Changeset, ChangesetChange, InventoryItem,
+		Item, ItemInstance, ItemInstanceHistory, ItemTranslation, JobRun, JobTask,
+		Leaderboard, LeaderboardSnapshot, LedgerEntry, LiveEvent, MarketFill,
+		MarketOrder, OutboxEvent, Player, PlayerAccount, PlayerEvent, Quest,
+		QuestProgress, Trade, Wallet, WebhookDelivery,
+		WebhookSubscription []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -12,7 +12,33 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
 	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboard"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboardsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/outboxevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhookdelivery"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhooksubscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +99,33 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			item.Table: item.ValidColumn,
+			catalogsnapshot.Table:     catalogsnapshot.ValidColumn,
+			catalogversion.Table:      catalogversion.ValidColumn,
+			changeset.Table:           changeset.ValidColumn,
+			changesetchange.Table:     changesetchange.ValidColumn,
+			inventoryitem.Table:       inventoryitem.ValidColumn,
+			item.Table:                item.ValidColumn,
+			iteminstance.Table:        iteminstance.ValidColumn,
+			iteminstancehistory.Table: iteminstancehistory.ValidColumn,
+			itemtranslation.Table:     itemtranslation.ValidColumn,
+			jobrun.Table:              jobrun.ValidColumn,
+			jobtask.Table:             jobtask.ValidColumn,
+			leaderboard.Table:         leaderboard.ValidColumn,
+			leaderboardsnapshot.Table: leaderboardsnapshot.ValidColumn,
+			ledgerentry.Table:         ledgerentry.ValidColumn,
+			liveevent.Table:           liveevent.ValidColumn,
+			marketfill.Table:          marketfill.ValidColumn,
+			marketorder.Table:         marketorder.ValidColumn,
+			outboxevent.Table:         outboxevent.ValidColumn,
+			player.Table:              player.ValidColumn,
+			playeraccount.Table:       playeraccount.ValidColumn,
+			playerevent.Table:         playerevent.ValidColumn,
+			quest.Table:               quest.ValidColumn,
+			questprogress.Table:       questprogress.ValidColumn,
+			trade.Table:               trade.ValidColumn,
+			wallet.Table:              wallet.ValidColumn,
+			webhookdelivery.Table:     webhookdelivery.ValidColumn,
+			webhooksubscription.Table: webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -9,6 +9,66 @@ import (
 	"github.com/nhatquangsin/game-service/infra/repo/entc"
 )
 
+// The CatalogSnapshotFunc type is an adapter to allow the use of ordinary
+// function as CatalogSnapshot mutator.
+type CatalogSnapshotFunc func(context.Context, *entc.CatalogSnapshotMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CatalogSnapshotFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.CatalogSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.CatalogSnapshotMutation", m)
+}
+
+// The CatalogVersionFunc type is an adapter to allow the use of ordinary
+// function as CatalogVersion mutator.
+type CatalogVersionFunc func(context.Context, *entc.CatalogVersionMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CatalogVersionFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.CatalogVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.CatalogVersionMutation", m)
+}
+
+// The ChangesetFunc type is an adapter to allow the use of ordinary
+// function as Changeset mutator.
+type ChangesetFunc func(context.Context, *entc.ChangesetMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChangesetFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.ChangesetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.ChangesetMutation", m)
+}
+
+// The ChangesetChangeFunc type is an adapter to allow the use of ordinary
+// function as ChangesetChange mutator.
+type ChangesetChangeFunc func(context.Context, *entc.ChangesetChangeMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChangesetChangeFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.ChangesetChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.ChangesetChangeMutation", m)
+}
+
+// The InventoryItemFunc type is an adapter to allow the use of ordinary
+// function as InventoryItem mutator.
+type InventoryItemFunc func(context.Context, *entc.InventoryItemMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InventoryItemFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.InventoryItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.InventoryItemMutation", m)
+}
+
 // The ItemFunc type is an adapter to allow the use of ordinary
 // function as Item mutator.
 type ItemFunc func(context.Context, *entc.ItemMutation) (entc.Value, error)
@@ -21,6 +81,258 @@ func (f ItemFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.ItemMutation", m)
 }
 
+// The ItemInstanceFunc type is an adapter to allow the use of ordinary
+// function as ItemInstance mutator.
+type ItemInstanceFunc func(context.Context, *entc.ItemInstanceMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemInstanceFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.ItemInstanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.ItemInstanceMutation", m)
+}
+
+// The ItemInstanceHistoryFunc type is an adapter to allow the use of ordinary
+// function as ItemInstanceHistory mutator.
+type ItemInstanceHistoryFunc func(context.Context, *entc.ItemInstanceHistoryMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemInstanceHistoryFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.ItemInstanceHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.ItemInstanceHistoryMutation", m)
+}
+
+// The ItemTranslationFunc type is an adapter to allow the use of ordinary
+// function as ItemTranslation mutator.
+type ItemTranslationFunc func(context.Context, *entc.ItemTranslationMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemTranslationFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.ItemTranslationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.ItemTranslationMutation", m)
+}
+
+// The JobRunFunc type is an adapter to allow the use of ordinary
+// function as JobRun mutator.
+type JobRunFunc func(context.Context, *entc.JobRunMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobRunFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.JobRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.JobRunMutation", m)
+}
+
+// The JobTaskFunc type is an adapter to allow the use of ordinary
+// function as JobTask mutator.
+type JobTaskFunc func(context.Context, *entc.JobTaskMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobTaskFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.JobTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.JobTaskMutation", m)
+}
+
+// The LeaderboardFunc type is an adapter to allow the use of ordinary
+// function as Leaderboard mutator.
+type LeaderboardFunc func(context.Context, *entc.LeaderboardMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaderboardFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.LeaderboardMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.LeaderboardMutation", m)
+}
+
+// The LeaderboardSnapshotFunc type is an adapter to allow the use of ordinary
+// function as LeaderboardSnapshot mutator.
+type LeaderboardSnapshotFunc func(context.Context, *entc.LeaderboardSnapshotMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaderboardSnapshotFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.LeaderboardSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.LeaderboardSnapshotMutation", m)
+}
+
+// The LedgerEntryFunc type is an adapter to allow the use of ordinary
+// function as LedgerEntry mutator.
+type LedgerEntryFunc func(context.Context, *entc.LedgerEntryMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LedgerEntryFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.LedgerEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.LedgerEntryMutation", m)
+}
+
+// The LiveEventFunc type is an adapter to allow the use of ordinary
+// function as LiveEvent mutator.
+type LiveEventFunc func(context.Context, *entc.LiveEventMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LiveEventFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.LiveEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.LiveEventMutation", m)
+}
+
+// The MarketFillFunc type is an adapter to allow the use of ordinary
+// function as MarketFill mutator.
+type MarketFillFunc func(context.Context, *entc.MarketFillMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MarketFillFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.MarketFillMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.MarketFillMutation", m)
+}
+
+// The MarketOrderFunc type is an adapter to allow the use of ordinary
+// function as MarketOrder mutator.
+type MarketOrderFunc func(context.Context, *entc.MarketOrderMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MarketOrderFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.MarketOrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.MarketOrderMutation", m)
+}
+
+// The OutboxEventFunc type is an adapter to allow the use of ordinary
+// function as OutboxEvent mutator.
+type OutboxEventFunc func(context.Context, *entc.OutboxEventMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxEventFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.OutboxEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.OutboxEventMutation", m)
+}
+
+// The PlayerFunc type is an adapter to allow the use of ordinary
+// function as Player mutator.
+type PlayerFunc func(context.Context, *entc.PlayerMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlayerFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.PlayerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.PlayerMutation", m)
+}
+
+// The PlayerAccountFunc type is an adapter to allow the use of ordinary
+// function as PlayerAccount mutator.
+type PlayerAccountFunc func(context.Context, *entc.PlayerAccountMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlayerAccountFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.PlayerAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.PlayerAccountMutation", m)
+}
+
+// The PlayerEventFunc type is an adapter to allow the use of ordinary
+// function as PlayerEvent mutator.
+type PlayerEventFunc func(context.Context, *entc.PlayerEventMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlayerEventFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.PlayerEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.PlayerEventMutation", m)
+}
+
+// The QuestFunc type is an adapter to allow the use of ordinary
+// function as Quest mutator.
+type QuestFunc func(context.Context, *entc.QuestMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuestFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.QuestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.QuestMutation", m)
+}
+
+// The QuestProgressFunc type is an adapter to allow the use of ordinary
+// function as QuestProgress mutator.
+type QuestProgressFunc func(context.Context, *entc.QuestProgressMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuestProgressFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.QuestProgressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.QuestProgressMutation", m)
+}
+
+// The TradeFunc type is an adapter to allow the use of ordinary
+// function as Trade mutator.
+type TradeFunc func(context.Context, *entc.TradeMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TradeFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.TradeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.TradeMutation", m)
+}
+
+// The WalletFunc type is an adapter to allow the use of ordinary
+// function as Wallet mutator.
+type WalletFunc func(context.Context, *entc.WalletMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WalletFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.WalletMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.WalletMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *entc.WebhookDeliveryMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.WebhookDeliveryMutation", m)
+}
+
+// The WebhookSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as WebhookSubscription mutator.
+type WebhookSubscriptionFunc func(context.Context, *entc.WebhookSubscriptionMutation) (entc.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookSubscriptionFunc) Mutate(ctx context.Context, m entc.Mutation) (entc.Value, error) {
+	if mv, ok := m.(*entc.WebhookSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entc.WebhookSubscriptionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, entc.Mutation) bool
 
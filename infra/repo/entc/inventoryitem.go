@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// InventoryItem is the model entity for the InventoryItem schema.
+type InventoryItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID string `json:"player_id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InventoryItemQuery when eager-loading is set.
+	Edges        InventoryItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InventoryItemEdges holds the relations/edges for other nodes in the graph.
+type InventoryItemEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InventoryItemEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InventoryItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inventoryitem.FieldID, inventoryitem.FieldQuantity, inventoryitem.FieldCreatedAt, inventoryitem.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case inventoryitem.FieldPlayerID, inventoryitem.FieldItemID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InventoryItem fields.
+func (ii *InventoryItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inventoryitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ii.ID = int64(value.Int64)
+		case inventoryitem.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				ii.PlayerID = value.String
+			}
+		case inventoryitem.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ii.ItemID = value.String
+			}
+		case inventoryitem.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				ii.Quantity = int(value.Int64)
+			}
+		case inventoryitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ii.CreatedAt = value.Int64
+			}
+		case inventoryitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ii.UpdatedAt = value.Int64
+			}
+		default:
+			ii.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InventoryItem.
+// This includes values selected through modifiers, order, etc.
+func (ii *InventoryItem) Value(name string) (ent.Value, error) {
+	return ii.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the InventoryItem entity.
+func (ii *InventoryItem) QueryPlayer() *PlayerQuery {
+	return NewInventoryItemClient(ii.config).QueryPlayer(ii)
+}
+
+// Update returns a builder for updating this InventoryItem.
+// Note that you need to call InventoryItem.Unwrap() before calling this method if this InventoryItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ii *InventoryItem) Update() *InventoryItemUpdateOne {
+	return NewInventoryItemClient(ii.config).UpdateOne(ii)
+}
+
+// Unwrap unwraps the InventoryItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ii *InventoryItem) Unwrap() *InventoryItem {
+	_tx, ok := ii.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: InventoryItem is not a transactional entity")
+	}
+	ii.config.driver = _tx.drv
+	return ii
+}
+
+// String implements the fmt.Stringer.
+func (ii *InventoryItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("InventoryItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ii.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(ii.PlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(ii.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", ii.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", ii.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InventoryItems is a parsable slice of InventoryItem.
+type InventoryItems []*InventoryItem
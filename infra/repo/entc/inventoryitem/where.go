@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inventoryitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLTE(FieldID, id))
+}
+
+// PlayerID applies equality check predicate on the "player_id" field. It's identical to PlayerIDEQ.
+func PlayerID(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldItemID, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PlayerIDEQ applies the EQ predicate on the "player_id" field.
+func PlayerIDEQ(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// PlayerIDNEQ applies the NEQ predicate on the "player_id" field.
+func PlayerIDNEQ(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNEQ(FieldPlayerID, v))
+}
+
+// PlayerIDIn applies the In predicate on the "player_id" field.
+func PlayerIDIn(vs ...string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDNotIn applies the NotIn predicate on the "player_id" field.
+func PlayerIDNotIn(vs ...string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDGT applies the GT predicate on the "player_id" field.
+func PlayerIDGT(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGT(FieldPlayerID, v))
+}
+
+// PlayerIDGTE applies the GTE predicate on the "player_id" field.
+func PlayerIDGTE(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGTE(FieldPlayerID, v))
+}
+
+// PlayerIDLT applies the LT predicate on the "player_id" field.
+func PlayerIDLT(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLT(FieldPlayerID, v))
+}
+
+// PlayerIDLTE applies the LTE predicate on the "player_id" field.
+func PlayerIDLTE(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLTE(FieldPlayerID, v))
+}
+
+// PlayerIDContains applies the Contains predicate on the "player_id" field.
+func PlayerIDContains(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldContains(FieldPlayerID, v))
+}
+
+// PlayerIDHasPrefix applies the HasPrefix predicate on the "player_id" field.
+func PlayerIDHasPrefix(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldHasPrefix(FieldPlayerID, v))
+}
+
+// PlayerIDHasSuffix applies the HasSuffix predicate on the "player_id" field.
+func PlayerIDHasSuffix(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldHasSuffix(FieldPlayerID, v))
+}
+
+// PlayerIDEqualFold applies the EqualFold predicate on the "player_id" field.
+func PlayerIDEqualFold(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEqualFold(FieldPlayerID, v))
+}
+
+// PlayerIDContainsFold applies the ContainsFold predicate on the "player_id" field.
+func PlayerIDContainsFold(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldContainsFold(FieldPlayerID, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLTE(FieldQuantity, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.InventoryItem {
+	return predicate.InventoryItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.InventoryItem {
+	return predicate.InventoryItem(func(s *sql.Selector) {
+		step := newPlayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InventoryItem) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InventoryItem) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InventoryItem) predicate.InventoryItem {
+	return predicate.InventoryItem(sql.NotPredicates(p))
+}
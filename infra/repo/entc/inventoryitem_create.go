@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// InventoryItemCreate is the builder for creating a InventoryItem entity.
+type InventoryItemCreate struct {
+	config
+	mutation *InventoryItemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlayerID sets the "player_id" field.
+func (iic *InventoryItemCreate) SetPlayerID(s string) *InventoryItemCreate {
+	iic.mutation.SetPlayerID(s)
+	return iic
+}
+
+// SetItemID sets the "item_id" field.
+func (iic *InventoryItemCreate) SetItemID(s string) *InventoryItemCreate {
+	iic.mutation.SetItemID(s)
+	return iic
+}
+
+// SetQuantity sets the "quantity" field.
+func (iic *InventoryItemCreate) SetQuantity(i int) *InventoryItemCreate {
+	iic.mutation.SetQuantity(i)
+	return iic
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (iic *InventoryItemCreate) SetNillableQuantity(i *int) *InventoryItemCreate {
+	if i != nil {
+		iic.SetQuantity(*i)
+	}
+	return iic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iic *InventoryItemCreate) SetCreatedAt(i int64) *InventoryItemCreate {
+	iic.mutation.SetCreatedAt(i)
+	return iic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iic *InventoryItemCreate) SetNillableCreatedAt(i *int64) *InventoryItemCreate {
+	if i != nil {
+		iic.SetCreatedAt(*i)
+	}
+	return iic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iic *InventoryItemCreate) SetUpdatedAt(i int64) *InventoryItemCreate {
+	iic.mutation.SetUpdatedAt(i)
+	return iic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iic *InventoryItemCreate) SetNillableUpdatedAt(i *int64) *InventoryItemCreate {
+	if i != nil {
+		iic.SetUpdatedAt(*i)
+	}
+	return iic
+}
+
+// SetID sets the "id" field.
+func (iic *InventoryItemCreate) SetID(i int64) *InventoryItemCreate {
+	iic.mutation.SetID(i)
+	return iic
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (iic *InventoryItemCreate) SetPlayer(p *Player) *InventoryItemCreate {
+	return iic.SetPlayerID(p.ID)
+}
+
+// Mutation returns the InventoryItemMutation object of the builder.
+func (iic *InventoryItemCreate) Mutation() *InventoryItemMutation {
+	return iic.mutation
+}
+
+// Save creates the InventoryItem in the database.
+func (iic *InventoryItemCreate) Save(ctx context.Context) (*InventoryItem, error) {
+	iic.defaults()
+	return withHooks(ctx, iic.sqlSave, iic.mutation, iic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iic *InventoryItemCreate) SaveX(ctx context.Context) *InventoryItem {
+	v, err := iic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iic *InventoryItemCreate) Exec(ctx context.Context) error {
+	_, err := iic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iic *InventoryItemCreate) ExecX(ctx context.Context) {
+	if err := iic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iic *InventoryItemCreate) defaults() {
+	if _, ok := iic.mutation.Quantity(); !ok {
+		v := inventoryitem.DefaultQuantity
+		iic.mutation.SetQuantity(v)
+	}
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		v := inventoryitem.DefaultCreatedAt()
+		iic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := iic.mutation.UpdatedAt(); !ok {
+		v := inventoryitem.DefaultUpdatedAt()
+		iic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iic *InventoryItemCreate) check() error {
+	if _, ok := iic.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`entc: missing required field "InventoryItem.player_id"`)}
+	}
+	if _, ok := iic.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`entc: missing required field "InventoryItem.item_id"`)}
+	}
+	if _, ok := iic.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`entc: missing required field "InventoryItem.quantity"`)}
+	}
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "InventoryItem.created_at"`)}
+	}
+	if _, ok := iic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "InventoryItem.updated_at"`)}
+	}
+	if len(iic.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`entc: missing required edge "InventoryItem.player"`)}
+	}
+	return nil
+}
+
+func (iic *InventoryItemCreate) sqlSave(ctx context.Context) (*InventoryItem, error) {
+	if err := iic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	iic.mutation.id = &_node.ID
+	iic.mutation.done = true
+	return _node, nil
+}
+
+func (iic *InventoryItemCreate) createSpec() (*InventoryItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InventoryItem{config: iic.config}
+		_spec = sqlgraph.NewCreateSpec(inventoryitem.Table, sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = iic.conflict
+	if id, ok := iic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iic.mutation.ItemID(); ok {
+		_spec.SetField(inventoryitem.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := iic.mutation.Quantity(); ok {
+		_spec.SetField(inventoryitem.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := iic.mutation.CreatedAt(); ok {
+		_spec.SetField(inventoryitem.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := iic.mutation.UpdatedAt(); ok {
+		_spec.SetField(inventoryitem.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := iic.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryitem.PlayerTable,
+			Columns: []string{inventoryitem.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlayerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InventoryItem.Create().
+//		SetPlayerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InventoryItemUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (iic *InventoryItemCreate) OnConflict(opts ...sql.ConflictOption) *InventoryItemUpsertOne {
+	iic.conflict = opts
+	return &InventoryItemUpsertOne{
+		create: iic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InventoryItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iic *InventoryItemCreate) OnConflictColumns(columns ...string) *InventoryItemUpsertOne {
+	iic.conflict = append(iic.conflict, sql.ConflictColumns(columns...))
+	return &InventoryItemUpsertOne{
+		create: iic,
+	}
+}
+
+type (
+	// InventoryItemUpsertOne is the builder for "upsert"-ing
+	//  one InventoryItem node.
+	InventoryItemUpsertOne struct {
+		create *InventoryItemCreate
+	}
+
+	// InventoryItemUpsert is the "OnConflict" setter.
+	InventoryItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlayerID sets the "player_id" field.
+func (u *InventoryItemUpsert) SetPlayerID(v string) *InventoryItemUpsert {
+	u.Set(inventoryitem.FieldPlayerID, v)
+	return u
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *InventoryItemUpsert) UpdatePlayerID() *InventoryItemUpsert {
+	u.SetExcluded(inventoryitem.FieldPlayerID)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *InventoryItemUpsert) SetItemID(v string) *InventoryItemUpsert {
+	u.Set(inventoryitem.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *InventoryItemUpsert) UpdateItemID() *InventoryItemUpsert {
+	u.SetExcluded(inventoryitem.FieldItemID)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InventoryItemUpsert) SetQuantity(v int) *InventoryItemUpsert {
+	u.Set(inventoryitem.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InventoryItemUpsert) UpdateQuantity() *InventoryItemUpsert {
+	u.SetExcluded(inventoryitem.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InventoryItemUpsert) AddQuantity(v int) *InventoryItemUpsert {
+	u.Add(inventoryitem.FieldQuantity, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InventoryItemUpsert) SetUpdatedAt(v int64) *InventoryItemUpsert {
+	u.Set(inventoryitem.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InventoryItemUpsert) UpdateUpdatedAt() *InventoryItemUpsert {
+	u.SetExcluded(inventoryitem.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *InventoryItemUpsert) AddUpdatedAt(v int64) *InventoryItemUpsert {
+	u.Add(inventoryitem.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InventoryItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(inventoryitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InventoryItemUpsertOne) UpdateNewValues() *InventoryItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(inventoryitem.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(inventoryitem.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InventoryItem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InventoryItemUpsertOne) Ignore() *InventoryItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InventoryItemUpsertOne) DoNothing() *InventoryItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InventoryItemCreate.OnConflict
+// documentation for more info.
+func (u *InventoryItemUpsertOne) Update(set func(*InventoryItemUpsert)) *InventoryItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InventoryItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *InventoryItemUpsertOne) SetPlayerID(v string) *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *InventoryItemUpsertOne) UpdatePlayerID() *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *InventoryItemUpsertOne) SetItemID(v string) *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *InventoryItemUpsertOne) UpdateItemID() *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InventoryItemUpsertOne) SetQuantity(v int) *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InventoryItemUpsertOne) AddQuantity(v int) *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InventoryItemUpsertOne) UpdateQuantity() *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InventoryItemUpsertOne) SetUpdatedAt(v int64) *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *InventoryItemUpsertOne) AddUpdatedAt(v int64) *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InventoryItemUpsertOne) UpdateUpdatedAt() *InventoryItemUpsertOne {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InventoryItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for InventoryItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InventoryItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InventoryItemUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InventoryItemUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InventoryItemCreateBulk is the builder for creating many InventoryItem entities in bulk.
+type InventoryItemCreateBulk struct {
+	config
+	err      error
+	builders []*InventoryItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InventoryItem entities in the database.
+func (iicb *InventoryItemCreateBulk) Save(ctx context.Context) ([]*InventoryItem, error) {
+	if iicb.err != nil {
+		return nil, iicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iicb.builders))
+	nodes := make([]*InventoryItem, len(iicb.builders))
+	mutators := make([]Mutator, len(iicb.builders))
+	for i := range iicb.builders {
+		func(i int, root context.Context) {
+			builder := iicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InventoryItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicb *InventoryItemCreateBulk) SaveX(ctx context.Context) []*InventoryItem {
+	v, err := iicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iicb *InventoryItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := iicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicb *InventoryItemCreateBulk) ExecX(ctx context.Context) {
+	if err := iicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InventoryItem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InventoryItemUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (iicb *InventoryItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *InventoryItemUpsertBulk {
+	iicb.conflict = opts
+	return &InventoryItemUpsertBulk{
+		create: iicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InventoryItem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iicb *InventoryItemCreateBulk) OnConflictColumns(columns ...string) *InventoryItemUpsertBulk {
+	iicb.conflict = append(iicb.conflict, sql.ConflictColumns(columns...))
+	return &InventoryItemUpsertBulk{
+		create: iicb,
+	}
+}
+
+// InventoryItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of InventoryItem nodes.
+type InventoryItemUpsertBulk struct {
+	create *InventoryItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InventoryItem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(inventoryitem.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InventoryItemUpsertBulk) UpdateNewValues() *InventoryItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(inventoryitem.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(inventoryitem.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InventoryItem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InventoryItemUpsertBulk) Ignore() *InventoryItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InventoryItemUpsertBulk) DoNothing() *InventoryItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InventoryItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *InventoryItemUpsertBulk) Update(set func(*InventoryItemUpsert)) *InventoryItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InventoryItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *InventoryItemUpsertBulk) SetPlayerID(v string) *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *InventoryItemUpsertBulk) UpdatePlayerID() *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *InventoryItemUpsertBulk) SetItemID(v string) *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *InventoryItemUpsertBulk) UpdateItemID() *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *InventoryItemUpsertBulk) SetQuantity(v int) *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *InventoryItemUpsertBulk) AddQuantity(v int) *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *InventoryItemUpsertBulk) UpdateQuantity() *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InventoryItemUpsertBulk) SetUpdatedAt(v int64) *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *InventoryItemUpsertBulk) AddUpdatedAt(v int64) *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InventoryItemUpsertBulk) UpdateUpdatedAt() *InventoryItemUpsertBulk {
+	return u.Update(func(s *InventoryItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InventoryItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the InventoryItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for InventoryItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InventoryItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
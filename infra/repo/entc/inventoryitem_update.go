@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// InventoryItemUpdate is the builder for updating InventoryItem entities.
+type InventoryItemUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InventoryItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InventoryItemUpdate builder.
+func (iiu *InventoryItemUpdate) Where(ps ...predicate.InventoryItem) *InventoryItemUpdate {
+	iiu.mutation.Where(ps...)
+	return iiu
+}
+
+// SetPlayerID sets the "player_id" field.
+func (iiu *InventoryItemUpdate) SetPlayerID(s string) *InventoryItemUpdate {
+	iiu.mutation.SetPlayerID(s)
+	return iiu
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (iiu *InventoryItemUpdate) SetNillablePlayerID(s *string) *InventoryItemUpdate {
+	if s != nil {
+		iiu.SetPlayerID(*s)
+	}
+	return iiu
+}
+
+// SetItemID sets the "item_id" field.
+func (iiu *InventoryItemUpdate) SetItemID(s string) *InventoryItemUpdate {
+	iiu.mutation.SetItemID(s)
+	return iiu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (iiu *InventoryItemUpdate) SetNillableItemID(s *string) *InventoryItemUpdate {
+	if s != nil {
+		iiu.SetItemID(*s)
+	}
+	return iiu
+}
+
+// SetQuantity sets the "quantity" field.
+func (iiu *InventoryItemUpdate) SetQuantity(i int) *InventoryItemUpdate {
+	iiu.mutation.ResetQuantity()
+	iiu.mutation.SetQuantity(i)
+	return iiu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (iiu *InventoryItemUpdate) SetNillableQuantity(i *int) *InventoryItemUpdate {
+	if i != nil {
+		iiu.SetQuantity(*i)
+	}
+	return iiu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (iiu *InventoryItemUpdate) AddQuantity(i int) *InventoryItemUpdate {
+	iiu.mutation.AddQuantity(i)
+	return iiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iiu *InventoryItemUpdate) SetUpdatedAt(i int64) *InventoryItemUpdate {
+	iiu.mutation.ResetUpdatedAt()
+	iiu.mutation.SetUpdatedAt(i)
+	return iiu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (iiu *InventoryItemUpdate) AddUpdatedAt(i int64) *InventoryItemUpdate {
+	iiu.mutation.AddUpdatedAt(i)
+	return iiu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (iiu *InventoryItemUpdate) SetPlayer(p *Player) *InventoryItemUpdate {
+	return iiu.SetPlayerID(p.ID)
+}
+
+// Mutation returns the InventoryItemMutation object of the builder.
+func (iiu *InventoryItemUpdate) Mutation() *InventoryItemMutation {
+	return iiu.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (iiu *InventoryItemUpdate) ClearPlayer() *InventoryItemUpdate {
+	iiu.mutation.ClearPlayer()
+	return iiu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iiu *InventoryItemUpdate) Save(ctx context.Context) (int, error) {
+	iiu.defaults()
+	return withHooks(ctx, iiu.sqlSave, iiu.mutation, iiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiu *InventoryItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := iiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iiu *InventoryItemUpdate) Exec(ctx context.Context) error {
+	_, err := iiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiu *InventoryItemUpdate) ExecX(ctx context.Context) {
+	if err := iiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiu *InventoryItemUpdate) defaults() {
+	if _, ok := iiu.mutation.UpdatedAt(); !ok {
+		v := inventoryitem.UpdateDefaultUpdatedAt()
+		iiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiu *InventoryItemUpdate) check() error {
+	if iiu.mutation.PlayerCleared() && len(iiu.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "InventoryItem.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiu *InventoryItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InventoryItemUpdate {
+	iiu.modifiers = append(iiu.modifiers, modifiers...)
+	return iiu
+}
+
+func (iiu *InventoryItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryitem.Table, inventoryitem.Columns, sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64))
+	if ps := iiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiu.mutation.ItemID(); ok {
+		_spec.SetField(inventoryitem.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := iiu.mutation.Quantity(); ok {
+		_spec.SetField(inventoryitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.AddedQuantity(); ok {
+		_spec.AddField(inventoryitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(inventoryitem.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(inventoryitem.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if iiu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryitem.PlayerTable,
+			Columns: []string{inventoryitem.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryitem.PlayerTable,
+			Columns: []string{inventoryitem.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iiu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iiu.mutation.done = true
+	return n, nil
+}
+
+// InventoryItemUpdateOne is the builder for updating a single InventoryItem entity.
+type InventoryItemUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InventoryItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPlayerID sets the "player_id" field.
+func (iiuo *InventoryItemUpdateOne) SetPlayerID(s string) *InventoryItemUpdateOne {
+	iiuo.mutation.SetPlayerID(s)
+	return iiuo
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (iiuo *InventoryItemUpdateOne) SetNillablePlayerID(s *string) *InventoryItemUpdateOne {
+	if s != nil {
+		iiuo.SetPlayerID(*s)
+	}
+	return iiuo
+}
+
+// SetItemID sets the "item_id" field.
+func (iiuo *InventoryItemUpdateOne) SetItemID(s string) *InventoryItemUpdateOne {
+	iiuo.mutation.SetItemID(s)
+	return iiuo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (iiuo *InventoryItemUpdateOne) SetNillableItemID(s *string) *InventoryItemUpdateOne {
+	if s != nil {
+		iiuo.SetItemID(*s)
+	}
+	return iiuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (iiuo *InventoryItemUpdateOne) SetQuantity(i int) *InventoryItemUpdateOne {
+	iiuo.mutation.ResetQuantity()
+	iiuo.mutation.SetQuantity(i)
+	return iiuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (iiuo *InventoryItemUpdateOne) SetNillableQuantity(i *int) *InventoryItemUpdateOne {
+	if i != nil {
+		iiuo.SetQuantity(*i)
+	}
+	return iiuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (iiuo *InventoryItemUpdateOne) AddQuantity(i int) *InventoryItemUpdateOne {
+	iiuo.mutation.AddQuantity(i)
+	return iiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iiuo *InventoryItemUpdateOne) SetUpdatedAt(i int64) *InventoryItemUpdateOne {
+	iiuo.mutation.ResetUpdatedAt()
+	iiuo.mutation.SetUpdatedAt(i)
+	return iiuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (iiuo *InventoryItemUpdateOne) AddUpdatedAt(i int64) *InventoryItemUpdateOne {
+	iiuo.mutation.AddUpdatedAt(i)
+	return iiuo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (iiuo *InventoryItemUpdateOne) SetPlayer(p *Player) *InventoryItemUpdateOne {
+	return iiuo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the InventoryItemMutation object of the builder.
+func (iiuo *InventoryItemUpdateOne) Mutation() *InventoryItemMutation {
+	return iiuo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (iiuo *InventoryItemUpdateOne) ClearPlayer() *InventoryItemUpdateOne {
+	iiuo.mutation.ClearPlayer()
+	return iiuo
+}
+
+// Where appends a list predicates to the InventoryItemUpdate builder.
+func (iiuo *InventoryItemUpdateOne) Where(ps ...predicate.InventoryItem) *InventoryItemUpdateOne {
+	iiuo.mutation.Where(ps...)
+	return iiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iiuo *InventoryItemUpdateOne) Select(field string, fields ...string) *InventoryItemUpdateOne {
+	iiuo.fields = append([]string{field}, fields...)
+	return iiuo
+}
+
+// Save executes the query and returns the updated InventoryItem entity.
+func (iiuo *InventoryItemUpdateOne) Save(ctx context.Context) (*InventoryItem, error) {
+	iiuo.defaults()
+	return withHooks(ctx, iiuo.sqlSave, iiuo.mutation, iiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiuo *InventoryItemUpdateOne) SaveX(ctx context.Context) *InventoryItem {
+	node, err := iiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iiuo *InventoryItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := iiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiuo *InventoryItemUpdateOne) ExecX(ctx context.Context) {
+	if err := iiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiuo *InventoryItemUpdateOne) defaults() {
+	if _, ok := iiuo.mutation.UpdatedAt(); !ok {
+		v := inventoryitem.UpdateDefaultUpdatedAt()
+		iiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiuo *InventoryItemUpdateOne) check() error {
+	if iiuo.mutation.PlayerCleared() && len(iiuo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "InventoryItem.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiuo *InventoryItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InventoryItemUpdateOne {
+	iiuo.modifiers = append(iiuo.modifiers, modifiers...)
+	return iiuo
+}
+
+func (iiuo *InventoryItemUpdateOne) sqlSave(ctx context.Context) (_node *InventoryItem, err error) {
+	if err := iiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inventoryitem.Table, inventoryitem.Columns, sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64))
+	id, ok := iiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "InventoryItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inventoryitem.FieldID)
+		for _, f := range fields {
+			if !inventoryitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != inventoryitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiuo.mutation.ItemID(); ok {
+		_spec.SetField(inventoryitem.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := iiuo.mutation.Quantity(); ok {
+		_spec.SetField(inventoryitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(inventoryitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(inventoryitem.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(inventoryitem.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if iiuo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryitem.PlayerTable,
+			Columns: []string{inventoryitem.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiuo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   inventoryitem.PlayerTable,
+			Columns: []string{inventoryitem.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iiuo.modifiers...)
+	_node = &InventoryItem{config: iiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inventoryitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iiuo.mutation.done = true
+	return _node, nil
+}
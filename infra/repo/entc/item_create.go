@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
 )
 
 // ItemCreate is the builder for creating a Item entity.
@@ -74,6 +75,21 @@ func (ic *ItemCreate) SetID(s string) *ItemCreate {
 	return ic
 }
 
+// AddTranslationIDs adds the "translations" edge to the ItemTranslation entity by IDs.
+func (ic *ItemCreate) AddTranslationIDs(ids ...int) *ItemCreate {
+	ic.mutation.AddTranslationIDs(ids...)
+	return ic
+}
+
+// AddTranslations adds the "translations" edges to the ItemTranslation entity.
+func (ic *ItemCreate) AddTranslations(i ...*ItemTranslation) *ItemCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (ic *ItemCreate) Mutation() *ItemMutation {
 	return ic.mutation
@@ -192,6 +208,22 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldUpdatedAt, field.TypeInt64, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := ic.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TranslationsTable,
+			Columns: []string{item.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
 	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
 )
 
@@ -83,11 +84,47 @@ func (iu *ItemUpdate) AddUpdatedAt(i int64) *ItemUpdate {
 	return iu
 }
 
+// AddTranslationIDs adds the "translations" edge to the ItemTranslation entity by IDs.
+func (iu *ItemUpdate) AddTranslationIDs(ids ...int) *ItemUpdate {
+	iu.mutation.AddTranslationIDs(ids...)
+	return iu
+}
+
+// AddTranslations adds the "translations" edges to the ItemTranslation entity.
+func (iu *ItemUpdate) AddTranslations(i ...*ItemTranslation) *ItemUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iu *ItemUpdate) Mutation() *ItemMutation {
 	return iu.mutation
 }
 
+// ClearTranslations clears all "translations" edges to the ItemTranslation entity.
+func (iu *ItemUpdate) ClearTranslations() *ItemUpdate {
+	iu.mutation.ClearTranslations()
+	return iu
+}
+
+// RemoveTranslationIDs removes the "translations" edge to ItemTranslation entities by IDs.
+func (iu *ItemUpdate) RemoveTranslationIDs(ids ...int) *ItemUpdate {
+	iu.mutation.RemoveTranslationIDs(ids...)
+	return iu
+}
+
+// RemoveTranslations removes "translations" edges to ItemTranslation entities.
+func (iu *ItemUpdate) RemoveTranslations(i ...*ItemTranslation) *ItemUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveTranslationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
 	iu.defaults()
@@ -154,6 +191,51 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.AddedUpdatedAt(); ok {
 		_spec.AddField(item.FieldUpdatedAt, field.TypeInt64, value)
 	}
+	if iu.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TranslationsTable,
+			Columns: []string{item.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !iu.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TranslationsTable,
+			Columns: []string{item.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TranslationsTable,
+			Columns: []string{item.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(iu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -231,11 +313,47 @@ func (iuo *ItemUpdateOne) AddUpdatedAt(i int64) *ItemUpdateOne {
 	return iuo
 }
 
+// AddTranslationIDs adds the "translations" edge to the ItemTranslation entity by IDs.
+func (iuo *ItemUpdateOne) AddTranslationIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.AddTranslationIDs(ids...)
+	return iuo
+}
+
+// AddTranslations adds the "translations" edges to the ItemTranslation entity.
+func (iuo *ItemUpdateOne) AddTranslations(i ...*ItemTranslation) *ItemUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddTranslationIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
 	return iuo.mutation
 }
 
+// ClearTranslations clears all "translations" edges to the ItemTranslation entity.
+func (iuo *ItemUpdateOne) ClearTranslations() *ItemUpdateOne {
+	iuo.mutation.ClearTranslations()
+	return iuo
+}
+
+// RemoveTranslationIDs removes the "translations" edge to ItemTranslation entities by IDs.
+func (iuo *ItemUpdateOne) RemoveTranslationIDs(ids ...int) *ItemUpdateOne {
+	iuo.mutation.RemoveTranslationIDs(ids...)
+	return iuo
+}
+
+// RemoveTranslations removes "translations" edges to ItemTranslation entities.
+func (iuo *ItemUpdateOne) RemoveTranslations(i ...*ItemTranslation) *ItemUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveTranslationIDs(ids...)
+}
+
 // Where appends a list predicates to the ItemUpdate builder.
 func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -332,6 +450,51 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if value, ok := iuo.mutation.AddedUpdatedAt(); ok {
 		_spec.AddField(item.FieldUpdatedAt, field.TypeInt64, value)
 	}
+	if iuo.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TranslationsTable,
+			Columns: []string{item.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !iuo.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TranslationsTable,
+			Columns: []string{item.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.TranslationsTable,
+			Columns: []string{item.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(iuo.modifiers...)
 	_node = &Item{config: iuo.config}
 	_spec.Assign = _node.assignValues
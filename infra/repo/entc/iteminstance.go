@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// ItemInstance is the model entity for the ItemInstance schema.
+type ItemInstance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// Durability holds the value of the "durability" field.
+	Durability int `json:"durability,omitempty"`
+	// MaxDurability holds the value of the "max_durability" field.
+	MaxDurability int `json:"max_durability,omitempty"`
+	// Stats holds the value of the "stats" field.
+	Stats map[string]interface{} `json:"stats,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// BurnedAt holds the value of the "burned_at" field.
+	BurnedAt int64 `json:"burned_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemInstanceQuery when eager-loading is set.
+	Edges        ItemInstanceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemInstanceEdges holds the relations/edges for other nodes in the graph.
+type ItemInstanceEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Player `json:"owner,omitempty"`
+	// History holds the value of the history edge.
+	History []*ItemInstanceHistory `json:"history,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemInstanceEdges) OwnerOrErr() (*Player, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// HistoryOrErr returns the History value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemInstanceEdges) HistoryOrErr() ([]*ItemInstanceHistory, error) {
+	if e.loadedTypes[1] {
+		return e.History, nil
+	}
+	return nil, &NotLoadedError{edge: "history"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemInstance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case iteminstance.FieldStats:
+			values[i] = new([]byte)
+		case iteminstance.FieldDurability, iteminstance.FieldMaxDurability, iteminstance.FieldBurnedAt, iteminstance.FieldCreatedAt, iteminstance.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case iteminstance.FieldID, iteminstance.FieldItemID, iteminstance.FieldOwnerID, iteminstance.FieldStatus:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemInstance fields.
+func (ii *ItemInstance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case iteminstance.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ii.ID = value.String
+			}
+		case iteminstance.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ii.ItemID = value.String
+			}
+		case iteminstance.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				ii.OwnerID = value.String
+			}
+		case iteminstance.FieldDurability:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field durability", values[i])
+			} else if value.Valid {
+				ii.Durability = int(value.Int64)
+			}
+		case iteminstance.FieldMaxDurability:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_durability", values[i])
+			} else if value.Valid {
+				ii.MaxDurability = int(value.Int64)
+			}
+		case iteminstance.FieldStats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stats", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ii.Stats); err != nil {
+					return fmt.Errorf("unmarshal field stats: %w", err)
+				}
+			}
+		case iteminstance.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ii.Status = value.String
+			}
+		case iteminstance.FieldBurnedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field burned_at", values[i])
+			} else if value.Valid {
+				ii.BurnedAt = value.Int64
+			}
+		case iteminstance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ii.CreatedAt = value.Int64
+			}
+		case iteminstance.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ii.UpdatedAt = value.Int64
+			}
+		default:
+			ii.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemInstance.
+// This includes values selected through modifiers, order, etc.
+func (ii *ItemInstance) Value(name string) (ent.Value, error) {
+	return ii.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ItemInstance entity.
+func (ii *ItemInstance) QueryOwner() *PlayerQuery {
+	return NewItemInstanceClient(ii.config).QueryOwner(ii)
+}
+
+// QueryHistory queries the "history" edge of the ItemInstance entity.
+func (ii *ItemInstance) QueryHistory() *ItemInstanceHistoryQuery {
+	return NewItemInstanceClient(ii.config).QueryHistory(ii)
+}
+
+// Update returns a builder for updating this ItemInstance.
+// Note that you need to call ItemInstance.Unwrap() before calling this method if this ItemInstance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ii *ItemInstance) Update() *ItemInstanceUpdateOne {
+	return NewItemInstanceClient(ii.config).UpdateOne(ii)
+}
+
+// Unwrap unwraps the ItemInstance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ii *ItemInstance) Unwrap() *ItemInstance {
+	_tx, ok := ii.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: ItemInstance is not a transactional entity")
+	}
+	ii.config.driver = _tx.drv
+	return ii
+}
+
+// String implements the fmt.Stringer.
+func (ii *ItemInstance) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemInstance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ii.ID))
+	builder.WriteString("item_id=")
+	builder.WriteString(ii.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(ii.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("durability=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Durability))
+	builder.WriteString(", ")
+	builder.WriteString("max_durability=")
+	builder.WriteString(fmt.Sprintf("%v", ii.MaxDurability))
+	builder.WriteString(", ")
+	builder.WriteString("stats=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Stats))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ii.Status)
+	builder.WriteString(", ")
+	builder.WriteString("burned_at=")
+	builder.WriteString(fmt.Sprintf("%v", ii.BurnedAt))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", ii.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", ii.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemInstances is a parsable slice of ItemInstance.
+type ItemInstances []*ItemInstance
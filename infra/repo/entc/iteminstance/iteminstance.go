@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iteminstance
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the iteminstance type in the database.
+	Label = "item_instance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldDurability holds the string denoting the durability field in the database.
+	FieldDurability = "durability"
+	// FieldMaxDurability holds the string denoting the max_durability field in the database.
+	FieldMaxDurability = "max_durability"
+	// FieldStats holds the string denoting the stats field in the database.
+	FieldStats = "stats"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldBurnedAt holds the string denoting the burned_at field in the database.
+	FieldBurnedAt = "burned_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// EdgeHistory holds the string denoting the history edge name in mutations.
+	EdgeHistory = "history"
+	// Table holds the table name of the iteminstance in the database.
+	Table = "item_instances"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "item_instances"
+	// OwnerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	OwnerInverseTable = "players"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "owner_id"
+	// HistoryTable is the table that holds the history relation/edge.
+	HistoryTable = "item_instance_histories"
+	// HistoryInverseTable is the table name for the ItemInstanceHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "iteminstancehistory" package.
+	HistoryInverseTable = "item_instance_histories"
+	// HistoryColumn is the table column denoting the history relation/edge.
+	HistoryColumn = "instance_id"
+)
+
+// Columns holds all SQL columns for iteminstance fields.
+var Columns = []string{
+	FieldID,
+	FieldItemID,
+	FieldOwnerID,
+	FieldDurability,
+	FieldMaxDurability,
+	FieldStats,
+	FieldStatus,
+	FieldBurnedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDurability holds the default value on creation for the "durability" field.
+	DefaultDurability int
+	// DefaultMaxDurability holds the default value on creation for the "max_durability" field.
+	DefaultMaxDurability int
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultBurnedAt holds the default value on creation for the "burned_at" field.
+	DefaultBurnedAt int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the ItemInstance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByDurability orders the results by the durability field.
+func ByDurability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurability, opts...).ToFunc()
+}
+
+// ByMaxDurability orders the results by the max_durability field.
+func ByMaxDurability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDurability, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByBurnedAt orders the results by the burned_at field.
+func ByBurnedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBurnedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHistoryCount orders the results by history count.
+func ByHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHistoryStep(), opts...)
+	}
+}
+
+// ByHistory orders the results by history terms.
+func ByHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHistoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
+func newHistoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HistoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HistoryTable, HistoryColumn),
+	)
+}
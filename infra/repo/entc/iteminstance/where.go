@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iteminstance
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldContainsFold(FieldID, id))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldItemID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// Durability applies equality check predicate on the "durability" field. It's identical to DurabilityEQ.
+func Durability(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldDurability, v))
+}
+
+// MaxDurability applies equality check predicate on the "max_durability" field. It's identical to MaxDurabilityEQ.
+func MaxDurability(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldMaxDurability, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldStatus, v))
+}
+
+// BurnedAt applies equality check predicate on the "burned_at" field. It's identical to BurnedAtEQ.
+func BurnedAt(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldBurnedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldContains(FieldOwnerID, v))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldHasPrefix(FieldOwnerID, v))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldHasSuffix(FieldOwnerID, v))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEqualFold(FieldOwnerID, v))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldContainsFold(FieldOwnerID, v))
+}
+
+// DurabilityEQ applies the EQ predicate on the "durability" field.
+func DurabilityEQ(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldDurability, v))
+}
+
+// DurabilityNEQ applies the NEQ predicate on the "durability" field.
+func DurabilityNEQ(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldDurability, v))
+}
+
+// DurabilityIn applies the In predicate on the "durability" field.
+func DurabilityIn(vs ...int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldDurability, vs...))
+}
+
+// DurabilityNotIn applies the NotIn predicate on the "durability" field.
+func DurabilityNotIn(vs ...int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldDurability, vs...))
+}
+
+// DurabilityGT applies the GT predicate on the "durability" field.
+func DurabilityGT(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldDurability, v))
+}
+
+// DurabilityGTE applies the GTE predicate on the "durability" field.
+func DurabilityGTE(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldDurability, v))
+}
+
+// DurabilityLT applies the LT predicate on the "durability" field.
+func DurabilityLT(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldDurability, v))
+}
+
+// DurabilityLTE applies the LTE predicate on the "durability" field.
+func DurabilityLTE(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldDurability, v))
+}
+
+// MaxDurabilityEQ applies the EQ predicate on the "max_durability" field.
+func MaxDurabilityEQ(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldMaxDurability, v))
+}
+
+// MaxDurabilityNEQ applies the NEQ predicate on the "max_durability" field.
+func MaxDurabilityNEQ(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldMaxDurability, v))
+}
+
+// MaxDurabilityIn applies the In predicate on the "max_durability" field.
+func MaxDurabilityIn(vs ...int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldMaxDurability, vs...))
+}
+
+// MaxDurabilityNotIn applies the NotIn predicate on the "max_durability" field.
+func MaxDurabilityNotIn(vs ...int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldMaxDurability, vs...))
+}
+
+// MaxDurabilityGT applies the GT predicate on the "max_durability" field.
+func MaxDurabilityGT(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldMaxDurability, v))
+}
+
+// MaxDurabilityGTE applies the GTE predicate on the "max_durability" field.
+func MaxDurabilityGTE(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldMaxDurability, v))
+}
+
+// MaxDurabilityLT applies the LT predicate on the "max_durability" field.
+func MaxDurabilityLT(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldMaxDurability, v))
+}
+
+// MaxDurabilityLTE applies the LTE predicate on the "max_durability" field.
+func MaxDurabilityLTE(v int) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldMaxDurability, v))
+}
+
+// StatsIsNil applies the IsNil predicate on the "stats" field.
+func StatsIsNil() predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIsNull(FieldStats))
+}
+
+// StatsNotNil applies the NotNil predicate on the "stats" field.
+func StatsNotNil() predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotNull(FieldStats))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// BurnedAtEQ applies the EQ predicate on the "burned_at" field.
+func BurnedAtEQ(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldBurnedAt, v))
+}
+
+// BurnedAtNEQ applies the NEQ predicate on the "burned_at" field.
+func BurnedAtNEQ(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldBurnedAt, v))
+}
+
+// BurnedAtIn applies the In predicate on the "burned_at" field.
+func BurnedAtIn(vs ...int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldBurnedAt, vs...))
+}
+
+// BurnedAtNotIn applies the NotIn predicate on the "burned_at" field.
+func BurnedAtNotIn(vs ...int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldBurnedAt, vs...))
+}
+
+// BurnedAtGT applies the GT predicate on the "burned_at" field.
+func BurnedAtGT(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldBurnedAt, v))
+}
+
+// BurnedAtGTE applies the GTE predicate on the "burned_at" field.
+func BurnedAtGTE(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldBurnedAt, v))
+}
+
+// BurnedAtLT applies the LT predicate on the "burned_at" field.
+func BurnedAtLT(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldBurnedAt, v))
+}
+
+// BurnedAtLTE applies the LTE predicate on the "burned_at" field.
+func BurnedAtLTE(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldBurnedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.ItemInstance {
+	return predicate.ItemInstance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.Player) predicate.ItemInstance {
+	return predicate.ItemInstance(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHistory applies the HasEdge predicate on the "history" edge.
+func HasHistory() predicate.ItemInstance {
+	return predicate.ItemInstance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HistoryTable, HistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHistoryWith applies the HasEdge predicate on the "history" edge with a given conditions (other predicates).
+func HasHistoryWith(preds ...predicate.ItemInstanceHistory) predicate.ItemInstance {
+	return predicate.ItemInstance(func(s *sql.Selector) {
+		step := newHistoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemInstance) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemInstance) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemInstance) predicate.ItemInstance {
+	return predicate.ItemInstance(sql.NotPredicates(p))
+}
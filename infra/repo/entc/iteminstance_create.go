@@ -0,0 +1,1086 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// ItemInstanceCreate is the builder for creating a ItemInstance entity.
+type ItemInstanceCreate struct {
+	config
+	mutation *ItemInstanceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetItemID sets the "item_id" field.
+func (iic *ItemInstanceCreate) SetItemID(s string) *ItemInstanceCreate {
+	iic.mutation.SetItemID(s)
+	return iic
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iic *ItemInstanceCreate) SetOwnerID(s string) *ItemInstanceCreate {
+	iic.mutation.SetOwnerID(s)
+	return iic
+}
+
+// SetDurability sets the "durability" field.
+func (iic *ItemInstanceCreate) SetDurability(i int) *ItemInstanceCreate {
+	iic.mutation.SetDurability(i)
+	return iic
+}
+
+// SetNillableDurability sets the "durability" field if the given value is not nil.
+func (iic *ItemInstanceCreate) SetNillableDurability(i *int) *ItemInstanceCreate {
+	if i != nil {
+		iic.SetDurability(*i)
+	}
+	return iic
+}
+
+// SetMaxDurability sets the "max_durability" field.
+func (iic *ItemInstanceCreate) SetMaxDurability(i int) *ItemInstanceCreate {
+	iic.mutation.SetMaxDurability(i)
+	return iic
+}
+
+// SetNillableMaxDurability sets the "max_durability" field if the given value is not nil.
+func (iic *ItemInstanceCreate) SetNillableMaxDurability(i *int) *ItemInstanceCreate {
+	if i != nil {
+		iic.SetMaxDurability(*i)
+	}
+	return iic
+}
+
+// SetStats sets the "stats" field.
+func (iic *ItemInstanceCreate) SetStats(m map[string]interface{}) *ItemInstanceCreate {
+	iic.mutation.SetStats(m)
+	return iic
+}
+
+// SetStatus sets the "status" field.
+func (iic *ItemInstanceCreate) SetStatus(s string) *ItemInstanceCreate {
+	iic.mutation.SetStatus(s)
+	return iic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iic *ItemInstanceCreate) SetNillableStatus(s *string) *ItemInstanceCreate {
+	if s != nil {
+		iic.SetStatus(*s)
+	}
+	return iic
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (iic *ItemInstanceCreate) SetBurnedAt(i int64) *ItemInstanceCreate {
+	iic.mutation.SetBurnedAt(i)
+	return iic
+}
+
+// SetNillableBurnedAt sets the "burned_at" field if the given value is not nil.
+func (iic *ItemInstanceCreate) SetNillableBurnedAt(i *int64) *ItemInstanceCreate {
+	if i != nil {
+		iic.SetBurnedAt(*i)
+	}
+	return iic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iic *ItemInstanceCreate) SetCreatedAt(i int64) *ItemInstanceCreate {
+	iic.mutation.SetCreatedAt(i)
+	return iic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iic *ItemInstanceCreate) SetNillableCreatedAt(i *int64) *ItemInstanceCreate {
+	if i != nil {
+		iic.SetCreatedAt(*i)
+	}
+	return iic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iic *ItemInstanceCreate) SetUpdatedAt(i int64) *ItemInstanceCreate {
+	iic.mutation.SetUpdatedAt(i)
+	return iic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iic *ItemInstanceCreate) SetNillableUpdatedAt(i *int64) *ItemInstanceCreate {
+	if i != nil {
+		iic.SetUpdatedAt(*i)
+	}
+	return iic
+}
+
+// SetID sets the "id" field.
+func (iic *ItemInstanceCreate) SetID(s string) *ItemInstanceCreate {
+	iic.mutation.SetID(s)
+	return iic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (iic *ItemInstanceCreate) SetNillableID(s *string) *ItemInstanceCreate {
+	if s != nil {
+		iic.SetID(*s)
+	}
+	return iic
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (iic *ItemInstanceCreate) SetOwner(p *Player) *ItemInstanceCreate {
+	return iic.SetOwnerID(p.ID)
+}
+
+// AddHistoryIDs adds the "history" edge to the ItemInstanceHistory entity by IDs.
+func (iic *ItemInstanceCreate) AddHistoryIDs(ids ...int64) *ItemInstanceCreate {
+	iic.mutation.AddHistoryIDs(ids...)
+	return iic
+}
+
+// AddHistory adds the "history" edges to the ItemInstanceHistory entity.
+func (iic *ItemInstanceCreate) AddHistory(i ...*ItemInstanceHistory) *ItemInstanceCreate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iic.AddHistoryIDs(ids...)
+}
+
+// Mutation returns the ItemInstanceMutation object of the builder.
+func (iic *ItemInstanceCreate) Mutation() *ItemInstanceMutation {
+	return iic.mutation
+}
+
+// Save creates the ItemInstance in the database.
+func (iic *ItemInstanceCreate) Save(ctx context.Context) (*ItemInstance, error) {
+	iic.defaults()
+	return withHooks(ctx, iic.sqlSave, iic.mutation, iic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iic *ItemInstanceCreate) SaveX(ctx context.Context) *ItemInstance {
+	v, err := iic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iic *ItemInstanceCreate) Exec(ctx context.Context) error {
+	_, err := iic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iic *ItemInstanceCreate) ExecX(ctx context.Context) {
+	if err := iic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iic *ItemInstanceCreate) defaults() {
+	if _, ok := iic.mutation.Durability(); !ok {
+		v := iteminstance.DefaultDurability
+		iic.mutation.SetDurability(v)
+	}
+	if _, ok := iic.mutation.MaxDurability(); !ok {
+		v := iteminstance.DefaultMaxDurability
+		iic.mutation.SetMaxDurability(v)
+	}
+	if _, ok := iic.mutation.Status(); !ok {
+		v := iteminstance.DefaultStatus
+		iic.mutation.SetStatus(v)
+	}
+	if _, ok := iic.mutation.BurnedAt(); !ok {
+		v := iteminstance.DefaultBurnedAt
+		iic.mutation.SetBurnedAt(v)
+	}
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		v := iteminstance.DefaultCreatedAt()
+		iic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := iic.mutation.UpdatedAt(); !ok {
+		v := iteminstance.DefaultUpdatedAt()
+		iic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := iic.mutation.ID(); !ok {
+		v := iteminstance.DefaultID()
+		iic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iic *ItemInstanceCreate) check() error {
+	if _, ok := iic.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`entc: missing required field "ItemInstance.item_id"`)}
+	}
+	if _, ok := iic.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`entc: missing required field "ItemInstance.owner_id"`)}
+	}
+	if _, ok := iic.mutation.Durability(); !ok {
+		return &ValidationError{Name: "durability", err: errors.New(`entc: missing required field "ItemInstance.durability"`)}
+	}
+	if _, ok := iic.mutation.MaxDurability(); !ok {
+		return &ValidationError{Name: "max_durability", err: errors.New(`entc: missing required field "ItemInstance.max_durability"`)}
+	}
+	if _, ok := iic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entc: missing required field "ItemInstance.status"`)}
+	}
+	if _, ok := iic.mutation.BurnedAt(); !ok {
+		return &ValidationError{Name: "burned_at", err: errors.New(`entc: missing required field "ItemInstance.burned_at"`)}
+	}
+	if _, ok := iic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "ItemInstance.created_at"`)}
+	}
+	if _, ok := iic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "ItemInstance.updated_at"`)}
+	}
+	if len(iic.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`entc: missing required edge "ItemInstance.owner"`)}
+	}
+	return nil
+}
+
+func (iic *ItemInstanceCreate) sqlSave(ctx context.Context) (*ItemInstance, error) {
+	if err := iic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ItemInstance.ID type: %T", _spec.ID.Value)
+		}
+	}
+	iic.mutation.id = &_node.ID
+	iic.mutation.done = true
+	return _node, nil
+}
+
+func (iic *ItemInstanceCreate) createSpec() (*ItemInstance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemInstance{config: iic.config}
+		_spec = sqlgraph.NewCreateSpec(iteminstance.Table, sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = iic.conflict
+	if id, ok := iic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iic.mutation.ItemID(); ok {
+		_spec.SetField(iteminstance.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := iic.mutation.Durability(); ok {
+		_spec.SetField(iteminstance.FieldDurability, field.TypeInt, value)
+		_node.Durability = value
+	}
+	if value, ok := iic.mutation.MaxDurability(); ok {
+		_spec.SetField(iteminstance.FieldMaxDurability, field.TypeInt, value)
+		_node.MaxDurability = value
+	}
+	if value, ok := iic.mutation.Stats(); ok {
+		_spec.SetField(iteminstance.FieldStats, field.TypeJSON, value)
+		_node.Stats = value
+	}
+	if value, ok := iic.mutation.Status(); ok {
+		_spec.SetField(iteminstance.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := iic.mutation.BurnedAt(); ok {
+		_spec.SetField(iteminstance.FieldBurnedAt, field.TypeInt64, value)
+		_node.BurnedAt = value
+	}
+	if value, ok := iic.mutation.CreatedAt(); ok {
+		_spec.SetField(iteminstance.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := iic.mutation.UpdatedAt(); ok {
+		_spec.SetField(iteminstance.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := iic.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstance.OwnerTable,
+			Columns: []string{iteminstance.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := iic.mutation.HistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   iteminstance.HistoryTable,
+			Columns: []string{iteminstance.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemInstance.Create().
+//		SetItemID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemInstanceUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (iic *ItemInstanceCreate) OnConflict(opts ...sql.ConflictOption) *ItemInstanceUpsertOne {
+	iic.conflict = opts
+	return &ItemInstanceUpsertOne{
+		create: iic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemInstance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iic *ItemInstanceCreate) OnConflictColumns(columns ...string) *ItemInstanceUpsertOne {
+	iic.conflict = append(iic.conflict, sql.ConflictColumns(columns...))
+	return &ItemInstanceUpsertOne{
+		create: iic,
+	}
+}
+
+type (
+	// ItemInstanceUpsertOne is the builder for "upsert"-ing
+	//  one ItemInstance node.
+	ItemInstanceUpsertOne struct {
+		create *ItemInstanceCreate
+	}
+
+	// ItemInstanceUpsert is the "OnConflict" setter.
+	ItemInstanceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOwnerID sets the "owner_id" field.
+func (u *ItemInstanceUpsert) SetOwnerID(v string) *ItemInstanceUpsert {
+	u.Set(iteminstance.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *ItemInstanceUpsert) UpdateOwnerID() *ItemInstanceUpsert {
+	u.SetExcluded(iteminstance.FieldOwnerID)
+	return u
+}
+
+// SetDurability sets the "durability" field.
+func (u *ItemInstanceUpsert) SetDurability(v int) *ItemInstanceUpsert {
+	u.Set(iteminstance.FieldDurability, v)
+	return u
+}
+
+// UpdateDurability sets the "durability" field to the value that was provided on create.
+func (u *ItemInstanceUpsert) UpdateDurability() *ItemInstanceUpsert {
+	u.SetExcluded(iteminstance.FieldDurability)
+	return u
+}
+
+// AddDurability adds v to the "durability" field.
+func (u *ItemInstanceUpsert) AddDurability(v int) *ItemInstanceUpsert {
+	u.Add(iteminstance.FieldDurability, v)
+	return u
+}
+
+// SetMaxDurability sets the "max_durability" field.
+func (u *ItemInstanceUpsert) SetMaxDurability(v int) *ItemInstanceUpsert {
+	u.Set(iteminstance.FieldMaxDurability, v)
+	return u
+}
+
+// UpdateMaxDurability sets the "max_durability" field to the value that was provided on create.
+func (u *ItemInstanceUpsert) UpdateMaxDurability() *ItemInstanceUpsert {
+	u.SetExcluded(iteminstance.FieldMaxDurability)
+	return u
+}
+
+// AddMaxDurability adds v to the "max_durability" field.
+func (u *ItemInstanceUpsert) AddMaxDurability(v int) *ItemInstanceUpsert {
+	u.Add(iteminstance.FieldMaxDurability, v)
+	return u
+}
+
+// SetStats sets the "stats" field.
+func (u *ItemInstanceUpsert) SetStats(v map[string]interface{}) *ItemInstanceUpsert {
+	u.Set(iteminstance.FieldStats, v)
+	return u
+}
+
+// UpdateStats sets the "stats" field to the value that was provided on create.
+func (u *ItemInstanceUpsert) UpdateStats() *ItemInstanceUpsert {
+	u.SetExcluded(iteminstance.FieldStats)
+	return u
+}
+
+// ClearStats clears the value of the "stats" field.
+func (u *ItemInstanceUpsert) ClearStats() *ItemInstanceUpsert {
+	u.SetNull(iteminstance.FieldStats)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ItemInstanceUpsert) SetStatus(v string) *ItemInstanceUpsert {
+	u.Set(iteminstance.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItemInstanceUpsert) UpdateStatus() *ItemInstanceUpsert {
+	u.SetExcluded(iteminstance.FieldStatus)
+	return u
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (u *ItemInstanceUpsert) SetBurnedAt(v int64) *ItemInstanceUpsert {
+	u.Set(iteminstance.FieldBurnedAt, v)
+	return u
+}
+
+// UpdateBurnedAt sets the "burned_at" field to the value that was provided on create.
+func (u *ItemInstanceUpsert) UpdateBurnedAt() *ItemInstanceUpsert {
+	u.SetExcluded(iteminstance.FieldBurnedAt)
+	return u
+}
+
+// AddBurnedAt adds v to the "burned_at" field.
+func (u *ItemInstanceUpsert) AddBurnedAt(v int64) *ItemInstanceUpsert {
+	u.Add(iteminstance.FieldBurnedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemInstanceUpsert) SetUpdatedAt(v int64) *ItemInstanceUpsert {
+	u.Set(iteminstance.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemInstanceUpsert) UpdateUpdatedAt() *ItemInstanceUpsert {
+	u.SetExcluded(iteminstance.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ItemInstanceUpsert) AddUpdatedAt(v int64) *ItemInstanceUpsert {
+	u.Add(iteminstance.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ItemInstance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(iteminstance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemInstanceUpsertOne) UpdateNewValues() *ItemInstanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(iteminstance.FieldID)
+		}
+		if _, exists := u.create.mutation.ItemID(); exists {
+			s.SetIgnore(iteminstance.FieldItemID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(iteminstance.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemInstance.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItemInstanceUpsertOne) Ignore() *ItemInstanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemInstanceUpsertOne) DoNothing() *ItemInstanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemInstanceCreate.OnConflict
+// documentation for more info.
+func (u *ItemInstanceUpsertOne) Update(set func(*ItemInstanceUpsert)) *ItemInstanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemInstanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *ItemInstanceUpsertOne) SetOwnerID(v string) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *ItemInstanceUpsertOne) UpdateOwnerID() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetDurability sets the "durability" field.
+func (u *ItemInstanceUpsertOne) SetDurability(v int) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetDurability(v)
+	})
+}
+
+// AddDurability adds v to the "durability" field.
+func (u *ItemInstanceUpsertOne) AddDurability(v int) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddDurability(v)
+	})
+}
+
+// UpdateDurability sets the "durability" field to the value that was provided on create.
+func (u *ItemInstanceUpsertOne) UpdateDurability() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateDurability()
+	})
+}
+
+// SetMaxDurability sets the "max_durability" field.
+func (u *ItemInstanceUpsertOne) SetMaxDurability(v int) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetMaxDurability(v)
+	})
+}
+
+// AddMaxDurability adds v to the "max_durability" field.
+func (u *ItemInstanceUpsertOne) AddMaxDurability(v int) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddMaxDurability(v)
+	})
+}
+
+// UpdateMaxDurability sets the "max_durability" field to the value that was provided on create.
+func (u *ItemInstanceUpsertOne) UpdateMaxDurability() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateMaxDurability()
+	})
+}
+
+// SetStats sets the "stats" field.
+func (u *ItemInstanceUpsertOne) SetStats(v map[string]interface{}) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetStats(v)
+	})
+}
+
+// UpdateStats sets the "stats" field to the value that was provided on create.
+func (u *ItemInstanceUpsertOne) UpdateStats() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateStats()
+	})
+}
+
+// ClearStats clears the value of the "stats" field.
+func (u *ItemInstanceUpsertOne) ClearStats() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.ClearStats()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ItemInstanceUpsertOne) SetStatus(v string) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItemInstanceUpsertOne) UpdateStatus() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (u *ItemInstanceUpsertOne) SetBurnedAt(v int64) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetBurnedAt(v)
+	})
+}
+
+// AddBurnedAt adds v to the "burned_at" field.
+func (u *ItemInstanceUpsertOne) AddBurnedAt(v int64) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddBurnedAt(v)
+	})
+}
+
+// UpdateBurnedAt sets the "burned_at" field to the value that was provided on create.
+func (u *ItemInstanceUpsertOne) UpdateBurnedAt() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateBurnedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemInstanceUpsertOne) SetUpdatedAt(v int64) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ItemInstanceUpsertOne) AddUpdatedAt(v int64) *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemInstanceUpsertOne) UpdateUpdatedAt() *ItemInstanceUpsertOne {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemInstanceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ItemInstanceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemInstanceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItemInstanceUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entc: ItemInstanceUpsertOne.ID is not supported by MySQL driver. Use ItemInstanceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItemInstanceUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ItemInstanceCreateBulk is the builder for creating many ItemInstance entities in bulk.
+type ItemInstanceCreateBulk struct {
+	config
+	err      error
+	builders []*ItemInstanceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ItemInstance entities in the database.
+func (iicb *ItemInstanceCreateBulk) Save(ctx context.Context) ([]*ItemInstance, error) {
+	if iicb.err != nil {
+		return nil, iicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iicb.builders))
+	nodes := make([]*ItemInstance, len(iicb.builders))
+	mutators := make([]Mutator, len(iicb.builders))
+	for i := range iicb.builders {
+		func(i int, root context.Context) {
+			builder := iicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemInstanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicb *ItemInstanceCreateBulk) SaveX(ctx context.Context) []*ItemInstance {
+	v, err := iicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iicb *ItemInstanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := iicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicb *ItemInstanceCreateBulk) ExecX(ctx context.Context) {
+	if err := iicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemInstance.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemInstanceUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (iicb *ItemInstanceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItemInstanceUpsertBulk {
+	iicb.conflict = opts
+	return &ItemInstanceUpsertBulk{
+		create: iicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemInstance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iicb *ItemInstanceCreateBulk) OnConflictColumns(columns ...string) *ItemInstanceUpsertBulk {
+	iicb.conflict = append(iicb.conflict, sql.ConflictColumns(columns...))
+	return &ItemInstanceUpsertBulk{
+		create: iicb,
+	}
+}
+
+// ItemInstanceUpsertBulk is the builder for "upsert"-ing
+// a bulk of ItemInstance nodes.
+type ItemInstanceUpsertBulk struct {
+	create *ItemInstanceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ItemInstance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(iteminstance.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemInstanceUpsertBulk) UpdateNewValues() *ItemInstanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(iteminstance.FieldID)
+			}
+			if _, exists := b.mutation.ItemID(); exists {
+				s.SetIgnore(iteminstance.FieldItemID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(iteminstance.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemInstance.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItemInstanceUpsertBulk) Ignore() *ItemInstanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemInstanceUpsertBulk) DoNothing() *ItemInstanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemInstanceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItemInstanceUpsertBulk) Update(set func(*ItemInstanceUpsert)) *ItemInstanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemInstanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *ItemInstanceUpsertBulk) SetOwnerID(v string) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *ItemInstanceUpsertBulk) UpdateOwnerID() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// SetDurability sets the "durability" field.
+func (u *ItemInstanceUpsertBulk) SetDurability(v int) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetDurability(v)
+	})
+}
+
+// AddDurability adds v to the "durability" field.
+func (u *ItemInstanceUpsertBulk) AddDurability(v int) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddDurability(v)
+	})
+}
+
+// UpdateDurability sets the "durability" field to the value that was provided on create.
+func (u *ItemInstanceUpsertBulk) UpdateDurability() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateDurability()
+	})
+}
+
+// SetMaxDurability sets the "max_durability" field.
+func (u *ItemInstanceUpsertBulk) SetMaxDurability(v int) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetMaxDurability(v)
+	})
+}
+
+// AddMaxDurability adds v to the "max_durability" field.
+func (u *ItemInstanceUpsertBulk) AddMaxDurability(v int) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddMaxDurability(v)
+	})
+}
+
+// UpdateMaxDurability sets the "max_durability" field to the value that was provided on create.
+func (u *ItemInstanceUpsertBulk) UpdateMaxDurability() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateMaxDurability()
+	})
+}
+
+// SetStats sets the "stats" field.
+func (u *ItemInstanceUpsertBulk) SetStats(v map[string]interface{}) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetStats(v)
+	})
+}
+
+// UpdateStats sets the "stats" field to the value that was provided on create.
+func (u *ItemInstanceUpsertBulk) UpdateStats() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateStats()
+	})
+}
+
+// ClearStats clears the value of the "stats" field.
+func (u *ItemInstanceUpsertBulk) ClearStats() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.ClearStats()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ItemInstanceUpsertBulk) SetStatus(v string) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItemInstanceUpsertBulk) UpdateStatus() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (u *ItemInstanceUpsertBulk) SetBurnedAt(v int64) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetBurnedAt(v)
+	})
+}
+
+// AddBurnedAt adds v to the "burned_at" field.
+func (u *ItemInstanceUpsertBulk) AddBurnedAt(v int64) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddBurnedAt(v)
+	})
+}
+
+// UpdateBurnedAt sets the "burned_at" field to the value that was provided on create.
+func (u *ItemInstanceUpsertBulk) UpdateBurnedAt() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateBurnedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemInstanceUpsertBulk) SetUpdatedAt(v int64) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ItemInstanceUpsertBulk) AddUpdatedAt(v int64) *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemInstanceUpsertBulk) UpdateUpdatedAt() *ItemInstanceUpsertBulk {
+	return u.Update(func(s *ItemInstanceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemInstanceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the ItemInstanceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ItemInstanceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemInstanceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemInstanceDelete is the builder for deleting a ItemInstance entity.
+type ItemInstanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemInstanceMutation
+}
+
+// Where appends a list predicates to the ItemInstanceDelete builder.
+func (iid *ItemInstanceDelete) Where(ps ...predicate.ItemInstance) *ItemInstanceDelete {
+	iid.mutation.Where(ps...)
+	return iid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iid *ItemInstanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iid.sqlExec, iid.mutation, iid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iid *ItemInstanceDelete) ExecX(ctx context.Context) int {
+	n, err := iid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iid *ItemInstanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(iteminstance.Table, sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString))
+	if ps := iid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iid.mutation.done = true
+	return affected, err
+}
+
+// ItemInstanceDeleteOne is the builder for deleting a single ItemInstance entity.
+type ItemInstanceDeleteOne struct {
+	iid *ItemInstanceDelete
+}
+
+// Where appends a list predicates to the ItemInstanceDelete builder.
+func (iido *ItemInstanceDeleteOne) Where(ps ...predicate.ItemInstance) *ItemInstanceDeleteOne {
+	iido.iid.mutation.Where(ps...)
+	return iido
+}
+
+// Exec executes the deletion query.
+func (iido *ItemInstanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := iido.iid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{iteminstance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iido *ItemInstanceDeleteOne) ExecX(ctx context.Context) {
+	if err := iido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemInstanceQuery is the builder for querying ItemInstance entities.
+type ItemInstanceQuery struct {
+	config
+	ctx         *QueryContext
+	order       []iteminstance.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ItemInstance
+	withOwner   *PlayerQuery
+	withHistory *ItemInstanceHistoryQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemInstanceQuery builder.
+func (iiq *ItemInstanceQuery) Where(ps ...predicate.ItemInstance) *ItemInstanceQuery {
+	iiq.predicates = append(iiq.predicates, ps...)
+	return iiq
+}
+
+// Limit the number of records to be returned by this query.
+func (iiq *ItemInstanceQuery) Limit(limit int) *ItemInstanceQuery {
+	iiq.ctx.Limit = &limit
+	return iiq
+}
+
+// Offset to start from.
+func (iiq *ItemInstanceQuery) Offset(offset int) *ItemInstanceQuery {
+	iiq.ctx.Offset = &offset
+	return iiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iiq *ItemInstanceQuery) Unique(unique bool) *ItemInstanceQuery {
+	iiq.ctx.Unique = &unique
+	return iiq
+}
+
+// Order specifies how the records should be ordered.
+func (iiq *ItemInstanceQuery) Order(o ...iteminstance.OrderOption) *ItemInstanceQuery {
+	iiq.order = append(iiq.order, o...)
+	return iiq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (iiq *ItemInstanceQuery) QueryOwner() *PlayerQuery {
+	query := (&PlayerClient{config: iiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(iteminstance.Table, iteminstance.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, iteminstance.OwnerTable, iteminstance.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHistory chains the current query on the "history" edge.
+func (iiq *ItemInstanceQuery) QueryHistory() *ItemInstanceHistoryQuery {
+	query := (&ItemInstanceHistoryClient{config: iiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(iteminstance.Table, iteminstance.FieldID, selector),
+			sqlgraph.To(iteminstancehistory.Table, iteminstancehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, iteminstance.HistoryTable, iteminstance.HistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemInstance entity from the query.
+// Returns a *NotFoundError when no ItemInstance was found.
+func (iiq *ItemInstanceQuery) First(ctx context.Context) (*ItemInstance, error) {
+	nodes, err := iiq.Limit(1).All(setContextOp(ctx, iiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{iteminstance.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) FirstX(ctx context.Context) *ItemInstance {
+	node, err := iiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemInstance ID from the query.
+// Returns a *NotFoundError when no ItemInstance ID was found.
+func (iiq *ItemInstanceQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iiq.Limit(1).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{iteminstance.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) FirstIDX(ctx context.Context) string {
+	id, err := iiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemInstance entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemInstance entity is found.
+// Returns a *NotFoundError when no ItemInstance entities are found.
+func (iiq *ItemInstanceQuery) Only(ctx context.Context) (*ItemInstance, error) {
+	nodes, err := iiq.Limit(2).All(setContextOp(ctx, iiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{iteminstance.Label}
+	default:
+		return nil, &NotSingularError{iteminstance.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) OnlyX(ctx context.Context) *ItemInstance {
+	node, err := iiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemInstance ID in the query.
+// Returns a *NotSingularError when more than one ItemInstance ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iiq *ItemInstanceQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = iiq.Limit(2).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{iteminstance.Label}
+	default:
+		err = &NotSingularError{iteminstance.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) OnlyIDX(ctx context.Context) string {
+	id, err := iiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemInstances.
+func (iiq *ItemInstanceQuery) All(ctx context.Context) ([]*ItemInstance, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryAll)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemInstance, *ItemInstanceQuery]()
+	return withInterceptors[[]*ItemInstance](ctx, iiq, qr, iiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) AllX(ctx context.Context) []*ItemInstance {
+	nodes, err := iiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemInstance IDs.
+func (iiq *ItemInstanceQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if iiq.ctx.Unique == nil && iiq.path != nil {
+		iiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryIDs)
+	if err = iiq.Select(iteminstance.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) IDsX(ctx context.Context) []string {
+	ids, err := iiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iiq *ItemInstanceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryCount)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iiq, querierCount[*ItemInstanceQuery](), iiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) CountX(ctx context.Context) int {
+	count, err := iiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iiq *ItemInstanceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryExist)
+	switch _, err := iiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iiq *ItemInstanceQuery) ExistX(ctx context.Context) bool {
+	exist, err := iiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemInstanceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iiq *ItemInstanceQuery) Clone() *ItemInstanceQuery {
+	if iiq == nil {
+		return nil
+	}
+	return &ItemInstanceQuery{
+		config:      iiq.config,
+		ctx:         iiq.ctx.Clone(),
+		order:       append([]iteminstance.OrderOption{}, iiq.order...),
+		inters:      append([]Interceptor{}, iiq.inters...),
+		predicates:  append([]predicate.ItemInstance{}, iiq.predicates...),
+		withOwner:   iiq.withOwner.Clone(),
+		withHistory: iiq.withHistory.Clone(),
+		// clone intermediate query.
+		sql:       iiq.sql.Clone(),
+		path:      iiq.path,
+		modifiers: append([]func(*sql.Selector){}, iiq.modifiers...),
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (iiq *ItemInstanceQuery) WithOwner(opts ...func(*PlayerQuery)) *ItemInstanceQuery {
+	query := (&PlayerClient{config: iiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iiq.withOwner = query
+	return iiq
+}
+
+// WithHistory tells the query-builder to eager-load the nodes that are connected to
+// the "history" edge. The optional arguments are used to configure the query builder of the edge.
+func (iiq *ItemInstanceQuery) WithHistory(opts ...func(*ItemInstanceHistoryQuery)) *ItemInstanceQuery {
+	query := (&ItemInstanceHistoryClient{config: iiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iiq.withHistory = query
+	return iiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID string `json:"item_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemInstance.Query().
+//		GroupBy(iteminstance.FieldItemID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (iiq *ItemInstanceQuery) GroupBy(field string, fields ...string) *ItemInstanceGroupBy {
+	iiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemInstanceGroupBy{build: iiq}
+	grbuild.flds = &iiq.ctx.Fields
+	grbuild.label = iteminstance.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID string `json:"item_id,omitempty"`
+//	}
+//
+//	client.ItemInstance.Query().
+//		Select(iteminstance.FieldItemID).
+//		Scan(ctx, &v)
+func (iiq *ItemInstanceQuery) Select(fields ...string) *ItemInstanceSelect {
+	iiq.ctx.Fields = append(iiq.ctx.Fields, fields...)
+	sbuild := &ItemInstanceSelect{ItemInstanceQuery: iiq}
+	sbuild.label = iteminstance.Label
+	sbuild.flds, sbuild.scan = &iiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemInstanceSelect configured with the given aggregations.
+func (iiq *ItemInstanceQuery) Aggregate(fns ...AggregateFunc) *ItemInstanceSelect {
+	return iiq.Select().Aggregate(fns...)
+}
+
+func (iiq *ItemInstanceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iiq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iiq.ctx.Fields {
+		if !iteminstance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if iiq.path != nil {
+		prev, err := iiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iiq.sql = prev
+	}
+	return nil
+}
+
+func (iiq *ItemInstanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemInstance, error) {
+	var (
+		nodes       = []*ItemInstance{}
+		_spec       = iiq.querySpec()
+		loadedTypes = [2]bool{
+			iiq.withOwner != nil,
+			iiq.withHistory != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemInstance).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemInstance{config: iiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := iiq.withOwner; query != nil {
+		if err := iiq.loadOwner(ctx, query, nodes, nil,
+			func(n *ItemInstance, e *Player) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iiq.withHistory; query != nil {
+		if err := iiq.loadHistory(ctx, query, nodes,
+			func(n *ItemInstance) { n.Edges.History = []*ItemInstanceHistory{} },
+			func(n *ItemInstance, e *ItemInstanceHistory) { n.Edges.History = append(n.Edges.History, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (iiq *ItemInstanceQuery) loadOwner(ctx context.Context, query *PlayerQuery, nodes []*ItemInstance, init func(*ItemInstance), assign func(*ItemInstance, *Player)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ItemInstance)
+	for i := range nodes {
+		fk := nodes[i].OwnerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(player.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (iiq *ItemInstanceQuery) loadHistory(ctx context.Context, query *ItemInstanceHistoryQuery, nodes []*ItemInstance, init func(*ItemInstance), assign func(*ItemInstance, *ItemInstanceHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ItemInstance)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(iteminstancehistory.FieldInstanceID)
+	}
+	query.Where(predicate.ItemInstanceHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(iteminstance.HistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.InstanceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "instance_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (iiq *ItemInstanceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iiq.querySpec()
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	_spec.Node.Columns = iiq.ctx.Fields
+	if len(iiq.ctx.Fields) > 0 {
+		_spec.Unique = iiq.ctx.Unique != nil && *iiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iiq.driver, _spec)
+}
+
+func (iiq *ItemInstanceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(iteminstance.Table, iteminstance.Columns, sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString))
+	_spec.From = iiq.sql
+	if unique := iiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, iteminstance.FieldID)
+		for i := range fields {
+			if fields[i] != iteminstance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if iiq.withOwner != nil {
+			_spec.Node.AddColumnOnce(iteminstance.FieldOwnerID)
+		}
+	}
+	if ps := iiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iiq *ItemInstanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iiq.driver.Dialect())
+	t1 := builder.Table(iteminstance.Table)
+	columns := iiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = iteminstance.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iiq.sql != nil {
+		selector = iiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iiq.ctx.Unique != nil && *iiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range iiq.modifiers {
+		m(selector)
+	}
+	for _, p := range iiq.predicates {
+		p(selector)
+	}
+	for _, p := range iiq.order {
+		p(selector)
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iiq *ItemInstanceQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemInstanceSelect {
+	iiq.modifiers = append(iiq.modifiers, modifiers...)
+	return iiq.Select()
+}
+
+// ItemInstanceGroupBy is the group-by builder for ItemInstance entities.
+type ItemInstanceGroupBy struct {
+	selector
+	build *ItemInstanceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iigb *ItemInstanceGroupBy) Aggregate(fns ...AggregateFunc) *ItemInstanceGroupBy {
+	iigb.fns = append(iigb.fns, fns...)
+	return iigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iigb *ItemInstanceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iigb.build.ctx, ent.OpQueryGroupBy)
+	if err := iigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemInstanceQuery, *ItemInstanceGroupBy](ctx, iigb.build, iigb, iigb.build.inters, v)
+}
+
+func (iigb *ItemInstanceGroupBy) sqlScan(ctx context.Context, root *ItemInstanceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iigb.fns))
+	for _, fn := range iigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iigb.flds)+len(iigb.fns))
+		for _, f := range *iigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemInstanceSelect is the builder for selecting fields of ItemInstance entities.
+type ItemInstanceSelect struct {
+	*ItemInstanceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iis *ItemInstanceSelect) Aggregate(fns ...AggregateFunc) *ItemInstanceSelect {
+	iis.fns = append(iis.fns, fns...)
+	return iis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iis *ItemInstanceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iis.ctx, ent.OpQuerySelect)
+	if err := iis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemInstanceQuery, *ItemInstanceSelect](ctx, iis.ItemInstanceQuery, iis, iis.inters, v)
+}
+
+func (iis *ItemInstanceSelect) sqlScan(ctx context.Context, root *ItemInstanceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iis.fns))
+	for _, fn := range iis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iis *ItemInstanceSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemInstanceSelect {
+	iis.modifiers = append(iis.modifiers, modifiers...)
+	return iis
+}
@@ -0,0 +1,773 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemInstanceUpdate is the builder for updating ItemInstance entities.
+type ItemInstanceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemInstanceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemInstanceUpdate builder.
+func (iiu *ItemInstanceUpdate) Where(ps ...predicate.ItemInstance) *ItemInstanceUpdate {
+	iiu.mutation.Where(ps...)
+	return iiu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iiu *ItemInstanceUpdate) SetOwnerID(s string) *ItemInstanceUpdate {
+	iiu.mutation.SetOwnerID(s)
+	return iiu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (iiu *ItemInstanceUpdate) SetNillableOwnerID(s *string) *ItemInstanceUpdate {
+	if s != nil {
+		iiu.SetOwnerID(*s)
+	}
+	return iiu
+}
+
+// SetDurability sets the "durability" field.
+func (iiu *ItemInstanceUpdate) SetDurability(i int) *ItemInstanceUpdate {
+	iiu.mutation.ResetDurability()
+	iiu.mutation.SetDurability(i)
+	return iiu
+}
+
+// SetNillableDurability sets the "durability" field if the given value is not nil.
+func (iiu *ItemInstanceUpdate) SetNillableDurability(i *int) *ItemInstanceUpdate {
+	if i != nil {
+		iiu.SetDurability(*i)
+	}
+	return iiu
+}
+
+// AddDurability adds i to the "durability" field.
+func (iiu *ItemInstanceUpdate) AddDurability(i int) *ItemInstanceUpdate {
+	iiu.mutation.AddDurability(i)
+	return iiu
+}
+
+// SetMaxDurability sets the "max_durability" field.
+func (iiu *ItemInstanceUpdate) SetMaxDurability(i int) *ItemInstanceUpdate {
+	iiu.mutation.ResetMaxDurability()
+	iiu.mutation.SetMaxDurability(i)
+	return iiu
+}
+
+// SetNillableMaxDurability sets the "max_durability" field if the given value is not nil.
+func (iiu *ItemInstanceUpdate) SetNillableMaxDurability(i *int) *ItemInstanceUpdate {
+	if i != nil {
+		iiu.SetMaxDurability(*i)
+	}
+	return iiu
+}
+
+// AddMaxDurability adds i to the "max_durability" field.
+func (iiu *ItemInstanceUpdate) AddMaxDurability(i int) *ItemInstanceUpdate {
+	iiu.mutation.AddMaxDurability(i)
+	return iiu
+}
+
+// SetStats sets the "stats" field.
+func (iiu *ItemInstanceUpdate) SetStats(m map[string]interface{}) *ItemInstanceUpdate {
+	iiu.mutation.SetStats(m)
+	return iiu
+}
+
+// ClearStats clears the value of the "stats" field.
+func (iiu *ItemInstanceUpdate) ClearStats() *ItemInstanceUpdate {
+	iiu.mutation.ClearStats()
+	return iiu
+}
+
+// SetStatus sets the "status" field.
+func (iiu *ItemInstanceUpdate) SetStatus(s string) *ItemInstanceUpdate {
+	iiu.mutation.SetStatus(s)
+	return iiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiu *ItemInstanceUpdate) SetNillableStatus(s *string) *ItemInstanceUpdate {
+	if s != nil {
+		iiu.SetStatus(*s)
+	}
+	return iiu
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (iiu *ItemInstanceUpdate) SetBurnedAt(i int64) *ItemInstanceUpdate {
+	iiu.mutation.ResetBurnedAt()
+	iiu.mutation.SetBurnedAt(i)
+	return iiu
+}
+
+// SetNillableBurnedAt sets the "burned_at" field if the given value is not nil.
+func (iiu *ItemInstanceUpdate) SetNillableBurnedAt(i *int64) *ItemInstanceUpdate {
+	if i != nil {
+		iiu.SetBurnedAt(*i)
+	}
+	return iiu
+}
+
+// AddBurnedAt adds i to the "burned_at" field.
+func (iiu *ItemInstanceUpdate) AddBurnedAt(i int64) *ItemInstanceUpdate {
+	iiu.mutation.AddBurnedAt(i)
+	return iiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iiu *ItemInstanceUpdate) SetUpdatedAt(i int64) *ItemInstanceUpdate {
+	iiu.mutation.ResetUpdatedAt()
+	iiu.mutation.SetUpdatedAt(i)
+	return iiu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (iiu *ItemInstanceUpdate) AddUpdatedAt(i int64) *ItemInstanceUpdate {
+	iiu.mutation.AddUpdatedAt(i)
+	return iiu
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (iiu *ItemInstanceUpdate) SetOwner(p *Player) *ItemInstanceUpdate {
+	return iiu.SetOwnerID(p.ID)
+}
+
+// AddHistoryIDs adds the "history" edge to the ItemInstanceHistory entity by IDs.
+func (iiu *ItemInstanceUpdate) AddHistoryIDs(ids ...int64) *ItemInstanceUpdate {
+	iiu.mutation.AddHistoryIDs(ids...)
+	return iiu
+}
+
+// AddHistory adds the "history" edges to the ItemInstanceHistory entity.
+func (iiu *ItemInstanceUpdate) AddHistory(i ...*ItemInstanceHistory) *ItemInstanceUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iiu.AddHistoryIDs(ids...)
+}
+
+// Mutation returns the ItemInstanceMutation object of the builder.
+func (iiu *ItemInstanceUpdate) Mutation() *ItemInstanceMutation {
+	return iiu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Player entity.
+func (iiu *ItemInstanceUpdate) ClearOwner() *ItemInstanceUpdate {
+	iiu.mutation.ClearOwner()
+	return iiu
+}
+
+// ClearHistory clears all "history" edges to the ItemInstanceHistory entity.
+func (iiu *ItemInstanceUpdate) ClearHistory() *ItemInstanceUpdate {
+	iiu.mutation.ClearHistory()
+	return iiu
+}
+
+// RemoveHistoryIDs removes the "history" edge to ItemInstanceHistory entities by IDs.
+func (iiu *ItemInstanceUpdate) RemoveHistoryIDs(ids ...int64) *ItemInstanceUpdate {
+	iiu.mutation.RemoveHistoryIDs(ids...)
+	return iiu
+}
+
+// RemoveHistory removes "history" edges to ItemInstanceHistory entities.
+func (iiu *ItemInstanceUpdate) RemoveHistory(i ...*ItemInstanceHistory) *ItemInstanceUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iiu.RemoveHistoryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iiu *ItemInstanceUpdate) Save(ctx context.Context) (int, error) {
+	iiu.defaults()
+	return withHooks(ctx, iiu.sqlSave, iiu.mutation, iiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiu *ItemInstanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iiu *ItemInstanceUpdate) Exec(ctx context.Context) error {
+	_, err := iiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiu *ItemInstanceUpdate) ExecX(ctx context.Context) {
+	if err := iiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiu *ItemInstanceUpdate) defaults() {
+	if _, ok := iiu.mutation.UpdatedAt(); !ok {
+		v := iteminstance.UpdateDefaultUpdatedAt()
+		iiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiu *ItemInstanceUpdate) check() error {
+	if iiu.mutation.OwnerCleared() && len(iiu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ItemInstance.owner"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiu *ItemInstanceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemInstanceUpdate {
+	iiu.modifiers = append(iiu.modifiers, modifiers...)
+	return iiu
+}
+
+func (iiu *ItemInstanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(iteminstance.Table, iteminstance.Columns, sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString))
+	if ps := iiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiu.mutation.Durability(); ok {
+		_spec.SetField(iteminstance.FieldDurability, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.AddedDurability(); ok {
+		_spec.AddField(iteminstance.FieldDurability, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.MaxDurability(); ok {
+		_spec.SetField(iteminstance.FieldMaxDurability, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.AddedMaxDurability(); ok {
+		_spec.AddField(iteminstance.FieldMaxDurability, field.TypeInt, value)
+	}
+	if value, ok := iiu.mutation.Stats(); ok {
+		_spec.SetField(iteminstance.FieldStats, field.TypeJSON, value)
+	}
+	if iiu.mutation.StatsCleared() {
+		_spec.ClearField(iteminstance.FieldStats, field.TypeJSON)
+	}
+	if value, ok := iiu.mutation.Status(); ok {
+		_spec.SetField(iteminstance.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iiu.mutation.BurnedAt(); ok {
+		_spec.SetField(iteminstance.FieldBurnedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.AddedBurnedAt(); ok {
+		_spec.AddField(iteminstance.FieldBurnedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(iteminstance.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(iteminstance.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if iiu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstance.OwnerTable,
+			Columns: []string{iteminstance.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstance.OwnerTable,
+			Columns: []string{iteminstance.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iiu.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   iteminstance.HistoryTable,
+			Columns: []string{iteminstance.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiu.mutation.RemovedHistoryIDs(); len(nodes) > 0 && !iiu.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   iteminstance.HistoryTable,
+			Columns: []string{iteminstance.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiu.mutation.HistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   iteminstance.HistoryTable,
+			Columns: []string{iteminstance.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iiu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{iteminstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iiu.mutation.done = true
+	return n, nil
+}
+
+// ItemInstanceUpdateOne is the builder for updating a single ItemInstance entity.
+type ItemInstanceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemInstanceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (iiuo *ItemInstanceUpdateOne) SetOwnerID(s string) *ItemInstanceUpdateOne {
+	iiuo.mutation.SetOwnerID(s)
+	return iiuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (iiuo *ItemInstanceUpdateOne) SetNillableOwnerID(s *string) *ItemInstanceUpdateOne {
+	if s != nil {
+		iiuo.SetOwnerID(*s)
+	}
+	return iiuo
+}
+
+// SetDurability sets the "durability" field.
+func (iiuo *ItemInstanceUpdateOne) SetDurability(i int) *ItemInstanceUpdateOne {
+	iiuo.mutation.ResetDurability()
+	iiuo.mutation.SetDurability(i)
+	return iiuo
+}
+
+// SetNillableDurability sets the "durability" field if the given value is not nil.
+func (iiuo *ItemInstanceUpdateOne) SetNillableDurability(i *int) *ItemInstanceUpdateOne {
+	if i != nil {
+		iiuo.SetDurability(*i)
+	}
+	return iiuo
+}
+
+// AddDurability adds i to the "durability" field.
+func (iiuo *ItemInstanceUpdateOne) AddDurability(i int) *ItemInstanceUpdateOne {
+	iiuo.mutation.AddDurability(i)
+	return iiuo
+}
+
+// SetMaxDurability sets the "max_durability" field.
+func (iiuo *ItemInstanceUpdateOne) SetMaxDurability(i int) *ItemInstanceUpdateOne {
+	iiuo.mutation.ResetMaxDurability()
+	iiuo.mutation.SetMaxDurability(i)
+	return iiuo
+}
+
+// SetNillableMaxDurability sets the "max_durability" field if the given value is not nil.
+func (iiuo *ItemInstanceUpdateOne) SetNillableMaxDurability(i *int) *ItemInstanceUpdateOne {
+	if i != nil {
+		iiuo.SetMaxDurability(*i)
+	}
+	return iiuo
+}
+
+// AddMaxDurability adds i to the "max_durability" field.
+func (iiuo *ItemInstanceUpdateOne) AddMaxDurability(i int) *ItemInstanceUpdateOne {
+	iiuo.mutation.AddMaxDurability(i)
+	return iiuo
+}
+
+// SetStats sets the "stats" field.
+func (iiuo *ItemInstanceUpdateOne) SetStats(m map[string]interface{}) *ItemInstanceUpdateOne {
+	iiuo.mutation.SetStats(m)
+	return iiuo
+}
+
+// ClearStats clears the value of the "stats" field.
+func (iiuo *ItemInstanceUpdateOne) ClearStats() *ItemInstanceUpdateOne {
+	iiuo.mutation.ClearStats()
+	return iiuo
+}
+
+// SetStatus sets the "status" field.
+func (iiuo *ItemInstanceUpdateOne) SetStatus(s string) *ItemInstanceUpdateOne {
+	iiuo.mutation.SetStatus(s)
+	return iiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiuo *ItemInstanceUpdateOne) SetNillableStatus(s *string) *ItemInstanceUpdateOne {
+	if s != nil {
+		iiuo.SetStatus(*s)
+	}
+	return iiuo
+}
+
+// SetBurnedAt sets the "burned_at" field.
+func (iiuo *ItemInstanceUpdateOne) SetBurnedAt(i int64) *ItemInstanceUpdateOne {
+	iiuo.mutation.ResetBurnedAt()
+	iiuo.mutation.SetBurnedAt(i)
+	return iiuo
+}
+
+// SetNillableBurnedAt sets the "burned_at" field if the given value is not nil.
+func (iiuo *ItemInstanceUpdateOne) SetNillableBurnedAt(i *int64) *ItemInstanceUpdateOne {
+	if i != nil {
+		iiuo.SetBurnedAt(*i)
+	}
+	return iiuo
+}
+
+// AddBurnedAt adds i to the "burned_at" field.
+func (iiuo *ItemInstanceUpdateOne) AddBurnedAt(i int64) *ItemInstanceUpdateOne {
+	iiuo.mutation.AddBurnedAt(i)
+	return iiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iiuo *ItemInstanceUpdateOne) SetUpdatedAt(i int64) *ItemInstanceUpdateOne {
+	iiuo.mutation.ResetUpdatedAt()
+	iiuo.mutation.SetUpdatedAt(i)
+	return iiuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (iiuo *ItemInstanceUpdateOne) AddUpdatedAt(i int64) *ItemInstanceUpdateOne {
+	iiuo.mutation.AddUpdatedAt(i)
+	return iiuo
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (iiuo *ItemInstanceUpdateOne) SetOwner(p *Player) *ItemInstanceUpdateOne {
+	return iiuo.SetOwnerID(p.ID)
+}
+
+// AddHistoryIDs adds the "history" edge to the ItemInstanceHistory entity by IDs.
+func (iiuo *ItemInstanceUpdateOne) AddHistoryIDs(ids ...int64) *ItemInstanceUpdateOne {
+	iiuo.mutation.AddHistoryIDs(ids...)
+	return iiuo
+}
+
+// AddHistory adds the "history" edges to the ItemInstanceHistory entity.
+func (iiuo *ItemInstanceUpdateOne) AddHistory(i ...*ItemInstanceHistory) *ItemInstanceUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iiuo.AddHistoryIDs(ids...)
+}
+
+// Mutation returns the ItemInstanceMutation object of the builder.
+func (iiuo *ItemInstanceUpdateOne) Mutation() *ItemInstanceMutation {
+	return iiuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Player entity.
+func (iiuo *ItemInstanceUpdateOne) ClearOwner() *ItemInstanceUpdateOne {
+	iiuo.mutation.ClearOwner()
+	return iiuo
+}
+
+// ClearHistory clears all "history" edges to the ItemInstanceHistory entity.
+func (iiuo *ItemInstanceUpdateOne) ClearHistory() *ItemInstanceUpdateOne {
+	iiuo.mutation.ClearHistory()
+	return iiuo
+}
+
+// RemoveHistoryIDs removes the "history" edge to ItemInstanceHistory entities by IDs.
+func (iiuo *ItemInstanceUpdateOne) RemoveHistoryIDs(ids ...int64) *ItemInstanceUpdateOne {
+	iiuo.mutation.RemoveHistoryIDs(ids...)
+	return iiuo
+}
+
+// RemoveHistory removes "history" edges to ItemInstanceHistory entities.
+func (iiuo *ItemInstanceUpdateOne) RemoveHistory(i ...*ItemInstanceHistory) *ItemInstanceUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iiuo.RemoveHistoryIDs(ids...)
+}
+
+// Where appends a list predicates to the ItemInstanceUpdate builder.
+func (iiuo *ItemInstanceUpdateOne) Where(ps ...predicate.ItemInstance) *ItemInstanceUpdateOne {
+	iiuo.mutation.Where(ps...)
+	return iiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iiuo *ItemInstanceUpdateOne) Select(field string, fields ...string) *ItemInstanceUpdateOne {
+	iiuo.fields = append([]string{field}, fields...)
+	return iiuo
+}
+
+// Save executes the query and returns the updated ItemInstance entity.
+func (iiuo *ItemInstanceUpdateOne) Save(ctx context.Context) (*ItemInstance, error) {
+	iiuo.defaults()
+	return withHooks(ctx, iiuo.sqlSave, iiuo.mutation, iiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiuo *ItemInstanceUpdateOne) SaveX(ctx context.Context) *ItemInstance {
+	node, err := iiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iiuo *ItemInstanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiuo *ItemInstanceUpdateOne) ExecX(ctx context.Context) {
+	if err := iiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iiuo *ItemInstanceUpdateOne) defaults() {
+	if _, ok := iiuo.mutation.UpdatedAt(); !ok {
+		v := iteminstance.UpdateDefaultUpdatedAt()
+		iiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiuo *ItemInstanceUpdateOne) check() error {
+	if iiuo.mutation.OwnerCleared() && len(iiuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ItemInstance.owner"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiuo *ItemInstanceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemInstanceUpdateOne {
+	iiuo.modifiers = append(iiuo.modifiers, modifiers...)
+	return iiuo
+}
+
+func (iiuo *ItemInstanceUpdateOne) sqlSave(ctx context.Context) (_node *ItemInstance, err error) {
+	if err := iiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(iteminstance.Table, iteminstance.Columns, sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString))
+	id, ok := iiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "ItemInstance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, iteminstance.FieldID)
+		for _, f := range fields {
+			if !iteminstance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != iteminstance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiuo.mutation.Durability(); ok {
+		_spec.SetField(iteminstance.FieldDurability, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.AddedDurability(); ok {
+		_spec.AddField(iteminstance.FieldDurability, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.MaxDurability(); ok {
+		_spec.SetField(iteminstance.FieldMaxDurability, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.AddedMaxDurability(); ok {
+		_spec.AddField(iteminstance.FieldMaxDurability, field.TypeInt, value)
+	}
+	if value, ok := iiuo.mutation.Stats(); ok {
+		_spec.SetField(iteminstance.FieldStats, field.TypeJSON, value)
+	}
+	if iiuo.mutation.StatsCleared() {
+		_spec.ClearField(iteminstance.FieldStats, field.TypeJSON)
+	}
+	if value, ok := iiuo.mutation.Status(); ok {
+		_spec.SetField(iteminstance.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := iiuo.mutation.BurnedAt(); ok {
+		_spec.SetField(iteminstance.FieldBurnedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.AddedBurnedAt(); ok {
+		_spec.AddField(iteminstance.FieldBurnedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(iteminstance.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(iteminstance.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if iiuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstance.OwnerTable,
+			Columns: []string{iteminstance.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstance.OwnerTable,
+			Columns: []string{iteminstance.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iiuo.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   iteminstance.HistoryTable,
+			Columns: []string{iteminstance.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiuo.mutation.RemovedHistoryIDs(); len(nodes) > 0 && !iiuo.mutation.HistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   iteminstance.HistoryTable,
+			Columns: []string{iteminstance.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiuo.mutation.HistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   iteminstance.HistoryTable,
+			Columns: []string{iteminstance.HistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iiuo.modifiers...)
+	_node = &ItemInstance{config: iiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{iteminstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+)
+
+// ItemInstanceHistory is the model entity for the ItemInstanceHistory schema.
+type ItemInstanceHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// InstanceID holds the value of the "instance_id" field.
+	InstanceID string `json:"instance_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// FromPlayerID holds the value of the "from_player_id" field.
+	FromPlayerID string `json:"from_player_id,omitempty"`
+	// ToPlayerID holds the value of the "to_player_id" field.
+	ToPlayerID string `json:"to_player_id,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemInstanceHistoryQuery when eager-loading is set.
+	Edges        ItemInstanceHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemInstanceHistoryEdges holds the relations/edges for other nodes in the graph.
+type ItemInstanceHistoryEdges struct {
+	// Instance holds the value of the instance edge.
+	Instance *ItemInstance `json:"instance,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// InstanceOrErr returns the Instance value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemInstanceHistoryEdges) InstanceOrErr() (*ItemInstance, error) {
+	if e.Instance != nil {
+		return e.Instance, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: iteminstance.Label}
+	}
+	return nil, &NotLoadedError{edge: "instance"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemInstanceHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case iteminstancehistory.FieldID, iteminstancehistory.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case iteminstancehistory.FieldInstanceID, iteminstancehistory.FieldAction, iteminstancehistory.FieldFromPlayerID, iteminstancehistory.FieldToPlayerID, iteminstancehistory.FieldActor, iteminstancehistory.FieldReason:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemInstanceHistory fields.
+func (iih *ItemInstanceHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case iteminstancehistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			iih.ID = int64(value.Int64)
+		case iteminstancehistory.FieldInstanceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance_id", values[i])
+			} else if value.Valid {
+				iih.InstanceID = value.String
+			}
+		case iteminstancehistory.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				iih.Action = value.String
+			}
+		case iteminstancehistory.FieldFromPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_player_id", values[i])
+			} else if value.Valid {
+				iih.FromPlayerID = value.String
+			}
+		case iteminstancehistory.FieldToPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_player_id", values[i])
+			} else if value.Valid {
+				iih.ToPlayerID = value.String
+			}
+		case iteminstancehistory.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				iih.Actor = value.String
+			}
+		case iteminstancehistory.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				iih.Reason = value.String
+			}
+		case iteminstancehistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				iih.CreatedAt = value.Int64
+			}
+		default:
+			iih.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemInstanceHistory.
+// This includes values selected through modifiers, order, etc.
+func (iih *ItemInstanceHistory) Value(name string) (ent.Value, error) {
+	return iih.selectValues.Get(name)
+}
+
+// QueryInstance queries the "instance" edge of the ItemInstanceHistory entity.
+func (iih *ItemInstanceHistory) QueryInstance() *ItemInstanceQuery {
+	return NewItemInstanceHistoryClient(iih.config).QueryInstance(iih)
+}
+
+// Update returns a builder for updating this ItemInstanceHistory.
+// Note that you need to call ItemInstanceHistory.Unwrap() before calling this method if this ItemInstanceHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (iih *ItemInstanceHistory) Update() *ItemInstanceHistoryUpdateOne {
+	return NewItemInstanceHistoryClient(iih.config).UpdateOne(iih)
+}
+
+// Unwrap unwraps the ItemInstanceHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (iih *ItemInstanceHistory) Unwrap() *ItemInstanceHistory {
+	_tx, ok := iih.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: ItemInstanceHistory is not a transactional entity")
+	}
+	iih.config.driver = _tx.drv
+	return iih
+}
+
+// String implements the fmt.Stringer.
+func (iih *ItemInstanceHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemInstanceHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", iih.ID))
+	builder.WriteString("instance_id=")
+	builder.WriteString(iih.InstanceID)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(iih.Action)
+	builder.WriteString(", ")
+	builder.WriteString("from_player_id=")
+	builder.WriteString(iih.FromPlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("to_player_id=")
+	builder.WriteString(iih.ToPlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(iih.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(iih.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", iih.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemInstanceHistories is a parsable slice of ItemInstanceHistory.
+type ItemInstanceHistories []*ItemInstanceHistory
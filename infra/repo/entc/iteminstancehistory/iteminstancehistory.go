@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iteminstancehistory
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the iteminstancehistory type in the database.
+	Label = "item_instance_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInstanceID holds the string denoting the instance_id field in the database.
+	FieldInstanceID = "instance_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldFromPlayerID holds the string denoting the from_player_id field in the database.
+	FieldFromPlayerID = "from_player_id"
+	// FieldToPlayerID holds the string denoting the to_player_id field in the database.
+	FieldToPlayerID = "to_player_id"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeInstance holds the string denoting the instance edge name in mutations.
+	EdgeInstance = "instance"
+	// Table holds the table name of the iteminstancehistory in the database.
+	Table = "item_instance_histories"
+	// InstanceTable is the table that holds the instance relation/edge.
+	InstanceTable = "item_instance_histories"
+	// InstanceInverseTable is the table name for the ItemInstance entity.
+	// It exists in this package in order to avoid circular dependency with the "iteminstance" package.
+	InstanceInverseTable = "item_instances"
+	// InstanceColumn is the table column denoting the instance relation/edge.
+	InstanceColumn = "instance_id"
+)
+
+// Columns holds all SQL columns for iteminstancehistory fields.
+var Columns = []string{
+	FieldID,
+	FieldInstanceID,
+	FieldAction,
+	FieldFromPlayerID,
+	FieldToPlayerID,
+	FieldActor,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFromPlayerID holds the default value on creation for the "from_player_id" field.
+	DefaultFromPlayerID string
+	// DefaultToPlayerID holds the default value on creation for the "to_player_id" field.
+	DefaultToPlayerID string
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+)
+
+// OrderOption defines the ordering options for the ItemInstanceHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByInstanceID orders the results by the instance_id field.
+func ByInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstanceID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByFromPlayerID orders the results by the from_player_id field.
+func ByFromPlayerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromPlayerID, opts...).ToFunc()
+}
+
+// ByToPlayerID orders the results by the to_player_id field.
+func ByToPlayerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToPlayerID, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByInstanceField orders the results by instance field.
+func ByInstanceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInstanceStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newInstanceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InstanceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InstanceTable, InstanceColumn),
+	)
+}
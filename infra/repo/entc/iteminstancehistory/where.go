@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iteminstancehistory
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldID, id))
+}
+
+// InstanceID applies equality check predicate on the "instance_id" field. It's identical to InstanceIDEQ.
+func InstanceID(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldInstanceID, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldAction, v))
+}
+
+// FromPlayerID applies equality check predicate on the "from_player_id" field. It's identical to FromPlayerIDEQ.
+func FromPlayerID(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldFromPlayerID, v))
+}
+
+// ToPlayerID applies equality check predicate on the "to_player_id" field. It's identical to ToPlayerIDEQ.
+func ToPlayerID(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldToPlayerID, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// InstanceIDEQ applies the EQ predicate on the "instance_id" field.
+func InstanceIDEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldInstanceID, v))
+}
+
+// InstanceIDNEQ applies the NEQ predicate on the "instance_id" field.
+func InstanceIDNEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldInstanceID, v))
+}
+
+// InstanceIDIn applies the In predicate on the "instance_id" field.
+func InstanceIDIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldInstanceID, vs...))
+}
+
+// InstanceIDNotIn applies the NotIn predicate on the "instance_id" field.
+func InstanceIDNotIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldInstanceID, vs...))
+}
+
+// InstanceIDGT applies the GT predicate on the "instance_id" field.
+func InstanceIDGT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldInstanceID, v))
+}
+
+// InstanceIDGTE applies the GTE predicate on the "instance_id" field.
+func InstanceIDGTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldInstanceID, v))
+}
+
+// InstanceIDLT applies the LT predicate on the "instance_id" field.
+func InstanceIDLT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldInstanceID, v))
+}
+
+// InstanceIDLTE applies the LTE predicate on the "instance_id" field.
+func InstanceIDLTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldInstanceID, v))
+}
+
+// InstanceIDContains applies the Contains predicate on the "instance_id" field.
+func InstanceIDContains(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContains(FieldInstanceID, v))
+}
+
+// InstanceIDHasPrefix applies the HasPrefix predicate on the "instance_id" field.
+func InstanceIDHasPrefix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasPrefix(FieldInstanceID, v))
+}
+
+// InstanceIDHasSuffix applies the HasSuffix predicate on the "instance_id" field.
+func InstanceIDHasSuffix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasSuffix(FieldInstanceID, v))
+}
+
+// InstanceIDEqualFold applies the EqualFold predicate on the "instance_id" field.
+func InstanceIDEqualFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEqualFold(FieldInstanceID, v))
+}
+
+// InstanceIDContainsFold applies the ContainsFold predicate on the "instance_id" field.
+func InstanceIDContainsFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContainsFold(FieldInstanceID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContainsFold(FieldAction, v))
+}
+
+// FromPlayerIDEQ applies the EQ predicate on the "from_player_id" field.
+func FromPlayerIDEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDNEQ applies the NEQ predicate on the "from_player_id" field.
+func FromPlayerIDNEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDIn applies the In predicate on the "from_player_id" field.
+func FromPlayerIDIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldFromPlayerID, vs...))
+}
+
+// FromPlayerIDNotIn applies the NotIn predicate on the "from_player_id" field.
+func FromPlayerIDNotIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldFromPlayerID, vs...))
+}
+
+// FromPlayerIDGT applies the GT predicate on the "from_player_id" field.
+func FromPlayerIDGT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDGTE applies the GTE predicate on the "from_player_id" field.
+func FromPlayerIDGTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDLT applies the LT predicate on the "from_player_id" field.
+func FromPlayerIDLT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDLTE applies the LTE predicate on the "from_player_id" field.
+func FromPlayerIDLTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDContains applies the Contains predicate on the "from_player_id" field.
+func FromPlayerIDContains(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContains(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDHasPrefix applies the HasPrefix predicate on the "from_player_id" field.
+func FromPlayerIDHasPrefix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasPrefix(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDHasSuffix applies the HasSuffix predicate on the "from_player_id" field.
+func FromPlayerIDHasSuffix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasSuffix(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDEqualFold applies the EqualFold predicate on the "from_player_id" field.
+func FromPlayerIDEqualFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEqualFold(FieldFromPlayerID, v))
+}
+
+// FromPlayerIDContainsFold applies the ContainsFold predicate on the "from_player_id" field.
+func FromPlayerIDContainsFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContainsFold(FieldFromPlayerID, v))
+}
+
+// ToPlayerIDEQ applies the EQ predicate on the "to_player_id" field.
+func ToPlayerIDEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldToPlayerID, v))
+}
+
+// ToPlayerIDNEQ applies the NEQ predicate on the "to_player_id" field.
+func ToPlayerIDNEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldToPlayerID, v))
+}
+
+// ToPlayerIDIn applies the In predicate on the "to_player_id" field.
+func ToPlayerIDIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldToPlayerID, vs...))
+}
+
+// ToPlayerIDNotIn applies the NotIn predicate on the "to_player_id" field.
+func ToPlayerIDNotIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldToPlayerID, vs...))
+}
+
+// ToPlayerIDGT applies the GT predicate on the "to_player_id" field.
+func ToPlayerIDGT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldToPlayerID, v))
+}
+
+// ToPlayerIDGTE applies the GTE predicate on the "to_player_id" field.
+func ToPlayerIDGTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldToPlayerID, v))
+}
+
+// ToPlayerIDLT applies the LT predicate on the "to_player_id" field.
+func ToPlayerIDLT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldToPlayerID, v))
+}
+
+// ToPlayerIDLTE applies the LTE predicate on the "to_player_id" field.
+func ToPlayerIDLTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldToPlayerID, v))
+}
+
+// ToPlayerIDContains applies the Contains predicate on the "to_player_id" field.
+func ToPlayerIDContains(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContains(FieldToPlayerID, v))
+}
+
+// ToPlayerIDHasPrefix applies the HasPrefix predicate on the "to_player_id" field.
+func ToPlayerIDHasPrefix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasPrefix(FieldToPlayerID, v))
+}
+
+// ToPlayerIDHasSuffix applies the HasSuffix predicate on the "to_player_id" field.
+func ToPlayerIDHasSuffix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasSuffix(FieldToPlayerID, v))
+}
+
+// ToPlayerIDEqualFold applies the EqualFold predicate on the "to_player_id" field.
+func ToPlayerIDEqualFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEqualFold(FieldToPlayerID, v))
+}
+
+// ToPlayerIDContainsFold applies the ContainsFold predicate on the "to_player_id" field.
+func ToPlayerIDContainsFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContainsFold(FieldToPlayerID, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContainsFold(FieldActor, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasInstance applies the HasEdge predicate on the "instance" edge.
+func HasInstance() predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InstanceTable, InstanceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInstanceWith applies the HasEdge predicate on the "instance" edge with a given conditions (other predicates).
+func HasInstanceWith(preds ...predicate.ItemInstance) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(func(s *sql.Selector) {
+		step := newInstanceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemInstanceHistory) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemInstanceHistory) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemInstanceHistory) predicate.ItemInstanceHistory {
+	return predicate.ItemInstanceHistory(sql.NotPredicates(p))
+}
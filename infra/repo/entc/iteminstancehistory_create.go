@@ -0,0 +1,844 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+)
+
+// ItemInstanceHistoryCreate is the builder for creating a ItemInstanceHistory entity.
+type ItemInstanceHistoryCreate struct {
+	config
+	mutation *ItemInstanceHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (iihc *ItemInstanceHistoryCreate) SetInstanceID(s string) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetInstanceID(s)
+	return iihc
+}
+
+// SetAction sets the "action" field.
+func (iihc *ItemInstanceHistoryCreate) SetAction(s string) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetAction(s)
+	return iihc
+}
+
+// SetFromPlayerID sets the "from_player_id" field.
+func (iihc *ItemInstanceHistoryCreate) SetFromPlayerID(s string) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetFromPlayerID(s)
+	return iihc
+}
+
+// SetNillableFromPlayerID sets the "from_player_id" field if the given value is not nil.
+func (iihc *ItemInstanceHistoryCreate) SetNillableFromPlayerID(s *string) *ItemInstanceHistoryCreate {
+	if s != nil {
+		iihc.SetFromPlayerID(*s)
+	}
+	return iihc
+}
+
+// SetToPlayerID sets the "to_player_id" field.
+func (iihc *ItemInstanceHistoryCreate) SetToPlayerID(s string) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetToPlayerID(s)
+	return iihc
+}
+
+// SetNillableToPlayerID sets the "to_player_id" field if the given value is not nil.
+func (iihc *ItemInstanceHistoryCreate) SetNillableToPlayerID(s *string) *ItemInstanceHistoryCreate {
+	if s != nil {
+		iihc.SetToPlayerID(*s)
+	}
+	return iihc
+}
+
+// SetActor sets the "actor" field.
+func (iihc *ItemInstanceHistoryCreate) SetActor(s string) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetActor(s)
+	return iihc
+}
+
+// SetReason sets the "reason" field.
+func (iihc *ItemInstanceHistoryCreate) SetReason(s string) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetReason(s)
+	return iihc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (iihc *ItemInstanceHistoryCreate) SetNillableReason(s *string) *ItemInstanceHistoryCreate {
+	if s != nil {
+		iihc.SetReason(*s)
+	}
+	return iihc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iihc *ItemInstanceHistoryCreate) SetCreatedAt(i int64) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetCreatedAt(i)
+	return iihc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iihc *ItemInstanceHistoryCreate) SetNillableCreatedAt(i *int64) *ItemInstanceHistoryCreate {
+	if i != nil {
+		iihc.SetCreatedAt(*i)
+	}
+	return iihc
+}
+
+// SetID sets the "id" field.
+func (iihc *ItemInstanceHistoryCreate) SetID(i int64) *ItemInstanceHistoryCreate {
+	iihc.mutation.SetID(i)
+	return iihc
+}
+
+// SetInstance sets the "instance" edge to the ItemInstance entity.
+func (iihc *ItemInstanceHistoryCreate) SetInstance(i *ItemInstance) *ItemInstanceHistoryCreate {
+	return iihc.SetInstanceID(i.ID)
+}
+
+// Mutation returns the ItemInstanceHistoryMutation object of the builder.
+func (iihc *ItemInstanceHistoryCreate) Mutation() *ItemInstanceHistoryMutation {
+	return iihc.mutation
+}
+
+// Save creates the ItemInstanceHistory in the database.
+func (iihc *ItemInstanceHistoryCreate) Save(ctx context.Context) (*ItemInstanceHistory, error) {
+	iihc.defaults()
+	return withHooks(ctx, iihc.sqlSave, iihc.mutation, iihc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iihc *ItemInstanceHistoryCreate) SaveX(ctx context.Context) *ItemInstanceHistory {
+	v, err := iihc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iihc *ItemInstanceHistoryCreate) Exec(ctx context.Context) error {
+	_, err := iihc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iihc *ItemInstanceHistoryCreate) ExecX(ctx context.Context) {
+	if err := iihc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iihc *ItemInstanceHistoryCreate) defaults() {
+	if _, ok := iihc.mutation.FromPlayerID(); !ok {
+		v := iteminstancehistory.DefaultFromPlayerID
+		iihc.mutation.SetFromPlayerID(v)
+	}
+	if _, ok := iihc.mutation.ToPlayerID(); !ok {
+		v := iteminstancehistory.DefaultToPlayerID
+		iihc.mutation.SetToPlayerID(v)
+	}
+	if _, ok := iihc.mutation.Reason(); !ok {
+		v := iteminstancehistory.DefaultReason
+		iihc.mutation.SetReason(v)
+	}
+	if _, ok := iihc.mutation.CreatedAt(); !ok {
+		v := iteminstancehistory.DefaultCreatedAt()
+		iihc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iihc *ItemInstanceHistoryCreate) check() error {
+	if _, ok := iihc.mutation.InstanceID(); !ok {
+		return &ValidationError{Name: "instance_id", err: errors.New(`entc: missing required field "ItemInstanceHistory.instance_id"`)}
+	}
+	if _, ok := iihc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`entc: missing required field "ItemInstanceHistory.action"`)}
+	}
+	if _, ok := iihc.mutation.FromPlayerID(); !ok {
+		return &ValidationError{Name: "from_player_id", err: errors.New(`entc: missing required field "ItemInstanceHistory.from_player_id"`)}
+	}
+	if _, ok := iihc.mutation.ToPlayerID(); !ok {
+		return &ValidationError{Name: "to_player_id", err: errors.New(`entc: missing required field "ItemInstanceHistory.to_player_id"`)}
+	}
+	if _, ok := iihc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`entc: missing required field "ItemInstanceHistory.actor"`)}
+	}
+	if _, ok := iihc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`entc: missing required field "ItemInstanceHistory.reason"`)}
+	}
+	if _, ok := iihc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "ItemInstanceHistory.created_at"`)}
+	}
+	if len(iihc.mutation.InstanceIDs()) == 0 {
+		return &ValidationError{Name: "instance", err: errors.New(`entc: missing required edge "ItemInstanceHistory.instance"`)}
+	}
+	return nil
+}
+
+func (iihc *ItemInstanceHistoryCreate) sqlSave(ctx context.Context) (*ItemInstanceHistory, error) {
+	if err := iihc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iihc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iihc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	iihc.mutation.id = &_node.ID
+	iihc.mutation.done = true
+	return _node, nil
+}
+
+func (iihc *ItemInstanceHistoryCreate) createSpec() (*ItemInstanceHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemInstanceHistory{config: iihc.config}
+		_spec = sqlgraph.NewCreateSpec(iteminstancehistory.Table, sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = iihc.conflict
+	if id, ok := iihc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iihc.mutation.Action(); ok {
+		_spec.SetField(iteminstancehistory.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := iihc.mutation.FromPlayerID(); ok {
+		_spec.SetField(iteminstancehistory.FieldFromPlayerID, field.TypeString, value)
+		_node.FromPlayerID = value
+	}
+	if value, ok := iihc.mutation.ToPlayerID(); ok {
+		_spec.SetField(iteminstancehistory.FieldToPlayerID, field.TypeString, value)
+		_node.ToPlayerID = value
+	}
+	if value, ok := iihc.mutation.Actor(); ok {
+		_spec.SetField(iteminstancehistory.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := iihc.mutation.Reason(); ok {
+		_spec.SetField(iteminstancehistory.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := iihc.mutation.CreatedAt(); ok {
+		_spec.SetField(iteminstancehistory.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if nodes := iihc.mutation.InstanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstancehistory.InstanceTable,
+			Columns: []string{iteminstancehistory.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InstanceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemInstanceHistory.Create().
+//		SetInstanceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemInstanceHistoryUpsert) {
+//			SetInstanceID(v+v).
+//		}).
+//		Exec(ctx)
+func (iihc *ItemInstanceHistoryCreate) OnConflict(opts ...sql.ConflictOption) *ItemInstanceHistoryUpsertOne {
+	iihc.conflict = opts
+	return &ItemInstanceHistoryUpsertOne{
+		create: iihc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemInstanceHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iihc *ItemInstanceHistoryCreate) OnConflictColumns(columns ...string) *ItemInstanceHistoryUpsertOne {
+	iihc.conflict = append(iihc.conflict, sql.ConflictColumns(columns...))
+	return &ItemInstanceHistoryUpsertOne{
+		create: iihc,
+	}
+}
+
+type (
+	// ItemInstanceHistoryUpsertOne is the builder for "upsert"-ing
+	//  one ItemInstanceHistory node.
+	ItemInstanceHistoryUpsertOne struct {
+		create *ItemInstanceHistoryCreate
+	}
+
+	// ItemInstanceHistoryUpsert is the "OnConflict" setter.
+	ItemInstanceHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetInstanceID sets the "instance_id" field.
+func (u *ItemInstanceHistoryUpsert) SetInstanceID(v string) *ItemInstanceHistoryUpsert {
+	u.Set(iteminstancehistory.FieldInstanceID, v)
+	return u
+}
+
+// UpdateInstanceID sets the "instance_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsert) UpdateInstanceID() *ItemInstanceHistoryUpsert {
+	u.SetExcluded(iteminstancehistory.FieldInstanceID)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *ItemInstanceHistoryUpsert) SetAction(v string) *ItemInstanceHistoryUpsert {
+	u.Set(iteminstancehistory.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsert) UpdateAction() *ItemInstanceHistoryUpsert {
+	u.SetExcluded(iteminstancehistory.FieldAction)
+	return u
+}
+
+// SetFromPlayerID sets the "from_player_id" field.
+func (u *ItemInstanceHistoryUpsert) SetFromPlayerID(v string) *ItemInstanceHistoryUpsert {
+	u.Set(iteminstancehistory.FieldFromPlayerID, v)
+	return u
+}
+
+// UpdateFromPlayerID sets the "from_player_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsert) UpdateFromPlayerID() *ItemInstanceHistoryUpsert {
+	u.SetExcluded(iteminstancehistory.FieldFromPlayerID)
+	return u
+}
+
+// SetToPlayerID sets the "to_player_id" field.
+func (u *ItemInstanceHistoryUpsert) SetToPlayerID(v string) *ItemInstanceHistoryUpsert {
+	u.Set(iteminstancehistory.FieldToPlayerID, v)
+	return u
+}
+
+// UpdateToPlayerID sets the "to_player_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsert) UpdateToPlayerID() *ItemInstanceHistoryUpsert {
+	u.SetExcluded(iteminstancehistory.FieldToPlayerID)
+	return u
+}
+
+// SetActor sets the "actor" field.
+func (u *ItemInstanceHistoryUpsert) SetActor(v string) *ItemInstanceHistoryUpsert {
+	u.Set(iteminstancehistory.FieldActor, v)
+	return u
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsert) UpdateActor() *ItemInstanceHistoryUpsert {
+	u.SetExcluded(iteminstancehistory.FieldActor)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *ItemInstanceHistoryUpsert) SetReason(v string) *ItemInstanceHistoryUpsert {
+	u.Set(iteminstancehistory.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsert) UpdateReason() *ItemInstanceHistoryUpsert {
+	u.SetExcluded(iteminstancehistory.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ItemInstanceHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(iteminstancehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemInstanceHistoryUpsertOne) UpdateNewValues() *ItemInstanceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(iteminstancehistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(iteminstancehistory.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemInstanceHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItemInstanceHistoryUpsertOne) Ignore() *ItemInstanceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemInstanceHistoryUpsertOne) DoNothing() *ItemInstanceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemInstanceHistoryCreate.OnConflict
+// documentation for more info.
+func (u *ItemInstanceHistoryUpsertOne) Update(set func(*ItemInstanceHistoryUpsert)) *ItemInstanceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemInstanceHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (u *ItemInstanceHistoryUpsertOne) SetInstanceID(v string) *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetInstanceID(v)
+	})
+}
+
+// UpdateInstanceID sets the "instance_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertOne) UpdateInstanceID() *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateInstanceID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *ItemInstanceHistoryUpsertOne) SetAction(v string) *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertOne) UpdateAction() *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetFromPlayerID sets the "from_player_id" field.
+func (u *ItemInstanceHistoryUpsertOne) SetFromPlayerID(v string) *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetFromPlayerID(v)
+	})
+}
+
+// UpdateFromPlayerID sets the "from_player_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertOne) UpdateFromPlayerID() *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateFromPlayerID()
+	})
+}
+
+// SetToPlayerID sets the "to_player_id" field.
+func (u *ItemInstanceHistoryUpsertOne) SetToPlayerID(v string) *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetToPlayerID(v)
+	})
+}
+
+// UpdateToPlayerID sets the "to_player_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertOne) UpdateToPlayerID() *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateToPlayerID()
+	})
+}
+
+// SetActor sets the "actor" field.
+func (u *ItemInstanceHistoryUpsertOne) SetActor(v string) *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertOne) UpdateActor() *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ItemInstanceHistoryUpsertOne) SetReason(v string) *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertOne) UpdateReason() *ItemInstanceHistoryUpsertOne {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemInstanceHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ItemInstanceHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemInstanceHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItemInstanceHistoryUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItemInstanceHistoryUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ItemInstanceHistoryCreateBulk is the builder for creating many ItemInstanceHistory entities in bulk.
+type ItemInstanceHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*ItemInstanceHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ItemInstanceHistory entities in the database.
+func (iihcb *ItemInstanceHistoryCreateBulk) Save(ctx context.Context) ([]*ItemInstanceHistory, error) {
+	if iihcb.err != nil {
+		return nil, iihcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iihcb.builders))
+	nodes := make([]*ItemInstanceHistory, len(iihcb.builders))
+	mutators := make([]Mutator, len(iihcb.builders))
+	for i := range iihcb.builders {
+		func(i int, root context.Context) {
+			builder := iihcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemInstanceHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iihcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iihcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iihcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iihcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iihcb *ItemInstanceHistoryCreateBulk) SaveX(ctx context.Context) []*ItemInstanceHistory {
+	v, err := iihcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iihcb *ItemInstanceHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := iihcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iihcb *ItemInstanceHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := iihcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemInstanceHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemInstanceHistoryUpsert) {
+//			SetInstanceID(v+v).
+//		}).
+//		Exec(ctx)
+func (iihcb *ItemInstanceHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItemInstanceHistoryUpsertBulk {
+	iihcb.conflict = opts
+	return &ItemInstanceHistoryUpsertBulk{
+		create: iihcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemInstanceHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iihcb *ItemInstanceHistoryCreateBulk) OnConflictColumns(columns ...string) *ItemInstanceHistoryUpsertBulk {
+	iihcb.conflict = append(iihcb.conflict, sql.ConflictColumns(columns...))
+	return &ItemInstanceHistoryUpsertBulk{
+		create: iihcb,
+	}
+}
+
+// ItemInstanceHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ItemInstanceHistory nodes.
+type ItemInstanceHistoryUpsertBulk struct {
+	create *ItemInstanceHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ItemInstanceHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(iteminstancehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemInstanceHistoryUpsertBulk) UpdateNewValues() *ItemInstanceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(iteminstancehistory.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(iteminstancehistory.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemInstanceHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItemInstanceHistoryUpsertBulk) Ignore() *ItemInstanceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemInstanceHistoryUpsertBulk) DoNothing() *ItemInstanceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemInstanceHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItemInstanceHistoryUpsertBulk) Update(set func(*ItemInstanceHistoryUpsert)) *ItemInstanceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemInstanceHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (u *ItemInstanceHistoryUpsertBulk) SetInstanceID(v string) *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetInstanceID(v)
+	})
+}
+
+// UpdateInstanceID sets the "instance_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertBulk) UpdateInstanceID() *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateInstanceID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *ItemInstanceHistoryUpsertBulk) SetAction(v string) *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertBulk) UpdateAction() *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetFromPlayerID sets the "from_player_id" field.
+func (u *ItemInstanceHistoryUpsertBulk) SetFromPlayerID(v string) *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetFromPlayerID(v)
+	})
+}
+
+// UpdateFromPlayerID sets the "from_player_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertBulk) UpdateFromPlayerID() *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateFromPlayerID()
+	})
+}
+
+// SetToPlayerID sets the "to_player_id" field.
+func (u *ItemInstanceHistoryUpsertBulk) SetToPlayerID(v string) *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetToPlayerID(v)
+	})
+}
+
+// UpdateToPlayerID sets the "to_player_id" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertBulk) UpdateToPlayerID() *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateToPlayerID()
+	})
+}
+
+// SetActor sets the "actor" field.
+func (u *ItemInstanceHistoryUpsertBulk) SetActor(v string) *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetActor(v)
+	})
+}
+
+// UpdateActor sets the "actor" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertBulk) UpdateActor() *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateActor()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *ItemInstanceHistoryUpsertBulk) SetReason(v string) *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *ItemInstanceHistoryUpsertBulk) UpdateReason() *ItemInstanceHistoryUpsertBulk {
+	return u.Update(func(s *ItemInstanceHistoryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemInstanceHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the ItemInstanceHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ItemInstanceHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemInstanceHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemInstanceHistoryDelete is the builder for deleting a ItemInstanceHistory entity.
+type ItemInstanceHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemInstanceHistoryMutation
+}
+
+// Where appends a list predicates to the ItemInstanceHistoryDelete builder.
+func (iihd *ItemInstanceHistoryDelete) Where(ps ...predicate.ItemInstanceHistory) *ItemInstanceHistoryDelete {
+	iihd.mutation.Where(ps...)
+	return iihd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iihd *ItemInstanceHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iihd.sqlExec, iihd.mutation, iihd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iihd *ItemInstanceHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := iihd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iihd *ItemInstanceHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(iteminstancehistory.Table, sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64))
+	if ps := iihd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iihd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iihd.mutation.done = true
+	return affected, err
+}
+
+// ItemInstanceHistoryDeleteOne is the builder for deleting a single ItemInstanceHistory entity.
+type ItemInstanceHistoryDeleteOne struct {
+	iihd *ItemInstanceHistoryDelete
+}
+
+// Where appends a list predicates to the ItemInstanceHistoryDelete builder.
+func (iihdo *ItemInstanceHistoryDeleteOne) Where(ps ...predicate.ItemInstanceHistory) *ItemInstanceHistoryDeleteOne {
+	iihdo.iihd.mutation.Where(ps...)
+	return iihdo
+}
+
+// Exec executes the deletion query.
+func (iihdo *ItemInstanceHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := iihdo.iihd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{iteminstancehistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iihdo *ItemInstanceHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := iihdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
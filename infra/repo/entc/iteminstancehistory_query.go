@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemInstanceHistoryQuery is the builder for querying ItemInstanceHistory entities.
+type ItemInstanceHistoryQuery struct {
+	config
+	ctx          *QueryContext
+	order        []iteminstancehistory.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ItemInstanceHistory
+	withInstance *ItemInstanceQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemInstanceHistoryQuery builder.
+func (iihq *ItemInstanceHistoryQuery) Where(ps ...predicate.ItemInstanceHistory) *ItemInstanceHistoryQuery {
+	iihq.predicates = append(iihq.predicates, ps...)
+	return iihq
+}
+
+// Limit the number of records to be returned by this query.
+func (iihq *ItemInstanceHistoryQuery) Limit(limit int) *ItemInstanceHistoryQuery {
+	iihq.ctx.Limit = &limit
+	return iihq
+}
+
+// Offset to start from.
+func (iihq *ItemInstanceHistoryQuery) Offset(offset int) *ItemInstanceHistoryQuery {
+	iihq.ctx.Offset = &offset
+	return iihq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iihq *ItemInstanceHistoryQuery) Unique(unique bool) *ItemInstanceHistoryQuery {
+	iihq.ctx.Unique = &unique
+	return iihq
+}
+
+// Order specifies how the records should be ordered.
+func (iihq *ItemInstanceHistoryQuery) Order(o ...iteminstancehistory.OrderOption) *ItemInstanceHistoryQuery {
+	iihq.order = append(iihq.order, o...)
+	return iihq
+}
+
+// QueryInstance chains the current query on the "instance" edge.
+func (iihq *ItemInstanceHistoryQuery) QueryInstance() *ItemInstanceQuery {
+	query := (&ItemInstanceClient{config: iihq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iihq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iihq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(iteminstancehistory.Table, iteminstancehistory.FieldID, selector),
+			sqlgraph.To(iteminstance.Table, iteminstance.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, iteminstancehistory.InstanceTable, iteminstancehistory.InstanceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iihq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemInstanceHistory entity from the query.
+// Returns a *NotFoundError when no ItemInstanceHistory was found.
+func (iihq *ItemInstanceHistoryQuery) First(ctx context.Context) (*ItemInstanceHistory, error) {
+	nodes, err := iihq.Limit(1).All(setContextOp(ctx, iihq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{iteminstancehistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) FirstX(ctx context.Context) *ItemInstanceHistory {
+	node, err := iihq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemInstanceHistory ID from the query.
+// Returns a *NotFoundError when no ItemInstanceHistory ID was found.
+func (iihq *ItemInstanceHistoryQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = iihq.Limit(1).IDs(setContextOp(ctx, iihq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{iteminstancehistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := iihq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemInstanceHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemInstanceHistory entity is found.
+// Returns a *NotFoundError when no ItemInstanceHistory entities are found.
+func (iihq *ItemInstanceHistoryQuery) Only(ctx context.Context) (*ItemInstanceHistory, error) {
+	nodes, err := iihq.Limit(2).All(setContextOp(ctx, iihq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{iteminstancehistory.Label}
+	default:
+		return nil, &NotSingularError{iteminstancehistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) OnlyX(ctx context.Context) *ItemInstanceHistory {
+	node, err := iihq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemInstanceHistory ID in the query.
+// Returns a *NotSingularError when more than one ItemInstanceHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iihq *ItemInstanceHistoryQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = iihq.Limit(2).IDs(setContextOp(ctx, iihq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{iteminstancehistory.Label}
+	default:
+		err = &NotSingularError{iteminstancehistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := iihq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemInstanceHistories.
+func (iihq *ItemInstanceHistoryQuery) All(ctx context.Context) ([]*ItemInstanceHistory, error) {
+	ctx = setContextOp(ctx, iihq.ctx, ent.OpQueryAll)
+	if err := iihq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemInstanceHistory, *ItemInstanceHistoryQuery]()
+	return withInterceptors[[]*ItemInstanceHistory](ctx, iihq, qr, iihq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) AllX(ctx context.Context) []*ItemInstanceHistory {
+	nodes, err := iihq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemInstanceHistory IDs.
+func (iihq *ItemInstanceHistoryQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if iihq.ctx.Unique == nil && iihq.path != nil {
+		iihq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iihq.ctx, ent.OpQueryIDs)
+	if err = iihq.Select(iteminstancehistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := iihq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iihq *ItemInstanceHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iihq.ctx, ent.OpQueryCount)
+	if err := iihq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iihq, querierCount[*ItemInstanceHistoryQuery](), iihq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) CountX(ctx context.Context) int {
+	count, err := iihq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iihq *ItemInstanceHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iihq.ctx, ent.OpQueryExist)
+	switch _, err := iihq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iihq *ItemInstanceHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := iihq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemInstanceHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iihq *ItemInstanceHistoryQuery) Clone() *ItemInstanceHistoryQuery {
+	if iihq == nil {
+		return nil
+	}
+	return &ItemInstanceHistoryQuery{
+		config:       iihq.config,
+		ctx:          iihq.ctx.Clone(),
+		order:        append([]iteminstancehistory.OrderOption{}, iihq.order...),
+		inters:       append([]Interceptor{}, iihq.inters...),
+		predicates:   append([]predicate.ItemInstanceHistory{}, iihq.predicates...),
+		withInstance: iihq.withInstance.Clone(),
+		// clone intermediate query.
+		sql:       iihq.sql.Clone(),
+		path:      iihq.path,
+		modifiers: append([]func(*sql.Selector){}, iihq.modifiers...),
+	}
+}
+
+// WithInstance tells the query-builder to eager-load the nodes that are connected to
+// the "instance" edge. The optional arguments are used to configure the query builder of the edge.
+func (iihq *ItemInstanceHistoryQuery) WithInstance(opts ...func(*ItemInstanceQuery)) *ItemInstanceHistoryQuery {
+	query := (&ItemInstanceClient{config: iihq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iihq.withInstance = query
+	return iihq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InstanceID string `json:"instance_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemInstanceHistory.Query().
+//		GroupBy(iteminstancehistory.FieldInstanceID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (iihq *ItemInstanceHistoryQuery) GroupBy(field string, fields ...string) *ItemInstanceHistoryGroupBy {
+	iihq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemInstanceHistoryGroupBy{build: iihq}
+	grbuild.flds = &iihq.ctx.Fields
+	grbuild.label = iteminstancehistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InstanceID string `json:"instance_id,omitempty"`
+//	}
+//
+//	client.ItemInstanceHistory.Query().
+//		Select(iteminstancehistory.FieldInstanceID).
+//		Scan(ctx, &v)
+func (iihq *ItemInstanceHistoryQuery) Select(fields ...string) *ItemInstanceHistorySelect {
+	iihq.ctx.Fields = append(iihq.ctx.Fields, fields...)
+	sbuild := &ItemInstanceHistorySelect{ItemInstanceHistoryQuery: iihq}
+	sbuild.label = iteminstancehistory.Label
+	sbuild.flds, sbuild.scan = &iihq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemInstanceHistorySelect configured with the given aggregations.
+func (iihq *ItemInstanceHistoryQuery) Aggregate(fns ...AggregateFunc) *ItemInstanceHistorySelect {
+	return iihq.Select().Aggregate(fns...)
+}
+
+func (iihq *ItemInstanceHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iihq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iihq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iihq.ctx.Fields {
+		if !iteminstancehistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if iihq.path != nil {
+		prev, err := iihq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iihq.sql = prev
+	}
+	return nil
+}
+
+func (iihq *ItemInstanceHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemInstanceHistory, error) {
+	var (
+		nodes       = []*ItemInstanceHistory{}
+		_spec       = iihq.querySpec()
+		loadedTypes = [1]bool{
+			iihq.withInstance != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemInstanceHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemInstanceHistory{config: iihq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(iihq.modifiers) > 0 {
+		_spec.Modifiers = iihq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iihq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := iihq.withInstance; query != nil {
+		if err := iihq.loadInstance(ctx, query, nodes, nil,
+			func(n *ItemInstanceHistory, e *ItemInstance) { n.Edges.Instance = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (iihq *ItemInstanceHistoryQuery) loadInstance(ctx context.Context, query *ItemInstanceQuery, nodes []*ItemInstanceHistory, init func(*ItemInstanceHistory), assign func(*ItemInstanceHistory, *ItemInstance)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ItemInstanceHistory)
+	for i := range nodes {
+		fk := nodes[i].InstanceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(iteminstance.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "instance_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (iihq *ItemInstanceHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iihq.querySpec()
+	if len(iihq.modifiers) > 0 {
+		_spec.Modifiers = iihq.modifiers
+	}
+	_spec.Node.Columns = iihq.ctx.Fields
+	if len(iihq.ctx.Fields) > 0 {
+		_spec.Unique = iihq.ctx.Unique != nil && *iihq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iihq.driver, _spec)
+}
+
+func (iihq *ItemInstanceHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(iteminstancehistory.Table, iteminstancehistory.Columns, sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64))
+	_spec.From = iihq.sql
+	if unique := iihq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iihq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iihq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, iteminstancehistory.FieldID)
+		for i := range fields {
+			if fields[i] != iteminstancehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if iihq.withInstance != nil {
+			_spec.Node.AddColumnOnce(iteminstancehistory.FieldInstanceID)
+		}
+	}
+	if ps := iihq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iihq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iihq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iihq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iihq *ItemInstanceHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iihq.driver.Dialect())
+	t1 := builder.Table(iteminstancehistory.Table)
+	columns := iihq.ctx.Fields
+	if len(columns) == 0 {
+		columns = iteminstancehistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iihq.sql != nil {
+		selector = iihq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iihq.ctx.Unique != nil && *iihq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range iihq.modifiers {
+		m(selector)
+	}
+	for _, p := range iihq.predicates {
+		p(selector)
+	}
+	for _, p := range iihq.order {
+		p(selector)
+	}
+	if offset := iihq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iihq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iihq *ItemInstanceHistoryQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemInstanceHistorySelect {
+	iihq.modifiers = append(iihq.modifiers, modifiers...)
+	return iihq.Select()
+}
+
+// ItemInstanceHistoryGroupBy is the group-by builder for ItemInstanceHistory entities.
+type ItemInstanceHistoryGroupBy struct {
+	selector
+	build *ItemInstanceHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iihgb *ItemInstanceHistoryGroupBy) Aggregate(fns ...AggregateFunc) *ItemInstanceHistoryGroupBy {
+	iihgb.fns = append(iihgb.fns, fns...)
+	return iihgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iihgb *ItemInstanceHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iihgb.build.ctx, ent.OpQueryGroupBy)
+	if err := iihgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemInstanceHistoryQuery, *ItemInstanceHistoryGroupBy](ctx, iihgb.build, iihgb, iihgb.build.inters, v)
+}
+
+func (iihgb *ItemInstanceHistoryGroupBy) sqlScan(ctx context.Context, root *ItemInstanceHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iihgb.fns))
+	for _, fn := range iihgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iihgb.flds)+len(iihgb.fns))
+		for _, f := range *iihgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iihgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iihgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemInstanceHistorySelect is the builder for selecting fields of ItemInstanceHistory entities.
+type ItemInstanceHistorySelect struct {
+	*ItemInstanceHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iihs *ItemInstanceHistorySelect) Aggregate(fns ...AggregateFunc) *ItemInstanceHistorySelect {
+	iihs.fns = append(iihs.fns, fns...)
+	return iihs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iihs *ItemInstanceHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iihs.ctx, ent.OpQuerySelect)
+	if err := iihs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemInstanceHistoryQuery, *ItemInstanceHistorySelect](ctx, iihs.ItemInstanceHistoryQuery, iihs, iihs.inters, v)
+}
+
+func (iihs *ItemInstanceHistorySelect) sqlScan(ctx context.Context, root *ItemInstanceHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iihs.fns))
+	for _, fn := range iihs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iihs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iihs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iihs *ItemInstanceHistorySelect) Modify(modifiers ...func(s *sql.Selector)) *ItemInstanceHistorySelect {
+	iihs.modifiers = append(iihs.modifiers, modifiers...)
+	return iihs
+}
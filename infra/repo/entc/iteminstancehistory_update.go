@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemInstanceHistoryUpdate is the builder for updating ItemInstanceHistory entities.
+type ItemInstanceHistoryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemInstanceHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemInstanceHistoryUpdate builder.
+func (iihu *ItemInstanceHistoryUpdate) Where(ps ...predicate.ItemInstanceHistory) *ItemInstanceHistoryUpdate {
+	iihu.mutation.Where(ps...)
+	return iihu
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (iihu *ItemInstanceHistoryUpdate) SetInstanceID(s string) *ItemInstanceHistoryUpdate {
+	iihu.mutation.SetInstanceID(s)
+	return iihu
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (iihu *ItemInstanceHistoryUpdate) SetNillableInstanceID(s *string) *ItemInstanceHistoryUpdate {
+	if s != nil {
+		iihu.SetInstanceID(*s)
+	}
+	return iihu
+}
+
+// SetAction sets the "action" field.
+func (iihu *ItemInstanceHistoryUpdate) SetAction(s string) *ItemInstanceHistoryUpdate {
+	iihu.mutation.SetAction(s)
+	return iihu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (iihu *ItemInstanceHistoryUpdate) SetNillableAction(s *string) *ItemInstanceHistoryUpdate {
+	if s != nil {
+		iihu.SetAction(*s)
+	}
+	return iihu
+}
+
+// SetFromPlayerID sets the "from_player_id" field.
+func (iihu *ItemInstanceHistoryUpdate) SetFromPlayerID(s string) *ItemInstanceHistoryUpdate {
+	iihu.mutation.SetFromPlayerID(s)
+	return iihu
+}
+
+// SetNillableFromPlayerID sets the "from_player_id" field if the given value is not nil.
+func (iihu *ItemInstanceHistoryUpdate) SetNillableFromPlayerID(s *string) *ItemInstanceHistoryUpdate {
+	if s != nil {
+		iihu.SetFromPlayerID(*s)
+	}
+	return iihu
+}
+
+// SetToPlayerID sets the "to_player_id" field.
+func (iihu *ItemInstanceHistoryUpdate) SetToPlayerID(s string) *ItemInstanceHistoryUpdate {
+	iihu.mutation.SetToPlayerID(s)
+	return iihu
+}
+
+// SetNillableToPlayerID sets the "to_player_id" field if the given value is not nil.
+func (iihu *ItemInstanceHistoryUpdate) SetNillableToPlayerID(s *string) *ItemInstanceHistoryUpdate {
+	if s != nil {
+		iihu.SetToPlayerID(*s)
+	}
+	return iihu
+}
+
+// SetActor sets the "actor" field.
+func (iihu *ItemInstanceHistoryUpdate) SetActor(s string) *ItemInstanceHistoryUpdate {
+	iihu.mutation.SetActor(s)
+	return iihu
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (iihu *ItemInstanceHistoryUpdate) SetNillableActor(s *string) *ItemInstanceHistoryUpdate {
+	if s != nil {
+		iihu.SetActor(*s)
+	}
+	return iihu
+}
+
+// SetReason sets the "reason" field.
+func (iihu *ItemInstanceHistoryUpdate) SetReason(s string) *ItemInstanceHistoryUpdate {
+	iihu.mutation.SetReason(s)
+	return iihu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (iihu *ItemInstanceHistoryUpdate) SetNillableReason(s *string) *ItemInstanceHistoryUpdate {
+	if s != nil {
+		iihu.SetReason(*s)
+	}
+	return iihu
+}
+
+// SetInstance sets the "instance" edge to the ItemInstance entity.
+func (iihu *ItemInstanceHistoryUpdate) SetInstance(i *ItemInstance) *ItemInstanceHistoryUpdate {
+	return iihu.SetInstanceID(i.ID)
+}
+
+// Mutation returns the ItemInstanceHistoryMutation object of the builder.
+func (iihu *ItemInstanceHistoryUpdate) Mutation() *ItemInstanceHistoryMutation {
+	return iihu.mutation
+}
+
+// ClearInstance clears the "instance" edge to the ItemInstance entity.
+func (iihu *ItemInstanceHistoryUpdate) ClearInstance() *ItemInstanceHistoryUpdate {
+	iihu.mutation.ClearInstance()
+	return iihu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iihu *ItemInstanceHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iihu.sqlSave, iihu.mutation, iihu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iihu *ItemInstanceHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := iihu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iihu *ItemInstanceHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := iihu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iihu *ItemInstanceHistoryUpdate) ExecX(ctx context.Context) {
+	if err := iihu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iihu *ItemInstanceHistoryUpdate) check() error {
+	if iihu.mutation.InstanceCleared() && len(iihu.mutation.InstanceIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ItemInstanceHistory.instance"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iihu *ItemInstanceHistoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemInstanceHistoryUpdate {
+	iihu.modifiers = append(iihu.modifiers, modifiers...)
+	return iihu
+}
+
+func (iihu *ItemInstanceHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iihu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(iteminstancehistory.Table, iteminstancehistory.Columns, sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64))
+	if ps := iihu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iihu.mutation.Action(); ok {
+		_spec.SetField(iteminstancehistory.FieldAction, field.TypeString, value)
+	}
+	if value, ok := iihu.mutation.FromPlayerID(); ok {
+		_spec.SetField(iteminstancehistory.FieldFromPlayerID, field.TypeString, value)
+	}
+	if value, ok := iihu.mutation.ToPlayerID(); ok {
+		_spec.SetField(iteminstancehistory.FieldToPlayerID, field.TypeString, value)
+	}
+	if value, ok := iihu.mutation.Actor(); ok {
+		_spec.SetField(iteminstancehistory.FieldActor, field.TypeString, value)
+	}
+	if value, ok := iihu.mutation.Reason(); ok {
+		_spec.SetField(iteminstancehistory.FieldReason, field.TypeString, value)
+	}
+	if iihu.mutation.InstanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstancehistory.InstanceTable,
+			Columns: []string{iteminstancehistory.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iihu.mutation.InstanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstancehistory.InstanceTable,
+			Columns: []string{iteminstancehistory.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iihu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iihu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{iteminstancehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iihu.mutation.done = true
+	return n, nil
+}
+
+// ItemInstanceHistoryUpdateOne is the builder for updating a single ItemInstanceHistory entity.
+type ItemInstanceHistoryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemInstanceHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetInstanceID sets the "instance_id" field.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetInstanceID(s string) *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.SetInstanceID(s)
+	return iihuo
+}
+
+// SetNillableInstanceID sets the "instance_id" field if the given value is not nil.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetNillableInstanceID(s *string) *ItemInstanceHistoryUpdateOne {
+	if s != nil {
+		iihuo.SetInstanceID(*s)
+	}
+	return iihuo
+}
+
+// SetAction sets the "action" field.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetAction(s string) *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.SetAction(s)
+	return iihuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetNillableAction(s *string) *ItemInstanceHistoryUpdateOne {
+	if s != nil {
+		iihuo.SetAction(*s)
+	}
+	return iihuo
+}
+
+// SetFromPlayerID sets the "from_player_id" field.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetFromPlayerID(s string) *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.SetFromPlayerID(s)
+	return iihuo
+}
+
+// SetNillableFromPlayerID sets the "from_player_id" field if the given value is not nil.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetNillableFromPlayerID(s *string) *ItemInstanceHistoryUpdateOne {
+	if s != nil {
+		iihuo.SetFromPlayerID(*s)
+	}
+	return iihuo
+}
+
+// SetToPlayerID sets the "to_player_id" field.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetToPlayerID(s string) *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.SetToPlayerID(s)
+	return iihuo
+}
+
+// SetNillableToPlayerID sets the "to_player_id" field if the given value is not nil.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetNillableToPlayerID(s *string) *ItemInstanceHistoryUpdateOne {
+	if s != nil {
+		iihuo.SetToPlayerID(*s)
+	}
+	return iihuo
+}
+
+// SetActor sets the "actor" field.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetActor(s string) *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.SetActor(s)
+	return iihuo
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetNillableActor(s *string) *ItemInstanceHistoryUpdateOne {
+	if s != nil {
+		iihuo.SetActor(*s)
+	}
+	return iihuo
+}
+
+// SetReason sets the "reason" field.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetReason(s string) *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.SetReason(s)
+	return iihuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetNillableReason(s *string) *ItemInstanceHistoryUpdateOne {
+	if s != nil {
+		iihuo.SetReason(*s)
+	}
+	return iihuo
+}
+
+// SetInstance sets the "instance" edge to the ItemInstance entity.
+func (iihuo *ItemInstanceHistoryUpdateOne) SetInstance(i *ItemInstance) *ItemInstanceHistoryUpdateOne {
+	return iihuo.SetInstanceID(i.ID)
+}
+
+// Mutation returns the ItemInstanceHistoryMutation object of the builder.
+func (iihuo *ItemInstanceHistoryUpdateOne) Mutation() *ItemInstanceHistoryMutation {
+	return iihuo.mutation
+}
+
+// ClearInstance clears the "instance" edge to the ItemInstance entity.
+func (iihuo *ItemInstanceHistoryUpdateOne) ClearInstance() *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.ClearInstance()
+	return iihuo
+}
+
+// Where appends a list predicates to the ItemInstanceHistoryUpdate builder.
+func (iihuo *ItemInstanceHistoryUpdateOne) Where(ps ...predicate.ItemInstanceHistory) *ItemInstanceHistoryUpdateOne {
+	iihuo.mutation.Where(ps...)
+	return iihuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iihuo *ItemInstanceHistoryUpdateOne) Select(field string, fields ...string) *ItemInstanceHistoryUpdateOne {
+	iihuo.fields = append([]string{field}, fields...)
+	return iihuo
+}
+
+// Save executes the query and returns the updated ItemInstanceHistory entity.
+func (iihuo *ItemInstanceHistoryUpdateOne) Save(ctx context.Context) (*ItemInstanceHistory, error) {
+	return withHooks(ctx, iihuo.sqlSave, iihuo.mutation, iihuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iihuo *ItemInstanceHistoryUpdateOne) SaveX(ctx context.Context) *ItemInstanceHistory {
+	node, err := iihuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iihuo *ItemInstanceHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := iihuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iihuo *ItemInstanceHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := iihuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iihuo *ItemInstanceHistoryUpdateOne) check() error {
+	if iihuo.mutation.InstanceCleared() && len(iihuo.mutation.InstanceIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ItemInstanceHistory.instance"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iihuo *ItemInstanceHistoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemInstanceHistoryUpdateOne {
+	iihuo.modifiers = append(iihuo.modifiers, modifiers...)
+	return iihuo
+}
+
+func (iihuo *ItemInstanceHistoryUpdateOne) sqlSave(ctx context.Context) (_node *ItemInstanceHistory, err error) {
+	if err := iihuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(iteminstancehistory.Table, iteminstancehistory.Columns, sqlgraph.NewFieldSpec(iteminstancehistory.FieldID, field.TypeInt64))
+	id, ok := iihuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "ItemInstanceHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iihuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, iteminstancehistory.FieldID)
+		for _, f := range fields {
+			if !iteminstancehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != iteminstancehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iihuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iihuo.mutation.Action(); ok {
+		_spec.SetField(iteminstancehistory.FieldAction, field.TypeString, value)
+	}
+	if value, ok := iihuo.mutation.FromPlayerID(); ok {
+		_spec.SetField(iteminstancehistory.FieldFromPlayerID, field.TypeString, value)
+	}
+	if value, ok := iihuo.mutation.ToPlayerID(); ok {
+		_spec.SetField(iteminstancehistory.FieldToPlayerID, field.TypeString, value)
+	}
+	if value, ok := iihuo.mutation.Actor(); ok {
+		_spec.SetField(iteminstancehistory.FieldActor, field.TypeString, value)
+	}
+	if value, ok := iihuo.mutation.Reason(); ok {
+		_spec.SetField(iteminstancehistory.FieldReason, field.TypeString, value)
+	}
+	if iihuo.mutation.InstanceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstancehistory.InstanceTable,
+			Columns: []string{iteminstancehistory.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iihuo.mutation.InstanceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   iteminstancehistory.InstanceTable,
+			Columns: []string{iteminstancehistory.InstanceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iihuo.modifiers...)
+	_node = &ItemInstanceHistory{config: iihuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iihuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{iteminstancehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iihuo.mutation.done = true
+	return _node, nil
+}
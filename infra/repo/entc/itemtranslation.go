@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+)
+
+// ItemTranslation is the model entity for the ItemTranslation schema.
+type ItemTranslation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale string `json:"locale,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemTranslationQuery when eager-loading is set.
+	Edges        ItemTranslationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemTranslationEdges holds the relations/edges for other nodes in the graph.
+type ItemTranslationEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemTranslationEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemTranslation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemtranslation.FieldID, itemtranslation.FieldCreatedAt, itemtranslation.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case itemtranslation.FieldItemID, itemtranslation.FieldLocale, itemtranslation.FieldName, itemtranslation.FieldDescription:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemTranslation fields.
+func (it *ItemTranslation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemtranslation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			it.ID = int(value.Int64)
+		case itemtranslation.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				it.ItemID = value.String
+			}
+		case itemtranslation.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				it.Locale = value.String
+			}
+		case itemtranslation.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				it.Name = value.String
+			}
+		case itemtranslation.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				it.Description = value.String
+			}
+		case itemtranslation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				it.CreatedAt = value.Int64
+			}
+		case itemtranslation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				it.UpdatedAt = value.Int64
+			}
+		default:
+			it.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemTranslation.
+// This includes values selected through modifiers, order, etc.
+func (it *ItemTranslation) Value(name string) (ent.Value, error) {
+	return it.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ItemTranslation entity.
+func (it *ItemTranslation) QueryItem() *ItemQuery {
+	return NewItemTranslationClient(it.config).QueryItem(it)
+}
+
+// Update returns a builder for updating this ItemTranslation.
+// Note that you need to call ItemTranslation.Unwrap() before calling this method if this ItemTranslation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (it *ItemTranslation) Update() *ItemTranslationUpdateOne {
+	return NewItemTranslationClient(it.config).UpdateOne(it)
+}
+
+// Unwrap unwraps the ItemTranslation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (it *ItemTranslation) Unwrap() *ItemTranslation {
+	_tx, ok := it.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: ItemTranslation is not a transactional entity")
+	}
+	it.config.driver = _tx.drv
+	return it
+}
+
+// String implements the fmt.Stringer.
+func (it *ItemTranslation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemTranslation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", it.ID))
+	builder.WriteString("item_id=")
+	builder.WriteString(it.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("locale=")
+	builder.WriteString(it.Locale)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(it.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(it.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", it.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", it.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemTranslations is a parsable slice of ItemTranslation.
+type ItemTranslations []*ItemTranslation
@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+)
+
+// ItemTranslationCreate is the builder for creating a ItemTranslation entity.
+type ItemTranslationCreate struct {
+	config
+	mutation *ItemTranslationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetItemID sets the "item_id" field.
+func (itc *ItemTranslationCreate) SetItemID(s string) *ItemTranslationCreate {
+	itc.mutation.SetItemID(s)
+	return itc
+}
+
+// SetLocale sets the "locale" field.
+func (itc *ItemTranslationCreate) SetLocale(s string) *ItemTranslationCreate {
+	itc.mutation.SetLocale(s)
+	return itc
+}
+
+// SetName sets the "name" field.
+func (itc *ItemTranslationCreate) SetName(s string) *ItemTranslationCreate {
+	itc.mutation.SetName(s)
+	return itc
+}
+
+// SetDescription sets the "description" field.
+func (itc *ItemTranslationCreate) SetDescription(s string) *ItemTranslationCreate {
+	itc.mutation.SetDescription(s)
+	return itc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (itc *ItemTranslationCreate) SetCreatedAt(i int64) *ItemTranslationCreate {
+	itc.mutation.SetCreatedAt(i)
+	return itc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (itc *ItemTranslationCreate) SetNillableCreatedAt(i *int64) *ItemTranslationCreate {
+	if i != nil {
+		itc.SetCreatedAt(*i)
+	}
+	return itc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (itc *ItemTranslationCreate) SetUpdatedAt(i int64) *ItemTranslationCreate {
+	itc.mutation.SetUpdatedAt(i)
+	return itc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (itc *ItemTranslationCreate) SetNillableUpdatedAt(i *int64) *ItemTranslationCreate {
+	if i != nil {
+		itc.SetUpdatedAt(*i)
+	}
+	return itc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (itc *ItemTranslationCreate) SetItem(i *Item) *ItemTranslationCreate {
+	return itc.SetItemID(i.ID)
+}
+
+// Mutation returns the ItemTranslationMutation object of the builder.
+func (itc *ItemTranslationCreate) Mutation() *ItemTranslationMutation {
+	return itc.mutation
+}
+
+// Save creates the ItemTranslation in the database.
+func (itc *ItemTranslationCreate) Save(ctx context.Context) (*ItemTranslation, error) {
+	itc.defaults()
+	return withHooks(ctx, itc.sqlSave, itc.mutation, itc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (itc *ItemTranslationCreate) SaveX(ctx context.Context) *ItemTranslation {
+	v, err := itc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itc *ItemTranslationCreate) Exec(ctx context.Context) error {
+	_, err := itc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itc *ItemTranslationCreate) ExecX(ctx context.Context) {
+	if err := itc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (itc *ItemTranslationCreate) defaults() {
+	if _, ok := itc.mutation.CreatedAt(); !ok {
+		v := itemtranslation.DefaultCreatedAt()
+		itc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := itc.mutation.UpdatedAt(); !ok {
+		v := itemtranslation.DefaultUpdatedAt()
+		itc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itc *ItemTranslationCreate) check() error {
+	if _, ok := itc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`entc: missing required field "ItemTranslation.item_id"`)}
+	}
+	if _, ok := itc.mutation.Locale(); !ok {
+		return &ValidationError{Name: "locale", err: errors.New(`entc: missing required field "ItemTranslation.locale"`)}
+	}
+	if _, ok := itc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entc: missing required field "ItemTranslation.name"`)}
+	}
+	if _, ok := itc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`entc: missing required field "ItemTranslation.description"`)}
+	}
+	if _, ok := itc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "ItemTranslation.created_at"`)}
+	}
+	if _, ok := itc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "ItemTranslation.updated_at"`)}
+	}
+	if len(itc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`entc: missing required edge "ItemTranslation.item"`)}
+	}
+	return nil
+}
+
+func (itc *ItemTranslationCreate) sqlSave(ctx context.Context) (*ItemTranslation, error) {
+	if err := itc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := itc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, itc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	itc.mutation.id = &_node.ID
+	itc.mutation.done = true
+	return _node, nil
+}
+
+func (itc *ItemTranslationCreate) createSpec() (*ItemTranslation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemTranslation{config: itc.config}
+		_spec = sqlgraph.NewCreateSpec(itemtranslation.Table, sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = itc.conflict
+	if value, ok := itc.mutation.Locale(); ok {
+		_spec.SetField(itemtranslation.FieldLocale, field.TypeString, value)
+		_node.Locale = value
+	}
+	if value, ok := itc.mutation.Name(); ok {
+		_spec.SetField(itemtranslation.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := itc.mutation.Description(); ok {
+		_spec.SetField(itemtranslation.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := itc.mutation.CreatedAt(); ok {
+		_spec.SetField(itemtranslation.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := itc.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemtranslation.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := itc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtranslation.ItemTable,
+			Columns: []string{itemtranslation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemTranslation.Create().
+//		SetItemID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemTranslationUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (itc *ItemTranslationCreate) OnConflict(opts ...sql.ConflictOption) *ItemTranslationUpsertOne {
+	itc.conflict = opts
+	return &ItemTranslationUpsertOne{
+		create: itc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemTranslation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (itc *ItemTranslationCreate) OnConflictColumns(columns ...string) *ItemTranslationUpsertOne {
+	itc.conflict = append(itc.conflict, sql.ConflictColumns(columns...))
+	return &ItemTranslationUpsertOne{
+		create: itc,
+	}
+}
+
+type (
+	// ItemTranslationUpsertOne is the builder for "upsert"-ing
+	//  one ItemTranslation node.
+	ItemTranslationUpsertOne struct {
+		create *ItemTranslationCreate
+	}
+
+	// ItemTranslationUpsert is the "OnConflict" setter.
+	ItemTranslationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetItemID sets the "item_id" field.
+func (u *ItemTranslationUpsert) SetItemID(v string) *ItemTranslationUpsert {
+	u.Set(itemtranslation.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ItemTranslationUpsert) UpdateItemID() *ItemTranslationUpsert {
+	u.SetExcluded(itemtranslation.FieldItemID)
+	return u
+}
+
+// SetLocale sets the "locale" field.
+func (u *ItemTranslationUpsert) SetLocale(v string) *ItemTranslationUpsert {
+	u.Set(itemtranslation.FieldLocale, v)
+	return u
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *ItemTranslationUpsert) UpdateLocale() *ItemTranslationUpsert {
+	u.SetExcluded(itemtranslation.FieldLocale)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ItemTranslationUpsert) SetName(v string) *ItemTranslationUpsert {
+	u.Set(itemtranslation.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ItemTranslationUpsert) UpdateName() *ItemTranslationUpsert {
+	u.SetExcluded(itemtranslation.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ItemTranslationUpsert) SetDescription(v string) *ItemTranslationUpsert {
+	u.Set(itemtranslation.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItemTranslationUpsert) UpdateDescription() *ItemTranslationUpsert {
+	u.SetExcluded(itemtranslation.FieldDescription)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemTranslationUpsert) SetUpdatedAt(v int64) *ItemTranslationUpsert {
+	u.Set(itemtranslation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemTranslationUpsert) UpdateUpdatedAt() *ItemTranslationUpsert {
+	u.SetExcluded(itemtranslation.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ItemTranslationUpsert) AddUpdatedAt(v int64) *ItemTranslationUpsert {
+	u.Add(itemtranslation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ItemTranslation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ItemTranslationUpsertOne) UpdateNewValues() *ItemTranslationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(itemtranslation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemTranslation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItemTranslationUpsertOne) Ignore() *ItemTranslationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemTranslationUpsertOne) DoNothing() *ItemTranslationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemTranslationCreate.OnConflict
+// documentation for more info.
+func (u *ItemTranslationUpsertOne) Update(set func(*ItemTranslationUpsert)) *ItemTranslationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemTranslationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ItemTranslationUpsertOne) SetItemID(v string) *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ItemTranslationUpsertOne) UpdateItemID() *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetLocale sets the "locale" field.
+func (u *ItemTranslationUpsertOne) SetLocale(v string) *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetLocale(v)
+	})
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *ItemTranslationUpsertOne) UpdateLocale() *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateLocale()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ItemTranslationUpsertOne) SetName(v string) *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ItemTranslationUpsertOne) UpdateName() *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ItemTranslationUpsertOne) SetDescription(v string) *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItemTranslationUpsertOne) UpdateDescription() *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemTranslationUpsertOne) SetUpdatedAt(v int64) *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ItemTranslationUpsertOne) AddUpdatedAt(v int64) *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemTranslationUpsertOne) UpdateUpdatedAt() *ItemTranslationUpsertOne {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemTranslationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ItemTranslationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemTranslationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItemTranslationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItemTranslationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ItemTranslationCreateBulk is the builder for creating many ItemTranslation entities in bulk.
+type ItemTranslationCreateBulk struct {
+	config
+	err      error
+	builders []*ItemTranslationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ItemTranslation entities in the database.
+func (itcb *ItemTranslationCreateBulk) Save(ctx context.Context) ([]*ItemTranslation, error) {
+	if itcb.err != nil {
+		return nil, itcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(itcb.builders))
+	nodes := make([]*ItemTranslation, len(itcb.builders))
+	mutators := make([]Mutator, len(itcb.builders))
+	for i := range itcb.builders {
+		func(i int, root context.Context) {
+			builder := itcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemTranslationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, itcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = itcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, itcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, itcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itcb *ItemTranslationCreateBulk) SaveX(ctx context.Context) []*ItemTranslation {
+	v, err := itcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (itcb *ItemTranslationCreateBulk) Exec(ctx context.Context) error {
+	_, err := itcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itcb *ItemTranslationCreateBulk) ExecX(ctx context.Context) {
+	if err := itcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemTranslation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemTranslationUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (itcb *ItemTranslationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItemTranslationUpsertBulk {
+	itcb.conflict = opts
+	return &ItemTranslationUpsertBulk{
+		create: itcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemTranslation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (itcb *ItemTranslationCreateBulk) OnConflictColumns(columns ...string) *ItemTranslationUpsertBulk {
+	itcb.conflict = append(itcb.conflict, sql.ConflictColumns(columns...))
+	return &ItemTranslationUpsertBulk{
+		create: itcb,
+	}
+}
+
+// ItemTranslationUpsertBulk is the builder for "upsert"-ing
+// a bulk of ItemTranslation nodes.
+type ItemTranslationUpsertBulk struct {
+	create *ItemTranslationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ItemTranslation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ItemTranslationUpsertBulk) UpdateNewValues() *ItemTranslationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(itemtranslation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemTranslation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItemTranslationUpsertBulk) Ignore() *ItemTranslationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemTranslationUpsertBulk) DoNothing() *ItemTranslationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemTranslationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItemTranslationUpsertBulk) Update(set func(*ItemTranslationUpsert)) *ItemTranslationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemTranslationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ItemTranslationUpsertBulk) SetItemID(v string) *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ItemTranslationUpsertBulk) UpdateItemID() *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetLocale sets the "locale" field.
+func (u *ItemTranslationUpsertBulk) SetLocale(v string) *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetLocale(v)
+	})
+}
+
+// UpdateLocale sets the "locale" field to the value that was provided on create.
+func (u *ItemTranslationUpsertBulk) UpdateLocale() *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateLocale()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ItemTranslationUpsertBulk) SetName(v string) *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ItemTranslationUpsertBulk) UpdateName() *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ItemTranslationUpsertBulk) SetDescription(v string) *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItemTranslationUpsertBulk) UpdateDescription() *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemTranslationUpsertBulk) SetUpdatedAt(v int64) *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *ItemTranslationUpsertBulk) AddUpdatedAt(v int64) *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemTranslationUpsertBulk) UpdateUpdatedAt() *ItemTranslationUpsertBulk {
+	return u.Update(func(s *ItemTranslationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemTranslationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the ItemTranslationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for ItemTranslationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemTranslationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
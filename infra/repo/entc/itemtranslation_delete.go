@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemTranslationDelete is the builder for deleting a ItemTranslation entity.
+type ItemTranslationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemTranslationMutation
+}
+
+// Where appends a list predicates to the ItemTranslationDelete builder.
+func (itd *ItemTranslationDelete) Where(ps ...predicate.ItemTranslation) *ItemTranslationDelete {
+	itd.mutation.Where(ps...)
+	return itd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (itd *ItemTranslationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, itd.sqlExec, itd.mutation, itd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itd *ItemTranslationDelete) ExecX(ctx context.Context) int {
+	n, err := itd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (itd *ItemTranslationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemtranslation.Table, sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt))
+	if ps := itd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, itd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	itd.mutation.done = true
+	return affected, err
+}
+
+// ItemTranslationDeleteOne is the builder for deleting a single ItemTranslation entity.
+type ItemTranslationDeleteOne struct {
+	itd *ItemTranslationDelete
+}
+
+// Where appends a list predicates to the ItemTranslationDelete builder.
+func (itdo *ItemTranslationDeleteOne) Where(ps ...predicate.ItemTranslation) *ItemTranslationDeleteOne {
+	itdo.itd.mutation.Where(ps...)
+	return itdo
+}
+
+// Exec executes the deletion query.
+func (itdo *ItemTranslationDeleteOne) Exec(ctx context.Context) error {
+	n, err := itdo.itd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemtranslation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itdo *ItemTranslationDeleteOne) ExecX(ctx context.Context) {
+	if err := itdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemTranslationQuery is the builder for querying ItemTranslation entities.
+type ItemTranslationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemtranslation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemTranslation
+	withItem   *ItemQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemTranslationQuery builder.
+func (itq *ItemTranslationQuery) Where(ps ...predicate.ItemTranslation) *ItemTranslationQuery {
+	itq.predicates = append(itq.predicates, ps...)
+	return itq
+}
+
+// Limit the number of records to be returned by this query.
+func (itq *ItemTranslationQuery) Limit(limit int) *ItemTranslationQuery {
+	itq.ctx.Limit = &limit
+	return itq
+}
+
+// Offset to start from.
+func (itq *ItemTranslationQuery) Offset(offset int) *ItemTranslationQuery {
+	itq.ctx.Offset = &offset
+	return itq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (itq *ItemTranslationQuery) Unique(unique bool) *ItemTranslationQuery {
+	itq.ctx.Unique = &unique
+	return itq
+}
+
+// Order specifies how the records should be ordered.
+func (itq *ItemTranslationQuery) Order(o ...itemtranslation.OrderOption) *ItemTranslationQuery {
+	itq.order = append(itq.order, o...)
+	return itq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (itq *ItemTranslationQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: itq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := itq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := itq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemtranslation.Table, itemtranslation.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemtranslation.ItemTable, itemtranslation.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(itq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemTranslation entity from the query.
+// Returns a *NotFoundError when no ItemTranslation was found.
+func (itq *ItemTranslationQuery) First(ctx context.Context) (*ItemTranslation, error) {
+	nodes, err := itq.Limit(1).All(setContextOp(ctx, itq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemtranslation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (itq *ItemTranslationQuery) FirstX(ctx context.Context) *ItemTranslation {
+	node, err := itq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemTranslation ID from the query.
+// Returns a *NotFoundError when no ItemTranslation ID was found.
+func (itq *ItemTranslationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = itq.Limit(1).IDs(setContextOp(ctx, itq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemtranslation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (itq *ItemTranslationQuery) FirstIDX(ctx context.Context) int {
+	id, err := itq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemTranslation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemTranslation entity is found.
+// Returns a *NotFoundError when no ItemTranslation entities are found.
+func (itq *ItemTranslationQuery) Only(ctx context.Context) (*ItemTranslation, error) {
+	nodes, err := itq.Limit(2).All(setContextOp(ctx, itq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemtranslation.Label}
+	default:
+		return nil, &NotSingularError{itemtranslation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (itq *ItemTranslationQuery) OnlyX(ctx context.Context) *ItemTranslation {
+	node, err := itq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemTranslation ID in the query.
+// Returns a *NotSingularError when more than one ItemTranslation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (itq *ItemTranslationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = itq.Limit(2).IDs(setContextOp(ctx, itq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemtranslation.Label}
+	default:
+		err = &NotSingularError{itemtranslation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (itq *ItemTranslationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := itq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemTranslations.
+func (itq *ItemTranslationQuery) All(ctx context.Context) ([]*ItemTranslation, error) {
+	ctx = setContextOp(ctx, itq.ctx, ent.OpQueryAll)
+	if err := itq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemTranslation, *ItemTranslationQuery]()
+	return withInterceptors[[]*ItemTranslation](ctx, itq, qr, itq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (itq *ItemTranslationQuery) AllX(ctx context.Context) []*ItemTranslation {
+	nodes, err := itq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemTranslation IDs.
+func (itq *ItemTranslationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if itq.ctx.Unique == nil && itq.path != nil {
+		itq.Unique(true)
+	}
+	ctx = setContextOp(ctx, itq.ctx, ent.OpQueryIDs)
+	if err = itq.Select(itemtranslation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (itq *ItemTranslationQuery) IDsX(ctx context.Context) []int {
+	ids, err := itq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (itq *ItemTranslationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, itq.ctx, ent.OpQueryCount)
+	if err := itq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, itq, querierCount[*ItemTranslationQuery](), itq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (itq *ItemTranslationQuery) CountX(ctx context.Context) int {
+	count, err := itq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (itq *ItemTranslationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, itq.ctx, ent.OpQueryExist)
+	switch _, err := itq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (itq *ItemTranslationQuery) ExistX(ctx context.Context) bool {
+	exist, err := itq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemTranslationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (itq *ItemTranslationQuery) Clone() *ItemTranslationQuery {
+	if itq == nil {
+		return nil
+	}
+	return &ItemTranslationQuery{
+		config:     itq.config,
+		ctx:        itq.ctx.Clone(),
+		order:      append([]itemtranslation.OrderOption{}, itq.order...),
+		inters:     append([]Interceptor{}, itq.inters...),
+		predicates: append([]predicate.ItemTranslation{}, itq.predicates...),
+		withItem:   itq.withItem.Clone(),
+		// clone intermediate query.
+		sql:       itq.sql.Clone(),
+		path:      itq.path,
+		modifiers: append([]func(*sql.Selector){}, itq.modifiers...),
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (itq *ItemTranslationQuery) WithItem(opts ...func(*ItemQuery)) *ItemTranslationQuery {
+	query := (&ItemClient{config: itq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	itq.withItem = query
+	return itq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID string `json:"item_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemTranslation.Query().
+//		GroupBy(itemtranslation.FieldItemID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (itq *ItemTranslationQuery) GroupBy(field string, fields ...string) *ItemTranslationGroupBy {
+	itq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemTranslationGroupBy{build: itq}
+	grbuild.flds = &itq.ctx.Fields
+	grbuild.label = itemtranslation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID string `json:"item_id,omitempty"`
+//	}
+//
+//	client.ItemTranslation.Query().
+//		Select(itemtranslation.FieldItemID).
+//		Scan(ctx, &v)
+func (itq *ItemTranslationQuery) Select(fields ...string) *ItemTranslationSelect {
+	itq.ctx.Fields = append(itq.ctx.Fields, fields...)
+	sbuild := &ItemTranslationSelect{ItemTranslationQuery: itq}
+	sbuild.label = itemtranslation.Label
+	sbuild.flds, sbuild.scan = &itq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemTranslationSelect configured with the given aggregations.
+func (itq *ItemTranslationQuery) Aggregate(fns ...AggregateFunc) *ItemTranslationSelect {
+	return itq.Select().Aggregate(fns...)
+}
+
+func (itq *ItemTranslationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range itq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, itq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range itq.ctx.Fields {
+		if !itemtranslation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if itq.path != nil {
+		prev, err := itq.path(ctx)
+		if err != nil {
+			return err
+		}
+		itq.sql = prev
+	}
+	return nil
+}
+
+func (itq *ItemTranslationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemTranslation, error) {
+	var (
+		nodes       = []*ItemTranslation{}
+		_spec       = itq.querySpec()
+		loadedTypes = [1]bool{
+			itq.withItem != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemTranslation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemTranslation{config: itq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, itq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := itq.withItem; query != nil {
+		if err := itq.loadItem(ctx, query, nodes, nil,
+			func(n *ItemTranslation, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (itq *ItemTranslationQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ItemTranslation, init func(*ItemTranslation), assign func(*ItemTranslation, *Item)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ItemTranslation)
+	for i := range nodes {
+		fk := nodes[i].ItemID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (itq *ItemTranslationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := itq.querySpec()
+	if len(itq.modifiers) > 0 {
+		_spec.Modifiers = itq.modifiers
+	}
+	_spec.Node.Columns = itq.ctx.Fields
+	if len(itq.ctx.Fields) > 0 {
+		_spec.Unique = itq.ctx.Unique != nil && *itq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, itq.driver, _spec)
+}
+
+func (itq *ItemTranslationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemtranslation.Table, itemtranslation.Columns, sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt))
+	_spec.From = itq.sql
+	if unique := itq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if itq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := itq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemtranslation.FieldID)
+		for i := range fields {
+			if fields[i] != itemtranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if itq.withItem != nil {
+			_spec.Node.AddColumnOnce(itemtranslation.FieldItemID)
+		}
+	}
+	if ps := itq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := itq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := itq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := itq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (itq *ItemTranslationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(itq.driver.Dialect())
+	t1 := builder.Table(itemtranslation.Table)
+	columns := itq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemtranslation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if itq.sql != nil {
+		selector = itq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if itq.ctx.Unique != nil && *itq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range itq.modifiers {
+		m(selector)
+	}
+	for _, p := range itq.predicates {
+		p(selector)
+	}
+	for _, p := range itq.order {
+		p(selector)
+	}
+	if offset := itq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := itq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (itq *ItemTranslationQuery) Modify(modifiers ...func(s *sql.Selector)) *ItemTranslationSelect {
+	itq.modifiers = append(itq.modifiers, modifiers...)
+	return itq.Select()
+}
+
+// ItemTranslationGroupBy is the group-by builder for ItemTranslation entities.
+type ItemTranslationGroupBy struct {
+	selector
+	build *ItemTranslationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (itgb *ItemTranslationGroupBy) Aggregate(fns ...AggregateFunc) *ItemTranslationGroupBy {
+	itgb.fns = append(itgb.fns, fns...)
+	return itgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (itgb *ItemTranslationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, itgb.build.ctx, ent.OpQueryGroupBy)
+	if err := itgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemTranslationQuery, *ItemTranslationGroupBy](ctx, itgb.build, itgb, itgb.build.inters, v)
+}
+
+func (itgb *ItemTranslationGroupBy) sqlScan(ctx context.Context, root *ItemTranslationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(itgb.fns))
+	for _, fn := range itgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*itgb.flds)+len(itgb.fns))
+		for _, f := range *itgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*itgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := itgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemTranslationSelect is the builder for selecting fields of ItemTranslation entities.
+type ItemTranslationSelect struct {
+	*ItemTranslationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (its *ItemTranslationSelect) Aggregate(fns ...AggregateFunc) *ItemTranslationSelect {
+	its.fns = append(its.fns, fns...)
+	return its
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (its *ItemTranslationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, its.ctx, ent.OpQuerySelect)
+	if err := its.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemTranslationQuery, *ItemTranslationSelect](ctx, its.ItemTranslationQuery, its, its.inters, v)
+}
+
+func (its *ItemTranslationSelect) sqlScan(ctx context.Context, root *ItemTranslationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(its.fns))
+	for _, fn := range its.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*its.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := its.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (its *ItemTranslationSelect) Modify(modifiers ...func(s *sql.Selector)) *ItemTranslationSelect {
+	its.modifiers = append(its.modifiers, modifiers...)
+	return its
+}
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ItemTranslationUpdate is the builder for updating ItemTranslation entities.
+type ItemTranslationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemTranslationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemTranslationUpdate builder.
+func (itu *ItemTranslationUpdate) Where(ps ...predicate.ItemTranslation) *ItemTranslationUpdate {
+	itu.mutation.Where(ps...)
+	return itu
+}
+
+// SetItemID sets the "item_id" field.
+func (itu *ItemTranslationUpdate) SetItemID(s string) *ItemTranslationUpdate {
+	itu.mutation.SetItemID(s)
+	return itu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (itu *ItemTranslationUpdate) SetNillableItemID(s *string) *ItemTranslationUpdate {
+	if s != nil {
+		itu.SetItemID(*s)
+	}
+	return itu
+}
+
+// SetLocale sets the "locale" field.
+func (itu *ItemTranslationUpdate) SetLocale(s string) *ItemTranslationUpdate {
+	itu.mutation.SetLocale(s)
+	return itu
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (itu *ItemTranslationUpdate) SetNillableLocale(s *string) *ItemTranslationUpdate {
+	if s != nil {
+		itu.SetLocale(*s)
+	}
+	return itu
+}
+
+// SetName sets the "name" field.
+func (itu *ItemTranslationUpdate) SetName(s string) *ItemTranslationUpdate {
+	itu.mutation.SetName(s)
+	return itu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (itu *ItemTranslationUpdate) SetNillableName(s *string) *ItemTranslationUpdate {
+	if s != nil {
+		itu.SetName(*s)
+	}
+	return itu
+}
+
+// SetDescription sets the "description" field.
+func (itu *ItemTranslationUpdate) SetDescription(s string) *ItemTranslationUpdate {
+	itu.mutation.SetDescription(s)
+	return itu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (itu *ItemTranslationUpdate) SetNillableDescription(s *string) *ItemTranslationUpdate {
+	if s != nil {
+		itu.SetDescription(*s)
+	}
+	return itu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (itu *ItemTranslationUpdate) SetUpdatedAt(i int64) *ItemTranslationUpdate {
+	itu.mutation.ResetUpdatedAt()
+	itu.mutation.SetUpdatedAt(i)
+	return itu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (itu *ItemTranslationUpdate) AddUpdatedAt(i int64) *ItemTranslationUpdate {
+	itu.mutation.AddUpdatedAt(i)
+	return itu
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (itu *ItemTranslationUpdate) SetItem(i *Item) *ItemTranslationUpdate {
+	return itu.SetItemID(i.ID)
+}
+
+// Mutation returns the ItemTranslationMutation object of the builder.
+func (itu *ItemTranslationUpdate) Mutation() *ItemTranslationMutation {
+	return itu.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (itu *ItemTranslationUpdate) ClearItem() *ItemTranslationUpdate {
+	itu.mutation.ClearItem()
+	return itu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (itu *ItemTranslationUpdate) Save(ctx context.Context) (int, error) {
+	itu.defaults()
+	return withHooks(ctx, itu.sqlSave, itu.mutation, itu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (itu *ItemTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := itu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (itu *ItemTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := itu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (itu *ItemTranslationUpdate) ExecX(ctx context.Context) {
+	if err := itu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (itu *ItemTranslationUpdate) defaults() {
+	if _, ok := itu.mutation.UpdatedAt(); !ok {
+		v := itemtranslation.UpdateDefaultUpdatedAt()
+		itu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (itu *ItemTranslationUpdate) check() error {
+	if itu.mutation.ItemCleared() && len(itu.mutation.ItemIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ItemTranslation.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (itu *ItemTranslationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemTranslationUpdate {
+	itu.modifiers = append(itu.modifiers, modifiers...)
+	return itu
+}
+
+func (itu *ItemTranslationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := itu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemtranslation.Table, itemtranslation.Columns, sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt))
+	if ps := itu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := itu.mutation.Locale(); ok {
+		_spec.SetField(itemtranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := itu.mutation.Name(); ok {
+		_spec.SetField(itemtranslation.FieldName, field.TypeString, value)
+	}
+	if value, ok := itu.mutation.Description(); ok {
+		_spec.SetField(itemtranslation.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := itu.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemtranslation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := itu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(itemtranslation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if itu.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtranslation.ItemTable,
+			Columns: []string{itemtranslation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := itu.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtranslation.ItemTable,
+			Columns: []string{itemtranslation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(itu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, itu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemtranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	itu.mutation.done = true
+	return n, nil
+}
+
+// ItemTranslationUpdateOne is the builder for updating a single ItemTranslation entity.
+type ItemTranslationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemTranslationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetItemID sets the "item_id" field.
+func (ituo *ItemTranslationUpdateOne) SetItemID(s string) *ItemTranslationUpdateOne {
+	ituo.mutation.SetItemID(s)
+	return ituo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (ituo *ItemTranslationUpdateOne) SetNillableItemID(s *string) *ItemTranslationUpdateOne {
+	if s != nil {
+		ituo.SetItemID(*s)
+	}
+	return ituo
+}
+
+// SetLocale sets the "locale" field.
+func (ituo *ItemTranslationUpdateOne) SetLocale(s string) *ItemTranslationUpdateOne {
+	ituo.mutation.SetLocale(s)
+	return ituo
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (ituo *ItemTranslationUpdateOne) SetNillableLocale(s *string) *ItemTranslationUpdateOne {
+	if s != nil {
+		ituo.SetLocale(*s)
+	}
+	return ituo
+}
+
+// SetName sets the "name" field.
+func (ituo *ItemTranslationUpdateOne) SetName(s string) *ItemTranslationUpdateOne {
+	ituo.mutation.SetName(s)
+	return ituo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ituo *ItemTranslationUpdateOne) SetNillableName(s *string) *ItemTranslationUpdateOne {
+	if s != nil {
+		ituo.SetName(*s)
+	}
+	return ituo
+}
+
+// SetDescription sets the "description" field.
+func (ituo *ItemTranslationUpdateOne) SetDescription(s string) *ItemTranslationUpdateOne {
+	ituo.mutation.SetDescription(s)
+	return ituo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ituo *ItemTranslationUpdateOne) SetNillableDescription(s *string) *ItemTranslationUpdateOne {
+	if s != nil {
+		ituo.SetDescription(*s)
+	}
+	return ituo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ituo *ItemTranslationUpdateOne) SetUpdatedAt(i int64) *ItemTranslationUpdateOne {
+	ituo.mutation.ResetUpdatedAt()
+	ituo.mutation.SetUpdatedAt(i)
+	return ituo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (ituo *ItemTranslationUpdateOne) AddUpdatedAt(i int64) *ItemTranslationUpdateOne {
+	ituo.mutation.AddUpdatedAt(i)
+	return ituo
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (ituo *ItemTranslationUpdateOne) SetItem(i *Item) *ItemTranslationUpdateOne {
+	return ituo.SetItemID(i.ID)
+}
+
+// Mutation returns the ItemTranslationMutation object of the builder.
+func (ituo *ItemTranslationUpdateOne) Mutation() *ItemTranslationMutation {
+	return ituo.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (ituo *ItemTranslationUpdateOne) ClearItem() *ItemTranslationUpdateOne {
+	ituo.mutation.ClearItem()
+	return ituo
+}
+
+// Where appends a list predicates to the ItemTranslationUpdate builder.
+func (ituo *ItemTranslationUpdateOne) Where(ps ...predicate.ItemTranslation) *ItemTranslationUpdateOne {
+	ituo.mutation.Where(ps...)
+	return ituo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ituo *ItemTranslationUpdateOne) Select(field string, fields ...string) *ItemTranslationUpdateOne {
+	ituo.fields = append([]string{field}, fields...)
+	return ituo
+}
+
+// Save executes the query and returns the updated ItemTranslation entity.
+func (ituo *ItemTranslationUpdateOne) Save(ctx context.Context) (*ItemTranslation, error) {
+	ituo.defaults()
+	return withHooks(ctx, ituo.sqlSave, ituo.mutation, ituo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ituo *ItemTranslationUpdateOne) SaveX(ctx context.Context) *ItemTranslation {
+	node, err := ituo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ituo *ItemTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ituo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ituo *ItemTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := ituo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ituo *ItemTranslationUpdateOne) defaults() {
+	if _, ok := ituo.mutation.UpdatedAt(); !ok {
+		v := itemtranslation.UpdateDefaultUpdatedAt()
+		ituo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ituo *ItemTranslationUpdateOne) check() error {
+	if ituo.mutation.ItemCleared() && len(ituo.mutation.ItemIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "ItemTranslation.item"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ituo *ItemTranslationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemTranslationUpdateOne {
+	ituo.modifiers = append(ituo.modifiers, modifiers...)
+	return ituo
+}
+
+func (ituo *ItemTranslationUpdateOne) sqlSave(ctx context.Context) (_node *ItemTranslation, err error) {
+	if err := ituo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemtranslation.Table, itemtranslation.Columns, sqlgraph.NewFieldSpec(itemtranslation.FieldID, field.TypeInt))
+	id, ok := ituo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "ItemTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ituo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemtranslation.FieldID)
+		for _, f := range fields {
+			if !itemtranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != itemtranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ituo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ituo.mutation.Locale(); ok {
+		_spec.SetField(itemtranslation.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := ituo.mutation.Name(); ok {
+		_spec.SetField(itemtranslation.FieldName, field.TypeString, value)
+	}
+	if value, ok := ituo.mutation.Description(); ok {
+		_spec.SetField(itemtranslation.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := ituo.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemtranslation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := ituo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(itemtranslation.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if ituo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtranslation.ItemTable,
+			Columns: []string{itemtranslation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ituo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemtranslation.ItemTable,
+			Columns: []string{itemtranslation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ituo.modifiers...)
+	_node = &ItemTranslation{config: ituo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ituo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemtranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ituo.mutation.done = true
+	return _node, nil
+}
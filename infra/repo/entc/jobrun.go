@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+)
+
+// JobRun is the model entity for the JobRun schema.
+type JobRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Job holds the value of the "job" field.
+	Job string `json:"job,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger string `json:"trigger,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID int64 `json:"task_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Node holds the value of the "node" field.
+	Node string `json:"node,omitempty"`
+	// ScheduledAt holds the value of the "scheduled_at" field.
+	ScheduledAt int64 `json:"scheduled_at,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt int64 `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt int64 `json:"finished_at,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// Error holds the value of the "error" field.
+	Error        string `json:"error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldID, jobrun.FieldTaskID, jobrun.FieldScheduledAt, jobrun.FieldStartedAt, jobrun.FieldFinishedAt, jobrun.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case jobrun.FieldJob, jobrun.FieldTrigger, jobrun.FieldStatus, jobrun.FieldNode, jobrun.FieldError:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobRun fields.
+func (jr *JobRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			jr.ID = int64(value.Int64)
+		case jobrun.FieldJob:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job", values[i])
+			} else if value.Valid {
+				jr.Job = value.String
+			}
+		case jobrun.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				jr.Trigger = value.String
+			}
+		case jobrun.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				jr.TaskID = value.Int64
+			}
+		case jobrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				jr.Status = value.String
+			}
+		case jobrun.FieldNode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field node", values[i])
+			} else if value.Valid {
+				jr.Node = value.String
+			}
+		case jobrun.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				jr.ScheduledAt = value.Int64
+			}
+		case jobrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				jr.StartedAt = value.Int64
+			}
+		case jobrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				jr.FinishedAt = value.Int64
+			}
+		case jobrun.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				jr.DurationMs = value.Int64
+			}
+		case jobrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				jr.Error = value.String
+			}
+		default:
+			jr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobRun.
+// This includes values selected through modifiers, order, etc.
+func (jr *JobRun) Value(name string) (ent.Value, error) {
+	return jr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JobRun.
+// Note that you need to call JobRun.Unwrap() before calling this method if this JobRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (jr *JobRun) Update() *JobRunUpdateOne {
+	return NewJobRunClient(jr.config).UpdateOne(jr)
+}
+
+// Unwrap unwraps the JobRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (jr *JobRun) Unwrap() *JobRun {
+	_tx, ok := jr.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: JobRun is not a transactional entity")
+	}
+	jr.config.driver = _tx.drv
+	return jr
+}
+
+// String implements the fmt.Stringer.
+func (jr *JobRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", jr.ID))
+	builder.WriteString("job=")
+	builder.WriteString(jr.Job)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(jr.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", jr.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(jr.Status)
+	builder.WriteString(", ")
+	builder.WriteString("node=")
+	builder.WriteString(jr.Node)
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(fmt.Sprintf("%v", jr.ScheduledAt))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(fmt.Sprintf("%v", jr.StartedAt))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(fmt.Sprintf("%v", jr.FinishedAt))
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", jr.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(jr.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobRuns is a parsable slice of JobRun.
+type JobRuns []*JobRun
@@ -0,0 +1,1127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+)
+
+// JobRunCreate is the builder for creating a JobRun entity.
+type JobRunCreate struct {
+	config
+	mutation *JobRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJob sets the "job" field.
+func (jrc *JobRunCreate) SetJob(s string) *JobRunCreate {
+	jrc.mutation.SetJob(s)
+	return jrc
+}
+
+// SetTrigger sets the "trigger" field.
+func (jrc *JobRunCreate) SetTrigger(s string) *JobRunCreate {
+	jrc.mutation.SetTrigger(s)
+	return jrc
+}
+
+// SetTaskID sets the "task_id" field.
+func (jrc *JobRunCreate) SetTaskID(i int64) *JobRunCreate {
+	jrc.mutation.SetTaskID(i)
+	return jrc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableTaskID(i *int64) *JobRunCreate {
+	if i != nil {
+		jrc.SetTaskID(*i)
+	}
+	return jrc
+}
+
+// SetStatus sets the "status" field.
+func (jrc *JobRunCreate) SetStatus(s string) *JobRunCreate {
+	jrc.mutation.SetStatus(s)
+	return jrc
+}
+
+// SetNode sets the "node" field.
+func (jrc *JobRunCreate) SetNode(s string) *JobRunCreate {
+	jrc.mutation.SetNode(s)
+	return jrc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (jrc *JobRunCreate) SetScheduledAt(i int64) *JobRunCreate {
+	jrc.mutation.SetScheduledAt(i)
+	return jrc
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableScheduledAt(i *int64) *JobRunCreate {
+	if i != nil {
+		jrc.SetScheduledAt(*i)
+	}
+	return jrc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jrc *JobRunCreate) SetStartedAt(i int64) *JobRunCreate {
+	jrc.mutation.SetStartedAt(i)
+	return jrc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jrc *JobRunCreate) SetFinishedAt(i int64) *JobRunCreate {
+	jrc.mutation.SetFinishedAt(i)
+	return jrc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableFinishedAt(i *int64) *JobRunCreate {
+	if i != nil {
+		jrc.SetFinishedAt(*i)
+	}
+	return jrc
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (jrc *JobRunCreate) SetDurationMs(i int64) *JobRunCreate {
+	jrc.mutation.SetDurationMs(i)
+	return jrc
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableDurationMs(i *int64) *JobRunCreate {
+	if i != nil {
+		jrc.SetDurationMs(*i)
+	}
+	return jrc
+}
+
+// SetError sets the "error" field.
+func (jrc *JobRunCreate) SetError(s string) *JobRunCreate {
+	jrc.mutation.SetError(s)
+	return jrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableError(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetError(*s)
+	}
+	return jrc
+}
+
+// SetID sets the "id" field.
+func (jrc *JobRunCreate) SetID(i int64) *JobRunCreate {
+	jrc.mutation.SetID(i)
+	return jrc
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jrc *JobRunCreate) Mutation() *JobRunMutation {
+	return jrc.mutation
+}
+
+// Save creates the JobRun in the database.
+func (jrc *JobRunCreate) Save(ctx context.Context) (*JobRun, error) {
+	jrc.defaults()
+	return withHooks(ctx, jrc.sqlSave, jrc.mutation, jrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jrc *JobRunCreate) SaveX(ctx context.Context) *JobRun {
+	v, err := jrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrc *JobRunCreate) Exec(ctx context.Context) error {
+	_, err := jrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrc *JobRunCreate) ExecX(ctx context.Context) {
+	if err := jrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jrc *JobRunCreate) defaults() {
+	if _, ok := jrc.mutation.TaskID(); !ok {
+		v := jobrun.DefaultTaskID
+		jrc.mutation.SetTaskID(v)
+	}
+	if _, ok := jrc.mutation.ScheduledAt(); !ok {
+		v := jobrun.DefaultScheduledAt
+		jrc.mutation.SetScheduledAt(v)
+	}
+	if _, ok := jrc.mutation.FinishedAt(); !ok {
+		v := jobrun.DefaultFinishedAt
+		jrc.mutation.SetFinishedAt(v)
+	}
+	if _, ok := jrc.mutation.DurationMs(); !ok {
+		v := jobrun.DefaultDurationMs
+		jrc.mutation.SetDurationMs(v)
+	}
+	if _, ok := jrc.mutation.Error(); !ok {
+		v := jobrun.DefaultError
+		jrc.mutation.SetError(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jrc *JobRunCreate) check() error {
+	if _, ok := jrc.mutation.Job(); !ok {
+		return &ValidationError{Name: "job", err: errors.New(`entc: missing required field "JobRun.job"`)}
+	}
+	if _, ok := jrc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`entc: missing required field "JobRun.trigger"`)}
+	}
+	if _, ok := jrc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`entc: missing required field "JobRun.task_id"`)}
+	}
+	if _, ok := jrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entc: missing required field "JobRun.status"`)}
+	}
+	if _, ok := jrc.mutation.Node(); !ok {
+		return &ValidationError{Name: "node", err: errors.New(`entc: missing required field "JobRun.node"`)}
+	}
+	if _, ok := jrc.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`entc: missing required field "JobRun.scheduled_at"`)}
+	}
+	if _, ok := jrc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`entc: missing required field "JobRun.started_at"`)}
+	}
+	if _, ok := jrc.mutation.FinishedAt(); !ok {
+		return &ValidationError{Name: "finished_at", err: errors.New(`entc: missing required field "JobRun.finished_at"`)}
+	}
+	if _, ok := jrc.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`entc: missing required field "JobRun.duration_ms"`)}
+	}
+	if _, ok := jrc.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`entc: missing required field "JobRun.error"`)}
+	}
+	return nil
+}
+
+func (jrc *JobRunCreate) sqlSave(ctx context.Context) (*JobRun, error) {
+	if err := jrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	jrc.mutation.id = &_node.ID
+	jrc.mutation.done = true
+	return _node, nil
+}
+
+func (jrc *JobRunCreate) createSpec() (*JobRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobRun{config: jrc.config}
+		_spec = sqlgraph.NewCreateSpec(jobrun.Table, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = jrc.conflict
+	if id, ok := jrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jrc.mutation.Job(); ok {
+		_spec.SetField(jobrun.FieldJob, field.TypeString, value)
+		_node.Job = value
+	}
+	if value, ok := jrc.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := jrc.mutation.TaskID(); ok {
+		_spec.SetField(jobrun.FieldTaskID, field.TypeInt64, value)
+		_node.TaskID = value
+	}
+	if value, ok := jrc.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := jrc.mutation.Node(); ok {
+		_spec.SetField(jobrun.FieldNode, field.TypeString, value)
+		_node.Node = value
+	}
+	if value, ok := jrc.mutation.ScheduledAt(); ok {
+		_spec.SetField(jobrun.FieldScheduledAt, field.TypeInt64, value)
+		_node.ScheduledAt = value
+	}
+	if value, ok := jrc.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeInt64, value)
+		_node.StartedAt = value
+	}
+	if value, ok := jrc.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeInt64, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := jrc.mutation.DurationMs(); ok {
+		_spec.SetField(jobrun.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := jrc.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobRun.Create().
+//		SetJob(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobRunUpsert) {
+//			SetJob(v+v).
+//		}).
+//		Exec(ctx)
+func (jrc *JobRunCreate) OnConflict(opts ...sql.ConflictOption) *JobRunUpsertOne {
+	jrc.conflict = opts
+	return &JobRunUpsertOne{
+		create: jrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jrc *JobRunCreate) OnConflictColumns(columns ...string) *JobRunUpsertOne {
+	jrc.conflict = append(jrc.conflict, sql.ConflictColumns(columns...))
+	return &JobRunUpsertOne{
+		create: jrc,
+	}
+}
+
+type (
+	// JobRunUpsertOne is the builder for "upsert"-ing
+	//  one JobRun node.
+	JobRunUpsertOne struct {
+		create *JobRunCreate
+	}
+
+	// JobRunUpsert is the "OnConflict" setter.
+	JobRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetJob sets the "job" field.
+func (u *JobRunUpsert) SetJob(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldJob, v)
+	return u
+}
+
+// UpdateJob sets the "job" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateJob() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldJob)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *JobRunUpsert) SetTrigger(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateTrigger() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldTrigger)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *JobRunUpsert) SetTaskID(v int64) *JobRunUpsert {
+	u.Set(jobrun.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateTaskID() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *JobRunUpsert) AddTaskID(v int64) *JobRunUpsert {
+	u.Add(jobrun.FieldTaskID, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobRunUpsert) SetStatus(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateStatus() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldStatus)
+	return u
+}
+
+// SetNode sets the "node" field.
+func (u *JobRunUpsert) SetNode(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldNode, v)
+	return u
+}
+
+// UpdateNode sets the "node" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateNode() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldNode)
+	return u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *JobRunUpsert) SetScheduledAt(v int64) *JobRunUpsert {
+	u.Set(jobrun.FieldScheduledAt, v)
+	return u
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateScheduledAt() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldScheduledAt)
+	return u
+}
+
+// AddScheduledAt adds v to the "scheduled_at" field.
+func (u *JobRunUpsert) AddScheduledAt(v int64) *JobRunUpsert {
+	u.Add(jobrun.FieldScheduledAt, v)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobRunUpsert) SetStartedAt(v int64) *JobRunUpsert {
+	u.Set(jobrun.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateStartedAt() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldStartedAt)
+	return u
+}
+
+// AddStartedAt adds v to the "started_at" field.
+func (u *JobRunUpsert) AddStartedAt(v int64) *JobRunUpsert {
+	u.Add(jobrun.FieldStartedAt, v)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobRunUpsert) SetFinishedAt(v int64) *JobRunUpsert {
+	u.Set(jobrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateFinishedAt() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldFinishedAt)
+	return u
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *JobRunUpsert) AddFinishedAt(v int64) *JobRunUpsert {
+	u.Add(jobrun.FieldFinishedAt, v)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *JobRunUpsert) SetDurationMs(v int64) *JobRunUpsert {
+	u.Set(jobrun.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateDurationMs() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *JobRunUpsert) AddDurationMs(v int64) *JobRunUpsert {
+	u.Add(jobrun.FieldDurationMs, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *JobRunUpsert) SetError(v string) *JobRunUpsert {
+	u.Set(jobrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobRunUpsert) UpdateError() *JobRunUpsert {
+	u.SetExcluded(jobrun.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobRunUpsertOne) UpdateNewValues() *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobrun.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobRunUpsertOne) Ignore() *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobRunUpsertOne) DoNothing() *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobRunCreate.OnConflict
+// documentation for more info.
+func (u *JobRunUpsertOne) Update(set func(*JobRunUpsert)) *JobRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJob sets the "job" field.
+func (u *JobRunUpsertOne) SetJob(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetJob(v)
+	})
+}
+
+// UpdateJob sets the "job" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateJob() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateJob()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *JobRunUpsertOne) SetTrigger(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateTrigger() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *JobRunUpsertOne) SetTaskID(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *JobRunUpsertOne) AddTaskID(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateTaskID() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobRunUpsertOne) SetStatus(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateStatus() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNode sets the "node" field.
+func (u *JobRunUpsertOne) SetNode(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetNode(v)
+	})
+}
+
+// UpdateNode sets the "node" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateNode() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateNode()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *JobRunUpsertOne) SetScheduledAt(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// AddScheduledAt adds v to the "scheduled_at" field.
+func (u *JobRunUpsertOne) AddScheduledAt(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateScheduledAt() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobRunUpsertOne) SetStartedAt(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// AddStartedAt adds v to the "started_at" field.
+func (u *JobRunUpsertOne) AddStartedAt(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateStartedAt() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobRunUpsertOne) SetFinishedAt(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *JobRunUpsertOne) AddFinishedAt(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateFinishedAt() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *JobRunUpsertOne) SetDurationMs(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *JobRunUpsertOne) AddDurationMs(v int64) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateDurationMs() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobRunUpsertOne) SetError(v string) *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobRunUpsertOne) UpdateError() *JobRunUpsertOne {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for JobRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobRunUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobRunUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobRunCreateBulk is the builder for creating many JobRun entities in bulk.
+type JobRunCreateBulk struct {
+	config
+	err      error
+	builders []*JobRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobRun entities in the database.
+func (jrcb *JobRunCreateBulk) Save(ctx context.Context) ([]*JobRun, error) {
+	if jrcb.err != nil {
+		return nil, jrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jrcb.builders))
+	nodes := make([]*JobRun, len(jrcb.builders))
+	mutators := make([]Mutator, len(jrcb.builders))
+	for i := range jrcb.builders {
+		func(i int, root context.Context) {
+			builder := jrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) SaveX(ctx context.Context) []*JobRun {
+	v, err := jrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrcb *JobRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := jrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) ExecX(ctx context.Context) {
+	if err := jrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobRunUpsert) {
+//			SetJob(v+v).
+//		}).
+//		Exec(ctx)
+func (jrcb *JobRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobRunUpsertBulk {
+	jrcb.conflict = opts
+	return &JobRunUpsertBulk{
+		create: jrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jrcb *JobRunCreateBulk) OnConflictColumns(columns ...string) *JobRunUpsertBulk {
+	jrcb.conflict = append(jrcb.conflict, sql.ConflictColumns(columns...))
+	return &JobRunUpsertBulk{
+		create: jrcb,
+	}
+}
+
+// JobRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobRun nodes.
+type JobRunUpsertBulk struct {
+	create *JobRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobRunUpsertBulk) UpdateNewValues() *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobrun.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobRunUpsertBulk) Ignore() *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobRunUpsertBulk) DoNothing() *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobRunUpsertBulk) Update(set func(*JobRunUpsert)) *JobRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJob sets the "job" field.
+func (u *JobRunUpsertBulk) SetJob(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetJob(v)
+	})
+}
+
+// UpdateJob sets the "job" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateJob() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateJob()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *JobRunUpsertBulk) SetTrigger(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateTrigger() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *JobRunUpsertBulk) SetTaskID(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *JobRunUpsertBulk) AddTaskID(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateTaskID() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobRunUpsertBulk) SetStatus(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateStatus() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNode sets the "node" field.
+func (u *JobRunUpsertBulk) SetNode(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetNode(v)
+	})
+}
+
+// UpdateNode sets the "node" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateNode() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateNode()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *JobRunUpsertBulk) SetScheduledAt(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// AddScheduledAt adds v to the "scheduled_at" field.
+func (u *JobRunUpsertBulk) AddScheduledAt(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateScheduledAt() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *JobRunUpsertBulk) SetStartedAt(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// AddStartedAt adds v to the "started_at" field.
+func (u *JobRunUpsertBulk) AddStartedAt(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateStartedAt() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *JobRunUpsertBulk) SetFinishedAt(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// AddFinishedAt adds v to the "finished_at" field.
+func (u *JobRunUpsertBulk) AddFinishedAt(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateFinishedAt() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *JobRunUpsertBulk) SetDurationMs(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *JobRunUpsertBulk) AddDurationMs(v int64) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateDurationMs() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *JobRunUpsertBulk) SetError(v string) *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *JobRunUpsertBulk) UpdateError() *JobRunUpsertBulk {
+	return u.Update(func(s *JobRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// Exec executes the query.
+func (u *JobRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the JobRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for JobRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// JobRunQuery is the builder for querying JobRun entities.
+type JobRunQuery struct {
+	config
+	ctx        *QueryContext
+	order      []jobrun.OrderOption
+	inters     []Interceptor
+	predicates []predicate.JobRun
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JobRunQuery builder.
+func (jrq *JobRunQuery) Where(ps ...predicate.JobRun) *JobRunQuery {
+	jrq.predicates = append(jrq.predicates, ps...)
+	return jrq
+}
+
+// Limit the number of records to be returned by this query.
+func (jrq *JobRunQuery) Limit(limit int) *JobRunQuery {
+	jrq.ctx.Limit = &limit
+	return jrq
+}
+
+// Offset to start from.
+func (jrq *JobRunQuery) Offset(offset int) *JobRunQuery {
+	jrq.ctx.Offset = &offset
+	return jrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jrq *JobRunQuery) Unique(unique bool) *JobRunQuery {
+	jrq.ctx.Unique = &unique
+	return jrq
+}
+
+// Order specifies how the records should be ordered.
+func (jrq *JobRunQuery) Order(o ...jobrun.OrderOption) *JobRunQuery {
+	jrq.order = append(jrq.order, o...)
+	return jrq
+}
+
+// First returns the first JobRun entity from the query.
+// Returns a *NotFoundError when no JobRun was found.
+func (jrq *JobRunQuery) First(ctx context.Context) (*JobRun, error) {
+	nodes, err := jrq.Limit(1).All(setContextOp(ctx, jrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{jobrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jrq *JobRunQuery) FirstX(ctx context.Context) *JobRun {
+	node, err := jrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JobRun ID from the query.
+// Returns a *NotFoundError when no JobRun ID was found.
+func (jrq *JobRunQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jrq.Limit(1).IDs(setContextOp(ctx, jrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{jobrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jrq *JobRunQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := jrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JobRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one JobRun entity is found.
+// Returns a *NotFoundError when no JobRun entities are found.
+func (jrq *JobRunQuery) Only(ctx context.Context) (*JobRun, error) {
+	nodes, err := jrq.Limit(2).All(setContextOp(ctx, jrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{jobrun.Label}
+	default:
+		return nil, &NotSingularError{jobrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jrq *JobRunQuery) OnlyX(ctx context.Context) *JobRun {
+	node, err := jrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JobRun ID in the query.
+// Returns a *NotSingularError when more than one JobRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (jrq *JobRunQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jrq.Limit(2).IDs(setContextOp(ctx, jrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{jobrun.Label}
+	default:
+		err = &NotSingularError{jobrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jrq *JobRunQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := jrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JobRuns.
+func (jrq *JobRunQuery) All(ctx context.Context) ([]*JobRun, error) {
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryAll)
+	if err := jrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*JobRun, *JobRunQuery]()
+	return withInterceptors[[]*JobRun](ctx, jrq, qr, jrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jrq *JobRunQuery) AllX(ctx context.Context) []*JobRun {
+	nodes, err := jrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JobRun IDs.
+func (jrq *JobRunQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if jrq.ctx.Unique == nil && jrq.path != nil {
+		jrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryIDs)
+	if err = jrq.Select(jobrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jrq *JobRunQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := jrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jrq *JobRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryCount)
+	if err := jrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, jrq, querierCount[*JobRunQuery](), jrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jrq *JobRunQuery) CountX(ctx context.Context) int {
+	count, err := jrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jrq *JobRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, jrq.ctx, ent.OpQueryExist)
+	switch _, err := jrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jrq *JobRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := jrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JobRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jrq *JobRunQuery) Clone() *JobRunQuery {
+	if jrq == nil {
+		return nil
+	}
+	return &JobRunQuery{
+		config:     jrq.config,
+		ctx:        jrq.ctx.Clone(),
+		order:      append([]jobrun.OrderOption{}, jrq.order...),
+		inters:     append([]Interceptor{}, jrq.inters...),
+		predicates: append([]predicate.JobRun{}, jrq.predicates...),
+		// clone intermediate query.
+		sql:       jrq.sql.Clone(),
+		path:      jrq.path,
+		modifiers: append([]func(*sql.Selector){}, jrq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Job string `json:"job,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JobRun.Query().
+//		GroupBy(jobrun.FieldJob).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (jrq *JobRunQuery) GroupBy(field string, fields ...string) *JobRunGroupBy {
+	jrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &JobRunGroupBy{build: jrq}
+	grbuild.flds = &jrq.ctx.Fields
+	grbuild.label = jobrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Job string `json:"job,omitempty"`
+//	}
+//
+//	client.JobRun.Query().
+//		Select(jobrun.FieldJob).
+//		Scan(ctx, &v)
+func (jrq *JobRunQuery) Select(fields ...string) *JobRunSelect {
+	jrq.ctx.Fields = append(jrq.ctx.Fields, fields...)
+	sbuild := &JobRunSelect{JobRunQuery: jrq}
+	sbuild.label = jobrun.Label
+	sbuild.flds, sbuild.scan = &jrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a JobRunSelect configured with the given aggregations.
+func (jrq *JobRunQuery) Aggregate(fns ...AggregateFunc) *JobRunSelect {
+	return jrq.Select().Aggregate(fns...)
+}
+
+func (jrq *JobRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range jrq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, jrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range jrq.ctx.Fields {
+		if !jobrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if jrq.path != nil {
+		prev, err := jrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jrq.sql = prev
+	}
+	return nil
+}
+
+func (jrq *JobRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*JobRun, error) {
+	var (
+		nodes = []*JobRun{}
+		_spec = jrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*JobRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &JobRun{config: jrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(jrq.modifiers) > 0 {
+		_spec.Modifiers = jrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (jrq *JobRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jrq.querySpec()
+	if len(jrq.modifiers) > 0 {
+		_spec.Modifiers = jrq.modifiers
+	}
+	_spec.Node.Columns = jrq.ctx.Fields
+	if len(jrq.ctx.Fields) > 0 {
+		_spec.Unique = jrq.ctx.Unique != nil && *jrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, jrq.driver, _spec)
+}
+
+func (jrq *JobRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt64))
+	_spec.From = jrq.sql
+	if unique := jrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if jrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := jrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for i := range fields {
+			if fields[i] != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := jrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jrq *JobRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jrq.driver.Dialect())
+	t1 := builder.Table(jobrun.Table)
+	columns := jrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = jobrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if jrq.sql != nil {
+		selector = jrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if jrq.ctx.Unique != nil && *jrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range jrq.modifiers {
+		m(selector)
+	}
+	for _, p := range jrq.predicates {
+		p(selector)
+	}
+	for _, p := range jrq.order {
+		p(selector)
+	}
+	if offset := jrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (jrq *JobRunQuery) Modify(modifiers ...func(s *sql.Selector)) *JobRunSelect {
+	jrq.modifiers = append(jrq.modifiers, modifiers...)
+	return jrq.Select()
+}
+
+// JobRunGroupBy is the group-by builder for JobRun entities.
+type JobRunGroupBy struct {
+	selector
+	build *JobRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jrgb *JobRunGroupBy) Aggregate(fns ...AggregateFunc) *JobRunGroupBy {
+	jrgb.fns = append(jrgb.fns, fns...)
+	return jrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jrgb *JobRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := jrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JobRunQuery, *JobRunGroupBy](ctx, jrgb.build, jrgb, jrgb.build.inters, v)
+}
+
+func (jrgb *JobRunGroupBy) sqlScan(ctx context.Context, root *JobRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(jrgb.fns))
+	for _, fn := range jrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*jrgb.flds)+len(jrgb.fns))
+		for _, f := range *jrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*jrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// JobRunSelect is the builder for selecting fields of JobRun entities.
+type JobRunSelect struct {
+	*JobRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (jrs *JobRunSelect) Aggregate(fns ...AggregateFunc) *JobRunSelect {
+	jrs.fns = append(jrs.fns, fns...)
+	return jrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jrs *JobRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jrs.ctx, ent.OpQuerySelect)
+	if err := jrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JobRunQuery, *JobRunSelect](ctx, jrs.JobRunQuery, jrs, jrs.inters, v)
+}
+
+func (jrs *JobRunSelect) sqlScan(ctx context.Context, root *JobRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(jrs.fns))
+	for _, fn := range jrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*jrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (jrs *JobRunSelect) Modify(modifiers ...func(s *sql.Selector)) *JobRunSelect {
+	jrs.modifiers = append(jrs.modifiers, modifiers...)
+	return jrs
+}
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// JobRunUpdate is the builder for updating JobRun entities.
+type JobRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JobRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jru *JobRunUpdate) Where(ps ...predicate.JobRun) *JobRunUpdate {
+	jru.mutation.Where(ps...)
+	return jru
+}
+
+// SetJob sets the "job" field.
+func (jru *JobRunUpdate) SetJob(s string) *JobRunUpdate {
+	jru.mutation.SetJob(s)
+	return jru
+}
+
+// SetNillableJob sets the "job" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableJob(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetJob(*s)
+	}
+	return jru
+}
+
+// SetTrigger sets the "trigger" field.
+func (jru *JobRunUpdate) SetTrigger(s string) *JobRunUpdate {
+	jru.mutation.SetTrigger(s)
+	return jru
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableTrigger(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetTrigger(*s)
+	}
+	return jru
+}
+
+// SetTaskID sets the "task_id" field.
+func (jru *JobRunUpdate) SetTaskID(i int64) *JobRunUpdate {
+	jru.mutation.ResetTaskID()
+	jru.mutation.SetTaskID(i)
+	return jru
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableTaskID(i *int64) *JobRunUpdate {
+	if i != nil {
+		jru.SetTaskID(*i)
+	}
+	return jru
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (jru *JobRunUpdate) AddTaskID(i int64) *JobRunUpdate {
+	jru.mutation.AddTaskID(i)
+	return jru
+}
+
+// SetStatus sets the "status" field.
+func (jru *JobRunUpdate) SetStatus(s string) *JobRunUpdate {
+	jru.mutation.SetStatus(s)
+	return jru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableStatus(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetStatus(*s)
+	}
+	return jru
+}
+
+// SetNode sets the "node" field.
+func (jru *JobRunUpdate) SetNode(s string) *JobRunUpdate {
+	jru.mutation.SetNode(s)
+	return jru
+}
+
+// SetNillableNode sets the "node" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableNode(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetNode(*s)
+	}
+	return jru
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (jru *JobRunUpdate) SetScheduledAt(i int64) *JobRunUpdate {
+	jru.mutation.ResetScheduledAt()
+	jru.mutation.SetScheduledAt(i)
+	return jru
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableScheduledAt(i *int64) *JobRunUpdate {
+	if i != nil {
+		jru.SetScheduledAt(*i)
+	}
+	return jru
+}
+
+// AddScheduledAt adds i to the "scheduled_at" field.
+func (jru *JobRunUpdate) AddScheduledAt(i int64) *JobRunUpdate {
+	jru.mutation.AddScheduledAt(i)
+	return jru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jru *JobRunUpdate) SetStartedAt(i int64) *JobRunUpdate {
+	jru.mutation.ResetStartedAt()
+	jru.mutation.SetStartedAt(i)
+	return jru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableStartedAt(i *int64) *JobRunUpdate {
+	if i != nil {
+		jru.SetStartedAt(*i)
+	}
+	return jru
+}
+
+// AddStartedAt adds i to the "started_at" field.
+func (jru *JobRunUpdate) AddStartedAt(i int64) *JobRunUpdate {
+	jru.mutation.AddStartedAt(i)
+	return jru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jru *JobRunUpdate) SetFinishedAt(i int64) *JobRunUpdate {
+	jru.mutation.ResetFinishedAt()
+	jru.mutation.SetFinishedAt(i)
+	return jru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableFinishedAt(i *int64) *JobRunUpdate {
+	if i != nil {
+		jru.SetFinishedAt(*i)
+	}
+	return jru
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (jru *JobRunUpdate) AddFinishedAt(i int64) *JobRunUpdate {
+	jru.mutation.AddFinishedAt(i)
+	return jru
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (jru *JobRunUpdate) SetDurationMs(i int64) *JobRunUpdate {
+	jru.mutation.ResetDurationMs()
+	jru.mutation.SetDurationMs(i)
+	return jru
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableDurationMs(i *int64) *JobRunUpdate {
+	if i != nil {
+		jru.SetDurationMs(*i)
+	}
+	return jru
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (jru *JobRunUpdate) AddDurationMs(i int64) *JobRunUpdate {
+	jru.mutation.AddDurationMs(i)
+	return jru
+}
+
+// SetError sets the "error" field.
+func (jru *JobRunUpdate) SetError(s string) *JobRunUpdate {
+	jru.mutation.SetError(s)
+	return jru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableError(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetError(*s)
+	}
+	return jru
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jru *JobRunUpdate) Mutation() *JobRunMutation {
+	return jru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jru *JobRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, jru.sqlSave, jru.mutation, jru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jru *JobRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := jru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jru *JobRunUpdate) Exec(ctx context.Context) error {
+	_, err := jru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jru *JobRunUpdate) ExecX(ctx context.Context) {
+	if err := jru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (jru *JobRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobRunUpdate {
+	jru.modifiers = append(jru.modifiers, modifiers...)
+	return jru
+}
+
+func (jru *JobRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt64))
+	if ps := jru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jru.mutation.Job(); ok {
+		_spec.SetField(jobrun.FieldJob, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.TaskID(); ok {
+		_spec.SetField(jobrun.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.AddedTaskID(); ok {
+		_spec.AddField(jobrun.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.Node(); ok {
+		_spec.SetField(jobrun.FieldNode, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.ScheduledAt(); ok {
+		_spec.SetField(jobrun.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.AddedScheduledAt(); ok {
+		_spec.AddField(jobrun.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.AddedStartedAt(); ok {
+		_spec.AddField(jobrun.FieldStartedAt, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(jobrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.DurationMs(); ok {
+		_spec.SetField(jobrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jru.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	_spec.AddModifiers(jru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, jru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jru.mutation.done = true
+	return n, nil
+}
+
+// JobRunUpdateOne is the builder for updating a single JobRun entity.
+type JobRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JobRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetJob sets the "job" field.
+func (jruo *JobRunUpdateOne) SetJob(s string) *JobRunUpdateOne {
+	jruo.mutation.SetJob(s)
+	return jruo
+}
+
+// SetNillableJob sets the "job" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableJob(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetJob(*s)
+	}
+	return jruo
+}
+
+// SetTrigger sets the "trigger" field.
+func (jruo *JobRunUpdateOne) SetTrigger(s string) *JobRunUpdateOne {
+	jruo.mutation.SetTrigger(s)
+	return jruo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableTrigger(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetTrigger(*s)
+	}
+	return jruo
+}
+
+// SetTaskID sets the "task_id" field.
+func (jruo *JobRunUpdateOne) SetTaskID(i int64) *JobRunUpdateOne {
+	jruo.mutation.ResetTaskID()
+	jruo.mutation.SetTaskID(i)
+	return jruo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableTaskID(i *int64) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetTaskID(*i)
+	}
+	return jruo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (jruo *JobRunUpdateOne) AddTaskID(i int64) *JobRunUpdateOne {
+	jruo.mutation.AddTaskID(i)
+	return jruo
+}
+
+// SetStatus sets the "status" field.
+func (jruo *JobRunUpdateOne) SetStatus(s string) *JobRunUpdateOne {
+	jruo.mutation.SetStatus(s)
+	return jruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableStatus(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetStatus(*s)
+	}
+	return jruo
+}
+
+// SetNode sets the "node" field.
+func (jruo *JobRunUpdateOne) SetNode(s string) *JobRunUpdateOne {
+	jruo.mutation.SetNode(s)
+	return jruo
+}
+
+// SetNillableNode sets the "node" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableNode(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetNode(*s)
+	}
+	return jruo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (jruo *JobRunUpdateOne) SetScheduledAt(i int64) *JobRunUpdateOne {
+	jruo.mutation.ResetScheduledAt()
+	jruo.mutation.SetScheduledAt(i)
+	return jruo
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableScheduledAt(i *int64) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetScheduledAt(*i)
+	}
+	return jruo
+}
+
+// AddScheduledAt adds i to the "scheduled_at" field.
+func (jruo *JobRunUpdateOne) AddScheduledAt(i int64) *JobRunUpdateOne {
+	jruo.mutation.AddScheduledAt(i)
+	return jruo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jruo *JobRunUpdateOne) SetStartedAt(i int64) *JobRunUpdateOne {
+	jruo.mutation.ResetStartedAt()
+	jruo.mutation.SetStartedAt(i)
+	return jruo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableStartedAt(i *int64) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetStartedAt(*i)
+	}
+	return jruo
+}
+
+// AddStartedAt adds i to the "started_at" field.
+func (jruo *JobRunUpdateOne) AddStartedAt(i int64) *JobRunUpdateOne {
+	jruo.mutation.AddStartedAt(i)
+	return jruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jruo *JobRunUpdateOne) SetFinishedAt(i int64) *JobRunUpdateOne {
+	jruo.mutation.ResetFinishedAt()
+	jruo.mutation.SetFinishedAt(i)
+	return jruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableFinishedAt(i *int64) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetFinishedAt(*i)
+	}
+	return jruo
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (jruo *JobRunUpdateOne) AddFinishedAt(i int64) *JobRunUpdateOne {
+	jruo.mutation.AddFinishedAt(i)
+	return jruo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (jruo *JobRunUpdateOne) SetDurationMs(i int64) *JobRunUpdateOne {
+	jruo.mutation.ResetDurationMs()
+	jruo.mutation.SetDurationMs(i)
+	return jruo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableDurationMs(i *int64) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetDurationMs(*i)
+	}
+	return jruo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (jruo *JobRunUpdateOne) AddDurationMs(i int64) *JobRunUpdateOne {
+	jruo.mutation.AddDurationMs(i)
+	return jruo
+}
+
+// SetError sets the "error" field.
+func (jruo *JobRunUpdateOne) SetError(s string) *JobRunUpdateOne {
+	jruo.mutation.SetError(s)
+	return jruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableError(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetError(*s)
+	}
+	return jruo
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jruo *JobRunUpdateOne) Mutation() *JobRunMutation {
+	return jruo.mutation
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jruo *JobRunUpdateOne) Where(ps ...predicate.JobRun) *JobRunUpdateOne {
+	jruo.mutation.Where(ps...)
+	return jruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jruo *JobRunUpdateOne) Select(field string, fields ...string) *JobRunUpdateOne {
+	jruo.fields = append([]string{field}, fields...)
+	return jruo
+}
+
+// Save executes the query and returns the updated JobRun entity.
+func (jruo *JobRunUpdateOne) Save(ctx context.Context) (*JobRun, error) {
+	return withHooks(ctx, jruo.sqlSave, jruo.mutation, jruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) SaveX(ctx context.Context) *JobRun {
+	node, err := jruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jruo *JobRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := jruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) ExecX(ctx context.Context) {
+	if err := jruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (jruo *JobRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobRunUpdateOne {
+	jruo.modifiers = append(jruo.modifiers, modifiers...)
+	return jruo
+}
+
+func (jruo *JobRunUpdateOne) sqlSave(ctx context.Context) (_node *JobRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeInt64))
+	id, ok := jruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "JobRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for _, f := range fields {
+			if !jobrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jruo.mutation.Job(); ok {
+		_spec.SetField(jobrun.FieldJob, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.Trigger(); ok {
+		_spec.SetField(jobrun.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.TaskID(); ok {
+		_spec.SetField(jobrun.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.AddedTaskID(); ok {
+		_spec.AddField(jobrun.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.Node(); ok {
+		_spec.SetField(jobrun.FieldNode, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.ScheduledAt(); ok {
+		_spec.SetField(jobrun.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.AddedScheduledAt(); ok {
+		_spec.AddField(jobrun.FieldScheduledAt, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.AddedStartedAt(); ok {
+		_spec.AddField(jobrun.FieldStartedAt, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(jobrun.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.DurationMs(); ok {
+		_spec.SetField(jobrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(jobrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := jruo.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	_spec.AddModifiers(jruo.modifiers...)
+	_node = &JobRun{config: jruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+)
+
+// JobTask is the model entity for the JobTask schema.
+type JobTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Queue holds the value of the "queue" field.
+	Queue string `json:"queue,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// RunAt holds the value of the "run_at" field.
+	RunAt int64 `json:"run_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobtask.FieldPayload:
+			values[i] = new([]byte)
+		case jobtask.FieldID, jobtask.FieldAttempts, jobtask.FieldRunAt, jobtask.FieldCreatedAt, jobtask.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case jobtask.FieldQueue, jobtask.FieldStatus, jobtask.FieldLastError:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobTask fields.
+func (jt *JobTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobtask.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			jt.ID = int64(value.Int64)
+		case jobtask.FieldQueue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field queue", values[i])
+			} else if value.Valid {
+				jt.Queue = value.String
+			}
+		case jobtask.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				jt.Payload = *value
+			}
+		case jobtask.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				jt.Status = value.String
+			}
+		case jobtask.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				jt.Attempts = int(value.Int64)
+			}
+		case jobtask.FieldRunAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_at", values[i])
+			} else if value.Valid {
+				jt.RunAt = value.Int64
+			}
+		case jobtask.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				jt.LastError = value.String
+			}
+		case jobtask.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				jt.CreatedAt = value.Int64
+			}
+		case jobtask.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				jt.UpdatedAt = value.Int64
+			}
+		default:
+			jt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobTask.
+// This includes values selected through modifiers, order, etc.
+func (jt *JobTask) Value(name string) (ent.Value, error) {
+	return jt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JobTask.
+// Note that you need to call JobTask.Unwrap() before calling this method if this JobTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (jt *JobTask) Update() *JobTaskUpdateOne {
+	return NewJobTaskClient(jt.config).UpdateOne(jt)
+}
+
+// Unwrap unwraps the JobTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (jt *JobTask) Unwrap() *JobTask {
+	_tx, ok := jt.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: JobTask is not a transactional entity")
+	}
+	jt.config.driver = _tx.drv
+	return jt
+}
+
+// String implements the fmt.Stringer.
+func (jt *JobTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", jt.ID))
+	builder.WriteString("queue=")
+	builder.WriteString(jt.Queue)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", jt.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(jt.Status)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", jt.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("run_at=")
+	builder.WriteString(fmt.Sprintf("%v", jt.RunAt))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(jt.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", jt.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", jt.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobTasks is a parsable slice of JobTask.
+type JobTasks []*JobTask
@@ -0,0 +1,935 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+)
+
+// JobTaskCreate is the builder for creating a JobTask entity.
+type JobTaskCreate struct {
+	config
+	mutation *JobTaskMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetQueue sets the "queue" field.
+func (jtc *JobTaskCreate) SetQueue(s string) *JobTaskCreate {
+	jtc.mutation.SetQueue(s)
+	return jtc
+}
+
+// SetPayload sets the "payload" field.
+func (jtc *JobTaskCreate) SetPayload(b []byte) *JobTaskCreate {
+	jtc.mutation.SetPayload(b)
+	return jtc
+}
+
+// SetStatus sets the "status" field.
+func (jtc *JobTaskCreate) SetStatus(s string) *JobTaskCreate {
+	jtc.mutation.SetStatus(s)
+	return jtc
+}
+
+// SetAttempts sets the "attempts" field.
+func (jtc *JobTaskCreate) SetAttempts(i int) *JobTaskCreate {
+	jtc.mutation.SetAttempts(i)
+	return jtc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jtc *JobTaskCreate) SetNillableAttempts(i *int) *JobTaskCreate {
+	if i != nil {
+		jtc.SetAttempts(*i)
+	}
+	return jtc
+}
+
+// SetRunAt sets the "run_at" field.
+func (jtc *JobTaskCreate) SetRunAt(i int64) *JobTaskCreate {
+	jtc.mutation.SetRunAt(i)
+	return jtc
+}
+
+// SetLastError sets the "last_error" field.
+func (jtc *JobTaskCreate) SetLastError(s string) *JobTaskCreate {
+	jtc.mutation.SetLastError(s)
+	return jtc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (jtc *JobTaskCreate) SetNillableLastError(s *string) *JobTaskCreate {
+	if s != nil {
+		jtc.SetLastError(*s)
+	}
+	return jtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jtc *JobTaskCreate) SetCreatedAt(i int64) *JobTaskCreate {
+	jtc.mutation.SetCreatedAt(i)
+	return jtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jtc *JobTaskCreate) SetNillableCreatedAt(i *int64) *JobTaskCreate {
+	if i != nil {
+		jtc.SetCreatedAt(*i)
+	}
+	return jtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jtc *JobTaskCreate) SetUpdatedAt(i int64) *JobTaskCreate {
+	jtc.mutation.SetUpdatedAt(i)
+	return jtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (jtc *JobTaskCreate) SetNillableUpdatedAt(i *int64) *JobTaskCreate {
+	if i != nil {
+		jtc.SetUpdatedAt(*i)
+	}
+	return jtc
+}
+
+// SetID sets the "id" field.
+func (jtc *JobTaskCreate) SetID(i int64) *JobTaskCreate {
+	jtc.mutation.SetID(i)
+	return jtc
+}
+
+// Mutation returns the JobTaskMutation object of the builder.
+func (jtc *JobTaskCreate) Mutation() *JobTaskMutation {
+	return jtc.mutation
+}
+
+// Save creates the JobTask in the database.
+func (jtc *JobTaskCreate) Save(ctx context.Context) (*JobTask, error) {
+	jtc.defaults()
+	return withHooks(ctx, jtc.sqlSave, jtc.mutation, jtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jtc *JobTaskCreate) SaveX(ctx context.Context) *JobTask {
+	v, err := jtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jtc *JobTaskCreate) Exec(ctx context.Context) error {
+	_, err := jtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jtc *JobTaskCreate) ExecX(ctx context.Context) {
+	if err := jtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jtc *JobTaskCreate) defaults() {
+	if _, ok := jtc.mutation.Attempts(); !ok {
+		v := jobtask.DefaultAttempts
+		jtc.mutation.SetAttempts(v)
+	}
+	if _, ok := jtc.mutation.LastError(); !ok {
+		v := jobtask.DefaultLastError
+		jtc.mutation.SetLastError(v)
+	}
+	if _, ok := jtc.mutation.CreatedAt(); !ok {
+		v := jobtask.DefaultCreatedAt()
+		jtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := jtc.mutation.UpdatedAt(); !ok {
+		v := jobtask.DefaultUpdatedAt()
+		jtc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jtc *JobTaskCreate) check() error {
+	if _, ok := jtc.mutation.Queue(); !ok {
+		return &ValidationError{Name: "queue", err: errors.New(`entc: missing required field "JobTask.queue"`)}
+	}
+	if _, ok := jtc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`entc: missing required field "JobTask.payload"`)}
+	}
+	if _, ok := jtc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entc: missing required field "JobTask.status"`)}
+	}
+	if _, ok := jtc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`entc: missing required field "JobTask.attempts"`)}
+	}
+	if _, ok := jtc.mutation.RunAt(); !ok {
+		return &ValidationError{Name: "run_at", err: errors.New(`entc: missing required field "JobTask.run_at"`)}
+	}
+	if _, ok := jtc.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`entc: missing required field "JobTask.last_error"`)}
+	}
+	if _, ok := jtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "JobTask.created_at"`)}
+	}
+	if _, ok := jtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "JobTask.updated_at"`)}
+	}
+	return nil
+}
+
+func (jtc *JobTaskCreate) sqlSave(ctx context.Context) (*JobTask, error) {
+	if err := jtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	jtc.mutation.id = &_node.ID
+	jtc.mutation.done = true
+	return _node, nil
+}
+
+func (jtc *JobTaskCreate) createSpec() (*JobTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobTask{config: jtc.config}
+		_spec = sqlgraph.NewCreateSpec(jobtask.Table, sqlgraph.NewFieldSpec(jobtask.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = jtc.conflict
+	if id, ok := jtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jtc.mutation.Queue(); ok {
+		_spec.SetField(jobtask.FieldQueue, field.TypeString, value)
+		_node.Queue = value
+	}
+	if value, ok := jtc.mutation.Payload(); ok {
+		_spec.SetField(jobtask.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := jtc.mutation.Status(); ok {
+		_spec.SetField(jobtask.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := jtc.mutation.Attempts(); ok {
+		_spec.SetField(jobtask.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := jtc.mutation.RunAt(); ok {
+		_spec.SetField(jobtask.FieldRunAt, field.TypeInt64, value)
+		_node.RunAt = value
+	}
+	if value, ok := jtc.mutation.LastError(); ok {
+		_spec.SetField(jobtask.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := jtc.mutation.CreatedAt(); ok {
+		_spec.SetField(jobtask.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := jtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobtask.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobTask.Create().
+//		SetQueue(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobTaskUpsert) {
+//			SetQueue(v+v).
+//		}).
+//		Exec(ctx)
+func (jtc *JobTaskCreate) OnConflict(opts ...sql.ConflictOption) *JobTaskUpsertOne {
+	jtc.conflict = opts
+	return &JobTaskUpsertOne{
+		create: jtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobTask.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jtc *JobTaskCreate) OnConflictColumns(columns ...string) *JobTaskUpsertOne {
+	jtc.conflict = append(jtc.conflict, sql.ConflictColumns(columns...))
+	return &JobTaskUpsertOne{
+		create: jtc,
+	}
+}
+
+type (
+	// JobTaskUpsertOne is the builder for "upsert"-ing
+	//  one JobTask node.
+	JobTaskUpsertOne struct {
+		create *JobTaskCreate
+	}
+
+	// JobTaskUpsert is the "OnConflict" setter.
+	JobTaskUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetQueue sets the "queue" field.
+func (u *JobTaskUpsert) SetQueue(v string) *JobTaskUpsert {
+	u.Set(jobtask.FieldQueue, v)
+	return u
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *JobTaskUpsert) UpdateQueue() *JobTaskUpsert {
+	u.SetExcluded(jobtask.FieldQueue)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobTaskUpsert) SetPayload(v []byte) *JobTaskUpsert {
+	u.Set(jobtask.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobTaskUpsert) UpdatePayload() *JobTaskUpsert {
+	u.SetExcluded(jobtask.FieldPayload)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *JobTaskUpsert) SetStatus(v string) *JobTaskUpsert {
+	u.Set(jobtask.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobTaskUpsert) UpdateStatus() *JobTaskUpsert {
+	u.SetExcluded(jobtask.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobTaskUpsert) SetAttempts(v int) *JobTaskUpsert {
+	u.Set(jobtask.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobTaskUpsert) UpdateAttempts() *JobTaskUpsert {
+	u.SetExcluded(jobtask.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobTaskUpsert) AddAttempts(v int) *JobTaskUpsert {
+	u.Add(jobtask.FieldAttempts, v)
+	return u
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobTaskUpsert) SetRunAt(v int64) *JobTaskUpsert {
+	u.Set(jobtask.FieldRunAt, v)
+	return u
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobTaskUpsert) UpdateRunAt() *JobTaskUpsert {
+	u.SetExcluded(jobtask.FieldRunAt)
+	return u
+}
+
+// AddRunAt adds v to the "run_at" field.
+func (u *JobTaskUpsert) AddRunAt(v int64) *JobTaskUpsert {
+	u.Add(jobtask.FieldRunAt, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobTaskUpsert) SetLastError(v string) *JobTaskUpsert {
+	u.Set(jobtask.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobTaskUpsert) UpdateLastError() *JobTaskUpsert {
+	u.SetExcluded(jobtask.FieldLastError)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobTaskUpsert) SetUpdatedAt(v int64) *JobTaskUpsert {
+	u.Set(jobtask.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobTaskUpsert) UpdateUpdatedAt() *JobTaskUpsert {
+	u.SetExcluded(jobtask.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *JobTaskUpsert) AddUpdatedAt(v int64) *JobTaskUpsert {
+	u.Add(jobtask.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JobTask.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobtask.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobTaskUpsertOne) UpdateNewValues() *JobTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jobtask.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(jobtask.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobTask.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JobTaskUpsertOne) Ignore() *JobTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobTaskUpsertOne) DoNothing() *JobTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobTaskCreate.OnConflict
+// documentation for more info.
+func (u *JobTaskUpsertOne) Update(set func(*JobTaskUpsert)) *JobTaskUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobTaskUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQueue sets the "queue" field.
+func (u *JobTaskUpsertOne) SetQueue(v string) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *JobTaskUpsertOne) UpdateQueue() *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobTaskUpsertOne) SetPayload(v []byte) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobTaskUpsertOne) UpdatePayload() *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobTaskUpsertOne) SetStatus(v string) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobTaskUpsertOne) UpdateStatus() *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobTaskUpsertOne) SetAttempts(v int) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobTaskUpsertOne) AddAttempts(v int) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobTaskUpsertOne) UpdateAttempts() *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobTaskUpsertOne) SetRunAt(v int64) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// AddRunAt adds v to the "run_at" field.
+func (u *JobTaskUpsertOne) AddRunAt(v int64) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.AddRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobTaskUpsertOne) UpdateRunAt() *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobTaskUpsertOne) SetLastError(v string) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobTaskUpsertOne) UpdateLastError() *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobTaskUpsertOne) SetUpdatedAt(v int64) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *JobTaskUpsertOne) AddUpdatedAt(v int64) *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobTaskUpsertOne) UpdateUpdatedAt() *JobTaskUpsertOne {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobTaskUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for JobTaskCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobTaskUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JobTaskUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JobTaskUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JobTaskCreateBulk is the builder for creating many JobTask entities in bulk.
+type JobTaskCreateBulk struct {
+	config
+	err      error
+	builders []*JobTaskCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JobTask entities in the database.
+func (jtcb *JobTaskCreateBulk) Save(ctx context.Context) ([]*JobTask, error) {
+	if jtcb.err != nil {
+		return nil, jtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jtcb.builders))
+	nodes := make([]*JobTask, len(jtcb.builders))
+	mutators := make([]Mutator, len(jtcb.builders))
+	for i := range jtcb.builders {
+		func(i int, root context.Context) {
+			builder := jtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jtcb *JobTaskCreateBulk) SaveX(ctx context.Context) []*JobTask {
+	v, err := jtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jtcb *JobTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := jtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jtcb *JobTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := jtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JobTask.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JobTaskUpsert) {
+//			SetQueue(v+v).
+//		}).
+//		Exec(ctx)
+func (jtcb *JobTaskCreateBulk) OnConflict(opts ...sql.ConflictOption) *JobTaskUpsertBulk {
+	jtcb.conflict = opts
+	return &JobTaskUpsertBulk{
+		create: jtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JobTask.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jtcb *JobTaskCreateBulk) OnConflictColumns(columns ...string) *JobTaskUpsertBulk {
+	jtcb.conflict = append(jtcb.conflict, sql.ConflictColumns(columns...))
+	return &JobTaskUpsertBulk{
+		create: jtcb,
+	}
+}
+
+// JobTaskUpsertBulk is the builder for "upsert"-ing
+// a bulk of JobTask nodes.
+type JobTaskUpsertBulk struct {
+	create *JobTaskCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JobTask.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jobtask.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JobTaskUpsertBulk) UpdateNewValues() *JobTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jobtask.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(jobtask.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JobTask.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JobTaskUpsertBulk) Ignore() *JobTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JobTaskUpsertBulk) DoNothing() *JobTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JobTaskCreateBulk.OnConflict
+// documentation for more info.
+func (u *JobTaskUpsertBulk) Update(set func(*JobTaskUpsert)) *JobTaskUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JobTaskUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetQueue sets the "queue" field.
+func (u *JobTaskUpsertBulk) SetQueue(v string) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *JobTaskUpsertBulk) UpdateQueue() *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *JobTaskUpsertBulk) SetPayload(v []byte) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *JobTaskUpsertBulk) UpdatePayload() *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *JobTaskUpsertBulk) SetStatus(v string) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *JobTaskUpsertBulk) UpdateStatus() *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *JobTaskUpsertBulk) SetAttempts(v int) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *JobTaskUpsertBulk) AddAttempts(v int) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *JobTaskUpsertBulk) UpdateAttempts() *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetRunAt sets the "run_at" field.
+func (u *JobTaskUpsertBulk) SetRunAt(v int64) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetRunAt(v)
+	})
+}
+
+// AddRunAt adds v to the "run_at" field.
+func (u *JobTaskUpsertBulk) AddRunAt(v int64) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.AddRunAt(v)
+	})
+}
+
+// UpdateRunAt sets the "run_at" field to the value that was provided on create.
+func (u *JobTaskUpsertBulk) UpdateRunAt() *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateRunAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *JobTaskUpsertBulk) SetLastError(v string) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *JobTaskUpsertBulk) UpdateLastError() *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *JobTaskUpsertBulk) SetUpdatedAt(v int64) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *JobTaskUpsertBulk) AddUpdatedAt(v int64) *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *JobTaskUpsertBulk) UpdateUpdatedAt() *JobTaskUpsertBulk {
+	return u.Update(func(s *JobTaskUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *JobTaskUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the JobTaskCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for JobTaskCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JobTaskUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
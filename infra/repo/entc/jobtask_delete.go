@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// JobTaskDelete is the builder for deleting a JobTask entity.
+type JobTaskDelete struct {
+	config
+	hooks    []Hook
+	mutation *JobTaskMutation
+}
+
+// Where appends a list predicates to the JobTaskDelete builder.
+func (jtd *JobTaskDelete) Where(ps ...predicate.JobTask) *JobTaskDelete {
+	jtd.mutation.Where(ps...)
+	return jtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (jtd *JobTaskDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, jtd.sqlExec, jtd.mutation, jtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jtd *JobTaskDelete) ExecX(ctx context.Context) int {
+	n, err := jtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (jtd *JobTaskDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(jobtask.Table, sqlgraph.NewFieldSpec(jobtask.FieldID, field.TypeInt64))
+	if ps := jtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, jtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	jtd.mutation.done = true
+	return affected, err
+}
+
+// JobTaskDeleteOne is the builder for deleting a single JobTask entity.
+type JobTaskDeleteOne struct {
+	jtd *JobTaskDelete
+}
+
+// Where appends a list predicates to the JobTaskDelete builder.
+func (jtdo *JobTaskDeleteOne) Where(ps ...predicate.JobTask) *JobTaskDeleteOne {
+	jtdo.jtd.mutation.Where(ps...)
+	return jtdo
+}
+
+// Exec executes the deletion query.
+func (jtdo *JobTaskDeleteOne) Exec(ctx context.Context) error {
+	n, err := jtdo.jtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{jobtask.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jtdo *JobTaskDeleteOne) ExecX(ctx context.Context) {
+	if err := jtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// JobTaskQuery is the builder for querying JobTask entities.
+type JobTaskQuery struct {
+	config
+	ctx        *QueryContext
+	order      []jobtask.OrderOption
+	inters     []Interceptor
+	predicates []predicate.JobTask
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JobTaskQuery builder.
+func (jtq *JobTaskQuery) Where(ps ...predicate.JobTask) *JobTaskQuery {
+	jtq.predicates = append(jtq.predicates, ps...)
+	return jtq
+}
+
+// Limit the number of records to be returned by this query.
+func (jtq *JobTaskQuery) Limit(limit int) *JobTaskQuery {
+	jtq.ctx.Limit = &limit
+	return jtq
+}
+
+// Offset to start from.
+func (jtq *JobTaskQuery) Offset(offset int) *JobTaskQuery {
+	jtq.ctx.Offset = &offset
+	return jtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jtq *JobTaskQuery) Unique(unique bool) *JobTaskQuery {
+	jtq.ctx.Unique = &unique
+	return jtq
+}
+
+// Order specifies how the records should be ordered.
+func (jtq *JobTaskQuery) Order(o ...jobtask.OrderOption) *JobTaskQuery {
+	jtq.order = append(jtq.order, o...)
+	return jtq
+}
+
+// First returns the first JobTask entity from the query.
+// Returns a *NotFoundError when no JobTask was found.
+func (jtq *JobTaskQuery) First(ctx context.Context) (*JobTask, error) {
+	nodes, err := jtq.Limit(1).All(setContextOp(ctx, jtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{jobtask.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jtq *JobTaskQuery) FirstX(ctx context.Context) *JobTask {
+	node, err := jtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JobTask ID from the query.
+// Returns a *NotFoundError when no JobTask ID was found.
+func (jtq *JobTaskQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jtq.Limit(1).IDs(setContextOp(ctx, jtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{jobtask.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jtq *JobTaskQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := jtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JobTask entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one JobTask entity is found.
+// Returns a *NotFoundError when no JobTask entities are found.
+func (jtq *JobTaskQuery) Only(ctx context.Context) (*JobTask, error) {
+	nodes, err := jtq.Limit(2).All(setContextOp(ctx, jtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{jobtask.Label}
+	default:
+		return nil, &NotSingularError{jobtask.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jtq *JobTaskQuery) OnlyX(ctx context.Context) *JobTask {
+	node, err := jtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JobTask ID in the query.
+// Returns a *NotSingularError when more than one JobTask ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (jtq *JobTaskQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = jtq.Limit(2).IDs(setContextOp(ctx, jtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{jobtask.Label}
+	default:
+		err = &NotSingularError{jobtask.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jtq *JobTaskQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := jtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JobTasks.
+func (jtq *JobTaskQuery) All(ctx context.Context) ([]*JobTask, error) {
+	ctx = setContextOp(ctx, jtq.ctx, ent.OpQueryAll)
+	if err := jtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*JobTask, *JobTaskQuery]()
+	return withInterceptors[[]*JobTask](ctx, jtq, qr, jtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jtq *JobTaskQuery) AllX(ctx context.Context) []*JobTask {
+	nodes, err := jtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JobTask IDs.
+func (jtq *JobTaskQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if jtq.ctx.Unique == nil && jtq.path != nil {
+		jtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, jtq.ctx, ent.OpQueryIDs)
+	if err = jtq.Select(jobtask.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jtq *JobTaskQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := jtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jtq *JobTaskQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, jtq.ctx, ent.OpQueryCount)
+	if err := jtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, jtq, querierCount[*JobTaskQuery](), jtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jtq *JobTaskQuery) CountX(ctx context.Context) int {
+	count, err := jtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jtq *JobTaskQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, jtq.ctx, ent.OpQueryExist)
+	switch _, err := jtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jtq *JobTaskQuery) ExistX(ctx context.Context) bool {
+	exist, err := jtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JobTaskQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jtq *JobTaskQuery) Clone() *JobTaskQuery {
+	if jtq == nil {
+		return nil
+	}
+	return &JobTaskQuery{
+		config:     jtq.config,
+		ctx:        jtq.ctx.Clone(),
+		order:      append([]jobtask.OrderOption{}, jtq.order...),
+		inters:     append([]Interceptor{}, jtq.inters...),
+		predicates: append([]predicate.JobTask{}, jtq.predicates...),
+		// clone intermediate query.
+		sql:       jtq.sql.Clone(),
+		path:      jtq.path,
+		modifiers: append([]func(*sql.Selector){}, jtq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Queue string `json:"queue,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JobTask.Query().
+//		GroupBy(jobtask.FieldQueue).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (jtq *JobTaskQuery) GroupBy(field string, fields ...string) *JobTaskGroupBy {
+	jtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &JobTaskGroupBy{build: jtq}
+	grbuild.flds = &jtq.ctx.Fields
+	grbuild.label = jobtask.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Queue string `json:"queue,omitempty"`
+//	}
+//
+//	client.JobTask.Query().
+//		Select(jobtask.FieldQueue).
+//		Scan(ctx, &v)
+func (jtq *JobTaskQuery) Select(fields ...string) *JobTaskSelect {
+	jtq.ctx.Fields = append(jtq.ctx.Fields, fields...)
+	sbuild := &JobTaskSelect{JobTaskQuery: jtq}
+	sbuild.label = jobtask.Label
+	sbuild.flds, sbuild.scan = &jtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a JobTaskSelect configured with the given aggregations.
+func (jtq *JobTaskQuery) Aggregate(fns ...AggregateFunc) *JobTaskSelect {
+	return jtq.Select().Aggregate(fns...)
+}
+
+func (jtq *JobTaskQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range jtq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, jtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range jtq.ctx.Fields {
+		if !jobtask.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if jtq.path != nil {
+		prev, err := jtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jtq.sql = prev
+	}
+	return nil
+}
+
+func (jtq *JobTaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*JobTask, error) {
+	var (
+		nodes = []*JobTask{}
+		_spec = jtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*JobTask).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &JobTask{config: jtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(jtq.modifiers) > 0 {
+		_spec.Modifiers = jtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (jtq *JobTaskQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jtq.querySpec()
+	if len(jtq.modifiers) > 0 {
+		_spec.Modifiers = jtq.modifiers
+	}
+	_spec.Node.Columns = jtq.ctx.Fields
+	if len(jtq.ctx.Fields) > 0 {
+		_spec.Unique = jtq.ctx.Unique != nil && *jtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, jtq.driver, _spec)
+}
+
+func (jtq *JobTaskQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(jobtask.Table, jobtask.Columns, sqlgraph.NewFieldSpec(jobtask.FieldID, field.TypeInt64))
+	_spec.From = jtq.sql
+	if unique := jtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if jtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := jtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobtask.FieldID)
+		for i := range fields {
+			if fields[i] != jobtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := jtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jtq *JobTaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jtq.driver.Dialect())
+	t1 := builder.Table(jobtask.Table)
+	columns := jtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = jobtask.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if jtq.sql != nil {
+		selector = jtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if jtq.ctx.Unique != nil && *jtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range jtq.modifiers {
+		m(selector)
+	}
+	for _, p := range jtq.predicates {
+		p(selector)
+	}
+	for _, p := range jtq.order {
+		p(selector)
+	}
+	if offset := jtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (jtq *JobTaskQuery) Modify(modifiers ...func(s *sql.Selector)) *JobTaskSelect {
+	jtq.modifiers = append(jtq.modifiers, modifiers...)
+	return jtq.Select()
+}
+
+// JobTaskGroupBy is the group-by builder for JobTask entities.
+type JobTaskGroupBy struct {
+	selector
+	build *JobTaskQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jtgb *JobTaskGroupBy) Aggregate(fns ...AggregateFunc) *JobTaskGroupBy {
+	jtgb.fns = append(jtgb.fns, fns...)
+	return jtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jtgb *JobTaskGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := jtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JobTaskQuery, *JobTaskGroupBy](ctx, jtgb.build, jtgb, jtgb.build.inters, v)
+}
+
+func (jtgb *JobTaskGroupBy) sqlScan(ctx context.Context, root *JobTaskQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(jtgb.fns))
+	for _, fn := range jtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*jtgb.flds)+len(jtgb.fns))
+		for _, f := range *jtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*jtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// JobTaskSelect is the builder for selecting fields of JobTask entities.
+type JobTaskSelect struct {
+	*JobTaskQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (jts *JobTaskSelect) Aggregate(fns ...AggregateFunc) *JobTaskSelect {
+	jts.fns = append(jts.fns, fns...)
+	return jts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jts *JobTaskSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jts.ctx, ent.OpQuerySelect)
+	if err := jts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JobTaskQuery, *JobTaskSelect](ctx, jts.JobTaskQuery, jts, jts.inters, v)
+}
+
+func (jts *JobTaskSelect) sqlScan(ctx context.Context, root *JobTaskQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(jts.fns))
+	for _, fn := range jts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*jts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (jts *JobTaskSelect) Modify(modifiers ...func(s *sql.Selector)) *JobTaskSelect {
+	jts.modifiers = append(jts.modifiers, modifiers...)
+	return jts
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// JobTaskUpdate is the builder for updating JobTask entities.
+type JobTaskUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JobTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JobTaskUpdate builder.
+func (jtu *JobTaskUpdate) Where(ps ...predicate.JobTask) *JobTaskUpdate {
+	jtu.mutation.Where(ps...)
+	return jtu
+}
+
+// SetQueue sets the "queue" field.
+func (jtu *JobTaskUpdate) SetQueue(s string) *JobTaskUpdate {
+	jtu.mutation.SetQueue(s)
+	return jtu
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (jtu *JobTaskUpdate) SetNillableQueue(s *string) *JobTaskUpdate {
+	if s != nil {
+		jtu.SetQueue(*s)
+	}
+	return jtu
+}
+
+// SetPayload sets the "payload" field.
+func (jtu *JobTaskUpdate) SetPayload(b []byte) *JobTaskUpdate {
+	jtu.mutation.SetPayload(b)
+	return jtu
+}
+
+// SetStatus sets the "status" field.
+func (jtu *JobTaskUpdate) SetStatus(s string) *JobTaskUpdate {
+	jtu.mutation.SetStatus(s)
+	return jtu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jtu *JobTaskUpdate) SetNillableStatus(s *string) *JobTaskUpdate {
+	if s != nil {
+		jtu.SetStatus(*s)
+	}
+	return jtu
+}
+
+// SetAttempts sets the "attempts" field.
+func (jtu *JobTaskUpdate) SetAttempts(i int) *JobTaskUpdate {
+	jtu.mutation.ResetAttempts()
+	jtu.mutation.SetAttempts(i)
+	return jtu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jtu *JobTaskUpdate) SetNillableAttempts(i *int) *JobTaskUpdate {
+	if i != nil {
+		jtu.SetAttempts(*i)
+	}
+	return jtu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (jtu *JobTaskUpdate) AddAttempts(i int) *JobTaskUpdate {
+	jtu.mutation.AddAttempts(i)
+	return jtu
+}
+
+// SetRunAt sets the "run_at" field.
+func (jtu *JobTaskUpdate) SetRunAt(i int64) *JobTaskUpdate {
+	jtu.mutation.ResetRunAt()
+	jtu.mutation.SetRunAt(i)
+	return jtu
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (jtu *JobTaskUpdate) SetNillableRunAt(i *int64) *JobTaskUpdate {
+	if i != nil {
+		jtu.SetRunAt(*i)
+	}
+	return jtu
+}
+
+// AddRunAt adds i to the "run_at" field.
+func (jtu *JobTaskUpdate) AddRunAt(i int64) *JobTaskUpdate {
+	jtu.mutation.AddRunAt(i)
+	return jtu
+}
+
+// SetLastError sets the "last_error" field.
+func (jtu *JobTaskUpdate) SetLastError(s string) *JobTaskUpdate {
+	jtu.mutation.SetLastError(s)
+	return jtu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (jtu *JobTaskUpdate) SetNillableLastError(s *string) *JobTaskUpdate {
+	if s != nil {
+		jtu.SetLastError(*s)
+	}
+	return jtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jtu *JobTaskUpdate) SetUpdatedAt(i int64) *JobTaskUpdate {
+	jtu.mutation.ResetUpdatedAt()
+	jtu.mutation.SetUpdatedAt(i)
+	return jtu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (jtu *JobTaskUpdate) AddUpdatedAt(i int64) *JobTaskUpdate {
+	jtu.mutation.AddUpdatedAt(i)
+	return jtu
+}
+
+// Mutation returns the JobTaskMutation object of the builder.
+func (jtu *JobTaskUpdate) Mutation() *JobTaskMutation {
+	return jtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jtu *JobTaskUpdate) Save(ctx context.Context) (int, error) {
+	jtu.defaults()
+	return withHooks(ctx, jtu.sqlSave, jtu.mutation, jtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jtu *JobTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := jtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jtu *JobTaskUpdate) Exec(ctx context.Context) error {
+	_, err := jtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jtu *JobTaskUpdate) ExecX(ctx context.Context) {
+	if err := jtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jtu *JobTaskUpdate) defaults() {
+	if _, ok := jtu.mutation.UpdatedAt(); !ok {
+		v := jobtask.UpdateDefaultUpdatedAt()
+		jtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (jtu *JobTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobTaskUpdate {
+	jtu.modifiers = append(jtu.modifiers, modifiers...)
+	return jtu
+}
+
+func (jtu *JobTaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobtask.Table, jobtask.Columns, sqlgraph.NewFieldSpec(jobtask.FieldID, field.TypeInt64))
+	if ps := jtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jtu.mutation.Queue(); ok {
+		_spec.SetField(jobtask.FieldQueue, field.TypeString, value)
+	}
+	if value, ok := jtu.mutation.Payload(); ok {
+		_spec.SetField(jobtask.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := jtu.mutation.Status(); ok {
+		_spec.SetField(jobtask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := jtu.mutation.Attempts(); ok {
+		_spec.SetField(jobtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jtu.mutation.AddedAttempts(); ok {
+		_spec.AddField(jobtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jtu.mutation.RunAt(); ok {
+		_spec.SetField(jobtask.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := jtu.mutation.AddedRunAt(); ok {
+		_spec.AddField(jobtask.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := jtu.mutation.LastError(); ok {
+		_spec.SetField(jobtask.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := jtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobtask.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := jtu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(jobtask.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(jtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, jtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jtu.mutation.done = true
+	return n, nil
+}
+
+// JobTaskUpdateOne is the builder for updating a single JobTask entity.
+type JobTaskUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JobTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQueue sets the "queue" field.
+func (jtuo *JobTaskUpdateOne) SetQueue(s string) *JobTaskUpdateOne {
+	jtuo.mutation.SetQueue(s)
+	return jtuo
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (jtuo *JobTaskUpdateOne) SetNillableQueue(s *string) *JobTaskUpdateOne {
+	if s != nil {
+		jtuo.SetQueue(*s)
+	}
+	return jtuo
+}
+
+// SetPayload sets the "payload" field.
+func (jtuo *JobTaskUpdateOne) SetPayload(b []byte) *JobTaskUpdateOne {
+	jtuo.mutation.SetPayload(b)
+	return jtuo
+}
+
+// SetStatus sets the "status" field.
+func (jtuo *JobTaskUpdateOne) SetStatus(s string) *JobTaskUpdateOne {
+	jtuo.mutation.SetStatus(s)
+	return jtuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jtuo *JobTaskUpdateOne) SetNillableStatus(s *string) *JobTaskUpdateOne {
+	if s != nil {
+		jtuo.SetStatus(*s)
+	}
+	return jtuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (jtuo *JobTaskUpdateOne) SetAttempts(i int) *JobTaskUpdateOne {
+	jtuo.mutation.ResetAttempts()
+	jtuo.mutation.SetAttempts(i)
+	return jtuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (jtuo *JobTaskUpdateOne) SetNillableAttempts(i *int) *JobTaskUpdateOne {
+	if i != nil {
+		jtuo.SetAttempts(*i)
+	}
+	return jtuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (jtuo *JobTaskUpdateOne) AddAttempts(i int) *JobTaskUpdateOne {
+	jtuo.mutation.AddAttempts(i)
+	return jtuo
+}
+
+// SetRunAt sets the "run_at" field.
+func (jtuo *JobTaskUpdateOne) SetRunAt(i int64) *JobTaskUpdateOne {
+	jtuo.mutation.ResetRunAt()
+	jtuo.mutation.SetRunAt(i)
+	return jtuo
+}
+
+// SetNillableRunAt sets the "run_at" field if the given value is not nil.
+func (jtuo *JobTaskUpdateOne) SetNillableRunAt(i *int64) *JobTaskUpdateOne {
+	if i != nil {
+		jtuo.SetRunAt(*i)
+	}
+	return jtuo
+}
+
+// AddRunAt adds i to the "run_at" field.
+func (jtuo *JobTaskUpdateOne) AddRunAt(i int64) *JobTaskUpdateOne {
+	jtuo.mutation.AddRunAt(i)
+	return jtuo
+}
+
+// SetLastError sets the "last_error" field.
+func (jtuo *JobTaskUpdateOne) SetLastError(s string) *JobTaskUpdateOne {
+	jtuo.mutation.SetLastError(s)
+	return jtuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (jtuo *JobTaskUpdateOne) SetNillableLastError(s *string) *JobTaskUpdateOne {
+	if s != nil {
+		jtuo.SetLastError(*s)
+	}
+	return jtuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jtuo *JobTaskUpdateOne) SetUpdatedAt(i int64) *JobTaskUpdateOne {
+	jtuo.mutation.ResetUpdatedAt()
+	jtuo.mutation.SetUpdatedAt(i)
+	return jtuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (jtuo *JobTaskUpdateOne) AddUpdatedAt(i int64) *JobTaskUpdateOne {
+	jtuo.mutation.AddUpdatedAt(i)
+	return jtuo
+}
+
+// Mutation returns the JobTaskMutation object of the builder.
+func (jtuo *JobTaskUpdateOne) Mutation() *JobTaskMutation {
+	return jtuo.mutation
+}
+
+// Where appends a list predicates to the JobTaskUpdate builder.
+func (jtuo *JobTaskUpdateOne) Where(ps ...predicate.JobTask) *JobTaskUpdateOne {
+	jtuo.mutation.Where(ps...)
+	return jtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jtuo *JobTaskUpdateOne) Select(field string, fields ...string) *JobTaskUpdateOne {
+	jtuo.fields = append([]string{field}, fields...)
+	return jtuo
+}
+
+// Save executes the query and returns the updated JobTask entity.
+func (jtuo *JobTaskUpdateOne) Save(ctx context.Context) (*JobTask, error) {
+	jtuo.defaults()
+	return withHooks(ctx, jtuo.sqlSave, jtuo.mutation, jtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jtuo *JobTaskUpdateOne) SaveX(ctx context.Context) *JobTask {
+	node, err := jtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jtuo *JobTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := jtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jtuo *JobTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := jtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jtuo *JobTaskUpdateOne) defaults() {
+	if _, ok := jtuo.mutation.UpdatedAt(); !ok {
+		v := jobtask.UpdateDefaultUpdatedAt()
+		jtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (jtuo *JobTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobTaskUpdateOne {
+	jtuo.modifiers = append(jtuo.modifiers, modifiers...)
+	return jtuo
+}
+
+func (jtuo *JobTaskUpdateOne) sqlSave(ctx context.Context) (_node *JobTask, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobtask.Table, jobtask.Columns, sqlgraph.NewFieldSpec(jobtask.FieldID, field.TypeInt64))
+	id, ok := jtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "JobTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobtask.FieldID)
+		for _, f := range fields {
+			if !jobtask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != jobtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jtuo.mutation.Queue(); ok {
+		_spec.SetField(jobtask.FieldQueue, field.TypeString, value)
+	}
+	if value, ok := jtuo.mutation.Payload(); ok {
+		_spec.SetField(jobtask.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := jtuo.mutation.Status(); ok {
+		_spec.SetField(jobtask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := jtuo.mutation.Attempts(); ok {
+		_spec.SetField(jobtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jtuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(jobtask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := jtuo.mutation.RunAt(); ok {
+		_spec.SetField(jobtask.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := jtuo.mutation.AddedRunAt(); ok {
+		_spec.AddField(jobtask.FieldRunAt, field.TypeInt64, value)
+	}
+	if value, ok := jtuo.mutation.LastError(); ok {
+		_spec.SetField(jobtask.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := jtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobtask.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := jtuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(jobtask.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(jtuo.modifiers...)
+	_node = &JobTask{config: jtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jtuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboard"
+)
+
+// Leaderboard is the model entity for the Leaderboard schema.
+type Leaderboard struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Metric holds the value of the "metric" field.
+	Metric string `json:"metric,omitempty"`
+	// Policy holds the value of the "policy" field.
+	Policy string `json:"policy,omitempty"`
+	// Reset holds the value of the "reset" field.
+	Reset string `json:"reset,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Leaderboard) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leaderboard.FieldCreatedAt, leaderboard.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case leaderboard.FieldID, leaderboard.FieldName, leaderboard.FieldMetric, leaderboard.FieldPolicy, leaderboard.FieldReset, leaderboard.FieldTimezone, leaderboard.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Leaderboard fields.
+func (l *Leaderboard) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leaderboard.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				l.ID = value.String
+			}
+		case leaderboard.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				l.Name = value.String
+			}
+		case leaderboard.FieldMetric:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric", values[i])
+			} else if value.Valid {
+				l.Metric = value.String
+			}
+		case leaderboard.FieldPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy", values[i])
+			} else if value.Valid {
+				l.Policy = value.String
+			}
+		case leaderboard.FieldReset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reset", values[i])
+			} else if value.Valid {
+				l.Reset = value.String
+			}
+		case leaderboard.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				l.Timezone = value.String
+			}
+		case leaderboard.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				l.CreatedBy = value.String
+			}
+		case leaderboard.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				l.CreatedAt = value.Int64
+			}
+		case leaderboard.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				l.UpdatedAt = value.Int64
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Leaderboard.
+// This includes values selected through modifiers, order, etc.
+func (l *Leaderboard) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Leaderboard.
+// Note that you need to call Leaderboard.Unwrap() before calling this method if this Leaderboard
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Leaderboard) Update() *LeaderboardUpdateOne {
+	return NewLeaderboardClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Leaderboard entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Leaderboard) Unwrap() *Leaderboard {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: Leaderboard is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Leaderboard) String() string {
+	var builder strings.Builder
+	builder.WriteString("Leaderboard(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("name=")
+	builder.WriteString(l.Name)
+	builder.WriteString(", ")
+	builder.WriteString("metric=")
+	builder.WriteString(l.Metric)
+	builder.WriteString(", ")
+	builder.WriteString("policy=")
+	builder.WriteString(l.Policy)
+	builder.WriteString(", ")
+	builder.WriteString("reset=")
+	builder.WriteString(l.Reset)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(l.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(l.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", l.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", l.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Leaderboards is a parsable slice of Leaderboard.
+type Leaderboards []*Leaderboard
@@ -0,0 +1,583 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leaderboard
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldName, v))
+}
+
+// Metric applies equality check predicate on the "metric" field. It's identical to MetricEQ.
+func Metric(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldMetric, v))
+}
+
+// Reset applies equality check predicate on the "reset" field. It's identical to ResetEQ.
+func Reset(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldReset, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldTimezone, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContainsFold(FieldName, v))
+}
+
+// MetricEQ applies the EQ predicate on the "metric" field.
+func MetricEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldMetric, v))
+}
+
+// MetricNEQ applies the NEQ predicate on the "metric" field.
+func MetricNEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldMetric, v))
+}
+
+// MetricIn applies the In predicate on the "metric" field.
+func MetricIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldMetric, vs...))
+}
+
+// MetricNotIn applies the NotIn predicate on the "metric" field.
+func MetricNotIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldMetric, vs...))
+}
+
+// MetricGT applies the GT predicate on the "metric" field.
+func MetricGT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldMetric, v))
+}
+
+// MetricGTE applies the GTE predicate on the "metric" field.
+func MetricGTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldMetric, v))
+}
+
+// MetricLT applies the LT predicate on the "metric" field.
+func MetricLT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldMetric, v))
+}
+
+// MetricLTE applies the LTE predicate on the "metric" field.
+func MetricLTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldMetric, v))
+}
+
+// MetricContains applies the Contains predicate on the "metric" field.
+func MetricContains(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContains(FieldMetric, v))
+}
+
+// MetricHasPrefix applies the HasPrefix predicate on the "metric" field.
+func MetricHasPrefix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasPrefix(FieldMetric, v))
+}
+
+// MetricHasSuffix applies the HasSuffix predicate on the "metric" field.
+func MetricHasSuffix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasSuffix(FieldMetric, v))
+}
+
+// MetricEqualFold applies the EqualFold predicate on the "metric" field.
+func MetricEqualFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEqualFold(FieldMetric, v))
+}
+
+// MetricContainsFold applies the ContainsFold predicate on the "metric" field.
+func MetricContainsFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContainsFold(FieldMetric, v))
+}
+
+// PolicyEQ applies the EQ predicate on the "policy" field.
+func PolicyEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldPolicy, v))
+}
+
+// PolicyNEQ applies the NEQ predicate on the "policy" field.
+func PolicyNEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldPolicy, v))
+}
+
+// PolicyIn applies the In predicate on the "policy" field.
+func PolicyIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldPolicy, vs...))
+}
+
+// PolicyNotIn applies the NotIn predicate on the "policy" field.
+func PolicyNotIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldPolicy, vs...))
+}
+
+// PolicyGT applies the GT predicate on the "policy" field.
+func PolicyGT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldPolicy, v))
+}
+
+// PolicyGTE applies the GTE predicate on the "policy" field.
+func PolicyGTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldPolicy, v))
+}
+
+// PolicyLT applies the LT predicate on the "policy" field.
+func PolicyLT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldPolicy, v))
+}
+
+// PolicyLTE applies the LTE predicate on the "policy" field.
+func PolicyLTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldPolicy, v))
+}
+
+// PolicyContains applies the Contains predicate on the "policy" field.
+func PolicyContains(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContains(FieldPolicy, v))
+}
+
+// PolicyHasPrefix applies the HasPrefix predicate on the "policy" field.
+func PolicyHasPrefix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasPrefix(FieldPolicy, v))
+}
+
+// PolicyHasSuffix applies the HasSuffix predicate on the "policy" field.
+func PolicyHasSuffix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasSuffix(FieldPolicy, v))
+}
+
+// PolicyEqualFold applies the EqualFold predicate on the "policy" field.
+func PolicyEqualFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEqualFold(FieldPolicy, v))
+}
+
+// PolicyContainsFold applies the ContainsFold predicate on the "policy" field.
+func PolicyContainsFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContainsFold(FieldPolicy, v))
+}
+
+// ResetEQ applies the EQ predicate on the "reset" field.
+func ResetEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldReset, v))
+}
+
+// ResetNEQ applies the NEQ predicate on the "reset" field.
+func ResetNEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldReset, v))
+}
+
+// ResetIn applies the In predicate on the "reset" field.
+func ResetIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldReset, vs...))
+}
+
+// ResetNotIn applies the NotIn predicate on the "reset" field.
+func ResetNotIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldReset, vs...))
+}
+
+// ResetGT applies the GT predicate on the "reset" field.
+func ResetGT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldReset, v))
+}
+
+// ResetGTE applies the GTE predicate on the "reset" field.
+func ResetGTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldReset, v))
+}
+
+// ResetLT applies the LT predicate on the "reset" field.
+func ResetLT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldReset, v))
+}
+
+// ResetLTE applies the LTE predicate on the "reset" field.
+func ResetLTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldReset, v))
+}
+
+// ResetContains applies the Contains predicate on the "reset" field.
+func ResetContains(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContains(FieldReset, v))
+}
+
+// ResetHasPrefix applies the HasPrefix predicate on the "reset" field.
+func ResetHasPrefix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasPrefix(FieldReset, v))
+}
+
+// ResetHasSuffix applies the HasSuffix predicate on the "reset" field.
+func ResetHasSuffix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasSuffix(FieldReset, v))
+}
+
+// ResetEqualFold applies the EqualFold predicate on the "reset" field.
+func ResetEqualFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEqualFold(FieldReset, v))
+}
+
+// ResetContainsFold applies the ContainsFold predicate on the "reset" field.
+func ResetContainsFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContainsFold(FieldReset, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Leaderboard) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Leaderboard) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Leaderboard) predicate.Leaderboard {
+	return predicate.Leaderboard(sql.NotPredicates(p))
+}
@@ -0,0 +1,912 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboard"
+)
+
+// LeaderboardCreate is the builder for creating a Leaderboard entity.
+type LeaderboardCreate struct {
+	config
+	mutation *LeaderboardMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (lc *LeaderboardCreate) SetName(s string) *LeaderboardCreate {
+	lc.mutation.SetName(s)
+	return lc
+}
+
+// SetMetric sets the "metric" field.
+func (lc *LeaderboardCreate) SetMetric(s string) *LeaderboardCreate {
+	lc.mutation.SetMetric(s)
+	return lc
+}
+
+// SetPolicy sets the "policy" field.
+func (lc *LeaderboardCreate) SetPolicy(s string) *LeaderboardCreate {
+	lc.mutation.SetPolicy(s)
+	return lc
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (lc *LeaderboardCreate) SetNillablePolicy(s *string) *LeaderboardCreate {
+	if s != nil {
+		lc.SetPolicy(*s)
+	}
+	return lc
+}
+
+// SetReset sets the "reset" field.
+func (lc *LeaderboardCreate) SetReset(s string) *LeaderboardCreate {
+	lc.mutation.SetReset(s)
+	return lc
+}
+
+// SetTimezone sets the "timezone" field.
+func (lc *LeaderboardCreate) SetTimezone(s string) *LeaderboardCreate {
+	lc.mutation.SetTimezone(s)
+	return lc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (lc *LeaderboardCreate) SetNillableTimezone(s *string) *LeaderboardCreate {
+	if s != nil {
+		lc.SetTimezone(*s)
+	}
+	return lc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (lc *LeaderboardCreate) SetCreatedBy(s string) *LeaderboardCreate {
+	lc.mutation.SetCreatedBy(s)
+	return lc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (lc *LeaderboardCreate) SetNillableCreatedBy(s *string) *LeaderboardCreate {
+	if s != nil {
+		lc.SetCreatedBy(*s)
+	}
+	return lc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lc *LeaderboardCreate) SetCreatedAt(i int64) *LeaderboardCreate {
+	lc.mutation.SetCreatedAt(i)
+	return lc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lc *LeaderboardCreate) SetNillableCreatedAt(i *int64) *LeaderboardCreate {
+	if i != nil {
+		lc.SetCreatedAt(*i)
+	}
+	return lc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lc *LeaderboardCreate) SetUpdatedAt(i int64) *LeaderboardCreate {
+	lc.mutation.SetUpdatedAt(i)
+	return lc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lc *LeaderboardCreate) SetNillableUpdatedAt(i *int64) *LeaderboardCreate {
+	if i != nil {
+		lc.SetUpdatedAt(*i)
+	}
+	return lc
+}
+
+// SetID sets the "id" field.
+func (lc *LeaderboardCreate) SetID(s string) *LeaderboardCreate {
+	lc.mutation.SetID(s)
+	return lc
+}
+
+// Mutation returns the LeaderboardMutation object of the builder.
+func (lc *LeaderboardCreate) Mutation() *LeaderboardMutation {
+	return lc.mutation
+}
+
+// Save creates the Leaderboard in the database.
+func (lc *LeaderboardCreate) Save(ctx context.Context) (*Leaderboard, error) {
+	lc.defaults()
+	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LeaderboardCreate) SaveX(ctx context.Context) *Leaderboard {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *LeaderboardCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *LeaderboardCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lc *LeaderboardCreate) defaults() {
+	if _, ok := lc.mutation.Policy(); !ok {
+		v := leaderboard.DefaultPolicy
+		lc.mutation.SetPolicy(v)
+	}
+	if _, ok := lc.mutation.Timezone(); !ok {
+		v := leaderboard.DefaultTimezone
+		lc.mutation.SetTimezone(v)
+	}
+	if _, ok := lc.mutation.CreatedBy(); !ok {
+		v := leaderboard.DefaultCreatedBy
+		lc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := lc.mutation.CreatedAt(); !ok {
+		v := leaderboard.DefaultCreatedAt()
+		lc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lc.mutation.UpdatedAt(); !ok {
+		v := leaderboard.DefaultUpdatedAt()
+		lc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LeaderboardCreate) check() error {
+	if _, ok := lc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entc: missing required field "Leaderboard.name"`)}
+	}
+	if _, ok := lc.mutation.Metric(); !ok {
+		return &ValidationError{Name: "metric", err: errors.New(`entc: missing required field "Leaderboard.metric"`)}
+	}
+	if _, ok := lc.mutation.Policy(); !ok {
+		return &ValidationError{Name: "policy", err: errors.New(`entc: missing required field "Leaderboard.policy"`)}
+	}
+	if _, ok := lc.mutation.Reset(); !ok {
+		return &ValidationError{Name: "reset", err: errors.New(`entc: missing required field "Leaderboard.reset"`)}
+	}
+	if _, ok := lc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`entc: missing required field "Leaderboard.timezone"`)}
+	}
+	if _, ok := lc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`entc: missing required field "Leaderboard.created_by"`)}
+	}
+	if _, ok := lc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "Leaderboard.created_at"`)}
+	}
+	if _, ok := lc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "Leaderboard.updated_at"`)}
+	}
+	return nil
+}
+
+func (lc *LeaderboardCreate) sqlSave(ctx context.Context) (*Leaderboard, error) {
+	if err := lc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Leaderboard.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lc.mutation.id = &_node.ID
+	lc.mutation.done = true
+	return _node, nil
+}
+
+func (lc *LeaderboardCreate) createSpec() (*Leaderboard, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Leaderboard{config: lc.config}
+		_spec = sqlgraph.NewCreateSpec(leaderboard.Table, sqlgraph.NewFieldSpec(leaderboard.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = lc.conflict
+	if id, ok := lc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lc.mutation.Name(); ok {
+		_spec.SetField(leaderboard.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := lc.mutation.Metric(); ok {
+		_spec.SetField(leaderboard.FieldMetric, field.TypeString, value)
+		_node.Metric = value
+	}
+	if value, ok := lc.mutation.Policy(); ok {
+		_spec.SetField(leaderboard.FieldPolicy, field.TypeString, value)
+		_node.Policy = value
+	}
+	if value, ok := lc.mutation.Reset(); ok {
+		_spec.SetField(leaderboard.FieldReset, field.TypeString, value)
+		_node.Reset = value
+	}
+	if value, ok := lc.mutation.Timezone(); ok {
+		_spec.SetField(leaderboard.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := lc.mutation.CreatedBy(); ok {
+		_spec.SetField(leaderboard.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := lc.mutation.CreatedAt(); ok {
+		_spec.SetField(leaderboard.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lc.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaderboard.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Leaderboard.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaderboardUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (lc *LeaderboardCreate) OnConflict(opts ...sql.ConflictOption) *LeaderboardUpsertOne {
+	lc.conflict = opts
+	return &LeaderboardUpsertOne{
+		create: lc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Leaderboard.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lc *LeaderboardCreate) OnConflictColumns(columns ...string) *LeaderboardUpsertOne {
+	lc.conflict = append(lc.conflict, sql.ConflictColumns(columns...))
+	return &LeaderboardUpsertOne{
+		create: lc,
+	}
+}
+
+type (
+	// LeaderboardUpsertOne is the builder for "upsert"-ing
+	//  one Leaderboard node.
+	LeaderboardUpsertOne struct {
+		create *LeaderboardCreate
+	}
+
+	// LeaderboardUpsert is the "OnConflict" setter.
+	LeaderboardUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *LeaderboardUpsert) SetName(v string) *LeaderboardUpsert {
+	u.Set(leaderboard.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LeaderboardUpsert) UpdateName() *LeaderboardUpsert {
+	u.SetExcluded(leaderboard.FieldName)
+	return u
+}
+
+// SetMetric sets the "metric" field.
+func (u *LeaderboardUpsert) SetMetric(v string) *LeaderboardUpsert {
+	u.Set(leaderboard.FieldMetric, v)
+	return u
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *LeaderboardUpsert) UpdateMetric() *LeaderboardUpsert {
+	u.SetExcluded(leaderboard.FieldMetric)
+	return u
+}
+
+// SetPolicy sets the "policy" field.
+func (u *LeaderboardUpsert) SetPolicy(v string) *LeaderboardUpsert {
+	u.Set(leaderboard.FieldPolicy, v)
+	return u
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *LeaderboardUpsert) UpdatePolicy() *LeaderboardUpsert {
+	u.SetExcluded(leaderboard.FieldPolicy)
+	return u
+}
+
+// SetReset sets the "reset" field.
+func (u *LeaderboardUpsert) SetReset(v string) *LeaderboardUpsert {
+	u.Set(leaderboard.FieldReset, v)
+	return u
+}
+
+// UpdateReset sets the "reset" field to the value that was provided on create.
+func (u *LeaderboardUpsert) UpdateReset() *LeaderboardUpsert {
+	u.SetExcluded(leaderboard.FieldReset)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LeaderboardUpsert) SetTimezone(v string) *LeaderboardUpsert {
+	u.Set(leaderboard.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LeaderboardUpsert) UpdateTimezone() *LeaderboardUpsert {
+	u.SetExcluded(leaderboard.FieldTimezone)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LeaderboardUpsert) SetCreatedBy(v string) *LeaderboardUpsert {
+	u.Set(leaderboard.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LeaderboardUpsert) UpdateCreatedBy() *LeaderboardUpsert {
+	u.SetExcluded(leaderboard.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaderboardUpsert) SetUpdatedAt(v int64) *LeaderboardUpsert {
+	u.Set(leaderboard.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaderboardUpsert) UpdateUpdatedAt() *LeaderboardUpsert {
+	u.SetExcluded(leaderboard.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *LeaderboardUpsert) AddUpdatedAt(v int64) *LeaderboardUpsert {
+	u.Add(leaderboard.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Leaderboard.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaderboard.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaderboardUpsertOne) UpdateNewValues() *LeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leaderboard.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(leaderboard.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Leaderboard.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeaderboardUpsertOne) Ignore() *LeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaderboardUpsertOne) DoNothing() *LeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaderboardCreate.OnConflict
+// documentation for more info.
+func (u *LeaderboardUpsertOne) Update(set func(*LeaderboardUpsert)) *LeaderboardUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaderboardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LeaderboardUpsertOne) SetName(v string) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LeaderboardUpsertOne) UpdateName() *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMetric sets the "metric" field.
+func (u *LeaderboardUpsertOne) SetMetric(v string) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetMetric(v)
+	})
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *LeaderboardUpsertOne) UpdateMetric() *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateMetric()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *LeaderboardUpsertOne) SetPolicy(v string) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *LeaderboardUpsertOne) UpdatePolicy() *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// SetReset sets the "reset" field.
+func (u *LeaderboardUpsertOne) SetReset(v string) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetReset(v)
+	})
+}
+
+// UpdateReset sets the "reset" field to the value that was provided on create.
+func (u *LeaderboardUpsertOne) UpdateReset() *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateReset()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LeaderboardUpsertOne) SetTimezone(v string) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LeaderboardUpsertOne) UpdateTimezone() *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LeaderboardUpsertOne) SetCreatedBy(v string) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LeaderboardUpsertOne) UpdateCreatedBy() *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaderboardUpsertOne) SetUpdatedAt(v int64) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *LeaderboardUpsertOne) AddUpdatedAt(v int64) *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaderboardUpsertOne) UpdateUpdatedAt() *LeaderboardUpsertOne {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaderboardUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LeaderboardCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaderboardUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeaderboardUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entc: LeaderboardUpsertOne.ID is not supported by MySQL driver. Use LeaderboardUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeaderboardUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeaderboardCreateBulk is the builder for creating many Leaderboard entities in bulk.
+type LeaderboardCreateBulk struct {
+	config
+	err      error
+	builders []*LeaderboardCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Leaderboard entities in the database.
+func (lcb *LeaderboardCreateBulk) Save(ctx context.Context) ([]*Leaderboard, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Leaderboard, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaderboardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LeaderboardCreateBulk) SaveX(ctx context.Context) []*Leaderboard {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *LeaderboardCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *LeaderboardCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Leaderboard.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaderboardUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (lcb *LeaderboardCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeaderboardUpsertBulk {
+	lcb.conflict = opts
+	return &LeaderboardUpsertBulk{
+		create: lcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Leaderboard.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lcb *LeaderboardCreateBulk) OnConflictColumns(columns ...string) *LeaderboardUpsertBulk {
+	lcb.conflict = append(lcb.conflict, sql.ConflictColumns(columns...))
+	return &LeaderboardUpsertBulk{
+		create: lcb,
+	}
+}
+
+// LeaderboardUpsertBulk is the builder for "upsert"-ing
+// a bulk of Leaderboard nodes.
+type LeaderboardUpsertBulk struct {
+	create *LeaderboardCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Leaderboard.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaderboard.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaderboardUpsertBulk) UpdateNewValues() *LeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(leaderboard.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(leaderboard.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Leaderboard.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LeaderboardUpsertBulk) Ignore() *LeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaderboardUpsertBulk) DoNothing() *LeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaderboardCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeaderboardUpsertBulk) Update(set func(*LeaderboardUpsert)) *LeaderboardUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaderboardUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LeaderboardUpsertBulk) SetName(v string) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LeaderboardUpsertBulk) UpdateName() *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetMetric sets the "metric" field.
+func (u *LeaderboardUpsertBulk) SetMetric(v string) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetMetric(v)
+	})
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *LeaderboardUpsertBulk) UpdateMetric() *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateMetric()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *LeaderboardUpsertBulk) SetPolicy(v string) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *LeaderboardUpsertBulk) UpdatePolicy() *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// SetReset sets the "reset" field.
+func (u *LeaderboardUpsertBulk) SetReset(v string) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetReset(v)
+	})
+}
+
+// UpdateReset sets the "reset" field to the value that was provided on create.
+func (u *LeaderboardUpsertBulk) UpdateReset() *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateReset()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LeaderboardUpsertBulk) SetTimezone(v string) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LeaderboardUpsertBulk) UpdateTimezone() *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LeaderboardUpsertBulk) SetCreatedBy(v string) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LeaderboardUpsertBulk) UpdateCreatedBy() *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LeaderboardUpsertBulk) SetUpdatedAt(v int64) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *LeaderboardUpsertBulk) AddUpdatedAt(v int64) *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LeaderboardUpsertBulk) UpdateUpdatedAt() *LeaderboardUpsertBulk {
+	return u.Update(func(s *LeaderboardUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaderboardUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the LeaderboardCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LeaderboardCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaderboardUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,451 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboard"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// LeaderboardUpdate is the builder for updating Leaderboard entities.
+type LeaderboardUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaderboardMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaderboardUpdate builder.
+func (lu *LeaderboardUpdate) Where(ps ...predicate.Leaderboard) *LeaderboardUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetName sets the "name" field.
+func (lu *LeaderboardUpdate) SetName(s string) *LeaderboardUpdate {
+	lu.mutation.SetName(s)
+	return lu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (lu *LeaderboardUpdate) SetNillableName(s *string) *LeaderboardUpdate {
+	if s != nil {
+		lu.SetName(*s)
+	}
+	return lu
+}
+
+// SetMetric sets the "metric" field.
+func (lu *LeaderboardUpdate) SetMetric(s string) *LeaderboardUpdate {
+	lu.mutation.SetMetric(s)
+	return lu
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (lu *LeaderboardUpdate) SetNillableMetric(s *string) *LeaderboardUpdate {
+	if s != nil {
+		lu.SetMetric(*s)
+	}
+	return lu
+}
+
+// SetPolicy sets the "policy" field.
+func (lu *LeaderboardUpdate) SetPolicy(s string) *LeaderboardUpdate {
+	lu.mutation.SetPolicy(s)
+	return lu
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (lu *LeaderboardUpdate) SetNillablePolicy(s *string) *LeaderboardUpdate {
+	if s != nil {
+		lu.SetPolicy(*s)
+	}
+	return lu
+}
+
+// SetReset sets the "reset" field.
+func (lu *LeaderboardUpdate) SetReset(s string) *LeaderboardUpdate {
+	lu.mutation.SetReset(s)
+	return lu
+}
+
+// SetNillableReset sets the "reset" field if the given value is not nil.
+func (lu *LeaderboardUpdate) SetNillableReset(s *string) *LeaderboardUpdate {
+	if s != nil {
+		lu.SetReset(*s)
+	}
+	return lu
+}
+
+// SetTimezone sets the "timezone" field.
+func (lu *LeaderboardUpdate) SetTimezone(s string) *LeaderboardUpdate {
+	lu.mutation.SetTimezone(s)
+	return lu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (lu *LeaderboardUpdate) SetNillableTimezone(s *string) *LeaderboardUpdate {
+	if s != nil {
+		lu.SetTimezone(*s)
+	}
+	return lu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (lu *LeaderboardUpdate) SetCreatedBy(s string) *LeaderboardUpdate {
+	lu.mutation.SetCreatedBy(s)
+	return lu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (lu *LeaderboardUpdate) SetNillableCreatedBy(s *string) *LeaderboardUpdate {
+	if s != nil {
+		lu.SetCreatedBy(*s)
+	}
+	return lu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lu *LeaderboardUpdate) SetUpdatedAt(i int64) *LeaderboardUpdate {
+	lu.mutation.ResetUpdatedAt()
+	lu.mutation.SetUpdatedAt(i)
+	return lu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (lu *LeaderboardUpdate) AddUpdatedAt(i int64) *LeaderboardUpdate {
+	lu.mutation.AddUpdatedAt(i)
+	return lu
+}
+
+// Mutation returns the LeaderboardMutation object of the builder.
+func (lu *LeaderboardUpdate) Mutation() *LeaderboardMutation {
+	return lu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LeaderboardUpdate) Save(ctx context.Context) (int, error) {
+	lu.defaults()
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LeaderboardUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LeaderboardUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LeaderboardUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lu *LeaderboardUpdate) defaults() {
+	if _, ok := lu.mutation.UpdatedAt(); !ok {
+		v := leaderboard.UpdateDefaultUpdatedAt()
+		lu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lu *LeaderboardUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaderboardUpdate {
+	lu.modifiers = append(lu.modifiers, modifiers...)
+	return lu
+}
+
+func (lu *LeaderboardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(leaderboard.Table, leaderboard.Columns, sqlgraph.NewFieldSpec(leaderboard.FieldID, field.TypeString))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Name(); ok {
+		_spec.SetField(leaderboard.FieldName, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Metric(); ok {
+		_spec.SetField(leaderboard.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Policy(); ok {
+		_spec.SetField(leaderboard.FieldPolicy, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Reset(); ok {
+		_spec.SetField(leaderboard.FieldReset, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Timezone(); ok {
+		_spec.SetField(leaderboard.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.CreatedBy(); ok {
+		_spec.SetField(leaderboard.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaderboard.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := lu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(leaderboard.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(lu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaderboard.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LeaderboardUpdateOne is the builder for updating a single Leaderboard entity.
+type LeaderboardUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaderboardMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (luo *LeaderboardUpdateOne) SetName(s string) *LeaderboardUpdateOne {
+	luo.mutation.SetName(s)
+	return luo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (luo *LeaderboardUpdateOne) SetNillableName(s *string) *LeaderboardUpdateOne {
+	if s != nil {
+		luo.SetName(*s)
+	}
+	return luo
+}
+
+// SetMetric sets the "metric" field.
+func (luo *LeaderboardUpdateOne) SetMetric(s string) *LeaderboardUpdateOne {
+	luo.mutation.SetMetric(s)
+	return luo
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (luo *LeaderboardUpdateOne) SetNillableMetric(s *string) *LeaderboardUpdateOne {
+	if s != nil {
+		luo.SetMetric(*s)
+	}
+	return luo
+}
+
+// SetPolicy sets the "policy" field.
+func (luo *LeaderboardUpdateOne) SetPolicy(s string) *LeaderboardUpdateOne {
+	luo.mutation.SetPolicy(s)
+	return luo
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (luo *LeaderboardUpdateOne) SetNillablePolicy(s *string) *LeaderboardUpdateOne {
+	if s != nil {
+		luo.SetPolicy(*s)
+	}
+	return luo
+}
+
+// SetReset sets the "reset" field.
+func (luo *LeaderboardUpdateOne) SetReset(s string) *LeaderboardUpdateOne {
+	luo.mutation.SetReset(s)
+	return luo
+}
+
+// SetNillableReset sets the "reset" field if the given value is not nil.
+func (luo *LeaderboardUpdateOne) SetNillableReset(s *string) *LeaderboardUpdateOne {
+	if s != nil {
+		luo.SetReset(*s)
+	}
+	return luo
+}
+
+// SetTimezone sets the "timezone" field.
+func (luo *LeaderboardUpdateOne) SetTimezone(s string) *LeaderboardUpdateOne {
+	luo.mutation.SetTimezone(s)
+	return luo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (luo *LeaderboardUpdateOne) SetNillableTimezone(s *string) *LeaderboardUpdateOne {
+	if s != nil {
+		luo.SetTimezone(*s)
+	}
+	return luo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (luo *LeaderboardUpdateOne) SetCreatedBy(s string) *LeaderboardUpdateOne {
+	luo.mutation.SetCreatedBy(s)
+	return luo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (luo *LeaderboardUpdateOne) SetNillableCreatedBy(s *string) *LeaderboardUpdateOne {
+	if s != nil {
+		luo.SetCreatedBy(*s)
+	}
+	return luo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (luo *LeaderboardUpdateOne) SetUpdatedAt(i int64) *LeaderboardUpdateOne {
+	luo.mutation.ResetUpdatedAt()
+	luo.mutation.SetUpdatedAt(i)
+	return luo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (luo *LeaderboardUpdateOne) AddUpdatedAt(i int64) *LeaderboardUpdateOne {
+	luo.mutation.AddUpdatedAt(i)
+	return luo
+}
+
+// Mutation returns the LeaderboardMutation object of the builder.
+func (luo *LeaderboardUpdateOne) Mutation() *LeaderboardMutation {
+	return luo.mutation
+}
+
+// Where appends a list predicates to the LeaderboardUpdate builder.
+func (luo *LeaderboardUpdateOne) Where(ps ...predicate.Leaderboard) *LeaderboardUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LeaderboardUpdateOne) Select(field string, fields ...string) *LeaderboardUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Leaderboard entity.
+func (luo *LeaderboardUpdateOne) Save(ctx context.Context) (*Leaderboard, error) {
+	luo.defaults()
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LeaderboardUpdateOne) SaveX(ctx context.Context) *Leaderboard {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LeaderboardUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LeaderboardUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (luo *LeaderboardUpdateOne) defaults() {
+	if _, ok := luo.mutation.UpdatedAt(); !ok {
+		v := leaderboard.UpdateDefaultUpdatedAt()
+		luo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (luo *LeaderboardUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaderboardUpdateOne {
+	luo.modifiers = append(luo.modifiers, modifiers...)
+	return luo
+}
+
+func (luo *LeaderboardUpdateOne) sqlSave(ctx context.Context) (_node *Leaderboard, err error) {
+	_spec := sqlgraph.NewUpdateSpec(leaderboard.Table, leaderboard.Columns, sqlgraph.NewFieldSpec(leaderboard.FieldID, field.TypeString))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "Leaderboard.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaderboard.FieldID)
+		for _, f := range fields {
+			if !leaderboard.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != leaderboard.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Name(); ok {
+		_spec.SetField(leaderboard.FieldName, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Metric(); ok {
+		_spec.SetField(leaderboard.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Policy(); ok {
+		_spec.SetField(leaderboard.FieldPolicy, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Reset(); ok {
+		_spec.SetField(leaderboard.FieldReset, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Timezone(); ok {
+		_spec.SetField(leaderboard.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.CreatedBy(); ok {
+		_spec.SetField(leaderboard.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.UpdatedAt(); ok {
+		_spec.SetField(leaderboard.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := luo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(leaderboard.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(luo.modifiers...)
+	_node = &Leaderboard{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaderboard.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
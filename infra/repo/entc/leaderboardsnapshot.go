@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboardsnapshot"
+)
+
+// LeaderboardSnapshot is the model entity for the LeaderboardSnapshot schema.
+type LeaderboardSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// LeaderboardID holds the value of the "leaderboard_id" field.
+	LeaderboardID string `json:"leaderboard_id,omitempty"`
+	// Period holds the value of the "period" field.
+	Period string `json:"period,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart int64 `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd int64 `json:"period_end,omitempty"`
+	// Entries holds the value of the "entries" field.
+	Entries []*entity.LeaderboardEntry `json:"entries,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaderboardSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leaderboardsnapshot.FieldEntries:
+			values[i] = new([]byte)
+		case leaderboardsnapshot.FieldID, leaderboardsnapshot.FieldPeriodStart, leaderboardsnapshot.FieldPeriodEnd, leaderboardsnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case leaderboardsnapshot.FieldLeaderboardID, leaderboardsnapshot.FieldPeriod:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaderboardSnapshot fields.
+func (ls *LeaderboardSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leaderboardsnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ls.ID = int64(value.Int64)
+		case leaderboardsnapshot.FieldLeaderboardID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field leaderboard_id", values[i])
+			} else if value.Valid {
+				ls.LeaderboardID = value.String
+			}
+		case leaderboardsnapshot.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				ls.Period = value.String
+			}
+		case leaderboardsnapshot.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				ls.PeriodStart = value.Int64
+			}
+		case leaderboardsnapshot.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				ls.PeriodEnd = value.Int64
+			}
+		case leaderboardsnapshot.FieldEntries:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field entries", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ls.Entries); err != nil {
+					return fmt.Errorf("unmarshal field entries: %w", err)
+				}
+			}
+		case leaderboardsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ls.CreatedAt = value.Int64
+			}
+		default:
+			ls.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeaderboardSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (ls *LeaderboardSnapshot) Value(name string) (ent.Value, error) {
+	return ls.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LeaderboardSnapshot.
+// Note that you need to call LeaderboardSnapshot.Unwrap() before calling this method if this LeaderboardSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ls *LeaderboardSnapshot) Update() *LeaderboardSnapshotUpdateOne {
+	return NewLeaderboardSnapshotClient(ls.config).UpdateOne(ls)
+}
+
+// Unwrap unwraps the LeaderboardSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ls *LeaderboardSnapshot) Unwrap() *LeaderboardSnapshot {
+	_tx, ok := ls.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: LeaderboardSnapshot is not a transactional entity")
+	}
+	ls.config.driver = _tx.drv
+	return ls
+}
+
+// String implements the fmt.Stringer.
+func (ls *LeaderboardSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaderboardSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ls.ID))
+	builder.WriteString("leaderboard_id=")
+	builder.WriteString(ls.LeaderboardID)
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(ls.Period)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(fmt.Sprintf("%v", ls.PeriodStart))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(fmt.Sprintf("%v", ls.PeriodEnd))
+	builder.WriteString(", ")
+	builder.WriteString("entries=")
+	builder.WriteString(fmt.Sprintf("%v", ls.Entries))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", ls.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaderboardSnapshots is a parsable slice of LeaderboardSnapshot.
+type LeaderboardSnapshots []*LeaderboardSnapshot
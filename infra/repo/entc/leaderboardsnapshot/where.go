@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leaderboardsnapshot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// LeaderboardID applies equality check predicate on the "leaderboard_id" field. It's identical to LeaderboardIDEQ.
+func LeaderboardID(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldLeaderboardID, v))
+}
+
+// Period applies equality check predicate on the "period" field. It's identical to PeriodEQ.
+func Period(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// LeaderboardIDEQ applies the EQ predicate on the "leaderboard_id" field.
+func LeaderboardIDEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDNEQ applies the NEQ predicate on the "leaderboard_id" field.
+func LeaderboardIDNEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDIn applies the In predicate on the "leaderboard_id" field.
+func LeaderboardIDIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldLeaderboardID, vs...))
+}
+
+// LeaderboardIDNotIn applies the NotIn predicate on the "leaderboard_id" field.
+func LeaderboardIDNotIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldLeaderboardID, vs...))
+}
+
+// LeaderboardIDGT applies the GT predicate on the "leaderboard_id" field.
+func LeaderboardIDGT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDGTE applies the GTE predicate on the "leaderboard_id" field.
+func LeaderboardIDGTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDLT applies the LT predicate on the "leaderboard_id" field.
+func LeaderboardIDLT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDLTE applies the LTE predicate on the "leaderboard_id" field.
+func LeaderboardIDLTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDContains applies the Contains predicate on the "leaderboard_id" field.
+func LeaderboardIDContains(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContains(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDHasPrefix applies the HasPrefix predicate on the "leaderboard_id" field.
+func LeaderboardIDHasPrefix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasPrefix(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDHasSuffix applies the HasSuffix predicate on the "leaderboard_id" field.
+func LeaderboardIDHasSuffix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasSuffix(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDEqualFold applies the EqualFold predicate on the "leaderboard_id" field.
+func LeaderboardIDEqualFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEqualFold(FieldLeaderboardID, v))
+}
+
+// LeaderboardIDContainsFold applies the ContainsFold predicate on the "leaderboard_id" field.
+func LeaderboardIDContainsFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContainsFold(FieldLeaderboardID, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// PeriodGT applies the GT predicate on the "period" field.
+func PeriodGT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldPeriod, v))
+}
+
+// PeriodGTE applies the GTE predicate on the "period" field.
+func PeriodGTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldPeriod, v))
+}
+
+// PeriodLT applies the LT predicate on the "period" field.
+func PeriodLT(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldPeriod, v))
+}
+
+// PeriodLTE applies the LTE predicate on the "period" field.
+func PeriodLTE(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldPeriod, v))
+}
+
+// PeriodContains applies the Contains predicate on the "period" field.
+func PeriodContains(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContains(FieldPeriod, v))
+}
+
+// PeriodHasPrefix applies the HasPrefix predicate on the "period" field.
+func PeriodHasPrefix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasPrefix(FieldPeriod, v))
+}
+
+// PeriodHasSuffix applies the HasSuffix predicate on the "period" field.
+func PeriodHasSuffix(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldHasSuffix(FieldPeriod, v))
+}
+
+// PeriodEqualFold applies the EqualFold predicate on the "period" field.
+func PeriodEqualFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEqualFold(FieldPeriod, v))
+}
+
+// PeriodContainsFold applies the ContainsFold predicate on the "period" field.
+func PeriodContainsFold(v string) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldContainsFold(FieldPeriod, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaderboardSnapshot) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaderboardSnapshot) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaderboardSnapshot) predicate.LeaderboardSnapshot {
+	return predicate.LeaderboardSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,774 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboardsnapshot"
+)
+
+// LeaderboardSnapshotCreate is the builder for creating a LeaderboardSnapshot entity.
+type LeaderboardSnapshotCreate struct {
+	config
+	mutation *LeaderboardSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetLeaderboardID sets the "leaderboard_id" field.
+func (lsc *LeaderboardSnapshotCreate) SetLeaderboardID(s string) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetLeaderboardID(s)
+	return lsc
+}
+
+// SetPeriod sets the "period" field.
+func (lsc *LeaderboardSnapshotCreate) SetPeriod(s string) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetPeriod(s)
+	return lsc
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (lsc *LeaderboardSnapshotCreate) SetPeriodStart(i int64) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetPeriodStart(i)
+	return lsc
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (lsc *LeaderboardSnapshotCreate) SetPeriodEnd(i int64) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetPeriodEnd(i)
+	return lsc
+}
+
+// SetEntries sets the "entries" field.
+func (lsc *LeaderboardSnapshotCreate) SetEntries(ee []*entity.LeaderboardEntry) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetEntries(ee)
+	return lsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lsc *LeaderboardSnapshotCreate) SetCreatedAt(i int64) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetCreatedAt(i)
+	return lsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lsc *LeaderboardSnapshotCreate) SetNillableCreatedAt(i *int64) *LeaderboardSnapshotCreate {
+	if i != nil {
+		lsc.SetCreatedAt(*i)
+	}
+	return lsc
+}
+
+// SetID sets the "id" field.
+func (lsc *LeaderboardSnapshotCreate) SetID(i int64) *LeaderboardSnapshotCreate {
+	lsc.mutation.SetID(i)
+	return lsc
+}
+
+// Mutation returns the LeaderboardSnapshotMutation object of the builder.
+func (lsc *LeaderboardSnapshotCreate) Mutation() *LeaderboardSnapshotMutation {
+	return lsc.mutation
+}
+
+// Save creates the LeaderboardSnapshot in the database.
+func (lsc *LeaderboardSnapshotCreate) Save(ctx context.Context) (*LeaderboardSnapshot, error) {
+	lsc.defaults()
+	return withHooks(ctx, lsc.sqlSave, lsc.mutation, lsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lsc *LeaderboardSnapshotCreate) SaveX(ctx context.Context) *LeaderboardSnapshot {
+	v, err := lsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lsc *LeaderboardSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := lsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsc *LeaderboardSnapshotCreate) ExecX(ctx context.Context) {
+	if err := lsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsc *LeaderboardSnapshotCreate) defaults() {
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		v := leaderboardsnapshot.DefaultCreatedAt()
+		lsc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lsc *LeaderboardSnapshotCreate) check() error {
+	if _, ok := lsc.mutation.LeaderboardID(); !ok {
+		return &ValidationError{Name: "leaderboard_id", err: errors.New(`entc: missing required field "LeaderboardSnapshot.leaderboard_id"`)}
+	}
+	if _, ok := lsc.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`entc: missing required field "LeaderboardSnapshot.period"`)}
+	}
+	if _, ok := lsc.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`entc: missing required field "LeaderboardSnapshot.period_start"`)}
+	}
+	if _, ok := lsc.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`entc: missing required field "LeaderboardSnapshot.period_end"`)}
+	}
+	if _, ok := lsc.mutation.Entries(); !ok {
+		return &ValidationError{Name: "entries", err: errors.New(`entc: missing required field "LeaderboardSnapshot.entries"`)}
+	}
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "LeaderboardSnapshot.created_at"`)}
+	}
+	return nil
+}
+
+func (lsc *LeaderboardSnapshotCreate) sqlSave(ctx context.Context) (*LeaderboardSnapshot, error) {
+	if err := lsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	lsc.mutation.id = &_node.ID
+	lsc.mutation.done = true
+	return _node, nil
+}
+
+func (lsc *LeaderboardSnapshotCreate) createSpec() (*LeaderboardSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaderboardSnapshot{config: lsc.config}
+		_spec = sqlgraph.NewCreateSpec(leaderboardsnapshot.Table, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = lsc.conflict
+	if id, ok := lsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lsc.mutation.LeaderboardID(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldLeaderboardID, field.TypeString, value)
+		_node.LeaderboardID = value
+	}
+	if value, ok := lsc.mutation.Period(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	if value, ok := lsc.mutation.PeriodStart(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriodStart, field.TypeInt64, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := lsc.mutation.PeriodEnd(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriodEnd, field.TypeInt64, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := lsc.mutation.Entries(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldEntries, field.TypeJSON, value)
+		_node.Entries = value
+	}
+	if value, ok := lsc.mutation.CreatedAt(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaderboardSnapshot.Create().
+//		SetLeaderboardID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaderboardSnapshotUpsert) {
+//			SetLeaderboardID(v+v).
+//		}).
+//		Exec(ctx)
+func (lsc *LeaderboardSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *LeaderboardSnapshotUpsertOne {
+	lsc.conflict = opts
+	return &LeaderboardSnapshotUpsertOne{
+		create: lsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lsc *LeaderboardSnapshotCreate) OnConflictColumns(columns ...string) *LeaderboardSnapshotUpsertOne {
+	lsc.conflict = append(lsc.conflict, sql.ConflictColumns(columns...))
+	return &LeaderboardSnapshotUpsertOne{
+		create: lsc,
+	}
+}
+
+type (
+	// LeaderboardSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one LeaderboardSnapshot node.
+	LeaderboardSnapshotUpsertOne struct {
+		create *LeaderboardSnapshotCreate
+	}
+
+	// LeaderboardSnapshotUpsert is the "OnConflict" setter.
+	LeaderboardSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLeaderboardID sets the "leaderboard_id" field.
+func (u *LeaderboardSnapshotUpsert) SetLeaderboardID(v string) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldLeaderboardID, v)
+	return u
+}
+
+// UpdateLeaderboardID sets the "leaderboard_id" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdateLeaderboardID() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldLeaderboardID)
+	return u
+}
+
+// SetPeriod sets the "period" field.
+func (u *LeaderboardSnapshotUpsert) SetPeriod(v string) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldPeriod, v)
+	return u
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdatePeriod() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldPeriod)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *LeaderboardSnapshotUpsert) SetPeriodStart(v int64) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdatePeriodStart() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldPeriodStart)
+	return u
+}
+
+// AddPeriodStart adds v to the "period_start" field.
+func (u *LeaderboardSnapshotUpsert) AddPeriodStart(v int64) *LeaderboardSnapshotUpsert {
+	u.Add(leaderboardsnapshot.FieldPeriodStart, v)
+	return u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *LeaderboardSnapshotUpsert) SetPeriodEnd(v int64) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldPeriodEnd, v)
+	return u
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdatePeriodEnd() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldPeriodEnd)
+	return u
+}
+
+// AddPeriodEnd adds v to the "period_end" field.
+func (u *LeaderboardSnapshotUpsert) AddPeriodEnd(v int64) *LeaderboardSnapshotUpsert {
+	u.Add(leaderboardsnapshot.FieldPeriodEnd, v)
+	return u
+}
+
+// SetEntries sets the "entries" field.
+func (u *LeaderboardSnapshotUpsert) SetEntries(v []*entity.LeaderboardEntry) *LeaderboardSnapshotUpsert {
+	u.Set(leaderboardsnapshot.FieldEntries, v)
+	return u
+}
+
+// UpdateEntries sets the "entries" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsert) UpdateEntries() *LeaderboardSnapshotUpsert {
+	u.SetExcluded(leaderboardsnapshot.FieldEntries)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaderboardsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaderboardSnapshotUpsertOne) UpdateNewValues() *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leaderboardsnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(leaderboardsnapshot.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeaderboardSnapshotUpsertOne) Ignore() *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaderboardSnapshotUpsertOne) DoNothing() *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaderboardSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *LeaderboardSnapshotUpsertOne) Update(set func(*LeaderboardSnapshotUpsert)) *LeaderboardSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaderboardSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLeaderboardID sets the "leaderboard_id" field.
+func (u *LeaderboardSnapshotUpsertOne) SetLeaderboardID(v string) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetLeaderboardID(v)
+	})
+}
+
+// UpdateLeaderboardID sets the "leaderboard_id" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdateLeaderboardID() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateLeaderboardID()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *LeaderboardSnapshotUpsertOne) SetPeriod(v string) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdatePeriod() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *LeaderboardSnapshotUpsertOne) SetPeriodStart(v int64) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// AddPeriodStart adds v to the "period_start" field.
+func (u *LeaderboardSnapshotUpsertOne) AddPeriodStart(v int64) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.AddPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdatePeriodStart() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *LeaderboardSnapshotUpsertOne) SetPeriodEnd(v int64) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// AddPeriodEnd adds v to the "period_end" field.
+func (u *LeaderboardSnapshotUpsertOne) AddPeriodEnd(v int64) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.AddPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdatePeriodEnd() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetEntries sets the "entries" field.
+func (u *LeaderboardSnapshotUpsertOne) SetEntries(v []*entity.LeaderboardEntry) *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetEntries(v)
+	})
+}
+
+// UpdateEntries sets the "entries" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertOne) UpdateEntries() *LeaderboardSnapshotUpsertOne {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateEntries()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaderboardSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LeaderboardSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaderboardSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeaderboardSnapshotUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeaderboardSnapshotUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeaderboardSnapshotCreateBulk is the builder for creating many LeaderboardSnapshot entities in bulk.
+type LeaderboardSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*LeaderboardSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LeaderboardSnapshot entities in the database.
+func (lscb *LeaderboardSnapshotCreateBulk) Save(ctx context.Context) ([]*LeaderboardSnapshot, error) {
+	if lscb.err != nil {
+		return nil, lscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lscb.builders))
+	nodes := make([]*LeaderboardSnapshot, len(lscb.builders))
+	mutators := make([]Mutator, len(lscb.builders))
+	for i := range lscb.builders {
+		func(i int, root context.Context) {
+			builder := lscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaderboardSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lscb *LeaderboardSnapshotCreateBulk) SaveX(ctx context.Context) []*LeaderboardSnapshot {
+	v, err := lscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lscb *LeaderboardSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := lscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lscb *LeaderboardSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := lscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaderboardSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaderboardSnapshotUpsert) {
+//			SetLeaderboardID(v+v).
+//		}).
+//		Exec(ctx)
+func (lscb *LeaderboardSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeaderboardSnapshotUpsertBulk {
+	lscb.conflict = opts
+	return &LeaderboardSnapshotUpsertBulk{
+		create: lscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lscb *LeaderboardSnapshotCreateBulk) OnConflictColumns(columns ...string) *LeaderboardSnapshotUpsertBulk {
+	lscb.conflict = append(lscb.conflict, sql.ConflictColumns(columns...))
+	return &LeaderboardSnapshotUpsertBulk{
+		create: lscb,
+	}
+}
+
+// LeaderboardSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of LeaderboardSnapshot nodes.
+type LeaderboardSnapshotUpsertBulk struct {
+	create *LeaderboardSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaderboardsnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaderboardSnapshotUpsertBulk) UpdateNewValues() *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(leaderboardsnapshot.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(leaderboardsnapshot.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaderboardSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LeaderboardSnapshotUpsertBulk) Ignore() *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaderboardSnapshotUpsertBulk) DoNothing() *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaderboardSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeaderboardSnapshotUpsertBulk) Update(set func(*LeaderboardSnapshotUpsert)) *LeaderboardSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaderboardSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLeaderboardID sets the "leaderboard_id" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetLeaderboardID(v string) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetLeaderboardID(v)
+	})
+}
+
+// UpdateLeaderboardID sets the "leaderboard_id" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdateLeaderboardID() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateLeaderboardID()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetPeriod(v string) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdatePeriod() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetPeriodStart(v int64) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// AddPeriodStart adds v to the "period_start" field.
+func (u *LeaderboardSnapshotUpsertBulk) AddPeriodStart(v int64) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.AddPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdatePeriodStart() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetPeriodEnd(v int64) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// AddPeriodEnd adds v to the "period_end" field.
+func (u *LeaderboardSnapshotUpsertBulk) AddPeriodEnd(v int64) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.AddPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdatePeriodEnd() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetEntries sets the "entries" field.
+func (u *LeaderboardSnapshotUpsertBulk) SetEntries(v []*entity.LeaderboardEntry) *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.SetEntries(v)
+	})
+}
+
+// UpdateEntries sets the "entries" field to the value that was provided on create.
+func (u *LeaderboardSnapshotUpsertBulk) UpdateEntries() *LeaderboardSnapshotUpsertBulk {
+	return u.Update(func(s *LeaderboardSnapshotUpsert) {
+		s.UpdateEntries()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaderboardSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the LeaderboardSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LeaderboardSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaderboardSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
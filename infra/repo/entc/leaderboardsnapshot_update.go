@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboardsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// LeaderboardSnapshotUpdate is the builder for updating LeaderboardSnapshot entities.
+type LeaderboardSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaderboardSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaderboardSnapshotUpdate builder.
+func (lsu *LeaderboardSnapshotUpdate) Where(ps ...predicate.LeaderboardSnapshot) *LeaderboardSnapshotUpdate {
+	lsu.mutation.Where(ps...)
+	return lsu
+}
+
+// SetLeaderboardID sets the "leaderboard_id" field.
+func (lsu *LeaderboardSnapshotUpdate) SetLeaderboardID(s string) *LeaderboardSnapshotUpdate {
+	lsu.mutation.SetLeaderboardID(s)
+	return lsu
+}
+
+// SetNillableLeaderboardID sets the "leaderboard_id" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillableLeaderboardID(s *string) *LeaderboardSnapshotUpdate {
+	if s != nil {
+		lsu.SetLeaderboardID(*s)
+	}
+	return lsu
+}
+
+// SetPeriod sets the "period" field.
+func (lsu *LeaderboardSnapshotUpdate) SetPeriod(s string) *LeaderboardSnapshotUpdate {
+	lsu.mutation.SetPeriod(s)
+	return lsu
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillablePeriod(s *string) *LeaderboardSnapshotUpdate {
+	if s != nil {
+		lsu.SetPeriod(*s)
+	}
+	return lsu
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (lsu *LeaderboardSnapshotUpdate) SetPeriodStart(i int64) *LeaderboardSnapshotUpdate {
+	lsu.mutation.ResetPeriodStart()
+	lsu.mutation.SetPeriodStart(i)
+	return lsu
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillablePeriodStart(i *int64) *LeaderboardSnapshotUpdate {
+	if i != nil {
+		lsu.SetPeriodStart(*i)
+	}
+	return lsu
+}
+
+// AddPeriodStart adds i to the "period_start" field.
+func (lsu *LeaderboardSnapshotUpdate) AddPeriodStart(i int64) *LeaderboardSnapshotUpdate {
+	lsu.mutation.AddPeriodStart(i)
+	return lsu
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (lsu *LeaderboardSnapshotUpdate) SetPeriodEnd(i int64) *LeaderboardSnapshotUpdate {
+	lsu.mutation.ResetPeriodEnd()
+	lsu.mutation.SetPeriodEnd(i)
+	return lsu
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (lsu *LeaderboardSnapshotUpdate) SetNillablePeriodEnd(i *int64) *LeaderboardSnapshotUpdate {
+	if i != nil {
+		lsu.SetPeriodEnd(*i)
+	}
+	return lsu
+}
+
+// AddPeriodEnd adds i to the "period_end" field.
+func (lsu *LeaderboardSnapshotUpdate) AddPeriodEnd(i int64) *LeaderboardSnapshotUpdate {
+	lsu.mutation.AddPeriodEnd(i)
+	return lsu
+}
+
+// SetEntries sets the "entries" field.
+func (lsu *LeaderboardSnapshotUpdate) SetEntries(ee []*entity.LeaderboardEntry) *LeaderboardSnapshotUpdate {
+	lsu.mutation.SetEntries(ee)
+	return lsu
+}
+
+// AppendEntries appends ee to the "entries" field.
+func (lsu *LeaderboardSnapshotUpdate) AppendEntries(ee []*entity.LeaderboardEntry) *LeaderboardSnapshotUpdate {
+	lsu.mutation.AppendEntries(ee)
+	return lsu
+}
+
+// Mutation returns the LeaderboardSnapshotMutation object of the builder.
+func (lsu *LeaderboardSnapshotUpdate) Mutation() *LeaderboardSnapshotMutation {
+	return lsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lsu *LeaderboardSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lsu.sqlSave, lsu.mutation, lsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsu *LeaderboardSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := lsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lsu *LeaderboardSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := lsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsu *LeaderboardSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := lsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lsu *LeaderboardSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaderboardSnapshotUpdate {
+	lsu.modifiers = append(lsu.modifiers, modifiers...)
+	return lsu
+}
+
+func (lsu *LeaderboardSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(leaderboardsnapshot.Table, leaderboardsnapshot.Columns, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeInt64))
+	if ps := lsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsu.mutation.LeaderboardID(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldLeaderboardID, field.TypeString, value)
+	}
+	if value, ok := lsu.mutation.Period(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := lsu.mutation.PeriodStart(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriodStart, field.TypeInt64, value)
+	}
+	if value, ok := lsu.mutation.AddedPeriodStart(); ok {
+		_spec.AddField(leaderboardsnapshot.FieldPeriodStart, field.TypeInt64, value)
+	}
+	if value, ok := lsu.mutation.PeriodEnd(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriodEnd, field.TypeInt64, value)
+	}
+	if value, ok := lsu.mutation.AddedPeriodEnd(); ok {
+		_spec.AddField(leaderboardsnapshot.FieldPeriodEnd, field.TypeInt64, value)
+	}
+	if value, ok := lsu.mutation.Entries(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldEntries, field.TypeJSON, value)
+	}
+	if value, ok := lsu.mutation.AppendedEntries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leaderboardsnapshot.FieldEntries, value)
+		})
+	}
+	_spec.AddModifiers(lsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, lsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaderboardsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lsu.mutation.done = true
+	return n, nil
+}
+
+// LeaderboardSnapshotUpdateOne is the builder for updating a single LeaderboardSnapshot entity.
+type LeaderboardSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaderboardSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLeaderboardID sets the "leaderboard_id" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetLeaderboardID(s string) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.SetLeaderboardID(s)
+	return lsuo
+}
+
+// SetNillableLeaderboardID sets the "leaderboard_id" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillableLeaderboardID(s *string) *LeaderboardSnapshotUpdateOne {
+	if s != nil {
+		lsuo.SetLeaderboardID(*s)
+	}
+	return lsuo
+}
+
+// SetPeriod sets the "period" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetPeriod(s string) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.SetPeriod(s)
+	return lsuo
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillablePeriod(s *string) *LeaderboardSnapshotUpdateOne {
+	if s != nil {
+		lsuo.SetPeriod(*s)
+	}
+	return lsuo
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetPeriodStart(i int64) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.ResetPeriodStart()
+	lsuo.mutation.SetPeriodStart(i)
+	return lsuo
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillablePeriodStart(i *int64) *LeaderboardSnapshotUpdateOne {
+	if i != nil {
+		lsuo.SetPeriodStart(*i)
+	}
+	return lsuo
+}
+
+// AddPeriodStart adds i to the "period_start" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) AddPeriodStart(i int64) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.AddPeriodStart(i)
+	return lsuo
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetPeriodEnd(i int64) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.ResetPeriodEnd()
+	lsuo.mutation.SetPeriodEnd(i)
+	return lsuo
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetNillablePeriodEnd(i *int64) *LeaderboardSnapshotUpdateOne {
+	if i != nil {
+		lsuo.SetPeriodEnd(*i)
+	}
+	return lsuo
+}
+
+// AddPeriodEnd adds i to the "period_end" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) AddPeriodEnd(i int64) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.AddPeriodEnd(i)
+	return lsuo
+}
+
+// SetEntries sets the "entries" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) SetEntries(ee []*entity.LeaderboardEntry) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.SetEntries(ee)
+	return lsuo
+}
+
+// AppendEntries appends ee to the "entries" field.
+func (lsuo *LeaderboardSnapshotUpdateOne) AppendEntries(ee []*entity.LeaderboardEntry) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.AppendEntries(ee)
+	return lsuo
+}
+
+// Mutation returns the LeaderboardSnapshotMutation object of the builder.
+func (lsuo *LeaderboardSnapshotUpdateOne) Mutation() *LeaderboardSnapshotMutation {
+	return lsuo.mutation
+}
+
+// Where appends a list predicates to the LeaderboardSnapshotUpdate builder.
+func (lsuo *LeaderboardSnapshotUpdateOne) Where(ps ...predicate.LeaderboardSnapshot) *LeaderboardSnapshotUpdateOne {
+	lsuo.mutation.Where(ps...)
+	return lsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lsuo *LeaderboardSnapshotUpdateOne) Select(field string, fields ...string) *LeaderboardSnapshotUpdateOne {
+	lsuo.fields = append([]string{field}, fields...)
+	return lsuo
+}
+
+// Save executes the query and returns the updated LeaderboardSnapshot entity.
+func (lsuo *LeaderboardSnapshotUpdateOne) Save(ctx context.Context) (*LeaderboardSnapshot, error) {
+	return withHooks(ctx, lsuo.sqlSave, lsuo.mutation, lsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsuo *LeaderboardSnapshotUpdateOne) SaveX(ctx context.Context) *LeaderboardSnapshot {
+	node, err := lsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lsuo *LeaderboardSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := lsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsuo *LeaderboardSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := lsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (lsuo *LeaderboardSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaderboardSnapshotUpdateOne {
+	lsuo.modifiers = append(lsuo.modifiers, modifiers...)
+	return lsuo
+}
+
+func (lsuo *LeaderboardSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *LeaderboardSnapshot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(leaderboardsnapshot.Table, leaderboardsnapshot.Columns, sqlgraph.NewFieldSpec(leaderboardsnapshot.FieldID, field.TypeInt64))
+	id, ok := lsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "LeaderboardSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaderboardsnapshot.FieldID)
+		for _, f := range fields {
+			if !leaderboardsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != leaderboardsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsuo.mutation.LeaderboardID(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldLeaderboardID, field.TypeString, value)
+	}
+	if value, ok := lsuo.mutation.Period(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := lsuo.mutation.PeriodStart(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriodStart, field.TypeInt64, value)
+	}
+	if value, ok := lsuo.mutation.AddedPeriodStart(); ok {
+		_spec.AddField(leaderboardsnapshot.FieldPeriodStart, field.TypeInt64, value)
+	}
+	if value, ok := lsuo.mutation.PeriodEnd(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldPeriodEnd, field.TypeInt64, value)
+	}
+	if value, ok := lsuo.mutation.AddedPeriodEnd(); ok {
+		_spec.AddField(leaderboardsnapshot.FieldPeriodEnd, field.TypeInt64, value)
+	}
+	if value, ok := lsuo.mutation.Entries(); ok {
+		_spec.SetField(leaderboardsnapshot.FieldEntries, field.TypeJSON, value)
+	}
+	if value, ok := lsuo.mutation.AppendedEntries(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leaderboardsnapshot.FieldEntries, value)
+		})
+	}
+	_spec.AddModifiers(lsuo.modifiers...)
+	_node = &LeaderboardSnapshot{config: lsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaderboardsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	lsuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// LedgerEntry is the model entity for the LedgerEntry schema.
+type LedgerEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID string `json:"player_id,omitempty"`
+	// AssetType holds the value of the "asset_type" field.
+	AssetType string `json:"asset_type,omitempty"`
+	// Asset holds the value of the "asset" field.
+	Asset string `json:"asset,omitempty"`
+	// Delta holds the value of the "delta" field.
+	Delta int64 `json:"delta,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance int64 `json:"balance,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LedgerEntryQuery when eager-loading is set.
+	Edges        LedgerEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LedgerEntryEdges holds the relations/edges for other nodes in the graph.
+type LedgerEntryEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LedgerEntryEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LedgerEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldID, ledgerentry.FieldDelta, ledgerentry.FieldBalance, ledgerentry.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case ledgerentry.FieldPlayerID, ledgerentry.FieldAssetType, ledgerentry.FieldAsset, ledgerentry.FieldReason, ledgerentry.FieldReference:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LedgerEntry fields.
+func (le *LedgerEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ledgerentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			le.ID = int64(value.Int64)
+		case ledgerentry.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				le.PlayerID = value.String
+			}
+		case ledgerentry.FieldAssetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_type", values[i])
+			} else if value.Valid {
+				le.AssetType = value.String
+			}
+		case ledgerentry.FieldAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset", values[i])
+			} else if value.Valid {
+				le.Asset = value.String
+			}
+		case ledgerentry.FieldDelta:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delta", values[i])
+			} else if value.Valid {
+				le.Delta = value.Int64
+			}
+		case ledgerentry.FieldBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				le.Balance = value.Int64
+			}
+		case ledgerentry.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				le.Reason = value.String
+			}
+		case ledgerentry.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				le.Reference = value.String
+			}
+		case ledgerentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				le.CreatedAt = value.Int64
+			}
+		default:
+			le.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LedgerEntry.
+// This includes values selected through modifiers, order, etc.
+func (le *LedgerEntry) Value(name string) (ent.Value, error) {
+	return le.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the LedgerEntry entity.
+func (le *LedgerEntry) QueryPlayer() *PlayerQuery {
+	return NewLedgerEntryClient(le.config).QueryPlayer(le)
+}
+
+// Update returns a builder for updating this LedgerEntry.
+// Note that you need to call LedgerEntry.Unwrap() before calling this method if this LedgerEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (le *LedgerEntry) Update() *LedgerEntryUpdateOne {
+	return NewLedgerEntryClient(le.config).UpdateOne(le)
+}
+
+// Unwrap unwraps the LedgerEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (le *LedgerEntry) Unwrap() *LedgerEntry {
+	_tx, ok := le.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: LedgerEntry is not a transactional entity")
+	}
+	le.config.driver = _tx.drv
+	return le
+}
+
+// String implements the fmt.Stringer.
+func (le *LedgerEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("LedgerEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", le.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(le.PlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("asset_type=")
+	builder.WriteString(le.AssetType)
+	builder.WriteString(", ")
+	builder.WriteString("asset=")
+	builder.WriteString(le.Asset)
+	builder.WriteString(", ")
+	builder.WriteString("delta=")
+	builder.WriteString(fmt.Sprintf("%v", le.Delta))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", le.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(le.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(le.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", le.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LedgerEntries is a parsable slice of LedgerEntry.
+type LedgerEntries []*LedgerEntry
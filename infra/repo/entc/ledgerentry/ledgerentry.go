@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ledgerentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ledgerentry type in the database.
+	Label = "ledger_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPlayerID holds the string denoting the player_id field in the database.
+	FieldPlayerID = "player_id"
+	// FieldAssetType holds the string denoting the asset_type field in the database.
+	FieldAssetType = "asset_type"
+	// FieldAsset holds the string denoting the asset field in the database.
+	FieldAsset = "asset"
+	// FieldDelta holds the string denoting the delta field in the database.
+	FieldDelta = "delta"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePlayer holds the string denoting the player edge name in mutations.
+	EdgePlayer = "player"
+	// Table holds the table name of the ledgerentry in the database.
+	Table = "ledger_entries"
+	// PlayerTable is the table that holds the player relation/edge.
+	PlayerTable = "ledger_entries"
+	// PlayerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	PlayerInverseTable = "players"
+	// PlayerColumn is the table column denoting the player relation/edge.
+	PlayerColumn = "player_id"
+)
+
+// Columns holds all SQL columns for ledgerentry fields.
+var Columns = []string{
+	FieldID,
+	FieldPlayerID,
+	FieldAssetType,
+	FieldAsset,
+	FieldDelta,
+	FieldBalance,
+	FieldReason,
+	FieldReference,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReference holds the default value on creation for the "reference" field.
+	DefaultReference string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+)
+
+// OrderOption defines the ordering options for the LedgerEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPlayerID orders the results by the player_id field.
+func ByPlayerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayerID, opts...).ToFunc()
+}
+
+// ByAssetType orders the results by the asset_type field.
+func ByAssetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetType, opts...).ToFunc()
+}
+
+// ByAsset orders the results by the asset field.
+func ByAsset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsset, opts...).ToFunc()
+}
+
+// ByDelta orders the results by the delta field.
+func ByDelta(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDelta, opts...).ToFunc()
+}
+
+// ByBalance orders the results by the balance field.
+func ByBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalance, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPlayerField orders the results by player field.
+func ByPlayerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPlayerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPlayerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PlayerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+	)
+}
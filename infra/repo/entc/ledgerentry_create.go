@@ -0,0 +1,913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// LedgerEntryCreate is the builder for creating a LedgerEntry entity.
+type LedgerEntryCreate struct {
+	config
+	mutation *LedgerEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlayerID sets the "player_id" field.
+func (lec *LedgerEntryCreate) SetPlayerID(s string) *LedgerEntryCreate {
+	lec.mutation.SetPlayerID(s)
+	return lec
+}
+
+// SetAssetType sets the "asset_type" field.
+func (lec *LedgerEntryCreate) SetAssetType(s string) *LedgerEntryCreate {
+	lec.mutation.SetAssetType(s)
+	return lec
+}
+
+// SetAsset sets the "asset" field.
+func (lec *LedgerEntryCreate) SetAsset(s string) *LedgerEntryCreate {
+	lec.mutation.SetAsset(s)
+	return lec
+}
+
+// SetDelta sets the "delta" field.
+func (lec *LedgerEntryCreate) SetDelta(i int64) *LedgerEntryCreate {
+	lec.mutation.SetDelta(i)
+	return lec
+}
+
+// SetBalance sets the "balance" field.
+func (lec *LedgerEntryCreate) SetBalance(i int64) *LedgerEntryCreate {
+	lec.mutation.SetBalance(i)
+	return lec
+}
+
+// SetReason sets the "reason" field.
+func (lec *LedgerEntryCreate) SetReason(s string) *LedgerEntryCreate {
+	lec.mutation.SetReason(s)
+	return lec
+}
+
+// SetReference sets the "reference" field.
+func (lec *LedgerEntryCreate) SetReference(s string) *LedgerEntryCreate {
+	lec.mutation.SetReference(s)
+	return lec
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableReference(s *string) *LedgerEntryCreate {
+	if s != nil {
+		lec.SetReference(*s)
+	}
+	return lec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lec *LedgerEntryCreate) SetCreatedAt(i int64) *LedgerEntryCreate {
+	lec.mutation.SetCreatedAt(i)
+	return lec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lec *LedgerEntryCreate) SetNillableCreatedAt(i *int64) *LedgerEntryCreate {
+	if i != nil {
+		lec.SetCreatedAt(*i)
+	}
+	return lec
+}
+
+// SetID sets the "id" field.
+func (lec *LedgerEntryCreate) SetID(i int64) *LedgerEntryCreate {
+	lec.mutation.SetID(i)
+	return lec
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (lec *LedgerEntryCreate) SetPlayer(p *Player) *LedgerEntryCreate {
+	return lec.SetPlayerID(p.ID)
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (lec *LedgerEntryCreate) Mutation() *LedgerEntryMutation {
+	return lec.mutation
+}
+
+// Save creates the LedgerEntry in the database.
+func (lec *LedgerEntryCreate) Save(ctx context.Context) (*LedgerEntry, error) {
+	lec.defaults()
+	return withHooks(ctx, lec.sqlSave, lec.mutation, lec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lec *LedgerEntryCreate) SaveX(ctx context.Context) *LedgerEntry {
+	v, err := lec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lec *LedgerEntryCreate) Exec(ctx context.Context) error {
+	_, err := lec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lec *LedgerEntryCreate) ExecX(ctx context.Context) {
+	if err := lec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lec *LedgerEntryCreate) defaults() {
+	if _, ok := lec.mutation.Reference(); !ok {
+		v := ledgerentry.DefaultReference
+		lec.mutation.SetReference(v)
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		v := ledgerentry.DefaultCreatedAt()
+		lec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lec *LedgerEntryCreate) check() error {
+	if _, ok := lec.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`entc: missing required field "LedgerEntry.player_id"`)}
+	}
+	if _, ok := lec.mutation.AssetType(); !ok {
+		return &ValidationError{Name: "asset_type", err: errors.New(`entc: missing required field "LedgerEntry.asset_type"`)}
+	}
+	if _, ok := lec.mutation.Asset(); !ok {
+		return &ValidationError{Name: "asset", err: errors.New(`entc: missing required field "LedgerEntry.asset"`)}
+	}
+	if _, ok := lec.mutation.Delta(); !ok {
+		return &ValidationError{Name: "delta", err: errors.New(`entc: missing required field "LedgerEntry.delta"`)}
+	}
+	if _, ok := lec.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`entc: missing required field "LedgerEntry.balance"`)}
+	}
+	if _, ok := lec.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`entc: missing required field "LedgerEntry.reason"`)}
+	}
+	if _, ok := lec.mutation.Reference(); !ok {
+		return &ValidationError{Name: "reference", err: errors.New(`entc: missing required field "LedgerEntry.reference"`)}
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "LedgerEntry.created_at"`)}
+	}
+	if len(lec.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`entc: missing required edge "LedgerEntry.player"`)}
+	}
+	return nil
+}
+
+func (lec *LedgerEntryCreate) sqlSave(ctx context.Context) (*LedgerEntry, error) {
+	if err := lec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	lec.mutation.id = &_node.ID
+	lec.mutation.done = true
+	return _node, nil
+}
+
+func (lec *LedgerEntryCreate) createSpec() (*LedgerEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerEntry{config: lec.config}
+		_spec = sqlgraph.NewCreateSpec(ledgerentry.Table, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = lec.conflict
+	if id, ok := lec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lec.mutation.AssetType(); ok {
+		_spec.SetField(ledgerentry.FieldAssetType, field.TypeString, value)
+		_node.AssetType = value
+	}
+	if value, ok := lec.mutation.Asset(); ok {
+		_spec.SetField(ledgerentry.FieldAsset, field.TypeString, value)
+		_node.Asset = value
+	}
+	if value, ok := lec.mutation.Delta(); ok {
+		_spec.SetField(ledgerentry.FieldDelta, field.TypeInt64, value)
+		_node.Delta = value
+	}
+	if value, ok := lec.mutation.Balance(); ok {
+		_spec.SetField(ledgerentry.FieldBalance, field.TypeInt64, value)
+		_node.Balance = value
+	}
+	if value, ok := lec.mutation.Reason(); ok {
+		_spec.SetField(ledgerentry.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := lec.mutation.Reference(); ok {
+		_spec.SetField(ledgerentry.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := lec.mutation.CreatedAt(); ok {
+		_spec.SetField(ledgerentry.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if nodes := lec.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerentry.PlayerTable,
+			Columns: []string{ledgerentry.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlayerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerEntry.Create().
+//		SetPlayerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerEntryUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (lec *LedgerEntryCreate) OnConflict(opts ...sql.ConflictOption) *LedgerEntryUpsertOne {
+	lec.conflict = opts
+	return &LedgerEntryUpsertOne{
+		create: lec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lec *LedgerEntryCreate) OnConflictColumns(columns ...string) *LedgerEntryUpsertOne {
+	lec.conflict = append(lec.conflict, sql.ConflictColumns(columns...))
+	return &LedgerEntryUpsertOne{
+		create: lec,
+	}
+}
+
+type (
+	// LedgerEntryUpsertOne is the builder for "upsert"-ing
+	//  one LedgerEntry node.
+	LedgerEntryUpsertOne struct {
+		create *LedgerEntryCreate
+	}
+
+	// LedgerEntryUpsert is the "OnConflict" setter.
+	LedgerEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlayerID sets the "player_id" field.
+func (u *LedgerEntryUpsert) SetPlayerID(v string) *LedgerEntryUpsert {
+	u.Set(ledgerentry.FieldPlayerID, v)
+	return u
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *LedgerEntryUpsert) UpdatePlayerID() *LedgerEntryUpsert {
+	u.SetExcluded(ledgerentry.FieldPlayerID)
+	return u
+}
+
+// SetAssetType sets the "asset_type" field.
+func (u *LedgerEntryUpsert) SetAssetType(v string) *LedgerEntryUpsert {
+	u.Set(ledgerentry.FieldAssetType, v)
+	return u
+}
+
+// UpdateAssetType sets the "asset_type" field to the value that was provided on create.
+func (u *LedgerEntryUpsert) UpdateAssetType() *LedgerEntryUpsert {
+	u.SetExcluded(ledgerentry.FieldAssetType)
+	return u
+}
+
+// SetAsset sets the "asset" field.
+func (u *LedgerEntryUpsert) SetAsset(v string) *LedgerEntryUpsert {
+	u.Set(ledgerentry.FieldAsset, v)
+	return u
+}
+
+// UpdateAsset sets the "asset" field to the value that was provided on create.
+func (u *LedgerEntryUpsert) UpdateAsset() *LedgerEntryUpsert {
+	u.SetExcluded(ledgerentry.FieldAsset)
+	return u
+}
+
+// SetDelta sets the "delta" field.
+func (u *LedgerEntryUpsert) SetDelta(v int64) *LedgerEntryUpsert {
+	u.Set(ledgerentry.FieldDelta, v)
+	return u
+}
+
+// UpdateDelta sets the "delta" field to the value that was provided on create.
+func (u *LedgerEntryUpsert) UpdateDelta() *LedgerEntryUpsert {
+	u.SetExcluded(ledgerentry.FieldDelta)
+	return u
+}
+
+// AddDelta adds v to the "delta" field.
+func (u *LedgerEntryUpsert) AddDelta(v int64) *LedgerEntryUpsert {
+	u.Add(ledgerentry.FieldDelta, v)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *LedgerEntryUpsert) SetBalance(v int64) *LedgerEntryUpsert {
+	u.Set(ledgerentry.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LedgerEntryUpsert) UpdateBalance() *LedgerEntryUpsert {
+	u.SetExcluded(ledgerentry.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LedgerEntryUpsert) AddBalance(v int64) *LedgerEntryUpsert {
+	u.Add(ledgerentry.FieldBalance, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *LedgerEntryUpsert) SetReason(v string) *LedgerEntryUpsert {
+	u.Set(ledgerentry.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LedgerEntryUpsert) UpdateReason() *LedgerEntryUpsert {
+	u.SetExcluded(ledgerentry.FieldReason)
+	return u
+}
+
+// SetReference sets the "reference" field.
+func (u *LedgerEntryUpsert) SetReference(v string) *LedgerEntryUpsert {
+	u.Set(ledgerentry.FieldReference, v)
+	return u
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *LedgerEntryUpsert) UpdateReference() *LedgerEntryUpsert {
+	u.SetExcluded(ledgerentry.FieldReference)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertOne) UpdateNewValues() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ledgerentry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ledgerentry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LedgerEntryUpsertOne) Ignore() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerEntryUpsertOne) DoNothing() *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerEntryCreate.OnConflict
+// documentation for more info.
+func (u *LedgerEntryUpsertOne) Update(set func(*LedgerEntryUpsert)) *LedgerEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *LedgerEntryUpsertOne) SetPlayerID(v string) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *LedgerEntryUpsertOne) UpdatePlayerID() *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetAssetType sets the "asset_type" field.
+func (u *LedgerEntryUpsertOne) SetAssetType(v string) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetAssetType(v)
+	})
+}
+
+// UpdateAssetType sets the "asset_type" field to the value that was provided on create.
+func (u *LedgerEntryUpsertOne) UpdateAssetType() *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateAssetType()
+	})
+}
+
+// SetAsset sets the "asset" field.
+func (u *LedgerEntryUpsertOne) SetAsset(v string) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetAsset(v)
+	})
+}
+
+// UpdateAsset sets the "asset" field to the value that was provided on create.
+func (u *LedgerEntryUpsertOne) UpdateAsset() *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateAsset()
+	})
+}
+
+// SetDelta sets the "delta" field.
+func (u *LedgerEntryUpsertOne) SetDelta(v int64) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetDelta(v)
+	})
+}
+
+// AddDelta adds v to the "delta" field.
+func (u *LedgerEntryUpsertOne) AddDelta(v int64) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.AddDelta(v)
+	})
+}
+
+// UpdateDelta sets the "delta" field to the value that was provided on create.
+func (u *LedgerEntryUpsertOne) UpdateDelta() *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateDelta()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LedgerEntryUpsertOne) SetBalance(v int64) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LedgerEntryUpsertOne) AddBalance(v int64) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LedgerEntryUpsertOne) UpdateBalance() *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LedgerEntryUpsertOne) SetReason(v string) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LedgerEntryUpsertOne) UpdateReason() *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *LedgerEntryUpsertOne) SetReference(v string) *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *LedgerEntryUpsertOne) UpdateReference() *LedgerEntryUpsertOne {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LedgerEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LedgerEntryUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LedgerEntryUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LedgerEntryCreateBulk is the builder for creating many LedgerEntry entities in bulk.
+type LedgerEntryCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LedgerEntry entities in the database.
+func (lecb *LedgerEntryCreateBulk) Save(ctx context.Context) ([]*LedgerEntry, error) {
+	if lecb.err != nil {
+		return nil, lecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lecb.builders))
+	nodes := make([]*LedgerEntry, len(lecb.builders))
+	mutators := make([]Mutator, len(lecb.builders))
+	for i := range lecb.builders {
+		func(i int, root context.Context) {
+			builder := lecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) SaveX(ctx context.Context) []*LedgerEntry {
+	v, err := lecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lecb *LedgerEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := lecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lecb *LedgerEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := lecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LedgerEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LedgerEntryUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (lecb *LedgerEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *LedgerEntryUpsertBulk {
+	lecb.conflict = opts
+	return &LedgerEntryUpsertBulk{
+		create: lecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lecb *LedgerEntryCreateBulk) OnConflictColumns(columns ...string) *LedgerEntryUpsertBulk {
+	lecb.conflict = append(lecb.conflict, sql.ConflictColumns(columns...))
+	return &LedgerEntryUpsertBulk{
+		create: lecb,
+	}
+}
+
+// LedgerEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of LedgerEntry nodes.
+type LedgerEntryUpsertBulk struct {
+	create *LedgerEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ledgerentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertBulk) UpdateNewValues() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ledgerentry.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ledgerentry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LedgerEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LedgerEntryUpsertBulk) Ignore() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LedgerEntryUpsertBulk) DoNothing() *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LedgerEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *LedgerEntryUpsertBulk) Update(set func(*LedgerEntryUpsert)) *LedgerEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LedgerEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *LedgerEntryUpsertBulk) SetPlayerID(v string) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *LedgerEntryUpsertBulk) UpdatePlayerID() *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetAssetType sets the "asset_type" field.
+func (u *LedgerEntryUpsertBulk) SetAssetType(v string) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetAssetType(v)
+	})
+}
+
+// UpdateAssetType sets the "asset_type" field to the value that was provided on create.
+func (u *LedgerEntryUpsertBulk) UpdateAssetType() *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateAssetType()
+	})
+}
+
+// SetAsset sets the "asset" field.
+func (u *LedgerEntryUpsertBulk) SetAsset(v string) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetAsset(v)
+	})
+}
+
+// UpdateAsset sets the "asset" field to the value that was provided on create.
+func (u *LedgerEntryUpsertBulk) UpdateAsset() *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateAsset()
+	})
+}
+
+// SetDelta sets the "delta" field.
+func (u *LedgerEntryUpsertBulk) SetDelta(v int64) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetDelta(v)
+	})
+}
+
+// AddDelta adds v to the "delta" field.
+func (u *LedgerEntryUpsertBulk) AddDelta(v int64) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.AddDelta(v)
+	})
+}
+
+// UpdateDelta sets the "delta" field to the value that was provided on create.
+func (u *LedgerEntryUpsertBulk) UpdateDelta() *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateDelta()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *LedgerEntryUpsertBulk) SetBalance(v int64) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *LedgerEntryUpsertBulk) AddBalance(v int64) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *LedgerEntryUpsertBulk) UpdateBalance() *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LedgerEntryUpsertBulk) SetReason(v string) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LedgerEntryUpsertBulk) UpdateReason() *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetReference sets the "reference" field.
+func (u *LedgerEntryUpsertBulk) SetReference(v string) *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.SetReference(v)
+	})
+}
+
+// UpdateReference sets the "reference" field to the value that was provided on create.
+func (u *LedgerEntryUpsertBulk) UpdateReference() *LedgerEntryUpsertBulk {
+	return u.Update(func(s *LedgerEntryUpsert) {
+		s.UpdateReference()
+	})
+}
+
+// Exec executes the query.
+func (u *LedgerEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the LedgerEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LedgerEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LedgerEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// LedgerEntryUpdate is the builder for updating LedgerEntry entities.
+type LedgerEntryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LedgerEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LedgerEntryUpdate builder.
+func (leu *LedgerEntryUpdate) Where(ps ...predicate.LedgerEntry) *LedgerEntryUpdate {
+	leu.mutation.Where(ps...)
+	return leu
+}
+
+// SetPlayerID sets the "player_id" field.
+func (leu *LedgerEntryUpdate) SetPlayerID(s string) *LedgerEntryUpdate {
+	leu.mutation.SetPlayerID(s)
+	return leu
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (leu *LedgerEntryUpdate) SetNillablePlayerID(s *string) *LedgerEntryUpdate {
+	if s != nil {
+		leu.SetPlayerID(*s)
+	}
+	return leu
+}
+
+// SetAssetType sets the "asset_type" field.
+func (leu *LedgerEntryUpdate) SetAssetType(s string) *LedgerEntryUpdate {
+	leu.mutation.SetAssetType(s)
+	return leu
+}
+
+// SetNillableAssetType sets the "asset_type" field if the given value is not nil.
+func (leu *LedgerEntryUpdate) SetNillableAssetType(s *string) *LedgerEntryUpdate {
+	if s != nil {
+		leu.SetAssetType(*s)
+	}
+	return leu
+}
+
+// SetAsset sets the "asset" field.
+func (leu *LedgerEntryUpdate) SetAsset(s string) *LedgerEntryUpdate {
+	leu.mutation.SetAsset(s)
+	return leu
+}
+
+// SetNillableAsset sets the "asset" field if the given value is not nil.
+func (leu *LedgerEntryUpdate) SetNillableAsset(s *string) *LedgerEntryUpdate {
+	if s != nil {
+		leu.SetAsset(*s)
+	}
+	return leu
+}
+
+// SetDelta sets the "delta" field.
+func (leu *LedgerEntryUpdate) SetDelta(i int64) *LedgerEntryUpdate {
+	leu.mutation.ResetDelta()
+	leu.mutation.SetDelta(i)
+	return leu
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (leu *LedgerEntryUpdate) SetNillableDelta(i *int64) *LedgerEntryUpdate {
+	if i != nil {
+		leu.SetDelta(*i)
+	}
+	return leu
+}
+
+// AddDelta adds i to the "delta" field.
+func (leu *LedgerEntryUpdate) AddDelta(i int64) *LedgerEntryUpdate {
+	leu.mutation.AddDelta(i)
+	return leu
+}
+
+// SetBalance sets the "balance" field.
+func (leu *LedgerEntryUpdate) SetBalance(i int64) *LedgerEntryUpdate {
+	leu.mutation.ResetBalance()
+	leu.mutation.SetBalance(i)
+	return leu
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (leu *LedgerEntryUpdate) SetNillableBalance(i *int64) *LedgerEntryUpdate {
+	if i != nil {
+		leu.SetBalance(*i)
+	}
+	return leu
+}
+
+// AddBalance adds i to the "balance" field.
+func (leu *LedgerEntryUpdate) AddBalance(i int64) *LedgerEntryUpdate {
+	leu.mutation.AddBalance(i)
+	return leu
+}
+
+// SetReason sets the "reason" field.
+func (leu *LedgerEntryUpdate) SetReason(s string) *LedgerEntryUpdate {
+	leu.mutation.SetReason(s)
+	return leu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (leu *LedgerEntryUpdate) SetNillableReason(s *string) *LedgerEntryUpdate {
+	if s != nil {
+		leu.SetReason(*s)
+	}
+	return leu
+}
+
+// SetReference sets the "reference" field.
+func (leu *LedgerEntryUpdate) SetReference(s string) *LedgerEntryUpdate {
+	leu.mutation.SetReference(s)
+	return leu
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (leu *LedgerEntryUpdate) SetNillableReference(s *string) *LedgerEntryUpdate {
+	if s != nil {
+		leu.SetReference(*s)
+	}
+	return leu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (leu *LedgerEntryUpdate) SetPlayer(p *Player) *LedgerEntryUpdate {
+	return leu.SetPlayerID(p.ID)
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (leu *LedgerEntryUpdate) Mutation() *LedgerEntryMutation {
+	return leu.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (leu *LedgerEntryUpdate) ClearPlayer() *LedgerEntryUpdate {
+	leu.mutation.ClearPlayer()
+	return leu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (leu *LedgerEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, leu.sqlSave, leu.mutation, leu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leu *LedgerEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := leu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (leu *LedgerEntryUpdate) Exec(ctx context.Context) error {
+	_, err := leu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leu *LedgerEntryUpdate) ExecX(ctx context.Context) {
+	if err := leu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (leu *LedgerEntryUpdate) check() error {
+	if leu.mutation.PlayerCleared() && len(leu.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "LedgerEntry.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (leu *LedgerEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LedgerEntryUpdate {
+	leu.modifiers = append(leu.modifiers, modifiers...)
+	return leu
+}
+
+func (leu *LedgerEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := leu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgerentry.Table, ledgerentry.Columns, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64))
+	if ps := leu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := leu.mutation.AssetType(); ok {
+		_spec.SetField(ledgerentry.FieldAssetType, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.Asset(); ok {
+		_spec.SetField(ledgerentry.FieldAsset, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.Delta(); ok {
+		_spec.SetField(ledgerentry.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.AddedDelta(); ok {
+		_spec.AddField(ledgerentry.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.Balance(); ok {
+		_spec.SetField(ledgerentry.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.AddedBalance(); ok {
+		_spec.AddField(ledgerentry.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.Reason(); ok {
+		_spec.SetField(ledgerentry.FieldReason, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.Reference(); ok {
+		_spec.SetField(ledgerentry.FieldReference, field.TypeString, value)
+	}
+	if leu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerentry.PlayerTable,
+			Columns: []string{ledgerentry.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := leu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerentry.PlayerTable,
+			Columns: []string{ledgerentry.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(leu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, leu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	leu.mutation.done = true
+	return n, nil
+}
+
+// LedgerEntryUpdateOne is the builder for updating a single LedgerEntry entity.
+type LedgerEntryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LedgerEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPlayerID sets the "player_id" field.
+func (leuo *LedgerEntryUpdateOne) SetPlayerID(s string) *LedgerEntryUpdateOne {
+	leuo.mutation.SetPlayerID(s)
+	return leuo
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (leuo *LedgerEntryUpdateOne) SetNillablePlayerID(s *string) *LedgerEntryUpdateOne {
+	if s != nil {
+		leuo.SetPlayerID(*s)
+	}
+	return leuo
+}
+
+// SetAssetType sets the "asset_type" field.
+func (leuo *LedgerEntryUpdateOne) SetAssetType(s string) *LedgerEntryUpdateOne {
+	leuo.mutation.SetAssetType(s)
+	return leuo
+}
+
+// SetNillableAssetType sets the "asset_type" field if the given value is not nil.
+func (leuo *LedgerEntryUpdateOne) SetNillableAssetType(s *string) *LedgerEntryUpdateOne {
+	if s != nil {
+		leuo.SetAssetType(*s)
+	}
+	return leuo
+}
+
+// SetAsset sets the "asset" field.
+func (leuo *LedgerEntryUpdateOne) SetAsset(s string) *LedgerEntryUpdateOne {
+	leuo.mutation.SetAsset(s)
+	return leuo
+}
+
+// SetNillableAsset sets the "asset" field if the given value is not nil.
+func (leuo *LedgerEntryUpdateOne) SetNillableAsset(s *string) *LedgerEntryUpdateOne {
+	if s != nil {
+		leuo.SetAsset(*s)
+	}
+	return leuo
+}
+
+// SetDelta sets the "delta" field.
+func (leuo *LedgerEntryUpdateOne) SetDelta(i int64) *LedgerEntryUpdateOne {
+	leuo.mutation.ResetDelta()
+	leuo.mutation.SetDelta(i)
+	return leuo
+}
+
+// SetNillableDelta sets the "delta" field if the given value is not nil.
+func (leuo *LedgerEntryUpdateOne) SetNillableDelta(i *int64) *LedgerEntryUpdateOne {
+	if i != nil {
+		leuo.SetDelta(*i)
+	}
+	return leuo
+}
+
+// AddDelta adds i to the "delta" field.
+func (leuo *LedgerEntryUpdateOne) AddDelta(i int64) *LedgerEntryUpdateOne {
+	leuo.mutation.AddDelta(i)
+	return leuo
+}
+
+// SetBalance sets the "balance" field.
+func (leuo *LedgerEntryUpdateOne) SetBalance(i int64) *LedgerEntryUpdateOne {
+	leuo.mutation.ResetBalance()
+	leuo.mutation.SetBalance(i)
+	return leuo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (leuo *LedgerEntryUpdateOne) SetNillableBalance(i *int64) *LedgerEntryUpdateOne {
+	if i != nil {
+		leuo.SetBalance(*i)
+	}
+	return leuo
+}
+
+// AddBalance adds i to the "balance" field.
+func (leuo *LedgerEntryUpdateOne) AddBalance(i int64) *LedgerEntryUpdateOne {
+	leuo.mutation.AddBalance(i)
+	return leuo
+}
+
+// SetReason sets the "reason" field.
+func (leuo *LedgerEntryUpdateOne) SetReason(s string) *LedgerEntryUpdateOne {
+	leuo.mutation.SetReason(s)
+	return leuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (leuo *LedgerEntryUpdateOne) SetNillableReason(s *string) *LedgerEntryUpdateOne {
+	if s != nil {
+		leuo.SetReason(*s)
+	}
+	return leuo
+}
+
+// SetReference sets the "reference" field.
+func (leuo *LedgerEntryUpdateOne) SetReference(s string) *LedgerEntryUpdateOne {
+	leuo.mutation.SetReference(s)
+	return leuo
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (leuo *LedgerEntryUpdateOne) SetNillableReference(s *string) *LedgerEntryUpdateOne {
+	if s != nil {
+		leuo.SetReference(*s)
+	}
+	return leuo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (leuo *LedgerEntryUpdateOne) SetPlayer(p *Player) *LedgerEntryUpdateOne {
+	return leuo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (leuo *LedgerEntryUpdateOne) Mutation() *LedgerEntryMutation {
+	return leuo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (leuo *LedgerEntryUpdateOne) ClearPlayer() *LedgerEntryUpdateOne {
+	leuo.mutation.ClearPlayer()
+	return leuo
+}
+
+// Where appends a list predicates to the LedgerEntryUpdate builder.
+func (leuo *LedgerEntryUpdateOne) Where(ps ...predicate.LedgerEntry) *LedgerEntryUpdateOne {
+	leuo.mutation.Where(ps...)
+	return leuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (leuo *LedgerEntryUpdateOne) Select(field string, fields ...string) *LedgerEntryUpdateOne {
+	leuo.fields = append([]string{field}, fields...)
+	return leuo
+}
+
+// Save executes the query and returns the updated LedgerEntry entity.
+func (leuo *LedgerEntryUpdateOne) Save(ctx context.Context) (*LedgerEntry, error) {
+	return withHooks(ctx, leuo.sqlSave, leuo.mutation, leuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leuo *LedgerEntryUpdateOne) SaveX(ctx context.Context) *LedgerEntry {
+	node, err := leuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (leuo *LedgerEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := leuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leuo *LedgerEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := leuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (leuo *LedgerEntryUpdateOne) check() error {
+	if leuo.mutation.PlayerCleared() && len(leuo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "LedgerEntry.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (leuo *LedgerEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LedgerEntryUpdateOne {
+	leuo.modifiers = append(leuo.modifiers, modifiers...)
+	return leuo
+}
+
+func (leuo *LedgerEntryUpdateOne) sqlSave(ctx context.Context) (_node *LedgerEntry, err error) {
+	if err := leuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ledgerentry.Table, ledgerentry.Columns, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64))
+	id, ok := leuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "LedgerEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := leuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ledgerentry.FieldID)
+		for _, f := range fields {
+			if !ledgerentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != ledgerentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := leuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := leuo.mutation.AssetType(); ok {
+		_spec.SetField(ledgerentry.FieldAssetType, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.Asset(); ok {
+		_spec.SetField(ledgerentry.FieldAsset, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.Delta(); ok {
+		_spec.SetField(ledgerentry.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.AddedDelta(); ok {
+		_spec.AddField(ledgerentry.FieldDelta, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.Balance(); ok {
+		_spec.SetField(ledgerentry.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.AddedBalance(); ok {
+		_spec.AddField(ledgerentry.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.Reason(); ok {
+		_spec.SetField(ledgerentry.FieldReason, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.Reference(); ok {
+		_spec.SetField(ledgerentry.FieldReference, field.TypeString, value)
+	}
+	if leuo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerentry.PlayerTable,
+			Columns: []string{ledgerentry.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := leuo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ledgerentry.PlayerTable,
+			Columns: []string{ledgerentry.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(leuo.modifiers...)
+	_node = &LedgerEntry{config: leuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, leuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ledgerentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	leuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+)
+
+// LiveEvent is the model entity for the LiveEvent schema.
+type LiveEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt int64 `json:"start_at,omitempty"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt int64 `json:"end_at,omitempty"`
+	// ItemIds holds the value of the "item_ids" field.
+	ItemIds []string `json:"item_ids,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LiveEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case liveevent.FieldItemIds:
+			values[i] = new([]byte)
+		case liveevent.FieldStartAt, liveevent.FieldEndAt, liveevent.FieldCreatedAt, liveevent.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case liveevent.FieldID, liveevent.FieldKind, liveevent.FieldName, liveevent.FieldDescription, liveevent.FieldTimezone, liveevent.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LiveEvent fields.
+func (le *LiveEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case liveevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				le.ID = value.String
+			}
+		case liveevent.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				le.Kind = value.String
+			}
+		case liveevent.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				le.Name = value.String
+			}
+		case liveevent.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				le.Description = value.String
+			}
+		case liveevent.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				le.Timezone = value.String
+			}
+		case liveevent.FieldStartAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				le.StartAt = value.Int64
+			}
+		case liveevent.FieldEndAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				le.EndAt = value.Int64
+			}
+		case liveevent.FieldItemIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field item_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &le.ItemIds); err != nil {
+					return fmt.Errorf("unmarshal field item_ids: %w", err)
+				}
+			}
+		case liveevent.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				le.CreatedBy = value.String
+			}
+		case liveevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				le.CreatedAt = value.Int64
+			}
+		case liveevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				le.UpdatedAt = value.Int64
+			}
+		default:
+			le.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LiveEvent.
+// This includes values selected through modifiers, order, etc.
+func (le *LiveEvent) Value(name string) (ent.Value, error) {
+	return le.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LiveEvent.
+// Note that you need to call LiveEvent.Unwrap() before calling this method if this LiveEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (le *LiveEvent) Update() *LiveEventUpdateOne {
+	return NewLiveEventClient(le.config).UpdateOne(le)
+}
+
+// Unwrap unwraps the LiveEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (le *LiveEvent) Unwrap() *LiveEvent {
+	_tx, ok := le.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: LiveEvent is not a transactional entity")
+	}
+	le.config.driver = _tx.drv
+	return le
+}
+
+// String implements the fmt.Stringer.
+func (le *LiveEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("LiveEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", le.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(le.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(le.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(le.Description)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(le.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(fmt.Sprintf("%v", le.StartAt))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(fmt.Sprintf("%v", le.EndAt))
+	builder.WriteString(", ")
+	builder.WriteString("item_ids=")
+	builder.WriteString(fmt.Sprintf("%v", le.ItemIds))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(le.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", le.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", le.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LiveEvents is a parsable slice of LiveEvent.
+type LiveEvents []*LiveEvent
@@ -0,0 +1,1070 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+)
+
+// LiveEventCreate is the builder for creating a LiveEvent entity.
+type LiveEventCreate struct {
+	config
+	mutation *LiveEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKind sets the "kind" field.
+func (lec *LiveEventCreate) SetKind(s string) *LiveEventCreate {
+	lec.mutation.SetKind(s)
+	return lec
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (lec *LiveEventCreate) SetNillableKind(s *string) *LiveEventCreate {
+	if s != nil {
+		lec.SetKind(*s)
+	}
+	return lec
+}
+
+// SetName sets the "name" field.
+func (lec *LiveEventCreate) SetName(s string) *LiveEventCreate {
+	lec.mutation.SetName(s)
+	return lec
+}
+
+// SetDescription sets the "description" field.
+func (lec *LiveEventCreate) SetDescription(s string) *LiveEventCreate {
+	lec.mutation.SetDescription(s)
+	return lec
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (lec *LiveEventCreate) SetNillableDescription(s *string) *LiveEventCreate {
+	if s != nil {
+		lec.SetDescription(*s)
+	}
+	return lec
+}
+
+// SetTimezone sets the "timezone" field.
+func (lec *LiveEventCreate) SetTimezone(s string) *LiveEventCreate {
+	lec.mutation.SetTimezone(s)
+	return lec
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (lec *LiveEventCreate) SetNillableTimezone(s *string) *LiveEventCreate {
+	if s != nil {
+		lec.SetTimezone(*s)
+	}
+	return lec
+}
+
+// SetStartAt sets the "start_at" field.
+func (lec *LiveEventCreate) SetStartAt(i int64) *LiveEventCreate {
+	lec.mutation.SetStartAt(i)
+	return lec
+}
+
+// SetEndAt sets the "end_at" field.
+func (lec *LiveEventCreate) SetEndAt(i int64) *LiveEventCreate {
+	lec.mutation.SetEndAt(i)
+	return lec
+}
+
+// SetItemIds sets the "item_ids" field.
+func (lec *LiveEventCreate) SetItemIds(s []string) *LiveEventCreate {
+	lec.mutation.SetItemIds(s)
+	return lec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (lec *LiveEventCreate) SetCreatedBy(s string) *LiveEventCreate {
+	lec.mutation.SetCreatedBy(s)
+	return lec
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (lec *LiveEventCreate) SetNillableCreatedBy(s *string) *LiveEventCreate {
+	if s != nil {
+		lec.SetCreatedBy(*s)
+	}
+	return lec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lec *LiveEventCreate) SetCreatedAt(i int64) *LiveEventCreate {
+	lec.mutation.SetCreatedAt(i)
+	return lec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lec *LiveEventCreate) SetNillableCreatedAt(i *int64) *LiveEventCreate {
+	if i != nil {
+		lec.SetCreatedAt(*i)
+	}
+	return lec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (lec *LiveEventCreate) SetUpdatedAt(i int64) *LiveEventCreate {
+	lec.mutation.SetUpdatedAt(i)
+	return lec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (lec *LiveEventCreate) SetNillableUpdatedAt(i *int64) *LiveEventCreate {
+	if i != nil {
+		lec.SetUpdatedAt(*i)
+	}
+	return lec
+}
+
+// SetID sets the "id" field.
+func (lec *LiveEventCreate) SetID(s string) *LiveEventCreate {
+	lec.mutation.SetID(s)
+	return lec
+}
+
+// Mutation returns the LiveEventMutation object of the builder.
+func (lec *LiveEventCreate) Mutation() *LiveEventMutation {
+	return lec.mutation
+}
+
+// Save creates the LiveEvent in the database.
+func (lec *LiveEventCreate) Save(ctx context.Context) (*LiveEvent, error) {
+	lec.defaults()
+	return withHooks(ctx, lec.sqlSave, lec.mutation, lec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lec *LiveEventCreate) SaveX(ctx context.Context) *LiveEvent {
+	v, err := lec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lec *LiveEventCreate) Exec(ctx context.Context) error {
+	_, err := lec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lec *LiveEventCreate) ExecX(ctx context.Context) {
+	if err := lec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lec *LiveEventCreate) defaults() {
+	if _, ok := lec.mutation.Kind(); !ok {
+		v := liveevent.DefaultKind
+		lec.mutation.SetKind(v)
+	}
+	if _, ok := lec.mutation.Description(); !ok {
+		v := liveevent.DefaultDescription
+		lec.mutation.SetDescription(v)
+	}
+	if _, ok := lec.mutation.Timezone(); !ok {
+		v := liveevent.DefaultTimezone
+		lec.mutation.SetTimezone(v)
+	}
+	if _, ok := lec.mutation.CreatedBy(); !ok {
+		v := liveevent.DefaultCreatedBy
+		lec.mutation.SetCreatedBy(v)
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		v := liveevent.DefaultCreatedAt()
+		lec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := lec.mutation.UpdatedAt(); !ok {
+		v := liveevent.DefaultUpdatedAt()
+		lec.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lec *LiveEventCreate) check() error {
+	if _, ok := lec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`entc: missing required field "LiveEvent.kind"`)}
+	}
+	if _, ok := lec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entc: missing required field "LiveEvent.name"`)}
+	}
+	if _, ok := lec.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`entc: missing required field "LiveEvent.description"`)}
+	}
+	if _, ok := lec.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`entc: missing required field "LiveEvent.timezone"`)}
+	}
+	if _, ok := lec.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`entc: missing required field "LiveEvent.start_at"`)}
+	}
+	if _, ok := lec.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`entc: missing required field "LiveEvent.end_at"`)}
+	}
+	if _, ok := lec.mutation.ItemIds(); !ok {
+		return &ValidationError{Name: "item_ids", err: errors.New(`entc: missing required field "LiveEvent.item_ids"`)}
+	}
+	if _, ok := lec.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`entc: missing required field "LiveEvent.created_by"`)}
+	}
+	if _, ok := lec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "LiveEvent.created_at"`)}
+	}
+	if _, ok := lec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "LiveEvent.updated_at"`)}
+	}
+	return nil
+}
+
+func (lec *LiveEventCreate) sqlSave(ctx context.Context) (*LiveEvent, error) {
+	if err := lec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LiveEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lec.mutation.id = &_node.ID
+	lec.mutation.done = true
+	return _node, nil
+}
+
+func (lec *LiveEventCreate) createSpec() (*LiveEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LiveEvent{config: lec.config}
+		_spec = sqlgraph.NewCreateSpec(liveevent.Table, sqlgraph.NewFieldSpec(liveevent.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = lec.conflict
+	if id, ok := lec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lec.mutation.Kind(); ok {
+		_spec.SetField(liveevent.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := lec.mutation.Name(); ok {
+		_spec.SetField(liveevent.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := lec.mutation.Description(); ok {
+		_spec.SetField(liveevent.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := lec.mutation.Timezone(); ok {
+		_spec.SetField(liveevent.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := lec.mutation.StartAt(); ok {
+		_spec.SetField(liveevent.FieldStartAt, field.TypeInt64, value)
+		_node.StartAt = value
+	}
+	if value, ok := lec.mutation.EndAt(); ok {
+		_spec.SetField(liveevent.FieldEndAt, field.TypeInt64, value)
+		_node.EndAt = value
+	}
+	if value, ok := lec.mutation.ItemIds(); ok {
+		_spec.SetField(liveevent.FieldItemIds, field.TypeJSON, value)
+		_node.ItemIds = value
+	}
+	if value, ok := lec.mutation.CreatedBy(); ok {
+		_spec.SetField(liveevent.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := lec.mutation.CreatedAt(); ok {
+		_spec.SetField(liveevent.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := lec.mutation.UpdatedAt(); ok {
+		_spec.SetField(liveevent.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiveEvent.Create().
+//		SetKind(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiveEventUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (lec *LiveEventCreate) OnConflict(opts ...sql.ConflictOption) *LiveEventUpsertOne {
+	lec.conflict = opts
+	return &LiveEventUpsertOne{
+		create: lec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiveEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lec *LiveEventCreate) OnConflictColumns(columns ...string) *LiveEventUpsertOne {
+	lec.conflict = append(lec.conflict, sql.ConflictColumns(columns...))
+	return &LiveEventUpsertOne{
+		create: lec,
+	}
+}
+
+type (
+	// LiveEventUpsertOne is the builder for "upsert"-ing
+	//  one LiveEvent node.
+	LiveEventUpsertOne struct {
+		create *LiveEventCreate
+	}
+
+	// LiveEventUpsert is the "OnConflict" setter.
+	LiveEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetKind sets the "kind" field.
+func (u *LiveEventUpsert) SetKind(v string) *LiveEventUpsert {
+	u.Set(liveevent.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateKind() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldKind)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LiveEventUpsert) SetName(v string) *LiveEventUpsert {
+	u.Set(liveevent.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateName() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *LiveEventUpsert) SetDescription(v string) *LiveEventUpsert {
+	u.Set(liveevent.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateDescription() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldDescription)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LiveEventUpsert) SetTimezone(v string) *LiveEventUpsert {
+	u.Set(liveevent.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateTimezone() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldTimezone)
+	return u
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *LiveEventUpsert) SetStartAt(v int64) *LiveEventUpsert {
+	u.Set(liveevent.FieldStartAt, v)
+	return u
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateStartAt() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldStartAt)
+	return u
+}
+
+// AddStartAt adds v to the "start_at" field.
+func (u *LiveEventUpsert) AddStartAt(v int64) *LiveEventUpsert {
+	u.Add(liveevent.FieldStartAt, v)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *LiveEventUpsert) SetEndAt(v int64) *LiveEventUpsert {
+	u.Set(liveevent.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateEndAt() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldEndAt)
+	return u
+}
+
+// AddEndAt adds v to the "end_at" field.
+func (u *LiveEventUpsert) AddEndAt(v int64) *LiveEventUpsert {
+	u.Add(liveevent.FieldEndAt, v)
+	return u
+}
+
+// SetItemIds sets the "item_ids" field.
+func (u *LiveEventUpsert) SetItemIds(v []string) *LiveEventUpsert {
+	u.Set(liveevent.FieldItemIds, v)
+	return u
+}
+
+// UpdateItemIds sets the "item_ids" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateItemIds() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldItemIds)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LiveEventUpsert) SetCreatedBy(v string) *LiveEventUpsert {
+	u.Set(liveevent.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateCreatedBy() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiveEventUpsert) SetUpdatedAt(v int64) *LiveEventUpsert {
+	u.Set(liveevent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiveEventUpsert) UpdateUpdatedAt() *LiveEventUpsert {
+	u.SetExcluded(liveevent.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *LiveEventUpsert) AddUpdatedAt(v int64) *LiveEventUpsert {
+	u.Add(liveevent.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LiveEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(liveevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiveEventUpsertOne) UpdateNewValues() *LiveEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(liveevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(liveevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiveEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LiveEventUpsertOne) Ignore() *LiveEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiveEventUpsertOne) DoNothing() *LiveEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiveEventCreate.OnConflict
+// documentation for more info.
+func (u *LiveEventUpsertOne) Update(set func(*LiveEventUpsert)) *LiveEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiveEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *LiveEventUpsertOne) SetKind(v string) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateKind() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LiveEventUpsertOne) SetName(v string) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateName() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LiveEventUpsertOne) SetDescription(v string) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateDescription() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LiveEventUpsertOne) SetTimezone(v string) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateTimezone() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *LiveEventUpsertOne) SetStartAt(v int64) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// AddStartAt adds v to the "start_at" field.
+func (u *LiveEventUpsertOne) AddStartAt(v int64) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.AddStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateStartAt() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *LiveEventUpsertOne) SetEndAt(v int64) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// AddEndAt adds v to the "end_at" field.
+func (u *LiveEventUpsertOne) AddEndAt(v int64) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.AddEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateEndAt() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetItemIds sets the "item_ids" field.
+func (u *LiveEventUpsertOne) SetItemIds(v []string) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetItemIds(v)
+	})
+}
+
+// UpdateItemIds sets the "item_ids" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateItemIds() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateItemIds()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LiveEventUpsertOne) SetCreatedBy(v string) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateCreatedBy() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiveEventUpsertOne) SetUpdatedAt(v int64) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *LiveEventUpsertOne) AddUpdatedAt(v int64) *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiveEventUpsertOne) UpdateUpdatedAt() *LiveEventUpsertOne {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LiveEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LiveEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiveEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LiveEventUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entc: LiveEventUpsertOne.ID is not supported by MySQL driver. Use LiveEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LiveEventUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LiveEventCreateBulk is the builder for creating many LiveEvent entities in bulk.
+type LiveEventCreateBulk struct {
+	config
+	err      error
+	builders []*LiveEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LiveEvent entities in the database.
+func (lecb *LiveEventCreateBulk) Save(ctx context.Context) ([]*LiveEvent, error) {
+	if lecb.err != nil {
+		return nil, lecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lecb.builders))
+	nodes := make([]*LiveEvent, len(lecb.builders))
+	mutators := make([]Mutator, len(lecb.builders))
+	for i := range lecb.builders {
+		func(i int, root context.Context) {
+			builder := lecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LiveEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = lecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lecb *LiveEventCreateBulk) SaveX(ctx context.Context) []*LiveEvent {
+	v, err := lecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lecb *LiveEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := lecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lecb *LiveEventCreateBulk) ExecX(ctx context.Context) {
+	if err := lecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LiveEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LiveEventUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (lecb *LiveEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *LiveEventUpsertBulk {
+	lecb.conflict = opts
+	return &LiveEventUpsertBulk{
+		create: lecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LiveEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (lecb *LiveEventCreateBulk) OnConflictColumns(columns ...string) *LiveEventUpsertBulk {
+	lecb.conflict = append(lecb.conflict, sql.ConflictColumns(columns...))
+	return &LiveEventUpsertBulk{
+		create: lecb,
+	}
+}
+
+// LiveEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of LiveEvent nodes.
+type LiveEventUpsertBulk struct {
+	create *LiveEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LiveEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(liveevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LiveEventUpsertBulk) UpdateNewValues() *LiveEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(liveevent.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(liveevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LiveEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LiveEventUpsertBulk) Ignore() *LiveEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LiveEventUpsertBulk) DoNothing() *LiveEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LiveEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *LiveEventUpsertBulk) Update(set func(*LiveEventUpsert)) *LiveEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LiveEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *LiveEventUpsertBulk) SetKind(v string) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateKind() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LiveEventUpsertBulk) SetName(v string) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateName() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LiveEventUpsertBulk) SetDescription(v string) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateDescription() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *LiveEventUpsertBulk) SetTimezone(v string) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateTimezone() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetStartAt sets the "start_at" field.
+func (u *LiveEventUpsertBulk) SetStartAt(v int64) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetStartAt(v)
+	})
+}
+
+// AddStartAt adds v to the "start_at" field.
+func (u *LiveEventUpsertBulk) AddStartAt(v int64) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.AddStartAt(v)
+	})
+}
+
+// UpdateStartAt sets the "start_at" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateStartAt() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateStartAt()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *LiveEventUpsertBulk) SetEndAt(v int64) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// AddEndAt adds v to the "end_at" field.
+func (u *LiveEventUpsertBulk) AddEndAt(v int64) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.AddEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateEndAt() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetItemIds sets the "item_ids" field.
+func (u *LiveEventUpsertBulk) SetItemIds(v []string) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetItemIds(v)
+	})
+}
+
+// UpdateItemIds sets the "item_ids" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateItemIds() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateItemIds()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *LiveEventUpsertBulk) SetCreatedBy(v string) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateCreatedBy() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *LiveEventUpsertBulk) SetUpdatedAt(v int64) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *LiveEventUpsertBulk) AddUpdatedAt(v int64) *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *LiveEventUpsertBulk) UpdateUpdatedAt() *LiveEventUpsertBulk {
+	return u.Update(func(s *LiveEventUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *LiveEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the LiveEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for LiveEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LiveEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// LiveEventQuery is the builder for querying LiveEvent entities.
+type LiveEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []liveevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.LiveEvent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LiveEventQuery builder.
+func (leq *LiveEventQuery) Where(ps ...predicate.LiveEvent) *LiveEventQuery {
+	leq.predicates = append(leq.predicates, ps...)
+	return leq
+}
+
+// Limit the number of records to be returned by this query.
+func (leq *LiveEventQuery) Limit(limit int) *LiveEventQuery {
+	leq.ctx.Limit = &limit
+	return leq
+}
+
+// Offset to start from.
+func (leq *LiveEventQuery) Offset(offset int) *LiveEventQuery {
+	leq.ctx.Offset = &offset
+	return leq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (leq *LiveEventQuery) Unique(unique bool) *LiveEventQuery {
+	leq.ctx.Unique = &unique
+	return leq
+}
+
+// Order specifies how the records should be ordered.
+func (leq *LiveEventQuery) Order(o ...liveevent.OrderOption) *LiveEventQuery {
+	leq.order = append(leq.order, o...)
+	return leq
+}
+
+// First returns the first LiveEvent entity from the query.
+// Returns a *NotFoundError when no LiveEvent was found.
+func (leq *LiveEventQuery) First(ctx context.Context) (*LiveEvent, error) {
+	nodes, err := leq.Limit(1).All(setContextOp(ctx, leq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{liveevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (leq *LiveEventQuery) FirstX(ctx context.Context) *LiveEvent {
+	node, err := leq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LiveEvent ID from the query.
+// Returns a *NotFoundError when no LiveEvent ID was found.
+func (leq *LiveEventQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = leq.Limit(1).IDs(setContextOp(ctx, leq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{liveevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (leq *LiveEventQuery) FirstIDX(ctx context.Context) string {
+	id, err := leq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LiveEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LiveEvent entity is found.
+// Returns a *NotFoundError when no LiveEvent entities are found.
+func (leq *LiveEventQuery) Only(ctx context.Context) (*LiveEvent, error) {
+	nodes, err := leq.Limit(2).All(setContextOp(ctx, leq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{liveevent.Label}
+	default:
+		return nil, &NotSingularError{liveevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (leq *LiveEventQuery) OnlyX(ctx context.Context) *LiveEvent {
+	node, err := leq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LiveEvent ID in the query.
+// Returns a *NotSingularError when more than one LiveEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (leq *LiveEventQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = leq.Limit(2).IDs(setContextOp(ctx, leq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{liveevent.Label}
+	default:
+		err = &NotSingularError{liveevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (leq *LiveEventQuery) OnlyIDX(ctx context.Context) string {
+	id, err := leq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LiveEvents.
+func (leq *LiveEventQuery) All(ctx context.Context) ([]*LiveEvent, error) {
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryAll)
+	if err := leq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LiveEvent, *LiveEventQuery]()
+	return withInterceptors[[]*LiveEvent](ctx, leq, qr, leq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (leq *LiveEventQuery) AllX(ctx context.Context) []*LiveEvent {
+	nodes, err := leq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LiveEvent IDs.
+func (leq *LiveEventQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if leq.ctx.Unique == nil && leq.path != nil {
+		leq.Unique(true)
+	}
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryIDs)
+	if err = leq.Select(liveevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (leq *LiveEventQuery) IDsX(ctx context.Context) []string {
+	ids, err := leq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (leq *LiveEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryCount)
+	if err := leq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, leq, querierCount[*LiveEventQuery](), leq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (leq *LiveEventQuery) CountX(ctx context.Context) int {
+	count, err := leq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (leq *LiveEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, leq.ctx, ent.OpQueryExist)
+	switch _, err := leq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (leq *LiveEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := leq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LiveEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (leq *LiveEventQuery) Clone() *LiveEventQuery {
+	if leq == nil {
+		return nil
+	}
+	return &LiveEventQuery{
+		config:     leq.config,
+		ctx:        leq.ctx.Clone(),
+		order:      append([]liveevent.OrderOption{}, leq.order...),
+		inters:     append([]Interceptor{}, leq.inters...),
+		predicates: append([]predicate.LiveEvent{}, leq.predicates...),
+		// clone intermediate query.
+		sql:       leq.sql.Clone(),
+		path:      leq.path,
+		modifiers: append([]func(*sql.Selector){}, leq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind string `json:"kind,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LiveEvent.Query().
+//		GroupBy(liveevent.FieldKind).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (leq *LiveEventQuery) GroupBy(field string, fields ...string) *LiveEventGroupBy {
+	leq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LiveEventGroupBy{build: leq}
+	grbuild.flds = &leq.ctx.Fields
+	grbuild.label = liveevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind string `json:"kind,omitempty"`
+//	}
+//
+//	client.LiveEvent.Query().
+//		Select(liveevent.FieldKind).
+//		Scan(ctx, &v)
+func (leq *LiveEventQuery) Select(fields ...string) *LiveEventSelect {
+	leq.ctx.Fields = append(leq.ctx.Fields, fields...)
+	sbuild := &LiveEventSelect{LiveEventQuery: leq}
+	sbuild.label = liveevent.Label
+	sbuild.flds, sbuild.scan = &leq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LiveEventSelect configured with the given aggregations.
+func (leq *LiveEventQuery) Aggregate(fns ...AggregateFunc) *LiveEventSelect {
+	return leq.Select().Aggregate(fns...)
+}
+
+func (leq *LiveEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range leq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, leq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range leq.ctx.Fields {
+		if !liveevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if leq.path != nil {
+		prev, err := leq.path(ctx)
+		if err != nil {
+			return err
+		}
+		leq.sql = prev
+	}
+	return nil
+}
+
+func (leq *LiveEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LiveEvent, error) {
+	var (
+		nodes = []*LiveEvent{}
+		_spec = leq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LiveEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LiveEvent{config: leq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(leq.modifiers) > 0 {
+		_spec.Modifiers = leq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, leq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (leq *LiveEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := leq.querySpec()
+	if len(leq.modifiers) > 0 {
+		_spec.Modifiers = leq.modifiers
+	}
+	_spec.Node.Columns = leq.ctx.Fields
+	if len(leq.ctx.Fields) > 0 {
+		_spec.Unique = leq.ctx.Unique != nil && *leq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, leq.driver, _spec)
+}
+
+func (leq *LiveEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(liveevent.Table, liveevent.Columns, sqlgraph.NewFieldSpec(liveevent.FieldID, field.TypeString))
+	_spec.From = leq.sql
+	if unique := leq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if leq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := leq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, liveevent.FieldID)
+		for i := range fields {
+			if fields[i] != liveevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := leq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := leq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := leq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := leq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (leq *LiveEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(leq.driver.Dialect())
+	t1 := builder.Table(liveevent.Table)
+	columns := leq.ctx.Fields
+	if len(columns) == 0 {
+		columns = liveevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if leq.sql != nil {
+		selector = leq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if leq.ctx.Unique != nil && *leq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range leq.modifiers {
+		m(selector)
+	}
+	for _, p := range leq.predicates {
+		p(selector)
+	}
+	for _, p := range leq.order {
+		p(selector)
+	}
+	if offset := leq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := leq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (leq *LiveEventQuery) Modify(modifiers ...func(s *sql.Selector)) *LiveEventSelect {
+	leq.modifiers = append(leq.modifiers, modifiers...)
+	return leq.Select()
+}
+
+// LiveEventGroupBy is the group-by builder for LiveEvent entities.
+type LiveEventGroupBy struct {
+	selector
+	build *LiveEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (legb *LiveEventGroupBy) Aggregate(fns ...AggregateFunc) *LiveEventGroupBy {
+	legb.fns = append(legb.fns, fns...)
+	return legb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (legb *LiveEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, legb.build.ctx, ent.OpQueryGroupBy)
+	if err := legb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LiveEventQuery, *LiveEventGroupBy](ctx, legb.build, legb, legb.build.inters, v)
+}
+
+func (legb *LiveEventGroupBy) sqlScan(ctx context.Context, root *LiveEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(legb.fns))
+	for _, fn := range legb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*legb.flds)+len(legb.fns))
+		for _, f := range *legb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*legb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := legb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LiveEventSelect is the builder for selecting fields of LiveEvent entities.
+type LiveEventSelect struct {
+	*LiveEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (les *LiveEventSelect) Aggregate(fns ...AggregateFunc) *LiveEventSelect {
+	les.fns = append(les.fns, fns...)
+	return les
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (les *LiveEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, les.ctx, ent.OpQuerySelect)
+	if err := les.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LiveEventQuery, *LiveEventSelect](ctx, les.LiveEventQuery, les, les.inters, v)
+}
+
+func (les *LiveEventSelect) sqlScan(ctx context.Context, root *LiveEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(les.fns))
+	for _, fn := range les.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*les.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := les.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (les *LiveEventSelect) Modify(modifiers ...func(s *sql.Selector)) *LiveEventSelect {
+	les.modifiers = append(les.modifiers, modifiers...)
+	return les
+}
@@ -0,0 +1,566 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// LiveEventUpdate is the builder for updating LiveEvent entities.
+type LiveEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LiveEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LiveEventUpdate builder.
+func (leu *LiveEventUpdate) Where(ps ...predicate.LiveEvent) *LiveEventUpdate {
+	leu.mutation.Where(ps...)
+	return leu
+}
+
+// SetKind sets the "kind" field.
+func (leu *LiveEventUpdate) SetKind(s string) *LiveEventUpdate {
+	leu.mutation.SetKind(s)
+	return leu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (leu *LiveEventUpdate) SetNillableKind(s *string) *LiveEventUpdate {
+	if s != nil {
+		leu.SetKind(*s)
+	}
+	return leu
+}
+
+// SetName sets the "name" field.
+func (leu *LiveEventUpdate) SetName(s string) *LiveEventUpdate {
+	leu.mutation.SetName(s)
+	return leu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (leu *LiveEventUpdate) SetNillableName(s *string) *LiveEventUpdate {
+	if s != nil {
+		leu.SetName(*s)
+	}
+	return leu
+}
+
+// SetDescription sets the "description" field.
+func (leu *LiveEventUpdate) SetDescription(s string) *LiveEventUpdate {
+	leu.mutation.SetDescription(s)
+	return leu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (leu *LiveEventUpdate) SetNillableDescription(s *string) *LiveEventUpdate {
+	if s != nil {
+		leu.SetDescription(*s)
+	}
+	return leu
+}
+
+// SetTimezone sets the "timezone" field.
+func (leu *LiveEventUpdate) SetTimezone(s string) *LiveEventUpdate {
+	leu.mutation.SetTimezone(s)
+	return leu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (leu *LiveEventUpdate) SetNillableTimezone(s *string) *LiveEventUpdate {
+	if s != nil {
+		leu.SetTimezone(*s)
+	}
+	return leu
+}
+
+// SetStartAt sets the "start_at" field.
+func (leu *LiveEventUpdate) SetStartAt(i int64) *LiveEventUpdate {
+	leu.mutation.ResetStartAt()
+	leu.mutation.SetStartAt(i)
+	return leu
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (leu *LiveEventUpdate) SetNillableStartAt(i *int64) *LiveEventUpdate {
+	if i != nil {
+		leu.SetStartAt(*i)
+	}
+	return leu
+}
+
+// AddStartAt adds i to the "start_at" field.
+func (leu *LiveEventUpdate) AddStartAt(i int64) *LiveEventUpdate {
+	leu.mutation.AddStartAt(i)
+	return leu
+}
+
+// SetEndAt sets the "end_at" field.
+func (leu *LiveEventUpdate) SetEndAt(i int64) *LiveEventUpdate {
+	leu.mutation.ResetEndAt()
+	leu.mutation.SetEndAt(i)
+	return leu
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (leu *LiveEventUpdate) SetNillableEndAt(i *int64) *LiveEventUpdate {
+	if i != nil {
+		leu.SetEndAt(*i)
+	}
+	return leu
+}
+
+// AddEndAt adds i to the "end_at" field.
+func (leu *LiveEventUpdate) AddEndAt(i int64) *LiveEventUpdate {
+	leu.mutation.AddEndAt(i)
+	return leu
+}
+
+// SetItemIds sets the "item_ids" field.
+func (leu *LiveEventUpdate) SetItemIds(s []string) *LiveEventUpdate {
+	leu.mutation.SetItemIds(s)
+	return leu
+}
+
+// AppendItemIds appends s to the "item_ids" field.
+func (leu *LiveEventUpdate) AppendItemIds(s []string) *LiveEventUpdate {
+	leu.mutation.AppendItemIds(s)
+	return leu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (leu *LiveEventUpdate) SetCreatedBy(s string) *LiveEventUpdate {
+	leu.mutation.SetCreatedBy(s)
+	return leu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (leu *LiveEventUpdate) SetNillableCreatedBy(s *string) *LiveEventUpdate {
+	if s != nil {
+		leu.SetCreatedBy(*s)
+	}
+	return leu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (leu *LiveEventUpdate) SetUpdatedAt(i int64) *LiveEventUpdate {
+	leu.mutation.ResetUpdatedAt()
+	leu.mutation.SetUpdatedAt(i)
+	return leu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (leu *LiveEventUpdate) AddUpdatedAt(i int64) *LiveEventUpdate {
+	leu.mutation.AddUpdatedAt(i)
+	return leu
+}
+
+// Mutation returns the LiveEventMutation object of the builder.
+func (leu *LiveEventUpdate) Mutation() *LiveEventMutation {
+	return leu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (leu *LiveEventUpdate) Save(ctx context.Context) (int, error) {
+	leu.defaults()
+	return withHooks(ctx, leu.sqlSave, leu.mutation, leu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leu *LiveEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := leu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (leu *LiveEventUpdate) Exec(ctx context.Context) error {
+	_, err := leu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leu *LiveEventUpdate) ExecX(ctx context.Context) {
+	if err := leu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (leu *LiveEventUpdate) defaults() {
+	if _, ok := leu.mutation.UpdatedAt(); !ok {
+		v := liveevent.UpdateDefaultUpdatedAt()
+		leu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (leu *LiveEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LiveEventUpdate {
+	leu.modifiers = append(leu.modifiers, modifiers...)
+	return leu
+}
+
+func (leu *LiveEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(liveevent.Table, liveevent.Columns, sqlgraph.NewFieldSpec(liveevent.FieldID, field.TypeString))
+	if ps := leu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := leu.mutation.Kind(); ok {
+		_spec.SetField(liveevent.FieldKind, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.Name(); ok {
+		_spec.SetField(liveevent.FieldName, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.Description(); ok {
+		_spec.SetField(liveevent.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.Timezone(); ok {
+		_spec.SetField(liveevent.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.StartAt(); ok {
+		_spec.SetField(liveevent.FieldStartAt, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.AddedStartAt(); ok {
+		_spec.AddField(liveevent.FieldStartAt, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.EndAt(); ok {
+		_spec.SetField(liveevent.FieldEndAt, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.AddedEndAt(); ok {
+		_spec.AddField(liveevent.FieldEndAt, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.ItemIds(); ok {
+		_spec.SetField(liveevent.FieldItemIds, field.TypeJSON, value)
+	}
+	if value, ok := leu.mutation.AppendedItemIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, liveevent.FieldItemIds, value)
+		})
+	}
+	if value, ok := leu.mutation.CreatedBy(); ok {
+		_spec.SetField(liveevent.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := leu.mutation.UpdatedAt(); ok {
+		_spec.SetField(liveevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := leu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(liveevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(leu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, leu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{liveevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	leu.mutation.done = true
+	return n, nil
+}
+
+// LiveEventUpdateOne is the builder for updating a single LiveEvent entity.
+type LiveEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LiveEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKind sets the "kind" field.
+func (leuo *LiveEventUpdateOne) SetKind(s string) *LiveEventUpdateOne {
+	leuo.mutation.SetKind(s)
+	return leuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (leuo *LiveEventUpdateOne) SetNillableKind(s *string) *LiveEventUpdateOne {
+	if s != nil {
+		leuo.SetKind(*s)
+	}
+	return leuo
+}
+
+// SetName sets the "name" field.
+func (leuo *LiveEventUpdateOne) SetName(s string) *LiveEventUpdateOne {
+	leuo.mutation.SetName(s)
+	return leuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (leuo *LiveEventUpdateOne) SetNillableName(s *string) *LiveEventUpdateOne {
+	if s != nil {
+		leuo.SetName(*s)
+	}
+	return leuo
+}
+
+// SetDescription sets the "description" field.
+func (leuo *LiveEventUpdateOne) SetDescription(s string) *LiveEventUpdateOne {
+	leuo.mutation.SetDescription(s)
+	return leuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (leuo *LiveEventUpdateOne) SetNillableDescription(s *string) *LiveEventUpdateOne {
+	if s != nil {
+		leuo.SetDescription(*s)
+	}
+	return leuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (leuo *LiveEventUpdateOne) SetTimezone(s string) *LiveEventUpdateOne {
+	leuo.mutation.SetTimezone(s)
+	return leuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (leuo *LiveEventUpdateOne) SetNillableTimezone(s *string) *LiveEventUpdateOne {
+	if s != nil {
+		leuo.SetTimezone(*s)
+	}
+	return leuo
+}
+
+// SetStartAt sets the "start_at" field.
+func (leuo *LiveEventUpdateOne) SetStartAt(i int64) *LiveEventUpdateOne {
+	leuo.mutation.ResetStartAt()
+	leuo.mutation.SetStartAt(i)
+	return leuo
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (leuo *LiveEventUpdateOne) SetNillableStartAt(i *int64) *LiveEventUpdateOne {
+	if i != nil {
+		leuo.SetStartAt(*i)
+	}
+	return leuo
+}
+
+// AddStartAt adds i to the "start_at" field.
+func (leuo *LiveEventUpdateOne) AddStartAt(i int64) *LiveEventUpdateOne {
+	leuo.mutation.AddStartAt(i)
+	return leuo
+}
+
+// SetEndAt sets the "end_at" field.
+func (leuo *LiveEventUpdateOne) SetEndAt(i int64) *LiveEventUpdateOne {
+	leuo.mutation.ResetEndAt()
+	leuo.mutation.SetEndAt(i)
+	return leuo
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (leuo *LiveEventUpdateOne) SetNillableEndAt(i *int64) *LiveEventUpdateOne {
+	if i != nil {
+		leuo.SetEndAt(*i)
+	}
+	return leuo
+}
+
+// AddEndAt adds i to the "end_at" field.
+func (leuo *LiveEventUpdateOne) AddEndAt(i int64) *LiveEventUpdateOne {
+	leuo.mutation.AddEndAt(i)
+	return leuo
+}
+
+// SetItemIds sets the "item_ids" field.
+func (leuo *LiveEventUpdateOne) SetItemIds(s []string) *LiveEventUpdateOne {
+	leuo.mutation.SetItemIds(s)
+	return leuo
+}
+
+// AppendItemIds appends s to the "item_ids" field.
+func (leuo *LiveEventUpdateOne) AppendItemIds(s []string) *LiveEventUpdateOne {
+	leuo.mutation.AppendItemIds(s)
+	return leuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (leuo *LiveEventUpdateOne) SetCreatedBy(s string) *LiveEventUpdateOne {
+	leuo.mutation.SetCreatedBy(s)
+	return leuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (leuo *LiveEventUpdateOne) SetNillableCreatedBy(s *string) *LiveEventUpdateOne {
+	if s != nil {
+		leuo.SetCreatedBy(*s)
+	}
+	return leuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (leuo *LiveEventUpdateOne) SetUpdatedAt(i int64) *LiveEventUpdateOne {
+	leuo.mutation.ResetUpdatedAt()
+	leuo.mutation.SetUpdatedAt(i)
+	return leuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (leuo *LiveEventUpdateOne) AddUpdatedAt(i int64) *LiveEventUpdateOne {
+	leuo.mutation.AddUpdatedAt(i)
+	return leuo
+}
+
+// Mutation returns the LiveEventMutation object of the builder.
+func (leuo *LiveEventUpdateOne) Mutation() *LiveEventMutation {
+	return leuo.mutation
+}
+
+// Where appends a list predicates to the LiveEventUpdate builder.
+func (leuo *LiveEventUpdateOne) Where(ps ...predicate.LiveEvent) *LiveEventUpdateOne {
+	leuo.mutation.Where(ps...)
+	return leuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (leuo *LiveEventUpdateOne) Select(field string, fields ...string) *LiveEventUpdateOne {
+	leuo.fields = append([]string{field}, fields...)
+	return leuo
+}
+
+// Save executes the query and returns the updated LiveEvent entity.
+func (leuo *LiveEventUpdateOne) Save(ctx context.Context) (*LiveEvent, error) {
+	leuo.defaults()
+	return withHooks(ctx, leuo.sqlSave, leuo.mutation, leuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (leuo *LiveEventUpdateOne) SaveX(ctx context.Context) *LiveEvent {
+	node, err := leuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (leuo *LiveEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := leuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (leuo *LiveEventUpdateOne) ExecX(ctx context.Context) {
+	if err := leuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (leuo *LiveEventUpdateOne) defaults() {
+	if _, ok := leuo.mutation.UpdatedAt(); !ok {
+		v := liveevent.UpdateDefaultUpdatedAt()
+		leuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (leuo *LiveEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LiveEventUpdateOne {
+	leuo.modifiers = append(leuo.modifiers, modifiers...)
+	return leuo
+}
+
+func (leuo *LiveEventUpdateOne) sqlSave(ctx context.Context) (_node *LiveEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(liveevent.Table, liveevent.Columns, sqlgraph.NewFieldSpec(liveevent.FieldID, field.TypeString))
+	id, ok := leuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "LiveEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := leuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, liveevent.FieldID)
+		for _, f := range fields {
+			if !liveevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != liveevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := leuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := leuo.mutation.Kind(); ok {
+		_spec.SetField(liveevent.FieldKind, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.Name(); ok {
+		_spec.SetField(liveevent.FieldName, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.Description(); ok {
+		_spec.SetField(liveevent.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.Timezone(); ok {
+		_spec.SetField(liveevent.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.StartAt(); ok {
+		_spec.SetField(liveevent.FieldStartAt, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.AddedStartAt(); ok {
+		_spec.AddField(liveevent.FieldStartAt, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.EndAt(); ok {
+		_spec.SetField(liveevent.FieldEndAt, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.AddedEndAt(); ok {
+		_spec.AddField(liveevent.FieldEndAt, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.ItemIds(); ok {
+		_spec.SetField(liveevent.FieldItemIds, field.TypeJSON, value)
+	}
+	if value, ok := leuo.mutation.AppendedItemIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, liveevent.FieldItemIds, value)
+		})
+	}
+	if value, ok := leuo.mutation.CreatedBy(); ok {
+		_spec.SetField(liveevent.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := leuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(liveevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := leuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(liveevent.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(leuo.modifiers...)
+	_node = &LiveEvent{config: leuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, leuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{liveevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	leuo.mutation.done = true
+	return _node, nil
+}
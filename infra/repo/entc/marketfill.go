@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+)
+
+// MarketFill is the model entity for the MarketFill schema.
+type MarketFill struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Price holds the value of the "price" field.
+	Price int64 `json:"price,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int64 `json:"quantity,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee int64 `json:"fee,omitempty"`
+	// SellOrderID holds the value of the "sell_order_id" field.
+	SellOrderID int64 `json:"sell_order_id,omitempty"`
+	// BuyOrderID holds the value of the "buy_order_id" field.
+	BuyOrderID int64 `json:"buy_order_id,omitempty"`
+	// SellerID holds the value of the "seller_id" field.
+	SellerID string `json:"seller_id,omitempty"`
+	// BuyerID holds the value of the "buyer_id" field.
+	BuyerID string `json:"buyer_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MarketFill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case marketfill.FieldID, marketfill.FieldPrice, marketfill.FieldQuantity, marketfill.FieldFee, marketfill.FieldSellOrderID, marketfill.FieldBuyOrderID, marketfill.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case marketfill.FieldItemID, marketfill.FieldCurrency, marketfill.FieldSellerID, marketfill.FieldBuyerID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MarketFill fields.
+func (mf *MarketFill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case marketfill.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mf.ID = int64(value.Int64)
+		case marketfill.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				mf.ItemID = value.String
+			}
+		case marketfill.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				mf.Currency = value.String
+			}
+		case marketfill.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				mf.Price = value.Int64
+			}
+		case marketfill.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				mf.Quantity = value.Int64
+			}
+		case marketfill.FieldFee:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fee", values[i])
+			} else if value.Valid {
+				mf.Fee = value.Int64
+			}
+		case marketfill.FieldSellOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sell_order_id", values[i])
+			} else if value.Valid {
+				mf.SellOrderID = value.Int64
+			}
+		case marketfill.FieldBuyOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field buy_order_id", values[i])
+			} else if value.Valid {
+				mf.BuyOrderID = value.Int64
+			}
+		case marketfill.FieldSellerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_id", values[i])
+			} else if value.Valid {
+				mf.SellerID = value.String
+			}
+		case marketfill.FieldBuyerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_id", values[i])
+			} else if value.Valid {
+				mf.BuyerID = value.String
+			}
+		case marketfill.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mf.CreatedAt = value.Int64
+			}
+		default:
+			mf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MarketFill.
+// This includes values selected through modifiers, order, etc.
+func (mf *MarketFill) Value(name string) (ent.Value, error) {
+	return mf.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MarketFill.
+// Note that you need to call MarketFill.Unwrap() before calling this method if this MarketFill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mf *MarketFill) Update() *MarketFillUpdateOne {
+	return NewMarketFillClient(mf.config).UpdateOne(mf)
+}
+
+// Unwrap unwraps the MarketFill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mf *MarketFill) Unwrap() *MarketFill {
+	_tx, ok := mf.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: MarketFill is not a transactional entity")
+	}
+	mf.config.driver = _tx.drv
+	return mf
+}
+
+// String implements the fmt.Stringer.
+func (mf *MarketFill) String() string {
+	var builder strings.Builder
+	builder.WriteString("MarketFill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mf.ID))
+	builder.WriteString("item_id=")
+	builder.WriteString(mf.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(mf.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", mf.Price))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", mf.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("fee=")
+	builder.WriteString(fmt.Sprintf("%v", mf.Fee))
+	builder.WriteString(", ")
+	builder.WriteString("sell_order_id=")
+	builder.WriteString(fmt.Sprintf("%v", mf.SellOrderID))
+	builder.WriteString(", ")
+	builder.WriteString("buy_order_id=")
+	builder.WriteString(fmt.Sprintf("%v", mf.BuyOrderID))
+	builder.WriteString(", ")
+	builder.WriteString("seller_id=")
+	builder.WriteString(mf.SellerID)
+	builder.WriteString(", ")
+	builder.WriteString("buyer_id=")
+	builder.WriteString(mf.BuyerID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", mf.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MarketFills is a parsable slice of MarketFill.
+type MarketFills []*MarketFill
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package marketfill
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldID, id))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldItemID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldPrice, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldFee, v))
+}
+
+// SellOrderID applies equality check predicate on the "sell_order_id" field. It's identical to SellOrderIDEQ.
+func SellOrderID(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldSellOrderID, v))
+}
+
+// BuyOrderID applies equality check predicate on the "buy_order_id" field. It's identical to BuyOrderIDEQ.
+func BuyOrderID(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldBuyOrderID, v))
+}
+
+// SellerID applies equality check predicate on the "seller_id" field. It's identical to SellerIDEQ.
+func SellerID(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldSellerID, v))
+}
+
+// BuyerID applies equality check predicate on the "buyer_id" field. It's identical to BuyerIDEQ.
+func BuyerID(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldBuyerID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldPrice, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldQuantity, v))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldFee, v))
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldFee, vs...))
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldFee, vs...))
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldFee, v))
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldFee, v))
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldFee, v))
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldFee, v))
+}
+
+// SellOrderIDEQ applies the EQ predicate on the "sell_order_id" field.
+func SellOrderIDEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldSellOrderID, v))
+}
+
+// SellOrderIDNEQ applies the NEQ predicate on the "sell_order_id" field.
+func SellOrderIDNEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldSellOrderID, v))
+}
+
+// SellOrderIDIn applies the In predicate on the "sell_order_id" field.
+func SellOrderIDIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldSellOrderID, vs...))
+}
+
+// SellOrderIDNotIn applies the NotIn predicate on the "sell_order_id" field.
+func SellOrderIDNotIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldSellOrderID, vs...))
+}
+
+// SellOrderIDGT applies the GT predicate on the "sell_order_id" field.
+func SellOrderIDGT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldSellOrderID, v))
+}
+
+// SellOrderIDGTE applies the GTE predicate on the "sell_order_id" field.
+func SellOrderIDGTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldSellOrderID, v))
+}
+
+// SellOrderIDLT applies the LT predicate on the "sell_order_id" field.
+func SellOrderIDLT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldSellOrderID, v))
+}
+
+// SellOrderIDLTE applies the LTE predicate on the "sell_order_id" field.
+func SellOrderIDLTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldSellOrderID, v))
+}
+
+// BuyOrderIDEQ applies the EQ predicate on the "buy_order_id" field.
+func BuyOrderIDEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldBuyOrderID, v))
+}
+
+// BuyOrderIDNEQ applies the NEQ predicate on the "buy_order_id" field.
+func BuyOrderIDNEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldBuyOrderID, v))
+}
+
+// BuyOrderIDIn applies the In predicate on the "buy_order_id" field.
+func BuyOrderIDIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldBuyOrderID, vs...))
+}
+
+// BuyOrderIDNotIn applies the NotIn predicate on the "buy_order_id" field.
+func BuyOrderIDNotIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldBuyOrderID, vs...))
+}
+
+// BuyOrderIDGT applies the GT predicate on the "buy_order_id" field.
+func BuyOrderIDGT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldBuyOrderID, v))
+}
+
+// BuyOrderIDGTE applies the GTE predicate on the "buy_order_id" field.
+func BuyOrderIDGTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldBuyOrderID, v))
+}
+
+// BuyOrderIDLT applies the LT predicate on the "buy_order_id" field.
+func BuyOrderIDLT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldBuyOrderID, v))
+}
+
+// BuyOrderIDLTE applies the LTE predicate on the "buy_order_id" field.
+func BuyOrderIDLTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldBuyOrderID, v))
+}
+
+// SellerIDEQ applies the EQ predicate on the "seller_id" field.
+func SellerIDEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldSellerID, v))
+}
+
+// SellerIDNEQ applies the NEQ predicate on the "seller_id" field.
+func SellerIDNEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldSellerID, v))
+}
+
+// SellerIDIn applies the In predicate on the "seller_id" field.
+func SellerIDIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldSellerID, vs...))
+}
+
+// SellerIDNotIn applies the NotIn predicate on the "seller_id" field.
+func SellerIDNotIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldSellerID, vs...))
+}
+
+// SellerIDGT applies the GT predicate on the "seller_id" field.
+func SellerIDGT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldSellerID, v))
+}
+
+// SellerIDGTE applies the GTE predicate on the "seller_id" field.
+func SellerIDGTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldSellerID, v))
+}
+
+// SellerIDLT applies the LT predicate on the "seller_id" field.
+func SellerIDLT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldSellerID, v))
+}
+
+// SellerIDLTE applies the LTE predicate on the "seller_id" field.
+func SellerIDLTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldSellerID, v))
+}
+
+// SellerIDContains applies the Contains predicate on the "seller_id" field.
+func SellerIDContains(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContains(FieldSellerID, v))
+}
+
+// SellerIDHasPrefix applies the HasPrefix predicate on the "seller_id" field.
+func SellerIDHasPrefix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasPrefix(FieldSellerID, v))
+}
+
+// SellerIDHasSuffix applies the HasSuffix predicate on the "seller_id" field.
+func SellerIDHasSuffix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasSuffix(FieldSellerID, v))
+}
+
+// SellerIDEqualFold applies the EqualFold predicate on the "seller_id" field.
+func SellerIDEqualFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEqualFold(FieldSellerID, v))
+}
+
+// SellerIDContainsFold applies the ContainsFold predicate on the "seller_id" field.
+func SellerIDContainsFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContainsFold(FieldSellerID, v))
+}
+
+// BuyerIDEQ applies the EQ predicate on the "buyer_id" field.
+func BuyerIDEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldBuyerID, v))
+}
+
+// BuyerIDNEQ applies the NEQ predicate on the "buyer_id" field.
+func BuyerIDNEQ(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldBuyerID, v))
+}
+
+// BuyerIDIn applies the In predicate on the "buyer_id" field.
+func BuyerIDIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldBuyerID, vs...))
+}
+
+// BuyerIDNotIn applies the NotIn predicate on the "buyer_id" field.
+func BuyerIDNotIn(vs ...string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldBuyerID, vs...))
+}
+
+// BuyerIDGT applies the GT predicate on the "buyer_id" field.
+func BuyerIDGT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldBuyerID, v))
+}
+
+// BuyerIDGTE applies the GTE predicate on the "buyer_id" field.
+func BuyerIDGTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldBuyerID, v))
+}
+
+// BuyerIDLT applies the LT predicate on the "buyer_id" field.
+func BuyerIDLT(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldBuyerID, v))
+}
+
+// BuyerIDLTE applies the LTE predicate on the "buyer_id" field.
+func BuyerIDLTE(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldBuyerID, v))
+}
+
+// BuyerIDContains applies the Contains predicate on the "buyer_id" field.
+func BuyerIDContains(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContains(FieldBuyerID, v))
+}
+
+// BuyerIDHasPrefix applies the HasPrefix predicate on the "buyer_id" field.
+func BuyerIDHasPrefix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasPrefix(FieldBuyerID, v))
+}
+
+// BuyerIDHasSuffix applies the HasSuffix predicate on the "buyer_id" field.
+func BuyerIDHasSuffix(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldHasSuffix(FieldBuyerID, v))
+}
+
+// BuyerIDEqualFold applies the EqualFold predicate on the "buyer_id" field.
+func BuyerIDEqualFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEqualFold(FieldBuyerID, v))
+}
+
+// BuyerIDContainsFold applies the ContainsFold predicate on the "buyer_id" field.
+func BuyerIDContainsFold(v string) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldContainsFold(FieldBuyerID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.MarketFill {
+	return predicate.MarketFill(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MarketFill) predicate.MarketFill {
+	return predicate.MarketFill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MarketFill) predicate.MarketFill {
+	return predicate.MarketFill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MarketFill) predicate.MarketFill {
+	return predicate.MarketFill(sql.NotPredicates(p))
+}
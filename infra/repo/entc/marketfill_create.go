@@ -0,0 +1,1057 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+)
+
+// MarketFillCreate is the builder for creating a MarketFill entity.
+type MarketFillCreate struct {
+	config
+	mutation *MarketFillMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetItemID sets the "item_id" field.
+func (mfc *MarketFillCreate) SetItemID(s string) *MarketFillCreate {
+	mfc.mutation.SetItemID(s)
+	return mfc
+}
+
+// SetCurrency sets the "currency" field.
+func (mfc *MarketFillCreate) SetCurrency(s string) *MarketFillCreate {
+	mfc.mutation.SetCurrency(s)
+	return mfc
+}
+
+// SetPrice sets the "price" field.
+func (mfc *MarketFillCreate) SetPrice(i int64) *MarketFillCreate {
+	mfc.mutation.SetPrice(i)
+	return mfc
+}
+
+// SetQuantity sets the "quantity" field.
+func (mfc *MarketFillCreate) SetQuantity(i int64) *MarketFillCreate {
+	mfc.mutation.SetQuantity(i)
+	return mfc
+}
+
+// SetFee sets the "fee" field.
+func (mfc *MarketFillCreate) SetFee(i int64) *MarketFillCreate {
+	mfc.mutation.SetFee(i)
+	return mfc
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (mfc *MarketFillCreate) SetNillableFee(i *int64) *MarketFillCreate {
+	if i != nil {
+		mfc.SetFee(*i)
+	}
+	return mfc
+}
+
+// SetSellOrderID sets the "sell_order_id" field.
+func (mfc *MarketFillCreate) SetSellOrderID(i int64) *MarketFillCreate {
+	mfc.mutation.SetSellOrderID(i)
+	return mfc
+}
+
+// SetBuyOrderID sets the "buy_order_id" field.
+func (mfc *MarketFillCreate) SetBuyOrderID(i int64) *MarketFillCreate {
+	mfc.mutation.SetBuyOrderID(i)
+	return mfc
+}
+
+// SetSellerID sets the "seller_id" field.
+func (mfc *MarketFillCreate) SetSellerID(s string) *MarketFillCreate {
+	mfc.mutation.SetSellerID(s)
+	return mfc
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (mfc *MarketFillCreate) SetBuyerID(s string) *MarketFillCreate {
+	mfc.mutation.SetBuyerID(s)
+	return mfc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mfc *MarketFillCreate) SetCreatedAt(i int64) *MarketFillCreate {
+	mfc.mutation.SetCreatedAt(i)
+	return mfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mfc *MarketFillCreate) SetNillableCreatedAt(i *int64) *MarketFillCreate {
+	if i != nil {
+		mfc.SetCreatedAt(*i)
+	}
+	return mfc
+}
+
+// SetID sets the "id" field.
+func (mfc *MarketFillCreate) SetID(i int64) *MarketFillCreate {
+	mfc.mutation.SetID(i)
+	return mfc
+}
+
+// Mutation returns the MarketFillMutation object of the builder.
+func (mfc *MarketFillCreate) Mutation() *MarketFillMutation {
+	return mfc.mutation
+}
+
+// Save creates the MarketFill in the database.
+func (mfc *MarketFillCreate) Save(ctx context.Context) (*MarketFill, error) {
+	mfc.defaults()
+	return withHooks(ctx, mfc.sqlSave, mfc.mutation, mfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mfc *MarketFillCreate) SaveX(ctx context.Context) *MarketFill {
+	v, err := mfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfc *MarketFillCreate) Exec(ctx context.Context) error {
+	_, err := mfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfc *MarketFillCreate) ExecX(ctx context.Context) {
+	if err := mfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mfc *MarketFillCreate) defaults() {
+	if _, ok := mfc.mutation.Fee(); !ok {
+		v := marketfill.DefaultFee
+		mfc.mutation.SetFee(v)
+	}
+	if _, ok := mfc.mutation.CreatedAt(); !ok {
+		v := marketfill.DefaultCreatedAt()
+		mfc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mfc *MarketFillCreate) check() error {
+	if _, ok := mfc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`entc: missing required field "MarketFill.item_id"`)}
+	}
+	if _, ok := mfc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`entc: missing required field "MarketFill.currency"`)}
+	}
+	if _, ok := mfc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`entc: missing required field "MarketFill.price"`)}
+	}
+	if _, ok := mfc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`entc: missing required field "MarketFill.quantity"`)}
+	}
+	if _, ok := mfc.mutation.Fee(); !ok {
+		return &ValidationError{Name: "fee", err: errors.New(`entc: missing required field "MarketFill.fee"`)}
+	}
+	if _, ok := mfc.mutation.SellOrderID(); !ok {
+		return &ValidationError{Name: "sell_order_id", err: errors.New(`entc: missing required field "MarketFill.sell_order_id"`)}
+	}
+	if _, ok := mfc.mutation.BuyOrderID(); !ok {
+		return &ValidationError{Name: "buy_order_id", err: errors.New(`entc: missing required field "MarketFill.buy_order_id"`)}
+	}
+	if _, ok := mfc.mutation.SellerID(); !ok {
+		return &ValidationError{Name: "seller_id", err: errors.New(`entc: missing required field "MarketFill.seller_id"`)}
+	}
+	if _, ok := mfc.mutation.BuyerID(); !ok {
+		return &ValidationError{Name: "buyer_id", err: errors.New(`entc: missing required field "MarketFill.buyer_id"`)}
+	}
+	if _, ok := mfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "MarketFill.created_at"`)}
+	}
+	return nil
+}
+
+func (mfc *MarketFillCreate) sqlSave(ctx context.Context) (*MarketFill, error) {
+	if err := mfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	mfc.mutation.id = &_node.ID
+	mfc.mutation.done = true
+	return _node, nil
+}
+
+func (mfc *MarketFillCreate) createSpec() (*MarketFill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MarketFill{config: mfc.config}
+		_spec = sqlgraph.NewCreateSpec(marketfill.Table, sqlgraph.NewFieldSpec(marketfill.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = mfc.conflict
+	if id, ok := mfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mfc.mutation.ItemID(); ok {
+		_spec.SetField(marketfill.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := mfc.mutation.Currency(); ok {
+		_spec.SetField(marketfill.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := mfc.mutation.Price(); ok {
+		_spec.SetField(marketfill.FieldPrice, field.TypeInt64, value)
+		_node.Price = value
+	}
+	if value, ok := mfc.mutation.Quantity(); ok {
+		_spec.SetField(marketfill.FieldQuantity, field.TypeInt64, value)
+		_node.Quantity = value
+	}
+	if value, ok := mfc.mutation.Fee(); ok {
+		_spec.SetField(marketfill.FieldFee, field.TypeInt64, value)
+		_node.Fee = value
+	}
+	if value, ok := mfc.mutation.SellOrderID(); ok {
+		_spec.SetField(marketfill.FieldSellOrderID, field.TypeInt64, value)
+		_node.SellOrderID = value
+	}
+	if value, ok := mfc.mutation.BuyOrderID(); ok {
+		_spec.SetField(marketfill.FieldBuyOrderID, field.TypeInt64, value)
+		_node.BuyOrderID = value
+	}
+	if value, ok := mfc.mutation.SellerID(); ok {
+		_spec.SetField(marketfill.FieldSellerID, field.TypeString, value)
+		_node.SellerID = value
+	}
+	if value, ok := mfc.mutation.BuyerID(); ok {
+		_spec.SetField(marketfill.FieldBuyerID, field.TypeString, value)
+		_node.BuyerID = value
+	}
+	if value, ok := mfc.mutation.CreatedAt(); ok {
+		_spec.SetField(marketfill.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MarketFill.Create().
+//		SetItemID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MarketFillUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (mfc *MarketFillCreate) OnConflict(opts ...sql.ConflictOption) *MarketFillUpsertOne {
+	mfc.conflict = opts
+	return &MarketFillUpsertOne{
+		create: mfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MarketFill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mfc *MarketFillCreate) OnConflictColumns(columns ...string) *MarketFillUpsertOne {
+	mfc.conflict = append(mfc.conflict, sql.ConflictColumns(columns...))
+	return &MarketFillUpsertOne{
+		create: mfc,
+	}
+}
+
+type (
+	// MarketFillUpsertOne is the builder for "upsert"-ing
+	//  one MarketFill node.
+	MarketFillUpsertOne struct {
+		create *MarketFillCreate
+	}
+
+	// MarketFillUpsert is the "OnConflict" setter.
+	MarketFillUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetItemID sets the "item_id" field.
+func (u *MarketFillUpsert) SetItemID(v string) *MarketFillUpsert {
+	u.Set(marketfill.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateItemID() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldItemID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *MarketFillUpsert) SetCurrency(v string) *MarketFillUpsert {
+	u.Set(marketfill.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateCurrency() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldCurrency)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *MarketFillUpsert) SetPrice(v int64) *MarketFillUpsert {
+	u.Set(marketfill.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdatePrice() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *MarketFillUpsert) AddPrice(v int64) *MarketFillUpsert {
+	u.Add(marketfill.FieldPrice, v)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *MarketFillUpsert) SetQuantity(v int64) *MarketFillUpsert {
+	u.Set(marketfill.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateQuantity() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *MarketFillUpsert) AddQuantity(v int64) *MarketFillUpsert {
+	u.Add(marketfill.FieldQuantity, v)
+	return u
+}
+
+// SetFee sets the "fee" field.
+func (u *MarketFillUpsert) SetFee(v int64) *MarketFillUpsert {
+	u.Set(marketfill.FieldFee, v)
+	return u
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateFee() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldFee)
+	return u
+}
+
+// AddFee adds v to the "fee" field.
+func (u *MarketFillUpsert) AddFee(v int64) *MarketFillUpsert {
+	u.Add(marketfill.FieldFee, v)
+	return u
+}
+
+// SetSellOrderID sets the "sell_order_id" field.
+func (u *MarketFillUpsert) SetSellOrderID(v int64) *MarketFillUpsert {
+	u.Set(marketfill.FieldSellOrderID, v)
+	return u
+}
+
+// UpdateSellOrderID sets the "sell_order_id" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateSellOrderID() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldSellOrderID)
+	return u
+}
+
+// AddSellOrderID adds v to the "sell_order_id" field.
+func (u *MarketFillUpsert) AddSellOrderID(v int64) *MarketFillUpsert {
+	u.Add(marketfill.FieldSellOrderID, v)
+	return u
+}
+
+// SetBuyOrderID sets the "buy_order_id" field.
+func (u *MarketFillUpsert) SetBuyOrderID(v int64) *MarketFillUpsert {
+	u.Set(marketfill.FieldBuyOrderID, v)
+	return u
+}
+
+// UpdateBuyOrderID sets the "buy_order_id" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateBuyOrderID() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldBuyOrderID)
+	return u
+}
+
+// AddBuyOrderID adds v to the "buy_order_id" field.
+func (u *MarketFillUpsert) AddBuyOrderID(v int64) *MarketFillUpsert {
+	u.Add(marketfill.FieldBuyOrderID, v)
+	return u
+}
+
+// SetSellerID sets the "seller_id" field.
+func (u *MarketFillUpsert) SetSellerID(v string) *MarketFillUpsert {
+	u.Set(marketfill.FieldSellerID, v)
+	return u
+}
+
+// UpdateSellerID sets the "seller_id" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateSellerID() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldSellerID)
+	return u
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *MarketFillUpsert) SetBuyerID(v string) *MarketFillUpsert {
+	u.Set(marketfill.FieldBuyerID, v)
+	return u
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *MarketFillUpsert) UpdateBuyerID() *MarketFillUpsert {
+	u.SetExcluded(marketfill.FieldBuyerID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MarketFill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(marketfill.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MarketFillUpsertOne) UpdateNewValues() *MarketFillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(marketfill.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(marketfill.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MarketFill.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MarketFillUpsertOne) Ignore() *MarketFillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MarketFillUpsertOne) DoNothing() *MarketFillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MarketFillCreate.OnConflict
+// documentation for more info.
+func (u *MarketFillUpsertOne) Update(set func(*MarketFillUpsert)) *MarketFillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MarketFillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *MarketFillUpsertOne) SetItemID(v string) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateItemID() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *MarketFillUpsertOne) SetCurrency(v string) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateCurrency() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *MarketFillUpsertOne) SetPrice(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *MarketFillUpsertOne) AddPrice(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdatePrice() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *MarketFillUpsertOne) SetQuantity(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *MarketFillUpsertOne) AddQuantity(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateQuantity() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetFee sets the "fee" field.
+func (u *MarketFillUpsertOne) SetFee(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// AddFee adds v to the "fee" field.
+func (u *MarketFillUpsertOne) AddFee(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateFee() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// SetSellOrderID sets the "sell_order_id" field.
+func (u *MarketFillUpsertOne) SetSellOrderID(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetSellOrderID(v)
+	})
+}
+
+// AddSellOrderID adds v to the "sell_order_id" field.
+func (u *MarketFillUpsertOne) AddSellOrderID(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddSellOrderID(v)
+	})
+}
+
+// UpdateSellOrderID sets the "sell_order_id" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateSellOrderID() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateSellOrderID()
+	})
+}
+
+// SetBuyOrderID sets the "buy_order_id" field.
+func (u *MarketFillUpsertOne) SetBuyOrderID(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetBuyOrderID(v)
+	})
+}
+
+// AddBuyOrderID adds v to the "buy_order_id" field.
+func (u *MarketFillUpsertOne) AddBuyOrderID(v int64) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddBuyOrderID(v)
+	})
+}
+
+// UpdateBuyOrderID sets the "buy_order_id" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateBuyOrderID() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateBuyOrderID()
+	})
+}
+
+// SetSellerID sets the "seller_id" field.
+func (u *MarketFillUpsertOne) SetSellerID(v string) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetSellerID(v)
+	})
+}
+
+// UpdateSellerID sets the "seller_id" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateSellerID() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateSellerID()
+	})
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *MarketFillUpsertOne) SetBuyerID(v string) *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetBuyerID(v)
+	})
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *MarketFillUpsertOne) UpdateBuyerID() *MarketFillUpsertOne {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateBuyerID()
+	})
+}
+
+// Exec executes the query.
+func (u *MarketFillUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for MarketFillCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MarketFillUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MarketFillUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MarketFillUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MarketFillCreateBulk is the builder for creating many MarketFill entities in bulk.
+type MarketFillCreateBulk struct {
+	config
+	err      error
+	builders []*MarketFillCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MarketFill entities in the database.
+func (mfcb *MarketFillCreateBulk) Save(ctx context.Context) ([]*MarketFill, error) {
+	if mfcb.err != nil {
+		return nil, mfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mfcb.builders))
+	nodes := make([]*MarketFill, len(mfcb.builders))
+	mutators := make([]Mutator, len(mfcb.builders))
+	for i := range mfcb.builders {
+		func(i int, root context.Context) {
+			builder := mfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MarketFillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfcb *MarketFillCreateBulk) SaveX(ctx context.Context) []*MarketFill {
+	v, err := mfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mfcb *MarketFillCreateBulk) Exec(ctx context.Context) error {
+	_, err := mfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfcb *MarketFillCreateBulk) ExecX(ctx context.Context) {
+	if err := mfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MarketFill.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MarketFillUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (mfcb *MarketFillCreateBulk) OnConflict(opts ...sql.ConflictOption) *MarketFillUpsertBulk {
+	mfcb.conflict = opts
+	return &MarketFillUpsertBulk{
+		create: mfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MarketFill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mfcb *MarketFillCreateBulk) OnConflictColumns(columns ...string) *MarketFillUpsertBulk {
+	mfcb.conflict = append(mfcb.conflict, sql.ConflictColumns(columns...))
+	return &MarketFillUpsertBulk{
+		create: mfcb,
+	}
+}
+
+// MarketFillUpsertBulk is the builder for "upsert"-ing
+// a bulk of MarketFill nodes.
+type MarketFillUpsertBulk struct {
+	create *MarketFillCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MarketFill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(marketfill.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MarketFillUpsertBulk) UpdateNewValues() *MarketFillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(marketfill.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(marketfill.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MarketFill.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MarketFillUpsertBulk) Ignore() *MarketFillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MarketFillUpsertBulk) DoNothing() *MarketFillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MarketFillCreateBulk.OnConflict
+// documentation for more info.
+func (u *MarketFillUpsertBulk) Update(set func(*MarketFillUpsert)) *MarketFillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MarketFillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *MarketFillUpsertBulk) SetItemID(v string) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateItemID() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *MarketFillUpsertBulk) SetCurrency(v string) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateCurrency() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *MarketFillUpsertBulk) SetPrice(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *MarketFillUpsertBulk) AddPrice(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdatePrice() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *MarketFillUpsertBulk) SetQuantity(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *MarketFillUpsertBulk) AddQuantity(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateQuantity() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetFee sets the "fee" field.
+func (u *MarketFillUpsertBulk) SetFee(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// AddFee adds v to the "fee" field.
+func (u *MarketFillUpsertBulk) AddFee(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateFee() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// SetSellOrderID sets the "sell_order_id" field.
+func (u *MarketFillUpsertBulk) SetSellOrderID(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetSellOrderID(v)
+	})
+}
+
+// AddSellOrderID adds v to the "sell_order_id" field.
+func (u *MarketFillUpsertBulk) AddSellOrderID(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddSellOrderID(v)
+	})
+}
+
+// UpdateSellOrderID sets the "sell_order_id" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateSellOrderID() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateSellOrderID()
+	})
+}
+
+// SetBuyOrderID sets the "buy_order_id" field.
+func (u *MarketFillUpsertBulk) SetBuyOrderID(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetBuyOrderID(v)
+	})
+}
+
+// AddBuyOrderID adds v to the "buy_order_id" field.
+func (u *MarketFillUpsertBulk) AddBuyOrderID(v int64) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.AddBuyOrderID(v)
+	})
+}
+
+// UpdateBuyOrderID sets the "buy_order_id" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateBuyOrderID() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateBuyOrderID()
+	})
+}
+
+// SetSellerID sets the "seller_id" field.
+func (u *MarketFillUpsertBulk) SetSellerID(v string) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetSellerID(v)
+	})
+}
+
+// UpdateSellerID sets the "seller_id" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateSellerID() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateSellerID()
+	})
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *MarketFillUpsertBulk) SetBuyerID(v string) *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.SetBuyerID(v)
+	})
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *MarketFillUpsertBulk) UpdateBuyerID() *MarketFillUpsertBulk {
+	return u.Update(func(s *MarketFillUpsert) {
+		s.UpdateBuyerID()
+	})
+}
+
+// Exec executes the query.
+func (u *MarketFillUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the MarketFillCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for MarketFillCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MarketFillUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
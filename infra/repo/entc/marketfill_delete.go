@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// MarketFillDelete is the builder for deleting a MarketFill entity.
+type MarketFillDelete struct {
+	config
+	hooks    []Hook
+	mutation *MarketFillMutation
+}
+
+// Where appends a list predicates to the MarketFillDelete builder.
+func (mfd *MarketFillDelete) Where(ps ...predicate.MarketFill) *MarketFillDelete {
+	mfd.mutation.Where(ps...)
+	return mfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mfd *MarketFillDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfd.sqlExec, mfd.mutation, mfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfd *MarketFillDelete) ExecX(ctx context.Context) int {
+	n, err := mfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mfd *MarketFillDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(marketfill.Table, sqlgraph.NewFieldSpec(marketfill.FieldID, field.TypeInt64))
+	if ps := mfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mfd.mutation.done = true
+	return affected, err
+}
+
+// MarketFillDeleteOne is the builder for deleting a single MarketFill entity.
+type MarketFillDeleteOne struct {
+	mfd *MarketFillDelete
+}
+
+// Where appends a list predicates to the MarketFillDelete builder.
+func (mfdo *MarketFillDeleteOne) Where(ps ...predicate.MarketFill) *MarketFillDeleteOne {
+	mfdo.mfd.mutation.Where(ps...)
+	return mfdo
+}
+
+// Exec executes the deletion query.
+func (mfdo *MarketFillDeleteOne) Exec(ctx context.Context) error {
+	n, err := mfdo.mfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{marketfill.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfdo *MarketFillDeleteOne) ExecX(ctx context.Context) {
+	if err := mfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
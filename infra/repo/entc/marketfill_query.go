@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// MarketFillQuery is the builder for querying MarketFill entities.
+type MarketFillQuery struct {
+	config
+	ctx        *QueryContext
+	order      []marketfill.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MarketFill
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MarketFillQuery builder.
+func (mfq *MarketFillQuery) Where(ps ...predicate.MarketFill) *MarketFillQuery {
+	mfq.predicates = append(mfq.predicates, ps...)
+	return mfq
+}
+
+// Limit the number of records to be returned by this query.
+func (mfq *MarketFillQuery) Limit(limit int) *MarketFillQuery {
+	mfq.ctx.Limit = &limit
+	return mfq
+}
+
+// Offset to start from.
+func (mfq *MarketFillQuery) Offset(offset int) *MarketFillQuery {
+	mfq.ctx.Offset = &offset
+	return mfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mfq *MarketFillQuery) Unique(unique bool) *MarketFillQuery {
+	mfq.ctx.Unique = &unique
+	return mfq
+}
+
+// Order specifies how the records should be ordered.
+func (mfq *MarketFillQuery) Order(o ...marketfill.OrderOption) *MarketFillQuery {
+	mfq.order = append(mfq.order, o...)
+	return mfq
+}
+
+// First returns the first MarketFill entity from the query.
+// Returns a *NotFoundError when no MarketFill was found.
+func (mfq *MarketFillQuery) First(ctx context.Context) (*MarketFill, error) {
+	nodes, err := mfq.Limit(1).All(setContextOp(ctx, mfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{marketfill.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mfq *MarketFillQuery) FirstX(ctx context.Context) *MarketFill {
+	node, err := mfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MarketFill ID from the query.
+// Returns a *NotFoundError when no MarketFill ID was found.
+func (mfq *MarketFillQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = mfq.Limit(1).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{marketfill.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mfq *MarketFillQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := mfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MarketFill entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MarketFill entity is found.
+// Returns a *NotFoundError when no MarketFill entities are found.
+func (mfq *MarketFillQuery) Only(ctx context.Context) (*MarketFill, error) {
+	nodes, err := mfq.Limit(2).All(setContextOp(ctx, mfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{marketfill.Label}
+	default:
+		return nil, &NotSingularError{marketfill.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mfq *MarketFillQuery) OnlyX(ctx context.Context) *MarketFill {
+	node, err := mfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MarketFill ID in the query.
+// Returns a *NotSingularError when more than one MarketFill ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mfq *MarketFillQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = mfq.Limit(2).IDs(setContextOp(ctx, mfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{marketfill.Label}
+	default:
+		err = &NotSingularError{marketfill.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mfq *MarketFillQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := mfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MarketFills.
+func (mfq *MarketFillQuery) All(ctx context.Context) ([]*MarketFill, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryAll)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MarketFill, *MarketFillQuery]()
+	return withInterceptors[[]*MarketFill](ctx, mfq, qr, mfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mfq *MarketFillQuery) AllX(ctx context.Context) []*MarketFill {
+	nodes, err := mfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MarketFill IDs.
+func (mfq *MarketFillQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if mfq.ctx.Unique == nil && mfq.path != nil {
+		mfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryIDs)
+	if err = mfq.Select(marketfill.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mfq *MarketFillQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := mfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mfq *MarketFillQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryCount)
+	if err := mfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mfq, querierCount[*MarketFillQuery](), mfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mfq *MarketFillQuery) CountX(ctx context.Context) int {
+	count, err := mfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mfq *MarketFillQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mfq.ctx, ent.OpQueryExist)
+	switch _, err := mfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mfq *MarketFillQuery) ExistX(ctx context.Context) bool {
+	exist, err := mfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MarketFillQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mfq *MarketFillQuery) Clone() *MarketFillQuery {
+	if mfq == nil {
+		return nil
+	}
+	return &MarketFillQuery{
+		config:     mfq.config,
+		ctx:        mfq.ctx.Clone(),
+		order:      append([]marketfill.OrderOption{}, mfq.order...),
+		inters:     append([]Interceptor{}, mfq.inters...),
+		predicates: append([]predicate.MarketFill{}, mfq.predicates...),
+		// clone intermediate query.
+		sql:       mfq.sql.Clone(),
+		path:      mfq.path,
+		modifiers: append([]func(*sql.Selector){}, mfq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID string `json:"item_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MarketFill.Query().
+//		GroupBy(marketfill.FieldItemID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (mfq *MarketFillQuery) GroupBy(field string, fields ...string) *MarketFillGroupBy {
+	mfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MarketFillGroupBy{build: mfq}
+	grbuild.flds = &mfq.ctx.Fields
+	grbuild.label = marketfill.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ItemID string `json:"item_id,omitempty"`
+//	}
+//
+//	client.MarketFill.Query().
+//		Select(marketfill.FieldItemID).
+//		Scan(ctx, &v)
+func (mfq *MarketFillQuery) Select(fields ...string) *MarketFillSelect {
+	mfq.ctx.Fields = append(mfq.ctx.Fields, fields...)
+	sbuild := &MarketFillSelect{MarketFillQuery: mfq}
+	sbuild.label = marketfill.Label
+	sbuild.flds, sbuild.scan = &mfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MarketFillSelect configured with the given aggregations.
+func (mfq *MarketFillQuery) Aggregate(fns ...AggregateFunc) *MarketFillSelect {
+	return mfq.Select().Aggregate(fns...)
+}
+
+func (mfq *MarketFillQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mfq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mfq.ctx.Fields {
+		if !marketfill.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if mfq.path != nil {
+		prev, err := mfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mfq.sql = prev
+	}
+	return nil
+}
+
+func (mfq *MarketFillQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MarketFill, error) {
+	var (
+		nodes = []*MarketFill{}
+		_spec = mfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MarketFill).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MarketFill{config: mfq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mfq.modifiers) > 0 {
+		_spec.Modifiers = mfq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mfq *MarketFillQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mfq.querySpec()
+	if len(mfq.modifiers) > 0 {
+		_spec.Modifiers = mfq.modifiers
+	}
+	_spec.Node.Columns = mfq.ctx.Fields
+	if len(mfq.ctx.Fields) > 0 {
+		_spec.Unique = mfq.ctx.Unique != nil && *mfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mfq.driver, _spec)
+}
+
+func (mfq *MarketFillQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(marketfill.Table, marketfill.Columns, sqlgraph.NewFieldSpec(marketfill.FieldID, field.TypeInt64))
+	_spec.From = mfq.sql
+	if unique := mfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, marketfill.FieldID)
+		for i := range fields {
+			if fields[i] != marketfill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mfq *MarketFillQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mfq.driver.Dialect())
+	t1 := builder.Table(marketfill.Table)
+	columns := mfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = marketfill.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mfq.sql != nil {
+		selector = mfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mfq.ctx.Unique != nil && *mfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mfq.modifiers {
+		m(selector)
+	}
+	for _, p := range mfq.predicates {
+		p(selector)
+	}
+	for _, p := range mfq.order {
+		p(selector)
+	}
+	if offset := mfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mfq *MarketFillQuery) Modify(modifiers ...func(s *sql.Selector)) *MarketFillSelect {
+	mfq.modifiers = append(mfq.modifiers, modifiers...)
+	return mfq.Select()
+}
+
+// MarketFillGroupBy is the group-by builder for MarketFill entities.
+type MarketFillGroupBy struct {
+	selector
+	build *MarketFillQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mfgb *MarketFillGroupBy) Aggregate(fns ...AggregateFunc) *MarketFillGroupBy {
+	mfgb.fns = append(mfgb.fns, fns...)
+	return mfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfgb *MarketFillGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MarketFillQuery, *MarketFillGroupBy](ctx, mfgb.build, mfgb, mfgb.build.inters, v)
+}
+
+func (mfgb *MarketFillGroupBy) sqlScan(ctx context.Context, root *MarketFillQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mfgb.fns))
+	for _, fn := range mfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mfgb.flds)+len(mfgb.fns))
+		for _, f := range *mfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MarketFillSelect is the builder for selecting fields of MarketFill entities.
+type MarketFillSelect struct {
+	*MarketFillQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mfs *MarketFillSelect) Aggregate(fns ...AggregateFunc) *MarketFillSelect {
+	mfs.fns = append(mfs.fns, fns...)
+	return mfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mfs *MarketFillSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mfs.ctx, ent.OpQuerySelect)
+	if err := mfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MarketFillQuery, *MarketFillSelect](ctx, mfs.MarketFillQuery, mfs, mfs.inters, v)
+}
+
+func (mfs *MarketFillSelect) sqlScan(ctx context.Context, root *MarketFillQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mfs.fns))
+	for _, fn := range mfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mfs *MarketFillSelect) Modify(modifiers ...func(s *sql.Selector)) *MarketFillSelect {
+	mfs.modifiers = append(mfs.modifiers, modifiers...)
+	return mfs
+}
@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// MarketFillUpdate is the builder for updating MarketFill entities.
+type MarketFillUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MarketFillMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MarketFillUpdate builder.
+func (mfu *MarketFillUpdate) Where(ps ...predicate.MarketFill) *MarketFillUpdate {
+	mfu.mutation.Where(ps...)
+	return mfu
+}
+
+// SetItemID sets the "item_id" field.
+func (mfu *MarketFillUpdate) SetItemID(s string) *MarketFillUpdate {
+	mfu.mutation.SetItemID(s)
+	return mfu
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableItemID(s *string) *MarketFillUpdate {
+	if s != nil {
+		mfu.SetItemID(*s)
+	}
+	return mfu
+}
+
+// SetCurrency sets the "currency" field.
+func (mfu *MarketFillUpdate) SetCurrency(s string) *MarketFillUpdate {
+	mfu.mutation.SetCurrency(s)
+	return mfu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableCurrency(s *string) *MarketFillUpdate {
+	if s != nil {
+		mfu.SetCurrency(*s)
+	}
+	return mfu
+}
+
+// SetPrice sets the "price" field.
+func (mfu *MarketFillUpdate) SetPrice(i int64) *MarketFillUpdate {
+	mfu.mutation.ResetPrice()
+	mfu.mutation.SetPrice(i)
+	return mfu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillablePrice(i *int64) *MarketFillUpdate {
+	if i != nil {
+		mfu.SetPrice(*i)
+	}
+	return mfu
+}
+
+// AddPrice adds i to the "price" field.
+func (mfu *MarketFillUpdate) AddPrice(i int64) *MarketFillUpdate {
+	mfu.mutation.AddPrice(i)
+	return mfu
+}
+
+// SetQuantity sets the "quantity" field.
+func (mfu *MarketFillUpdate) SetQuantity(i int64) *MarketFillUpdate {
+	mfu.mutation.ResetQuantity()
+	mfu.mutation.SetQuantity(i)
+	return mfu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableQuantity(i *int64) *MarketFillUpdate {
+	if i != nil {
+		mfu.SetQuantity(*i)
+	}
+	return mfu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (mfu *MarketFillUpdate) AddQuantity(i int64) *MarketFillUpdate {
+	mfu.mutation.AddQuantity(i)
+	return mfu
+}
+
+// SetFee sets the "fee" field.
+func (mfu *MarketFillUpdate) SetFee(i int64) *MarketFillUpdate {
+	mfu.mutation.ResetFee()
+	mfu.mutation.SetFee(i)
+	return mfu
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableFee(i *int64) *MarketFillUpdate {
+	if i != nil {
+		mfu.SetFee(*i)
+	}
+	return mfu
+}
+
+// AddFee adds i to the "fee" field.
+func (mfu *MarketFillUpdate) AddFee(i int64) *MarketFillUpdate {
+	mfu.mutation.AddFee(i)
+	return mfu
+}
+
+// SetSellOrderID sets the "sell_order_id" field.
+func (mfu *MarketFillUpdate) SetSellOrderID(i int64) *MarketFillUpdate {
+	mfu.mutation.ResetSellOrderID()
+	mfu.mutation.SetSellOrderID(i)
+	return mfu
+}
+
+// SetNillableSellOrderID sets the "sell_order_id" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableSellOrderID(i *int64) *MarketFillUpdate {
+	if i != nil {
+		mfu.SetSellOrderID(*i)
+	}
+	return mfu
+}
+
+// AddSellOrderID adds i to the "sell_order_id" field.
+func (mfu *MarketFillUpdate) AddSellOrderID(i int64) *MarketFillUpdate {
+	mfu.mutation.AddSellOrderID(i)
+	return mfu
+}
+
+// SetBuyOrderID sets the "buy_order_id" field.
+func (mfu *MarketFillUpdate) SetBuyOrderID(i int64) *MarketFillUpdate {
+	mfu.mutation.ResetBuyOrderID()
+	mfu.mutation.SetBuyOrderID(i)
+	return mfu
+}
+
+// SetNillableBuyOrderID sets the "buy_order_id" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableBuyOrderID(i *int64) *MarketFillUpdate {
+	if i != nil {
+		mfu.SetBuyOrderID(*i)
+	}
+	return mfu
+}
+
+// AddBuyOrderID adds i to the "buy_order_id" field.
+func (mfu *MarketFillUpdate) AddBuyOrderID(i int64) *MarketFillUpdate {
+	mfu.mutation.AddBuyOrderID(i)
+	return mfu
+}
+
+// SetSellerID sets the "seller_id" field.
+func (mfu *MarketFillUpdate) SetSellerID(s string) *MarketFillUpdate {
+	mfu.mutation.SetSellerID(s)
+	return mfu
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableSellerID(s *string) *MarketFillUpdate {
+	if s != nil {
+		mfu.SetSellerID(*s)
+	}
+	return mfu
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (mfu *MarketFillUpdate) SetBuyerID(s string) *MarketFillUpdate {
+	mfu.mutation.SetBuyerID(s)
+	return mfu
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (mfu *MarketFillUpdate) SetNillableBuyerID(s *string) *MarketFillUpdate {
+	if s != nil {
+		mfu.SetBuyerID(*s)
+	}
+	return mfu
+}
+
+// Mutation returns the MarketFillMutation object of the builder.
+func (mfu *MarketFillUpdate) Mutation() *MarketFillMutation {
+	return mfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mfu *MarketFillUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mfu.sqlSave, mfu.mutation, mfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfu *MarketFillUpdate) SaveX(ctx context.Context) int {
+	affected, err := mfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mfu *MarketFillUpdate) Exec(ctx context.Context) error {
+	_, err := mfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfu *MarketFillUpdate) ExecX(ctx context.Context) {
+	if err := mfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mfu *MarketFillUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MarketFillUpdate {
+	mfu.modifiers = append(mfu.modifiers, modifiers...)
+	return mfu
+}
+
+func (mfu *MarketFillUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(marketfill.Table, marketfill.Columns, sqlgraph.NewFieldSpec(marketfill.FieldID, field.TypeInt64))
+	if ps := mfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfu.mutation.ItemID(); ok {
+		_spec.SetField(marketfill.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.Currency(); ok {
+		_spec.SetField(marketfill.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.Price(); ok {
+		_spec.SetField(marketfill.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.AddedPrice(); ok {
+		_spec.AddField(marketfill.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.Quantity(); ok {
+		_spec.SetField(marketfill.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.AddedQuantity(); ok {
+		_spec.AddField(marketfill.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.Fee(); ok {
+		_spec.SetField(marketfill.FieldFee, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.AddedFee(); ok {
+		_spec.AddField(marketfill.FieldFee, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.SellOrderID(); ok {
+		_spec.SetField(marketfill.FieldSellOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.AddedSellOrderID(); ok {
+		_spec.AddField(marketfill.FieldSellOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.BuyOrderID(); ok {
+		_spec.SetField(marketfill.FieldBuyOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.AddedBuyOrderID(); ok {
+		_spec.AddField(marketfill.FieldBuyOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfu.mutation.SellerID(); ok {
+		_spec.SetField(marketfill.FieldSellerID, field.TypeString, value)
+	}
+	if value, ok := mfu.mutation.BuyerID(); ok {
+		_spec.SetField(marketfill.FieldBuyerID, field.TypeString, value)
+	}
+	_spec.AddModifiers(mfu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{marketfill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mfu.mutation.done = true
+	return n, nil
+}
+
+// MarketFillUpdateOne is the builder for updating a single MarketFill entity.
+type MarketFillUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MarketFillMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetItemID sets the "item_id" field.
+func (mfuo *MarketFillUpdateOne) SetItemID(s string) *MarketFillUpdateOne {
+	mfuo.mutation.SetItemID(s)
+	return mfuo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableItemID(s *string) *MarketFillUpdateOne {
+	if s != nil {
+		mfuo.SetItemID(*s)
+	}
+	return mfuo
+}
+
+// SetCurrency sets the "currency" field.
+func (mfuo *MarketFillUpdateOne) SetCurrency(s string) *MarketFillUpdateOne {
+	mfuo.mutation.SetCurrency(s)
+	return mfuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableCurrency(s *string) *MarketFillUpdateOne {
+	if s != nil {
+		mfuo.SetCurrency(*s)
+	}
+	return mfuo
+}
+
+// SetPrice sets the "price" field.
+func (mfuo *MarketFillUpdateOne) SetPrice(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.ResetPrice()
+	mfuo.mutation.SetPrice(i)
+	return mfuo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillablePrice(i *int64) *MarketFillUpdateOne {
+	if i != nil {
+		mfuo.SetPrice(*i)
+	}
+	return mfuo
+}
+
+// AddPrice adds i to the "price" field.
+func (mfuo *MarketFillUpdateOne) AddPrice(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.AddPrice(i)
+	return mfuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (mfuo *MarketFillUpdateOne) SetQuantity(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.ResetQuantity()
+	mfuo.mutation.SetQuantity(i)
+	return mfuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableQuantity(i *int64) *MarketFillUpdateOne {
+	if i != nil {
+		mfuo.SetQuantity(*i)
+	}
+	return mfuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (mfuo *MarketFillUpdateOne) AddQuantity(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.AddQuantity(i)
+	return mfuo
+}
+
+// SetFee sets the "fee" field.
+func (mfuo *MarketFillUpdateOne) SetFee(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.ResetFee()
+	mfuo.mutation.SetFee(i)
+	return mfuo
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableFee(i *int64) *MarketFillUpdateOne {
+	if i != nil {
+		mfuo.SetFee(*i)
+	}
+	return mfuo
+}
+
+// AddFee adds i to the "fee" field.
+func (mfuo *MarketFillUpdateOne) AddFee(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.AddFee(i)
+	return mfuo
+}
+
+// SetSellOrderID sets the "sell_order_id" field.
+func (mfuo *MarketFillUpdateOne) SetSellOrderID(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.ResetSellOrderID()
+	mfuo.mutation.SetSellOrderID(i)
+	return mfuo
+}
+
+// SetNillableSellOrderID sets the "sell_order_id" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableSellOrderID(i *int64) *MarketFillUpdateOne {
+	if i != nil {
+		mfuo.SetSellOrderID(*i)
+	}
+	return mfuo
+}
+
+// AddSellOrderID adds i to the "sell_order_id" field.
+func (mfuo *MarketFillUpdateOne) AddSellOrderID(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.AddSellOrderID(i)
+	return mfuo
+}
+
+// SetBuyOrderID sets the "buy_order_id" field.
+func (mfuo *MarketFillUpdateOne) SetBuyOrderID(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.ResetBuyOrderID()
+	mfuo.mutation.SetBuyOrderID(i)
+	return mfuo
+}
+
+// SetNillableBuyOrderID sets the "buy_order_id" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableBuyOrderID(i *int64) *MarketFillUpdateOne {
+	if i != nil {
+		mfuo.SetBuyOrderID(*i)
+	}
+	return mfuo
+}
+
+// AddBuyOrderID adds i to the "buy_order_id" field.
+func (mfuo *MarketFillUpdateOne) AddBuyOrderID(i int64) *MarketFillUpdateOne {
+	mfuo.mutation.AddBuyOrderID(i)
+	return mfuo
+}
+
+// SetSellerID sets the "seller_id" field.
+func (mfuo *MarketFillUpdateOne) SetSellerID(s string) *MarketFillUpdateOne {
+	mfuo.mutation.SetSellerID(s)
+	return mfuo
+}
+
+// SetNillableSellerID sets the "seller_id" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableSellerID(s *string) *MarketFillUpdateOne {
+	if s != nil {
+		mfuo.SetSellerID(*s)
+	}
+	return mfuo
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (mfuo *MarketFillUpdateOne) SetBuyerID(s string) *MarketFillUpdateOne {
+	mfuo.mutation.SetBuyerID(s)
+	return mfuo
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (mfuo *MarketFillUpdateOne) SetNillableBuyerID(s *string) *MarketFillUpdateOne {
+	if s != nil {
+		mfuo.SetBuyerID(*s)
+	}
+	return mfuo
+}
+
+// Mutation returns the MarketFillMutation object of the builder.
+func (mfuo *MarketFillUpdateOne) Mutation() *MarketFillMutation {
+	return mfuo.mutation
+}
+
+// Where appends a list predicates to the MarketFillUpdate builder.
+func (mfuo *MarketFillUpdateOne) Where(ps ...predicate.MarketFill) *MarketFillUpdateOne {
+	mfuo.mutation.Where(ps...)
+	return mfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mfuo *MarketFillUpdateOne) Select(field string, fields ...string) *MarketFillUpdateOne {
+	mfuo.fields = append([]string{field}, fields...)
+	return mfuo
+}
+
+// Save executes the query and returns the updated MarketFill entity.
+func (mfuo *MarketFillUpdateOne) Save(ctx context.Context) (*MarketFill, error) {
+	return withHooks(ctx, mfuo.sqlSave, mfuo.mutation, mfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mfuo *MarketFillUpdateOne) SaveX(ctx context.Context) *MarketFill {
+	node, err := mfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mfuo *MarketFillUpdateOne) Exec(ctx context.Context) error {
+	_, err := mfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mfuo *MarketFillUpdateOne) ExecX(ctx context.Context) {
+	if err := mfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mfuo *MarketFillUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MarketFillUpdateOne {
+	mfuo.modifiers = append(mfuo.modifiers, modifiers...)
+	return mfuo
+}
+
+func (mfuo *MarketFillUpdateOne) sqlSave(ctx context.Context) (_node *MarketFill, err error) {
+	_spec := sqlgraph.NewUpdateSpec(marketfill.Table, marketfill.Columns, sqlgraph.NewFieldSpec(marketfill.FieldID, field.TypeInt64))
+	id, ok := mfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "MarketFill.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, marketfill.FieldID)
+		for _, f := range fields {
+			if !marketfill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != marketfill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mfuo.mutation.ItemID(); ok {
+		_spec.SetField(marketfill.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.Currency(); ok {
+		_spec.SetField(marketfill.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.Price(); ok {
+		_spec.SetField(marketfill.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.AddedPrice(); ok {
+		_spec.AddField(marketfill.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.Quantity(); ok {
+		_spec.SetField(marketfill.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(marketfill.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.Fee(); ok {
+		_spec.SetField(marketfill.FieldFee, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.AddedFee(); ok {
+		_spec.AddField(marketfill.FieldFee, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.SellOrderID(); ok {
+		_spec.SetField(marketfill.FieldSellOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.AddedSellOrderID(); ok {
+		_spec.AddField(marketfill.FieldSellOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.BuyOrderID(); ok {
+		_spec.SetField(marketfill.FieldBuyOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.AddedBuyOrderID(); ok {
+		_spec.AddField(marketfill.FieldBuyOrderID, field.TypeInt64, value)
+	}
+	if value, ok := mfuo.mutation.SellerID(); ok {
+		_spec.SetField(marketfill.FieldSellerID, field.TypeString, value)
+	}
+	if value, ok := mfuo.mutation.BuyerID(); ok {
+		_spec.SetField(marketfill.FieldBuyerID, field.TypeString, value)
+	}
+	_spec.AddModifiers(mfuo.modifiers...)
+	_node = &MarketFill{config: mfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{marketfill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mfuo.mutation.done = true
+	return _node, nil
+}
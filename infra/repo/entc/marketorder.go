@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// MarketOrder is the model entity for the MarketOrder schema.
+type MarketOrder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID string `json:"player_id,omitempty"`
+	// Side holds the value of the "side" field.
+	Side string `json:"side,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Price holds the value of the "price" field.
+	Price int64 `json:"price,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int64 `json:"quantity,omitempty"`
+	// Remaining holds the value of the "remaining" field.
+	Remaining int64 `json:"remaining,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MarketOrderQuery when eager-loading is set.
+	Edges        MarketOrderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MarketOrderEdges holds the relations/edges for other nodes in the graph.
+type MarketOrderEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MarketOrderEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MarketOrder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case marketorder.FieldID, marketorder.FieldPrice, marketorder.FieldQuantity, marketorder.FieldRemaining, marketorder.FieldCreatedAt, marketorder.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case marketorder.FieldPlayerID, marketorder.FieldSide, marketorder.FieldItemID, marketorder.FieldCurrency, marketorder.FieldStatus:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MarketOrder fields.
+func (mo *MarketOrder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case marketorder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mo.ID = int64(value.Int64)
+		case marketorder.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				mo.PlayerID = value.String
+			}
+		case marketorder.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				mo.Side = value.String
+			}
+		case marketorder.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				mo.ItemID = value.String
+			}
+		case marketorder.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				mo.Currency = value.String
+			}
+		case marketorder.FieldPrice:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				mo.Price = value.Int64
+			}
+		case marketorder.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				mo.Quantity = value.Int64
+			}
+		case marketorder.FieldRemaining:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining", values[i])
+			} else if value.Valid {
+				mo.Remaining = value.Int64
+			}
+		case marketorder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				mo.Status = value.String
+			}
+		case marketorder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mo.CreatedAt = value.Int64
+			}
+		case marketorder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mo.UpdatedAt = value.Int64
+			}
+		default:
+			mo.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MarketOrder.
+// This includes values selected through modifiers, order, etc.
+func (mo *MarketOrder) Value(name string) (ent.Value, error) {
+	return mo.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the MarketOrder entity.
+func (mo *MarketOrder) QueryPlayer() *PlayerQuery {
+	return NewMarketOrderClient(mo.config).QueryPlayer(mo)
+}
+
+// Update returns a builder for updating this MarketOrder.
+// Note that you need to call MarketOrder.Unwrap() before calling this method if this MarketOrder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mo *MarketOrder) Update() *MarketOrderUpdateOne {
+	return NewMarketOrderClient(mo.config).UpdateOne(mo)
+}
+
+// Unwrap unwraps the MarketOrder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mo *MarketOrder) Unwrap() *MarketOrder {
+	_tx, ok := mo.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: MarketOrder is not a transactional entity")
+	}
+	mo.config.driver = _tx.drv
+	return mo
+}
+
+// String implements the fmt.Stringer.
+func (mo *MarketOrder) String() string {
+	var builder strings.Builder
+	builder.WriteString("MarketOrder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mo.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(mo.PlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(mo.Side)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(mo.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(mo.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", mo.Price))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", mo.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("remaining=")
+	builder.WriteString(fmt.Sprintf("%v", mo.Remaining))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(mo.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", mo.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", mo.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MarketOrders is a parsable slice of MarketOrder.
+type MarketOrders []*MarketOrder
@@ -0,0 +1,667 @@
+// Code generated by ent, DO NOT EDIT.
+
+package marketorder
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldID, id))
+}
+
+// PlayerID applies equality check predicate on the "player_id" field. It's identical to PlayerIDEQ.
+func PlayerID(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// Side applies equality check predicate on the "side" field. It's identical to SideEQ.
+func Side(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldSide, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldItemID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldPrice, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Remaining applies equality check predicate on the "remaining" field. It's identical to RemainingEQ.
+func Remaining(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldRemaining, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PlayerIDEQ applies the EQ predicate on the "player_id" field.
+func PlayerIDEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// PlayerIDNEQ applies the NEQ predicate on the "player_id" field.
+func PlayerIDNEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldPlayerID, v))
+}
+
+// PlayerIDIn applies the In predicate on the "player_id" field.
+func PlayerIDIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDNotIn applies the NotIn predicate on the "player_id" field.
+func PlayerIDNotIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDGT applies the GT predicate on the "player_id" field.
+func PlayerIDGT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldPlayerID, v))
+}
+
+// PlayerIDGTE applies the GTE predicate on the "player_id" field.
+func PlayerIDGTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldPlayerID, v))
+}
+
+// PlayerIDLT applies the LT predicate on the "player_id" field.
+func PlayerIDLT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldPlayerID, v))
+}
+
+// PlayerIDLTE applies the LTE predicate on the "player_id" field.
+func PlayerIDLTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldPlayerID, v))
+}
+
+// PlayerIDContains applies the Contains predicate on the "player_id" field.
+func PlayerIDContains(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContains(FieldPlayerID, v))
+}
+
+// PlayerIDHasPrefix applies the HasPrefix predicate on the "player_id" field.
+func PlayerIDHasPrefix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasPrefix(FieldPlayerID, v))
+}
+
+// PlayerIDHasSuffix applies the HasSuffix predicate on the "player_id" field.
+func PlayerIDHasSuffix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasSuffix(FieldPlayerID, v))
+}
+
+// PlayerIDEqualFold applies the EqualFold predicate on the "player_id" field.
+func PlayerIDEqualFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEqualFold(FieldPlayerID, v))
+}
+
+// PlayerIDContainsFold applies the ContainsFold predicate on the "player_id" field.
+func PlayerIDContainsFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContainsFold(FieldPlayerID, v))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// SideGT applies the GT predicate on the "side" field.
+func SideGT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldSide, v))
+}
+
+// SideGTE applies the GTE predicate on the "side" field.
+func SideGTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldSide, v))
+}
+
+// SideLT applies the LT predicate on the "side" field.
+func SideLT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldSide, v))
+}
+
+// SideLTE applies the LTE predicate on the "side" field.
+func SideLTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldSide, v))
+}
+
+// SideContains applies the Contains predicate on the "side" field.
+func SideContains(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContains(FieldSide, v))
+}
+
+// SideHasPrefix applies the HasPrefix predicate on the "side" field.
+func SideHasPrefix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasPrefix(FieldSide, v))
+}
+
+// SideHasSuffix applies the HasSuffix predicate on the "side" field.
+func SideHasSuffix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasSuffix(FieldSide, v))
+}
+
+// SideEqualFold applies the EqualFold predicate on the "side" field.
+func SideEqualFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEqualFold(FieldSide, v))
+}
+
+// SideContainsFold applies the ContainsFold predicate on the "side" field.
+func SideContainsFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContainsFold(FieldSide, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldPrice, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldQuantity, v))
+}
+
+// RemainingEQ applies the EQ predicate on the "remaining" field.
+func RemainingEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldRemaining, v))
+}
+
+// RemainingNEQ applies the NEQ predicate on the "remaining" field.
+func RemainingNEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldRemaining, v))
+}
+
+// RemainingIn applies the In predicate on the "remaining" field.
+func RemainingIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldRemaining, vs...))
+}
+
+// RemainingNotIn applies the NotIn predicate on the "remaining" field.
+func RemainingNotIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldRemaining, vs...))
+}
+
+// RemainingGT applies the GT predicate on the "remaining" field.
+func RemainingGT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldRemaining, v))
+}
+
+// RemainingGTE applies the GTE predicate on the "remaining" field.
+func RemainingGTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldRemaining, v))
+}
+
+// RemainingLT applies the LT predicate on the "remaining" field.
+func RemainingLT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldRemaining, v))
+}
+
+// RemainingLTE applies the LTE predicate on the "remaining" field.
+func RemainingLTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldRemaining, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.MarketOrder {
+	return predicate.MarketOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.MarketOrder {
+	return predicate.MarketOrder(func(s *sql.Selector) {
+		step := newPlayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MarketOrder) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MarketOrder) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MarketOrder) predicate.MarketOrder {
+	return predicate.MarketOrder(sql.NotPredicates(p))
+}
@@ -0,0 +1,1071 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// MarketOrderCreate is the builder for creating a MarketOrder entity.
+type MarketOrderCreate struct {
+	config
+	mutation *MarketOrderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlayerID sets the "player_id" field.
+func (moc *MarketOrderCreate) SetPlayerID(s string) *MarketOrderCreate {
+	moc.mutation.SetPlayerID(s)
+	return moc
+}
+
+// SetSide sets the "side" field.
+func (moc *MarketOrderCreate) SetSide(s string) *MarketOrderCreate {
+	moc.mutation.SetSide(s)
+	return moc
+}
+
+// SetItemID sets the "item_id" field.
+func (moc *MarketOrderCreate) SetItemID(s string) *MarketOrderCreate {
+	moc.mutation.SetItemID(s)
+	return moc
+}
+
+// SetCurrency sets the "currency" field.
+func (moc *MarketOrderCreate) SetCurrency(s string) *MarketOrderCreate {
+	moc.mutation.SetCurrency(s)
+	return moc
+}
+
+// SetPrice sets the "price" field.
+func (moc *MarketOrderCreate) SetPrice(i int64) *MarketOrderCreate {
+	moc.mutation.SetPrice(i)
+	return moc
+}
+
+// SetQuantity sets the "quantity" field.
+func (moc *MarketOrderCreate) SetQuantity(i int64) *MarketOrderCreate {
+	moc.mutation.SetQuantity(i)
+	return moc
+}
+
+// SetRemaining sets the "remaining" field.
+func (moc *MarketOrderCreate) SetRemaining(i int64) *MarketOrderCreate {
+	moc.mutation.SetRemaining(i)
+	return moc
+}
+
+// SetStatus sets the "status" field.
+func (moc *MarketOrderCreate) SetStatus(s string) *MarketOrderCreate {
+	moc.mutation.SetStatus(s)
+	return moc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (moc *MarketOrderCreate) SetNillableStatus(s *string) *MarketOrderCreate {
+	if s != nil {
+		moc.SetStatus(*s)
+	}
+	return moc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (moc *MarketOrderCreate) SetCreatedAt(i int64) *MarketOrderCreate {
+	moc.mutation.SetCreatedAt(i)
+	return moc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (moc *MarketOrderCreate) SetNillableCreatedAt(i *int64) *MarketOrderCreate {
+	if i != nil {
+		moc.SetCreatedAt(*i)
+	}
+	return moc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (moc *MarketOrderCreate) SetUpdatedAt(i int64) *MarketOrderCreate {
+	moc.mutation.SetUpdatedAt(i)
+	return moc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (moc *MarketOrderCreate) SetNillableUpdatedAt(i *int64) *MarketOrderCreate {
+	if i != nil {
+		moc.SetUpdatedAt(*i)
+	}
+	return moc
+}
+
+// SetID sets the "id" field.
+func (moc *MarketOrderCreate) SetID(i int64) *MarketOrderCreate {
+	moc.mutation.SetID(i)
+	return moc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (moc *MarketOrderCreate) SetPlayer(p *Player) *MarketOrderCreate {
+	return moc.SetPlayerID(p.ID)
+}
+
+// Mutation returns the MarketOrderMutation object of the builder.
+func (moc *MarketOrderCreate) Mutation() *MarketOrderMutation {
+	return moc.mutation
+}
+
+// Save creates the MarketOrder in the database.
+func (moc *MarketOrderCreate) Save(ctx context.Context) (*MarketOrder, error) {
+	moc.defaults()
+	return withHooks(ctx, moc.sqlSave, moc.mutation, moc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (moc *MarketOrderCreate) SaveX(ctx context.Context) *MarketOrder {
+	v, err := moc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (moc *MarketOrderCreate) Exec(ctx context.Context) error {
+	_, err := moc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (moc *MarketOrderCreate) ExecX(ctx context.Context) {
+	if err := moc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (moc *MarketOrderCreate) defaults() {
+	if _, ok := moc.mutation.Status(); !ok {
+		v := marketorder.DefaultStatus
+		moc.mutation.SetStatus(v)
+	}
+	if _, ok := moc.mutation.CreatedAt(); !ok {
+		v := marketorder.DefaultCreatedAt()
+		moc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := moc.mutation.UpdatedAt(); !ok {
+		v := marketorder.DefaultUpdatedAt()
+		moc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (moc *MarketOrderCreate) check() error {
+	if _, ok := moc.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`entc: missing required field "MarketOrder.player_id"`)}
+	}
+	if _, ok := moc.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`entc: missing required field "MarketOrder.side"`)}
+	}
+	if _, ok := moc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`entc: missing required field "MarketOrder.item_id"`)}
+	}
+	if _, ok := moc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`entc: missing required field "MarketOrder.currency"`)}
+	}
+	if _, ok := moc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`entc: missing required field "MarketOrder.price"`)}
+	}
+	if _, ok := moc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`entc: missing required field "MarketOrder.quantity"`)}
+	}
+	if _, ok := moc.mutation.Remaining(); !ok {
+		return &ValidationError{Name: "remaining", err: errors.New(`entc: missing required field "MarketOrder.remaining"`)}
+	}
+	if _, ok := moc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entc: missing required field "MarketOrder.status"`)}
+	}
+	if _, ok := moc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "MarketOrder.created_at"`)}
+	}
+	if _, ok := moc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "MarketOrder.updated_at"`)}
+	}
+	if len(moc.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`entc: missing required edge "MarketOrder.player"`)}
+	}
+	return nil
+}
+
+func (moc *MarketOrderCreate) sqlSave(ctx context.Context) (*MarketOrder, error) {
+	if err := moc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := moc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, moc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	moc.mutation.id = &_node.ID
+	moc.mutation.done = true
+	return _node, nil
+}
+
+func (moc *MarketOrderCreate) createSpec() (*MarketOrder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MarketOrder{config: moc.config}
+		_spec = sqlgraph.NewCreateSpec(marketorder.Table, sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = moc.conflict
+	if id, ok := moc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := moc.mutation.Side(); ok {
+		_spec.SetField(marketorder.FieldSide, field.TypeString, value)
+		_node.Side = value
+	}
+	if value, ok := moc.mutation.ItemID(); ok {
+		_spec.SetField(marketorder.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := moc.mutation.Currency(); ok {
+		_spec.SetField(marketorder.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := moc.mutation.Price(); ok {
+		_spec.SetField(marketorder.FieldPrice, field.TypeInt64, value)
+		_node.Price = value
+	}
+	if value, ok := moc.mutation.Quantity(); ok {
+		_spec.SetField(marketorder.FieldQuantity, field.TypeInt64, value)
+		_node.Quantity = value
+	}
+	if value, ok := moc.mutation.Remaining(); ok {
+		_spec.SetField(marketorder.FieldRemaining, field.TypeInt64, value)
+		_node.Remaining = value
+	}
+	if value, ok := moc.mutation.Status(); ok {
+		_spec.SetField(marketorder.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := moc.mutation.CreatedAt(); ok {
+		_spec.SetField(marketorder.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := moc.mutation.UpdatedAt(); ok {
+		_spec.SetField(marketorder.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := moc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   marketorder.PlayerTable,
+			Columns: []string{marketorder.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlayerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MarketOrder.Create().
+//		SetPlayerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MarketOrderUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (moc *MarketOrderCreate) OnConflict(opts ...sql.ConflictOption) *MarketOrderUpsertOne {
+	moc.conflict = opts
+	return &MarketOrderUpsertOne{
+		create: moc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MarketOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (moc *MarketOrderCreate) OnConflictColumns(columns ...string) *MarketOrderUpsertOne {
+	moc.conflict = append(moc.conflict, sql.ConflictColumns(columns...))
+	return &MarketOrderUpsertOne{
+		create: moc,
+	}
+}
+
+type (
+	// MarketOrderUpsertOne is the builder for "upsert"-ing
+	//  one MarketOrder node.
+	MarketOrderUpsertOne struct {
+		create *MarketOrderCreate
+	}
+
+	// MarketOrderUpsert is the "OnConflict" setter.
+	MarketOrderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlayerID sets the "player_id" field.
+func (u *MarketOrderUpsert) SetPlayerID(v string) *MarketOrderUpsert {
+	u.Set(marketorder.FieldPlayerID, v)
+	return u
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdatePlayerID() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldPlayerID)
+	return u
+}
+
+// SetSide sets the "side" field.
+func (u *MarketOrderUpsert) SetSide(v string) *MarketOrderUpsert {
+	u.Set(marketorder.FieldSide, v)
+	return u
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdateSide() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldSide)
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *MarketOrderUpsert) SetItemID(v string) *MarketOrderUpsert {
+	u.Set(marketorder.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdateItemID() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldItemID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *MarketOrderUpsert) SetCurrency(v string) *MarketOrderUpsert {
+	u.Set(marketorder.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdateCurrency() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldCurrency)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *MarketOrderUpsert) SetPrice(v int64) *MarketOrderUpsert {
+	u.Set(marketorder.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdatePrice() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *MarketOrderUpsert) AddPrice(v int64) *MarketOrderUpsert {
+	u.Add(marketorder.FieldPrice, v)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *MarketOrderUpsert) SetQuantity(v int64) *MarketOrderUpsert {
+	u.Set(marketorder.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdateQuantity() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *MarketOrderUpsert) AddQuantity(v int64) *MarketOrderUpsert {
+	u.Add(marketorder.FieldQuantity, v)
+	return u
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *MarketOrderUpsert) SetRemaining(v int64) *MarketOrderUpsert {
+	u.Set(marketorder.FieldRemaining, v)
+	return u
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdateRemaining() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldRemaining)
+	return u
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *MarketOrderUpsert) AddRemaining(v int64) *MarketOrderUpsert {
+	u.Add(marketorder.FieldRemaining, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *MarketOrderUpsert) SetStatus(v string) *MarketOrderUpsert {
+	u.Set(marketorder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdateStatus() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldStatus)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MarketOrderUpsert) SetUpdatedAt(v int64) *MarketOrderUpsert {
+	u.Set(marketorder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MarketOrderUpsert) UpdateUpdatedAt() *MarketOrderUpsert {
+	u.SetExcluded(marketorder.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *MarketOrderUpsert) AddUpdatedAt(v int64) *MarketOrderUpsert {
+	u.Add(marketorder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MarketOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(marketorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MarketOrderUpsertOne) UpdateNewValues() *MarketOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(marketorder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(marketorder.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MarketOrder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MarketOrderUpsertOne) Ignore() *MarketOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MarketOrderUpsertOne) DoNothing() *MarketOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MarketOrderCreate.OnConflict
+// documentation for more info.
+func (u *MarketOrderUpsertOne) Update(set func(*MarketOrderUpsert)) *MarketOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MarketOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *MarketOrderUpsertOne) SetPlayerID(v string) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdatePlayerID() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *MarketOrderUpsertOne) SetSide(v string) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdateSide() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *MarketOrderUpsertOne) SetItemID(v string) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdateItemID() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *MarketOrderUpsertOne) SetCurrency(v string) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdateCurrency() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *MarketOrderUpsertOne) SetPrice(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *MarketOrderUpsertOne) AddPrice(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdatePrice() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *MarketOrderUpsertOne) SetQuantity(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *MarketOrderUpsertOne) AddQuantity(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdateQuantity() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *MarketOrderUpsertOne) SetRemaining(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetRemaining(v)
+	})
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *MarketOrderUpsertOne) AddRemaining(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddRemaining(v)
+	})
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdateRemaining() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateRemaining()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *MarketOrderUpsertOne) SetStatus(v string) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdateStatus() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MarketOrderUpsertOne) SetUpdatedAt(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *MarketOrderUpsertOne) AddUpdatedAt(v int64) *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MarketOrderUpsertOne) UpdateUpdatedAt() *MarketOrderUpsertOne {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MarketOrderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for MarketOrderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MarketOrderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MarketOrderUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MarketOrderUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MarketOrderCreateBulk is the builder for creating many MarketOrder entities in bulk.
+type MarketOrderCreateBulk struct {
+	config
+	err      error
+	builders []*MarketOrderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MarketOrder entities in the database.
+func (mocb *MarketOrderCreateBulk) Save(ctx context.Context) ([]*MarketOrder, error) {
+	if mocb.err != nil {
+		return nil, mocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mocb.builders))
+	nodes := make([]*MarketOrder, len(mocb.builders))
+	mutators := make([]Mutator, len(mocb.builders))
+	for i := range mocb.builders {
+		func(i int, root context.Context) {
+			builder := mocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MarketOrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mocb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mocb *MarketOrderCreateBulk) SaveX(ctx context.Context) []*MarketOrder {
+	v, err := mocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mocb *MarketOrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := mocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mocb *MarketOrderCreateBulk) ExecX(ctx context.Context) {
+	if err := mocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MarketOrder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MarketOrderUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (mocb *MarketOrderCreateBulk) OnConflict(opts ...sql.ConflictOption) *MarketOrderUpsertBulk {
+	mocb.conflict = opts
+	return &MarketOrderUpsertBulk{
+		create: mocb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MarketOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mocb *MarketOrderCreateBulk) OnConflictColumns(columns ...string) *MarketOrderUpsertBulk {
+	mocb.conflict = append(mocb.conflict, sql.ConflictColumns(columns...))
+	return &MarketOrderUpsertBulk{
+		create: mocb,
+	}
+}
+
+// MarketOrderUpsertBulk is the builder for "upsert"-ing
+// a bulk of MarketOrder nodes.
+type MarketOrderUpsertBulk struct {
+	create *MarketOrderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MarketOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(marketorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MarketOrderUpsertBulk) UpdateNewValues() *MarketOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(marketorder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(marketorder.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MarketOrder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MarketOrderUpsertBulk) Ignore() *MarketOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MarketOrderUpsertBulk) DoNothing() *MarketOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MarketOrderCreateBulk.OnConflict
+// documentation for more info.
+func (u *MarketOrderUpsertBulk) Update(set func(*MarketOrderUpsert)) *MarketOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MarketOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *MarketOrderUpsertBulk) SetPlayerID(v string) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdatePlayerID() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *MarketOrderUpsertBulk) SetSide(v string) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdateSide() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetItemID sets the "item_id" field.
+func (u *MarketOrderUpsertBulk) SetItemID(v string) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdateItemID() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *MarketOrderUpsertBulk) SetCurrency(v string) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdateCurrency() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *MarketOrderUpsertBulk) SetPrice(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *MarketOrderUpsertBulk) AddPrice(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdatePrice() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *MarketOrderUpsertBulk) SetQuantity(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *MarketOrderUpsertBulk) AddQuantity(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdateQuantity() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *MarketOrderUpsertBulk) SetRemaining(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetRemaining(v)
+	})
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *MarketOrderUpsertBulk) AddRemaining(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddRemaining(v)
+	})
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdateRemaining() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateRemaining()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *MarketOrderUpsertBulk) SetStatus(v string) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdateStatus() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MarketOrderUpsertBulk) SetUpdatedAt(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *MarketOrderUpsertBulk) AddUpdatedAt(v int64) *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MarketOrderUpsertBulk) UpdateUpdatedAt() *MarketOrderUpsertBulk {
+	return u.Update(func(s *MarketOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MarketOrderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the MarketOrderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for MarketOrderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MarketOrderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
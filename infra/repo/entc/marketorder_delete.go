@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// MarketOrderDelete is the builder for deleting a MarketOrder entity.
+type MarketOrderDelete struct {
+	config
+	hooks    []Hook
+	mutation *MarketOrderMutation
+}
+
+// Where appends a list predicates to the MarketOrderDelete builder.
+func (mod *MarketOrderDelete) Where(ps ...predicate.MarketOrder) *MarketOrderDelete {
+	mod.mutation.Where(ps...)
+	return mod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mod *MarketOrderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mod.sqlExec, mod.mutation, mod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mod *MarketOrderDelete) ExecX(ctx context.Context) int {
+	n, err := mod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mod *MarketOrderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(marketorder.Table, sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64))
+	if ps := mod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mod.mutation.done = true
+	return affected, err
+}
+
+// MarketOrderDeleteOne is the builder for deleting a single MarketOrder entity.
+type MarketOrderDeleteOne struct {
+	mod *MarketOrderDelete
+}
+
+// Where appends a list predicates to the MarketOrderDelete builder.
+func (modo *MarketOrderDeleteOne) Where(ps ...predicate.MarketOrder) *MarketOrderDeleteOne {
+	modo.mod.mutation.Where(ps...)
+	return modo
+}
+
+// Exec executes the deletion query.
+func (modo *MarketOrderDeleteOne) Exec(ctx context.Context) error {
+	n, err := modo.mod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{marketorder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (modo *MarketOrderDeleteOne) ExecX(ctx context.Context) {
+	if err := modo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
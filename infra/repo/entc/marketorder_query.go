@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// MarketOrderQuery is the builder for querying MarketOrder entities.
+type MarketOrderQuery struct {
+	config
+	ctx        *QueryContext
+	order      []marketorder.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MarketOrder
+	withPlayer *PlayerQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MarketOrderQuery builder.
+func (moq *MarketOrderQuery) Where(ps ...predicate.MarketOrder) *MarketOrderQuery {
+	moq.predicates = append(moq.predicates, ps...)
+	return moq
+}
+
+// Limit the number of records to be returned by this query.
+func (moq *MarketOrderQuery) Limit(limit int) *MarketOrderQuery {
+	moq.ctx.Limit = &limit
+	return moq
+}
+
+// Offset to start from.
+func (moq *MarketOrderQuery) Offset(offset int) *MarketOrderQuery {
+	moq.ctx.Offset = &offset
+	return moq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (moq *MarketOrderQuery) Unique(unique bool) *MarketOrderQuery {
+	moq.ctx.Unique = &unique
+	return moq
+}
+
+// Order specifies how the records should be ordered.
+func (moq *MarketOrderQuery) Order(o ...marketorder.OrderOption) *MarketOrderQuery {
+	moq.order = append(moq.order, o...)
+	return moq
+}
+
+// QueryPlayer chains the current query on the "player" edge.
+func (moq *MarketOrderQuery) QueryPlayer() *PlayerQuery {
+	query := (&PlayerClient{config: moq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := moq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := moq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(marketorder.Table, marketorder.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, marketorder.PlayerTable, marketorder.PlayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(moq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MarketOrder entity from the query.
+// Returns a *NotFoundError when no MarketOrder was found.
+func (moq *MarketOrderQuery) First(ctx context.Context) (*MarketOrder, error) {
+	nodes, err := moq.Limit(1).All(setContextOp(ctx, moq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{marketorder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (moq *MarketOrderQuery) FirstX(ctx context.Context) *MarketOrder {
+	node, err := moq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MarketOrder ID from the query.
+// Returns a *NotFoundError when no MarketOrder ID was found.
+func (moq *MarketOrderQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = moq.Limit(1).IDs(setContextOp(ctx, moq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{marketorder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (moq *MarketOrderQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := moq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MarketOrder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MarketOrder entity is found.
+// Returns a *NotFoundError when no MarketOrder entities are found.
+func (moq *MarketOrderQuery) Only(ctx context.Context) (*MarketOrder, error) {
+	nodes, err := moq.Limit(2).All(setContextOp(ctx, moq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{marketorder.Label}
+	default:
+		return nil, &NotSingularError{marketorder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (moq *MarketOrderQuery) OnlyX(ctx context.Context) *MarketOrder {
+	node, err := moq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MarketOrder ID in the query.
+// Returns a *NotSingularError when more than one MarketOrder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (moq *MarketOrderQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = moq.Limit(2).IDs(setContextOp(ctx, moq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{marketorder.Label}
+	default:
+		err = &NotSingularError{marketorder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (moq *MarketOrderQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := moq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MarketOrders.
+func (moq *MarketOrderQuery) All(ctx context.Context) ([]*MarketOrder, error) {
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryAll)
+	if err := moq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MarketOrder, *MarketOrderQuery]()
+	return withInterceptors[[]*MarketOrder](ctx, moq, qr, moq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (moq *MarketOrderQuery) AllX(ctx context.Context) []*MarketOrder {
+	nodes, err := moq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MarketOrder IDs.
+func (moq *MarketOrderQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if moq.ctx.Unique == nil && moq.path != nil {
+		moq.Unique(true)
+	}
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryIDs)
+	if err = moq.Select(marketorder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (moq *MarketOrderQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := moq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (moq *MarketOrderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryCount)
+	if err := moq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, moq, querierCount[*MarketOrderQuery](), moq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (moq *MarketOrderQuery) CountX(ctx context.Context) int {
+	count, err := moq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (moq *MarketOrderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, moq.ctx, ent.OpQueryExist)
+	switch _, err := moq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (moq *MarketOrderQuery) ExistX(ctx context.Context) bool {
+	exist, err := moq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MarketOrderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (moq *MarketOrderQuery) Clone() *MarketOrderQuery {
+	if moq == nil {
+		return nil
+	}
+	return &MarketOrderQuery{
+		config:     moq.config,
+		ctx:        moq.ctx.Clone(),
+		order:      append([]marketorder.OrderOption{}, moq.order...),
+		inters:     append([]Interceptor{}, moq.inters...),
+		predicates: append([]predicate.MarketOrder{}, moq.predicates...),
+		withPlayer: moq.withPlayer.Clone(),
+		// clone intermediate query.
+		sql:       moq.sql.Clone(),
+		path:      moq.path,
+		modifiers: append([]func(*sql.Selector){}, moq.modifiers...),
+	}
+}
+
+// WithPlayer tells the query-builder to eager-load the nodes that are connected to
+// the "player" edge. The optional arguments are used to configure the query builder of the edge.
+func (moq *MarketOrderQuery) WithPlayer(opts ...func(*PlayerQuery)) *MarketOrderQuery {
+	query := (&PlayerClient{config: moq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	moq.withPlayer = query
+	return moq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MarketOrder.Query().
+//		GroupBy(marketorder.FieldPlayerID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (moq *MarketOrderQuery) GroupBy(field string, fields ...string) *MarketOrderGroupBy {
+	moq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MarketOrderGroupBy{build: moq}
+	grbuild.flds = &moq.ctx.Fields
+	grbuild.label = marketorder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//	}
+//
+//	client.MarketOrder.Query().
+//		Select(marketorder.FieldPlayerID).
+//		Scan(ctx, &v)
+func (moq *MarketOrderQuery) Select(fields ...string) *MarketOrderSelect {
+	moq.ctx.Fields = append(moq.ctx.Fields, fields...)
+	sbuild := &MarketOrderSelect{MarketOrderQuery: moq}
+	sbuild.label = marketorder.Label
+	sbuild.flds, sbuild.scan = &moq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MarketOrderSelect configured with the given aggregations.
+func (moq *MarketOrderQuery) Aggregate(fns ...AggregateFunc) *MarketOrderSelect {
+	return moq.Select().Aggregate(fns...)
+}
+
+func (moq *MarketOrderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range moq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, moq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range moq.ctx.Fields {
+		if !marketorder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if moq.path != nil {
+		prev, err := moq.path(ctx)
+		if err != nil {
+			return err
+		}
+		moq.sql = prev
+	}
+	return nil
+}
+
+func (moq *MarketOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MarketOrder, error) {
+	var (
+		nodes       = []*MarketOrder{}
+		_spec       = moq.querySpec()
+		loadedTypes = [1]bool{
+			moq.withPlayer != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MarketOrder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MarketOrder{config: moq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(moq.modifiers) > 0 {
+		_spec.Modifiers = moq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, moq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := moq.withPlayer; query != nil {
+		if err := moq.loadPlayer(ctx, query, nodes, nil,
+			func(n *MarketOrder, e *Player) { n.Edges.Player = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (moq *MarketOrderQuery) loadPlayer(ctx context.Context, query *PlayerQuery, nodes []*MarketOrder, init func(*MarketOrder), assign func(*MarketOrder, *Player)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*MarketOrder)
+	for i := range nodes {
+		fk := nodes[i].PlayerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(player.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "player_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (moq *MarketOrderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := moq.querySpec()
+	if len(moq.modifiers) > 0 {
+		_spec.Modifiers = moq.modifiers
+	}
+	_spec.Node.Columns = moq.ctx.Fields
+	if len(moq.ctx.Fields) > 0 {
+		_spec.Unique = moq.ctx.Unique != nil && *moq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, moq.driver, _spec)
+}
+
+func (moq *MarketOrderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(marketorder.Table, marketorder.Columns, sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64))
+	_spec.From = moq.sql
+	if unique := moq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if moq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := moq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, marketorder.FieldID)
+		for i := range fields {
+			if fields[i] != marketorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if moq.withPlayer != nil {
+			_spec.Node.AddColumnOnce(marketorder.FieldPlayerID)
+		}
+	}
+	if ps := moq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := moq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := moq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := moq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (moq *MarketOrderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(moq.driver.Dialect())
+	t1 := builder.Table(marketorder.Table)
+	columns := moq.ctx.Fields
+	if len(columns) == 0 {
+		columns = marketorder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if moq.sql != nil {
+		selector = moq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if moq.ctx.Unique != nil && *moq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range moq.modifiers {
+		m(selector)
+	}
+	for _, p := range moq.predicates {
+		p(selector)
+	}
+	for _, p := range moq.order {
+		p(selector)
+	}
+	if offset := moq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := moq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (moq *MarketOrderQuery) Modify(modifiers ...func(s *sql.Selector)) *MarketOrderSelect {
+	moq.modifiers = append(moq.modifiers, modifiers...)
+	return moq.Select()
+}
+
+// MarketOrderGroupBy is the group-by builder for MarketOrder entities.
+type MarketOrderGroupBy struct {
+	selector
+	build *MarketOrderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mogb *MarketOrderGroupBy) Aggregate(fns ...AggregateFunc) *MarketOrderGroupBy {
+	mogb.fns = append(mogb.fns, fns...)
+	return mogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mogb *MarketOrderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mogb.build.ctx, ent.OpQueryGroupBy)
+	if err := mogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MarketOrderQuery, *MarketOrderGroupBy](ctx, mogb.build, mogb, mogb.build.inters, v)
+}
+
+func (mogb *MarketOrderGroupBy) sqlScan(ctx context.Context, root *MarketOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mogb.fns))
+	for _, fn := range mogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mogb.flds)+len(mogb.fns))
+		for _, f := range *mogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MarketOrderSelect is the builder for selecting fields of MarketOrder entities.
+type MarketOrderSelect struct {
+	*MarketOrderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mos *MarketOrderSelect) Aggregate(fns ...AggregateFunc) *MarketOrderSelect {
+	mos.fns = append(mos.fns, fns...)
+	return mos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mos *MarketOrderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mos.ctx, ent.OpQuerySelect)
+	if err := mos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MarketOrderQuery, *MarketOrderSelect](ctx, mos.MarketOrderQuery, mos, mos.inters, v)
+}
+
+func (mos *MarketOrderSelect) sqlScan(ctx context.Context, root *MarketOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mos.fns))
+	for _, fn := range mos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mos *MarketOrderSelect) Modify(modifiers ...func(s *sql.Selector)) *MarketOrderSelect {
+	mos.modifiers = append(mos.modifiers, modifiers...)
+	return mos
+}
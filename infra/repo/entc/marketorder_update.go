@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// MarketOrderUpdate is the builder for updating MarketOrder entities.
+type MarketOrderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MarketOrderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MarketOrderUpdate builder.
+func (mou *MarketOrderUpdate) Where(ps ...predicate.MarketOrder) *MarketOrderUpdate {
+	mou.mutation.Where(ps...)
+	return mou
+}
+
+// SetPlayerID sets the "player_id" field.
+func (mou *MarketOrderUpdate) SetPlayerID(s string) *MarketOrderUpdate {
+	mou.mutation.SetPlayerID(s)
+	return mou
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillablePlayerID(s *string) *MarketOrderUpdate {
+	if s != nil {
+		mou.SetPlayerID(*s)
+	}
+	return mou
+}
+
+// SetSide sets the "side" field.
+func (mou *MarketOrderUpdate) SetSide(s string) *MarketOrderUpdate {
+	mou.mutation.SetSide(s)
+	return mou
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillableSide(s *string) *MarketOrderUpdate {
+	if s != nil {
+		mou.SetSide(*s)
+	}
+	return mou
+}
+
+// SetItemID sets the "item_id" field.
+func (mou *MarketOrderUpdate) SetItemID(s string) *MarketOrderUpdate {
+	mou.mutation.SetItemID(s)
+	return mou
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillableItemID(s *string) *MarketOrderUpdate {
+	if s != nil {
+		mou.SetItemID(*s)
+	}
+	return mou
+}
+
+// SetCurrency sets the "currency" field.
+func (mou *MarketOrderUpdate) SetCurrency(s string) *MarketOrderUpdate {
+	mou.mutation.SetCurrency(s)
+	return mou
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillableCurrency(s *string) *MarketOrderUpdate {
+	if s != nil {
+		mou.SetCurrency(*s)
+	}
+	return mou
+}
+
+// SetPrice sets the "price" field.
+func (mou *MarketOrderUpdate) SetPrice(i int64) *MarketOrderUpdate {
+	mou.mutation.ResetPrice()
+	mou.mutation.SetPrice(i)
+	return mou
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillablePrice(i *int64) *MarketOrderUpdate {
+	if i != nil {
+		mou.SetPrice(*i)
+	}
+	return mou
+}
+
+// AddPrice adds i to the "price" field.
+func (mou *MarketOrderUpdate) AddPrice(i int64) *MarketOrderUpdate {
+	mou.mutation.AddPrice(i)
+	return mou
+}
+
+// SetQuantity sets the "quantity" field.
+func (mou *MarketOrderUpdate) SetQuantity(i int64) *MarketOrderUpdate {
+	mou.mutation.ResetQuantity()
+	mou.mutation.SetQuantity(i)
+	return mou
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillableQuantity(i *int64) *MarketOrderUpdate {
+	if i != nil {
+		mou.SetQuantity(*i)
+	}
+	return mou
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (mou *MarketOrderUpdate) AddQuantity(i int64) *MarketOrderUpdate {
+	mou.mutation.AddQuantity(i)
+	return mou
+}
+
+// SetRemaining sets the "remaining" field.
+func (mou *MarketOrderUpdate) SetRemaining(i int64) *MarketOrderUpdate {
+	mou.mutation.ResetRemaining()
+	mou.mutation.SetRemaining(i)
+	return mou
+}
+
+// SetNillableRemaining sets the "remaining" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillableRemaining(i *int64) *MarketOrderUpdate {
+	if i != nil {
+		mou.SetRemaining(*i)
+	}
+	return mou
+}
+
+// AddRemaining adds i to the "remaining" field.
+func (mou *MarketOrderUpdate) AddRemaining(i int64) *MarketOrderUpdate {
+	mou.mutation.AddRemaining(i)
+	return mou
+}
+
+// SetStatus sets the "status" field.
+func (mou *MarketOrderUpdate) SetStatus(s string) *MarketOrderUpdate {
+	mou.mutation.SetStatus(s)
+	return mou
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mou *MarketOrderUpdate) SetNillableStatus(s *string) *MarketOrderUpdate {
+	if s != nil {
+		mou.SetStatus(*s)
+	}
+	return mou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mou *MarketOrderUpdate) SetUpdatedAt(i int64) *MarketOrderUpdate {
+	mou.mutation.ResetUpdatedAt()
+	mou.mutation.SetUpdatedAt(i)
+	return mou
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (mou *MarketOrderUpdate) AddUpdatedAt(i int64) *MarketOrderUpdate {
+	mou.mutation.AddUpdatedAt(i)
+	return mou
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (mou *MarketOrderUpdate) SetPlayer(p *Player) *MarketOrderUpdate {
+	return mou.SetPlayerID(p.ID)
+}
+
+// Mutation returns the MarketOrderMutation object of the builder.
+func (mou *MarketOrderUpdate) Mutation() *MarketOrderMutation {
+	return mou.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (mou *MarketOrderUpdate) ClearPlayer() *MarketOrderUpdate {
+	mou.mutation.ClearPlayer()
+	return mou
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mou *MarketOrderUpdate) Save(ctx context.Context) (int, error) {
+	mou.defaults()
+	return withHooks(ctx, mou.sqlSave, mou.mutation, mou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mou *MarketOrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := mou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mou *MarketOrderUpdate) Exec(ctx context.Context) error {
+	_, err := mou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mou *MarketOrderUpdate) ExecX(ctx context.Context) {
+	if err := mou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mou *MarketOrderUpdate) defaults() {
+	if _, ok := mou.mutation.UpdatedAt(); !ok {
+		v := marketorder.UpdateDefaultUpdatedAt()
+		mou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mou *MarketOrderUpdate) check() error {
+	if mou.mutation.PlayerCleared() && len(mou.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "MarketOrder.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mou *MarketOrderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MarketOrderUpdate {
+	mou.modifiers = append(mou.modifiers, modifiers...)
+	return mou
+}
+
+func (mou *MarketOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(marketorder.Table, marketorder.Columns, sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64))
+	if ps := mou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mou.mutation.Side(); ok {
+		_spec.SetField(marketorder.FieldSide, field.TypeString, value)
+	}
+	if value, ok := mou.mutation.ItemID(); ok {
+		_spec.SetField(marketorder.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := mou.mutation.Currency(); ok {
+		_spec.SetField(marketorder.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := mou.mutation.Price(); ok {
+		_spec.SetField(marketorder.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mou.mutation.AddedPrice(); ok {
+		_spec.AddField(marketorder.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mou.mutation.Quantity(); ok {
+		_spec.SetField(marketorder.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mou.mutation.AddedQuantity(); ok {
+		_spec.AddField(marketorder.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mou.mutation.Remaining(); ok {
+		_spec.SetField(marketorder.FieldRemaining, field.TypeInt64, value)
+	}
+	if value, ok := mou.mutation.AddedRemaining(); ok {
+		_spec.AddField(marketorder.FieldRemaining, field.TypeInt64, value)
+	}
+	if value, ok := mou.mutation.Status(); ok {
+		_spec.SetField(marketorder.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := mou.mutation.UpdatedAt(); ok {
+		_spec.SetField(marketorder.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := mou.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(marketorder.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if mou.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   marketorder.PlayerTable,
+			Columns: []string{marketorder.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mou.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   marketorder.PlayerTable,
+			Columns: []string{marketorder.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mou.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{marketorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mou.mutation.done = true
+	return n, nil
+}
+
+// MarketOrderUpdateOne is the builder for updating a single MarketOrder entity.
+type MarketOrderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MarketOrderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPlayerID sets the "player_id" field.
+func (mouo *MarketOrderUpdateOne) SetPlayerID(s string) *MarketOrderUpdateOne {
+	mouo.mutation.SetPlayerID(s)
+	return mouo
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillablePlayerID(s *string) *MarketOrderUpdateOne {
+	if s != nil {
+		mouo.SetPlayerID(*s)
+	}
+	return mouo
+}
+
+// SetSide sets the "side" field.
+func (mouo *MarketOrderUpdateOne) SetSide(s string) *MarketOrderUpdateOne {
+	mouo.mutation.SetSide(s)
+	return mouo
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillableSide(s *string) *MarketOrderUpdateOne {
+	if s != nil {
+		mouo.SetSide(*s)
+	}
+	return mouo
+}
+
+// SetItemID sets the "item_id" field.
+func (mouo *MarketOrderUpdateOne) SetItemID(s string) *MarketOrderUpdateOne {
+	mouo.mutation.SetItemID(s)
+	return mouo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillableItemID(s *string) *MarketOrderUpdateOne {
+	if s != nil {
+		mouo.SetItemID(*s)
+	}
+	return mouo
+}
+
+// SetCurrency sets the "currency" field.
+func (mouo *MarketOrderUpdateOne) SetCurrency(s string) *MarketOrderUpdateOne {
+	mouo.mutation.SetCurrency(s)
+	return mouo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillableCurrency(s *string) *MarketOrderUpdateOne {
+	if s != nil {
+		mouo.SetCurrency(*s)
+	}
+	return mouo
+}
+
+// SetPrice sets the "price" field.
+func (mouo *MarketOrderUpdateOne) SetPrice(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.ResetPrice()
+	mouo.mutation.SetPrice(i)
+	return mouo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillablePrice(i *int64) *MarketOrderUpdateOne {
+	if i != nil {
+		mouo.SetPrice(*i)
+	}
+	return mouo
+}
+
+// AddPrice adds i to the "price" field.
+func (mouo *MarketOrderUpdateOne) AddPrice(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.AddPrice(i)
+	return mouo
+}
+
+// SetQuantity sets the "quantity" field.
+func (mouo *MarketOrderUpdateOne) SetQuantity(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.ResetQuantity()
+	mouo.mutation.SetQuantity(i)
+	return mouo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillableQuantity(i *int64) *MarketOrderUpdateOne {
+	if i != nil {
+		mouo.SetQuantity(*i)
+	}
+	return mouo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (mouo *MarketOrderUpdateOne) AddQuantity(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.AddQuantity(i)
+	return mouo
+}
+
+// SetRemaining sets the "remaining" field.
+func (mouo *MarketOrderUpdateOne) SetRemaining(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.ResetRemaining()
+	mouo.mutation.SetRemaining(i)
+	return mouo
+}
+
+// SetNillableRemaining sets the "remaining" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillableRemaining(i *int64) *MarketOrderUpdateOne {
+	if i != nil {
+		mouo.SetRemaining(*i)
+	}
+	return mouo
+}
+
+// AddRemaining adds i to the "remaining" field.
+func (mouo *MarketOrderUpdateOne) AddRemaining(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.AddRemaining(i)
+	return mouo
+}
+
+// SetStatus sets the "status" field.
+func (mouo *MarketOrderUpdateOne) SetStatus(s string) *MarketOrderUpdateOne {
+	mouo.mutation.SetStatus(s)
+	return mouo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mouo *MarketOrderUpdateOne) SetNillableStatus(s *string) *MarketOrderUpdateOne {
+	if s != nil {
+		mouo.SetStatus(*s)
+	}
+	return mouo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mouo *MarketOrderUpdateOne) SetUpdatedAt(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.ResetUpdatedAt()
+	mouo.mutation.SetUpdatedAt(i)
+	return mouo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (mouo *MarketOrderUpdateOne) AddUpdatedAt(i int64) *MarketOrderUpdateOne {
+	mouo.mutation.AddUpdatedAt(i)
+	return mouo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (mouo *MarketOrderUpdateOne) SetPlayer(p *Player) *MarketOrderUpdateOne {
+	return mouo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the MarketOrderMutation object of the builder.
+func (mouo *MarketOrderUpdateOne) Mutation() *MarketOrderMutation {
+	return mouo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (mouo *MarketOrderUpdateOne) ClearPlayer() *MarketOrderUpdateOne {
+	mouo.mutation.ClearPlayer()
+	return mouo
+}
+
+// Where appends a list predicates to the MarketOrderUpdate builder.
+func (mouo *MarketOrderUpdateOne) Where(ps ...predicate.MarketOrder) *MarketOrderUpdateOne {
+	mouo.mutation.Where(ps...)
+	return mouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mouo *MarketOrderUpdateOne) Select(field string, fields ...string) *MarketOrderUpdateOne {
+	mouo.fields = append([]string{field}, fields...)
+	return mouo
+}
+
+// Save executes the query and returns the updated MarketOrder entity.
+func (mouo *MarketOrderUpdateOne) Save(ctx context.Context) (*MarketOrder, error) {
+	mouo.defaults()
+	return withHooks(ctx, mouo.sqlSave, mouo.mutation, mouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mouo *MarketOrderUpdateOne) SaveX(ctx context.Context) *MarketOrder {
+	node, err := mouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mouo *MarketOrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := mouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mouo *MarketOrderUpdateOne) ExecX(ctx context.Context) {
+	if err := mouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mouo *MarketOrderUpdateOne) defaults() {
+	if _, ok := mouo.mutation.UpdatedAt(); !ok {
+		v := marketorder.UpdateDefaultUpdatedAt()
+		mouo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mouo *MarketOrderUpdateOne) check() error {
+	if mouo.mutation.PlayerCleared() && len(mouo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "MarketOrder.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mouo *MarketOrderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MarketOrderUpdateOne {
+	mouo.modifiers = append(mouo.modifiers, modifiers...)
+	return mouo
+}
+
+func (mouo *MarketOrderUpdateOne) sqlSave(ctx context.Context) (_node *MarketOrder, err error) {
+	if err := mouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(marketorder.Table, marketorder.Columns, sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64))
+	id, ok := mouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "MarketOrder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, marketorder.FieldID)
+		for _, f := range fields {
+			if !marketorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != marketorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mouo.mutation.Side(); ok {
+		_spec.SetField(marketorder.FieldSide, field.TypeString, value)
+	}
+	if value, ok := mouo.mutation.ItemID(); ok {
+		_spec.SetField(marketorder.FieldItemID, field.TypeString, value)
+	}
+	if value, ok := mouo.mutation.Currency(); ok {
+		_spec.SetField(marketorder.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := mouo.mutation.Price(); ok {
+		_spec.SetField(marketorder.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mouo.mutation.AddedPrice(); ok {
+		_spec.AddField(marketorder.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := mouo.mutation.Quantity(); ok {
+		_spec.SetField(marketorder.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mouo.mutation.AddedQuantity(); ok {
+		_spec.AddField(marketorder.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := mouo.mutation.Remaining(); ok {
+		_spec.SetField(marketorder.FieldRemaining, field.TypeInt64, value)
+	}
+	if value, ok := mouo.mutation.AddedRemaining(); ok {
+		_spec.AddField(marketorder.FieldRemaining, field.TypeInt64, value)
+	}
+	if value, ok := mouo.mutation.Status(); ok {
+		_spec.SetField(marketorder.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := mouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(marketorder.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := mouo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(marketorder.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if mouo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   marketorder.PlayerTable,
+			Columns: []string{marketorder.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mouo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   marketorder.PlayerTable,
+			Columns: []string{marketorder.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mouo.modifiers...)
+	_node = &MarketOrder{config: mouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{marketorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mouo.mutation.done = true
+	return _node, nil
+}
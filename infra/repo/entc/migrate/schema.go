@@ -8,6 +8,119 @@ import (
 )
 
 var (
+	// CatalogSnapshotsColumns holds the columns for the "catalog_snapshots" table.
+	CatalogSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// CatalogSnapshotsTable holds the schema information for the "catalog_snapshots" table.
+	CatalogSnapshotsTable = &schema.Table{
+		Name:       "catalog_snapshots",
+		Columns:    CatalogSnapshotsColumns,
+		PrimaryKey: []*schema.Column{CatalogSnapshotsColumns[0]},
+	}
+	// CatalogVersionsColumns holds the columns for the "catalog_versions" table.
+	CatalogVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "changeset_id", Type: field.TypeInt64, Default: 0},
+		{Name: "published_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// CatalogVersionsTable holds the schema information for the "catalog_versions" table.
+	CatalogVersionsTable = &schema.Table{
+		Name:       "catalog_versions",
+		Columns:    CatalogVersionsColumns,
+		PrimaryKey: []*schema.Column{CatalogVersionsColumns[0]},
+	}
+	// ChangesetsColumns holds the columns for the "changesets" table.
+	ChangesetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "editors", Type: field.TypeJSON, Nullable: true},
+		{Name: "reviewer", Type: field.TypeString, Default: ""},
+		{Name: "catalog_version", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// ChangesetsTable holds the schema information for the "changesets" table.
+	ChangesetsTable = &schema.Table{
+		Name:       "changesets",
+		Columns:    ChangesetsColumns,
+		PrimaryKey: []*schema.Column{ChangesetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "changeset_status",
+				Unique:  false,
+				Columns: []*schema.Column{ChangesetsColumns[2]},
+			},
+		},
+	}
+	// ChangesetChangesColumns holds the columns for the "changeset_changes" table.
+	ChangesetChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "op", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "category", Type: field.TypeString, Default: ""},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "translations", Type: field.TypeJSON, Nullable: true},
+		{Name: "changeset_id", Type: field.TypeInt64},
+	}
+	// ChangesetChangesTable holds the schema information for the "changeset_changes" table.
+	ChangesetChangesTable = &schema.Table{
+		Name:       "changeset_changes",
+		Columns:    ChangesetChangesColumns,
+		PrimaryKey: []*schema.Column{ChangesetChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "changeset_changes_changesets_changes",
+				Columns:    []*schema.Column{ChangesetChangesColumns[7]},
+				RefColumns: []*schema.Column{ChangesetsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "changesetchange_changeset_id_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{ChangesetChangesColumns[7], ChangesetChangesColumns[1]},
+			},
+		},
+	}
+	// InventoryItemsColumns holds the columns for the "inventory_items" table.
+	InventoryItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "player_id", Type: field.TypeString},
+	}
+	// InventoryItemsTable holds the schema information for the "inventory_items" table.
+	InventoryItemsTable = &schema.Table{
+		Name:       "inventory_items",
+		Columns:    InventoryItemsColumns,
+		PrimaryKey: []*schema.Column{InventoryItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "inventory_items_players_inventory",
+				Columns:    []*schema.Column{InventoryItemsColumns[5]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inventoryitem_player_id_item_id",
+				Unique:  true,
+				Columns: []*schema.Column{InventoryItemsColumns[5], InventoryItemsColumns[1]},
+			},
+		},
+	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -23,11 +136,697 @@ var (
 		Columns:    ItemsColumns,
 		PrimaryKey: []*schema.Column{ItemsColumns[0]},
 	}
+	// ItemInstancesColumns holds the columns for the "item_instances" table.
+	ItemInstancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "durability", Type: field.TypeInt, Default: 0},
+		{Name: "max_durability", Type: field.TypeInt, Default: 0},
+		{Name: "stats", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "burned_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "owner_id", Type: field.TypeString},
+	}
+	// ItemInstancesTable holds the schema information for the "item_instances" table.
+	ItemInstancesTable = &schema.Table{
+		Name:       "item_instances",
+		Columns:    ItemInstancesColumns,
+		PrimaryKey: []*schema.Column{ItemInstancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_instances_players_item_instances",
+				Columns:    []*schema.Column{ItemInstancesColumns[9]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "iteminstance_owner_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ItemInstancesColumns[9], ItemInstancesColumns[5]},
+			},
+			{
+				Name:    "iteminstance_item_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ItemInstancesColumns[1], ItemInstancesColumns[5]},
+			},
+		},
+	}
+	// ItemInstanceHistoriesColumns holds the columns for the "item_instance_histories" table.
+	ItemInstanceHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "from_player_id", Type: field.TypeString, Default: ""},
+		{Name: "to_player_id", Type: field.TypeString, Default: ""},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "instance_id", Type: field.TypeString},
+	}
+	// ItemInstanceHistoriesTable holds the schema information for the "item_instance_histories" table.
+	ItemInstanceHistoriesTable = &schema.Table{
+		Name:       "item_instance_histories",
+		Columns:    ItemInstanceHistoriesColumns,
+		PrimaryKey: []*schema.Column{ItemInstanceHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_instance_histories_item_instances_history",
+				Columns:    []*schema.Column{ItemInstanceHistoriesColumns[7]},
+				RefColumns: []*schema.Column{ItemInstancesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "iteminstancehistory_instance_id",
+				Unique:  false,
+				Columns: []*schema.Column{ItemInstanceHistoriesColumns[7]},
+			},
+		},
+	}
+	// ItemTranslationsColumns holds the columns for the "item_translations" table.
+	ItemTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "locale", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "item_id", Type: field.TypeString},
+	}
+	// ItemTranslationsTable holds the schema information for the "item_translations" table.
+	ItemTranslationsTable = &schema.Table{
+		Name:       "item_translations",
+		Columns:    ItemTranslationsColumns,
+		PrimaryKey: []*schema.Column{ItemTranslationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_translations_items_translations",
+				Columns:    []*schema.Column{ItemTranslationsColumns[6]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemtranslation_item_id_locale",
+				Unique:  true,
+				Columns: []*schema.Column{ItemTranslationsColumns[6], ItemTranslationsColumns[1]},
+			},
+			{
+				Name:    "itemtranslation_locale",
+				Unique:  false,
+				Columns: []*schema.Column{ItemTranslationsColumns[1]},
+			},
+		},
+	}
+	// JobRunsColumns holds the columns for the "job_runs" table.
+	JobRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "job", Type: field.TypeString},
+		{Name: "trigger", Type: field.TypeString},
+		{Name: "task_id", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeString},
+		{Name: "node", Type: field.TypeString},
+		{Name: "scheduled_at", Type: field.TypeInt64, Default: 0},
+		{Name: "started_at", Type: field.TypeInt64},
+		{Name: "finished_at", Type: field.TypeInt64, Default: 0},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "error", Type: field.TypeString, Default: ""},
+	}
+	// JobRunsTable holds the schema information for the "job_runs" table.
+	JobRunsTable = &schema.Table{
+		Name:       "job_runs",
+		Columns:    JobRunsColumns,
+		PrimaryKey: []*schema.Column{JobRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobrun_job",
+				Unique:  false,
+				Columns: []*schema.Column{JobRunsColumns[1]},
+			},
+			{
+				Name:    "jobrun_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobRunsColumns[7]},
+			},
+		},
+	}
+	// JobTasksColumns holds the columns for the "job_tasks" table.
+	JobTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "queue", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "run_at", Type: field.TypeInt64},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// JobTasksTable holds the schema information for the "job_tasks" table.
+	JobTasksTable = &schema.Table{
+		Name:       "job_tasks",
+		Columns:    JobTasksColumns,
+		PrimaryKey: []*schema.Column{JobTasksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "jobtask_queue_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobTasksColumns[1], JobTasksColumns[3], JobTasksColumns[5]},
+			},
+			{
+				Name:    "jobtask_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobTasksColumns[8]},
+			},
+		},
+	}
+	// LeaderboardsColumns holds the columns for the "leaderboards" table.
+	LeaderboardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "metric", Type: field.TypeString},
+		{Name: "policy", Type: field.TypeString, Default: "best"},
+		{Name: "reset", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// LeaderboardsTable holds the schema information for the "leaderboards" table.
+	LeaderboardsTable = &schema.Table{
+		Name:       "leaderboards",
+		Columns:    LeaderboardsColumns,
+		PrimaryKey: []*schema.Column{LeaderboardsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leaderboard_metric",
+				Unique:  false,
+				Columns: []*schema.Column{LeaderboardsColumns[2]},
+			},
+		},
+	}
+	// LeaderboardSnapshotsColumns holds the columns for the "leaderboard_snapshots" table.
+	LeaderboardSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "leaderboard_id", Type: field.TypeString},
+		{Name: "period", Type: field.TypeString},
+		{Name: "period_start", Type: field.TypeInt64},
+		{Name: "period_end", Type: field.TypeInt64},
+		{Name: "entries", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// LeaderboardSnapshotsTable holds the schema information for the "leaderboard_snapshots" table.
+	LeaderboardSnapshotsTable = &schema.Table{
+		Name:       "leaderboard_snapshots",
+		Columns:    LeaderboardSnapshotsColumns,
+		PrimaryKey: []*schema.Column{LeaderboardSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "leaderboardsnapshot_leaderboard_id_period",
+				Unique:  true,
+				Columns: []*schema.Column{LeaderboardSnapshotsColumns[1], LeaderboardSnapshotsColumns[2]},
+			},
+			{
+				Name:    "leaderboardsnapshot_leaderboard_id_period_end",
+				Unique:  false,
+				Columns: []*schema.Column{LeaderboardSnapshotsColumns[1], LeaderboardSnapshotsColumns[4]},
+			},
+		},
+	}
+	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
+	LedgerEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "asset_type", Type: field.TypeString},
+		{Name: "asset", Type: field.TypeString},
+		{Name: "delta", Type: field.TypeInt64},
+		{Name: "balance", Type: field.TypeInt64},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "reference", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "player_id", Type: field.TypeString},
+	}
+	// LedgerEntriesTable holds the schema information for the "ledger_entries" table.
+	LedgerEntriesTable = &schema.Table{
+		Name:       "ledger_entries",
+		Columns:    LedgerEntriesColumns,
+		PrimaryKey: []*schema.Column{LedgerEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ledger_entries_players_ledger",
+				Columns:    []*schema.Column{LedgerEntriesColumns[8]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerentry_player_id_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[8], LedgerEntriesColumns[0]},
+			},
+		},
+	}
+	// LiveEventsColumns holds the columns for the "live_events" table.
+	LiveEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString, Default: "event"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "start_at", Type: field.TypeInt64},
+		{Name: "end_at", Type: field.TypeInt64},
+		{Name: "item_ids", Type: field.TypeJSON},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// LiveEventsTable holds the schema information for the "live_events" table.
+	LiveEventsTable = &schema.Table{
+		Name:       "live_events",
+		Columns:    LiveEventsColumns,
+		PrimaryKey: []*schema.Column{LiveEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "liveevent_start_at_end_at",
+				Unique:  false,
+				Columns: []*schema.Column{LiveEventsColumns[5], LiveEventsColumns[6]},
+			},
+		},
+	}
+	// MarketFillsColumns holds the columns for the "market_fills" table.
+	MarketFillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "price", Type: field.TypeInt64},
+		{Name: "quantity", Type: field.TypeInt64},
+		{Name: "fee", Type: field.TypeInt64, Default: 0},
+		{Name: "sell_order_id", Type: field.TypeInt64},
+		{Name: "buy_order_id", Type: field.TypeInt64},
+		{Name: "seller_id", Type: field.TypeString},
+		{Name: "buyer_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// MarketFillsTable holds the schema information for the "market_fills" table.
+	MarketFillsTable = &schema.Table{
+		Name:       "market_fills",
+		Columns:    MarketFillsColumns,
+		PrimaryKey: []*schema.Column{MarketFillsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "marketfill_item_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MarketFillsColumns[1], MarketFillsColumns[10]},
+			},
+		},
+	}
+	// MarketOrdersColumns holds the columns for the "market_orders" table.
+	MarketOrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "side", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "price", Type: field.TypeInt64},
+		{Name: "quantity", Type: field.TypeInt64},
+		{Name: "remaining", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeString, Default: "open"},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "player_id", Type: field.TypeString},
+	}
+	// MarketOrdersTable holds the schema information for the "market_orders" table.
+	MarketOrdersTable = &schema.Table{
+		Name:       "market_orders",
+		Columns:    MarketOrdersColumns,
+		PrimaryKey: []*schema.Column{MarketOrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "market_orders_players_market_orders",
+				Columns:    []*schema.Column{MarketOrdersColumns[10]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "marketorder_item_id_side_status_price",
+				Unique:  false,
+				Columns: []*schema.Column{MarketOrdersColumns[2], MarketOrdersColumns[1], MarketOrdersColumns[7], MarketOrdersColumns[4]},
+			},
+			{
+				Name:    "marketorder_player_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{MarketOrdersColumns[10], MarketOrdersColumns[7]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "aggregate_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeInt64, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "published_at", Type: field.TypeInt64, Default: 0},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_status",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[5]},
+			},
+			{
+				Name:    "outboxevent_aggregate_type_aggregate_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[1], OutboxEventsColumns[2]},
+			},
+		},
+	}
+	// PlayersColumns holds the columns for the "players" table.
+	PlayersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "display_name", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString, Default: ""},
+		{Name: "banned", Type: field.TypeBool, Default: false},
+		{Name: "ban_reason", Type: field.TypeString, Default: ""},
+		{Name: "banned_until", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// PlayersTable holds the schema information for the "players" table.
+	PlayersTable = &schema.Table{
+		Name:       "players",
+		Columns:    PlayersColumns,
+		PrimaryKey: []*schema.Column{PlayersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "player_region",
+				Unique:  false,
+				Columns: []*schema.Column{PlayersColumns[2]},
+			},
+		},
+	}
+	// PlayerAccountsColumns holds the columns for the "player_accounts" table.
+	PlayerAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "player_id", Type: field.TypeString},
+	}
+	// PlayerAccountsTable holds the schema information for the "player_accounts" table.
+	PlayerAccountsTable = &schema.Table{
+		Name:       "player_accounts",
+		Columns:    PlayerAccountsColumns,
+		PrimaryKey: []*schema.Column{PlayerAccountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "player_accounts_players_accounts",
+				Columns:    []*schema.Column{PlayerAccountsColumns[4]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playeraccount_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{PlayerAccountsColumns[1], PlayerAccountsColumns[2]},
+			},
+			{
+				Name:    "playeraccount_player_id_provider",
+				Unique:  true,
+				Columns: []*schema.Column{PlayerAccountsColumns[4], PlayerAccountsColumns[1]},
+			},
+		},
+	}
+	// PlayerEventsColumns holds the columns for the "player_events" table.
+	PlayerEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "player_id", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// PlayerEventsTable holds the schema information for the "player_events" table.
+	PlayerEventsTable = &schema.Table{
+		Name:       "player_events",
+		Columns:    PlayerEventsColumns,
+		PrimaryKey: []*schema.Column{PlayerEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playerevent_player_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{PlayerEventsColumns[1], PlayerEventsColumns[2]},
+			},
+			{
+				Name:    "playerevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlayerEventsColumns[4]},
+			},
+		},
+	}
+	// QuestsColumns holds the columns for the "quests" table.
+	QuestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString, Default: "quest"},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "objectives", Type: field.TypeJSON},
+		{Name: "rewards", Type: field.TypeJSON},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// QuestsTable holds the schema information for the "quests" table.
+	QuestsTable = &schema.Table{
+		Name:       "quests",
+		Columns:    QuestsColumns,
+		PrimaryKey: []*schema.Column{QuestsColumns[0]},
+	}
+	// QuestProgressesColumns holds the columns for the "quest_progresses" table.
+	QuestProgressesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "player_id", Type: field.TypeString},
+		{Name: "quest_id", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "counts", Type: field.TypeJSON},
+		{Name: "completed_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// QuestProgressesTable holds the schema information for the "quest_progresses" table.
+	QuestProgressesTable = &schema.Table{
+		Name:       "quest_progresses",
+		Columns:    QuestProgressesColumns,
+		PrimaryKey: []*schema.Column{QuestProgressesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questprogress_player_id_quest_id",
+				Unique:  true,
+				Columns: []*schema.Column{QuestProgressesColumns[1], QuestProgressesColumns[2]},
+			},
+		},
+	}
+	// TradesColumns holds the columns for the "trades" table.
+	TradesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "offered", Type: field.TypeJSON},
+		{Name: "requested", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeInt64},
+		{Name: "resolved_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "proposer_id", Type: field.TypeString},
+		{Name: "recipient_id", Type: field.TypeString},
+	}
+	// TradesTable holds the schema information for the "trades" table.
+	TradesTable = &schema.Table{
+		Name:       "trades",
+		Columns:    TradesColumns,
+		PrimaryKey: []*schema.Column{TradesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "trades_players_proposed_trades",
+				Columns:    []*schema.Column{TradesColumns[8]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "trades_players_received_trades",
+				Columns:    []*schema.Column{TradesColumns[9]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trade_proposer_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{TradesColumns[8], TradesColumns[3]},
+			},
+			{
+				Name:    "trade_recipient_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{TradesColumns[9], TradesColumns[3]},
+			},
+			{
+				Name:    "trade_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{TradesColumns[3], TradesColumns[4]},
+			},
+		},
+	}
+	// WalletsColumns holds the columns for the "wallets" table.
+	WalletsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "balance", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "player_id", Type: field.TypeString},
+	}
+	// WalletsTable holds the schema information for the "wallets" table.
+	WalletsTable = &schema.Table{
+		Name:       "wallets",
+		Columns:    WalletsColumns,
+		PrimaryKey: []*schema.Column{WalletsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallets_players_wallets",
+				Columns:    []*schema.Column{WalletsColumns[5]},
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallet_player_id_currency",
+				Unique:  true,
+				Columns: []*schema.Column{WalletsColumns[5], WalletsColumns[1]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "event_id", Type: field.TypeInt64},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeInt64, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "delivered_at", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+		{Name: "subscription_id", Type: field.TypeInt64},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "webhookdelivery_subscription_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CatalogSnapshotsTable,
+		CatalogVersionsTable,
+		ChangesetsTable,
+		ChangesetChangesTable,
+		InventoryItemsTable,
 		ItemsTable,
+		ItemInstancesTable,
+		ItemInstanceHistoriesTable,
+		ItemTranslationsTable,
+		JobRunsTable,
+		JobTasksTable,
+		LeaderboardsTable,
+		LeaderboardSnapshotsTable,
+		LedgerEntriesTable,
+		LiveEventsTable,
+		MarketFillsTable,
+		MarketOrdersTable,
+		OutboxEventsTable,
+		PlayersTable,
+		PlayerAccountsTable,
+		PlayerEventsTable,
+		QuestsTable,
+		QuestProgressesTable,
+		TradesTable,
+		WalletsTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
 	}
 )
 
 func init() {
+	ChangesetChangesTable.ForeignKeys[0].RefTable = ChangesetsTable
+	InventoryItemsTable.ForeignKeys[0].RefTable = PlayersTable
+	ItemInstancesTable.ForeignKeys[0].RefTable = PlayersTable
+	ItemInstanceHistoriesTable.ForeignKeys[0].RefTable = ItemInstancesTable
+	ItemTranslationsTable.ForeignKeys[0].RefTable = ItemsTable
+	LedgerEntriesTable.ForeignKeys[0].RefTable = PlayersTable
+	MarketOrdersTable.ForeignKeys[0].RefTable = PlayersTable
+	PlayerAccountsTable.ForeignKeys[0].RefTable = PlayersTable
+	TradesTable.ForeignKeys[0].RefTable = PlayersTable
+	TradesTable.ForeignKeys[1].RefTable = PlayersTable
+	WalletsTable.ForeignKeys[0].RefTable = PlayersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookSubscriptionsTable
 }
@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/outboxevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OutboxEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeu *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (oeu *OutboxEventUpdate) SetAggregateType(s string) *OutboxEventUpdate {
+	oeu.mutation.SetAggregateType(s)
+	return oeu
+}
+
+// SetNillableAggregateType sets the "aggregate_type" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAggregateType(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetAggregateType(*s)
+	}
+	return oeu
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (oeu *OutboxEventUpdate) SetAggregateID(s string) *OutboxEventUpdate {
+	oeu.mutation.SetAggregateID(s)
+	return oeu
+}
+
+// SetNillableAggregateID sets the "aggregate_id" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAggregateID(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetAggregateID(*s)
+	}
+	return oeu
+}
+
+// SetType sets the "type" field.
+func (oeu *OutboxEventUpdate) SetType(s string) *OutboxEventUpdate {
+	oeu.mutation.SetType(s)
+	return oeu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableType(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetType(*s)
+	}
+	return oeu
+}
+
+// SetPayload sets the "payload" field.
+func (oeu *OutboxEventUpdate) SetPayload(b []byte) *OutboxEventUpdate {
+	oeu.mutation.SetPayload(b)
+	return oeu
+}
+
+// SetStatus sets the "status" field.
+func (oeu *OutboxEventUpdate) SetStatus(s string) *OutboxEventUpdate {
+	oeu.mutation.SetStatus(s)
+	return oeu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableStatus(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetStatus(*s)
+	}
+	return oeu
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeu *OutboxEventUpdate) SetAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.ResetAttempts()
+	oeu.mutation.SetAttempts(i)
+	return oeu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAttempts(i *int) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetAttempts(*i)
+	}
+	return oeu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeu *OutboxEventUpdate) AddAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.AddAttempts(i)
+	return oeu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeu *OutboxEventUpdate) SetNextAttemptAt(i int64) *OutboxEventUpdate {
+	oeu.mutation.ResetNextAttemptAt()
+	oeu.mutation.SetNextAttemptAt(i)
+	return oeu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableNextAttemptAt(i *int64) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetNextAttemptAt(*i)
+	}
+	return oeu
+}
+
+// AddNextAttemptAt adds i to the "next_attempt_at" field.
+func (oeu *OutboxEventUpdate) AddNextAttemptAt(i int64) *OutboxEventUpdate {
+	oeu.mutation.AddNextAttemptAt(i)
+	return oeu
+}
+
+// SetLastError sets the "last_error" field.
+func (oeu *OutboxEventUpdate) SetLastError(s string) *OutboxEventUpdate {
+	oeu.mutation.SetLastError(s)
+	return oeu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableLastError(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetLastError(*s)
+	}
+	return oeu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (oeu *OutboxEventUpdate) SetPublishedAt(i int64) *OutboxEventUpdate {
+	oeu.mutation.ResetPublishedAt()
+	oeu.mutation.SetPublishedAt(i)
+	return oeu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillablePublishedAt(i *int64) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetPublishedAt(*i)
+	}
+	return oeu
+}
+
+// AddPublishedAt adds i to the "published_at" field.
+func (oeu *OutboxEventUpdate) AddPublishedAt(i int64) *OutboxEventUpdate {
+	oeu.mutation.AddPublishedAt(i)
+	return oeu
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeu *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (oeu *OutboxEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutboxEventUpdate {
+	oeu.modifiers = append(oeu.modifiers, modifiers...)
+	return oeu
+}
+
+func (oeu *OutboxEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt64))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeu.mutation.AggregateType(); ok {
+		_spec.SetField(outboxevent.FieldAggregateType, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.AggregateID(); ok {
+		_spec.SetField(outboxevent.FieldAggregateID, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.GetType(); ok {
+		_spec.SetField(outboxevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeu.mutation.Status(); ok {
+		_spec.SetField(outboxevent.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := oeu.mutation.AddedNextAttemptAt(); ok {
+		_spec.AddField(outboxevent.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := oeu.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeInt64, value)
+	}
+	if value, ok := oeu.mutation.AddedPublishedAt(); ok {
+		_spec.AddField(outboxevent.FieldPublishedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(oeu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OutboxEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (oeuo *OutboxEventUpdateOne) SetAggregateType(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetAggregateType(s)
+	return oeuo
+}
+
+// SetNillableAggregateType sets the "aggregate_type" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAggregateType(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetAggregateType(*s)
+	}
+	return oeuo
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (oeuo *OutboxEventUpdateOne) SetAggregateID(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetAggregateID(s)
+	return oeuo
+}
+
+// SetNillableAggregateID sets the "aggregate_id" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAggregateID(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetAggregateID(*s)
+	}
+	return oeuo
+}
+
+// SetType sets the "type" field.
+func (oeuo *OutboxEventUpdateOne) SetType(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetType(s)
+	return oeuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableType(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetType(*s)
+	}
+	return oeuo
+}
+
+// SetPayload sets the "payload" field.
+func (oeuo *OutboxEventUpdateOne) SetPayload(b []byte) *OutboxEventUpdateOne {
+	oeuo.mutation.SetPayload(b)
+	return oeuo
+}
+
+// SetStatus sets the "status" field.
+func (oeuo *OutboxEventUpdateOne) SetStatus(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetStatus(s)
+	return oeuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableStatus(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetStatus(*s)
+	}
+	return oeuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) SetAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetAttempts()
+	oeuo.mutation.SetAttempts(i)
+	return oeuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAttempts(i *int) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetAttempts(*i)
+	}
+	return oeuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) AddAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.AddAttempts(i)
+	return oeuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeuo *OutboxEventUpdateOne) SetNextAttemptAt(i int64) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetNextAttemptAt()
+	oeuo.mutation.SetNextAttemptAt(i)
+	return oeuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableNextAttemptAt(i *int64) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetNextAttemptAt(*i)
+	}
+	return oeuo
+}
+
+// AddNextAttemptAt adds i to the "next_attempt_at" field.
+func (oeuo *OutboxEventUpdateOne) AddNextAttemptAt(i int64) *OutboxEventUpdateOne {
+	oeuo.mutation.AddNextAttemptAt(i)
+	return oeuo
+}
+
+// SetLastError sets the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) SetLastError(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetLastError(s)
+	return oeuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableLastError(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetLastError(*s)
+	}
+	return oeuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (oeuo *OutboxEventUpdateOne) SetPublishedAt(i int64) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetPublishedAt()
+	oeuo.mutation.SetPublishedAt(i)
+	return oeuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillablePublishedAt(i *int64) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetPublishedAt(*i)
+	}
+	return oeuo
+}
+
+// AddPublishedAt adds i to the "published_at" field.
+func (oeuo *OutboxEventUpdateOne) AddPublishedAt(i int64) *OutboxEventUpdateOne {
+	oeuo.mutation.AddPublishedAt(i)
+	return oeuo
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeuo *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeuo *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (oeuo *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	return withHooks(ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (oeuo *OutboxEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OutboxEventUpdateOne {
+	oeuo.modifiers = append(oeuo.modifiers, modifiers...)
+	return oeuo
+}
+
+func (oeuo *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt64))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeuo.mutation.AggregateType(); ok {
+		_spec.SetField(outboxevent.FieldAggregateType, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.AggregateID(); ok {
+		_spec.SetField(outboxevent.FieldAggregateID, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.GetType(); ok {
+		_spec.SetField(outboxevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeuo.mutation.Status(); ok {
+		_spec.SetField(outboxevent.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := oeuo.mutation.AddedNextAttemptAt(); ok {
+		_spec.AddField(outboxevent.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := oeuo.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeInt64, value)
+	}
+	if value, ok := oeuo.mutation.AddedPublishedAt(); ok {
+		_spec.AddField(outboxevent.FieldPublishedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(oeuo.modifiers...)
+	_node = &OutboxEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
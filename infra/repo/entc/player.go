@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+)
+
+// Player is the model entity for the Player schema.
+type Player struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// Region holds the value of the "region" field.
+	Region string `json:"region,omitempty"`
+	// Banned holds the value of the "banned" field.
+	Banned bool `json:"banned,omitempty"`
+	// BanReason holds the value of the "ban_reason" field.
+	BanReason string `json:"ban_reason,omitempty"`
+	// BannedUntil holds the value of the "banned_until" field.
+	BannedUntil int64 `json:"banned_until,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlayerQuery when eager-loading is set.
+	Edges        PlayerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlayerEdges holds the relations/edges for other nodes in the graph.
+type PlayerEdges struct {
+	// Accounts holds the value of the accounts edge.
+	Accounts []*PlayerAccount `json:"accounts,omitempty"`
+	// Inventory holds the value of the inventory edge.
+	Inventory []*InventoryItem `json:"inventory,omitempty"`
+	// Wallets holds the value of the wallets edge.
+	Wallets []*Wallet `json:"wallets,omitempty"`
+	// Ledger holds the value of the ledger edge.
+	Ledger []*LedgerEntry `json:"ledger,omitempty"`
+	// ProposedTrades holds the value of the proposed_trades edge.
+	ProposedTrades []*Trade `json:"proposed_trades,omitempty"`
+	// ReceivedTrades holds the value of the received_trades edge.
+	ReceivedTrades []*Trade `json:"received_trades,omitempty"`
+	// MarketOrders holds the value of the market_orders edge.
+	MarketOrders []*MarketOrder `json:"market_orders,omitempty"`
+	// ItemInstances holds the value of the item_instances edge.
+	ItemInstances []*ItemInstance `json:"item_instances,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [8]bool
+}
+
+// AccountsOrErr returns the Accounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) AccountsOrErr() ([]*PlayerAccount, error) {
+	if e.loadedTypes[0] {
+		return e.Accounts, nil
+	}
+	return nil, &NotLoadedError{edge: "accounts"}
+}
+
+// InventoryOrErr returns the Inventory value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) InventoryOrErr() ([]*InventoryItem, error) {
+	if e.loadedTypes[1] {
+		return e.Inventory, nil
+	}
+	return nil, &NotLoadedError{edge: "inventory"}
+}
+
+// WalletsOrErr returns the Wallets value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) WalletsOrErr() ([]*Wallet, error) {
+	if e.loadedTypes[2] {
+		return e.Wallets, nil
+	}
+	return nil, &NotLoadedError{edge: "wallets"}
+}
+
+// LedgerOrErr returns the Ledger value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) LedgerOrErr() ([]*LedgerEntry, error) {
+	if e.loadedTypes[3] {
+		return e.Ledger, nil
+	}
+	return nil, &NotLoadedError{edge: "ledger"}
+}
+
+// ProposedTradesOrErr returns the ProposedTrades value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) ProposedTradesOrErr() ([]*Trade, error) {
+	if e.loadedTypes[4] {
+		return e.ProposedTrades, nil
+	}
+	return nil, &NotLoadedError{edge: "proposed_trades"}
+}
+
+// ReceivedTradesOrErr returns the ReceivedTrades value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) ReceivedTradesOrErr() ([]*Trade, error) {
+	if e.loadedTypes[5] {
+		return e.ReceivedTrades, nil
+	}
+	return nil, &NotLoadedError{edge: "received_trades"}
+}
+
+// MarketOrdersOrErr returns the MarketOrders value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) MarketOrdersOrErr() ([]*MarketOrder, error) {
+	if e.loadedTypes[6] {
+		return e.MarketOrders, nil
+	}
+	return nil, &NotLoadedError{edge: "market_orders"}
+}
+
+// ItemInstancesOrErr returns the ItemInstances value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) ItemInstancesOrErr() ([]*ItemInstance, error) {
+	if e.loadedTypes[7] {
+		return e.ItemInstances, nil
+	}
+	return nil, &NotLoadedError{edge: "item_instances"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Player) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case player.FieldBanned:
+			values[i] = new(sql.NullBool)
+		case player.FieldBannedUntil, player.FieldCreatedAt, player.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case player.FieldID, player.FieldDisplayName, player.FieldRegion, player.FieldBanReason:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Player fields.
+func (pl *Player) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case player.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pl.ID = value.String
+			}
+		case player.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				pl.DisplayName = value.String
+			}
+		case player.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				pl.Region = value.String
+			}
+		case player.FieldBanned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field banned", values[i])
+			} else if value.Valid {
+				pl.Banned = value.Bool
+			}
+		case player.FieldBanReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ban_reason", values[i])
+			} else if value.Valid {
+				pl.BanReason = value.String
+			}
+		case player.FieldBannedUntil:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_until", values[i])
+			} else if value.Valid {
+				pl.BannedUntil = value.Int64
+			}
+		case player.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pl.CreatedAt = value.Int64
+			}
+		case player.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pl.UpdatedAt = value.Int64
+			}
+		default:
+			pl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Player.
+// This includes values selected through modifiers, order, etc.
+func (pl *Player) Value(name string) (ent.Value, error) {
+	return pl.selectValues.Get(name)
+}
+
+// QueryAccounts queries the "accounts" edge of the Player entity.
+func (pl *Player) QueryAccounts() *PlayerAccountQuery {
+	return NewPlayerClient(pl.config).QueryAccounts(pl)
+}
+
+// QueryInventory queries the "inventory" edge of the Player entity.
+func (pl *Player) QueryInventory() *InventoryItemQuery {
+	return NewPlayerClient(pl.config).QueryInventory(pl)
+}
+
+// QueryWallets queries the "wallets" edge of the Player entity.
+func (pl *Player) QueryWallets() *WalletQuery {
+	return NewPlayerClient(pl.config).QueryWallets(pl)
+}
+
+// QueryLedger queries the "ledger" edge of the Player entity.
+func (pl *Player) QueryLedger() *LedgerEntryQuery {
+	return NewPlayerClient(pl.config).QueryLedger(pl)
+}
+
+// QueryProposedTrades queries the "proposed_trades" edge of the Player entity.
+func (pl *Player) QueryProposedTrades() *TradeQuery {
+	return NewPlayerClient(pl.config).QueryProposedTrades(pl)
+}
+
+// QueryReceivedTrades queries the "received_trades" edge of the Player entity.
+func (pl *Player) QueryReceivedTrades() *TradeQuery {
+	return NewPlayerClient(pl.config).QueryReceivedTrades(pl)
+}
+
+// QueryMarketOrders queries the "market_orders" edge of the Player entity.
+func (pl *Player) QueryMarketOrders() *MarketOrderQuery {
+	return NewPlayerClient(pl.config).QueryMarketOrders(pl)
+}
+
+// QueryItemInstances queries the "item_instances" edge of the Player entity.
+func (pl *Player) QueryItemInstances() *ItemInstanceQuery {
+	return NewPlayerClient(pl.config).QueryItemInstances(pl)
+}
+
+// Update returns a builder for updating this Player.
+// Note that you need to call Player.Unwrap() before calling this method if this Player
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pl *Player) Update() *PlayerUpdateOne {
+	return NewPlayerClient(pl.config).UpdateOne(pl)
+}
+
+// Unwrap unwraps the Player entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pl *Player) Unwrap() *Player {
+	_tx, ok := pl.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: Player is not a transactional entity")
+	}
+	pl.config.driver = _tx.drv
+	return pl
+}
+
+// String implements the fmt.Stringer.
+func (pl *Player) String() string {
+	var builder strings.Builder
+	builder.WriteString("Player(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pl.ID))
+	builder.WriteString("display_name=")
+	builder.WriteString(pl.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(pl.Region)
+	builder.WriteString(", ")
+	builder.WriteString("banned=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Banned))
+	builder.WriteString(", ")
+	builder.WriteString("ban_reason=")
+	builder.WriteString(pl.BanReason)
+	builder.WriteString(", ")
+	builder.WriteString("banned_until=")
+	builder.WriteString(fmt.Sprintf("%v", pl.BannedUntil))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", pl.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", pl.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Players is a parsable slice of Player.
+type Players []*Player
@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package player
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the player type in the database.
+	Label = "player"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldBanned holds the string denoting the banned field in the database.
+	FieldBanned = "banned"
+	// FieldBanReason holds the string denoting the ban_reason field in the database.
+	FieldBanReason = "ban_reason"
+	// FieldBannedUntil holds the string denoting the banned_until field in the database.
+	FieldBannedUntil = "banned_until"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeAccounts holds the string denoting the accounts edge name in mutations.
+	EdgeAccounts = "accounts"
+	// EdgeInventory holds the string denoting the inventory edge name in mutations.
+	EdgeInventory = "inventory"
+	// EdgeWallets holds the string denoting the wallets edge name in mutations.
+	EdgeWallets = "wallets"
+	// EdgeLedger holds the string denoting the ledger edge name in mutations.
+	EdgeLedger = "ledger"
+	// EdgeProposedTrades holds the string denoting the proposed_trades edge name in mutations.
+	EdgeProposedTrades = "proposed_trades"
+	// EdgeReceivedTrades holds the string denoting the received_trades edge name in mutations.
+	EdgeReceivedTrades = "received_trades"
+	// EdgeMarketOrders holds the string denoting the market_orders edge name in mutations.
+	EdgeMarketOrders = "market_orders"
+	// EdgeItemInstances holds the string denoting the item_instances edge name in mutations.
+	EdgeItemInstances = "item_instances"
+	// Table holds the table name of the player in the database.
+	Table = "players"
+	// AccountsTable is the table that holds the accounts relation/edge.
+	AccountsTable = "player_accounts"
+	// AccountsInverseTable is the table name for the PlayerAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "playeraccount" package.
+	AccountsInverseTable = "player_accounts"
+	// AccountsColumn is the table column denoting the accounts relation/edge.
+	AccountsColumn = "player_id"
+	// InventoryTable is the table that holds the inventory relation/edge.
+	InventoryTable = "inventory_items"
+	// InventoryInverseTable is the table name for the InventoryItem entity.
+	// It exists in this package in order to avoid circular dependency with the "inventoryitem" package.
+	InventoryInverseTable = "inventory_items"
+	// InventoryColumn is the table column denoting the inventory relation/edge.
+	InventoryColumn = "player_id"
+	// WalletsTable is the table that holds the wallets relation/edge.
+	WalletsTable = "wallets"
+	// WalletsInverseTable is the table name for the Wallet entity.
+	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	WalletsInverseTable = "wallets"
+	// WalletsColumn is the table column denoting the wallets relation/edge.
+	WalletsColumn = "player_id"
+	// LedgerTable is the table that holds the ledger relation/edge.
+	LedgerTable = "ledger_entries"
+	// LedgerInverseTable is the table name for the LedgerEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "ledgerentry" package.
+	LedgerInverseTable = "ledger_entries"
+	// LedgerColumn is the table column denoting the ledger relation/edge.
+	LedgerColumn = "player_id"
+	// ProposedTradesTable is the table that holds the proposed_trades relation/edge.
+	ProposedTradesTable = "trades"
+	// ProposedTradesInverseTable is the table name for the Trade entity.
+	// It exists in this package in order to avoid circular dependency with the "trade" package.
+	ProposedTradesInverseTable = "trades"
+	// ProposedTradesColumn is the table column denoting the proposed_trades relation/edge.
+	ProposedTradesColumn = "proposer_id"
+	// ReceivedTradesTable is the table that holds the received_trades relation/edge.
+	ReceivedTradesTable = "trades"
+	// ReceivedTradesInverseTable is the table name for the Trade entity.
+	// It exists in this package in order to avoid circular dependency with the "trade" package.
+	ReceivedTradesInverseTable = "trades"
+	// ReceivedTradesColumn is the table column denoting the received_trades relation/edge.
+	ReceivedTradesColumn = "recipient_id"
+	// MarketOrdersTable is the table that holds the market_orders relation/edge.
+	MarketOrdersTable = "market_orders"
+	// MarketOrdersInverseTable is the table name for the MarketOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "marketorder" package.
+	MarketOrdersInverseTable = "market_orders"
+	// MarketOrdersColumn is the table column denoting the market_orders relation/edge.
+	MarketOrdersColumn = "player_id"
+	// ItemInstancesTable is the table that holds the item_instances relation/edge.
+	ItemInstancesTable = "item_instances"
+	// ItemInstancesInverseTable is the table name for the ItemInstance entity.
+	// It exists in this package in order to avoid circular dependency with the "iteminstance" package.
+	ItemInstancesInverseTable = "item_instances"
+	// ItemInstancesColumn is the table column denoting the item_instances relation/edge.
+	ItemInstancesColumn = "owner_id"
+)
+
+// Columns holds all SQL columns for player fields.
+var Columns = []string{
+	FieldID,
+	FieldDisplayName,
+	FieldRegion,
+	FieldBanned,
+	FieldBanReason,
+	FieldBannedUntil,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRegion holds the default value on creation for the "region" field.
+	DefaultRegion string
+	// DefaultBanned holds the default value on creation for the "banned" field.
+	DefaultBanned bool
+	// DefaultBanReason holds the default value on creation for the "ban_reason" field.
+	DefaultBanReason string
+	// DefaultBannedUntil holds the default value on creation for the "banned_until" field.
+	DefaultBannedUntil int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() int64
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() int64
+)
+
+// OrderOption defines the ordering options for the Player queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByBanned orders the results by the banned field.
+func ByBanned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBanned, opts...).ToFunc()
+}
+
+// ByBanReason orders the results by the ban_reason field.
+func ByBanReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBanReason, opts...).ToFunc()
+}
+
+// ByBannedUntil orders the results by the banned_until field.
+func ByBannedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBannedUntil, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAccountsCount orders the results by accounts count.
+func ByAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccountsStep(), opts...)
+	}
+}
+
+// ByAccounts orders the results by accounts terms.
+func ByAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInventoryCount orders the results by inventory count.
+func ByInventoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInventoryStep(), opts...)
+	}
+}
+
+// ByInventory orders the results by inventory terms.
+func ByInventory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInventoryStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWalletsCount orders the results by wallets count.
+func ByWalletsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWalletsStep(), opts...)
+	}
+}
+
+// ByWallets orders the results by wallets terms.
+func ByWallets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWalletsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLedgerCount orders the results by ledger count.
+func ByLedgerCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLedgerStep(), opts...)
+	}
+}
+
+// ByLedger orders the results by ledger terms.
+func ByLedger(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedgerStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProposedTradesCount orders the results by proposed_trades count.
+func ByProposedTradesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProposedTradesStep(), opts...)
+	}
+}
+
+// ByProposedTrades orders the results by proposed_trades terms.
+func ByProposedTrades(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProposedTradesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceivedTradesCount orders the results by received_trades count.
+func ByReceivedTradesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceivedTradesStep(), opts...)
+	}
+}
+
+// ByReceivedTrades orders the results by received_trades terms.
+func ByReceivedTrades(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceivedTradesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMarketOrdersCount orders the results by market_orders count.
+func ByMarketOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMarketOrdersStep(), opts...)
+	}
+}
+
+// ByMarketOrders orders the results by market_orders terms.
+func ByMarketOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMarketOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemInstancesCount orders the results by item_instances count.
+func ByItemInstancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemInstancesStep(), opts...)
+	}
+}
+
+// ByItemInstances orders the results by item_instances terms.
+func ByItemInstances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemInstancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccountsTable, AccountsColumn),
+	)
+}
+func newInventoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InventoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InventoryTable, InventoryColumn),
+	)
+}
+func newWalletsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WalletsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WalletsTable, WalletsColumn),
+	)
+}
+func newLedgerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedgerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LedgerTable, LedgerColumn),
+	)
+}
+func newProposedTradesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProposedTradesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProposedTradesTable, ProposedTradesColumn),
+	)
+}
+func newReceivedTradesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceivedTradesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceivedTradesTable, ReceivedTradesColumn),
+	)
+}
+func newMarketOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MarketOrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MarketOrdersTable, MarketOrdersColumn),
+	)
+}
+func newItemInstancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInstancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemInstancesTable, ItemInstancesColumn),
+	)
+}
@@ -0,0 +1,1146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+)
+
+// PlayerCreate is the builder for creating a Player entity.
+type PlayerCreate struct {
+	config
+	mutation *PlayerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDisplayName sets the "display_name" field.
+func (pc *PlayerCreate) SetDisplayName(s string) *PlayerCreate {
+	pc.mutation.SetDisplayName(s)
+	return pc
+}
+
+// SetRegion sets the "region" field.
+func (pc *PlayerCreate) SetRegion(s string) *PlayerCreate {
+	pc.mutation.SetRegion(s)
+	return pc
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableRegion(s *string) *PlayerCreate {
+	if s != nil {
+		pc.SetRegion(*s)
+	}
+	return pc
+}
+
+// SetBanned sets the "banned" field.
+func (pc *PlayerCreate) SetBanned(b bool) *PlayerCreate {
+	pc.mutation.SetBanned(b)
+	return pc
+}
+
+// SetNillableBanned sets the "banned" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableBanned(b *bool) *PlayerCreate {
+	if b != nil {
+		pc.SetBanned(*b)
+	}
+	return pc
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (pc *PlayerCreate) SetBanReason(s string) *PlayerCreate {
+	pc.mutation.SetBanReason(s)
+	return pc
+}
+
+// SetNillableBanReason sets the "ban_reason" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableBanReason(s *string) *PlayerCreate {
+	if s != nil {
+		pc.SetBanReason(*s)
+	}
+	return pc
+}
+
+// SetBannedUntil sets the "banned_until" field.
+func (pc *PlayerCreate) SetBannedUntil(i int64) *PlayerCreate {
+	pc.mutation.SetBannedUntil(i)
+	return pc
+}
+
+// SetNillableBannedUntil sets the "banned_until" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableBannedUntil(i *int64) *PlayerCreate {
+	if i != nil {
+		pc.SetBannedUntil(*i)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PlayerCreate) SetCreatedAt(i int64) *PlayerCreate {
+	pc.mutation.SetCreatedAt(i)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableCreatedAt(i *int64) *PlayerCreate {
+	if i != nil {
+		pc.SetCreatedAt(*i)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PlayerCreate) SetUpdatedAt(i int64) *PlayerCreate {
+	pc.mutation.SetUpdatedAt(i)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableUpdatedAt(i *int64) *PlayerCreate {
+	if i != nil {
+		pc.SetUpdatedAt(*i)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PlayerCreate) SetID(s string) *PlayerCreate {
+	pc.mutation.SetID(s)
+	return pc
+}
+
+// AddAccountIDs adds the "accounts" edge to the PlayerAccount entity by IDs.
+func (pc *PlayerCreate) AddAccountIDs(ids ...int64) *PlayerCreate {
+	pc.mutation.AddAccountIDs(ids...)
+	return pc
+}
+
+// AddAccounts adds the "accounts" edges to the PlayerAccount entity.
+func (pc *PlayerCreate) AddAccounts(p ...*PlayerAccount) *PlayerCreate {
+	ids := make([]int64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddAccountIDs(ids...)
+}
+
+// AddInventoryIDs adds the "inventory" edge to the InventoryItem entity by IDs.
+func (pc *PlayerCreate) AddInventoryIDs(ids ...int64) *PlayerCreate {
+	pc.mutation.AddInventoryIDs(ids...)
+	return pc
+}
+
+// AddInventory adds the "inventory" edges to the InventoryItem entity.
+func (pc *PlayerCreate) AddInventory(i ...*InventoryItem) *PlayerCreate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pc.AddInventoryIDs(ids...)
+}
+
+// AddWalletIDs adds the "wallets" edge to the Wallet entity by IDs.
+func (pc *PlayerCreate) AddWalletIDs(ids ...int64) *PlayerCreate {
+	pc.mutation.AddWalletIDs(ids...)
+	return pc
+}
+
+// AddWallets adds the "wallets" edges to the Wallet entity.
+func (pc *PlayerCreate) AddWallets(w ...*Wallet) *PlayerCreate {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pc.AddWalletIDs(ids...)
+}
+
+// AddLedgerIDs adds the "ledger" edge to the LedgerEntry entity by IDs.
+func (pc *PlayerCreate) AddLedgerIDs(ids ...int64) *PlayerCreate {
+	pc.mutation.AddLedgerIDs(ids...)
+	return pc
+}
+
+// AddLedger adds the "ledger" edges to the LedgerEntry entity.
+func (pc *PlayerCreate) AddLedger(l ...*LedgerEntry) *PlayerCreate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pc.AddLedgerIDs(ids...)
+}
+
+// AddProposedTradeIDs adds the "proposed_trades" edge to the Trade entity by IDs.
+func (pc *PlayerCreate) AddProposedTradeIDs(ids ...int64) *PlayerCreate {
+	pc.mutation.AddProposedTradeIDs(ids...)
+	return pc
+}
+
+// AddProposedTrades adds the "proposed_trades" edges to the Trade entity.
+func (pc *PlayerCreate) AddProposedTrades(t ...*Trade) *PlayerCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddProposedTradeIDs(ids...)
+}
+
+// AddReceivedTradeIDs adds the "received_trades" edge to the Trade entity by IDs.
+func (pc *PlayerCreate) AddReceivedTradeIDs(ids ...int64) *PlayerCreate {
+	pc.mutation.AddReceivedTradeIDs(ids...)
+	return pc
+}
+
+// AddReceivedTrades adds the "received_trades" edges to the Trade entity.
+func (pc *PlayerCreate) AddReceivedTrades(t ...*Trade) *PlayerCreate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddReceivedTradeIDs(ids...)
+}
+
+// AddMarketOrderIDs adds the "market_orders" edge to the MarketOrder entity by IDs.
+func (pc *PlayerCreate) AddMarketOrderIDs(ids ...int64) *PlayerCreate {
+	pc.mutation.AddMarketOrderIDs(ids...)
+	return pc
+}
+
+// AddMarketOrders adds the "market_orders" edges to the MarketOrder entity.
+func (pc *PlayerCreate) AddMarketOrders(m ...*MarketOrder) *PlayerCreate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pc.AddMarketOrderIDs(ids...)
+}
+
+// AddItemInstanceIDs adds the "item_instances" edge to the ItemInstance entity by IDs.
+func (pc *PlayerCreate) AddItemInstanceIDs(ids ...string) *PlayerCreate {
+	pc.mutation.AddItemInstanceIDs(ids...)
+	return pc
+}
+
+// AddItemInstances adds the "item_instances" edges to the ItemInstance entity.
+func (pc *PlayerCreate) AddItemInstances(i ...*ItemInstance) *PlayerCreate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pc.AddItemInstanceIDs(ids...)
+}
+
+// Mutation returns the PlayerMutation object of the builder.
+func (pc *PlayerCreate) Mutation() *PlayerMutation {
+	return pc.mutation
+}
+
+// Save creates the Player in the database.
+func (pc *PlayerCreate) Save(ctx context.Context) (*Player, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PlayerCreate) SaveX(ctx context.Context) *Player {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PlayerCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PlayerCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PlayerCreate) defaults() {
+	if _, ok := pc.mutation.Region(); !ok {
+		v := player.DefaultRegion
+		pc.mutation.SetRegion(v)
+	}
+	if _, ok := pc.mutation.Banned(); !ok {
+		v := player.DefaultBanned
+		pc.mutation.SetBanned(v)
+	}
+	if _, ok := pc.mutation.BanReason(); !ok {
+		v := player.DefaultBanReason
+		pc.mutation.SetBanReason(v)
+	}
+	if _, ok := pc.mutation.BannedUntil(); !ok {
+		v := player.DefaultBannedUntil
+		pc.mutation.SetBannedUntil(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := player.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := player.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PlayerCreate) check() error {
+	if _, ok := pc.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`entc: missing required field "Player.display_name"`)}
+	}
+	if _, ok := pc.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`entc: missing required field "Player.region"`)}
+	}
+	if _, ok := pc.mutation.Banned(); !ok {
+		return &ValidationError{Name: "banned", err: errors.New(`entc: missing required field "Player.banned"`)}
+	}
+	if _, ok := pc.mutation.BanReason(); !ok {
+		return &ValidationError{Name: "ban_reason", err: errors.New(`entc: missing required field "Player.ban_reason"`)}
+	}
+	if _, ok := pc.mutation.BannedUntil(); !ok {
+		return &ValidationError{Name: "banned_until", err: errors.New(`entc: missing required field "Player.banned_until"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "Player.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "Player.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *PlayerCreate) sqlSave(ctx context.Context) (*Player, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Player.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Player{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(player.Table, sqlgraph.NewFieldSpec(player.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = pc.conflict
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.DisplayName(); ok {
+		_spec.SetField(player.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := pc.mutation.Region(); ok {
+		_spec.SetField(player.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := pc.mutation.Banned(); ok {
+		_spec.SetField(player.FieldBanned, field.TypeBool, value)
+		_node.Banned = value
+	}
+	if value, ok := pc.mutation.BanReason(); ok {
+		_spec.SetField(player.FieldBanReason, field.TypeString, value)
+		_node.BanReason = value
+	}
+	if value, ok := pc.mutation.BannedUntil(); ok {
+		_spec.SetField(player.FieldBannedUntil, field.TypeInt64, value)
+		_node.BannedUntil = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(player.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(player.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.AccountsTable,
+			Columns: []string{player.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.InventoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.InventoryTable,
+			Columns: []string{player.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.WalletsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.WalletsTable,
+			Columns: []string{player.WalletsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.LedgerTable,
+			Columns: []string{player.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ProposedTradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ProposedTradesTable,
+			Columns: []string{player.ProposedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ReceivedTradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ReceivedTradesTable,
+			Columns: []string{player.ReceivedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.MarketOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.MarketOrdersTable,
+			Columns: []string{player.MarketOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ItemInstancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ItemInstancesTable,
+			Columns: []string{player.ItemInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Player.Create().
+//		SetDisplayName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlayerUpsert) {
+//			SetDisplayName(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PlayerCreate) OnConflict(opts ...sql.ConflictOption) *PlayerUpsertOne {
+	pc.conflict = opts
+	return &PlayerUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Player.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PlayerCreate) OnConflictColumns(columns ...string) *PlayerUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PlayerUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PlayerUpsertOne is the builder for "upsert"-ing
+	//  one Player node.
+	PlayerUpsertOne struct {
+		create *PlayerCreate
+	}
+
+	// PlayerUpsert is the "OnConflict" setter.
+	PlayerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDisplayName sets the "display_name" field.
+func (u *PlayerUpsert) SetDisplayName(v string) *PlayerUpsert {
+	u.Set(player.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *PlayerUpsert) UpdateDisplayName() *PlayerUpsert {
+	u.SetExcluded(player.FieldDisplayName)
+	return u
+}
+
+// SetRegion sets the "region" field.
+func (u *PlayerUpsert) SetRegion(v string) *PlayerUpsert {
+	u.Set(player.FieldRegion, v)
+	return u
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *PlayerUpsert) UpdateRegion() *PlayerUpsert {
+	u.SetExcluded(player.FieldRegion)
+	return u
+}
+
+// SetBanned sets the "banned" field.
+func (u *PlayerUpsert) SetBanned(v bool) *PlayerUpsert {
+	u.Set(player.FieldBanned, v)
+	return u
+}
+
+// UpdateBanned sets the "banned" field to the value that was provided on create.
+func (u *PlayerUpsert) UpdateBanned() *PlayerUpsert {
+	u.SetExcluded(player.FieldBanned)
+	return u
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (u *PlayerUpsert) SetBanReason(v string) *PlayerUpsert {
+	u.Set(player.FieldBanReason, v)
+	return u
+}
+
+// UpdateBanReason sets the "ban_reason" field to the value that was provided on create.
+func (u *PlayerUpsert) UpdateBanReason() *PlayerUpsert {
+	u.SetExcluded(player.FieldBanReason)
+	return u
+}
+
+// SetBannedUntil sets the "banned_until" field.
+func (u *PlayerUpsert) SetBannedUntil(v int64) *PlayerUpsert {
+	u.Set(player.FieldBannedUntil, v)
+	return u
+}
+
+// UpdateBannedUntil sets the "banned_until" field to the value that was provided on create.
+func (u *PlayerUpsert) UpdateBannedUntil() *PlayerUpsert {
+	u.SetExcluded(player.FieldBannedUntil)
+	return u
+}
+
+// AddBannedUntil adds v to the "banned_until" field.
+func (u *PlayerUpsert) AddBannedUntil(v int64) *PlayerUpsert {
+	u.Add(player.FieldBannedUntil, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlayerUpsert) SetUpdatedAt(v int64) *PlayerUpsert {
+	u.Set(player.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlayerUpsert) UpdateUpdatedAt() *PlayerUpsert {
+	u.SetExcluded(player.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *PlayerUpsert) AddUpdatedAt(v int64) *PlayerUpsert {
+	u.Add(player.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Player.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(player.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlayerUpsertOne) UpdateNewValues() *PlayerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(player.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(player.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Player.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlayerUpsertOne) Ignore() *PlayerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlayerUpsertOne) DoNothing() *PlayerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlayerCreate.OnConflict
+// documentation for more info.
+func (u *PlayerUpsertOne) Update(set func(*PlayerUpsert)) *PlayerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlayerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *PlayerUpsertOne) SetDisplayName(v string) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *PlayerUpsertOne) UpdateDisplayName() *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetRegion sets the "region" field.
+func (u *PlayerUpsertOne) SetRegion(v string) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetRegion(v)
+	})
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *PlayerUpsertOne) UpdateRegion() *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateRegion()
+	})
+}
+
+// SetBanned sets the "banned" field.
+func (u *PlayerUpsertOne) SetBanned(v bool) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetBanned(v)
+	})
+}
+
+// UpdateBanned sets the "banned" field to the value that was provided on create.
+func (u *PlayerUpsertOne) UpdateBanned() *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateBanned()
+	})
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (u *PlayerUpsertOne) SetBanReason(v string) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetBanReason(v)
+	})
+}
+
+// UpdateBanReason sets the "ban_reason" field to the value that was provided on create.
+func (u *PlayerUpsertOne) UpdateBanReason() *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateBanReason()
+	})
+}
+
+// SetBannedUntil sets the "banned_until" field.
+func (u *PlayerUpsertOne) SetBannedUntil(v int64) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetBannedUntil(v)
+	})
+}
+
+// AddBannedUntil adds v to the "banned_until" field.
+func (u *PlayerUpsertOne) AddBannedUntil(v int64) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.AddBannedUntil(v)
+	})
+}
+
+// UpdateBannedUntil sets the "banned_until" field to the value that was provided on create.
+func (u *PlayerUpsertOne) UpdateBannedUntil() *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateBannedUntil()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlayerUpsertOne) SetUpdatedAt(v int64) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *PlayerUpsertOne) AddUpdatedAt(v int64) *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlayerUpsertOne) UpdateUpdatedAt() *PlayerUpsertOne {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlayerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for PlayerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlayerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlayerUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entc: PlayerUpsertOne.ID is not supported by MySQL driver. Use PlayerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlayerUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlayerCreateBulk is the builder for creating many Player entities in bulk.
+type PlayerCreateBulk struct {
+	config
+	err      error
+	builders []*PlayerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Player entities in the database.
+func (pcb *PlayerCreateBulk) Save(ctx context.Context) ([]*Player, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Player, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PlayerCreateBulk) SaveX(ctx context.Context) []*Player {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PlayerCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PlayerCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Player.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlayerUpsert) {
+//			SetDisplayName(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PlayerCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlayerUpsertBulk {
+	pcb.conflict = opts
+	return &PlayerUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Player.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PlayerCreateBulk) OnConflictColumns(columns ...string) *PlayerUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PlayerUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PlayerUpsertBulk is the builder for "upsert"-ing
+// a bulk of Player nodes.
+type PlayerUpsertBulk struct {
+	create *PlayerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Player.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(player.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlayerUpsertBulk) UpdateNewValues() *PlayerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(player.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(player.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Player.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlayerUpsertBulk) Ignore() *PlayerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlayerUpsertBulk) DoNothing() *PlayerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlayerCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlayerUpsertBulk) Update(set func(*PlayerUpsert)) *PlayerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlayerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *PlayerUpsertBulk) SetDisplayName(v string) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *PlayerUpsertBulk) UpdateDisplayName() *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetRegion sets the "region" field.
+func (u *PlayerUpsertBulk) SetRegion(v string) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetRegion(v)
+	})
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *PlayerUpsertBulk) UpdateRegion() *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateRegion()
+	})
+}
+
+// SetBanned sets the "banned" field.
+func (u *PlayerUpsertBulk) SetBanned(v bool) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetBanned(v)
+	})
+}
+
+// UpdateBanned sets the "banned" field to the value that was provided on create.
+func (u *PlayerUpsertBulk) UpdateBanned() *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateBanned()
+	})
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (u *PlayerUpsertBulk) SetBanReason(v string) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetBanReason(v)
+	})
+}
+
+// UpdateBanReason sets the "ban_reason" field to the value that was provided on create.
+func (u *PlayerUpsertBulk) UpdateBanReason() *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateBanReason()
+	})
+}
+
+// SetBannedUntil sets the "banned_until" field.
+func (u *PlayerUpsertBulk) SetBannedUntil(v int64) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetBannedUntil(v)
+	})
+}
+
+// AddBannedUntil adds v to the "banned_until" field.
+func (u *PlayerUpsertBulk) AddBannedUntil(v int64) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.AddBannedUntil(v)
+	})
+}
+
+// UpdateBannedUntil sets the "banned_until" field to the value that was provided on create.
+func (u *PlayerUpsertBulk) UpdateBannedUntil() *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateBannedUntil()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PlayerUpsertBulk) SetUpdatedAt(v int64) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *PlayerUpsertBulk) AddUpdatedAt(v int64) *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PlayerUpsertBulk) UpdateUpdatedAt() *PlayerUpsertBulk {
+	return u.Update(func(s *PlayerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PlayerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the PlayerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for PlayerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlayerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1740 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+)
+
+// PlayerUpdate is the builder for updating Player entities.
+type PlayerUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PlayerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PlayerUpdate builder.
+func (pu *PlayerUpdate) Where(ps ...predicate.Player) *PlayerUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetDisplayName sets the "display_name" field.
+func (pu *PlayerUpdate) SetDisplayName(s string) *PlayerUpdate {
+	pu.mutation.SetDisplayName(s)
+	return pu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableDisplayName(s *string) *PlayerUpdate {
+	if s != nil {
+		pu.SetDisplayName(*s)
+	}
+	return pu
+}
+
+// SetRegion sets the "region" field.
+func (pu *PlayerUpdate) SetRegion(s string) *PlayerUpdate {
+	pu.mutation.SetRegion(s)
+	return pu
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableRegion(s *string) *PlayerUpdate {
+	if s != nil {
+		pu.SetRegion(*s)
+	}
+	return pu
+}
+
+// SetBanned sets the "banned" field.
+func (pu *PlayerUpdate) SetBanned(b bool) *PlayerUpdate {
+	pu.mutation.SetBanned(b)
+	return pu
+}
+
+// SetNillableBanned sets the "banned" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableBanned(b *bool) *PlayerUpdate {
+	if b != nil {
+		pu.SetBanned(*b)
+	}
+	return pu
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (pu *PlayerUpdate) SetBanReason(s string) *PlayerUpdate {
+	pu.mutation.SetBanReason(s)
+	return pu
+}
+
+// SetNillableBanReason sets the "ban_reason" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableBanReason(s *string) *PlayerUpdate {
+	if s != nil {
+		pu.SetBanReason(*s)
+	}
+	return pu
+}
+
+// SetBannedUntil sets the "banned_until" field.
+func (pu *PlayerUpdate) SetBannedUntil(i int64) *PlayerUpdate {
+	pu.mutation.ResetBannedUntil()
+	pu.mutation.SetBannedUntil(i)
+	return pu
+}
+
+// SetNillableBannedUntil sets the "banned_until" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableBannedUntil(i *int64) *PlayerUpdate {
+	if i != nil {
+		pu.SetBannedUntil(*i)
+	}
+	return pu
+}
+
+// AddBannedUntil adds i to the "banned_until" field.
+func (pu *PlayerUpdate) AddBannedUntil(i int64) *PlayerUpdate {
+	pu.mutation.AddBannedUntil(i)
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PlayerUpdate) SetUpdatedAt(i int64) *PlayerUpdate {
+	pu.mutation.ResetUpdatedAt()
+	pu.mutation.SetUpdatedAt(i)
+	return pu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (pu *PlayerUpdate) AddUpdatedAt(i int64) *PlayerUpdate {
+	pu.mutation.AddUpdatedAt(i)
+	return pu
+}
+
+// AddAccountIDs adds the "accounts" edge to the PlayerAccount entity by IDs.
+func (pu *PlayerUpdate) AddAccountIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.AddAccountIDs(ids...)
+	return pu
+}
+
+// AddAccounts adds the "accounts" edges to the PlayerAccount entity.
+func (pu *PlayerUpdate) AddAccounts(p ...*PlayerAccount) *PlayerUpdate {
+	ids := make([]int64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddAccountIDs(ids...)
+}
+
+// AddInventoryIDs adds the "inventory" edge to the InventoryItem entity by IDs.
+func (pu *PlayerUpdate) AddInventoryIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.AddInventoryIDs(ids...)
+	return pu
+}
+
+// AddInventory adds the "inventory" edges to the InventoryItem entity.
+func (pu *PlayerUpdate) AddInventory(i ...*InventoryItem) *PlayerUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pu.AddInventoryIDs(ids...)
+}
+
+// AddWalletIDs adds the "wallets" edge to the Wallet entity by IDs.
+func (pu *PlayerUpdate) AddWalletIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.AddWalletIDs(ids...)
+	return pu
+}
+
+// AddWallets adds the "wallets" edges to the Wallet entity.
+func (pu *PlayerUpdate) AddWallets(w ...*Wallet) *PlayerUpdate {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.AddWalletIDs(ids...)
+}
+
+// AddLedgerIDs adds the "ledger" edge to the LedgerEntry entity by IDs.
+func (pu *PlayerUpdate) AddLedgerIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.AddLedgerIDs(ids...)
+	return pu
+}
+
+// AddLedger adds the "ledger" edges to the LedgerEntry entity.
+func (pu *PlayerUpdate) AddLedger(l ...*LedgerEntry) *PlayerUpdate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pu.AddLedgerIDs(ids...)
+}
+
+// AddProposedTradeIDs adds the "proposed_trades" edge to the Trade entity by IDs.
+func (pu *PlayerUpdate) AddProposedTradeIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.AddProposedTradeIDs(ids...)
+	return pu
+}
+
+// AddProposedTrades adds the "proposed_trades" edges to the Trade entity.
+func (pu *PlayerUpdate) AddProposedTrades(t ...*Trade) *PlayerUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddProposedTradeIDs(ids...)
+}
+
+// AddReceivedTradeIDs adds the "received_trades" edge to the Trade entity by IDs.
+func (pu *PlayerUpdate) AddReceivedTradeIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.AddReceivedTradeIDs(ids...)
+	return pu
+}
+
+// AddReceivedTrades adds the "received_trades" edges to the Trade entity.
+func (pu *PlayerUpdate) AddReceivedTrades(t ...*Trade) *PlayerUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddReceivedTradeIDs(ids...)
+}
+
+// AddMarketOrderIDs adds the "market_orders" edge to the MarketOrder entity by IDs.
+func (pu *PlayerUpdate) AddMarketOrderIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.AddMarketOrderIDs(ids...)
+	return pu
+}
+
+// AddMarketOrders adds the "market_orders" edges to the MarketOrder entity.
+func (pu *PlayerUpdate) AddMarketOrders(m ...*MarketOrder) *PlayerUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.AddMarketOrderIDs(ids...)
+}
+
+// AddItemInstanceIDs adds the "item_instances" edge to the ItemInstance entity by IDs.
+func (pu *PlayerUpdate) AddItemInstanceIDs(ids ...string) *PlayerUpdate {
+	pu.mutation.AddItemInstanceIDs(ids...)
+	return pu
+}
+
+// AddItemInstances adds the "item_instances" edges to the ItemInstance entity.
+func (pu *PlayerUpdate) AddItemInstances(i ...*ItemInstance) *PlayerUpdate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pu.AddItemInstanceIDs(ids...)
+}
+
+// Mutation returns the PlayerMutation object of the builder.
+func (pu *PlayerUpdate) Mutation() *PlayerMutation {
+	return pu.mutation
+}
+
+// ClearAccounts clears all "accounts" edges to the PlayerAccount entity.
+func (pu *PlayerUpdate) ClearAccounts() *PlayerUpdate {
+	pu.mutation.ClearAccounts()
+	return pu
+}
+
+// RemoveAccountIDs removes the "accounts" edge to PlayerAccount entities by IDs.
+func (pu *PlayerUpdate) RemoveAccountIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.RemoveAccountIDs(ids...)
+	return pu
+}
+
+// RemoveAccounts removes "accounts" edges to PlayerAccount entities.
+func (pu *PlayerUpdate) RemoveAccounts(p ...*PlayerAccount) *PlayerUpdate {
+	ids := make([]int64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveAccountIDs(ids...)
+}
+
+// ClearInventory clears all "inventory" edges to the InventoryItem entity.
+func (pu *PlayerUpdate) ClearInventory() *PlayerUpdate {
+	pu.mutation.ClearInventory()
+	return pu
+}
+
+// RemoveInventoryIDs removes the "inventory" edge to InventoryItem entities by IDs.
+func (pu *PlayerUpdate) RemoveInventoryIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.RemoveInventoryIDs(ids...)
+	return pu
+}
+
+// RemoveInventory removes "inventory" edges to InventoryItem entities.
+func (pu *PlayerUpdate) RemoveInventory(i ...*InventoryItem) *PlayerUpdate {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pu.RemoveInventoryIDs(ids...)
+}
+
+// ClearWallets clears all "wallets" edges to the Wallet entity.
+func (pu *PlayerUpdate) ClearWallets() *PlayerUpdate {
+	pu.mutation.ClearWallets()
+	return pu
+}
+
+// RemoveWalletIDs removes the "wallets" edge to Wallet entities by IDs.
+func (pu *PlayerUpdate) RemoveWalletIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.RemoveWalletIDs(ids...)
+	return pu
+}
+
+// RemoveWallets removes "wallets" edges to Wallet entities.
+func (pu *PlayerUpdate) RemoveWallets(w ...*Wallet) *PlayerUpdate {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.RemoveWalletIDs(ids...)
+}
+
+// ClearLedger clears all "ledger" edges to the LedgerEntry entity.
+func (pu *PlayerUpdate) ClearLedger() *PlayerUpdate {
+	pu.mutation.ClearLedger()
+	return pu
+}
+
+// RemoveLedgerIDs removes the "ledger" edge to LedgerEntry entities by IDs.
+func (pu *PlayerUpdate) RemoveLedgerIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.RemoveLedgerIDs(ids...)
+	return pu
+}
+
+// RemoveLedger removes "ledger" edges to LedgerEntry entities.
+func (pu *PlayerUpdate) RemoveLedger(l ...*LedgerEntry) *PlayerUpdate {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pu.RemoveLedgerIDs(ids...)
+}
+
+// ClearProposedTrades clears all "proposed_trades" edges to the Trade entity.
+func (pu *PlayerUpdate) ClearProposedTrades() *PlayerUpdate {
+	pu.mutation.ClearProposedTrades()
+	return pu
+}
+
+// RemoveProposedTradeIDs removes the "proposed_trades" edge to Trade entities by IDs.
+func (pu *PlayerUpdate) RemoveProposedTradeIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.RemoveProposedTradeIDs(ids...)
+	return pu
+}
+
+// RemoveProposedTrades removes "proposed_trades" edges to Trade entities.
+func (pu *PlayerUpdate) RemoveProposedTrades(t ...*Trade) *PlayerUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveProposedTradeIDs(ids...)
+}
+
+// ClearReceivedTrades clears all "received_trades" edges to the Trade entity.
+func (pu *PlayerUpdate) ClearReceivedTrades() *PlayerUpdate {
+	pu.mutation.ClearReceivedTrades()
+	return pu
+}
+
+// RemoveReceivedTradeIDs removes the "received_trades" edge to Trade entities by IDs.
+func (pu *PlayerUpdate) RemoveReceivedTradeIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.RemoveReceivedTradeIDs(ids...)
+	return pu
+}
+
+// RemoveReceivedTrades removes "received_trades" edges to Trade entities.
+func (pu *PlayerUpdate) RemoveReceivedTrades(t ...*Trade) *PlayerUpdate {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveReceivedTradeIDs(ids...)
+}
+
+// ClearMarketOrders clears all "market_orders" edges to the MarketOrder entity.
+func (pu *PlayerUpdate) ClearMarketOrders() *PlayerUpdate {
+	pu.mutation.ClearMarketOrders()
+	return pu
+}
+
+// RemoveMarketOrderIDs removes the "market_orders" edge to MarketOrder entities by IDs.
+func (pu *PlayerUpdate) RemoveMarketOrderIDs(ids ...int64) *PlayerUpdate {
+	pu.mutation.RemoveMarketOrderIDs(ids...)
+	return pu
+}
+
+// RemoveMarketOrders removes "market_orders" edges to MarketOrder entities.
+func (pu *PlayerUpdate) RemoveMarketOrders(m ...*MarketOrder) *PlayerUpdate {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return pu.RemoveMarketOrderIDs(ids...)
+}
+
+// ClearItemInstances clears all "item_instances" edges to the ItemInstance entity.
+func (pu *PlayerUpdate) ClearItemInstances() *PlayerUpdate {
+	pu.mutation.ClearItemInstances()
+	return pu
+}
+
+// RemoveItemInstanceIDs removes the "item_instances" edge to ItemInstance entities by IDs.
+func (pu *PlayerUpdate) RemoveItemInstanceIDs(ids ...string) *PlayerUpdate {
+	pu.mutation.RemoveItemInstanceIDs(ids...)
+	return pu
+}
+
+// RemoveItemInstances removes "item_instances" edges to ItemInstance entities.
+func (pu *PlayerUpdate) RemoveItemInstances(i ...*ItemInstance) *PlayerUpdate {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return pu.RemoveItemInstanceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PlayerUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PlayerUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PlayerUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PlayerUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PlayerUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := player.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PlayerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlayerUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
+func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(player.Table, player.Columns, sqlgraph.NewFieldSpec(player.FieldID, field.TypeString))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.DisplayName(); ok {
+		_spec.SetField(player.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Region(); ok {
+		_spec.SetField(player.FieldRegion, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Banned(); ok {
+		_spec.SetField(player.FieldBanned, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.BanReason(); ok {
+		_spec.SetField(player.FieldBanReason, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.BannedUntil(); ok {
+		_spec.SetField(player.FieldBannedUntil, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedBannedUntil(); ok {
+		_spec.AddField(player.FieldBannedUntil, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(player.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(player.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if pu.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.AccountsTable,
+			Columns: []string{player.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !pu.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.AccountsTable,
+			Columns: []string{player.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.AccountsTable,
+			Columns: []string{player.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.InventoryTable,
+			Columns: []string{player.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedInventoryIDs(); len(nodes) > 0 && !pu.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.InventoryTable,
+			Columns: []string{player.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.InventoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.InventoryTable,
+			Columns: []string{player.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WalletsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.WalletsTable,
+			Columns: []string{player.WalletsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWalletsIDs(); len(nodes) > 0 && !pu.mutation.WalletsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.WalletsTable,
+			Columns: []string{player.WalletsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WalletsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.WalletsTable,
+			Columns: []string{player.WalletsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.LedgerTable,
+			Columns: []string{player.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedLedgerIDs(); len(nodes) > 0 && !pu.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.LedgerTable,
+			Columns: []string{player.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.LedgerTable,
+			Columns: []string{player.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ProposedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ProposedTradesTable,
+			Columns: []string{player.ProposedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedProposedTradesIDs(); len(nodes) > 0 && !pu.mutation.ProposedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ProposedTradesTable,
+			Columns: []string{player.ProposedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ProposedTradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ProposedTradesTable,
+			Columns: []string{player.ProposedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ReceivedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ReceivedTradesTable,
+			Columns: []string{player.ReceivedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedReceivedTradesIDs(); len(nodes) > 0 && !pu.mutation.ReceivedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ReceivedTradesTable,
+			Columns: []string{player.ReceivedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ReceivedTradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ReceivedTradesTable,
+			Columns: []string{player.ReceivedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.MarketOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.MarketOrdersTable,
+			Columns: []string{player.MarketOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedMarketOrdersIDs(); len(nodes) > 0 && !pu.mutation.MarketOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.MarketOrdersTable,
+			Columns: []string{player.MarketOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.MarketOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.MarketOrdersTable,
+			Columns: []string{player.MarketOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ItemInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ItemInstancesTable,
+			Columns: []string{player.ItemInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedItemInstancesIDs(); len(nodes) > 0 && !pu.mutation.ItemInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ItemInstancesTable,
+			Columns: []string{player.ItemInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ItemInstancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ItemInstancesTable,
+			Columns: []string{player.ItemInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{player.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PlayerUpdateOne is the builder for updating a single Player entity.
+type PlayerUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PlayerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDisplayName sets the "display_name" field.
+func (puo *PlayerUpdateOne) SetDisplayName(s string) *PlayerUpdateOne {
+	puo.mutation.SetDisplayName(s)
+	return puo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableDisplayName(s *string) *PlayerUpdateOne {
+	if s != nil {
+		puo.SetDisplayName(*s)
+	}
+	return puo
+}
+
+// SetRegion sets the "region" field.
+func (puo *PlayerUpdateOne) SetRegion(s string) *PlayerUpdateOne {
+	puo.mutation.SetRegion(s)
+	return puo
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableRegion(s *string) *PlayerUpdateOne {
+	if s != nil {
+		puo.SetRegion(*s)
+	}
+	return puo
+}
+
+// SetBanned sets the "banned" field.
+func (puo *PlayerUpdateOne) SetBanned(b bool) *PlayerUpdateOne {
+	puo.mutation.SetBanned(b)
+	return puo
+}
+
+// SetNillableBanned sets the "banned" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableBanned(b *bool) *PlayerUpdateOne {
+	if b != nil {
+		puo.SetBanned(*b)
+	}
+	return puo
+}
+
+// SetBanReason sets the "ban_reason" field.
+func (puo *PlayerUpdateOne) SetBanReason(s string) *PlayerUpdateOne {
+	puo.mutation.SetBanReason(s)
+	return puo
+}
+
+// SetNillableBanReason sets the "ban_reason" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableBanReason(s *string) *PlayerUpdateOne {
+	if s != nil {
+		puo.SetBanReason(*s)
+	}
+	return puo
+}
+
+// SetBannedUntil sets the "banned_until" field.
+func (puo *PlayerUpdateOne) SetBannedUntil(i int64) *PlayerUpdateOne {
+	puo.mutation.ResetBannedUntil()
+	puo.mutation.SetBannedUntil(i)
+	return puo
+}
+
+// SetNillableBannedUntil sets the "banned_until" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableBannedUntil(i *int64) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetBannedUntil(*i)
+	}
+	return puo
+}
+
+// AddBannedUntil adds i to the "banned_until" field.
+func (puo *PlayerUpdateOne) AddBannedUntil(i int64) *PlayerUpdateOne {
+	puo.mutation.AddBannedUntil(i)
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PlayerUpdateOne) SetUpdatedAt(i int64) *PlayerUpdateOne {
+	puo.mutation.ResetUpdatedAt()
+	puo.mutation.SetUpdatedAt(i)
+	return puo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (puo *PlayerUpdateOne) AddUpdatedAt(i int64) *PlayerUpdateOne {
+	puo.mutation.AddUpdatedAt(i)
+	return puo
+}
+
+// AddAccountIDs adds the "accounts" edge to the PlayerAccount entity by IDs.
+func (puo *PlayerUpdateOne) AddAccountIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.AddAccountIDs(ids...)
+	return puo
+}
+
+// AddAccounts adds the "accounts" edges to the PlayerAccount entity.
+func (puo *PlayerUpdateOne) AddAccounts(p ...*PlayerAccount) *PlayerUpdateOne {
+	ids := make([]int64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddAccountIDs(ids...)
+}
+
+// AddInventoryIDs adds the "inventory" edge to the InventoryItem entity by IDs.
+func (puo *PlayerUpdateOne) AddInventoryIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.AddInventoryIDs(ids...)
+	return puo
+}
+
+// AddInventory adds the "inventory" edges to the InventoryItem entity.
+func (puo *PlayerUpdateOne) AddInventory(i ...*InventoryItem) *PlayerUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return puo.AddInventoryIDs(ids...)
+}
+
+// AddWalletIDs adds the "wallets" edge to the Wallet entity by IDs.
+func (puo *PlayerUpdateOne) AddWalletIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.AddWalletIDs(ids...)
+	return puo
+}
+
+// AddWallets adds the "wallets" edges to the Wallet entity.
+func (puo *PlayerUpdateOne) AddWallets(w ...*Wallet) *PlayerUpdateOne {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.AddWalletIDs(ids...)
+}
+
+// AddLedgerIDs adds the "ledger" edge to the LedgerEntry entity by IDs.
+func (puo *PlayerUpdateOne) AddLedgerIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.AddLedgerIDs(ids...)
+	return puo
+}
+
+// AddLedger adds the "ledger" edges to the LedgerEntry entity.
+func (puo *PlayerUpdateOne) AddLedger(l ...*LedgerEntry) *PlayerUpdateOne {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return puo.AddLedgerIDs(ids...)
+}
+
+// AddProposedTradeIDs adds the "proposed_trades" edge to the Trade entity by IDs.
+func (puo *PlayerUpdateOne) AddProposedTradeIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.AddProposedTradeIDs(ids...)
+	return puo
+}
+
+// AddProposedTrades adds the "proposed_trades" edges to the Trade entity.
+func (puo *PlayerUpdateOne) AddProposedTrades(t ...*Trade) *PlayerUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddProposedTradeIDs(ids...)
+}
+
+// AddReceivedTradeIDs adds the "received_trades" edge to the Trade entity by IDs.
+func (puo *PlayerUpdateOne) AddReceivedTradeIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.AddReceivedTradeIDs(ids...)
+	return puo
+}
+
+// AddReceivedTrades adds the "received_trades" edges to the Trade entity.
+func (puo *PlayerUpdateOne) AddReceivedTrades(t ...*Trade) *PlayerUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddReceivedTradeIDs(ids...)
+}
+
+// AddMarketOrderIDs adds the "market_orders" edge to the MarketOrder entity by IDs.
+func (puo *PlayerUpdateOne) AddMarketOrderIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.AddMarketOrderIDs(ids...)
+	return puo
+}
+
+// AddMarketOrders adds the "market_orders" edges to the MarketOrder entity.
+func (puo *PlayerUpdateOne) AddMarketOrders(m ...*MarketOrder) *PlayerUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.AddMarketOrderIDs(ids...)
+}
+
+// AddItemInstanceIDs adds the "item_instances" edge to the ItemInstance entity by IDs.
+func (puo *PlayerUpdateOne) AddItemInstanceIDs(ids ...string) *PlayerUpdateOne {
+	puo.mutation.AddItemInstanceIDs(ids...)
+	return puo
+}
+
+// AddItemInstances adds the "item_instances" edges to the ItemInstance entity.
+func (puo *PlayerUpdateOne) AddItemInstances(i ...*ItemInstance) *PlayerUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return puo.AddItemInstanceIDs(ids...)
+}
+
+// Mutation returns the PlayerMutation object of the builder.
+func (puo *PlayerUpdateOne) Mutation() *PlayerMutation {
+	return puo.mutation
+}
+
+// ClearAccounts clears all "accounts" edges to the PlayerAccount entity.
+func (puo *PlayerUpdateOne) ClearAccounts() *PlayerUpdateOne {
+	puo.mutation.ClearAccounts()
+	return puo
+}
+
+// RemoveAccountIDs removes the "accounts" edge to PlayerAccount entities by IDs.
+func (puo *PlayerUpdateOne) RemoveAccountIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.RemoveAccountIDs(ids...)
+	return puo
+}
+
+// RemoveAccounts removes "accounts" edges to PlayerAccount entities.
+func (puo *PlayerUpdateOne) RemoveAccounts(p ...*PlayerAccount) *PlayerUpdateOne {
+	ids := make([]int64, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveAccountIDs(ids...)
+}
+
+// ClearInventory clears all "inventory" edges to the InventoryItem entity.
+func (puo *PlayerUpdateOne) ClearInventory() *PlayerUpdateOne {
+	puo.mutation.ClearInventory()
+	return puo
+}
+
+// RemoveInventoryIDs removes the "inventory" edge to InventoryItem entities by IDs.
+func (puo *PlayerUpdateOne) RemoveInventoryIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.RemoveInventoryIDs(ids...)
+	return puo
+}
+
+// RemoveInventory removes "inventory" edges to InventoryItem entities.
+func (puo *PlayerUpdateOne) RemoveInventory(i ...*InventoryItem) *PlayerUpdateOne {
+	ids := make([]int64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return puo.RemoveInventoryIDs(ids...)
+}
+
+// ClearWallets clears all "wallets" edges to the Wallet entity.
+func (puo *PlayerUpdateOne) ClearWallets() *PlayerUpdateOne {
+	puo.mutation.ClearWallets()
+	return puo
+}
+
+// RemoveWalletIDs removes the "wallets" edge to Wallet entities by IDs.
+func (puo *PlayerUpdateOne) RemoveWalletIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.RemoveWalletIDs(ids...)
+	return puo
+}
+
+// RemoveWallets removes "wallets" edges to Wallet entities.
+func (puo *PlayerUpdateOne) RemoveWallets(w ...*Wallet) *PlayerUpdateOne {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.RemoveWalletIDs(ids...)
+}
+
+// ClearLedger clears all "ledger" edges to the LedgerEntry entity.
+func (puo *PlayerUpdateOne) ClearLedger() *PlayerUpdateOne {
+	puo.mutation.ClearLedger()
+	return puo
+}
+
+// RemoveLedgerIDs removes the "ledger" edge to LedgerEntry entities by IDs.
+func (puo *PlayerUpdateOne) RemoveLedgerIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.RemoveLedgerIDs(ids...)
+	return puo
+}
+
+// RemoveLedger removes "ledger" edges to LedgerEntry entities.
+func (puo *PlayerUpdateOne) RemoveLedger(l ...*LedgerEntry) *PlayerUpdateOne {
+	ids := make([]int64, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return puo.RemoveLedgerIDs(ids...)
+}
+
+// ClearProposedTrades clears all "proposed_trades" edges to the Trade entity.
+func (puo *PlayerUpdateOne) ClearProposedTrades() *PlayerUpdateOne {
+	puo.mutation.ClearProposedTrades()
+	return puo
+}
+
+// RemoveProposedTradeIDs removes the "proposed_trades" edge to Trade entities by IDs.
+func (puo *PlayerUpdateOne) RemoveProposedTradeIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.RemoveProposedTradeIDs(ids...)
+	return puo
+}
+
+// RemoveProposedTrades removes "proposed_trades" edges to Trade entities.
+func (puo *PlayerUpdateOne) RemoveProposedTrades(t ...*Trade) *PlayerUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveProposedTradeIDs(ids...)
+}
+
+// ClearReceivedTrades clears all "received_trades" edges to the Trade entity.
+func (puo *PlayerUpdateOne) ClearReceivedTrades() *PlayerUpdateOne {
+	puo.mutation.ClearReceivedTrades()
+	return puo
+}
+
+// RemoveReceivedTradeIDs removes the "received_trades" edge to Trade entities by IDs.
+func (puo *PlayerUpdateOne) RemoveReceivedTradeIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.RemoveReceivedTradeIDs(ids...)
+	return puo
+}
+
+// RemoveReceivedTrades removes "received_trades" edges to Trade entities.
+func (puo *PlayerUpdateOne) RemoveReceivedTrades(t ...*Trade) *PlayerUpdateOne {
+	ids := make([]int64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveReceivedTradeIDs(ids...)
+}
+
+// ClearMarketOrders clears all "market_orders" edges to the MarketOrder entity.
+func (puo *PlayerUpdateOne) ClearMarketOrders() *PlayerUpdateOne {
+	puo.mutation.ClearMarketOrders()
+	return puo
+}
+
+// RemoveMarketOrderIDs removes the "market_orders" edge to MarketOrder entities by IDs.
+func (puo *PlayerUpdateOne) RemoveMarketOrderIDs(ids ...int64) *PlayerUpdateOne {
+	puo.mutation.RemoveMarketOrderIDs(ids...)
+	return puo
+}
+
+// RemoveMarketOrders removes "market_orders" edges to MarketOrder entities.
+func (puo *PlayerUpdateOne) RemoveMarketOrders(m ...*MarketOrder) *PlayerUpdateOne {
+	ids := make([]int64, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return puo.RemoveMarketOrderIDs(ids...)
+}
+
+// ClearItemInstances clears all "item_instances" edges to the ItemInstance entity.
+func (puo *PlayerUpdateOne) ClearItemInstances() *PlayerUpdateOne {
+	puo.mutation.ClearItemInstances()
+	return puo
+}
+
+// RemoveItemInstanceIDs removes the "item_instances" edge to ItemInstance entities by IDs.
+func (puo *PlayerUpdateOne) RemoveItemInstanceIDs(ids ...string) *PlayerUpdateOne {
+	puo.mutation.RemoveItemInstanceIDs(ids...)
+	return puo
+}
+
+// RemoveItemInstances removes "item_instances" edges to ItemInstance entities.
+func (puo *PlayerUpdateOne) RemoveItemInstances(i ...*ItemInstance) *PlayerUpdateOne {
+	ids := make([]string, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return puo.RemoveItemInstanceIDs(ids...)
+}
+
+// Where appends a list predicates to the PlayerUpdate builder.
+func (puo *PlayerUpdateOne) Where(ps ...predicate.Player) *PlayerUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PlayerUpdateOne) Select(field string, fields ...string) *PlayerUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Player entity.
+func (puo *PlayerUpdateOne) Save(ctx context.Context) (*Player, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PlayerUpdateOne) SaveX(ctx context.Context) *Player {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PlayerUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PlayerUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PlayerUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := player.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PlayerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlayerUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
+func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err error) {
+	_spec := sqlgraph.NewUpdateSpec(player.Table, player.Columns, sqlgraph.NewFieldSpec(player.FieldID, field.TypeString))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "Player.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, player.FieldID)
+		for _, f := range fields {
+			if !player.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != player.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.DisplayName(); ok {
+		_spec.SetField(player.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Region(); ok {
+		_spec.SetField(player.FieldRegion, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Banned(); ok {
+		_spec.SetField(player.FieldBanned, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.BanReason(); ok {
+		_spec.SetField(player.FieldBanReason, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.BannedUntil(); ok {
+		_spec.SetField(player.FieldBannedUntil, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedBannedUntil(); ok {
+		_spec.AddField(player.FieldBannedUntil, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(player.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(player.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if puo.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.AccountsTable,
+			Columns: []string{player.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !puo.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.AccountsTable,
+			Columns: []string{player.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.AccountsTable,
+			Columns: []string{player.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.InventoryTable,
+			Columns: []string{player.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedInventoryIDs(); len(nodes) > 0 && !puo.mutation.InventoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.InventoryTable,
+			Columns: []string{player.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.InventoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.InventoryTable,
+			Columns: []string{player.InventoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(inventoryitem.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.WalletsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.WalletsTable,
+			Columns: []string{player.WalletsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedWalletsIDs(); len(nodes) > 0 && !puo.mutation.WalletsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.WalletsTable,
+			Columns: []string{player.WalletsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.WalletsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.WalletsTable,
+			Columns: []string{player.WalletsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.LedgerTable,
+			Columns: []string{player.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedLedgerIDs(); len(nodes) > 0 && !puo.mutation.LedgerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.LedgerTable,
+			Columns: []string{player.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.LedgerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.LedgerTable,
+			Columns: []string{player.LedgerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ProposedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ProposedTradesTable,
+			Columns: []string{player.ProposedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedProposedTradesIDs(); len(nodes) > 0 && !puo.mutation.ProposedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ProposedTradesTable,
+			Columns: []string{player.ProposedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ProposedTradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ProposedTradesTable,
+			Columns: []string{player.ProposedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ReceivedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ReceivedTradesTable,
+			Columns: []string{player.ReceivedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedReceivedTradesIDs(); len(nodes) > 0 && !puo.mutation.ReceivedTradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ReceivedTradesTable,
+			Columns: []string{player.ReceivedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ReceivedTradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ReceivedTradesTable,
+			Columns: []string{player.ReceivedTradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.MarketOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.MarketOrdersTable,
+			Columns: []string{player.MarketOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedMarketOrdersIDs(); len(nodes) > 0 && !puo.mutation.MarketOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.MarketOrdersTable,
+			Columns: []string{player.MarketOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.MarketOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.MarketOrdersTable,
+			Columns: []string{player.MarketOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(marketorder.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ItemInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ItemInstancesTable,
+			Columns: []string{player.ItemInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedItemInstancesIDs(); len(nodes) > 0 && !puo.mutation.ItemInstancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ItemInstancesTable,
+			Columns: []string{player.ItemInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ItemInstancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ItemInstancesTable,
+			Columns: []string{player.ItemInstancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(iteminstance.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(puo.modifiers...)
+	_node = &Player{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{player.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+)
+
+// PlayerAccount is the model entity for the PlayerAccount schema.
+type PlayerAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID string `json:"player_id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlayerAccountQuery when eager-loading is set.
+	Edges        PlayerAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlayerAccountEdges holds the relations/edges for other nodes in the graph.
+type PlayerAccountEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayerAccountEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlayerAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playeraccount.FieldID, playeraccount.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case playeraccount.FieldPlayerID, playeraccount.FieldProvider, playeraccount.FieldSubject:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlayerAccount fields.
+func (pa *PlayerAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playeraccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pa.ID = int64(value.Int64)
+		case playeraccount.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				pa.PlayerID = value.String
+			}
+		case playeraccount.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				pa.Provider = value.String
+			}
+		case playeraccount.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				pa.Subject = value.String
+			}
+		case playeraccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Int64
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlayerAccount.
+// This includes values selected through modifiers, order, etc.
+func (pa *PlayerAccount) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the PlayerAccount entity.
+func (pa *PlayerAccount) QueryPlayer() *PlayerQuery {
+	return NewPlayerAccountClient(pa.config).QueryPlayer(pa)
+}
+
+// Update returns a builder for updating this PlayerAccount.
+// Note that you need to call PlayerAccount.Unwrap() before calling this method if this PlayerAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *PlayerAccount) Update() *PlayerAccountUpdateOne {
+	return NewPlayerAccountClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the PlayerAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *PlayerAccount) Unwrap() *PlayerAccount {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: PlayerAccount is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *PlayerAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlayerAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(pa.PlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(pa.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(pa.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", pa.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlayerAccounts is a parsable slice of PlayerAccount.
+type PlayerAccounts []*PlayerAccount
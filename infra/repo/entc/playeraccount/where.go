@@ -0,0 +1,347 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playeraccount
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLTE(FieldID, id))
+}
+
+// PlayerID applies equality check predicate on the "player_id" field. It's identical to PlayerIDEQ.
+func PlayerID(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldProvider, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldSubject, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PlayerIDEQ applies the EQ predicate on the "player_id" field.
+func PlayerIDEQ(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// PlayerIDNEQ applies the NEQ predicate on the "player_id" field.
+func PlayerIDNEQ(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNEQ(FieldPlayerID, v))
+}
+
+// PlayerIDIn applies the In predicate on the "player_id" field.
+func PlayerIDIn(vs ...string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDNotIn applies the NotIn predicate on the "player_id" field.
+func PlayerIDNotIn(vs ...string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNotIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDGT applies the GT predicate on the "player_id" field.
+func PlayerIDGT(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGT(FieldPlayerID, v))
+}
+
+// PlayerIDGTE applies the GTE predicate on the "player_id" field.
+func PlayerIDGTE(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGTE(FieldPlayerID, v))
+}
+
+// PlayerIDLT applies the LT predicate on the "player_id" field.
+func PlayerIDLT(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLT(FieldPlayerID, v))
+}
+
+// PlayerIDLTE applies the LTE predicate on the "player_id" field.
+func PlayerIDLTE(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLTE(FieldPlayerID, v))
+}
+
+// PlayerIDContains applies the Contains predicate on the "player_id" field.
+func PlayerIDContains(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldContains(FieldPlayerID, v))
+}
+
+// PlayerIDHasPrefix applies the HasPrefix predicate on the "player_id" field.
+func PlayerIDHasPrefix(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldHasPrefix(FieldPlayerID, v))
+}
+
+// PlayerIDHasSuffix applies the HasSuffix predicate on the "player_id" field.
+func PlayerIDHasSuffix(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldHasSuffix(FieldPlayerID, v))
+}
+
+// PlayerIDEqualFold applies the EqualFold predicate on the "player_id" field.
+func PlayerIDEqualFold(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEqualFold(FieldPlayerID, v))
+}
+
+// PlayerIDContainsFold applies the ContainsFold predicate on the "player_id" field.
+func PlayerIDContainsFold(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldContainsFold(FieldPlayerID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.PlayerAccount {
+	return predicate.PlayerAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.PlayerAccount {
+	return predicate.PlayerAccount(func(s *sql.Selector) {
+		step := newPlayerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlayerAccount) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlayerAccount) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlayerAccount) predicate.PlayerAccount {
+	return predicate.PlayerAccount(sql.NotPredicates(p))
+}
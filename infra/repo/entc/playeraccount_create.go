@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+)
+
+// PlayerAccountCreate is the builder for creating a PlayerAccount entity.
+type PlayerAccountCreate struct {
+	config
+	mutation *PlayerAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlayerID sets the "player_id" field.
+func (pac *PlayerAccountCreate) SetPlayerID(s string) *PlayerAccountCreate {
+	pac.mutation.SetPlayerID(s)
+	return pac
+}
+
+// SetProvider sets the "provider" field.
+func (pac *PlayerAccountCreate) SetProvider(s string) *PlayerAccountCreate {
+	pac.mutation.SetProvider(s)
+	return pac
+}
+
+// SetSubject sets the "subject" field.
+func (pac *PlayerAccountCreate) SetSubject(s string) *PlayerAccountCreate {
+	pac.mutation.SetSubject(s)
+	return pac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pac *PlayerAccountCreate) SetCreatedAt(i int64) *PlayerAccountCreate {
+	pac.mutation.SetCreatedAt(i)
+	return pac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pac *PlayerAccountCreate) SetNillableCreatedAt(i *int64) *PlayerAccountCreate {
+	if i != nil {
+		pac.SetCreatedAt(*i)
+	}
+	return pac
+}
+
+// SetID sets the "id" field.
+func (pac *PlayerAccountCreate) SetID(i int64) *PlayerAccountCreate {
+	pac.mutation.SetID(i)
+	return pac
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (pac *PlayerAccountCreate) SetPlayer(p *Player) *PlayerAccountCreate {
+	return pac.SetPlayerID(p.ID)
+}
+
+// Mutation returns the PlayerAccountMutation object of the builder.
+func (pac *PlayerAccountCreate) Mutation() *PlayerAccountMutation {
+	return pac.mutation
+}
+
+// Save creates the PlayerAccount in the database.
+func (pac *PlayerAccountCreate) Save(ctx context.Context) (*PlayerAccount, error) {
+	pac.defaults()
+	return withHooks(ctx, pac.sqlSave, pac.mutation, pac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pac *PlayerAccountCreate) SaveX(ctx context.Context) *PlayerAccount {
+	v, err := pac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pac *PlayerAccountCreate) Exec(ctx context.Context) error {
+	_, err := pac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pac *PlayerAccountCreate) ExecX(ctx context.Context) {
+	if err := pac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pac *PlayerAccountCreate) defaults() {
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		v := playeraccount.DefaultCreatedAt()
+		pac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pac *PlayerAccountCreate) check() error {
+	if _, ok := pac.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`entc: missing required field "PlayerAccount.player_id"`)}
+	}
+	if _, ok := pac.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`entc: missing required field "PlayerAccount.provider"`)}
+	}
+	if _, ok := pac.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`entc: missing required field "PlayerAccount.subject"`)}
+	}
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "PlayerAccount.created_at"`)}
+	}
+	if len(pac.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`entc: missing required edge "PlayerAccount.player"`)}
+	}
+	return nil
+}
+
+func (pac *PlayerAccountCreate) sqlSave(ctx context.Context) (*PlayerAccount, error) {
+	if err := pac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	pac.mutation.id = &_node.ID
+	pac.mutation.done = true
+	return _node, nil
+}
+
+func (pac *PlayerAccountCreate) createSpec() (*PlayerAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlayerAccount{config: pac.config}
+		_spec = sqlgraph.NewCreateSpec(playeraccount.Table, sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = pac.conflict
+	if id, ok := pac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pac.mutation.Provider(); ok {
+		_spec.SetField(playeraccount.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := pac.mutation.Subject(); ok {
+		_spec.SetField(playeraccount.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := pac.mutation.CreatedAt(); ok {
+		_spec.SetField(playeraccount.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pac.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playeraccount.PlayerTable,
+			Columns: []string{playeraccount.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlayerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlayerAccount.Create().
+//		SetPlayerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlayerAccountUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pac *PlayerAccountCreate) OnConflict(opts ...sql.ConflictOption) *PlayerAccountUpsertOne {
+	pac.conflict = opts
+	return &PlayerAccountUpsertOne{
+		create: pac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlayerAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pac *PlayerAccountCreate) OnConflictColumns(columns ...string) *PlayerAccountUpsertOne {
+	pac.conflict = append(pac.conflict, sql.ConflictColumns(columns...))
+	return &PlayerAccountUpsertOne{
+		create: pac,
+	}
+}
+
+type (
+	// PlayerAccountUpsertOne is the builder for "upsert"-ing
+	//  one PlayerAccount node.
+	PlayerAccountUpsertOne struct {
+		create *PlayerAccountCreate
+	}
+
+	// PlayerAccountUpsert is the "OnConflict" setter.
+	PlayerAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlayerID sets the "player_id" field.
+func (u *PlayerAccountUpsert) SetPlayerID(v string) *PlayerAccountUpsert {
+	u.Set(playeraccount.FieldPlayerID, v)
+	return u
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *PlayerAccountUpsert) UpdatePlayerID() *PlayerAccountUpsert {
+	u.SetExcluded(playeraccount.FieldPlayerID)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *PlayerAccountUpsert) SetProvider(v string) *PlayerAccountUpsert {
+	u.Set(playeraccount.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PlayerAccountUpsert) UpdateProvider() *PlayerAccountUpsert {
+	u.SetExcluded(playeraccount.FieldProvider)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *PlayerAccountUpsert) SetSubject(v string) *PlayerAccountUpsert {
+	u.Set(playeraccount.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *PlayerAccountUpsert) UpdateSubject() *PlayerAccountUpsert {
+	u.SetExcluded(playeraccount.FieldSubject)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PlayerAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(playeraccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlayerAccountUpsertOne) UpdateNewValues() *PlayerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(playeraccount.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(playeraccount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlayerAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlayerAccountUpsertOne) Ignore() *PlayerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlayerAccountUpsertOne) DoNothing() *PlayerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlayerAccountCreate.OnConflict
+// documentation for more info.
+func (u *PlayerAccountUpsertOne) Update(set func(*PlayerAccountUpsert)) *PlayerAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlayerAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *PlayerAccountUpsertOne) SetPlayerID(v string) *PlayerAccountUpsertOne {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *PlayerAccountUpsertOne) UpdatePlayerID() *PlayerAccountUpsertOne {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PlayerAccountUpsertOne) SetProvider(v string) *PlayerAccountUpsertOne {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PlayerAccountUpsertOne) UpdateProvider() *PlayerAccountUpsertOne {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *PlayerAccountUpsertOne) SetSubject(v string) *PlayerAccountUpsertOne {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *PlayerAccountUpsertOne) UpdateSubject() *PlayerAccountUpsertOne {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// Exec executes the query.
+func (u *PlayerAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for PlayerAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlayerAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlayerAccountUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlayerAccountUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlayerAccountCreateBulk is the builder for creating many PlayerAccount entities in bulk.
+type PlayerAccountCreateBulk struct {
+	config
+	err      error
+	builders []*PlayerAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PlayerAccount entities in the database.
+func (pacb *PlayerAccountCreateBulk) Save(ctx context.Context) ([]*PlayerAccount, error) {
+	if pacb.err != nil {
+		return nil, pacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pacb.builders))
+	nodes := make([]*PlayerAccount, len(pacb.builders))
+	mutators := make([]Mutator, len(pacb.builders))
+	for i := range pacb.builders {
+		func(i int, root context.Context) {
+			builder := pacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayerAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pacb *PlayerAccountCreateBulk) SaveX(ctx context.Context) []*PlayerAccount {
+	v, err := pacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pacb *PlayerAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := pacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pacb *PlayerAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := pacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlayerAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlayerAccountUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pacb *PlayerAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlayerAccountUpsertBulk {
+	pacb.conflict = opts
+	return &PlayerAccountUpsertBulk{
+		create: pacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlayerAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pacb *PlayerAccountCreateBulk) OnConflictColumns(columns ...string) *PlayerAccountUpsertBulk {
+	pacb.conflict = append(pacb.conflict, sql.ConflictColumns(columns...))
+	return &PlayerAccountUpsertBulk{
+		create: pacb,
+	}
+}
+
+// PlayerAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of PlayerAccount nodes.
+type PlayerAccountUpsertBulk struct {
+	create *PlayerAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PlayerAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(playeraccount.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlayerAccountUpsertBulk) UpdateNewValues() *PlayerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(playeraccount.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(playeraccount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlayerAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlayerAccountUpsertBulk) Ignore() *PlayerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlayerAccountUpsertBulk) DoNothing() *PlayerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlayerAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlayerAccountUpsertBulk) Update(set func(*PlayerAccountUpsert)) *PlayerAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlayerAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *PlayerAccountUpsertBulk) SetPlayerID(v string) *PlayerAccountUpsertBulk {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *PlayerAccountUpsertBulk) UpdatePlayerID() *PlayerAccountUpsertBulk {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *PlayerAccountUpsertBulk) SetProvider(v string) *PlayerAccountUpsertBulk {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *PlayerAccountUpsertBulk) UpdateProvider() *PlayerAccountUpsertBulk {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *PlayerAccountUpsertBulk) SetSubject(v string) *PlayerAccountUpsertBulk {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *PlayerAccountUpsertBulk) UpdateSubject() *PlayerAccountUpsertBulk {
+	return u.Update(func(s *PlayerAccountUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// Exec executes the query.
+func (u *PlayerAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the PlayerAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for PlayerAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlayerAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// PlayerAccountDelete is the builder for deleting a PlayerAccount entity.
+type PlayerAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *PlayerAccountMutation
+}
+
+// Where appends a list predicates to the PlayerAccountDelete builder.
+func (pad *PlayerAccountDelete) Where(ps ...predicate.PlayerAccount) *PlayerAccountDelete {
+	pad.mutation.Where(ps...)
+	return pad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pad *PlayerAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pad.sqlExec, pad.mutation, pad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pad *PlayerAccountDelete) ExecX(ctx context.Context) int {
+	n, err := pad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pad *PlayerAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(playeraccount.Table, sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64))
+	if ps := pad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pad.mutation.done = true
+	return affected, err
+}
+
+// PlayerAccountDeleteOne is the builder for deleting a single PlayerAccount entity.
+type PlayerAccountDeleteOne struct {
+	pad *PlayerAccountDelete
+}
+
+// Where appends a list predicates to the PlayerAccountDelete builder.
+func (pado *PlayerAccountDeleteOne) Where(ps ...predicate.PlayerAccount) *PlayerAccountDeleteOne {
+	pado.pad.mutation.Where(ps...)
+	return pado
+}
+
+// Exec executes the deletion query.
+func (pado *PlayerAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := pado.pad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{playeraccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pado *PlayerAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := pado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// PlayerAccountQuery is the builder for querying PlayerAccount entities.
+type PlayerAccountQuery struct {
+	config
+	ctx        *QueryContext
+	order      []playeraccount.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PlayerAccount
+	withPlayer *PlayerQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PlayerAccountQuery builder.
+func (paq *PlayerAccountQuery) Where(ps ...predicate.PlayerAccount) *PlayerAccountQuery {
+	paq.predicates = append(paq.predicates, ps...)
+	return paq
+}
+
+// Limit the number of records to be returned by this query.
+func (paq *PlayerAccountQuery) Limit(limit int) *PlayerAccountQuery {
+	paq.ctx.Limit = &limit
+	return paq
+}
+
+// Offset to start from.
+func (paq *PlayerAccountQuery) Offset(offset int) *PlayerAccountQuery {
+	paq.ctx.Offset = &offset
+	return paq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (paq *PlayerAccountQuery) Unique(unique bool) *PlayerAccountQuery {
+	paq.ctx.Unique = &unique
+	return paq
+}
+
+// Order specifies how the records should be ordered.
+func (paq *PlayerAccountQuery) Order(o ...playeraccount.OrderOption) *PlayerAccountQuery {
+	paq.order = append(paq.order, o...)
+	return paq
+}
+
+// QueryPlayer chains the current query on the "player" edge.
+func (paq *PlayerAccountQuery) QueryPlayer() *PlayerQuery {
+	query := (&PlayerClient{config: paq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := paq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := paq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playeraccount.Table, playeraccount.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playeraccount.PlayerTable, playeraccount.PlayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(paq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PlayerAccount entity from the query.
+// Returns a *NotFoundError when no PlayerAccount was found.
+func (paq *PlayerAccountQuery) First(ctx context.Context) (*PlayerAccount, error) {
+	nodes, err := paq.Limit(1).All(setContextOp(ctx, paq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{playeraccount.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (paq *PlayerAccountQuery) FirstX(ctx context.Context) *PlayerAccount {
+	node, err := paq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PlayerAccount ID from the query.
+// Returns a *NotFoundError when no PlayerAccount ID was found.
+func (paq *PlayerAccountQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = paq.Limit(1).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{playeraccount.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (paq *PlayerAccountQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := paq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PlayerAccount entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PlayerAccount entity is found.
+// Returns a *NotFoundError when no PlayerAccount entities are found.
+func (paq *PlayerAccountQuery) Only(ctx context.Context) (*PlayerAccount, error) {
+	nodes, err := paq.Limit(2).All(setContextOp(ctx, paq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{playeraccount.Label}
+	default:
+		return nil, &NotSingularError{playeraccount.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (paq *PlayerAccountQuery) OnlyX(ctx context.Context) *PlayerAccount {
+	node, err := paq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PlayerAccount ID in the query.
+// Returns a *NotSingularError when more than one PlayerAccount ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (paq *PlayerAccountQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = paq.Limit(2).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{playeraccount.Label}
+	default:
+		err = &NotSingularError{playeraccount.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (paq *PlayerAccountQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := paq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PlayerAccounts.
+func (paq *PlayerAccountQuery) All(ctx context.Context) ([]*PlayerAccount, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryAll)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PlayerAccount, *PlayerAccountQuery]()
+	return withInterceptors[[]*PlayerAccount](ctx, paq, qr, paq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (paq *PlayerAccountQuery) AllX(ctx context.Context) []*PlayerAccount {
+	nodes, err := paq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PlayerAccount IDs.
+func (paq *PlayerAccountQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if paq.ctx.Unique == nil && paq.path != nil {
+		paq.Unique(true)
+	}
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryIDs)
+	if err = paq.Select(playeraccount.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (paq *PlayerAccountQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := paq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (paq *PlayerAccountQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryCount)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, paq, querierCount[*PlayerAccountQuery](), paq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (paq *PlayerAccountQuery) CountX(ctx context.Context) int {
+	count, err := paq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (paq *PlayerAccountQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryExist)
+	switch _, err := paq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (paq *PlayerAccountQuery) ExistX(ctx context.Context) bool {
+	exist, err := paq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PlayerAccountQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (paq *PlayerAccountQuery) Clone() *PlayerAccountQuery {
+	if paq == nil {
+		return nil
+	}
+	return &PlayerAccountQuery{
+		config:     paq.config,
+		ctx:        paq.ctx.Clone(),
+		order:      append([]playeraccount.OrderOption{}, paq.order...),
+		inters:     append([]Interceptor{}, paq.inters...),
+		predicates: append([]predicate.PlayerAccount{}, paq.predicates...),
+		withPlayer: paq.withPlayer.Clone(),
+		// clone intermediate query.
+		sql:       paq.sql.Clone(),
+		path:      paq.path,
+		modifiers: append([]func(*sql.Selector){}, paq.modifiers...),
+	}
+}
+
+// WithPlayer tells the query-builder to eager-load the nodes that are connected to
+// the "player" edge. The optional arguments are used to configure the query builder of the edge.
+func (paq *PlayerAccountQuery) WithPlayer(opts ...func(*PlayerQuery)) *PlayerAccountQuery {
+	query := (&PlayerClient{config: paq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	paq.withPlayer = query
+	return paq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PlayerAccount.Query().
+//		GroupBy(playeraccount.FieldPlayerID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (paq *PlayerAccountQuery) GroupBy(field string, fields ...string) *PlayerAccountGroupBy {
+	paq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PlayerAccountGroupBy{build: paq}
+	grbuild.flds = &paq.ctx.Fields
+	grbuild.label = playeraccount.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//	}
+//
+//	client.PlayerAccount.Query().
+//		Select(playeraccount.FieldPlayerID).
+//		Scan(ctx, &v)
+func (paq *PlayerAccountQuery) Select(fields ...string) *PlayerAccountSelect {
+	paq.ctx.Fields = append(paq.ctx.Fields, fields...)
+	sbuild := &PlayerAccountSelect{PlayerAccountQuery: paq}
+	sbuild.label = playeraccount.Label
+	sbuild.flds, sbuild.scan = &paq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PlayerAccountSelect configured with the given aggregations.
+func (paq *PlayerAccountQuery) Aggregate(fns ...AggregateFunc) *PlayerAccountSelect {
+	return paq.Select().Aggregate(fns...)
+}
+
+func (paq *PlayerAccountQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range paq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, paq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range paq.ctx.Fields {
+		if !playeraccount.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if paq.path != nil {
+		prev, err := paq.path(ctx)
+		if err != nil {
+			return err
+		}
+		paq.sql = prev
+	}
+	return nil
+}
+
+func (paq *PlayerAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PlayerAccount, error) {
+	var (
+		nodes       = []*PlayerAccount{}
+		_spec       = paq.querySpec()
+		loadedTypes = [1]bool{
+			paq.withPlayer != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PlayerAccount).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PlayerAccount{config: paq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(paq.modifiers) > 0 {
+		_spec.Modifiers = paq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, paq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := paq.withPlayer; query != nil {
+		if err := paq.loadPlayer(ctx, query, nodes, nil,
+			func(n *PlayerAccount, e *Player) { n.Edges.Player = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (paq *PlayerAccountQuery) loadPlayer(ctx context.Context, query *PlayerQuery, nodes []*PlayerAccount, init func(*PlayerAccount), assign func(*PlayerAccount, *Player)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*PlayerAccount)
+	for i := range nodes {
+		fk := nodes[i].PlayerID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(player.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "player_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (paq *PlayerAccountQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := paq.querySpec()
+	if len(paq.modifiers) > 0 {
+		_spec.Modifiers = paq.modifiers
+	}
+	_spec.Node.Columns = paq.ctx.Fields
+	if len(paq.ctx.Fields) > 0 {
+		_spec.Unique = paq.ctx.Unique != nil && *paq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, paq.driver, _spec)
+}
+
+func (paq *PlayerAccountQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(playeraccount.Table, playeraccount.Columns, sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64))
+	_spec.From = paq.sql
+	if unique := paq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if paq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := paq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playeraccount.FieldID)
+		for i := range fields {
+			if fields[i] != playeraccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if paq.withPlayer != nil {
+			_spec.Node.AddColumnOnce(playeraccount.FieldPlayerID)
+		}
+	}
+	if ps := paq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := paq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (paq *PlayerAccountQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(paq.driver.Dialect())
+	t1 := builder.Table(playeraccount.Table)
+	columns := paq.ctx.Fields
+	if len(columns) == 0 {
+		columns = playeraccount.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if paq.sql != nil {
+		selector = paq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if paq.ctx.Unique != nil && *paq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range paq.modifiers {
+		m(selector)
+	}
+	for _, p := range paq.predicates {
+		p(selector)
+	}
+	for _, p := range paq.order {
+		p(selector)
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (paq *PlayerAccountQuery) Modify(modifiers ...func(s *sql.Selector)) *PlayerAccountSelect {
+	paq.modifiers = append(paq.modifiers, modifiers...)
+	return paq.Select()
+}
+
+// PlayerAccountGroupBy is the group-by builder for PlayerAccount entities.
+type PlayerAccountGroupBy struct {
+	selector
+	build *PlayerAccountQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pagb *PlayerAccountGroupBy) Aggregate(fns ...AggregateFunc) *PlayerAccountGroupBy {
+	pagb.fns = append(pagb.fns, fns...)
+	return pagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pagb *PlayerAccountGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pagb.build.ctx, ent.OpQueryGroupBy)
+	if err := pagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlayerAccountQuery, *PlayerAccountGroupBy](ctx, pagb.build, pagb, pagb.build.inters, v)
+}
+
+func (pagb *PlayerAccountGroupBy) sqlScan(ctx context.Context, root *PlayerAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pagb.fns))
+	for _, fn := range pagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pagb.flds)+len(pagb.fns))
+		for _, f := range *pagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PlayerAccountSelect is the builder for selecting fields of PlayerAccount entities.
+type PlayerAccountSelect struct {
+	*PlayerAccountQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pas *PlayerAccountSelect) Aggregate(fns ...AggregateFunc) *PlayerAccountSelect {
+	pas.fns = append(pas.fns, fns...)
+	return pas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pas *PlayerAccountSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pas.ctx, ent.OpQuerySelect)
+	if err := pas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlayerAccountQuery, *PlayerAccountSelect](ctx, pas.PlayerAccountQuery, pas, pas.inters, v)
+}
+
+func (pas *PlayerAccountSelect) sqlScan(ctx context.Context, root *PlayerAccountQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pas.fns))
+	for _, fn := range pas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pas *PlayerAccountSelect) Modify(modifiers ...func(s *sql.Selector)) *PlayerAccountSelect {
+	pas.modifiers = append(pas.modifiers, modifiers...)
+	return pas
+}
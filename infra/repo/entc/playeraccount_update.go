@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// PlayerAccountUpdate is the builder for updating PlayerAccount entities.
+type PlayerAccountUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PlayerAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PlayerAccountUpdate builder.
+func (pau *PlayerAccountUpdate) Where(ps ...predicate.PlayerAccount) *PlayerAccountUpdate {
+	pau.mutation.Where(ps...)
+	return pau
+}
+
+// SetPlayerID sets the "player_id" field.
+func (pau *PlayerAccountUpdate) SetPlayerID(s string) *PlayerAccountUpdate {
+	pau.mutation.SetPlayerID(s)
+	return pau
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (pau *PlayerAccountUpdate) SetNillablePlayerID(s *string) *PlayerAccountUpdate {
+	if s != nil {
+		pau.SetPlayerID(*s)
+	}
+	return pau
+}
+
+// SetProvider sets the "provider" field.
+func (pau *PlayerAccountUpdate) SetProvider(s string) *PlayerAccountUpdate {
+	pau.mutation.SetProvider(s)
+	return pau
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pau *PlayerAccountUpdate) SetNillableProvider(s *string) *PlayerAccountUpdate {
+	if s != nil {
+		pau.SetProvider(*s)
+	}
+	return pau
+}
+
+// SetSubject sets the "subject" field.
+func (pau *PlayerAccountUpdate) SetSubject(s string) *PlayerAccountUpdate {
+	pau.mutation.SetSubject(s)
+	return pau
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (pau *PlayerAccountUpdate) SetNillableSubject(s *string) *PlayerAccountUpdate {
+	if s != nil {
+		pau.SetSubject(*s)
+	}
+	return pau
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (pau *PlayerAccountUpdate) SetPlayer(p *Player) *PlayerAccountUpdate {
+	return pau.SetPlayerID(p.ID)
+}
+
+// Mutation returns the PlayerAccountMutation object of the builder.
+func (pau *PlayerAccountUpdate) Mutation() *PlayerAccountMutation {
+	return pau.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (pau *PlayerAccountUpdate) ClearPlayer() *PlayerAccountUpdate {
+	pau.mutation.ClearPlayer()
+	return pau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pau *PlayerAccountUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pau.sqlSave, pau.mutation, pau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pau *PlayerAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := pau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pau *PlayerAccountUpdate) Exec(ctx context.Context) error {
+	_, err := pau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pau *PlayerAccountUpdate) ExecX(ctx context.Context) {
+	if err := pau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pau *PlayerAccountUpdate) check() error {
+	if pau.mutation.PlayerCleared() && len(pau.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "PlayerAccount.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pau *PlayerAccountUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlayerAccountUpdate {
+	pau.modifiers = append(pau.modifiers, modifiers...)
+	return pau
+}
+
+func (pau *PlayerAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playeraccount.Table, playeraccount.Columns, sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64))
+	if ps := pau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pau.mutation.Provider(); ok {
+		_spec.SetField(playeraccount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.Subject(); ok {
+		_spec.SetField(playeraccount.FieldSubject, field.TypeString, value)
+	}
+	if pau.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playeraccount.PlayerTable,
+			Columns: []string{playeraccount.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pau.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playeraccount.PlayerTable,
+			Columns: []string{playeraccount.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pau.mutation.done = true
+	return n, nil
+}
+
+// PlayerAccountUpdateOne is the builder for updating a single PlayerAccount entity.
+type PlayerAccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PlayerAccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPlayerID sets the "player_id" field.
+func (pauo *PlayerAccountUpdateOne) SetPlayerID(s string) *PlayerAccountUpdateOne {
+	pauo.mutation.SetPlayerID(s)
+	return pauo
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (pauo *PlayerAccountUpdateOne) SetNillablePlayerID(s *string) *PlayerAccountUpdateOne {
+	if s != nil {
+		pauo.SetPlayerID(*s)
+	}
+	return pauo
+}
+
+// SetProvider sets the "provider" field.
+func (pauo *PlayerAccountUpdateOne) SetProvider(s string) *PlayerAccountUpdateOne {
+	pauo.mutation.SetProvider(s)
+	return pauo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pauo *PlayerAccountUpdateOne) SetNillableProvider(s *string) *PlayerAccountUpdateOne {
+	if s != nil {
+		pauo.SetProvider(*s)
+	}
+	return pauo
+}
+
+// SetSubject sets the "subject" field.
+func (pauo *PlayerAccountUpdateOne) SetSubject(s string) *PlayerAccountUpdateOne {
+	pauo.mutation.SetSubject(s)
+	return pauo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (pauo *PlayerAccountUpdateOne) SetNillableSubject(s *string) *PlayerAccountUpdateOne {
+	if s != nil {
+		pauo.SetSubject(*s)
+	}
+	return pauo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (pauo *PlayerAccountUpdateOne) SetPlayer(p *Player) *PlayerAccountUpdateOne {
+	return pauo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the PlayerAccountMutation object of the builder.
+func (pauo *PlayerAccountUpdateOne) Mutation() *PlayerAccountMutation {
+	return pauo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (pauo *PlayerAccountUpdateOne) ClearPlayer() *PlayerAccountUpdateOne {
+	pauo.mutation.ClearPlayer()
+	return pauo
+}
+
+// Where appends a list predicates to the PlayerAccountUpdate builder.
+func (pauo *PlayerAccountUpdateOne) Where(ps ...predicate.PlayerAccount) *PlayerAccountUpdateOne {
+	pauo.mutation.Where(ps...)
+	return pauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pauo *PlayerAccountUpdateOne) Select(field string, fields ...string) *PlayerAccountUpdateOne {
+	pauo.fields = append([]string{field}, fields...)
+	return pauo
+}
+
+// Save executes the query and returns the updated PlayerAccount entity.
+func (pauo *PlayerAccountUpdateOne) Save(ctx context.Context) (*PlayerAccount, error) {
+	return withHooks(ctx, pauo.sqlSave, pauo.mutation, pauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pauo *PlayerAccountUpdateOne) SaveX(ctx context.Context) *PlayerAccount {
+	node, err := pauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pauo *PlayerAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := pauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pauo *PlayerAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := pauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pauo *PlayerAccountUpdateOne) check() error {
+	if pauo.mutation.PlayerCleared() && len(pauo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "PlayerAccount.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pauo *PlayerAccountUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlayerAccountUpdateOne {
+	pauo.modifiers = append(pauo.modifiers, modifiers...)
+	return pauo
+}
+
+func (pauo *PlayerAccountUpdateOne) sqlSave(ctx context.Context) (_node *PlayerAccount, err error) {
+	if err := pauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playeraccount.Table, playeraccount.Columns, sqlgraph.NewFieldSpec(playeraccount.FieldID, field.TypeInt64))
+	id, ok := pauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "PlayerAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playeraccount.FieldID)
+		for _, f := range fields {
+			if !playeraccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != playeraccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pauo.mutation.Provider(); ok {
+		_spec.SetField(playeraccount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.Subject(); ok {
+		_spec.SetField(playeraccount.FieldSubject, field.TypeString, value)
+	}
+	if pauo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playeraccount.PlayerTable,
+			Columns: []string{playeraccount.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pauo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playeraccount.PlayerTable,
+			Columns: []string{playeraccount.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pauo.modifiers...)
+	_node = &PlayerAccount{config: pauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+)
+
+// PlayerEvent is the model entity for the PlayerEvent schema.
+type PlayerEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID string `json:"player_id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID string `json:"event_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlayerEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playerevent.FieldID, playerevent.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case playerevent.FieldPlayerID, playerevent.FieldEventID, playerevent.FieldType:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlayerEvent fields.
+func (pe *PlayerEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playerevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pe.ID = int64(value.Int64)
+		case playerevent.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				pe.PlayerID = value.String
+			}
+		case playerevent.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				pe.EventID = value.String
+			}
+		case playerevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pe.Type = value.String
+			}
+		case playerevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pe.CreatedAt = value.Int64
+			}
+		default:
+			pe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlayerEvent.
+// This includes values selected through modifiers, order, etc.
+func (pe *PlayerEvent) Value(name string) (ent.Value, error) {
+	return pe.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PlayerEvent.
+// Note that you need to call PlayerEvent.Unwrap() before calling this method if this PlayerEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pe *PlayerEvent) Update() *PlayerEventUpdateOne {
+	return NewPlayerEventClient(pe.config).UpdateOne(pe)
+}
+
+// Unwrap unwraps the PlayerEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pe *PlayerEvent) Unwrap() *PlayerEvent {
+	_tx, ok := pe.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: PlayerEvent is not a transactional entity")
+	}
+	pe.config.driver = _tx.drv
+	return pe
+}
+
+// String implements the fmt.Stringer.
+func (pe *PlayerEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlayerEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pe.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(pe.PlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(pe.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(pe.Type)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", pe.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlayerEvents is a parsable slice of PlayerEvent.
+type PlayerEvents []*PlayerEvent
@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package playerevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLTE(FieldID, id))
+}
+
+// PlayerID applies equality check predicate on the "player_id" field. It's identical to PlayerIDEQ.
+func PlayerID(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldEventID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldType, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PlayerIDEQ applies the EQ predicate on the "player_id" field.
+func PlayerIDEQ(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// PlayerIDNEQ applies the NEQ predicate on the "player_id" field.
+func PlayerIDNEQ(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNEQ(FieldPlayerID, v))
+}
+
+// PlayerIDIn applies the In predicate on the "player_id" field.
+func PlayerIDIn(vs ...string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDNotIn applies the NotIn predicate on the "player_id" field.
+func PlayerIDNotIn(vs ...string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNotIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDGT applies the GT predicate on the "player_id" field.
+func PlayerIDGT(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGT(FieldPlayerID, v))
+}
+
+// PlayerIDGTE applies the GTE predicate on the "player_id" field.
+func PlayerIDGTE(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGTE(FieldPlayerID, v))
+}
+
+// PlayerIDLT applies the LT predicate on the "player_id" field.
+func PlayerIDLT(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLT(FieldPlayerID, v))
+}
+
+// PlayerIDLTE applies the LTE predicate on the "player_id" field.
+func PlayerIDLTE(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLTE(FieldPlayerID, v))
+}
+
+// PlayerIDContains applies the Contains predicate on the "player_id" field.
+func PlayerIDContains(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldContains(FieldPlayerID, v))
+}
+
+// PlayerIDHasPrefix applies the HasPrefix predicate on the "player_id" field.
+func PlayerIDHasPrefix(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldHasPrefix(FieldPlayerID, v))
+}
+
+// PlayerIDHasSuffix applies the HasSuffix predicate on the "player_id" field.
+func PlayerIDHasSuffix(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldHasSuffix(FieldPlayerID, v))
+}
+
+// PlayerIDEqualFold applies the EqualFold predicate on the "player_id" field.
+func PlayerIDEqualFold(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEqualFold(FieldPlayerID, v))
+}
+
+// PlayerIDContainsFold applies the ContainsFold predicate on the "player_id" field.
+func PlayerIDContainsFold(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldContainsFold(FieldPlayerID, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldContainsFold(FieldType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PlayerEvent) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PlayerEvent) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PlayerEvent) predicate.PlayerEvent {
+	return predicate.PlayerEvent(sql.NotPredicates(p))
+}
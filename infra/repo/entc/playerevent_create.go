@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+)
+
+// PlayerEventCreate is the builder for creating a PlayerEvent entity.
+type PlayerEventCreate struct {
+	config
+	mutation *PlayerEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlayerID sets the "player_id" field.
+func (pec *PlayerEventCreate) SetPlayerID(s string) *PlayerEventCreate {
+	pec.mutation.SetPlayerID(s)
+	return pec
+}
+
+// SetEventID sets the "event_id" field.
+func (pec *PlayerEventCreate) SetEventID(s string) *PlayerEventCreate {
+	pec.mutation.SetEventID(s)
+	return pec
+}
+
+// SetType sets the "type" field.
+func (pec *PlayerEventCreate) SetType(s string) *PlayerEventCreate {
+	pec.mutation.SetType(s)
+	return pec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pec *PlayerEventCreate) SetCreatedAt(i int64) *PlayerEventCreate {
+	pec.mutation.SetCreatedAt(i)
+	return pec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pec *PlayerEventCreate) SetNillableCreatedAt(i *int64) *PlayerEventCreate {
+	if i != nil {
+		pec.SetCreatedAt(*i)
+	}
+	return pec
+}
+
+// SetID sets the "id" field.
+func (pec *PlayerEventCreate) SetID(i int64) *PlayerEventCreate {
+	pec.mutation.SetID(i)
+	return pec
+}
+
+// Mutation returns the PlayerEventMutation object of the builder.
+func (pec *PlayerEventCreate) Mutation() *PlayerEventMutation {
+	return pec.mutation
+}
+
+// Save creates the PlayerEvent in the database.
+func (pec *PlayerEventCreate) Save(ctx context.Context) (*PlayerEvent, error) {
+	pec.defaults()
+	return withHooks(ctx, pec.sqlSave, pec.mutation, pec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pec *PlayerEventCreate) SaveX(ctx context.Context) *PlayerEvent {
+	v, err := pec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pec *PlayerEventCreate) Exec(ctx context.Context) error {
+	_, err := pec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pec *PlayerEventCreate) ExecX(ctx context.Context) {
+	if err := pec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pec *PlayerEventCreate) defaults() {
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		v := playerevent.DefaultCreatedAt()
+		pec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pec *PlayerEventCreate) check() error {
+	if _, ok := pec.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`entc: missing required field "PlayerEvent.player_id"`)}
+	}
+	if _, ok := pec.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`entc: missing required field "PlayerEvent.event_id"`)}
+	}
+	if _, ok := pec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`entc: missing required field "PlayerEvent.type"`)}
+	}
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "PlayerEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (pec *PlayerEventCreate) sqlSave(ctx context.Context) (*PlayerEvent, error) {
+	if err := pec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	pec.mutation.id = &_node.ID
+	pec.mutation.done = true
+	return _node, nil
+}
+
+func (pec *PlayerEventCreate) createSpec() (*PlayerEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlayerEvent{config: pec.config}
+		_spec = sqlgraph.NewCreateSpec(playerevent.Table, sqlgraph.NewFieldSpec(playerevent.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = pec.conflict
+	if id, ok := pec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pec.mutation.PlayerID(); ok {
+		_spec.SetField(playerevent.FieldPlayerID, field.TypeString, value)
+		_node.PlayerID = value
+	}
+	if value, ok := pec.mutation.EventID(); ok {
+		_spec.SetField(playerevent.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := pec.mutation.GetType(); ok {
+		_spec.SetField(playerevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := pec.mutation.CreatedAt(); ok {
+		_spec.SetField(playerevent.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlayerEvent.Create().
+//		SetPlayerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlayerEventUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pec *PlayerEventCreate) OnConflict(opts ...sql.ConflictOption) *PlayerEventUpsertOne {
+	pec.conflict = opts
+	return &PlayerEventUpsertOne{
+		create: pec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlayerEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pec *PlayerEventCreate) OnConflictColumns(columns ...string) *PlayerEventUpsertOne {
+	pec.conflict = append(pec.conflict, sql.ConflictColumns(columns...))
+	return &PlayerEventUpsertOne{
+		create: pec,
+	}
+}
+
+type (
+	// PlayerEventUpsertOne is the builder for "upsert"-ing
+	//  one PlayerEvent node.
+	PlayerEventUpsertOne struct {
+		create *PlayerEventCreate
+	}
+
+	// PlayerEventUpsert is the "OnConflict" setter.
+	PlayerEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlayerID sets the "player_id" field.
+func (u *PlayerEventUpsert) SetPlayerID(v string) *PlayerEventUpsert {
+	u.Set(playerevent.FieldPlayerID, v)
+	return u
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *PlayerEventUpsert) UpdatePlayerID() *PlayerEventUpsert {
+	u.SetExcluded(playerevent.FieldPlayerID)
+	return u
+}
+
+// SetEventID sets the "event_id" field.
+func (u *PlayerEventUpsert) SetEventID(v string) *PlayerEventUpsert {
+	u.Set(playerevent.FieldEventID, v)
+	return u
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *PlayerEventUpsert) UpdateEventID() *PlayerEventUpsert {
+	u.SetExcluded(playerevent.FieldEventID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *PlayerEventUpsert) SetType(v string) *PlayerEventUpsert {
+	u.Set(playerevent.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PlayerEventUpsert) UpdateType() *PlayerEventUpsert {
+	u.SetExcluded(playerevent.FieldType)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PlayerEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(playerevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlayerEventUpsertOne) UpdateNewValues() *PlayerEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(playerevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(playerevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlayerEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PlayerEventUpsertOne) Ignore() *PlayerEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlayerEventUpsertOne) DoNothing() *PlayerEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlayerEventCreate.OnConflict
+// documentation for more info.
+func (u *PlayerEventUpsertOne) Update(set func(*PlayerEventUpsert)) *PlayerEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlayerEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *PlayerEventUpsertOne) SetPlayerID(v string) *PlayerEventUpsertOne {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *PlayerEventUpsertOne) UpdatePlayerID() *PlayerEventUpsertOne {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *PlayerEventUpsertOne) SetEventID(v string) *PlayerEventUpsertOne {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *PlayerEventUpsertOne) UpdateEventID() *PlayerEventUpsertOne {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PlayerEventUpsertOne) SetType(v string) *PlayerEventUpsertOne {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PlayerEventUpsertOne) UpdateType() *PlayerEventUpsertOne {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// Exec executes the query.
+func (u *PlayerEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for PlayerEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlayerEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PlayerEventUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PlayerEventUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PlayerEventCreateBulk is the builder for creating many PlayerEvent entities in bulk.
+type PlayerEventCreateBulk struct {
+	config
+	err      error
+	builders []*PlayerEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PlayerEvent entities in the database.
+func (pecb *PlayerEventCreateBulk) Save(ctx context.Context) ([]*PlayerEvent, error) {
+	if pecb.err != nil {
+		return nil, pecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pecb.builders))
+	nodes := make([]*PlayerEvent, len(pecb.builders))
+	mutators := make([]Mutator, len(pecb.builders))
+	for i := range pecb.builders {
+		func(i int, root context.Context) {
+			builder := pecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlayerEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pecb *PlayerEventCreateBulk) SaveX(ctx context.Context) []*PlayerEvent {
+	v, err := pecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pecb *PlayerEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := pecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pecb *PlayerEventCreateBulk) ExecX(ctx context.Context) {
+	if err := pecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PlayerEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PlayerEventUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pecb *PlayerEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *PlayerEventUpsertBulk {
+	pecb.conflict = opts
+	return &PlayerEventUpsertBulk{
+		create: pecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PlayerEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pecb *PlayerEventCreateBulk) OnConflictColumns(columns ...string) *PlayerEventUpsertBulk {
+	pecb.conflict = append(pecb.conflict, sql.ConflictColumns(columns...))
+	return &PlayerEventUpsertBulk{
+		create: pecb,
+	}
+}
+
+// PlayerEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of PlayerEvent nodes.
+type PlayerEventUpsertBulk struct {
+	create *PlayerEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PlayerEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(playerevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PlayerEventUpsertBulk) UpdateNewValues() *PlayerEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(playerevent.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(playerevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PlayerEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PlayerEventUpsertBulk) Ignore() *PlayerEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PlayerEventUpsertBulk) DoNothing() *PlayerEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PlayerEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *PlayerEventUpsertBulk) Update(set func(*PlayerEventUpsert)) *PlayerEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PlayerEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *PlayerEventUpsertBulk) SetPlayerID(v string) *PlayerEventUpsertBulk {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *PlayerEventUpsertBulk) UpdatePlayerID() *PlayerEventUpsertBulk {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetEventID sets the "event_id" field.
+func (u *PlayerEventUpsertBulk) SetEventID(v string) *PlayerEventUpsertBulk {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *PlayerEventUpsertBulk) UpdateEventID() *PlayerEventUpsertBulk {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PlayerEventUpsertBulk) SetType(v string) *PlayerEventUpsertBulk {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PlayerEventUpsertBulk) UpdateType() *PlayerEventUpsertBulk {
+	return u.Update(func(s *PlayerEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// Exec executes the query.
+func (u *PlayerEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the PlayerEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for PlayerEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PlayerEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
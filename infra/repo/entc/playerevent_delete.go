@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// PlayerEventDelete is the builder for deleting a PlayerEvent entity.
+type PlayerEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *PlayerEventMutation
+}
+
+// Where appends a list predicates to the PlayerEventDelete builder.
+func (ped *PlayerEventDelete) Where(ps ...predicate.PlayerEvent) *PlayerEventDelete {
+	ped.mutation.Where(ps...)
+	return ped
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ped *PlayerEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ped.sqlExec, ped.mutation, ped.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ped *PlayerEventDelete) ExecX(ctx context.Context) int {
+	n, err := ped.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ped *PlayerEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(playerevent.Table, sqlgraph.NewFieldSpec(playerevent.FieldID, field.TypeInt64))
+	if ps := ped.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ped.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ped.mutation.done = true
+	return affected, err
+}
+
+// PlayerEventDeleteOne is the builder for deleting a single PlayerEvent entity.
+type PlayerEventDeleteOne struct {
+	ped *PlayerEventDelete
+}
+
+// Where appends a list predicates to the PlayerEventDelete builder.
+func (pedo *PlayerEventDeleteOne) Where(ps ...predicate.PlayerEvent) *PlayerEventDeleteOne {
+	pedo.ped.mutation.Where(ps...)
+	return pedo
+}
+
+// Exec executes the deletion query.
+func (pedo *PlayerEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := pedo.ped.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{playerevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pedo *PlayerEventDeleteOne) ExecX(ctx context.Context) {
+	if err := pedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
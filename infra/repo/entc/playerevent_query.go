@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// PlayerEventQuery is the builder for querying PlayerEvent entities.
+type PlayerEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []playerevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PlayerEvent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PlayerEventQuery builder.
+func (peq *PlayerEventQuery) Where(ps ...predicate.PlayerEvent) *PlayerEventQuery {
+	peq.predicates = append(peq.predicates, ps...)
+	return peq
+}
+
+// Limit the number of records to be returned by this query.
+func (peq *PlayerEventQuery) Limit(limit int) *PlayerEventQuery {
+	peq.ctx.Limit = &limit
+	return peq
+}
+
+// Offset to start from.
+func (peq *PlayerEventQuery) Offset(offset int) *PlayerEventQuery {
+	peq.ctx.Offset = &offset
+	return peq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (peq *PlayerEventQuery) Unique(unique bool) *PlayerEventQuery {
+	peq.ctx.Unique = &unique
+	return peq
+}
+
+// Order specifies how the records should be ordered.
+func (peq *PlayerEventQuery) Order(o ...playerevent.OrderOption) *PlayerEventQuery {
+	peq.order = append(peq.order, o...)
+	return peq
+}
+
+// First returns the first PlayerEvent entity from the query.
+// Returns a *NotFoundError when no PlayerEvent was found.
+func (peq *PlayerEventQuery) First(ctx context.Context) (*PlayerEvent, error) {
+	nodes, err := peq.Limit(1).All(setContextOp(ctx, peq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{playerevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (peq *PlayerEventQuery) FirstX(ctx context.Context) *PlayerEvent {
+	node, err := peq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PlayerEvent ID from the query.
+// Returns a *NotFoundError when no PlayerEvent ID was found.
+func (peq *PlayerEventQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = peq.Limit(1).IDs(setContextOp(ctx, peq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{playerevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (peq *PlayerEventQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := peq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PlayerEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PlayerEvent entity is found.
+// Returns a *NotFoundError when no PlayerEvent entities are found.
+func (peq *PlayerEventQuery) Only(ctx context.Context) (*PlayerEvent, error) {
+	nodes, err := peq.Limit(2).All(setContextOp(ctx, peq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{playerevent.Label}
+	default:
+		return nil, &NotSingularError{playerevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (peq *PlayerEventQuery) OnlyX(ctx context.Context) *PlayerEvent {
+	node, err := peq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PlayerEvent ID in the query.
+// Returns a *NotSingularError when more than one PlayerEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (peq *PlayerEventQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = peq.Limit(2).IDs(setContextOp(ctx, peq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{playerevent.Label}
+	default:
+		err = &NotSingularError{playerevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (peq *PlayerEventQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := peq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PlayerEvents.
+func (peq *PlayerEventQuery) All(ctx context.Context) ([]*PlayerEvent, error) {
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryAll)
+	if err := peq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PlayerEvent, *PlayerEventQuery]()
+	return withInterceptors[[]*PlayerEvent](ctx, peq, qr, peq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (peq *PlayerEventQuery) AllX(ctx context.Context) []*PlayerEvent {
+	nodes, err := peq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PlayerEvent IDs.
+func (peq *PlayerEventQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if peq.ctx.Unique == nil && peq.path != nil {
+		peq.Unique(true)
+	}
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryIDs)
+	if err = peq.Select(playerevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (peq *PlayerEventQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := peq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (peq *PlayerEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryCount)
+	if err := peq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, peq, querierCount[*PlayerEventQuery](), peq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (peq *PlayerEventQuery) CountX(ctx context.Context) int {
+	count, err := peq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (peq *PlayerEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, peq.ctx, ent.OpQueryExist)
+	switch _, err := peq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (peq *PlayerEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := peq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PlayerEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (peq *PlayerEventQuery) Clone() *PlayerEventQuery {
+	if peq == nil {
+		return nil
+	}
+	return &PlayerEventQuery{
+		config:     peq.config,
+		ctx:        peq.ctx.Clone(),
+		order:      append([]playerevent.OrderOption{}, peq.order...),
+		inters:     append([]Interceptor{}, peq.inters...),
+		predicates: append([]predicate.PlayerEvent{}, peq.predicates...),
+		// clone intermediate query.
+		sql:       peq.sql.Clone(),
+		path:      peq.path,
+		modifiers: append([]func(*sql.Selector){}, peq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PlayerEvent.Query().
+//		GroupBy(playerevent.FieldPlayerID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (peq *PlayerEventQuery) GroupBy(field string, fields ...string) *PlayerEventGroupBy {
+	peq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PlayerEventGroupBy{build: peq}
+	grbuild.flds = &peq.ctx.Fields
+	grbuild.label = playerevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//	}
+//
+//	client.PlayerEvent.Query().
+//		Select(playerevent.FieldPlayerID).
+//		Scan(ctx, &v)
+func (peq *PlayerEventQuery) Select(fields ...string) *PlayerEventSelect {
+	peq.ctx.Fields = append(peq.ctx.Fields, fields...)
+	sbuild := &PlayerEventSelect{PlayerEventQuery: peq}
+	sbuild.label = playerevent.Label
+	sbuild.flds, sbuild.scan = &peq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PlayerEventSelect configured with the given aggregations.
+func (peq *PlayerEventQuery) Aggregate(fns ...AggregateFunc) *PlayerEventSelect {
+	return peq.Select().Aggregate(fns...)
+}
+
+func (peq *PlayerEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range peq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, peq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range peq.ctx.Fields {
+		if !playerevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if peq.path != nil {
+		prev, err := peq.path(ctx)
+		if err != nil {
+			return err
+		}
+		peq.sql = prev
+	}
+	return nil
+}
+
+func (peq *PlayerEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PlayerEvent, error) {
+	var (
+		nodes = []*PlayerEvent{}
+		_spec = peq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PlayerEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PlayerEvent{config: peq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(peq.modifiers) > 0 {
+		_spec.Modifiers = peq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, peq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (peq *PlayerEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := peq.querySpec()
+	if len(peq.modifiers) > 0 {
+		_spec.Modifiers = peq.modifiers
+	}
+	_spec.Node.Columns = peq.ctx.Fields
+	if len(peq.ctx.Fields) > 0 {
+		_spec.Unique = peq.ctx.Unique != nil && *peq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, peq.driver, _spec)
+}
+
+func (peq *PlayerEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(playerevent.Table, playerevent.Columns, sqlgraph.NewFieldSpec(playerevent.FieldID, field.TypeInt64))
+	_spec.From = peq.sql
+	if unique := peq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if peq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := peq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playerevent.FieldID)
+		for i := range fields {
+			if fields[i] != playerevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := peq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := peq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := peq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := peq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (peq *PlayerEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(peq.driver.Dialect())
+	t1 := builder.Table(playerevent.Table)
+	columns := peq.ctx.Fields
+	if len(columns) == 0 {
+		columns = playerevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if peq.sql != nil {
+		selector = peq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if peq.ctx.Unique != nil && *peq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range peq.modifiers {
+		m(selector)
+	}
+	for _, p := range peq.predicates {
+		p(selector)
+	}
+	for _, p := range peq.order {
+		p(selector)
+	}
+	if offset := peq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := peq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (peq *PlayerEventQuery) Modify(modifiers ...func(s *sql.Selector)) *PlayerEventSelect {
+	peq.modifiers = append(peq.modifiers, modifiers...)
+	return peq.Select()
+}
+
+// PlayerEventGroupBy is the group-by builder for PlayerEvent entities.
+type PlayerEventGroupBy struct {
+	selector
+	build *PlayerEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pegb *PlayerEventGroupBy) Aggregate(fns ...AggregateFunc) *PlayerEventGroupBy {
+	pegb.fns = append(pegb.fns, fns...)
+	return pegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pegb *PlayerEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pegb.build.ctx, ent.OpQueryGroupBy)
+	if err := pegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlayerEventQuery, *PlayerEventGroupBy](ctx, pegb.build, pegb, pegb.build.inters, v)
+}
+
+func (pegb *PlayerEventGroupBy) sqlScan(ctx context.Context, root *PlayerEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pegb.fns))
+	for _, fn := range pegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pegb.flds)+len(pegb.fns))
+		for _, f := range *pegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PlayerEventSelect is the builder for selecting fields of PlayerEvent entities.
+type PlayerEventSelect struct {
+	*PlayerEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pes *PlayerEventSelect) Aggregate(fns ...AggregateFunc) *PlayerEventSelect {
+	pes.fns = append(pes.fns, fns...)
+	return pes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pes *PlayerEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pes.ctx, ent.OpQuerySelect)
+	if err := pes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PlayerEventQuery, *PlayerEventSelect](ctx, pes.PlayerEventQuery, pes, pes.inters, v)
+}
+
+func (pes *PlayerEventSelect) sqlScan(ctx context.Context, root *PlayerEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pes.fns))
+	for _, fn := range pes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pes *PlayerEventSelect) Modify(modifiers ...func(s *sql.Selector)) *PlayerEventSelect {
+	pes.modifiers = append(pes.modifiers, modifiers...)
+	return pes
+}
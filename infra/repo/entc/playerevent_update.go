@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// PlayerEventUpdate is the builder for updating PlayerEvent entities.
+type PlayerEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PlayerEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PlayerEventUpdate builder.
+func (peu *PlayerEventUpdate) Where(ps ...predicate.PlayerEvent) *PlayerEventUpdate {
+	peu.mutation.Where(ps...)
+	return peu
+}
+
+// SetPlayerID sets the "player_id" field.
+func (peu *PlayerEventUpdate) SetPlayerID(s string) *PlayerEventUpdate {
+	peu.mutation.SetPlayerID(s)
+	return peu
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (peu *PlayerEventUpdate) SetNillablePlayerID(s *string) *PlayerEventUpdate {
+	if s != nil {
+		peu.SetPlayerID(*s)
+	}
+	return peu
+}
+
+// SetEventID sets the "event_id" field.
+func (peu *PlayerEventUpdate) SetEventID(s string) *PlayerEventUpdate {
+	peu.mutation.SetEventID(s)
+	return peu
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (peu *PlayerEventUpdate) SetNillableEventID(s *string) *PlayerEventUpdate {
+	if s != nil {
+		peu.SetEventID(*s)
+	}
+	return peu
+}
+
+// SetType sets the "type" field.
+func (peu *PlayerEventUpdate) SetType(s string) *PlayerEventUpdate {
+	peu.mutation.SetType(s)
+	return peu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (peu *PlayerEventUpdate) SetNillableType(s *string) *PlayerEventUpdate {
+	if s != nil {
+		peu.SetType(*s)
+	}
+	return peu
+}
+
+// Mutation returns the PlayerEventMutation object of the builder.
+func (peu *PlayerEventUpdate) Mutation() *PlayerEventMutation {
+	return peu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (peu *PlayerEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, peu.sqlSave, peu.mutation, peu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (peu *PlayerEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := peu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (peu *PlayerEventUpdate) Exec(ctx context.Context) error {
+	_, err := peu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (peu *PlayerEventUpdate) ExecX(ctx context.Context) {
+	if err := peu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (peu *PlayerEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlayerEventUpdate {
+	peu.modifiers = append(peu.modifiers, modifiers...)
+	return peu
+}
+
+func (peu *PlayerEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(playerevent.Table, playerevent.Columns, sqlgraph.NewFieldSpec(playerevent.FieldID, field.TypeInt64))
+	if ps := peu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := peu.mutation.PlayerID(); ok {
+		_spec.SetField(playerevent.FieldPlayerID, field.TypeString, value)
+	}
+	if value, ok := peu.mutation.EventID(); ok {
+		_spec.SetField(playerevent.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := peu.mutation.GetType(); ok {
+		_spec.SetField(playerevent.FieldType, field.TypeString, value)
+	}
+	_spec.AddModifiers(peu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, peu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playerevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	peu.mutation.done = true
+	return n, nil
+}
+
+// PlayerEventUpdateOne is the builder for updating a single PlayerEvent entity.
+type PlayerEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PlayerEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPlayerID sets the "player_id" field.
+func (peuo *PlayerEventUpdateOne) SetPlayerID(s string) *PlayerEventUpdateOne {
+	peuo.mutation.SetPlayerID(s)
+	return peuo
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (peuo *PlayerEventUpdateOne) SetNillablePlayerID(s *string) *PlayerEventUpdateOne {
+	if s != nil {
+		peuo.SetPlayerID(*s)
+	}
+	return peuo
+}
+
+// SetEventID sets the "event_id" field.
+func (peuo *PlayerEventUpdateOne) SetEventID(s string) *PlayerEventUpdateOne {
+	peuo.mutation.SetEventID(s)
+	return peuo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (peuo *PlayerEventUpdateOne) SetNillableEventID(s *string) *PlayerEventUpdateOne {
+	if s != nil {
+		peuo.SetEventID(*s)
+	}
+	return peuo
+}
+
+// SetType sets the "type" field.
+func (peuo *PlayerEventUpdateOne) SetType(s string) *PlayerEventUpdateOne {
+	peuo.mutation.SetType(s)
+	return peuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (peuo *PlayerEventUpdateOne) SetNillableType(s *string) *PlayerEventUpdateOne {
+	if s != nil {
+		peuo.SetType(*s)
+	}
+	return peuo
+}
+
+// Mutation returns the PlayerEventMutation object of the builder.
+func (peuo *PlayerEventUpdateOne) Mutation() *PlayerEventMutation {
+	return peuo.mutation
+}
+
+// Where appends a list predicates to the PlayerEventUpdate builder.
+func (peuo *PlayerEventUpdateOne) Where(ps ...predicate.PlayerEvent) *PlayerEventUpdateOne {
+	peuo.mutation.Where(ps...)
+	return peuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (peuo *PlayerEventUpdateOne) Select(field string, fields ...string) *PlayerEventUpdateOne {
+	peuo.fields = append([]string{field}, fields...)
+	return peuo
+}
+
+// Save executes the query and returns the updated PlayerEvent entity.
+func (peuo *PlayerEventUpdateOne) Save(ctx context.Context) (*PlayerEvent, error) {
+	return withHooks(ctx, peuo.sqlSave, peuo.mutation, peuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (peuo *PlayerEventUpdateOne) SaveX(ctx context.Context) *PlayerEvent {
+	node, err := peuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (peuo *PlayerEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := peuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (peuo *PlayerEventUpdateOne) ExecX(ctx context.Context) {
+	if err := peuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (peuo *PlayerEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PlayerEventUpdateOne {
+	peuo.modifiers = append(peuo.modifiers, modifiers...)
+	return peuo
+}
+
+func (peuo *PlayerEventUpdateOne) sqlSave(ctx context.Context) (_node *PlayerEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(playerevent.Table, playerevent.Columns, sqlgraph.NewFieldSpec(playerevent.FieldID, field.TypeInt64))
+	id, ok := peuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "PlayerEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := peuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playerevent.FieldID)
+		for _, f := range fields {
+			if !playerevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != playerevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := peuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := peuo.mutation.PlayerID(); ok {
+		_spec.SetField(playerevent.FieldPlayerID, field.TypeString, value)
+	}
+	if value, ok := peuo.mutation.EventID(); ok {
+		_spec.SetField(playerevent.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := peuo.mutation.GetType(); ok {
+		_spec.SetField(playerevent.FieldType, field.TypeString, value)
+	}
+	_spec.AddModifiers(peuo.modifiers...)
+	_node = &PlayerEvent{config: peuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, peuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playerevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	peuo.mutation.done = true
+	return _node, nil
+}
@@ -6,5 +6,83 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// CatalogSnapshot is the predicate function for catalogsnapshot builders.
+type CatalogSnapshot func(*sql.Selector)
+
+// CatalogVersion is the predicate function for catalogversion builders.
+type CatalogVersion func(*sql.Selector)
+
+// Changeset is the predicate function for changeset builders.
+type Changeset func(*sql.Selector)
+
+// ChangesetChange is the predicate function for changesetchange builders.
+type ChangesetChange func(*sql.Selector)
+
+// InventoryItem is the predicate function for inventoryitem builders.
+type InventoryItem func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
+
+// ItemInstance is the predicate function for iteminstance builders.
+type ItemInstance func(*sql.Selector)
+
+// ItemInstanceHistory is the predicate function for iteminstancehistory builders.
+type ItemInstanceHistory func(*sql.Selector)
+
+// ItemTranslation is the predicate function for itemtranslation builders.
+type ItemTranslation func(*sql.Selector)
+
+// JobRun is the predicate function for jobrun builders.
+type JobRun func(*sql.Selector)
+
+// JobTask is the predicate function for jobtask builders.
+type JobTask func(*sql.Selector)
+
+// Leaderboard is the predicate function for leaderboard builders.
+type Leaderboard func(*sql.Selector)
+
+// LeaderboardSnapshot is the predicate function for leaderboardsnapshot builders.
+type LeaderboardSnapshot func(*sql.Selector)
+
+// LedgerEntry is the predicate function for ledgerentry builders.
+type LedgerEntry func(*sql.Selector)
+
+// LiveEvent is the predicate function for liveevent builders.
+type LiveEvent func(*sql.Selector)
+
+// MarketFill is the predicate function for marketfill builders.
+type MarketFill func(*sql.Selector)
+
+// MarketOrder is the predicate function for marketorder builders.
+type MarketOrder func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// Player is the predicate function for player builders.
+type Player func(*sql.Selector)
+
+// PlayerAccount is the predicate function for playeraccount builders.
+type PlayerAccount func(*sql.Selector)
+
+// PlayerEvent is the predicate function for playerevent builders.
+type PlayerEvent func(*sql.Selector)
+
+// Quest is the predicate function for quest builders.
+type Quest func(*sql.Selector)
+
+// QuestProgress is the predicate function for questprogress builders.
+type QuestProgress func(*sql.Selector)
+
+// Trade is the predicate function for trade builders.
+type Trade func(*sql.Selector)
+
+// Wallet is the predicate function for wallet builders.
+type Wallet func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+)
+
+// Quest is the model entity for the Quest schema.
+type Quest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Objectives holds the value of the "objectives" field.
+	Objectives []*entity.QuestObjective `json:"objectives,omitempty"`
+	// Rewards holds the value of the "rewards" field.
+	Rewards []*entity.QuestReward `json:"rewards,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quest.FieldObjectives, quest.FieldRewards:
+			values[i] = new([]byte)
+		case quest.FieldActive:
+			values[i] = new(sql.NullBool)
+		case quest.FieldCreatedAt, quest.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case quest.FieldID, quest.FieldKind, quest.FieldName, quest.FieldDescription, quest.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quest fields.
+func (q *Quest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quest.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				q.ID = value.String
+			}
+		case quest.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				q.Kind = value.String
+			}
+		case quest.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				q.Name = value.String
+			}
+		case quest.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				q.Description = value.String
+			}
+		case quest.FieldObjectives:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field objectives", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &q.Objectives); err != nil {
+					return fmt.Errorf("unmarshal field objectives: %w", err)
+				}
+			}
+		case quest.FieldRewards:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rewards", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &q.Rewards); err != nil {
+					return fmt.Errorf("unmarshal field rewards: %w", err)
+				}
+			}
+		case quest.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				q.Active = value.Bool
+			}
+		case quest.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				q.CreatedBy = value.String
+			}
+		case quest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Int64
+			}
+		case quest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				q.UpdatedAt = value.Int64
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quest.
+// This includes values selected through modifiers, order, etc.
+func (q *Quest) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Quest.
+// Note that you need to call Quest.Unwrap() before calling this method if this Quest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quest) Update() *QuestUpdateOne {
+	return NewQuestClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quest) Unwrap() *Quest {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: Quest is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quest) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(q.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(q.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(q.Description)
+	builder.WriteString(", ")
+	builder.WriteString("objectives=")
+	builder.WriteString(fmt.Sprintf("%v", q.Objectives))
+	builder.WriteString(", ")
+	builder.WriteString("rewards=")
+	builder.WriteString(fmt.Sprintf("%v", q.Rewards))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", q.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(q.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", q.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", q.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Quests is a parsable slice of Quest.
+type Quests []*Quest
@@ -0,0 +1,978 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+)
+
+// QuestCreate is the builder for creating a Quest entity.
+type QuestCreate struct {
+	config
+	mutation *QuestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetKind sets the "kind" field.
+func (qc *QuestCreate) SetKind(s string) *QuestCreate {
+	qc.mutation.SetKind(s)
+	return qc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (qc *QuestCreate) SetNillableKind(s *string) *QuestCreate {
+	if s != nil {
+		qc.SetKind(*s)
+	}
+	return qc
+}
+
+// SetName sets the "name" field.
+func (qc *QuestCreate) SetName(s string) *QuestCreate {
+	qc.mutation.SetName(s)
+	return qc
+}
+
+// SetDescription sets the "description" field.
+func (qc *QuestCreate) SetDescription(s string) *QuestCreate {
+	qc.mutation.SetDescription(s)
+	return qc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qc *QuestCreate) SetNillableDescription(s *string) *QuestCreate {
+	if s != nil {
+		qc.SetDescription(*s)
+	}
+	return qc
+}
+
+// SetObjectives sets the "objectives" field.
+func (qc *QuestCreate) SetObjectives(eo []*entity.QuestObjective) *QuestCreate {
+	qc.mutation.SetObjectives(eo)
+	return qc
+}
+
+// SetRewards sets the "rewards" field.
+func (qc *QuestCreate) SetRewards(er []*entity.QuestReward) *QuestCreate {
+	qc.mutation.SetRewards(er)
+	return qc
+}
+
+// SetActive sets the "active" field.
+func (qc *QuestCreate) SetActive(b bool) *QuestCreate {
+	qc.mutation.SetActive(b)
+	return qc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (qc *QuestCreate) SetNillableActive(b *bool) *QuestCreate {
+	if b != nil {
+		qc.SetActive(*b)
+	}
+	return qc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (qc *QuestCreate) SetCreatedBy(s string) *QuestCreate {
+	qc.mutation.SetCreatedBy(s)
+	return qc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (qc *QuestCreate) SetNillableCreatedBy(s *string) *QuestCreate {
+	if s != nil {
+		qc.SetCreatedBy(*s)
+	}
+	return qc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qc *QuestCreate) SetCreatedAt(i int64) *QuestCreate {
+	qc.mutation.SetCreatedAt(i)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qc *QuestCreate) SetNillableCreatedAt(i *int64) *QuestCreate {
+	if i != nil {
+		qc.SetCreatedAt(*i)
+	}
+	return qc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qc *QuestCreate) SetUpdatedAt(i int64) *QuestCreate {
+	qc.mutation.SetUpdatedAt(i)
+	return qc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qc *QuestCreate) SetNillableUpdatedAt(i *int64) *QuestCreate {
+	if i != nil {
+		qc.SetUpdatedAt(*i)
+	}
+	return qc
+}
+
+// SetID sets the "id" field.
+func (qc *QuestCreate) SetID(s string) *QuestCreate {
+	qc.mutation.SetID(s)
+	return qc
+}
+
+// Mutation returns the QuestMutation object of the builder.
+func (qc *QuestCreate) Mutation() *QuestMutation {
+	return qc.mutation
+}
+
+// Save creates the Quest in the database.
+func (qc *QuestCreate) Save(ctx context.Context) (*Quest, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuestCreate) SaveX(ctx context.Context) *Quest {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuestCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuestCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuestCreate) defaults() {
+	if _, ok := qc.mutation.Kind(); !ok {
+		v := quest.DefaultKind
+		qc.mutation.SetKind(v)
+	}
+	if _, ok := qc.mutation.Description(); !ok {
+		v := quest.DefaultDescription
+		qc.mutation.SetDescription(v)
+	}
+	if _, ok := qc.mutation.Active(); !ok {
+		v := quest.DefaultActive
+		qc.mutation.SetActive(v)
+	}
+	if _, ok := qc.mutation.CreatedBy(); !ok {
+		v := quest.DefaultCreatedBy
+		qc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quest.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		v := quest.DefaultUpdatedAt()
+		qc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuestCreate) check() error {
+	if _, ok := qc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`entc: missing required field "Quest.kind"`)}
+	}
+	if _, ok := qc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entc: missing required field "Quest.name"`)}
+	}
+	if _, ok := qc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`entc: missing required field "Quest.description"`)}
+	}
+	if _, ok := qc.mutation.Objectives(); !ok {
+		return &ValidationError{Name: "objectives", err: errors.New(`entc: missing required field "Quest.objectives"`)}
+	}
+	if _, ok := qc.mutation.Rewards(); !ok {
+		return &ValidationError{Name: "rewards", err: errors.New(`entc: missing required field "Quest.rewards"`)}
+	}
+	if _, ok := qc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`entc: missing required field "Quest.active"`)}
+	}
+	if _, ok := qc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`entc: missing required field "Quest.created_by"`)}
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "Quest.created_at"`)}
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "Quest.updated_at"`)}
+	}
+	return nil
+}
+
+func (qc *QuestCreate) sqlSave(ctx context.Context) (*Quest, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Quest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuestCreate) createSpec() (*Quest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quest{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(quest.Table, sqlgraph.NewFieldSpec(quest.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = qc.conflict
+	if id, ok := qc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := qc.mutation.Kind(); ok {
+		_spec.SetField(quest.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := qc.mutation.Name(); ok {
+		_spec.SetField(quest.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := qc.mutation.Description(); ok {
+		_spec.SetField(quest.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := qc.mutation.Objectives(); ok {
+		_spec.SetField(quest.FieldObjectives, field.TypeJSON, value)
+		_node.Objectives = value
+	}
+	if value, ok := qc.mutation.Rewards(); ok {
+		_spec.SetField(quest.FieldRewards, field.TypeJSON, value)
+		_node.Rewards = value
+	}
+	if value, ok := qc.mutation.Active(); ok {
+		_spec.SetField(quest.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := qc.mutation.CreatedBy(); ok {
+		_spec.SetField(quest.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.SetField(quest.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qc.mutation.UpdatedAt(); ok {
+		_spec.SetField(quest.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quest.Create().
+//		SetKind(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (qc *QuestCreate) OnConflict(opts ...sql.ConflictOption) *QuestUpsertOne {
+	qc.conflict = opts
+	return &QuestUpsertOne{
+		create: qc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qc *QuestCreate) OnConflictColumns(columns ...string) *QuestUpsertOne {
+	qc.conflict = append(qc.conflict, sql.ConflictColumns(columns...))
+	return &QuestUpsertOne{
+		create: qc,
+	}
+}
+
+type (
+	// QuestUpsertOne is the builder for "upsert"-ing
+	//  one Quest node.
+	QuestUpsertOne struct {
+		create *QuestCreate
+	}
+
+	// QuestUpsert is the "OnConflict" setter.
+	QuestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetKind sets the "kind" field.
+func (u *QuestUpsert) SetKind(v string) *QuestUpsert {
+	u.Set(quest.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateKind() *QuestUpsert {
+	u.SetExcluded(quest.FieldKind)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *QuestUpsert) SetName(v string) *QuestUpsert {
+	u.Set(quest.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateName() *QuestUpsert {
+	u.SetExcluded(quest.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestUpsert) SetDescription(v string) *QuestUpsert {
+	u.Set(quest.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateDescription() *QuestUpsert {
+	u.SetExcluded(quest.FieldDescription)
+	return u
+}
+
+// SetObjectives sets the "objectives" field.
+func (u *QuestUpsert) SetObjectives(v []*entity.QuestObjective) *QuestUpsert {
+	u.Set(quest.FieldObjectives, v)
+	return u
+}
+
+// UpdateObjectives sets the "objectives" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateObjectives() *QuestUpsert {
+	u.SetExcluded(quest.FieldObjectives)
+	return u
+}
+
+// SetRewards sets the "rewards" field.
+func (u *QuestUpsert) SetRewards(v []*entity.QuestReward) *QuestUpsert {
+	u.Set(quest.FieldRewards, v)
+	return u
+}
+
+// UpdateRewards sets the "rewards" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateRewards() *QuestUpsert {
+	u.SetExcluded(quest.FieldRewards)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *QuestUpsert) SetActive(v bool) *QuestUpsert {
+	u.Set(quest.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateActive() *QuestUpsert {
+	u.SetExcluded(quest.FieldActive)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *QuestUpsert) SetCreatedBy(v string) *QuestUpsert {
+	u.Set(quest.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateCreatedBy() *QuestUpsert {
+	u.SetExcluded(quest.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestUpsert) SetUpdatedAt(v int64) *QuestUpsert {
+	u.Set(quest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestUpsert) UpdateUpdatedAt() *QuestUpsert {
+	u.SetExcluded(quest.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *QuestUpsert) AddUpdatedAt(v int64) *QuestUpsert {
+	u.Add(quest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Quest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(quest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestUpsertOne) UpdateNewValues() *QuestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(quest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(quest.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestUpsertOne) Ignore() *QuestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestUpsertOne) DoNothing() *QuestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestCreate.OnConflict
+// documentation for more info.
+func (u *QuestUpsertOne) Update(set func(*QuestUpsert)) *QuestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *QuestUpsertOne) SetKind(v string) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateKind() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *QuestUpsertOne) SetName(v string) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateName() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestUpsertOne) SetDescription(v string) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateDescription() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetObjectives sets the "objectives" field.
+func (u *QuestUpsertOne) SetObjectives(v []*entity.QuestObjective) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetObjectives(v)
+	})
+}
+
+// UpdateObjectives sets the "objectives" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateObjectives() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateObjectives()
+	})
+}
+
+// SetRewards sets the "rewards" field.
+func (u *QuestUpsertOne) SetRewards(v []*entity.QuestReward) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetRewards(v)
+	})
+}
+
+// UpdateRewards sets the "rewards" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateRewards() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateRewards()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *QuestUpsertOne) SetActive(v bool) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateActive() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *QuestUpsertOne) SetCreatedBy(v string) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateCreatedBy() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestUpsertOne) SetUpdatedAt(v int64) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *QuestUpsertOne) AddUpdatedAt(v int64) *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestUpsertOne) UpdateUpdatedAt() *QuestUpsertOne {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for QuestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entc: QuestUpsertOne.ID is not supported by MySQL driver. Use QuestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuestCreateBulk is the builder for creating many Quest entities in bulk.
+type QuestCreateBulk struct {
+	config
+	err      error
+	builders []*QuestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Quest entities in the database.
+func (qcb *QuestCreateBulk) Save(ctx context.Context) ([]*Quest, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quest, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuestCreateBulk) SaveX(ctx context.Context) []*Quest {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuestCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuestCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestUpsert) {
+//			SetKind(v+v).
+//		}).
+//		Exec(ctx)
+func (qcb *QuestCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestUpsertBulk {
+	qcb.conflict = opts
+	return &QuestUpsertBulk{
+		create: qcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qcb *QuestCreateBulk) OnConflictColumns(columns ...string) *QuestUpsertBulk {
+	qcb.conflict = append(qcb.conflict, sql.ConflictColumns(columns...))
+	return &QuestUpsertBulk{
+		create: qcb,
+	}
+}
+
+// QuestUpsertBulk is the builder for "upsert"-ing
+// a bulk of Quest nodes.
+type QuestUpsertBulk struct {
+	create *QuestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Quest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(quest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestUpsertBulk) UpdateNewValues() *QuestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(quest.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(quest.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestUpsertBulk) Ignore() *QuestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestUpsertBulk) DoNothing() *QuestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestUpsertBulk) Update(set func(*QuestUpsert)) *QuestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *QuestUpsertBulk) SetKind(v string) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateKind() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *QuestUpsertBulk) SetName(v string) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateName() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestUpsertBulk) SetDescription(v string) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateDescription() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetObjectives sets the "objectives" field.
+func (u *QuestUpsertBulk) SetObjectives(v []*entity.QuestObjective) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetObjectives(v)
+	})
+}
+
+// UpdateObjectives sets the "objectives" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateObjectives() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateObjectives()
+	})
+}
+
+// SetRewards sets the "rewards" field.
+func (u *QuestUpsertBulk) SetRewards(v []*entity.QuestReward) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetRewards(v)
+	})
+}
+
+// UpdateRewards sets the "rewards" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateRewards() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateRewards()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *QuestUpsertBulk) SetActive(v bool) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateActive() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *QuestUpsertBulk) SetCreatedBy(v string) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateCreatedBy() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestUpsertBulk) SetUpdatedAt(v int64) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *QuestUpsertBulk) AddUpdatedAt(v int64) *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestUpsertBulk) UpdateUpdatedAt() *QuestUpsertBulk {
+	return u.Update(func(s *QuestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the QuestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for QuestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
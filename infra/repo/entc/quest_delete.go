@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+)
+
+// QuestDelete is the builder for deleting a Quest entity.
+type QuestDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuestMutation
+}
+
+// Where appends a list predicates to the QuestDelete builder.
+func (qd *QuestDelete) Where(ps ...predicate.Quest) *QuestDelete {
+	qd.mutation.Where(ps...)
+	return qd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qd *QuestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qd.sqlExec, qd.mutation, qd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qd *QuestDelete) ExecX(ctx context.Context) int {
+	n, err := qd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qd *QuestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(quest.Table, sqlgraph.NewFieldSpec(quest.FieldID, field.TypeString))
+	if ps := qd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qd.mutation.done = true
+	return affected, err
+}
+
+// QuestDeleteOne is the builder for deleting a single Quest entity.
+type QuestDeleteOne struct {
+	qd *QuestDelete
+}
+
+// Where appends a list predicates to the QuestDelete builder.
+func (qdo *QuestDeleteOne) Where(ps ...predicate.Quest) *QuestDeleteOne {
+	qdo.qd.mutation.Where(ps...)
+	return qdo
+}
+
+// Exec executes the deletion query.
+func (qdo *QuestDeleteOne) Exec(ctx context.Context) error {
+	n, err := qdo.qd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{quest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qdo *QuestDeleteOne) ExecX(ctx context.Context) {
+	if err := qdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
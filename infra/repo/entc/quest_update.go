@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+)
+
+// QuestUpdate is the builder for updating Quest entities.
+type QuestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QuestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QuestUpdate builder.
+func (qu *QuestUpdate) Where(ps ...predicate.Quest) *QuestUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetKind sets the "kind" field.
+func (qu *QuestUpdate) SetKind(s string) *QuestUpdate {
+	qu.mutation.SetKind(s)
+	return qu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (qu *QuestUpdate) SetNillableKind(s *string) *QuestUpdate {
+	if s != nil {
+		qu.SetKind(*s)
+	}
+	return qu
+}
+
+// SetName sets the "name" field.
+func (qu *QuestUpdate) SetName(s string) *QuestUpdate {
+	qu.mutation.SetName(s)
+	return qu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (qu *QuestUpdate) SetNillableName(s *string) *QuestUpdate {
+	if s != nil {
+		qu.SetName(*s)
+	}
+	return qu
+}
+
+// SetDescription sets the "description" field.
+func (qu *QuestUpdate) SetDescription(s string) *QuestUpdate {
+	qu.mutation.SetDescription(s)
+	return qu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qu *QuestUpdate) SetNillableDescription(s *string) *QuestUpdate {
+	if s != nil {
+		qu.SetDescription(*s)
+	}
+	return qu
+}
+
+// SetObjectives sets the "objectives" field.
+func (qu *QuestUpdate) SetObjectives(eo []*entity.QuestObjective) *QuestUpdate {
+	qu.mutation.SetObjectives(eo)
+	return qu
+}
+
+// AppendObjectives appends eo to the "objectives" field.
+func (qu *QuestUpdate) AppendObjectives(eo []*entity.QuestObjective) *QuestUpdate {
+	qu.mutation.AppendObjectives(eo)
+	return qu
+}
+
+// SetRewards sets the "rewards" field.
+func (qu *QuestUpdate) SetRewards(er []*entity.QuestReward) *QuestUpdate {
+	qu.mutation.SetRewards(er)
+	return qu
+}
+
+// AppendRewards appends er to the "rewards" field.
+func (qu *QuestUpdate) AppendRewards(er []*entity.QuestReward) *QuestUpdate {
+	qu.mutation.AppendRewards(er)
+	return qu
+}
+
+// SetActive sets the "active" field.
+func (qu *QuestUpdate) SetActive(b bool) *QuestUpdate {
+	qu.mutation.SetActive(b)
+	return qu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (qu *QuestUpdate) SetNillableActive(b *bool) *QuestUpdate {
+	if b != nil {
+		qu.SetActive(*b)
+	}
+	return qu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (qu *QuestUpdate) SetCreatedBy(s string) *QuestUpdate {
+	qu.mutation.SetCreatedBy(s)
+	return qu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (qu *QuestUpdate) SetNillableCreatedBy(s *string) *QuestUpdate {
+	if s != nil {
+		qu.SetCreatedBy(*s)
+	}
+	return qu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qu *QuestUpdate) SetUpdatedAt(i int64) *QuestUpdate {
+	qu.mutation.ResetUpdatedAt()
+	qu.mutation.SetUpdatedAt(i)
+	return qu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (qu *QuestUpdate) AddUpdatedAt(i int64) *QuestUpdate {
+	qu.mutation.AddUpdatedAt(i)
+	return qu
+}
+
+// Mutation returns the QuestMutation object of the builder.
+func (qu *QuestUpdate) Mutation() *QuestMutation {
+	return qu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuestUpdate) Save(ctx context.Context) (int, error) {
+	qu.defaults()
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuestUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuestUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuestUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qu *QuestUpdate) defaults() {
+	if _, ok := qu.mutation.UpdatedAt(); !ok {
+		v := quest.UpdateDefaultUpdatedAt()
+		qu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qu *QuestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestUpdate {
+	qu.modifiers = append(qu.modifiers, modifiers...)
+	return qu
+}
+
+func (qu *QuestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quest.Table, quest.Columns, sqlgraph.NewFieldSpec(quest.FieldID, field.TypeString))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.Kind(); ok {
+		_spec.SetField(quest.FieldKind, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Name(); ok {
+		_spec.SetField(quest.FieldName, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Description(); ok {
+		_spec.SetField(quest.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Objectives(); ok {
+		_spec.SetField(quest.FieldObjectives, field.TypeJSON, value)
+	}
+	if value, ok := qu.mutation.AppendedObjectives(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quest.FieldObjectives, value)
+		})
+	}
+	if value, ok := qu.mutation.Rewards(); ok {
+		_spec.SetField(quest.FieldRewards, field.TypeJSON, value)
+	}
+	if value, ok := qu.mutation.AppendedRewards(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quest.FieldRewards, value)
+		})
+	}
+	if value, ok := qu.mutation.Active(); ok {
+		_spec.SetField(quest.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := qu.mutation.CreatedBy(); ok {
+		_spec.SetField(quest.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.UpdatedAt(); ok {
+		_spec.SetField(quest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := qu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(quest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(qu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuestUpdateOne is the builder for updating a single Quest entity.
+type QuestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QuestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetKind sets the "kind" field.
+func (quo *QuestUpdateOne) SetKind(s string) *QuestUpdateOne {
+	quo.mutation.SetKind(s)
+	return quo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (quo *QuestUpdateOne) SetNillableKind(s *string) *QuestUpdateOne {
+	if s != nil {
+		quo.SetKind(*s)
+	}
+	return quo
+}
+
+// SetName sets the "name" field.
+func (quo *QuestUpdateOne) SetName(s string) *QuestUpdateOne {
+	quo.mutation.SetName(s)
+	return quo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (quo *QuestUpdateOne) SetNillableName(s *string) *QuestUpdateOne {
+	if s != nil {
+		quo.SetName(*s)
+	}
+	return quo
+}
+
+// SetDescription sets the "description" field.
+func (quo *QuestUpdateOne) SetDescription(s string) *QuestUpdateOne {
+	quo.mutation.SetDescription(s)
+	return quo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (quo *QuestUpdateOne) SetNillableDescription(s *string) *QuestUpdateOne {
+	if s != nil {
+		quo.SetDescription(*s)
+	}
+	return quo
+}
+
+// SetObjectives sets the "objectives" field.
+func (quo *QuestUpdateOne) SetObjectives(eo []*entity.QuestObjective) *QuestUpdateOne {
+	quo.mutation.SetObjectives(eo)
+	return quo
+}
+
+// AppendObjectives appends eo to the "objectives" field.
+func (quo *QuestUpdateOne) AppendObjectives(eo []*entity.QuestObjective) *QuestUpdateOne {
+	quo.mutation.AppendObjectives(eo)
+	return quo
+}
+
+// SetRewards sets the "rewards" field.
+func (quo *QuestUpdateOne) SetRewards(er []*entity.QuestReward) *QuestUpdateOne {
+	quo.mutation.SetRewards(er)
+	return quo
+}
+
+// AppendRewards appends er to the "rewards" field.
+func (quo *QuestUpdateOne) AppendRewards(er []*entity.QuestReward) *QuestUpdateOne {
+	quo.mutation.AppendRewards(er)
+	return quo
+}
+
+// SetActive sets the "active" field.
+func (quo *QuestUpdateOne) SetActive(b bool) *QuestUpdateOne {
+	quo.mutation.SetActive(b)
+	return quo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (quo *QuestUpdateOne) SetNillableActive(b *bool) *QuestUpdateOne {
+	if b != nil {
+		quo.SetActive(*b)
+	}
+	return quo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (quo *QuestUpdateOne) SetCreatedBy(s string) *QuestUpdateOne {
+	quo.mutation.SetCreatedBy(s)
+	return quo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (quo *QuestUpdateOne) SetNillableCreatedBy(s *string) *QuestUpdateOne {
+	if s != nil {
+		quo.SetCreatedBy(*s)
+	}
+	return quo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (quo *QuestUpdateOne) SetUpdatedAt(i int64) *QuestUpdateOne {
+	quo.mutation.ResetUpdatedAt()
+	quo.mutation.SetUpdatedAt(i)
+	return quo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (quo *QuestUpdateOne) AddUpdatedAt(i int64) *QuestUpdateOne {
+	quo.mutation.AddUpdatedAt(i)
+	return quo
+}
+
+// Mutation returns the QuestMutation object of the builder.
+func (quo *QuestUpdateOne) Mutation() *QuestMutation {
+	return quo.mutation
+}
+
+// Where appends a list predicates to the QuestUpdate builder.
+func (quo *QuestUpdateOne) Where(ps ...predicate.Quest) *QuestUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuestUpdateOne) Select(field string, fields ...string) *QuestUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quest entity.
+func (quo *QuestUpdateOne) Save(ctx context.Context) (*Quest, error) {
+	quo.defaults()
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuestUpdateOne) SaveX(ctx context.Context) *Quest {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuestUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuestUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (quo *QuestUpdateOne) defaults() {
+	if _, ok := quo.mutation.UpdatedAt(); !ok {
+		v := quest.UpdateDefaultUpdatedAt()
+		quo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (quo *QuestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestUpdateOne {
+	quo.modifiers = append(quo.modifiers, modifiers...)
+	return quo
+}
+
+func (quo *QuestUpdateOne) sqlSave(ctx context.Context) (_node *Quest, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quest.Table, quest.Columns, sqlgraph.NewFieldSpec(quest.FieldID, field.TypeString))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "Quest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quest.FieldID)
+		for _, f := range fields {
+			if !quest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != quest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.Kind(); ok {
+		_spec.SetField(quest.FieldKind, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Name(); ok {
+		_spec.SetField(quest.FieldName, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Description(); ok {
+		_spec.SetField(quest.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Objectives(); ok {
+		_spec.SetField(quest.FieldObjectives, field.TypeJSON, value)
+	}
+	if value, ok := quo.mutation.AppendedObjectives(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quest.FieldObjectives, value)
+		})
+	}
+	if value, ok := quo.mutation.Rewards(); ok {
+		_spec.SetField(quest.FieldRewards, field.TypeJSON, value)
+	}
+	if value, ok := quo.mutation.AppendedRewards(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quest.FieldRewards, value)
+		})
+	}
+	if value, ok := quo.mutation.Active(); ok {
+		_spec.SetField(quest.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := quo.mutation.CreatedBy(); ok {
+		_spec.SetField(quest.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := quo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(quest.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(quo.modifiers...)
+	_node = &Quest{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
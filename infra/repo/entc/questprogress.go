@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+)
+
+// QuestProgress is the model entity for the QuestProgress schema.
+type QuestProgress struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID string `json:"player_id,omitempty"`
+	// QuestID holds the value of the "quest_id" field.
+	QuestID string `json:"quest_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Counts holds the value of the "counts" field.
+	Counts []int `json:"counts,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt int64 `json:"completed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuestProgress) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questprogress.FieldCounts:
+			values[i] = new([]byte)
+		case questprogress.FieldID, questprogress.FieldCompletedAt, questprogress.FieldCreatedAt, questprogress.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case questprogress.FieldPlayerID, questprogress.FieldQuestID, questprogress.FieldStatus:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuestProgress fields.
+func (qp *QuestProgress) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questprogress.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			qp.ID = int64(value.Int64)
+		case questprogress.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				qp.PlayerID = value.String
+			}
+		case questprogress.FieldQuestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quest_id", values[i])
+			} else if value.Valid {
+				qp.QuestID = value.String
+			}
+		case questprogress.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				qp.Status = value.String
+			}
+		case questprogress.FieldCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qp.Counts); err != nil {
+					return fmt.Errorf("unmarshal field counts: %w", err)
+				}
+			}
+		case questprogress.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				qp.CompletedAt = value.Int64
+			}
+		case questprogress.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				qp.CreatedAt = value.Int64
+			}
+		case questprogress.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				qp.UpdatedAt = value.Int64
+			}
+		default:
+			qp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuestProgress.
+// This includes values selected through modifiers, order, etc.
+func (qp *QuestProgress) Value(name string) (ent.Value, error) {
+	return qp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QuestProgress.
+// Note that you need to call QuestProgress.Unwrap() before calling this method if this QuestProgress
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qp *QuestProgress) Update() *QuestProgressUpdateOne {
+	return NewQuestProgressClient(qp.config).UpdateOne(qp)
+}
+
+// Unwrap unwraps the QuestProgress entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qp *QuestProgress) Unwrap() *QuestProgress {
+	_tx, ok := qp.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: QuestProgress is not a transactional entity")
+	}
+	qp.config.driver = _tx.drv
+	return qp
+}
+
+// String implements the fmt.Stringer.
+func (qp *QuestProgress) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuestProgress(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qp.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(qp.PlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("quest_id=")
+	builder.WriteString(qp.QuestID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(qp.Status)
+	builder.WriteString(", ")
+	builder.WriteString("counts=")
+	builder.WriteString(fmt.Sprintf("%v", qp.Counts))
+	builder.WriteString(", ")
+	builder.WriteString("completed_at=")
+	builder.WriteString(fmt.Sprintf("%v", qp.CompletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", qp.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", qp.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuestProgresses is a parsable slice of QuestProgress.
+type QuestProgresses []*QuestProgress
@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questprogress
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLTE(FieldID, id))
+}
+
+// PlayerID applies equality check predicate on the "player_id" field. It's identical to PlayerIDEQ.
+func PlayerID(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// QuestID applies equality check predicate on the "quest_id" field. It's identical to QuestIDEQ.
+func QuestID(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldQuestID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldStatus, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PlayerIDEQ applies the EQ predicate on the "player_id" field.
+func PlayerIDEQ(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldPlayerID, v))
+}
+
+// PlayerIDNEQ applies the NEQ predicate on the "player_id" field.
+func PlayerIDNEQ(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNEQ(FieldPlayerID, v))
+}
+
+// PlayerIDIn applies the In predicate on the "player_id" field.
+func PlayerIDIn(vs ...string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDNotIn applies the NotIn predicate on the "player_id" field.
+func PlayerIDNotIn(vs ...string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNotIn(FieldPlayerID, vs...))
+}
+
+// PlayerIDGT applies the GT predicate on the "player_id" field.
+func PlayerIDGT(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGT(FieldPlayerID, v))
+}
+
+// PlayerIDGTE applies the GTE predicate on the "player_id" field.
+func PlayerIDGTE(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGTE(FieldPlayerID, v))
+}
+
+// PlayerIDLT applies the LT predicate on the "player_id" field.
+func PlayerIDLT(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLT(FieldPlayerID, v))
+}
+
+// PlayerIDLTE applies the LTE predicate on the "player_id" field.
+func PlayerIDLTE(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLTE(FieldPlayerID, v))
+}
+
+// PlayerIDContains applies the Contains predicate on the "player_id" field.
+func PlayerIDContains(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldContains(FieldPlayerID, v))
+}
+
+// PlayerIDHasPrefix applies the HasPrefix predicate on the "player_id" field.
+func PlayerIDHasPrefix(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldHasPrefix(FieldPlayerID, v))
+}
+
+// PlayerIDHasSuffix applies the HasSuffix predicate on the "player_id" field.
+func PlayerIDHasSuffix(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldHasSuffix(FieldPlayerID, v))
+}
+
+// PlayerIDEqualFold applies the EqualFold predicate on the "player_id" field.
+func PlayerIDEqualFold(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEqualFold(FieldPlayerID, v))
+}
+
+// PlayerIDContainsFold applies the ContainsFold predicate on the "player_id" field.
+func PlayerIDContainsFold(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldContainsFold(FieldPlayerID, v))
+}
+
+// QuestIDEQ applies the EQ predicate on the "quest_id" field.
+func QuestIDEQ(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldQuestID, v))
+}
+
+// QuestIDNEQ applies the NEQ predicate on the "quest_id" field.
+func QuestIDNEQ(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNEQ(FieldQuestID, v))
+}
+
+// QuestIDIn applies the In predicate on the "quest_id" field.
+func QuestIDIn(vs ...string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldIn(FieldQuestID, vs...))
+}
+
+// QuestIDNotIn applies the NotIn predicate on the "quest_id" field.
+func QuestIDNotIn(vs ...string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNotIn(FieldQuestID, vs...))
+}
+
+// QuestIDGT applies the GT predicate on the "quest_id" field.
+func QuestIDGT(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGT(FieldQuestID, v))
+}
+
+// QuestIDGTE applies the GTE predicate on the "quest_id" field.
+func QuestIDGTE(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGTE(FieldQuestID, v))
+}
+
+// QuestIDLT applies the LT predicate on the "quest_id" field.
+func QuestIDLT(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLT(FieldQuestID, v))
+}
+
+// QuestIDLTE applies the LTE predicate on the "quest_id" field.
+func QuestIDLTE(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLTE(FieldQuestID, v))
+}
+
+// QuestIDContains applies the Contains predicate on the "quest_id" field.
+func QuestIDContains(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldContains(FieldQuestID, v))
+}
+
+// QuestIDHasPrefix applies the HasPrefix predicate on the "quest_id" field.
+func QuestIDHasPrefix(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldHasPrefix(FieldQuestID, v))
+}
+
+// QuestIDHasSuffix applies the HasSuffix predicate on the "quest_id" field.
+func QuestIDHasSuffix(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldHasSuffix(FieldQuestID, v))
+}
+
+// QuestIDEqualFold applies the EqualFold predicate on the "quest_id" field.
+func QuestIDEqualFold(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEqualFold(FieldQuestID, v))
+}
+
+// QuestIDContainsFold applies the ContainsFold predicate on the "quest_id" field.
+func QuestIDContainsFold(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldContainsFold(FieldQuestID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuestProgress) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuestProgress) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuestProgress) predicate.QuestProgress {
+	return predicate.QuestProgress(sql.NotPredicates(p))
+}
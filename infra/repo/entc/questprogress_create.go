@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+)
+
+// QuestProgressCreate is the builder for creating a QuestProgress entity.
+type QuestProgressCreate struct {
+	config
+	mutation *QuestProgressMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlayerID sets the "player_id" field.
+func (qpc *QuestProgressCreate) SetPlayerID(s string) *QuestProgressCreate {
+	qpc.mutation.SetPlayerID(s)
+	return qpc
+}
+
+// SetQuestID sets the "quest_id" field.
+func (qpc *QuestProgressCreate) SetQuestID(s string) *QuestProgressCreate {
+	qpc.mutation.SetQuestID(s)
+	return qpc
+}
+
+// SetStatus sets the "status" field.
+func (qpc *QuestProgressCreate) SetStatus(s string) *QuestProgressCreate {
+	qpc.mutation.SetStatus(s)
+	return qpc
+}
+
+// SetCounts sets the "counts" field.
+func (qpc *QuestProgressCreate) SetCounts(i []int) *QuestProgressCreate {
+	qpc.mutation.SetCounts(i)
+	return qpc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (qpc *QuestProgressCreate) SetCompletedAt(i int64) *QuestProgressCreate {
+	qpc.mutation.SetCompletedAt(i)
+	return qpc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (qpc *QuestProgressCreate) SetNillableCompletedAt(i *int64) *QuestProgressCreate {
+	if i != nil {
+		qpc.SetCompletedAt(*i)
+	}
+	return qpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qpc *QuestProgressCreate) SetCreatedAt(i int64) *QuestProgressCreate {
+	qpc.mutation.SetCreatedAt(i)
+	return qpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qpc *QuestProgressCreate) SetNillableCreatedAt(i *int64) *QuestProgressCreate {
+	if i != nil {
+		qpc.SetCreatedAt(*i)
+	}
+	return qpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qpc *QuestProgressCreate) SetUpdatedAt(i int64) *QuestProgressCreate {
+	qpc.mutation.SetUpdatedAt(i)
+	return qpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qpc *QuestProgressCreate) SetNillableUpdatedAt(i *int64) *QuestProgressCreate {
+	if i != nil {
+		qpc.SetUpdatedAt(*i)
+	}
+	return qpc
+}
+
+// SetID sets the "id" field.
+func (qpc *QuestProgressCreate) SetID(i int64) *QuestProgressCreate {
+	qpc.mutation.SetID(i)
+	return qpc
+}
+
+// Mutation returns the QuestProgressMutation object of the builder.
+func (qpc *QuestProgressCreate) Mutation() *QuestProgressMutation {
+	return qpc.mutation
+}
+
+// Save creates the QuestProgress in the database.
+func (qpc *QuestProgressCreate) Save(ctx context.Context) (*QuestProgress, error) {
+	qpc.defaults()
+	return withHooks(ctx, qpc.sqlSave, qpc.mutation, qpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qpc *QuestProgressCreate) SaveX(ctx context.Context) *QuestProgress {
+	v, err := qpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qpc *QuestProgressCreate) Exec(ctx context.Context) error {
+	_, err := qpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qpc *QuestProgressCreate) ExecX(ctx context.Context) {
+	if err := qpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qpc *QuestProgressCreate) defaults() {
+	if _, ok := qpc.mutation.CompletedAt(); !ok {
+		v := questprogress.DefaultCompletedAt
+		qpc.mutation.SetCompletedAt(v)
+	}
+	if _, ok := qpc.mutation.CreatedAt(); !ok {
+		v := questprogress.DefaultCreatedAt()
+		qpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qpc.mutation.UpdatedAt(); !ok {
+		v := questprogress.DefaultUpdatedAt()
+		qpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qpc *QuestProgressCreate) check() error {
+	if _, ok := qpc.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`entc: missing required field "QuestProgress.player_id"`)}
+	}
+	if _, ok := qpc.mutation.QuestID(); !ok {
+		return &ValidationError{Name: "quest_id", err: errors.New(`entc: missing required field "QuestProgress.quest_id"`)}
+	}
+	if _, ok := qpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entc: missing required field "QuestProgress.status"`)}
+	}
+	if _, ok := qpc.mutation.Counts(); !ok {
+		return &ValidationError{Name: "counts", err: errors.New(`entc: missing required field "QuestProgress.counts"`)}
+	}
+	if _, ok := qpc.mutation.CompletedAt(); !ok {
+		return &ValidationError{Name: "completed_at", err: errors.New(`entc: missing required field "QuestProgress.completed_at"`)}
+	}
+	if _, ok := qpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "QuestProgress.created_at"`)}
+	}
+	if _, ok := qpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "QuestProgress.updated_at"`)}
+	}
+	return nil
+}
+
+func (qpc *QuestProgressCreate) sqlSave(ctx context.Context) (*QuestProgress, error) {
+	if err := qpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	qpc.mutation.id = &_node.ID
+	qpc.mutation.done = true
+	return _node, nil
+}
+
+func (qpc *QuestProgressCreate) createSpec() (*QuestProgress, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuestProgress{config: qpc.config}
+		_spec = sqlgraph.NewCreateSpec(questprogress.Table, sqlgraph.NewFieldSpec(questprogress.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = qpc.conflict
+	if id, ok := qpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := qpc.mutation.PlayerID(); ok {
+		_spec.SetField(questprogress.FieldPlayerID, field.TypeString, value)
+		_node.PlayerID = value
+	}
+	if value, ok := qpc.mutation.QuestID(); ok {
+		_spec.SetField(questprogress.FieldQuestID, field.TypeString, value)
+		_node.QuestID = value
+	}
+	if value, ok := qpc.mutation.Status(); ok {
+		_spec.SetField(questprogress.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := qpc.mutation.Counts(); ok {
+		_spec.SetField(questprogress.FieldCounts, field.TypeJSON, value)
+		_node.Counts = value
+	}
+	if value, ok := qpc.mutation.CompletedAt(); ok {
+		_spec.SetField(questprogress.FieldCompletedAt, field.TypeInt64, value)
+		_node.CompletedAt = value
+	}
+	if value, ok := qpc.mutation.CreatedAt(); ok {
+		_spec.SetField(questprogress.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(questprogress.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestProgress.Create().
+//		SetPlayerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestProgressUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (qpc *QuestProgressCreate) OnConflict(opts ...sql.ConflictOption) *QuestProgressUpsertOne {
+	qpc.conflict = opts
+	return &QuestProgressUpsertOne{
+		create: qpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qpc *QuestProgressCreate) OnConflictColumns(columns ...string) *QuestProgressUpsertOne {
+	qpc.conflict = append(qpc.conflict, sql.ConflictColumns(columns...))
+	return &QuestProgressUpsertOne{
+		create: qpc,
+	}
+}
+
+type (
+	// QuestProgressUpsertOne is the builder for "upsert"-ing
+	//  one QuestProgress node.
+	QuestProgressUpsertOne struct {
+		create *QuestProgressCreate
+	}
+
+	// QuestProgressUpsert is the "OnConflict" setter.
+	QuestProgressUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlayerID sets the "player_id" field.
+func (u *QuestProgressUpsert) SetPlayerID(v string) *QuestProgressUpsert {
+	u.Set(questprogress.FieldPlayerID, v)
+	return u
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *QuestProgressUpsert) UpdatePlayerID() *QuestProgressUpsert {
+	u.SetExcluded(questprogress.FieldPlayerID)
+	return u
+}
+
+// SetQuestID sets the "quest_id" field.
+func (u *QuestProgressUpsert) SetQuestID(v string) *QuestProgressUpsert {
+	u.Set(questprogress.FieldQuestID, v)
+	return u
+}
+
+// UpdateQuestID sets the "quest_id" field to the value that was provided on create.
+func (u *QuestProgressUpsert) UpdateQuestID() *QuestProgressUpsert {
+	u.SetExcluded(questprogress.FieldQuestID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestProgressUpsert) SetStatus(v string) *QuestProgressUpsert {
+	u.Set(questprogress.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestProgressUpsert) UpdateStatus() *QuestProgressUpsert {
+	u.SetExcluded(questprogress.FieldStatus)
+	return u
+}
+
+// SetCounts sets the "counts" field.
+func (u *QuestProgressUpsert) SetCounts(v []int) *QuestProgressUpsert {
+	u.Set(questprogress.FieldCounts, v)
+	return u
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *QuestProgressUpsert) UpdateCounts() *QuestProgressUpsert {
+	u.SetExcluded(questprogress.FieldCounts)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QuestProgressUpsert) SetCompletedAt(v int64) *QuestProgressUpsert {
+	u.Set(questprogress.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QuestProgressUpsert) UpdateCompletedAt() *QuestProgressUpsert {
+	u.SetExcluded(questprogress.FieldCompletedAt)
+	return u
+}
+
+// AddCompletedAt adds v to the "completed_at" field.
+func (u *QuestProgressUpsert) AddCompletedAt(v int64) *QuestProgressUpsert {
+	u.Add(questprogress.FieldCompletedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestProgressUpsert) SetUpdatedAt(v int64) *QuestProgressUpsert {
+	u.Set(questprogress.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestProgressUpsert) UpdateUpdatedAt() *QuestProgressUpsert {
+	u.SetExcluded(questprogress.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *QuestProgressUpsert) AddUpdatedAt(v int64) *QuestProgressUpsert {
+	u.Add(questprogress.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.QuestProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questprogress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestProgressUpsertOne) UpdateNewValues() *QuestProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(questprogress.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(questprogress.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestProgress.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestProgressUpsertOne) Ignore() *QuestProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestProgressUpsertOne) DoNothing() *QuestProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestProgressCreate.OnConflict
+// documentation for more info.
+func (u *QuestProgressUpsertOne) Update(set func(*QuestProgressUpsert)) *QuestProgressUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *QuestProgressUpsertOne) SetPlayerID(v string) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *QuestProgressUpsertOne) UpdatePlayerID() *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetQuestID sets the "quest_id" field.
+func (u *QuestProgressUpsertOne) SetQuestID(v string) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetQuestID(v)
+	})
+}
+
+// UpdateQuestID sets the "quest_id" field to the value that was provided on create.
+func (u *QuestProgressUpsertOne) UpdateQuestID() *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateQuestID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestProgressUpsertOne) SetStatus(v string) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestProgressUpsertOne) UpdateStatus() *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCounts sets the "counts" field.
+func (u *QuestProgressUpsertOne) SetCounts(v []int) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetCounts(v)
+	})
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *QuestProgressUpsertOne) UpdateCounts() *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateCounts()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QuestProgressUpsertOne) SetCompletedAt(v int64) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// AddCompletedAt adds v to the "completed_at" field.
+func (u *QuestProgressUpsertOne) AddCompletedAt(v int64) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.AddCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QuestProgressUpsertOne) UpdateCompletedAt() *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestProgressUpsertOne) SetUpdatedAt(v int64) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *QuestProgressUpsertOne) AddUpdatedAt(v int64) *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestProgressUpsertOne) UpdateUpdatedAt() *QuestProgressUpsertOne {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestProgressUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for QuestProgressCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestProgressUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestProgressUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestProgressUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuestProgressCreateBulk is the builder for creating many QuestProgress entities in bulk.
+type QuestProgressCreateBulk struct {
+	config
+	err      error
+	builders []*QuestProgressCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the QuestProgress entities in the database.
+func (qpcb *QuestProgressCreateBulk) Save(ctx context.Context) ([]*QuestProgress, error) {
+	if qpcb.err != nil {
+		return nil, qpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qpcb.builders))
+	nodes := make([]*QuestProgress, len(qpcb.builders))
+	mutators := make([]Mutator, len(qpcb.builders))
+	for i := range qpcb.builders {
+		func(i int, root context.Context) {
+			builder := qpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestProgressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qpcb *QuestProgressCreateBulk) SaveX(ctx context.Context) []*QuestProgress {
+	v, err := qpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qpcb *QuestProgressCreateBulk) Exec(ctx context.Context) error {
+	_, err := qpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qpcb *QuestProgressCreateBulk) ExecX(ctx context.Context) {
+	if err := qpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestProgress.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestProgressUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (qpcb *QuestProgressCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestProgressUpsertBulk {
+	qpcb.conflict = opts
+	return &QuestProgressUpsertBulk{
+		create: qpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestProgress.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qpcb *QuestProgressCreateBulk) OnConflictColumns(columns ...string) *QuestProgressUpsertBulk {
+	qpcb.conflict = append(qpcb.conflict, sql.ConflictColumns(columns...))
+	return &QuestProgressUpsertBulk{
+		create: qpcb,
+	}
+}
+
+// QuestProgressUpsertBulk is the builder for "upsert"-ing
+// a bulk of QuestProgress nodes.
+type QuestProgressUpsertBulk struct {
+	create *QuestProgressCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QuestProgress.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questprogress.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestProgressUpsertBulk) UpdateNewValues() *QuestProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(questprogress.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(questprogress.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestProgress.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestProgressUpsertBulk) Ignore() *QuestProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestProgressUpsertBulk) DoNothing() *QuestProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestProgressCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestProgressUpsertBulk) Update(set func(*QuestProgressUpsert)) *QuestProgressUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestProgressUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *QuestProgressUpsertBulk) SetPlayerID(v string) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *QuestProgressUpsertBulk) UpdatePlayerID() *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetQuestID sets the "quest_id" field.
+func (u *QuestProgressUpsertBulk) SetQuestID(v string) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetQuestID(v)
+	})
+}
+
+// UpdateQuestID sets the "quest_id" field to the value that was provided on create.
+func (u *QuestProgressUpsertBulk) UpdateQuestID() *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateQuestID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestProgressUpsertBulk) SetStatus(v string) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestProgressUpsertBulk) UpdateStatus() *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCounts sets the "counts" field.
+func (u *QuestProgressUpsertBulk) SetCounts(v []int) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetCounts(v)
+	})
+}
+
+// UpdateCounts sets the "counts" field to the value that was provided on create.
+func (u *QuestProgressUpsertBulk) UpdateCounts() *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateCounts()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QuestProgressUpsertBulk) SetCompletedAt(v int64) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// AddCompletedAt adds v to the "completed_at" field.
+func (u *QuestProgressUpsertBulk) AddCompletedAt(v int64) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.AddCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QuestProgressUpsertBulk) UpdateCompletedAt() *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestProgressUpsertBulk) SetUpdatedAt(v int64) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *QuestProgressUpsertBulk) AddUpdatedAt(v int64) *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestProgressUpsertBulk) UpdateUpdatedAt() *QuestProgressUpsertBulk {
+	return u.Update(func(s *QuestProgressUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestProgressUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the QuestProgressCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for QuestProgressCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestProgressUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+)
+
+// QuestProgressDelete is the builder for deleting a QuestProgress entity.
+type QuestProgressDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuestProgressMutation
+}
+
+// Where appends a list predicates to the QuestProgressDelete builder.
+func (qpd *QuestProgressDelete) Where(ps ...predicate.QuestProgress) *QuestProgressDelete {
+	qpd.mutation.Where(ps...)
+	return qpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (qpd *QuestProgressDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, qpd.sqlExec, qpd.mutation, qpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qpd *QuestProgressDelete) ExecX(ctx context.Context) int {
+	n, err := qpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (qpd *QuestProgressDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(questprogress.Table, sqlgraph.NewFieldSpec(questprogress.FieldID, field.TypeInt64))
+	if ps := qpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, qpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	qpd.mutation.done = true
+	return affected, err
+}
+
+// QuestProgressDeleteOne is the builder for deleting a single QuestProgress entity.
+type QuestProgressDeleteOne struct {
+	qpd *QuestProgressDelete
+}
+
+// Where appends a list predicates to the QuestProgressDelete builder.
+func (qpdo *QuestProgressDeleteOne) Where(ps ...predicate.QuestProgress) *QuestProgressDeleteOne {
+	qpdo.qpd.mutation.Where(ps...)
+	return qpdo
+}
+
+// Exec executes the deletion query.
+func (qpdo *QuestProgressDeleteOne) Exec(ctx context.Context) error {
+	n, err := qpdo.qpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{questprogress.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qpdo *QuestProgressDeleteOne) ExecX(ctx context.Context) {
+	if err := qpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+)
+
+// QuestProgressQuery is the builder for querying QuestProgress entities.
+type QuestProgressQuery struct {
+	config
+	ctx        *QueryContext
+	order      []questprogress.OrderOption
+	inters     []Interceptor
+	predicates []predicate.QuestProgress
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuestProgressQuery builder.
+func (qpq *QuestProgressQuery) Where(ps ...predicate.QuestProgress) *QuestProgressQuery {
+	qpq.predicates = append(qpq.predicates, ps...)
+	return qpq
+}
+
+// Limit the number of records to be returned by this query.
+func (qpq *QuestProgressQuery) Limit(limit int) *QuestProgressQuery {
+	qpq.ctx.Limit = &limit
+	return qpq
+}
+
+// Offset to start from.
+func (qpq *QuestProgressQuery) Offset(offset int) *QuestProgressQuery {
+	qpq.ctx.Offset = &offset
+	return qpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qpq *QuestProgressQuery) Unique(unique bool) *QuestProgressQuery {
+	qpq.ctx.Unique = &unique
+	return qpq
+}
+
+// Order specifies how the records should be ordered.
+func (qpq *QuestProgressQuery) Order(o ...questprogress.OrderOption) *QuestProgressQuery {
+	qpq.order = append(qpq.order, o...)
+	return qpq
+}
+
+// First returns the first QuestProgress entity from the query.
+// Returns a *NotFoundError when no QuestProgress was found.
+func (qpq *QuestProgressQuery) First(ctx context.Context) (*QuestProgress, error) {
+	nodes, err := qpq.Limit(1).All(setContextOp(ctx, qpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{questprogress.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qpq *QuestProgressQuery) FirstX(ctx context.Context) *QuestProgress {
+	node, err := qpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuestProgress ID from the query.
+// Returns a *NotFoundError when no QuestProgress ID was found.
+func (qpq *QuestProgressQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = qpq.Limit(1).IDs(setContextOp(ctx, qpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{questprogress.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qpq *QuestProgressQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := qpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuestProgress entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuestProgress entity is found.
+// Returns a *NotFoundError when no QuestProgress entities are found.
+func (qpq *QuestProgressQuery) Only(ctx context.Context) (*QuestProgress, error) {
+	nodes, err := qpq.Limit(2).All(setContextOp(ctx, qpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{questprogress.Label}
+	default:
+		return nil, &NotSingularError{questprogress.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qpq *QuestProgressQuery) OnlyX(ctx context.Context) *QuestProgress {
+	node, err := qpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuestProgress ID in the query.
+// Returns a *NotSingularError when more than one QuestProgress ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qpq *QuestProgressQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = qpq.Limit(2).IDs(setContextOp(ctx, qpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{questprogress.Label}
+	default:
+		err = &NotSingularError{questprogress.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qpq *QuestProgressQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := qpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuestProgresses.
+func (qpq *QuestProgressQuery) All(ctx context.Context) ([]*QuestProgress, error) {
+	ctx = setContextOp(ctx, qpq.ctx, ent.OpQueryAll)
+	if err := qpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuestProgress, *QuestProgressQuery]()
+	return withInterceptors[[]*QuestProgress](ctx, qpq, qr, qpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qpq *QuestProgressQuery) AllX(ctx context.Context) []*QuestProgress {
+	nodes, err := qpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuestProgress IDs.
+func (qpq *QuestProgressQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if qpq.ctx.Unique == nil && qpq.path != nil {
+		qpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qpq.ctx, ent.OpQueryIDs)
+	if err = qpq.Select(questprogress.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qpq *QuestProgressQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := qpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qpq *QuestProgressQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qpq.ctx, ent.OpQueryCount)
+	if err := qpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qpq, querierCount[*QuestProgressQuery](), qpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qpq *QuestProgressQuery) CountX(ctx context.Context) int {
+	count, err := qpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qpq *QuestProgressQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qpq.ctx, ent.OpQueryExist)
+	switch _, err := qpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entc: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qpq *QuestProgressQuery) ExistX(ctx context.Context) bool {
+	exist, err := qpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuestProgressQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qpq *QuestProgressQuery) Clone() *QuestProgressQuery {
+	if qpq == nil {
+		return nil
+	}
+	return &QuestProgressQuery{
+		config:     qpq.config,
+		ctx:        qpq.ctx.Clone(),
+		order:      append([]questprogress.OrderOption{}, qpq.order...),
+		inters:     append([]Interceptor{}, qpq.inters...),
+		predicates: append([]predicate.QuestProgress{}, qpq.predicates...),
+		// clone intermediate query.
+		sql:       qpq.sql.Clone(),
+		path:      qpq.path,
+		modifiers: append([]func(*sql.Selector){}, qpq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuestProgress.Query().
+//		GroupBy(questprogress.FieldPlayerID).
+//		Aggregate(entc.Count()).
+//		Scan(ctx, &v)
+func (qpq *QuestProgressQuery) GroupBy(field string, fields ...string) *QuestProgressGroupBy {
+	qpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuestProgressGroupBy{build: qpq}
+	grbuild.flds = &qpq.ctx.Fields
+	grbuild.label = questprogress.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PlayerID string `json:"player_id,omitempty"`
+//	}
+//
+//	client.QuestProgress.Query().
+//		Select(questprogress.FieldPlayerID).
+//		Scan(ctx, &v)
+func (qpq *QuestProgressQuery) Select(fields ...string) *QuestProgressSelect {
+	qpq.ctx.Fields = append(qpq.ctx.Fields, fields...)
+	sbuild := &QuestProgressSelect{QuestProgressQuery: qpq}
+	sbuild.label = questprogress.Label
+	sbuild.flds, sbuild.scan = &qpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuestProgressSelect configured with the given aggregations.
+func (qpq *QuestProgressQuery) Aggregate(fns ...AggregateFunc) *QuestProgressSelect {
+	return qpq.Select().Aggregate(fns...)
+}
+
+func (qpq *QuestProgressQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qpq.inters {
+		if inter == nil {
+			return fmt.Errorf("entc: uninitialized interceptor (forgotten import entc/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qpq.ctx.Fields {
+		if !questprogress.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+		}
+	}
+	if qpq.path != nil {
+		prev, err := qpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qpq.sql = prev
+	}
+	return nil
+}
+
+func (qpq *QuestProgressQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuestProgress, error) {
+	var (
+		nodes = []*QuestProgress{}
+		_spec = qpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuestProgress).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuestProgress{config: qpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(qpq.modifiers) > 0 {
+		_spec.Modifiers = qpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qpq *QuestProgressQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qpq.querySpec()
+	if len(qpq.modifiers) > 0 {
+		_spec.Modifiers = qpq.modifiers
+	}
+	_spec.Node.Columns = qpq.ctx.Fields
+	if len(qpq.ctx.Fields) > 0 {
+		_spec.Unique = qpq.ctx.Unique != nil && *qpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qpq.driver, _spec)
+}
+
+func (qpq *QuestProgressQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(questprogress.Table, questprogress.Columns, sqlgraph.NewFieldSpec(questprogress.FieldID, field.TypeInt64))
+	_spec.From = qpq.sql
+	if unique := qpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questprogress.FieldID)
+		for i := range fields {
+			if fields[i] != questprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qpq *QuestProgressQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qpq.driver.Dialect())
+	t1 := builder.Table(questprogress.Table)
+	columns := qpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = questprogress.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qpq.sql != nil {
+		selector = qpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qpq.ctx.Unique != nil && *qpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range qpq.modifiers {
+		m(selector)
+	}
+	for _, p := range qpq.predicates {
+		p(selector)
+	}
+	for _, p := range qpq.order {
+		p(selector)
+	}
+	if offset := qpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qpq *QuestProgressQuery) Modify(modifiers ...func(s *sql.Selector)) *QuestProgressSelect {
+	qpq.modifiers = append(qpq.modifiers, modifiers...)
+	return qpq.Select()
+}
+
+// QuestProgressGroupBy is the group-by builder for QuestProgress entities.
+type QuestProgressGroupBy struct {
+	selector
+	build *QuestProgressQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qpgb *QuestProgressGroupBy) Aggregate(fns ...AggregateFunc) *QuestProgressGroupBy {
+	qpgb.fns = append(qpgb.fns, fns...)
+	return qpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qpgb *QuestProgressGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestProgressQuery, *QuestProgressGroupBy](ctx, qpgb.build, qpgb, qpgb.build.inters, v)
+}
+
+func (qpgb *QuestProgressGroupBy) sqlScan(ctx context.Context, root *QuestProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qpgb.fns))
+	for _, fn := range qpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qpgb.flds)+len(qpgb.fns))
+		for _, f := range *qpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuestProgressSelect is the builder for selecting fields of QuestProgress entities.
+type QuestProgressSelect struct {
+	*QuestProgressQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qps *QuestProgressSelect) Aggregate(fns ...AggregateFunc) *QuestProgressSelect {
+	qps.fns = append(qps.fns, fns...)
+	return qps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qps *QuestProgressSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qps.ctx, ent.OpQuerySelect)
+	if err := qps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestProgressQuery, *QuestProgressSelect](ctx, qps.QuestProgressQuery, qps, qps.inters, v)
+}
+
+func (qps *QuestProgressSelect) sqlScan(ctx context.Context, root *QuestProgressQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qps.fns))
+	for _, fn := range qps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qps *QuestProgressSelect) Modify(modifiers ...func(s *sql.Selector)) *QuestProgressSelect {
+	qps.modifiers = append(qps.modifiers, modifiers...)
+	return qps
+}
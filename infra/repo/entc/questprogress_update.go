@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+)
+
+// QuestProgressUpdate is the builder for updating QuestProgress entities.
+type QuestProgressUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QuestProgressMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QuestProgressUpdate builder.
+func (qpu *QuestProgressUpdate) Where(ps ...predicate.QuestProgress) *QuestProgressUpdate {
+	qpu.mutation.Where(ps...)
+	return qpu
+}
+
+// SetPlayerID sets the "player_id" field.
+func (qpu *QuestProgressUpdate) SetPlayerID(s string) *QuestProgressUpdate {
+	qpu.mutation.SetPlayerID(s)
+	return qpu
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (qpu *QuestProgressUpdate) SetNillablePlayerID(s *string) *QuestProgressUpdate {
+	if s != nil {
+		qpu.SetPlayerID(*s)
+	}
+	return qpu
+}
+
+// SetQuestID sets the "quest_id" field.
+func (qpu *QuestProgressUpdate) SetQuestID(s string) *QuestProgressUpdate {
+	qpu.mutation.SetQuestID(s)
+	return qpu
+}
+
+// SetNillableQuestID sets the "quest_id" field if the given value is not nil.
+func (qpu *QuestProgressUpdate) SetNillableQuestID(s *string) *QuestProgressUpdate {
+	if s != nil {
+		qpu.SetQuestID(*s)
+	}
+	return qpu
+}
+
+// SetStatus sets the "status" field.
+func (qpu *QuestProgressUpdate) SetStatus(s string) *QuestProgressUpdate {
+	qpu.mutation.SetStatus(s)
+	return qpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qpu *QuestProgressUpdate) SetNillableStatus(s *string) *QuestProgressUpdate {
+	if s != nil {
+		qpu.SetStatus(*s)
+	}
+	return qpu
+}
+
+// SetCounts sets the "counts" field.
+func (qpu *QuestProgressUpdate) SetCounts(i []int) *QuestProgressUpdate {
+	qpu.mutation.SetCounts(i)
+	return qpu
+}
+
+// AppendCounts appends i to the "counts" field.
+func (qpu *QuestProgressUpdate) AppendCounts(i []int) *QuestProgressUpdate {
+	qpu.mutation.AppendCounts(i)
+	return qpu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (qpu *QuestProgressUpdate) SetCompletedAt(i int64) *QuestProgressUpdate {
+	qpu.mutation.ResetCompletedAt()
+	qpu.mutation.SetCompletedAt(i)
+	return qpu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (qpu *QuestProgressUpdate) SetNillableCompletedAt(i *int64) *QuestProgressUpdate {
+	if i != nil {
+		qpu.SetCompletedAt(*i)
+	}
+	return qpu
+}
+
+// AddCompletedAt adds i to the "completed_at" field.
+func (qpu *QuestProgressUpdate) AddCompletedAt(i int64) *QuestProgressUpdate {
+	qpu.mutation.AddCompletedAt(i)
+	return qpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qpu *QuestProgressUpdate) SetUpdatedAt(i int64) *QuestProgressUpdate {
+	qpu.mutation.ResetUpdatedAt()
+	qpu.mutation.SetUpdatedAt(i)
+	return qpu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (qpu *QuestProgressUpdate) AddUpdatedAt(i int64) *QuestProgressUpdate {
+	qpu.mutation.AddUpdatedAt(i)
+	return qpu
+}
+
+// Mutation returns the QuestProgressMutation object of the builder.
+func (qpu *QuestProgressUpdate) Mutation() *QuestProgressMutation {
+	return qpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qpu *QuestProgressUpdate) Save(ctx context.Context) (int, error) {
+	qpu.defaults()
+	return withHooks(ctx, qpu.sqlSave, qpu.mutation, qpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qpu *QuestProgressUpdate) SaveX(ctx context.Context) int {
+	affected, err := qpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qpu *QuestProgressUpdate) Exec(ctx context.Context) error {
+	_, err := qpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qpu *QuestProgressUpdate) ExecX(ctx context.Context) {
+	if err := qpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qpu *QuestProgressUpdate) defaults() {
+	if _, ok := qpu.mutation.UpdatedAt(); !ok {
+		v := questprogress.UpdateDefaultUpdatedAt()
+		qpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qpu *QuestProgressUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestProgressUpdate {
+	qpu.modifiers = append(qpu.modifiers, modifiers...)
+	return qpu
+}
+
+func (qpu *QuestProgressUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(questprogress.Table, questprogress.Columns, sqlgraph.NewFieldSpec(questprogress.FieldID, field.TypeInt64))
+	if ps := qpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qpu.mutation.PlayerID(); ok {
+		_spec.SetField(questprogress.FieldPlayerID, field.TypeString, value)
+	}
+	if value, ok := qpu.mutation.QuestID(); ok {
+		_spec.SetField(questprogress.FieldQuestID, field.TypeString, value)
+	}
+	if value, ok := qpu.mutation.Status(); ok {
+		_spec.SetField(questprogress.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qpu.mutation.Counts(); ok {
+		_spec.SetField(questprogress.FieldCounts, field.TypeJSON, value)
+	}
+	if value, ok := qpu.mutation.AppendedCounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, questprogress.FieldCounts, value)
+		})
+	}
+	if value, ok := qpu.mutation.CompletedAt(); ok {
+		_spec.SetField(questprogress.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if value, ok := qpu.mutation.AddedCompletedAt(); ok {
+		_spec.AddField(questprogress.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if value, ok := qpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(questprogress.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := qpu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(questprogress.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(qpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, qpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qpu.mutation.done = true
+	return n, nil
+}
+
+// QuestProgressUpdateOne is the builder for updating a single QuestProgress entity.
+type QuestProgressUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QuestProgressMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPlayerID sets the "player_id" field.
+func (qpuo *QuestProgressUpdateOne) SetPlayerID(s string) *QuestProgressUpdateOne {
+	qpuo.mutation.SetPlayerID(s)
+	return qpuo
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (qpuo *QuestProgressUpdateOne) SetNillablePlayerID(s *string) *QuestProgressUpdateOne {
+	if s != nil {
+		qpuo.SetPlayerID(*s)
+	}
+	return qpuo
+}
+
+// SetQuestID sets the "quest_id" field.
+func (qpuo *QuestProgressUpdateOne) SetQuestID(s string) *QuestProgressUpdateOne {
+	qpuo.mutation.SetQuestID(s)
+	return qpuo
+}
+
+// SetNillableQuestID sets the "quest_id" field if the given value is not nil.
+func (qpuo *QuestProgressUpdateOne) SetNillableQuestID(s *string) *QuestProgressUpdateOne {
+	if s != nil {
+		qpuo.SetQuestID(*s)
+	}
+	return qpuo
+}
+
+// SetStatus sets the "status" field.
+func (qpuo *QuestProgressUpdateOne) SetStatus(s string) *QuestProgressUpdateOne {
+	qpuo.mutation.SetStatus(s)
+	return qpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qpuo *QuestProgressUpdateOne) SetNillableStatus(s *string) *QuestProgressUpdateOne {
+	if s != nil {
+		qpuo.SetStatus(*s)
+	}
+	return qpuo
+}
+
+// SetCounts sets the "counts" field.
+func (qpuo *QuestProgressUpdateOne) SetCounts(i []int) *QuestProgressUpdateOne {
+	qpuo.mutation.SetCounts(i)
+	return qpuo
+}
+
+// AppendCounts appends i to the "counts" field.
+func (qpuo *QuestProgressUpdateOne) AppendCounts(i []int) *QuestProgressUpdateOne {
+	qpuo.mutation.AppendCounts(i)
+	return qpuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (qpuo *QuestProgressUpdateOne) SetCompletedAt(i int64) *QuestProgressUpdateOne {
+	qpuo.mutation.ResetCompletedAt()
+	qpuo.mutation.SetCompletedAt(i)
+	return qpuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (qpuo *QuestProgressUpdateOne) SetNillableCompletedAt(i *int64) *QuestProgressUpdateOne {
+	if i != nil {
+		qpuo.SetCompletedAt(*i)
+	}
+	return qpuo
+}
+
+// AddCompletedAt adds i to the "completed_at" field.
+func (qpuo *QuestProgressUpdateOne) AddCompletedAt(i int64) *QuestProgressUpdateOne {
+	qpuo.mutation.AddCompletedAt(i)
+	return qpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qpuo *QuestProgressUpdateOne) SetUpdatedAt(i int64) *QuestProgressUpdateOne {
+	qpuo.mutation.ResetUpdatedAt()
+	qpuo.mutation.SetUpdatedAt(i)
+	return qpuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (qpuo *QuestProgressUpdateOne) AddUpdatedAt(i int64) *QuestProgressUpdateOne {
+	qpuo.mutation.AddUpdatedAt(i)
+	return qpuo
+}
+
+// Mutation returns the QuestProgressMutation object of the builder.
+func (qpuo *QuestProgressUpdateOne) Mutation() *QuestProgressMutation {
+	return qpuo.mutation
+}
+
+// Where appends a list predicates to the QuestProgressUpdate builder.
+func (qpuo *QuestProgressUpdateOne) Where(ps ...predicate.QuestProgress) *QuestProgressUpdateOne {
+	qpuo.mutation.Where(ps...)
+	return qpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qpuo *QuestProgressUpdateOne) Select(field string, fields ...string) *QuestProgressUpdateOne {
+	qpuo.fields = append([]string{field}, fields...)
+	return qpuo
+}
+
+// Save executes the query and returns the updated QuestProgress entity.
+func (qpuo *QuestProgressUpdateOne) Save(ctx context.Context) (*QuestProgress, error) {
+	qpuo.defaults()
+	return withHooks(ctx, qpuo.sqlSave, qpuo.mutation, qpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qpuo *QuestProgressUpdateOne) SaveX(ctx context.Context) *QuestProgress {
+	node, err := qpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qpuo *QuestProgressUpdateOne) Exec(ctx context.Context) error {
+	_, err := qpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qpuo *QuestProgressUpdateOne) ExecX(ctx context.Context) {
+	if err := qpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qpuo *QuestProgressUpdateOne) defaults() {
+	if _, ok := qpuo.mutation.UpdatedAt(); !ok {
+		v := questprogress.UpdateDefaultUpdatedAt()
+		qpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qpuo *QuestProgressUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuestProgressUpdateOne {
+	qpuo.modifiers = append(qpuo.modifiers, modifiers...)
+	return qpuo
+}
+
+func (qpuo *QuestProgressUpdateOne) sqlSave(ctx context.Context) (_node *QuestProgress, err error) {
+	_spec := sqlgraph.NewUpdateSpec(questprogress.Table, questprogress.Columns, sqlgraph.NewFieldSpec(questprogress.FieldID, field.TypeInt64))
+	id, ok := qpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "QuestProgress.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questprogress.FieldID)
+		for _, f := range fields {
+			if !questprogress.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != questprogress.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qpuo.mutation.PlayerID(); ok {
+		_spec.SetField(questprogress.FieldPlayerID, field.TypeString, value)
+	}
+	if value, ok := qpuo.mutation.QuestID(); ok {
+		_spec.SetField(questprogress.FieldQuestID, field.TypeString, value)
+	}
+	if value, ok := qpuo.mutation.Status(); ok {
+		_spec.SetField(questprogress.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := qpuo.mutation.Counts(); ok {
+		_spec.SetField(questprogress.FieldCounts, field.TypeJSON, value)
+	}
+	if value, ok := qpuo.mutation.AppendedCounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, questprogress.FieldCounts, value)
+		})
+	}
+	if value, ok := qpuo.mutation.CompletedAt(); ok {
+		_spec.SetField(questprogress.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if value, ok := qpuo.mutation.AddedCompletedAt(); ok {
+		_spec.AddField(questprogress.FieldCompletedAt, field.TypeInt64, value)
+	}
+	if value, ok := qpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(questprogress.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := qpuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(questprogress.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(qpuo.modifiers...)
+	_node = &QuestProgress{config: qpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questprogress.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qpuo.mutation.done = true
+	return _node, nil
+}
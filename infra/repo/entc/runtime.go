@@ -4,13 +4,109 @@ package entc
 
 import (
 	"github.com/nhatquangsin/game-service/domain/schema"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
 	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboard"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboardsnapshot"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/outboxevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhookdelivery"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhooksubscription"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	catalogsnapshotFields := schema.CatalogSnapshot{}.Fields()
+	_ = catalogsnapshotFields
+	// catalogsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	catalogsnapshotDescCreatedAt := catalogsnapshotFields[3].Descriptor()
+	// catalogsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	catalogsnapshot.DefaultCreatedAt = catalogsnapshotDescCreatedAt.Default.(func() int64)
+	catalogversionFields := schema.CatalogVersion{}.Fields()
+	_ = catalogversionFields
+	// catalogversionDescChangesetID is the schema descriptor for changeset_id field.
+	catalogversionDescChangesetID := catalogversionFields[1].Descriptor()
+	// catalogversion.DefaultChangesetID holds the default value on creation for the changeset_id field.
+	catalogversion.DefaultChangesetID = catalogversionDescChangesetID.Default.(int64)
+	// catalogversionDescPublishedBy is the schema descriptor for published_by field.
+	catalogversionDescPublishedBy := catalogversionFields[2].Descriptor()
+	// catalogversion.DefaultPublishedBy holds the default value on creation for the published_by field.
+	catalogversion.DefaultPublishedBy = catalogversionDescPublishedBy.Default.(string)
+	// catalogversionDescCreatedAt is the schema descriptor for created_at field.
+	catalogversionDescCreatedAt := catalogversionFields[3].Descriptor()
+	// catalogversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	catalogversion.DefaultCreatedAt = catalogversionDescCreatedAt.Default.(func() int64)
+	changesetFields := schema.Changeset{}.Fields()
+	_ = changesetFields
+	// changesetDescReviewer is the schema descriptor for reviewer field.
+	changesetDescReviewer := changesetFields[5].Descriptor()
+	// changeset.DefaultReviewer holds the default value on creation for the reviewer field.
+	changeset.DefaultReviewer = changesetDescReviewer.Default.(string)
+	// changesetDescCatalogVersion is the schema descriptor for catalog_version field.
+	changesetDescCatalogVersion := changesetFields[6].Descriptor()
+	// changeset.DefaultCatalogVersion holds the default value on creation for the catalog_version field.
+	changeset.DefaultCatalogVersion = changesetDescCatalogVersion.Default.(int64)
+	// changesetDescCreatedAt is the schema descriptor for created_at field.
+	changesetDescCreatedAt := changesetFields[7].Descriptor()
+	// changeset.DefaultCreatedAt holds the default value on creation for the created_at field.
+	changeset.DefaultCreatedAt = changesetDescCreatedAt.Default.(func() int64)
+	// changesetDescUpdatedAt is the schema descriptor for updated_at field.
+	changesetDescUpdatedAt := changesetFields[8].Descriptor()
+	// changeset.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	changeset.DefaultUpdatedAt = changesetDescUpdatedAt.Default.(func() int64)
+	// changeset.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	changeset.UpdateDefaultUpdatedAt = changesetDescUpdatedAt.UpdateDefault.(func() int64)
+	changesetchangeFields := schema.ChangesetChange{}.Fields()
+	_ = changesetchangeFields
+	// changesetchangeDescName is the schema descriptor for name field.
+	changesetchangeDescName := changesetchangeFields[3].Descriptor()
+	// changesetchange.DefaultName holds the default value on creation for the name field.
+	changesetchange.DefaultName = changesetchangeDescName.Default.(string)
+	// changesetchangeDescCategory is the schema descriptor for category field.
+	changesetchangeDescCategory := changesetchangeFields[4].Descriptor()
+	// changesetchange.DefaultCategory holds the default value on creation for the category field.
+	changesetchange.DefaultCategory = changesetchangeDescCategory.Default.(string)
+	// changesetchangeDescDescription is the schema descriptor for description field.
+	changesetchangeDescDescription := changesetchangeFields[5].Descriptor()
+	// changesetchange.DefaultDescription holds the default value on creation for the description field.
+	changesetchange.DefaultDescription = changesetchangeDescDescription.Default.(string)
+	inventoryitemFields := schema.InventoryItem{}.Fields()
+	_ = inventoryitemFields
+	// inventoryitemDescQuantity is the schema descriptor for quantity field.
+	inventoryitemDescQuantity := inventoryitemFields[3].Descriptor()
+	// inventoryitem.DefaultQuantity holds the default value on creation for the quantity field.
+	inventoryitem.DefaultQuantity = inventoryitemDescQuantity.Default.(int)
+	// inventoryitemDescCreatedAt is the schema descriptor for created_at field.
+	inventoryitemDescCreatedAt := inventoryitemFields[4].Descriptor()
+	// inventoryitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inventoryitem.DefaultCreatedAt = inventoryitemDescCreatedAt.Default.(func() int64)
+	// inventoryitemDescUpdatedAt is the schema descriptor for updated_at field.
+	inventoryitemDescUpdatedAt := inventoryitemFields[5].Descriptor()
+	// inventoryitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	inventoryitem.DefaultUpdatedAt = inventoryitemDescUpdatedAt.Default.(func() int64)
+	// inventoryitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	inventoryitem.UpdateDefaultUpdatedAt = inventoryitemDescUpdatedAt.UpdateDefault.(func() int64)
 	itemFields := schema.Item{}.Fields()
 	_ = itemFields
 	// itemDescCreatedAt is the schema descriptor for created_at field.
@@ -23,4 +119,396 @@ func init() {
 	item.DefaultUpdatedAt = itemDescUpdatedAt.Default.(func() int64)
 	// item.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	item.UpdateDefaultUpdatedAt = itemDescUpdatedAt.UpdateDefault.(func() int64)
+	iteminstanceFields := schema.ItemInstance{}.Fields()
+	_ = iteminstanceFields
+	// iteminstanceDescDurability is the schema descriptor for durability field.
+	iteminstanceDescDurability := iteminstanceFields[3].Descriptor()
+	// iteminstance.DefaultDurability holds the default value on creation for the durability field.
+	iteminstance.DefaultDurability = iteminstanceDescDurability.Default.(int)
+	// iteminstanceDescMaxDurability is the schema descriptor for max_durability field.
+	iteminstanceDescMaxDurability := iteminstanceFields[4].Descriptor()
+	// iteminstance.DefaultMaxDurability holds the default value on creation for the max_durability field.
+	iteminstance.DefaultMaxDurability = iteminstanceDescMaxDurability.Default.(int)
+	// iteminstanceDescStatus is the schema descriptor for status field.
+	iteminstanceDescStatus := iteminstanceFields[6].Descriptor()
+	// iteminstance.DefaultStatus holds the default value on creation for the status field.
+	iteminstance.DefaultStatus = iteminstanceDescStatus.Default.(string)
+	// iteminstanceDescBurnedAt is the schema descriptor for burned_at field.
+	iteminstanceDescBurnedAt := iteminstanceFields[7].Descriptor()
+	// iteminstance.DefaultBurnedAt holds the default value on creation for the burned_at field.
+	iteminstance.DefaultBurnedAt = iteminstanceDescBurnedAt.Default.(int64)
+	// iteminstanceDescCreatedAt is the schema descriptor for created_at field.
+	iteminstanceDescCreatedAt := iteminstanceFields[8].Descriptor()
+	// iteminstance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	iteminstance.DefaultCreatedAt = iteminstanceDescCreatedAt.Default.(func() int64)
+	// iteminstanceDescUpdatedAt is the schema descriptor for updated_at field.
+	iteminstanceDescUpdatedAt := iteminstanceFields[9].Descriptor()
+	// iteminstance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	iteminstance.DefaultUpdatedAt = iteminstanceDescUpdatedAt.Default.(func() int64)
+	// iteminstance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	iteminstance.UpdateDefaultUpdatedAt = iteminstanceDescUpdatedAt.UpdateDefault.(func() int64)
+	// iteminstanceDescID is the schema descriptor for id field.
+	iteminstanceDescID := iteminstanceFields[0].Descriptor()
+	// iteminstance.DefaultID holds the default value on creation for the id field.
+	iteminstance.DefaultID = iteminstanceDescID.Default.(func() string)
+	iteminstancehistoryFields := schema.ItemInstanceHistory{}.Fields()
+	_ = iteminstancehistoryFields
+	// iteminstancehistoryDescFromPlayerID is the schema descriptor for from_player_id field.
+	iteminstancehistoryDescFromPlayerID := iteminstancehistoryFields[3].Descriptor()
+	// iteminstancehistory.DefaultFromPlayerID holds the default value on creation for the from_player_id field.
+	iteminstancehistory.DefaultFromPlayerID = iteminstancehistoryDescFromPlayerID.Default.(string)
+	// iteminstancehistoryDescToPlayerID is the schema descriptor for to_player_id field.
+	iteminstancehistoryDescToPlayerID := iteminstancehistoryFields[4].Descriptor()
+	// iteminstancehistory.DefaultToPlayerID holds the default value on creation for the to_player_id field.
+	iteminstancehistory.DefaultToPlayerID = iteminstancehistoryDescToPlayerID.Default.(string)
+	// iteminstancehistoryDescReason is the schema descriptor for reason field.
+	iteminstancehistoryDescReason := iteminstancehistoryFields[6].Descriptor()
+	// iteminstancehistory.DefaultReason holds the default value on creation for the reason field.
+	iteminstancehistory.DefaultReason = iteminstancehistoryDescReason.Default.(string)
+	// iteminstancehistoryDescCreatedAt is the schema descriptor for created_at field.
+	iteminstancehistoryDescCreatedAt := iteminstancehistoryFields[7].Descriptor()
+	// iteminstancehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	iteminstancehistory.DefaultCreatedAt = iteminstancehistoryDescCreatedAt.Default.(func() int64)
+	itemtranslationFields := schema.ItemTranslation{}.Fields()
+	_ = itemtranslationFields
+	// itemtranslationDescCreatedAt is the schema descriptor for created_at field.
+	itemtranslationDescCreatedAt := itemtranslationFields[4].Descriptor()
+	// itemtranslation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemtranslation.DefaultCreatedAt = itemtranslationDescCreatedAt.Default.(func() int64)
+	// itemtranslationDescUpdatedAt is the schema descriptor for updated_at field.
+	itemtranslationDescUpdatedAt := itemtranslationFields[5].Descriptor()
+	// itemtranslation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	itemtranslation.DefaultUpdatedAt = itemtranslationDescUpdatedAt.Default.(func() int64)
+	// itemtranslation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	itemtranslation.UpdateDefaultUpdatedAt = itemtranslationDescUpdatedAt.UpdateDefault.(func() int64)
+	jobrunFields := schema.JobRun{}.Fields()
+	_ = jobrunFields
+	// jobrunDescTaskID is the schema descriptor for task_id field.
+	jobrunDescTaskID := jobrunFields[3].Descriptor()
+	// jobrun.DefaultTaskID holds the default value on creation for the task_id field.
+	jobrun.DefaultTaskID = jobrunDescTaskID.Default.(int64)
+	// jobrunDescScheduledAt is the schema descriptor for scheduled_at field.
+	jobrunDescScheduledAt := jobrunFields[6].Descriptor()
+	// jobrun.DefaultScheduledAt holds the default value on creation for the scheduled_at field.
+	jobrun.DefaultScheduledAt = jobrunDescScheduledAt.Default.(int64)
+	// jobrunDescFinishedAt is the schema descriptor for finished_at field.
+	jobrunDescFinishedAt := jobrunFields[8].Descriptor()
+	// jobrun.DefaultFinishedAt holds the default value on creation for the finished_at field.
+	jobrun.DefaultFinishedAt = jobrunDescFinishedAt.Default.(int64)
+	// jobrunDescDurationMs is the schema descriptor for duration_ms field.
+	jobrunDescDurationMs := jobrunFields[9].Descriptor()
+	// jobrun.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	jobrun.DefaultDurationMs = jobrunDescDurationMs.Default.(int64)
+	// jobrunDescError is the schema descriptor for error field.
+	jobrunDescError := jobrunFields[10].Descriptor()
+	// jobrun.DefaultError holds the default value on creation for the error field.
+	jobrun.DefaultError = jobrunDescError.Default.(string)
+	jobtaskFields := schema.JobTask{}.Fields()
+	_ = jobtaskFields
+	// jobtaskDescAttempts is the schema descriptor for attempts field.
+	jobtaskDescAttempts := jobtaskFields[4].Descriptor()
+	// jobtask.DefaultAttempts holds the default value on creation for the attempts field.
+	jobtask.DefaultAttempts = jobtaskDescAttempts.Default.(int)
+	// jobtaskDescLastError is the schema descriptor for last_error field.
+	jobtaskDescLastError := jobtaskFields[6].Descriptor()
+	// jobtask.DefaultLastError holds the default value on creation for the last_error field.
+	jobtask.DefaultLastError = jobtaskDescLastError.Default.(string)
+	// jobtaskDescCreatedAt is the schema descriptor for created_at field.
+	jobtaskDescCreatedAt := jobtaskFields[7].Descriptor()
+	// jobtask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	jobtask.DefaultCreatedAt = jobtaskDescCreatedAt.Default.(func() int64)
+	// jobtaskDescUpdatedAt is the schema descriptor for updated_at field.
+	jobtaskDescUpdatedAt := jobtaskFields[8].Descriptor()
+	// jobtask.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	jobtask.DefaultUpdatedAt = jobtaskDescUpdatedAt.Default.(func() int64)
+	// jobtask.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	jobtask.UpdateDefaultUpdatedAt = jobtaskDescUpdatedAt.UpdateDefault.(func() int64)
+	leaderboardFields := schema.Leaderboard{}.Fields()
+	_ = leaderboardFields
+	// leaderboardDescPolicy is the schema descriptor for policy field.
+	leaderboardDescPolicy := leaderboardFields[3].Descriptor()
+	// leaderboard.DefaultPolicy holds the default value on creation for the policy field.
+	leaderboard.DefaultPolicy = leaderboardDescPolicy.Default.(string)
+	// leaderboardDescTimezone is the schema descriptor for timezone field.
+	leaderboardDescTimezone := leaderboardFields[5].Descriptor()
+	// leaderboard.DefaultTimezone holds the default value on creation for the timezone field.
+	leaderboard.DefaultTimezone = leaderboardDescTimezone.Default.(string)
+	// leaderboardDescCreatedBy is the schema descriptor for created_by field.
+	leaderboardDescCreatedBy := leaderboardFields[6].Descriptor()
+	// leaderboard.DefaultCreatedBy holds the default value on creation for the created_by field.
+	leaderboard.DefaultCreatedBy = leaderboardDescCreatedBy.Default.(string)
+	// leaderboardDescCreatedAt is the schema descriptor for created_at field.
+	leaderboardDescCreatedAt := leaderboardFields[7].Descriptor()
+	// leaderboard.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leaderboard.DefaultCreatedAt = leaderboardDescCreatedAt.Default.(func() int64)
+	// leaderboardDescUpdatedAt is the schema descriptor for updated_at field.
+	leaderboardDescUpdatedAt := leaderboardFields[8].Descriptor()
+	// leaderboard.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	leaderboard.DefaultUpdatedAt = leaderboardDescUpdatedAt.Default.(func() int64)
+	// leaderboard.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	leaderboard.UpdateDefaultUpdatedAt = leaderboardDescUpdatedAt.UpdateDefault.(func() int64)
+	leaderboardsnapshotFields := schema.LeaderboardSnapshot{}.Fields()
+	_ = leaderboardsnapshotFields
+	// leaderboardsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	leaderboardsnapshotDescCreatedAt := leaderboardsnapshotFields[6].Descriptor()
+	// leaderboardsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	leaderboardsnapshot.DefaultCreatedAt = leaderboardsnapshotDescCreatedAt.Default.(func() int64)
+	ledgerentryFields := schema.LedgerEntry{}.Fields()
+	_ = ledgerentryFields
+	// ledgerentryDescReference is the schema descriptor for reference field.
+	ledgerentryDescReference := ledgerentryFields[7].Descriptor()
+	// ledgerentry.DefaultReference holds the default value on creation for the reference field.
+	ledgerentry.DefaultReference = ledgerentryDescReference.Default.(string)
+	// ledgerentryDescCreatedAt is the schema descriptor for created_at field.
+	ledgerentryDescCreatedAt := ledgerentryFields[8].Descriptor()
+	// ledgerentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgerentry.DefaultCreatedAt = ledgerentryDescCreatedAt.Default.(func() int64)
+	liveeventFields := schema.LiveEvent{}.Fields()
+	_ = liveeventFields
+	// liveeventDescKind is the schema descriptor for kind field.
+	liveeventDescKind := liveeventFields[1].Descriptor()
+	// liveevent.DefaultKind holds the default value on creation for the kind field.
+	liveevent.DefaultKind = liveeventDescKind.Default.(string)
+	// liveeventDescDescription is the schema descriptor for description field.
+	liveeventDescDescription := liveeventFields[3].Descriptor()
+	// liveevent.DefaultDescription holds the default value on creation for the description field.
+	liveevent.DefaultDescription = liveeventDescDescription.Default.(string)
+	// liveeventDescTimezone is the schema descriptor for timezone field.
+	liveeventDescTimezone := liveeventFields[4].Descriptor()
+	// liveevent.DefaultTimezone holds the default value on creation for the timezone field.
+	liveevent.DefaultTimezone = liveeventDescTimezone.Default.(string)
+	// liveeventDescCreatedBy is the schema descriptor for created_by field.
+	liveeventDescCreatedBy := liveeventFields[8].Descriptor()
+	// liveevent.DefaultCreatedBy holds the default value on creation for the created_by field.
+	liveevent.DefaultCreatedBy = liveeventDescCreatedBy.Default.(string)
+	// liveeventDescCreatedAt is the schema descriptor for created_at field.
+	liveeventDescCreatedAt := liveeventFields[9].Descriptor()
+	// liveevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	liveevent.DefaultCreatedAt = liveeventDescCreatedAt.Default.(func() int64)
+	// liveeventDescUpdatedAt is the schema descriptor for updated_at field.
+	liveeventDescUpdatedAt := liveeventFields[10].Descriptor()
+	// liveevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	liveevent.DefaultUpdatedAt = liveeventDescUpdatedAt.Default.(func() int64)
+	// liveevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	liveevent.UpdateDefaultUpdatedAt = liveeventDescUpdatedAt.UpdateDefault.(func() int64)
+	marketfillFields := schema.MarketFill{}.Fields()
+	_ = marketfillFields
+	// marketfillDescFee is the schema descriptor for fee field.
+	marketfillDescFee := marketfillFields[5].Descriptor()
+	// marketfill.DefaultFee holds the default value on creation for the fee field.
+	marketfill.DefaultFee = marketfillDescFee.Default.(int64)
+	// marketfillDescCreatedAt is the schema descriptor for created_at field.
+	marketfillDescCreatedAt := marketfillFields[10].Descriptor()
+	// marketfill.DefaultCreatedAt holds the default value on creation for the created_at field.
+	marketfill.DefaultCreatedAt = marketfillDescCreatedAt.Default.(func() int64)
+	marketorderFields := schema.MarketOrder{}.Fields()
+	_ = marketorderFields
+	// marketorderDescStatus is the schema descriptor for status field.
+	marketorderDescStatus := marketorderFields[8].Descriptor()
+	// marketorder.DefaultStatus holds the default value on creation for the status field.
+	marketorder.DefaultStatus = marketorderDescStatus.Default.(string)
+	// marketorderDescCreatedAt is the schema descriptor for created_at field.
+	marketorderDescCreatedAt := marketorderFields[9].Descriptor()
+	// marketorder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	marketorder.DefaultCreatedAt = marketorderDescCreatedAt.Default.(func() int64)
+	// marketorderDescUpdatedAt is the schema descriptor for updated_at field.
+	marketorderDescUpdatedAt := marketorderFields[10].Descriptor()
+	// marketorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	marketorder.DefaultUpdatedAt = marketorderDescUpdatedAt.Default.(func() int64)
+	// marketorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	marketorder.UpdateDefaultUpdatedAt = marketorderDescUpdatedAt.UpdateDefault.(func() int64)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxeventDescNextAttemptAt := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxevent.DefaultNextAttemptAt = outboxeventDescNextAttemptAt.Default.(int64)
+	// outboxeventDescLastError is the schema descriptor for last_error field.
+	outboxeventDescLastError := outboxeventFields[8].Descriptor()
+	// outboxevent.DefaultLastError holds the default value on creation for the last_error field.
+	outboxevent.DefaultLastError = outboxeventDescLastError.Default.(string)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[9].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() int64)
+	// outboxeventDescPublishedAt is the schema descriptor for published_at field.
+	outboxeventDescPublishedAt := outboxeventFields[10].Descriptor()
+	// outboxevent.DefaultPublishedAt holds the default value on creation for the published_at field.
+	outboxevent.DefaultPublishedAt = outboxeventDescPublishedAt.Default.(int64)
+	playerFields := schema.Player{}.Fields()
+	_ = playerFields
+	// playerDescRegion is the schema descriptor for region field.
+	playerDescRegion := playerFields[2].Descriptor()
+	// player.DefaultRegion holds the default value on creation for the region field.
+	player.DefaultRegion = playerDescRegion.Default.(string)
+	// playerDescBanned is the schema descriptor for banned field.
+	playerDescBanned := playerFields[3].Descriptor()
+	// player.DefaultBanned holds the default value on creation for the banned field.
+	player.DefaultBanned = playerDescBanned.Default.(bool)
+	// playerDescBanReason is the schema descriptor for ban_reason field.
+	playerDescBanReason := playerFields[4].Descriptor()
+	// player.DefaultBanReason holds the default value on creation for the ban_reason field.
+	player.DefaultBanReason = playerDescBanReason.Default.(string)
+	// playerDescBannedUntil is the schema descriptor for banned_until field.
+	playerDescBannedUntil := playerFields[5].Descriptor()
+	// player.DefaultBannedUntil holds the default value on creation for the banned_until field.
+	player.DefaultBannedUntil = playerDescBannedUntil.Default.(int64)
+	// playerDescCreatedAt is the schema descriptor for created_at field.
+	playerDescCreatedAt := playerFields[6].Descriptor()
+	// player.DefaultCreatedAt holds the default value on creation for the created_at field.
+	player.DefaultCreatedAt = playerDescCreatedAt.Default.(func() int64)
+	// playerDescUpdatedAt is the schema descriptor for updated_at field.
+	playerDescUpdatedAt := playerFields[7].Descriptor()
+	// player.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	player.DefaultUpdatedAt = playerDescUpdatedAt.Default.(func() int64)
+	// player.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	player.UpdateDefaultUpdatedAt = playerDescUpdatedAt.UpdateDefault.(func() int64)
+	playeraccountFields := schema.PlayerAccount{}.Fields()
+	_ = playeraccountFields
+	// playeraccountDescCreatedAt is the schema descriptor for created_at field.
+	playeraccountDescCreatedAt := playeraccountFields[4].Descriptor()
+	// playeraccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playeraccount.DefaultCreatedAt = playeraccountDescCreatedAt.Default.(func() int64)
+	playereventFields := schema.PlayerEvent{}.Fields()
+	_ = playereventFields
+	// playereventDescCreatedAt is the schema descriptor for created_at field.
+	playereventDescCreatedAt := playereventFields[4].Descriptor()
+	// playerevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playerevent.DefaultCreatedAt = playereventDescCreatedAt.Default.(func() int64)
+	questFields := schema.Quest{}.Fields()
+	_ = questFields
+	// questDescKind is the schema descriptor for kind field.
+	questDescKind := questFields[1].Descriptor()
+	// quest.DefaultKind holds the default value on creation for the kind field.
+	quest.DefaultKind = questDescKind.Default.(string)
+	// questDescDescription is the schema descriptor for description field.
+	questDescDescription := questFields[3].Descriptor()
+	// quest.DefaultDescription holds the default value on creation for the description field.
+	quest.DefaultDescription = questDescDescription.Default.(string)
+	// questDescActive is the schema descriptor for active field.
+	questDescActive := questFields[6].Descriptor()
+	// quest.DefaultActive holds the default value on creation for the active field.
+	quest.DefaultActive = questDescActive.Default.(bool)
+	// questDescCreatedBy is the schema descriptor for created_by field.
+	questDescCreatedBy := questFields[7].Descriptor()
+	// quest.DefaultCreatedBy holds the default value on creation for the created_by field.
+	quest.DefaultCreatedBy = questDescCreatedBy.Default.(string)
+	// questDescCreatedAt is the schema descriptor for created_at field.
+	questDescCreatedAt := questFields[8].Descriptor()
+	// quest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quest.DefaultCreatedAt = questDescCreatedAt.Default.(func() int64)
+	// questDescUpdatedAt is the schema descriptor for updated_at field.
+	questDescUpdatedAt := questFields[9].Descriptor()
+	// quest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quest.DefaultUpdatedAt = questDescUpdatedAt.Default.(func() int64)
+	// quest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quest.UpdateDefaultUpdatedAt = questDescUpdatedAt.UpdateDefault.(func() int64)
+	questprogressFields := schema.QuestProgress{}.Fields()
+	_ = questprogressFields
+	// questprogressDescCompletedAt is the schema descriptor for completed_at field.
+	questprogressDescCompletedAt := questprogressFields[5].Descriptor()
+	// questprogress.DefaultCompletedAt holds the default value on creation for the completed_at field.
+	questprogress.DefaultCompletedAt = questprogressDescCompletedAt.Default.(int64)
+	// questprogressDescCreatedAt is the schema descriptor for created_at field.
+	questprogressDescCreatedAt := questprogressFields[6].Descriptor()
+	// questprogress.DefaultCreatedAt holds the default value on creation for the created_at field.
+	questprogress.DefaultCreatedAt = questprogressDescCreatedAt.Default.(func() int64)
+	// questprogressDescUpdatedAt is the schema descriptor for updated_at field.
+	questprogressDescUpdatedAt := questprogressFields[7].Descriptor()
+	// questprogress.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	questprogress.DefaultUpdatedAt = questprogressDescUpdatedAt.Default.(func() int64)
+	// questprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	questprogress.UpdateDefaultUpdatedAt = questprogressDescUpdatedAt.UpdateDefault.(func() int64)
+	tradeFields := schema.Trade{}.Fields()
+	_ = tradeFields
+	// tradeDescStatus is the schema descriptor for status field.
+	tradeDescStatus := tradeFields[5].Descriptor()
+	// trade.DefaultStatus holds the default value on creation for the status field.
+	trade.DefaultStatus = tradeDescStatus.Default.(string)
+	// tradeDescResolvedAt is the schema descriptor for resolved_at field.
+	tradeDescResolvedAt := tradeFields[7].Descriptor()
+	// trade.DefaultResolvedAt holds the default value on creation for the resolved_at field.
+	trade.DefaultResolvedAt = tradeDescResolvedAt.Default.(int64)
+	// tradeDescCreatedAt is the schema descriptor for created_at field.
+	tradeDescCreatedAt := tradeFields[8].Descriptor()
+	// trade.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trade.DefaultCreatedAt = tradeDescCreatedAt.Default.(func() int64)
+	// tradeDescUpdatedAt is the schema descriptor for updated_at field.
+	tradeDescUpdatedAt := tradeFields[9].Descriptor()
+	// trade.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	trade.DefaultUpdatedAt = tradeDescUpdatedAt.Default.(func() int64)
+	// trade.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	trade.UpdateDefaultUpdatedAt = tradeDescUpdatedAt.UpdateDefault.(func() int64)
+	walletFields := schema.Wallet{}.Fields()
+	_ = walletFields
+	// walletDescBalance is the schema descriptor for balance field.
+	walletDescBalance := walletFields[3].Descriptor()
+	// wallet.DefaultBalance holds the default value on creation for the balance field.
+	wallet.DefaultBalance = walletDescBalance.Default.(int64)
+	// walletDescCreatedAt is the schema descriptor for created_at field.
+	walletDescCreatedAt := walletFields[4].Descriptor()
+	// wallet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallet.DefaultCreatedAt = walletDescCreatedAt.Default.(func() int64)
+	// walletDescUpdatedAt is the schema descriptor for updated_at field.
+	walletDescUpdatedAt := walletFields[5].Descriptor()
+	// wallet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wallet.DefaultUpdatedAt = walletDescUpdatedAt.Default.(func() int64)
+	// wallet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wallet.UpdateDefaultUpdatedAt = walletDescUpdatedAt.UpdateDefault.(func() int64)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(int64)
+	// webhookdeliveryDescResponseStatus is the schema descriptor for response_status field.
+	webhookdeliveryDescResponseStatus := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultResponseStatus holds the default value on creation for the response_status field.
+	webhookdelivery.DefaultResponseStatus = webhookdeliveryDescResponseStatus.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultLastError holds the default value on creation for the last_error field.
+	webhookdelivery.DefaultLastError = webhookdeliveryDescLastError.Default.(string)
+	// webhookdeliveryDescDeliveredAt is the schema descriptor for delivered_at field.
+	webhookdeliveryDescDeliveredAt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultDeliveredAt holds the default value on creation for the delivered_at field.
+	webhookdelivery.DefaultDeliveredAt = webhookdeliveryDescDeliveredAt.Default.(int64)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() int64)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() int64)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() int64)
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescDescription is the schema descriptor for description field.
+	webhooksubscriptionDescDescription := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.DefaultDescription holds the default value on creation for the description field.
+	webhooksubscription.DefaultDescription = webhooksubscriptionDescDescription.Default.(string)
+	// webhooksubscriptionDescActive is the schema descriptor for active field.
+	webhooksubscriptionDescActive := webhooksubscriptionFields[5].Descriptor()
+	// webhooksubscription.DefaultActive holds the default value on creation for the active field.
+	webhooksubscription.DefaultActive = webhooksubscriptionDescActive.Default.(bool)
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionFields[7].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() int64)
+	// webhooksubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	webhooksubscriptionDescUpdatedAt := webhooksubscriptionFields[8].Descriptor()
+	// webhooksubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhooksubscription.DefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.Default.(func() int64)
+	// webhooksubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhooksubscription.UpdateDefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.UpdateDefault.(func() int64)
 }
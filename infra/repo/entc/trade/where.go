@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trade
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldID, id))
+}
+
+// ProposerID applies equality check predicate on the "proposer_id" field. It's identical to ProposerIDEQ.
+func ProposerID(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldProposerID, v))
+}
+
+// RecipientID applies equality check predicate on the "recipient_id" field. It's identical to RecipientIDEQ.
+func RecipientID(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldStatus, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProposerIDEQ applies the EQ predicate on the "proposer_id" field.
+func ProposerIDEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldProposerID, v))
+}
+
+// ProposerIDNEQ applies the NEQ predicate on the "proposer_id" field.
+func ProposerIDNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldProposerID, v))
+}
+
+// ProposerIDIn applies the In predicate on the "proposer_id" field.
+func ProposerIDIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldProposerID, vs...))
+}
+
+// ProposerIDNotIn applies the NotIn predicate on the "proposer_id" field.
+func ProposerIDNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldProposerID, vs...))
+}
+
+// ProposerIDGT applies the GT predicate on the "proposer_id" field.
+func ProposerIDGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldProposerID, v))
+}
+
+// ProposerIDGTE applies the GTE predicate on the "proposer_id" field.
+func ProposerIDGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldProposerID, v))
+}
+
+// ProposerIDLT applies the LT predicate on the "proposer_id" field.
+func ProposerIDLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldProposerID, v))
+}
+
+// ProposerIDLTE applies the LTE predicate on the "proposer_id" field.
+func ProposerIDLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldProposerID, v))
+}
+
+// ProposerIDContains applies the Contains predicate on the "proposer_id" field.
+func ProposerIDContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldProposerID, v))
+}
+
+// ProposerIDHasPrefix applies the HasPrefix predicate on the "proposer_id" field.
+func ProposerIDHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldProposerID, v))
+}
+
+// ProposerIDHasSuffix applies the HasSuffix predicate on the "proposer_id" field.
+func ProposerIDHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldProposerID, v))
+}
+
+// ProposerIDEqualFold applies the EqualFold predicate on the "proposer_id" field.
+func ProposerIDEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldProposerID, v))
+}
+
+// ProposerIDContainsFold applies the ContainsFold predicate on the "proposer_id" field.
+func ProposerIDContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldProposerID, v))
+}
+
+// RecipientIDEQ applies the EQ predicate on the "recipient_id" field.
+func RecipientIDEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// RecipientIDNEQ applies the NEQ predicate on the "recipient_id" field.
+func RecipientIDNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldRecipientID, v))
+}
+
+// RecipientIDIn applies the In predicate on the "recipient_id" field.
+func RecipientIDIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDNotIn applies the NotIn predicate on the "recipient_id" field.
+func RecipientIDNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDGT applies the GT predicate on the "recipient_id" field.
+func RecipientIDGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldRecipientID, v))
+}
+
+// RecipientIDGTE applies the GTE predicate on the "recipient_id" field.
+func RecipientIDGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldRecipientID, v))
+}
+
+// RecipientIDLT applies the LT predicate on the "recipient_id" field.
+func RecipientIDLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldRecipientID, v))
+}
+
+// RecipientIDLTE applies the LTE predicate on the "recipient_id" field.
+func RecipientIDLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldRecipientID, v))
+}
+
+// RecipientIDContains applies the Contains predicate on the "recipient_id" field.
+func RecipientIDContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldRecipientID, v))
+}
+
+// RecipientIDHasPrefix applies the HasPrefix predicate on the "recipient_id" field.
+func RecipientIDHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldRecipientID, v))
+}
+
+// RecipientIDHasSuffix applies the HasSuffix predicate on the "recipient_id" field.
+func RecipientIDHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldRecipientID, v))
+}
+
+// RecipientIDEqualFold applies the EqualFold predicate on the "recipient_id" field.
+func RecipientIDEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldRecipientID, v))
+}
+
+// RecipientIDContainsFold applies the ContainsFold predicate on the "recipient_id" field.
+func RecipientIDContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldRecipientID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...int64) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v int64) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProposer applies the HasEdge predicate on the "proposer" edge.
+func HasProposer() predicate.Trade {
+	return predicate.Trade(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProposerTable, ProposerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProposerWith applies the HasEdge predicate on the "proposer" edge with a given conditions (other predicates).
+func HasProposerWith(preds ...predicate.Player) predicate.Trade {
+	return predicate.Trade(func(s *sql.Selector) {
+		step := newProposerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecipient applies the HasEdge predicate on the "recipient" edge.
+func HasRecipient() predicate.Trade {
+	return predicate.Trade(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RecipientTable, RecipientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipientWith applies the HasEdge predicate on the "recipient" edge with a given conditions (other predicates).
+func HasRecipientWith(preds ...predicate.Player) predicate.Trade {
+	return predicate.Trade(func(s *sql.Selector) {
+		step := newRecipientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Trade) predicate.Trade {
+	return predicate.Trade(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Trade) predicate.Trade {
+	return predicate.Trade(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Trade) predicate.Trade {
+	return predicate.Trade(sql.NotPredicates(p))
+}
@@ -0,0 +1,1032 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+)
+
+// TradeCreate is the builder for creating a Trade entity.
+type TradeCreate struct {
+	config
+	mutation *TradeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProposerID sets the "proposer_id" field.
+func (tc *TradeCreate) SetProposerID(s string) *TradeCreate {
+	tc.mutation.SetProposerID(s)
+	return tc
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (tc *TradeCreate) SetRecipientID(s string) *TradeCreate {
+	tc.mutation.SetRecipientID(s)
+	return tc
+}
+
+// SetOffered sets the "offered" field.
+func (tc *TradeCreate) SetOffered(ea []*entity.TradeAsset) *TradeCreate {
+	tc.mutation.SetOffered(ea)
+	return tc
+}
+
+// SetRequested sets the "requested" field.
+func (tc *TradeCreate) SetRequested(ea []*entity.TradeAsset) *TradeCreate {
+	tc.mutation.SetRequested(ea)
+	return tc
+}
+
+// SetStatus sets the "status" field.
+func (tc *TradeCreate) SetStatus(s string) *TradeCreate {
+	tc.mutation.SetStatus(s)
+	return tc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tc *TradeCreate) SetNillableStatus(s *string) *TradeCreate {
+	if s != nil {
+		tc.SetStatus(*s)
+	}
+	return tc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tc *TradeCreate) SetExpiresAt(i int64) *TradeCreate {
+	tc.mutation.SetExpiresAt(i)
+	return tc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (tc *TradeCreate) SetResolvedAt(i int64) *TradeCreate {
+	tc.mutation.SetResolvedAt(i)
+	return tc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (tc *TradeCreate) SetNillableResolvedAt(i *int64) *TradeCreate {
+	if i != nil {
+		tc.SetResolvedAt(*i)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TradeCreate) SetCreatedAt(i int64) *TradeCreate {
+	tc.mutation.SetCreatedAt(i)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TradeCreate) SetNillableCreatedAt(i *int64) *TradeCreate {
+	if i != nil {
+		tc.SetCreatedAt(*i)
+	}
+	return tc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tc *TradeCreate) SetUpdatedAt(i int64) *TradeCreate {
+	tc.mutation.SetUpdatedAt(i)
+	return tc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tc *TradeCreate) SetNillableUpdatedAt(i *int64) *TradeCreate {
+	if i != nil {
+		tc.SetUpdatedAt(*i)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TradeCreate) SetID(i int64) *TradeCreate {
+	tc.mutation.SetID(i)
+	return tc
+}
+
+// SetProposer sets the "proposer" edge to the Player entity.
+func (tc *TradeCreate) SetProposer(p *Player) *TradeCreate {
+	return tc.SetProposerID(p.ID)
+}
+
+// SetRecipient sets the "recipient" edge to the Player entity.
+func (tc *TradeCreate) SetRecipient(p *Player) *TradeCreate {
+	return tc.SetRecipientID(p.ID)
+}
+
+// Mutation returns the TradeMutation object of the builder.
+func (tc *TradeCreate) Mutation() *TradeMutation {
+	return tc.mutation
+}
+
+// Save creates the Trade in the database.
+func (tc *TradeCreate) Save(ctx context.Context) (*Trade, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TradeCreate) SaveX(ctx context.Context) *Trade {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TradeCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TradeCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TradeCreate) defaults() {
+	if _, ok := tc.mutation.Status(); !ok {
+		v := trade.DefaultStatus
+		tc.mutation.SetStatus(v)
+	}
+	if _, ok := tc.mutation.ResolvedAt(); !ok {
+		v := trade.DefaultResolvedAt
+		tc.mutation.SetResolvedAt(v)
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := trade.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		v := trade.DefaultUpdatedAt()
+		tc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TradeCreate) check() error {
+	if _, ok := tc.mutation.ProposerID(); !ok {
+		return &ValidationError{Name: "proposer_id", err: errors.New(`entc: missing required field "Trade.proposer_id"`)}
+	}
+	if _, ok := tc.mutation.RecipientID(); !ok {
+		return &ValidationError{Name: "recipient_id", err: errors.New(`entc: missing required field "Trade.recipient_id"`)}
+	}
+	if _, ok := tc.mutation.Offered(); !ok {
+		return &ValidationError{Name: "offered", err: errors.New(`entc: missing required field "Trade.offered"`)}
+	}
+	if _, ok := tc.mutation.Requested(); !ok {
+		return &ValidationError{Name: "requested", err: errors.New(`entc: missing required field "Trade.requested"`)}
+	}
+	if _, ok := tc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entc: missing required field "Trade.status"`)}
+	}
+	if _, ok := tc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`entc: missing required field "Trade.expires_at"`)}
+	}
+	if _, ok := tc.mutation.ResolvedAt(); !ok {
+		return &ValidationError{Name: "resolved_at", err: errors.New(`entc: missing required field "Trade.resolved_at"`)}
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "Trade.created_at"`)}
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "Trade.updated_at"`)}
+	}
+	if len(tc.mutation.ProposerIDs()) == 0 {
+		return &ValidationError{Name: "proposer", err: errors.New(`entc: missing required edge "Trade.proposer"`)}
+	}
+	if len(tc.mutation.RecipientIDs()) == 0 {
+		return &ValidationError{Name: "recipient", err: errors.New(`entc: missing required edge "Trade.recipient"`)}
+	}
+	return nil
+}
+
+func (tc *TradeCreate) sqlSave(ctx context.Context) (*Trade, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TradeCreate) createSpec() (*Trade, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Trade{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(trade.Table, sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = tc.conflict
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tc.mutation.Offered(); ok {
+		_spec.SetField(trade.FieldOffered, field.TypeJSON, value)
+		_node.Offered = value
+	}
+	if value, ok := tc.mutation.Requested(); ok {
+		_spec.SetField(trade.FieldRequested, field.TypeJSON, value)
+		_node.Requested = value
+	}
+	if value, ok := tc.mutation.Status(); ok {
+		_spec.SetField(trade.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := tc.mutation.ExpiresAt(); ok {
+		_spec.SetField(trade.FieldExpiresAt, field.TypeInt64, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := tc.mutation.ResolvedAt(); ok {
+		_spec.SetField(trade.FieldResolvedAt, field.TypeInt64, value)
+		_node.ResolvedAt = value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.SetField(trade.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tc.mutation.UpdatedAt(); ok {
+		_spec.SetField(trade.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tc.mutation.ProposerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.ProposerTable,
+			Columns: []string{trade.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProposerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.RecipientTable,
+			Columns: []string{trade.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RecipientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Trade.Create().
+//		SetProposerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TradeUpsert) {
+//			SetProposerID(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TradeCreate) OnConflict(opts ...sql.ConflictOption) *TradeUpsertOne {
+	tc.conflict = opts
+	return &TradeUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Trade.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TradeCreate) OnConflictColumns(columns ...string) *TradeUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TradeUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TradeUpsertOne is the builder for "upsert"-ing
+	//  one Trade node.
+	TradeUpsertOne struct {
+		create *TradeCreate
+	}
+
+	// TradeUpsert is the "OnConflict" setter.
+	TradeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProposerID sets the "proposer_id" field.
+func (u *TradeUpsert) SetProposerID(v string) *TradeUpsert {
+	u.Set(trade.FieldProposerID, v)
+	return u
+}
+
+// UpdateProposerID sets the "proposer_id" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateProposerID() *TradeUpsert {
+	u.SetExcluded(trade.FieldProposerID)
+	return u
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (u *TradeUpsert) SetRecipientID(v string) *TradeUpsert {
+	u.Set(trade.FieldRecipientID, v)
+	return u
+}
+
+// UpdateRecipientID sets the "recipient_id" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateRecipientID() *TradeUpsert {
+	u.SetExcluded(trade.FieldRecipientID)
+	return u
+}
+
+// SetOffered sets the "offered" field.
+func (u *TradeUpsert) SetOffered(v []*entity.TradeAsset) *TradeUpsert {
+	u.Set(trade.FieldOffered, v)
+	return u
+}
+
+// UpdateOffered sets the "offered" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateOffered() *TradeUpsert {
+	u.SetExcluded(trade.FieldOffered)
+	return u
+}
+
+// SetRequested sets the "requested" field.
+func (u *TradeUpsert) SetRequested(v []*entity.TradeAsset) *TradeUpsert {
+	u.Set(trade.FieldRequested, v)
+	return u
+}
+
+// UpdateRequested sets the "requested" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateRequested() *TradeUpsert {
+	u.SetExcluded(trade.FieldRequested)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TradeUpsert) SetStatus(v string) *TradeUpsert {
+	u.Set(trade.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateStatus() *TradeUpsert {
+	u.SetExcluded(trade.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TradeUpsert) SetExpiresAt(v int64) *TradeUpsert {
+	u.Set(trade.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateExpiresAt() *TradeUpsert {
+	u.SetExcluded(trade.FieldExpiresAt)
+	return u
+}
+
+// AddExpiresAt adds v to the "expires_at" field.
+func (u *TradeUpsert) AddExpiresAt(v int64) *TradeUpsert {
+	u.Add(trade.FieldExpiresAt, v)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *TradeUpsert) SetResolvedAt(v int64) *TradeUpsert {
+	u.Set(trade.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateResolvedAt() *TradeUpsert {
+	u.SetExcluded(trade.FieldResolvedAt)
+	return u
+}
+
+// AddResolvedAt adds v to the "resolved_at" field.
+func (u *TradeUpsert) AddResolvedAt(v int64) *TradeUpsert {
+	u.Add(trade.FieldResolvedAt, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TradeUpsert) SetUpdatedAt(v int64) *TradeUpsert {
+	u.Set(trade.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TradeUpsert) UpdateUpdatedAt() *TradeUpsert {
+	u.SetExcluded(trade.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *TradeUpsert) AddUpdatedAt(v int64) *TradeUpsert {
+	u.Add(trade.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Trade.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(trade.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TradeUpsertOne) UpdateNewValues() *TradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(trade.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(trade.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Trade.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TradeUpsertOne) Ignore() *TradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TradeUpsertOne) DoNothing() *TradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TradeCreate.OnConflict
+// documentation for more info.
+func (u *TradeUpsertOne) Update(set func(*TradeUpsert)) *TradeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TradeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProposerID sets the "proposer_id" field.
+func (u *TradeUpsertOne) SetProposerID(v string) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetProposerID(v)
+	})
+}
+
+// UpdateProposerID sets the "proposer_id" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateProposerID() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateProposerID()
+	})
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (u *TradeUpsertOne) SetRecipientID(v string) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetRecipientID(v)
+	})
+}
+
+// UpdateRecipientID sets the "recipient_id" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateRecipientID() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateRecipientID()
+	})
+}
+
+// SetOffered sets the "offered" field.
+func (u *TradeUpsertOne) SetOffered(v []*entity.TradeAsset) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetOffered(v)
+	})
+}
+
+// UpdateOffered sets the "offered" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateOffered() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateOffered()
+	})
+}
+
+// SetRequested sets the "requested" field.
+func (u *TradeUpsertOne) SetRequested(v []*entity.TradeAsset) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetRequested(v)
+	})
+}
+
+// UpdateRequested sets the "requested" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateRequested() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateRequested()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TradeUpsertOne) SetStatus(v string) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateStatus() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TradeUpsertOne) SetExpiresAt(v int64) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// AddExpiresAt adds v to the "expires_at" field.
+func (u *TradeUpsertOne) AddExpiresAt(v int64) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.AddExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateExpiresAt() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *TradeUpsertOne) SetResolvedAt(v int64) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// AddResolvedAt adds v to the "resolved_at" field.
+func (u *TradeUpsertOne) AddResolvedAt(v int64) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.AddResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateResolvedAt() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TradeUpsertOne) SetUpdatedAt(v int64) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *TradeUpsertOne) AddUpdatedAt(v int64) *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TradeUpsertOne) UpdateUpdatedAt() *TradeUpsertOne {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TradeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for TradeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TradeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TradeUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TradeUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TradeCreateBulk is the builder for creating many Trade entities in bulk.
+type TradeCreateBulk struct {
+	config
+	err      error
+	builders []*TradeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Trade entities in the database.
+func (tcb *TradeCreateBulk) Save(ctx context.Context) ([]*Trade, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Trade, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TradeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TradeCreateBulk) SaveX(ctx context.Context) []*Trade {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TradeCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TradeCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Trade.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TradeUpsert) {
+//			SetProposerID(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TradeCreateBulk) OnConflict(opts ...sql.ConflictOption) *TradeUpsertBulk {
+	tcb.conflict = opts
+	return &TradeUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Trade.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TradeCreateBulk) OnConflictColumns(columns ...string) *TradeUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TradeUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TradeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Trade nodes.
+type TradeUpsertBulk struct {
+	create *TradeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Trade.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(trade.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TradeUpsertBulk) UpdateNewValues() *TradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(trade.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(trade.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Trade.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TradeUpsertBulk) Ignore() *TradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TradeUpsertBulk) DoNothing() *TradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TradeCreateBulk.OnConflict
+// documentation for more info.
+func (u *TradeUpsertBulk) Update(set func(*TradeUpsert)) *TradeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TradeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProposerID sets the "proposer_id" field.
+func (u *TradeUpsertBulk) SetProposerID(v string) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetProposerID(v)
+	})
+}
+
+// UpdateProposerID sets the "proposer_id" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateProposerID() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateProposerID()
+	})
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (u *TradeUpsertBulk) SetRecipientID(v string) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetRecipientID(v)
+	})
+}
+
+// UpdateRecipientID sets the "recipient_id" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateRecipientID() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateRecipientID()
+	})
+}
+
+// SetOffered sets the "offered" field.
+func (u *TradeUpsertBulk) SetOffered(v []*entity.TradeAsset) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetOffered(v)
+	})
+}
+
+// UpdateOffered sets the "offered" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateOffered() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateOffered()
+	})
+}
+
+// SetRequested sets the "requested" field.
+func (u *TradeUpsertBulk) SetRequested(v []*entity.TradeAsset) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetRequested(v)
+	})
+}
+
+// UpdateRequested sets the "requested" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateRequested() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateRequested()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TradeUpsertBulk) SetStatus(v string) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateStatus() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TradeUpsertBulk) SetExpiresAt(v int64) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// AddExpiresAt adds v to the "expires_at" field.
+func (u *TradeUpsertBulk) AddExpiresAt(v int64) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.AddExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateExpiresAt() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *TradeUpsertBulk) SetResolvedAt(v int64) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// AddResolvedAt adds v to the "resolved_at" field.
+func (u *TradeUpsertBulk) AddResolvedAt(v int64) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.AddResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateResolvedAt() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TradeUpsertBulk) SetUpdatedAt(v int64) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *TradeUpsertBulk) AddUpdatedAt(v int64) *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TradeUpsertBulk) UpdateUpdatedAt() *TradeUpsertBulk {
+	return u.Update(func(s *TradeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TradeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the TradeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for TradeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TradeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+)
+
+// TradeUpdate is the builder for updating Trade entities.
+type TradeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TradeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TradeUpdate builder.
+func (tu *TradeUpdate) Where(ps ...predicate.Trade) *TradeUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetProposerID sets the "proposer_id" field.
+func (tu *TradeUpdate) SetProposerID(s string) *TradeUpdate {
+	tu.mutation.SetProposerID(s)
+	return tu
+}
+
+// SetNillableProposerID sets the "proposer_id" field if the given value is not nil.
+func (tu *TradeUpdate) SetNillableProposerID(s *string) *TradeUpdate {
+	if s != nil {
+		tu.SetProposerID(*s)
+	}
+	return tu
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (tu *TradeUpdate) SetRecipientID(s string) *TradeUpdate {
+	tu.mutation.SetRecipientID(s)
+	return tu
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (tu *TradeUpdate) SetNillableRecipientID(s *string) *TradeUpdate {
+	if s != nil {
+		tu.SetRecipientID(*s)
+	}
+	return tu
+}
+
+// SetOffered sets the "offered" field.
+func (tu *TradeUpdate) SetOffered(ea []*entity.TradeAsset) *TradeUpdate {
+	tu.mutation.SetOffered(ea)
+	return tu
+}
+
+// AppendOffered appends ea to the "offered" field.
+func (tu *TradeUpdate) AppendOffered(ea []*entity.TradeAsset) *TradeUpdate {
+	tu.mutation.AppendOffered(ea)
+	return tu
+}
+
+// SetRequested sets the "requested" field.
+func (tu *TradeUpdate) SetRequested(ea []*entity.TradeAsset) *TradeUpdate {
+	tu.mutation.SetRequested(ea)
+	return tu
+}
+
+// AppendRequested appends ea to the "requested" field.
+func (tu *TradeUpdate) AppendRequested(ea []*entity.TradeAsset) *TradeUpdate {
+	tu.mutation.AppendRequested(ea)
+	return tu
+}
+
+// SetStatus sets the "status" field.
+func (tu *TradeUpdate) SetStatus(s string) *TradeUpdate {
+	tu.mutation.SetStatus(s)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TradeUpdate) SetNillableStatus(s *string) *TradeUpdate {
+	if s != nil {
+		tu.SetStatus(*s)
+	}
+	return tu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tu *TradeUpdate) SetExpiresAt(i int64) *TradeUpdate {
+	tu.mutation.ResetExpiresAt()
+	tu.mutation.SetExpiresAt(i)
+	return tu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tu *TradeUpdate) SetNillableExpiresAt(i *int64) *TradeUpdate {
+	if i != nil {
+		tu.SetExpiresAt(*i)
+	}
+	return tu
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (tu *TradeUpdate) AddExpiresAt(i int64) *TradeUpdate {
+	tu.mutation.AddExpiresAt(i)
+	return tu
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (tu *TradeUpdate) SetResolvedAt(i int64) *TradeUpdate {
+	tu.mutation.ResetResolvedAt()
+	tu.mutation.SetResolvedAt(i)
+	return tu
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (tu *TradeUpdate) SetNillableResolvedAt(i *int64) *TradeUpdate {
+	if i != nil {
+		tu.SetResolvedAt(*i)
+	}
+	return tu
+}
+
+// AddResolvedAt adds i to the "resolved_at" field.
+func (tu *TradeUpdate) AddResolvedAt(i int64) *TradeUpdate {
+	tu.mutation.AddResolvedAt(i)
+	return tu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tu *TradeUpdate) SetUpdatedAt(i int64) *TradeUpdate {
+	tu.mutation.ResetUpdatedAt()
+	tu.mutation.SetUpdatedAt(i)
+	return tu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (tu *TradeUpdate) AddUpdatedAt(i int64) *TradeUpdate {
+	tu.mutation.AddUpdatedAt(i)
+	return tu
+}
+
+// SetProposer sets the "proposer" edge to the Player entity.
+func (tu *TradeUpdate) SetProposer(p *Player) *TradeUpdate {
+	return tu.SetProposerID(p.ID)
+}
+
+// SetRecipient sets the "recipient" edge to the Player entity.
+func (tu *TradeUpdate) SetRecipient(p *Player) *TradeUpdate {
+	return tu.SetRecipientID(p.ID)
+}
+
+// Mutation returns the TradeMutation object of the builder.
+func (tu *TradeUpdate) Mutation() *TradeMutation {
+	return tu.mutation
+}
+
+// ClearProposer clears the "proposer" edge to the Player entity.
+func (tu *TradeUpdate) ClearProposer() *TradeUpdate {
+	tu.mutation.ClearProposer()
+	return tu
+}
+
+// ClearRecipient clears the "recipient" edge to the Player entity.
+func (tu *TradeUpdate) ClearRecipient() *TradeUpdate {
+	tu.mutation.ClearRecipient()
+	return tu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TradeUpdate) Save(ctx context.Context) (int, error) {
+	tu.defaults()
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TradeUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TradeUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TradeUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tu *TradeUpdate) defaults() {
+	if _, ok := tu.mutation.UpdatedAt(); !ok {
+		v := trade.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TradeUpdate) check() error {
+	if tu.mutation.ProposerCleared() && len(tu.mutation.ProposerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "Trade.proposer"`)
+	}
+	if tu.mutation.RecipientCleared() && len(tu.mutation.RecipientIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "Trade.recipient"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tu *TradeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TradeUpdate {
+	tu.modifiers = append(tu.modifiers, modifiers...)
+	return tu
+}
+
+func (tu *TradeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trade.Table, trade.Columns, sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Offered(); ok {
+		_spec.SetField(trade.FieldOffered, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedOffered(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, trade.FieldOffered, value)
+		})
+	}
+	if value, ok := tu.mutation.Requested(); ok {
+		_spec.SetField(trade.FieldRequested, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedRequested(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, trade.FieldRequested, value)
+		})
+	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(trade.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.ExpiresAt(); ok {
+		_spec.SetField(trade.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(trade.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.ResolvedAt(); ok {
+		_spec.SetField(trade.FieldResolvedAt, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedResolvedAt(); ok {
+		_spec.AddField(trade.FieldResolvedAt, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.SetField(trade.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(trade.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if tu.mutation.ProposerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.ProposerTable,
+			Columns: []string{trade.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ProposerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.ProposerTable,
+			Columns: []string{trade.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.RecipientTable,
+			Columns: []string{trade.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.RecipientTable,
+			Columns: []string{trade.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TradeUpdateOne is the builder for updating a single Trade entity.
+type TradeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TradeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProposerID sets the "proposer_id" field.
+func (tuo *TradeUpdateOne) SetProposerID(s string) *TradeUpdateOne {
+	tuo.mutation.SetProposerID(s)
+	return tuo
+}
+
+// SetNillableProposerID sets the "proposer_id" field if the given value is not nil.
+func (tuo *TradeUpdateOne) SetNillableProposerID(s *string) *TradeUpdateOne {
+	if s != nil {
+		tuo.SetProposerID(*s)
+	}
+	return tuo
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (tuo *TradeUpdateOne) SetRecipientID(s string) *TradeUpdateOne {
+	tuo.mutation.SetRecipientID(s)
+	return tuo
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (tuo *TradeUpdateOne) SetNillableRecipientID(s *string) *TradeUpdateOne {
+	if s != nil {
+		tuo.SetRecipientID(*s)
+	}
+	return tuo
+}
+
+// SetOffered sets the "offered" field.
+func (tuo *TradeUpdateOne) SetOffered(ea []*entity.TradeAsset) *TradeUpdateOne {
+	tuo.mutation.SetOffered(ea)
+	return tuo
+}
+
+// AppendOffered appends ea to the "offered" field.
+func (tuo *TradeUpdateOne) AppendOffered(ea []*entity.TradeAsset) *TradeUpdateOne {
+	tuo.mutation.AppendOffered(ea)
+	return tuo
+}
+
+// SetRequested sets the "requested" field.
+func (tuo *TradeUpdateOne) SetRequested(ea []*entity.TradeAsset) *TradeUpdateOne {
+	tuo.mutation.SetRequested(ea)
+	return tuo
+}
+
+// AppendRequested appends ea to the "requested" field.
+func (tuo *TradeUpdateOne) AppendRequested(ea []*entity.TradeAsset) *TradeUpdateOne {
+	tuo.mutation.AppendRequested(ea)
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TradeUpdateOne) SetStatus(s string) *TradeUpdateOne {
+	tuo.mutation.SetStatus(s)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TradeUpdateOne) SetNillableStatus(s *string) *TradeUpdateOne {
+	if s != nil {
+		tuo.SetStatus(*s)
+	}
+	return tuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tuo *TradeUpdateOne) SetExpiresAt(i int64) *TradeUpdateOne {
+	tuo.mutation.ResetExpiresAt()
+	tuo.mutation.SetExpiresAt(i)
+	return tuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tuo *TradeUpdateOne) SetNillableExpiresAt(i *int64) *TradeUpdateOne {
+	if i != nil {
+		tuo.SetExpiresAt(*i)
+	}
+	return tuo
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (tuo *TradeUpdateOne) AddExpiresAt(i int64) *TradeUpdateOne {
+	tuo.mutation.AddExpiresAt(i)
+	return tuo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (tuo *TradeUpdateOne) SetResolvedAt(i int64) *TradeUpdateOne {
+	tuo.mutation.ResetResolvedAt()
+	tuo.mutation.SetResolvedAt(i)
+	return tuo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (tuo *TradeUpdateOne) SetNillableResolvedAt(i *int64) *TradeUpdateOne {
+	if i != nil {
+		tuo.SetResolvedAt(*i)
+	}
+	return tuo
+}
+
+// AddResolvedAt adds i to the "resolved_at" field.
+func (tuo *TradeUpdateOne) AddResolvedAt(i int64) *TradeUpdateOne {
+	tuo.mutation.AddResolvedAt(i)
+	return tuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TradeUpdateOne) SetUpdatedAt(i int64) *TradeUpdateOne {
+	tuo.mutation.ResetUpdatedAt()
+	tuo.mutation.SetUpdatedAt(i)
+	return tuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (tuo *TradeUpdateOne) AddUpdatedAt(i int64) *TradeUpdateOne {
+	tuo.mutation.AddUpdatedAt(i)
+	return tuo
+}
+
+// SetProposer sets the "proposer" edge to the Player entity.
+func (tuo *TradeUpdateOne) SetProposer(p *Player) *TradeUpdateOne {
+	return tuo.SetProposerID(p.ID)
+}
+
+// SetRecipient sets the "recipient" edge to the Player entity.
+func (tuo *TradeUpdateOne) SetRecipient(p *Player) *TradeUpdateOne {
+	return tuo.SetRecipientID(p.ID)
+}
+
+// Mutation returns the TradeMutation object of the builder.
+func (tuo *TradeUpdateOne) Mutation() *TradeMutation {
+	return tuo.mutation
+}
+
+// ClearProposer clears the "proposer" edge to the Player entity.
+func (tuo *TradeUpdateOne) ClearProposer() *TradeUpdateOne {
+	tuo.mutation.ClearProposer()
+	return tuo
+}
+
+// ClearRecipient clears the "recipient" edge to the Player entity.
+func (tuo *TradeUpdateOne) ClearRecipient() *TradeUpdateOne {
+	tuo.mutation.ClearRecipient()
+	return tuo
+}
+
+// Where appends a list predicates to the TradeUpdate builder.
+func (tuo *TradeUpdateOne) Where(ps ...predicate.Trade) *TradeUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TradeUpdateOne) Select(field string, fields ...string) *TradeUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Trade entity.
+func (tuo *TradeUpdateOne) Save(ctx context.Context) (*Trade, error) {
+	tuo.defaults()
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TradeUpdateOne) SaveX(ctx context.Context) *Trade {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TradeUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TradeUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tuo *TradeUpdateOne) defaults() {
+	if _, ok := tuo.mutation.UpdatedAt(); !ok {
+		v := trade.UpdateDefaultUpdatedAt()
+		tuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TradeUpdateOne) check() error {
+	if tuo.mutation.ProposerCleared() && len(tuo.mutation.ProposerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "Trade.proposer"`)
+	}
+	if tuo.mutation.RecipientCleared() && len(tuo.mutation.RecipientIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "Trade.recipient"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuo *TradeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TradeUpdateOne {
+	tuo.modifiers = append(tuo.modifiers, modifiers...)
+	return tuo
+}
+
+func (tuo *TradeUpdateOne) sqlSave(ctx context.Context) (_node *Trade, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trade.Table, trade.Columns, sqlgraph.NewFieldSpec(trade.FieldID, field.TypeInt64))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "Trade.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trade.FieldID)
+		for _, f := range fields {
+			if !trade.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != trade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Offered(); ok {
+		_spec.SetField(trade.FieldOffered, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedOffered(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, trade.FieldOffered, value)
+		})
+	}
+	if value, ok := tuo.mutation.Requested(); ok {
+		_spec.SetField(trade.FieldRequested, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedRequested(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, trade.FieldRequested, value)
+		})
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(trade.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(trade.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(trade.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.ResolvedAt(); ok {
+		_spec.SetField(trade.FieldResolvedAt, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedResolvedAt(); ok {
+		_spec.AddField(trade.FieldResolvedAt, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(trade.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(trade.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if tuo.mutation.ProposerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.ProposerTable,
+			Columns: []string{trade.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ProposerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.ProposerTable,
+			Columns: []string{trade.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.RecipientTable,
+			Columns: []string{trade.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trade.RecipientTable,
+			Columns: []string{trade.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tuo.modifiers...)
+	_node = &Trade{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
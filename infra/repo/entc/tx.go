@@ -4,6 +4,8 @@ package entc
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,8 +14,60 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// CatalogSnapshot is the client for interacting with the CatalogSnapshot builders.
+	CatalogSnapshot *CatalogSnapshotClient
+	// CatalogVersion is the client for interacting with the CatalogVersion builders.
+	CatalogVersion *CatalogVersionClient
+	// Changeset is the client for interacting with the Changeset builders.
+	Changeset *ChangesetClient
+	// ChangesetChange is the client for interacting with the ChangesetChange builders.
+	ChangesetChange *ChangesetChangeClient
+	// InventoryItem is the client for interacting with the InventoryItem builders.
+	InventoryItem *InventoryItemClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemInstance is the client for interacting with the ItemInstance builders.
+	ItemInstance *ItemInstanceClient
+	// ItemInstanceHistory is the client for interacting with the ItemInstanceHistory builders.
+	ItemInstanceHistory *ItemInstanceHistoryClient
+	// ItemTranslation is the client for interacting with the ItemTranslation builders.
+	ItemTranslation *ItemTranslationClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// JobTask is the client for interacting with the JobTask builders.
+	JobTask *JobTaskClient
+	// Leaderboard is the client for interacting with the Leaderboard builders.
+	Leaderboard *LeaderboardClient
+	// LeaderboardSnapshot is the client for interacting with the LeaderboardSnapshot builders.
+	LeaderboardSnapshot *LeaderboardSnapshotClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// LiveEvent is the client for interacting with the LiveEvent builders.
+	LiveEvent *LiveEventClient
+	// MarketFill is the client for interacting with the MarketFill builders.
+	MarketFill *MarketFillClient
+	// MarketOrder is the client for interacting with the MarketOrder builders.
+	MarketOrder *MarketOrderClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// Player is the client for interacting with the Player builders.
+	Player *PlayerClient
+	// PlayerAccount is the client for interacting with the PlayerAccount builders.
+	PlayerAccount *PlayerAccountClient
+	// PlayerEvent is the client for interacting with the PlayerEvent builders.
+	PlayerEvent *PlayerEventClient
+	// Quest is the client for interacting with the Quest builders.
+	Quest *QuestClient
+	// QuestProgress is the client for interacting with the QuestProgress builders.
+	QuestProgress *QuestProgressClient
+	// Trade is the client for interacting with the Trade builders.
+	Trade *TradeClient
+	// Wallet is the client for interacting with the Wallet builders.
+	Wallet *WalletClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +199,33 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.CatalogSnapshot = NewCatalogSnapshotClient(tx.config)
+	tx.CatalogVersion = NewCatalogVersionClient(tx.config)
+	tx.Changeset = NewChangesetClient(tx.config)
+	tx.ChangesetChange = NewChangesetChangeClient(tx.config)
+	tx.InventoryItem = NewInventoryItemClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.ItemInstance = NewItemInstanceClient(tx.config)
+	tx.ItemInstanceHistory = NewItemInstanceHistoryClient(tx.config)
+	tx.ItemTranslation = NewItemTranslationClient(tx.config)
+	tx.JobRun = NewJobRunClient(tx.config)
+	tx.JobTask = NewJobTaskClient(tx.config)
+	tx.Leaderboard = NewLeaderboardClient(tx.config)
+	tx.LeaderboardSnapshot = NewLeaderboardSnapshotClient(tx.config)
+	tx.LedgerEntry = NewLedgerEntryClient(tx.config)
+	tx.LiveEvent = NewLiveEventClient(tx.config)
+	tx.MarketFill = NewMarketFillClient(tx.config)
+	tx.MarketOrder = NewMarketOrderClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.Player = NewPlayerClient(tx.config)
+	tx.PlayerAccount = NewPlayerAccountClient(tx.config)
+	tx.PlayerEvent = NewPlayerEventClient(tx.config)
+	tx.Quest = NewQuestClient(tx.config)
+	tx.QuestProgress = NewQuestProgressClient(tx.config)
+	tx.Trade = NewTradeClient(tx.config)
+	tx.Wallet = NewWalletClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +235,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Item.QueryXXX(), the query will be executed
+// applies a query, for example: CatalogSnapshot.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -208,3 +288,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
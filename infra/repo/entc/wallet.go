@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+)
+
+// Wallet is the model entity for the Wallet schema.
+type Wallet struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// PlayerID holds the value of the "player_id" field.
+	PlayerID string `json:"player_id,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance int64 `json:"balance,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt int64 `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WalletQuery when eager-loading is set.
+	Edges        WalletEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WalletEdges holds the relations/edges for other nodes in the graph.
+type WalletEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WalletEdges) PlayerOrErr() (*Player, error) {
+	if e.Player != nil {
+		return e.Player, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: player.Label}
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Wallet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wallet.FieldID, wallet.FieldBalance, wallet.FieldCreatedAt, wallet.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case wallet.FieldPlayerID, wallet.FieldCurrency:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Wallet fields.
+func (w *Wallet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wallet.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			w.ID = int64(value.Int64)
+		case wallet.FieldPlayerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_id", values[i])
+			} else if value.Valid {
+				w.PlayerID = value.String
+			}
+		case wallet.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				w.Currency = value.String
+			}
+		case wallet.FieldBalance:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				w.Balance = value.Int64
+			}
+		case wallet.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				w.CreatedAt = value.Int64
+			}
+		case wallet.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				w.UpdatedAt = value.Int64
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Wallet.
+// This includes values selected through modifiers, order, etc.
+func (w *Wallet) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// QueryPlayer queries the "player" edge of the Wallet entity.
+func (w *Wallet) QueryPlayer() *PlayerQuery {
+	return NewWalletClient(w.config).QueryPlayer(w)
+}
+
+// Update returns a builder for updating this Wallet.
+// Note that you need to call Wallet.Unwrap() before calling this method if this Wallet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Wallet) Update() *WalletUpdateOne {
+	return NewWalletClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Wallet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Wallet) Unwrap() *Wallet {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("entc: Wallet is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Wallet) String() string {
+	var builder strings.Builder
+	builder.WriteString("Wallet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("player_id=")
+	builder.WriteString(w.PlayerID)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(w.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", w.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", w.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", w.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Wallets is a parsable slice of Wallet.
+type Wallets []*Wallet
@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+)
+
+// WalletCreate is the builder for creating a Wallet entity.
+type WalletCreate struct {
+	config
+	mutation *WalletMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPlayerID sets the "player_id" field.
+func (wc *WalletCreate) SetPlayerID(s string) *WalletCreate {
+	wc.mutation.SetPlayerID(s)
+	return wc
+}
+
+// SetCurrency sets the "currency" field.
+func (wc *WalletCreate) SetCurrency(s string) *WalletCreate {
+	wc.mutation.SetCurrency(s)
+	return wc
+}
+
+// SetBalance sets the "balance" field.
+func (wc *WalletCreate) SetBalance(i int64) *WalletCreate {
+	wc.mutation.SetBalance(i)
+	return wc
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableBalance(i *int64) *WalletCreate {
+	if i != nil {
+		wc.SetBalance(*i)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WalletCreate) SetCreatedAt(i int64) *WalletCreate {
+	wc.mutation.SetCreatedAt(i)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableCreatedAt(i *int64) *WalletCreate {
+	if i != nil {
+		wc.SetCreatedAt(*i)
+	}
+	return wc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wc *WalletCreate) SetUpdatedAt(i int64) *WalletCreate {
+	wc.mutation.SetUpdatedAt(i)
+	return wc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableUpdatedAt(i *int64) *WalletCreate {
+	if i != nil {
+		wc.SetUpdatedAt(*i)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WalletCreate) SetID(i int64) *WalletCreate {
+	wc.mutation.SetID(i)
+	return wc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (wc *WalletCreate) SetPlayer(p *Player) *WalletCreate {
+	return wc.SetPlayerID(p.ID)
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (wc *WalletCreate) Mutation() *WalletMutation {
+	return wc.mutation
+}
+
+// Save creates the Wallet in the database.
+func (wc *WalletCreate) Save(ctx context.Context) (*Wallet, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WalletCreate) SaveX(ctx context.Context) *Wallet {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WalletCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WalletCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WalletCreate) defaults() {
+	if _, ok := wc.mutation.Balance(); !ok {
+		v := wallet.DefaultBalance
+		wc.mutation.SetBalance(v)
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := wallet.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		v := wallet.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WalletCreate) check() error {
+	if _, ok := wc.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player_id", err: errors.New(`entc: missing required field "Wallet.player_id"`)}
+	}
+	if _, ok := wc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`entc: missing required field "Wallet.currency"`)}
+	}
+	if _, ok := wc.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`entc: missing required field "Wallet.balance"`)}
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "Wallet.created_at"`)}
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "Wallet.updated_at"`)}
+	}
+	if len(wc.mutation.PlayerIDs()) == 0 {
+		return &ValidationError{Name: "player", err: errors.New(`entc: missing required edge "Wallet.player"`)}
+	}
+	return nil
+}
+
+func (wc *WalletCreate) sqlSave(ctx context.Context) (*Wallet, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Wallet{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(wallet.Table, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = wc.conflict
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wc.mutation.Currency(); ok {
+		_spec.SetField(wallet.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := wc.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeInt64, value)
+		_node.Balance = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(wallet.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallet.PlayerTable,
+			Columns: []string{wallet.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlayerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wallet.Create().
+//		SetPlayerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (wc *WalletCreate) OnConflict(opts ...sql.ConflictOption) *WalletUpsertOne {
+	wc.conflict = opts
+	return &WalletUpsertOne{
+		create: wc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wc *WalletCreate) OnConflictColumns(columns ...string) *WalletUpsertOne {
+	wc.conflict = append(wc.conflict, sql.ConflictColumns(columns...))
+	return &WalletUpsertOne{
+		create: wc,
+	}
+}
+
+type (
+	// WalletUpsertOne is the builder for "upsert"-ing
+	//  one Wallet node.
+	WalletUpsertOne struct {
+		create *WalletCreate
+	}
+
+	// WalletUpsert is the "OnConflict" setter.
+	WalletUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPlayerID sets the "player_id" field.
+func (u *WalletUpsert) SetPlayerID(v string) *WalletUpsert {
+	u.Set(wallet.FieldPlayerID, v)
+	return u
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *WalletUpsert) UpdatePlayerID() *WalletUpsert {
+	u.SetExcluded(wallet.FieldPlayerID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WalletUpsert) SetCurrency(v string) *WalletUpsert {
+	u.Set(wallet.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateCurrency() *WalletUpsert {
+	u.SetExcluded(wallet.FieldCurrency)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsert) SetBalance(v int64) *WalletUpsert {
+	u.Set(wallet.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateBalance() *WalletUpsert {
+	u.SetExcluded(wallet.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsert) AddBalance(v int64) *WalletUpsert {
+	u.Add(wallet.FieldBalance, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsert) SetUpdatedAt(v int64) *WalletUpsert {
+	u.Set(wallet.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateUpdatedAt() *WalletUpsert {
+	u.SetExcluded(wallet.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WalletUpsert) AddUpdatedAt(v int64) *WalletUpsert {
+	u.Add(wallet.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallet.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletUpsertOne) UpdateNewValues() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(wallet.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wallet.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WalletUpsertOne) Ignore() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletUpsertOne) DoNothing() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletCreate.OnConflict
+// documentation for more info.
+func (u *WalletUpsertOne) Update(set func(*WalletUpsert)) *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *WalletUpsertOne) SetPlayerID(v string) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdatePlayerID() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WalletUpsertOne) SetCurrency(v string) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateCurrency() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsertOne) SetBalance(v int64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsertOne) AddBalance(v int64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateBalance() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsertOne) SetUpdatedAt(v int64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WalletUpsertOne) AddUpdatedAt(v int64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateUpdatedAt() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for WalletCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WalletUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WalletUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WalletCreateBulk is the builder for creating many Wallet entities in bulk.
+type WalletCreateBulk struct {
+	config
+	err      error
+	builders []*WalletCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Wallet entities in the database.
+func (wcb *WalletCreateBulk) Save(ctx context.Context) ([]*Wallet, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Wallet, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WalletCreateBulk) SaveX(ctx context.Context) []*Wallet {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WalletCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WalletCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wallet.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletUpsert) {
+//			SetPlayerID(v+v).
+//		}).
+//		Exec(ctx)
+func (wcb *WalletCreateBulk) OnConflict(opts ...sql.ConflictOption) *WalletUpsertBulk {
+	wcb.conflict = opts
+	return &WalletUpsertBulk{
+		create: wcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wcb *WalletCreateBulk) OnConflictColumns(columns ...string) *WalletUpsertBulk {
+	wcb.conflict = append(wcb.conflict, sql.ConflictColumns(columns...))
+	return &WalletUpsertBulk{
+		create: wcb,
+	}
+}
+
+// WalletUpsertBulk is the builder for "upsert"-ing
+// a bulk of Wallet nodes.
+type WalletUpsertBulk struct {
+	create *WalletCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(wallet.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WalletUpsertBulk) UpdateNewValues() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(wallet.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wallet.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WalletUpsertBulk) Ignore() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletUpsertBulk) DoNothing() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletCreateBulk.OnConflict
+// documentation for more info.
+func (u *WalletUpsertBulk) Update(set func(*WalletUpsert)) *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPlayerID sets the "player_id" field.
+func (u *WalletUpsertBulk) SetPlayerID(v string) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetPlayerID(v)
+	})
+}
+
+// UpdatePlayerID sets the "player_id" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdatePlayerID() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdatePlayerID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *WalletUpsertBulk) SetCurrency(v string) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateCurrency() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsertBulk) SetBalance(v int64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsertBulk) AddBalance(v int64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateBalance() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsertBulk) SetUpdatedAt(v int64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WalletUpsertBulk) AddUpdatedAt(v int64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateUpdatedAt() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the WalletCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for WalletCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+)
+
+// WalletUpdate is the builder for updating Wallet entities.
+type WalletUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WalletMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WalletUpdate builder.
+func (wu *WalletUpdate) Where(ps ...predicate.Wallet) *WalletUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetPlayerID sets the "player_id" field.
+func (wu *WalletUpdate) SetPlayerID(s string) *WalletUpdate {
+	wu.mutation.SetPlayerID(s)
+	return wu
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillablePlayerID(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetPlayerID(*s)
+	}
+	return wu
+}
+
+// SetCurrency sets the "currency" field.
+func (wu *WalletUpdate) SetCurrency(s string) *WalletUpdate {
+	wu.mutation.SetCurrency(s)
+	return wu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableCurrency(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetCurrency(*s)
+	}
+	return wu
+}
+
+// SetBalance sets the "balance" field.
+func (wu *WalletUpdate) SetBalance(i int64) *WalletUpdate {
+	wu.mutation.ResetBalance()
+	wu.mutation.SetBalance(i)
+	return wu
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableBalance(i *int64) *WalletUpdate {
+	if i != nil {
+		wu.SetBalance(*i)
+	}
+	return wu
+}
+
+// AddBalance adds i to the "balance" field.
+func (wu *WalletUpdate) AddBalance(i int64) *WalletUpdate {
+	wu.mutation.AddBalance(i)
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WalletUpdate) SetUpdatedAt(i int64) *WalletUpdate {
+	wu.mutation.ResetUpdatedAt()
+	wu.mutation.SetUpdatedAt(i)
+	return wu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (wu *WalletUpdate) AddUpdatedAt(i int64) *WalletUpdate {
+	wu.mutation.AddUpdatedAt(i)
+	return wu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (wu *WalletUpdate) SetPlayer(p *Player) *WalletUpdate {
+	return wu.SetPlayerID(p.ID)
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (wu *WalletUpdate) Mutation() *WalletMutation {
+	return wu.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (wu *WalletUpdate) ClearPlayer() *WalletUpdate {
+	wu.mutation.ClearPlayer()
+	return wu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WalletUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WalletUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WalletUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WalletUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WalletUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := wallet.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WalletUpdate) check() error {
+	if wu.mutation.PlayerCleared() && len(wu.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "Wallet.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wu *WalletUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WalletUpdate {
+	wu.modifiers = append(wu.modifiers, modifiers...)
+	return wu
+}
+
+func (wu *WalletUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Currency(); ok {
+		_spec.SetField(wallet.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := wu.mutation.AddedBalance(); ok {
+		_spec.AddField(wallet.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := wu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(wallet.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if wu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallet.PlayerTable,
+			Columns: []string{wallet.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallet.PlayerTable,
+			Columns: []string{wallet.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WalletUpdateOne is the builder for updating a single Wallet entity.
+type WalletUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WalletMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPlayerID sets the "player_id" field.
+func (wuo *WalletUpdateOne) SetPlayerID(s string) *WalletUpdateOne {
+	wuo.mutation.SetPlayerID(s)
+	return wuo
+}
+
+// SetNillablePlayerID sets the "player_id" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillablePlayerID(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetPlayerID(*s)
+	}
+	return wuo
+}
+
+// SetCurrency sets the "currency" field.
+func (wuo *WalletUpdateOne) SetCurrency(s string) *WalletUpdateOne {
+	wuo.mutation.SetCurrency(s)
+	return wuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableCurrency(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetCurrency(*s)
+	}
+	return wuo
+}
+
+// SetBalance sets the "balance" field.
+func (wuo *WalletUpdateOne) SetBalance(i int64) *WalletUpdateOne {
+	wuo.mutation.ResetBalance()
+	wuo.mutation.SetBalance(i)
+	return wuo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableBalance(i *int64) *WalletUpdateOne {
+	if i != nil {
+		wuo.SetBalance(*i)
+	}
+	return wuo
+}
+
+// AddBalance adds i to the "balance" field.
+func (wuo *WalletUpdateOne) AddBalance(i int64) *WalletUpdateOne {
+	wuo.mutation.AddBalance(i)
+	return wuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WalletUpdateOne) SetUpdatedAt(i int64) *WalletUpdateOne {
+	wuo.mutation.ResetUpdatedAt()
+	wuo.mutation.SetUpdatedAt(i)
+	return wuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (wuo *WalletUpdateOne) AddUpdatedAt(i int64) *WalletUpdateOne {
+	wuo.mutation.AddUpdatedAt(i)
+	return wuo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (wuo *WalletUpdateOne) SetPlayer(p *Player) *WalletUpdateOne {
+	return wuo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (wuo *WalletUpdateOne) Mutation() *WalletMutation {
+	return wuo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (wuo *WalletUpdateOne) ClearPlayer() *WalletUpdateOne {
+	wuo.mutation.ClearPlayer()
+	return wuo
+}
+
+// Where appends a list predicates to the WalletUpdate builder.
+func (wuo *WalletUpdateOne) Where(ps ...predicate.Wallet) *WalletUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WalletUpdateOne) Select(field string, fields ...string) *WalletUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Wallet entity.
+func (wuo *WalletUpdateOne) Save(ctx context.Context) (*Wallet, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WalletUpdateOne) SaveX(ctx context.Context) *Wallet {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WalletUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WalletUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WalletUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := wallet.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WalletUpdateOne) check() error {
+	if wuo.mutation.PlayerCleared() && len(wuo.mutation.PlayerIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "Wallet.player"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wuo *WalletUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WalletUpdateOne {
+	wuo.modifiers = append(wuo.modifiers, modifiers...)
+	return wuo
+}
+
+func (wuo *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt64))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "Wallet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallet.FieldID)
+		for _, f := range fields {
+			if !wallet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != wallet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Currency(); ok {
+		_spec.SetField(wallet.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := wuo.mutation.AddedBalance(); ok {
+		_spec.AddField(wallet.FieldBalance, field.TypeInt64, value)
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := wuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(wallet.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if wuo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallet.PlayerTable,
+			Columns: []string{wallet.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallet.PlayerTable,
+			Columns: []string{wallet.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wuo.modifiers...)
+	_node = &Wallet{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,768 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhookdelivery"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhooksubscription"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (wdu *WebhookDeliveryUpdate) SetSubscriptionID(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.SetSubscriptionID(i)
+	return wdu
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableSubscriptionID(i *int64) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetSubscriptionID(*i)
+	}
+	return wdu
+}
+
+// SetEventID sets the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) SetEventID(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetEventID()
+	wdu.mutation.SetEventID(i)
+	return wdu
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventID(i *int64) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetEventID(*i)
+	}
+	return wdu
+}
+
+// AddEventID adds i to the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) AddEventID(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.AddEventID(i)
+	return wdu
+}
+
+// SetEventType sets the "event_type" field.
+func (wdu *WebhookDeliveryUpdate) SetEventType(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventType(s)
+	return wdu
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEventType(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetEventType(*s)
+	}
+	return wdu
+}
+
+// SetPayload sets the "payload" field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(b []byte) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(b)
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(s)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetStatus(*s)
+	}
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptAt(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetNextAttemptAt()
+	wdu.mutation.SetNextAttemptAt(i)
+	return wdu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptAt(i *int64) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetNextAttemptAt(*i)
+	}
+	return wdu
+}
+
+// AddNextAttemptAt adds i to the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) AddNextAttemptAt(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.AddNextAttemptAt(i)
+	return wdu
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseStatus()
+	wdu.mutation.SetResponseStatus(i)
+	return wdu
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseStatus(*i)
+	}
+	return wdu
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) AddResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseStatus(i)
+	return wdu
+}
+
+// SetLastError sets the "last_error" field.
+func (wdu *WebhookDeliveryUpdate) SetLastError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetLastError(s)
+	return wdu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetLastError(*s)
+	}
+	return wdu
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveredAt(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetDeliveredAt()
+	wdu.mutation.SetDeliveredAt(i)
+	return wdu
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveredAt(i *int64) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetDeliveredAt(*i)
+	}
+	return wdu
+}
+
+// AddDeliveredAt adds i to the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) AddDeliveredAt(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.AddDeliveredAt(i)
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedAt(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetUpdatedAt()
+	wdu.mutation.SetUpdatedAt(i)
+	return wdu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) AddUpdatedAt(i int64) *WebhookDeliveryUpdate {
+	wdu.mutation.AddUpdatedAt(i)
+	return wdu
+}
+
+// SetSubscription sets the "subscription" edge to the WebhookSubscription entity.
+func (wdu *WebhookDeliveryUpdate) SetSubscription(w *WebhookSubscription) *WebhookDeliveryUpdate {
+	return wdu.SetSubscriptionID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the WebhookSubscription entity.
+func (wdu *WebhookDeliveryUpdate) ClearSubscription() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearSubscription()
+	return wdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	wdu.defaults()
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if wdu.mutation.SubscriptionCleared() && len(wdu.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "WebhookDelivery.subscription"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdu *WebhookDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdate {
+	wdu.modifiers = append(wdu.modifiers, modifiers...)
+	return wdu
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.AddedEventID(); ok {
+		_spec.AddField(webhookdelivery.FieldEventID, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.AddedNextAttemptAt(); ok {
+		_spec.AddField(webhookdelivery.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.AddedDeliveredAt(); ok {
+		_spec.AddField(webhookdelivery.FieldDeliveredAt, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := wdu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(webhookdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if wdu.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.SubscriptionTable,
+			Columns: []string{webhookdelivery.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.SubscriptionTable,
+			Columns: []string{webhookdelivery.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetSubscriptionID(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetSubscriptionID(i)
+	return wduo
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableSubscriptionID(i *int64) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetSubscriptionID(*i)
+	}
+	return wduo
+}
+
+// SetEventID sets the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventID(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetEventID()
+	wduo.mutation.SetEventID(i)
+	return wduo
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventID(i *int64) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetEventID(*i)
+	}
+	return wduo
+}
+
+// AddEventID adds i to the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) AddEventID(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddEventID(i)
+	return wduo
+}
+
+// SetEventType sets the "event_type" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventType(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventType(s)
+	return wduo
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEventType(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetEventType(*s)
+	}
+	return wduo
+}
+
+// SetPayload sets the "payload" field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(b []byte) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(b)
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(s)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetStatus(*s)
+	}
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptAt(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetNextAttemptAt()
+	wduo.mutation.SetNextAttemptAt(i)
+	return wduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptAt(i *int64) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetNextAttemptAt(*i)
+	}
+	return wduo
+}
+
+// AddNextAttemptAt adds i to the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) AddNextAttemptAt(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddNextAttemptAt(i)
+	return wduo
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseStatus()
+	wduo.mutation.SetResponseStatus(i)
+	return wduo
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseStatus(*i)
+	}
+	return wduo
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseStatus(i)
+	return wduo
+}
+
+// SetLastError sets the "last_error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetLastError(s)
+	return wduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetLastError(*s)
+	}
+	return wduo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveredAt(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetDeliveredAt()
+	wduo.mutation.SetDeliveredAt(i)
+	return wduo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveredAt(i *int64) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetDeliveredAt(*i)
+	}
+	return wduo
+}
+
+// AddDeliveredAt adds i to the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) AddDeliveredAt(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddDeliveredAt(i)
+	return wduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedAt(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetUpdatedAt()
+	wduo.mutation.SetUpdatedAt(i)
+	return wduo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) AddUpdatedAt(i int64) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddUpdatedAt(i)
+	return wduo
+}
+
+// SetSubscription sets the "subscription" edge to the WebhookSubscription entity.
+func (wduo *WebhookDeliveryUpdateOne) SetSubscription(w *WebhookSubscription) *WebhookDeliveryUpdateOne {
+	return wduo.SetSubscriptionID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearSubscription clears the "subscription" edge to the WebhookSubscription entity.
+func (wduo *WebhookDeliveryUpdateOne) ClearSubscription() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearSubscription()
+	return wduo
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wduo.defaults()
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if wduo.mutation.SubscriptionCleared() && len(wduo.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`entc: clearing a required unique edge "WebhookDelivery.subscription"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wduo *WebhookDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdateOne {
+	wduo.modifiers = append(wduo.modifiers, modifiers...)
+	return wduo
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.AddedEventID(); ok {
+		_spec.AddField(webhookdelivery.FieldEventID, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.AddedNextAttemptAt(); ok {
+		_spec.AddField(webhookdelivery.FieldNextAttemptAt, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.AddedDeliveredAt(); ok {
+		_spec.AddField(webhookdelivery.FieldDeliveredAt, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := wduo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(webhookdelivery.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if wduo.mutation.SubscriptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.SubscriptionTable,
+			Columns: []string{webhookdelivery.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.SubscriptionTable,
+			Columns: []string{webhookdelivery.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wduo.modifiers...)
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhookdelivery"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhooksubscription"
+)
+
+// WebhookSubscriptionCreate is the builder for creating a WebhookSubscription entity.
+type WebhookSubscriptionCreate struct {
+	config
+	mutation *WebhookSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetURL sets the "url" field.
+func (wsc *WebhookSubscriptionCreate) SetURL(s string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetURL(s)
+	return wsc
+}
+
+// SetEvents sets the "events" field.
+func (wsc *WebhookSubscriptionCreate) SetEvents(s []string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetEvents(s)
+	return wsc
+}
+
+// SetSecret sets the "secret" field.
+func (wsc *WebhookSubscriptionCreate) SetSecret(s string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetSecret(s)
+	return wsc
+}
+
+// SetDescription sets the "description" field.
+func (wsc *WebhookSubscriptionCreate) SetDescription(s string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetDescription(s)
+	return wsc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wsc *WebhookSubscriptionCreate) SetNillableDescription(s *string) *WebhookSubscriptionCreate {
+	if s != nil {
+		wsc.SetDescription(*s)
+	}
+	return wsc
+}
+
+// SetActive sets the "active" field.
+func (wsc *WebhookSubscriptionCreate) SetActive(b bool) *WebhookSubscriptionCreate {
+	wsc.mutation.SetActive(b)
+	return wsc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wsc *WebhookSubscriptionCreate) SetNillableActive(b *bool) *WebhookSubscriptionCreate {
+	if b != nil {
+		wsc.SetActive(*b)
+	}
+	return wsc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wsc *WebhookSubscriptionCreate) SetCreatedBy(s string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetCreatedBy(s)
+	return wsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsc *WebhookSubscriptionCreate) SetCreatedAt(i int64) *WebhookSubscriptionCreate {
+	wsc.mutation.SetCreatedAt(i)
+	return wsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsc *WebhookSubscriptionCreate) SetNillableCreatedAt(i *int64) *WebhookSubscriptionCreate {
+	if i != nil {
+		wsc.SetCreatedAt(*i)
+	}
+	return wsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsc *WebhookSubscriptionCreate) SetUpdatedAt(i int64) *WebhookSubscriptionCreate {
+	wsc.mutation.SetUpdatedAt(i)
+	return wsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wsc *WebhookSubscriptionCreate) SetNillableUpdatedAt(i *int64) *WebhookSubscriptionCreate {
+	if i != nil {
+		wsc.SetUpdatedAt(*i)
+	}
+	return wsc
+}
+
+// SetID sets the "id" field.
+func (wsc *WebhookSubscriptionCreate) SetID(i int64) *WebhookSubscriptionCreate {
+	wsc.mutation.SetID(i)
+	return wsc
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wsc *WebhookSubscriptionCreate) AddDeliveryIDs(ids ...int64) *WebhookSubscriptionCreate {
+	wsc.mutation.AddDeliveryIDs(ids...)
+	return wsc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wsc *WebhookSubscriptionCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionCreate {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsc *WebhookSubscriptionCreate) Mutation() *WebhookSubscriptionMutation {
+	return wsc.mutation
+}
+
+// Save creates the WebhookSubscription in the database.
+func (wsc *WebhookSubscriptionCreate) Save(ctx context.Context) (*WebhookSubscription, error) {
+	wsc.defaults()
+	return withHooks(ctx, wsc.sqlSave, wsc.mutation, wsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WebhookSubscriptionCreate) SaveX(ctx context.Context) *WebhookSubscription {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WebhookSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WebhookSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WebhookSubscriptionCreate) defaults() {
+	if _, ok := wsc.mutation.Description(); !ok {
+		v := webhooksubscription.DefaultDescription
+		wsc.mutation.SetDescription(v)
+	}
+	if _, ok := wsc.mutation.Active(); !ok {
+		v := webhooksubscription.DefaultActive
+		wsc.mutation.SetActive(v)
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		v := webhooksubscription.DefaultCreatedAt()
+		wsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		v := webhooksubscription.DefaultUpdatedAt()
+		wsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WebhookSubscriptionCreate) check() error {
+	if _, ok := wsc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`entc: missing required field "WebhookSubscription.url"`)}
+	}
+	if _, ok := wsc.mutation.Events(); !ok {
+		return &ValidationError{Name: "events", err: errors.New(`entc: missing required field "WebhookSubscription.events"`)}
+	}
+	if _, ok := wsc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`entc: missing required field "WebhookSubscription.secret"`)}
+	}
+	if _, ok := wsc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`entc: missing required field "WebhookSubscription.description"`)}
+	}
+	if _, ok := wsc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`entc: missing required field "WebhookSubscription.active"`)}
+	}
+	if _, ok := wsc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`entc: missing required field "WebhookSubscription.created_by"`)}
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`entc: missing required field "WebhookSubscription.created_at"`)}
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`entc: missing required field "WebhookSubscription.updated_at"`)}
+	}
+	return nil
+}
+
+func (wsc *WebhookSubscriptionCreate) sqlSave(ctx context.Context) (*WebhookSubscription, error) {
+	if err := wsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	wsc.mutation.id = &_node.ID
+	wsc.mutation.done = true
+	return _node, nil
+}
+
+func (wsc *WebhookSubscriptionCreate) createSpec() (*WebhookSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookSubscription{config: wsc.config}
+		_spec = sqlgraph.NewCreateSpec(webhooksubscription.Table, sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = wsc.conflict
+	if id, ok := wsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wsc.mutation.URL(); ok {
+		_spec.SetField(webhooksubscription.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wsc.mutation.Events(); ok {
+		_spec.SetField(webhooksubscription.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if value, ok := wsc.mutation.Secret(); ok {
+		_spec.SetField(webhooksubscription.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := wsc.mutation.Description(); ok {
+		_spec.SetField(webhooksubscription.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := wsc.mutation.Active(); ok {
+		_spec.SetField(webhooksubscription.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := wsc.mutation.CreatedBy(); ok {
+		_spec.SetField(webhooksubscription.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wsc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhooksubscription.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhooksubscription.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := wsc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookSubscription.Create().
+//		SetURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookSubscriptionUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (wsc *WebhookSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *WebhookSubscriptionUpsertOne {
+	wsc.conflict = opts
+	return &WebhookSubscriptionUpsertOne{
+		create: wsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wsc *WebhookSubscriptionCreate) OnConflictColumns(columns ...string) *WebhookSubscriptionUpsertOne {
+	wsc.conflict = append(wsc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookSubscriptionUpsertOne{
+		create: wsc,
+	}
+}
+
+type (
+	// WebhookSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one WebhookSubscription node.
+	WebhookSubscriptionUpsertOne struct {
+		create *WebhookSubscriptionCreate
+	}
+
+	// WebhookSubscriptionUpsert is the "OnConflict" setter.
+	WebhookSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetURL sets the "url" field.
+func (u *WebhookSubscriptionUpsert) SetURL(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateURL() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldURL)
+	return u
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookSubscriptionUpsert) SetEvents(v []string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldEvents, v)
+	return u
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateEvents() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldEvents)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookSubscriptionUpsert) SetSecret(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateSecret() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldSecret)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookSubscriptionUpsert) SetDescription(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateDescription() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldDescription)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *WebhookSubscriptionUpsert) SetActive(v bool) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateActive() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldActive)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *WebhookSubscriptionUpsert) SetCreatedBy(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateCreatedBy() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookSubscriptionUpsert) SetUpdatedAt(v int64) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateUpdatedAt() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldUpdatedAt)
+	return u
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WebhookSubscriptionUpsert) AddUpdatedAt(v int64) *WebhookSubscriptionUpsert {
+	u.Add(webhooksubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhooksubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookSubscriptionUpsertOne) UpdateNewValues() *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhooksubscription.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webhooksubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookSubscriptionUpsertOne) Ignore() *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookSubscriptionUpsertOne) DoNothing() *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *WebhookSubscriptionUpsertOne) Update(set func(*WebhookSubscriptionUpsert)) *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookSubscriptionUpsertOne) SetURL(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateURL() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookSubscriptionUpsertOne) SetEvents(v []string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateEvents() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookSubscriptionUpsertOne) SetSecret(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateSecret() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookSubscriptionUpsertOne) SetDescription(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateDescription() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WebhookSubscriptionUpsertOne) SetActive(v bool) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateActive() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *WebhookSubscriptionUpsertOne) SetCreatedBy(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateCreatedBy() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookSubscriptionUpsertOne) SetUpdatedAt(v int64) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WebhookSubscriptionUpsertOne) AddUpdatedAt(v int64) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateUpdatedAt() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for WebhookSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookSubscriptionUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookSubscriptionUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookSubscriptionCreateBulk is the builder for creating many WebhookSubscription entities in bulk.
+type WebhookSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookSubscription entities in the database.
+func (wscb *WebhookSubscriptionCreateBulk) Save(ctx context.Context) ([]*WebhookSubscription, error) {
+	if wscb.err != nil {
+		return nil, wscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WebhookSubscription, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WebhookSubscriptionCreateBulk) SaveX(ctx context.Context) []*WebhookSubscription {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WebhookSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WebhookSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookSubscriptionUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (wscb *WebhookSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookSubscriptionUpsertBulk {
+	wscb.conflict = opts
+	return &WebhookSubscriptionUpsertBulk{
+		create: wscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wscb *WebhookSubscriptionCreateBulk) OnConflictColumns(columns ...string) *WebhookSubscriptionUpsertBulk {
+	wscb.conflict = append(wscb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookSubscriptionUpsertBulk{
+		create: wscb,
+	}
+}
+
+// WebhookSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookSubscription nodes.
+type WebhookSubscriptionUpsertBulk struct {
+	create *WebhookSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhooksubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookSubscriptionUpsertBulk) UpdateNewValues() *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhooksubscription.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webhooksubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookSubscriptionUpsertBulk) Ignore() *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookSubscriptionUpsertBulk) DoNothing() *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookSubscriptionUpsertBulk) Update(set func(*WebhookSubscriptionUpsert)) *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookSubscriptionUpsertBulk) SetURL(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateURL() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookSubscriptionUpsertBulk) SetEvents(v []string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateEvents() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookSubscriptionUpsertBulk) SetSecret(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateSecret() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookSubscriptionUpsertBulk) SetDescription(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateDescription() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *WebhookSubscriptionUpsertBulk) SetActive(v bool) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateActive() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *WebhookSubscriptionUpsertBulk) SetCreatedBy(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateCreatedBy() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookSubscriptionUpsertBulk) SetUpdatedAt(v int64) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// AddUpdatedAt adds v to the "updated_at" field.
+func (u *WebhookSubscriptionUpsertBulk) AddUpdatedAt(v int64) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.AddUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateUpdatedAt() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entc: OnConflict was set for builder %d. Set it on the WebhookSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entc: missing options for WebhookSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/predicate"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhookdelivery"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhooksubscription"
+)
+
+// WebhookSubscriptionUpdate is the builder for updating WebhookSubscription entities.
+type WebhookSubscriptionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookSubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookSubscriptionUpdate builder.
+func (wsu *WebhookSubscriptionUpdate) Where(ps ...predicate.WebhookSubscription) *WebhookSubscriptionUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetURL sets the "url" field.
+func (wsu *WebhookSubscriptionUpdate) SetURL(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetURL(s)
+	return wsu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableURL(s *string) *WebhookSubscriptionUpdate {
+	if s != nil {
+		wsu.SetURL(*s)
+	}
+	return wsu
+}
+
+// SetEvents sets the "events" field.
+func (wsu *WebhookSubscriptionUpdate) SetEvents(s []string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetEvents(s)
+	return wsu
+}
+
+// AppendEvents appends s to the "events" field.
+func (wsu *WebhookSubscriptionUpdate) AppendEvents(s []string) *WebhookSubscriptionUpdate {
+	wsu.mutation.AppendEvents(s)
+	return wsu
+}
+
+// SetSecret sets the "secret" field.
+func (wsu *WebhookSubscriptionUpdate) SetSecret(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetSecret(s)
+	return wsu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableSecret(s *string) *WebhookSubscriptionUpdate {
+	if s != nil {
+		wsu.SetSecret(*s)
+	}
+	return wsu
+}
+
+// SetDescription sets the "description" field.
+func (wsu *WebhookSubscriptionUpdate) SetDescription(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetDescription(s)
+	return wsu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableDescription(s *string) *WebhookSubscriptionUpdate {
+	if s != nil {
+		wsu.SetDescription(*s)
+	}
+	return wsu
+}
+
+// SetActive sets the "active" field.
+func (wsu *WebhookSubscriptionUpdate) SetActive(b bool) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetActive(b)
+	return wsu
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableActive(b *bool) *WebhookSubscriptionUpdate {
+	if b != nil {
+		wsu.SetActive(*b)
+	}
+	return wsu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wsu *WebhookSubscriptionUpdate) SetCreatedBy(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetCreatedBy(s)
+	return wsu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableCreatedBy(s *string) *WebhookSubscriptionUpdate {
+	if s != nil {
+		wsu.SetCreatedBy(*s)
+	}
+	return wsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsu *WebhookSubscriptionUpdate) SetUpdatedAt(i int64) *WebhookSubscriptionUpdate {
+	wsu.mutation.ResetUpdatedAt()
+	wsu.mutation.SetUpdatedAt(i)
+	return wsu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (wsu *WebhookSubscriptionUpdate) AddUpdatedAt(i int64) *WebhookSubscriptionUpdate {
+	wsu.mutation.AddUpdatedAt(i)
+	return wsu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wsu *WebhookSubscriptionUpdate) AddDeliveryIDs(ids ...int64) *WebhookSubscriptionUpdate {
+	wsu.mutation.AddDeliveryIDs(ids...)
+	return wsu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wsu *WebhookSubscriptionUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdate {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsu *WebhookSubscriptionUpdate) Mutation() *WebhookSubscriptionMutation {
+	return wsu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wsu *WebhookSubscriptionUpdate) ClearDeliveries() *WebhookSubscriptionUpdate {
+	wsu.mutation.ClearDeliveries()
+	return wsu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wsu *WebhookSubscriptionUpdate) RemoveDeliveryIDs(ids ...int64) *WebhookSubscriptionUpdate {
+	wsu.mutation.RemoveDeliveryIDs(ids...)
+	return wsu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wsu *WebhookSubscriptionUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdate {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WebhookSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	wsu.defaults()
+	return withHooks(ctx, wsu.sqlSave, wsu.mutation, wsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WebhookSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WebhookSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WebhookSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsu *WebhookSubscriptionUpdate) defaults() {
+	if _, ok := wsu.mutation.UpdatedAt(); !ok {
+		v := webhooksubscription.UpdateDefaultUpdatedAt()
+		wsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsu *WebhookSubscriptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookSubscriptionUpdate {
+	wsu.modifiers = append(wsu.modifiers, modifiers...)
+	return wsu
+}
+
+func (wsu *WebhookSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhooksubscription.Table, webhooksubscription.Columns, sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt64))
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.URL(); ok {
+		_spec.SetField(webhooksubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Events(); ok {
+		_spec.SetField(webhooksubscription.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wsu.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhooksubscription.FieldEvents, value)
+		})
+	}
+	if value, ok := wsu.mutation.Secret(); ok {
+		_spec.SetField(webhooksubscription.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Description(); ok {
+		_spec.SetField(webhooksubscription.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.Active(); ok {
+		_spec.SetField(webhooksubscription.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wsu.mutation.CreatedBy(); ok {
+		_spec.SetField(webhooksubscription.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhooksubscription.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := wsu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(webhooksubscription.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if wsu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wsu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhooksubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wsu.mutation.done = true
+	return n, nil
+}
+
+// WebhookSubscriptionUpdateOne is the builder for updating a single WebhookSubscription entity.
+type WebhookSubscriptionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookSubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetURL sets the "url" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetURL(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetURL(s)
+	return wsuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableURL(s *string) *WebhookSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetURL(*s)
+	}
+	return wsuo
+}
+
+// SetEvents sets the "events" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetEvents(s []string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetEvents(s)
+	return wsuo
+}
+
+// AppendEvents appends s to the "events" field.
+func (wsuo *WebhookSubscriptionUpdateOne) AppendEvents(s []string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.AppendEvents(s)
+	return wsuo
+}
+
+// SetSecret sets the "secret" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetSecret(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetSecret(s)
+	return wsuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableSecret(s *string) *WebhookSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetSecret(*s)
+	}
+	return wsuo
+}
+
+// SetDescription sets the "description" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetDescription(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetDescription(s)
+	return wsuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableDescription(s *string) *WebhookSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetDescription(*s)
+	}
+	return wsuo
+}
+
+// SetActive sets the "active" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetActive(b bool) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetActive(b)
+	return wsuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableActive(b *bool) *WebhookSubscriptionUpdateOne {
+	if b != nil {
+		wsuo.SetActive(*b)
+	}
+	return wsuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetCreatedBy(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetCreatedBy(s)
+	return wsuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableCreatedBy(s *string) *WebhookSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetCreatedBy(*s)
+	}
+	return wsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetUpdatedAt(i int64) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.ResetUpdatedAt()
+	wsuo.mutation.SetUpdatedAt(i)
+	return wsuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (wsuo *WebhookSubscriptionUpdateOne) AddUpdatedAt(i int64) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.AddUpdatedAt(i)
+	return wsuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wsuo *WebhookSubscriptionUpdateOne) AddDeliveryIDs(ids ...int64) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.AddDeliveryIDs(ids...)
+	return wsuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wsuo *WebhookSubscriptionUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdateOne {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsuo *WebhookSubscriptionUpdateOne) Mutation() *WebhookSubscriptionMutation {
+	return wsuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wsuo *WebhookSubscriptionUpdateOne) ClearDeliveries() *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.ClearDeliveries()
+	return wsuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wsuo *WebhookSubscriptionUpdateOne) RemoveDeliveryIDs(ids ...int64) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.RemoveDeliveryIDs(ids...)
+	return wsuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wsuo *WebhookSubscriptionUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdateOne {
+	ids := make([]int64, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookSubscriptionUpdate builder.
+func (wsuo *WebhookSubscriptionUpdateOne) Where(ps ...predicate.WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.Where(ps...)
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WebhookSubscriptionUpdateOne) Select(field string, fields ...string) *WebhookSubscriptionUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WebhookSubscription entity.
+func (wsuo *WebhookSubscriptionUpdateOne) Save(ctx context.Context) (*WebhookSubscription, error) {
+	wsuo.defaults()
+	return withHooks(ctx, wsuo.sqlSave, wsuo.mutation, wsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WebhookSubscriptionUpdateOne) SaveX(ctx context.Context) *WebhookSubscription {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WebhookSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WebhookSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsuo *WebhookSubscriptionUpdateOne) defaults() {
+	if _, ok := wsuo.mutation.UpdatedAt(); !ok {
+		v := webhooksubscription.UpdateDefaultUpdatedAt()
+		wsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsuo *WebhookSubscriptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookSubscriptionUpdateOne {
+	wsuo.modifiers = append(wsuo.modifiers, modifiers...)
+	return wsuo
+}
+
+func (wsuo *WebhookSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *WebhookSubscription, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhooksubscription.Table, webhooksubscription.Columns, sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt64))
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entc: missing "WebhookSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhooksubscription.FieldID)
+		for _, f := range fields {
+			if !webhooksubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entc: invalid field %q for query", f)}
+			}
+			if f != webhooksubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.URL(); ok {
+		_spec.SetField(webhooksubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Events(); ok {
+		_spec.SetField(webhooksubscription.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wsuo.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhooksubscription.FieldEvents, value)
+		})
+	}
+	if value, ok := wsuo.mutation.Secret(); ok {
+		_spec.SetField(webhooksubscription.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Description(); ok {
+		_spec.SetField(webhooksubscription.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.Active(); ok {
+		_spec.SetField(webhooksubscription.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := wsuo.mutation.CreatedBy(); ok {
+		_spec.SetField(webhooksubscription.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhooksubscription.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := wsuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(webhooksubscription.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if wsuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wsuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsuo.modifiers...)
+	_node = &WebhookSubscription{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhooksubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsuo.mutation.done = true
+	return _node, nil
+}
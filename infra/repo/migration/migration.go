@@ -0,0 +1,422 @@
+// Package migration applies the versioned migration files of the service.
+//
+// Migration files are kept in atlas format: one "<version>_<name>.sql" file per
+// change and an "atlas.sum" checksum file guarding the directory against
+// accidental edits. Atlas does not record how to revert a file, so reverting
+// is supported through an optional companion file of the same name in the
+// "down" sub directory.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	atlasschema "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	entcmigrate "github.com/nhatquangsin/game-service/infra/repo/entc/migrate"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// lockName is the name of the lock held while changing the schema, so only
+// one replica migrates at a time.
+const lockName = "game-service/migration"
+
+// downDir is the sub directory holding the revert files of migrations.
+const downDir = "down"
+
+// FXModule represents a FX module for the Migrator.
+var FXModule = fx.Provide(
+	func(client database.Client, v *viper.Viper) (*Migrator, error) {
+		return New(
+			client.MasterDB(context.Background()),
+			Options.Dialect(client.Dialect()),
+			Options.ConfigLoader(v),
+		)
+	},
+)
+
+// AutoMigrateFXModule represents a FX module applying all pending migrations
+// on boot when "postgres.migration.auto" is enabled. It must be registered
+// before modules invoking anything reading the database. SQLite databases are
+// auto migrated by the database client instead.
+var AutoMigrateFXModule = fx.Invoke(
+	func(m *Migrator) error {
+		if !m.auto || m.dialect != dialect.Postgres {
+			return nil
+		}
+
+		_, err := m.Up(context.Background(), 0, "")
+		return err
+	},
+)
+
+// Option is a function that sets some option on the Migrator.
+type Option func(*Migrator) error
+
+// Options is a factory for all available Migrator's options.
+var Options options
+
+type options struct{}
+
+// Dir is an Option to set the path of the migration directory.
+func (options) Dir(path string) Option {
+	return func(m *Migrator) error {
+		m.dir = path
+		return nil
+	}
+}
+
+// Dialect is an Option to set the dialect of the database, migration files
+// are written for dialect.Postgres only.
+func (options) Dialect(name string) Option {
+	return func(m *Migrator) error {
+		m.dialect = name
+		return nil
+	}
+}
+
+// Auto is an Option to apply pending migrations on boot.
+func (options) Auto(auto bool) Option {
+	return func(m *Migrator) error {
+		m.auto = auto
+		return nil
+	}
+}
+
+// LockTimeout is an Option to set how long to wait for the migration lock.
+func (options) LockTimeout(timeout time.Duration) Option {
+	return func(m *Migrator) error {
+		m.lockTimeout = timeout
+		return nil
+	}
+}
+
+// ConfigLoader is an Options to load all options that configured through viper.
+// Migrator's options that are loaded from viper by following keys:
+//
+//   - postgres.migration.dir
+//     path of the migration directory, defaults to "./migrations".
+//   - postgres.migration.auto
+//     applies pending migrations on boot, meant for dev environments.
+//   - postgres.migration.lock_timeout
+//     how long to wait for another replica to finish migrating.
+func (options) ConfigLoader(v *viper.Viper) Option {
+	return func(m *Migrator) error {
+		cfg := struct {
+			Postgres struct {
+				Migration struct {
+					Dir         string        `mapstructure:"dir"`
+					Auto        bool          `mapstructure:"auto"`
+					LockTimeout time.Duration `mapstructure:"lock_timeout"`
+				} `mapstructure:"migration"`
+			} `mapstructure:"postgres"`
+		}{}
+
+		if err := viperutil.Unmarshal(v, &cfg); err != nil {
+			return err
+		}
+
+		opts := []Option{
+			Options.Auto(cfg.Postgres.Migration.Auto),
+		}
+		if cfg.Postgres.Migration.Dir != "" {
+			opts = append(opts, Options.Dir(cfg.Postgres.Migration.Dir))
+		}
+		if cfg.Postgres.Migration.LockTimeout > 0 {
+			opts = append(opts, Options.LockTimeout(cfg.Postgres.Migration.LockTimeout))
+		}
+
+		for _, opt := range opts {
+			if err := opt(m); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Migrator applies and reverts versioned migration files.
+type Migrator struct {
+	db          *sql.DB
+	dialect     string
+	dir         string
+	auto        bool
+	lockTimeout time.Duration
+}
+
+// Status describes the migration state of the database.
+type Status struct {
+	// Current is the version of the last applied migration.
+	Current string
+	// Applied are the revisions of applied migrations ordered by version.
+	Applied []*migrate.Revision
+	// Pending are the migration files not applied yet.
+	Pending []migrate.File
+	// Dirty is set when the database has tables but no applied migration, it
+	// must be baselined before migrations can be applied.
+	Dirty bool
+}
+
+// New creates and returns new instance of Migrator.
+func New(db *sql.DB, opts ...Option) (*Migrator, error) {
+	m := &Migrator{
+		db:          db,
+		dialect:     dialect.Postgres,
+		dir:         "./migrations",
+		lockTimeout: time.Minute,
+	}
+
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Up applies n pending migrations, or all of them when n <= 0. On a database
+// that was migrated by hand, baseline is the version of the migration file
+// matching its current schema. It returns the applied files.
+func (m *Migrator) Up(ctx context.Context, n int, baseline string) ([]migrate.File, error) {
+	var applied []migrate.File
+	err := m.withLock(ctx, func(drv migrate.Driver, dir migrate.Dir, rrw *revisionReadWriter) error {
+		var opts []migrate.ExecutorOption
+		if baseline != "" {
+			opts = append(opts, migrate.WithBaselineVersion(baseline))
+		}
+
+		ex, err := migrate.NewExecutor(drv, dir, rrw, opts...)
+		if err != nil {
+			return err
+		}
+
+		pending, err := ex.Pending(ctx)
+		if errors.Is(err, migrate.ErrNoPendingFiles) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if n > 0 && n < len(pending) {
+			pending = pending[:n]
+		}
+
+		if err := ex.ExecuteFiles(ctx, pending); err != nil {
+			return err
+		}
+		applied = pending
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the last n applied migrations using their companion files in
+// the "down" sub directory. It returns the reverted revisions.
+func (m *Migrator) Down(ctx context.Context, n int) ([]*migrate.Revision, error) {
+	var reverted []*migrate.Revision
+	err := m.withLock(ctx, func(_ migrate.Driver, dir migrate.Dir, rrw *revisionReadWriter) error {
+		revs, err := rrw.ReadRevisions(ctx)
+		if err != nil {
+			return err
+		}
+
+		files, err := dir.Files()
+		if err != nil {
+			return err
+		}
+		byVersion := make(map[string]migrate.File)
+		for _, f := range files {
+			byVersion[f.Version()] = f
+		}
+
+		for i := len(revs) - 1; i >= 0 && len(reverted) < n; i-- {
+			rev := revs[i]
+			if rev.Type.Has(migrate.RevisionTypeBaseline) {
+				return fmt.Errorf("cannot revert baseline version %s", rev.Version)
+			}
+
+			f, ok := byVersion[rev.Version]
+			if !ok {
+				return fmt.Errorf("migration file of version %s not found", rev.Version)
+			}
+
+			if err := m.revert(ctx, f, rev); err != nil {
+				return err
+			}
+			reverted = append(reverted, rev)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status returns the migration state of the database.
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	drv, dir, rrw, err := m.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revs, err := rrw.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ex, err := migrate.NewExecutor(drv, dir, rrw)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Status{
+		Applied: revs,
+	}
+
+	var notClean *migrate.NotCleanError
+	res.Pending, err = ex.Pending(ctx)
+	switch {
+	case errors.As(err, &notClean):
+		res.Dirty = true
+		if res.Pending, err = dir.Files(); err != nil {
+			return nil, err
+		}
+	case err != nil && !errors.Is(err, migrate.ErrNoPendingFiles):
+		return nil, err
+	}
+	if len(revs) > 0 {
+		res.Current = revs[len(revs)-1].Version
+	}
+
+	return res, nil
+}
+
+// Diff writes a new migration file named name with the statements moving the
+// schema of the migration directory to the ent schema. devURL is a URL to an
+// empty database the directory is replayed on to compute the diff.
+func (m *Migrator) Diff(ctx context.Context, devURL, name string) error {
+	dir, err := migrate.NewLocalDir(m.dir)
+	if err != nil {
+		return err
+	}
+
+	return entcmigrate.NamedDiff(
+		ctx,
+		devURL,
+		name,
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(migrate.DefaultFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+	)
+}
+
+// withLock runs fn while holding the migration lock.
+func (m *Migrator) withLock(
+	ctx context.Context,
+	fn func(migrate.Driver, migrate.Dir, *revisionReadWriter) error,
+) error {
+	drv, dir, rrw, err := m.open(ctx)
+	if err != nil {
+		return err
+	}
+
+	locker, ok := drv.(atlasschema.Locker)
+	if !ok {
+		return fmt.Errorf("migration: driver does not support locking")
+	}
+
+	unlock, err := locker.Lock(ctx, lockName, m.lockTimeout)
+	if err != nil {
+		return fmt.Errorf("migration: acquiring lock: %w", err)
+	}
+
+	err = fn(drv, dir, rrw)
+	if uerr := unlock(); uerr != nil && err == nil {
+		err = uerr
+	}
+
+	return err
+}
+
+// open opens the migration directory, validates it against its checksum file
+// and prepares the revision table.
+func (m *Migrator) open(ctx context.Context) (migrate.Driver, migrate.Dir, *revisionReadWriter, error) {
+	if m.dialect != dialect.Postgres {
+		return nil, nil, nil, fmt.Errorf("migration: versioned migrations are not supported on %s", m.dialect)
+	}
+
+	dir, err := migrate.NewLocalDir(m.dir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := migrate.Validate(dir); err != nil {
+		return nil, nil, nil, fmt.Errorf("migration: %s: %w", m.dir, err)
+	}
+
+	drv, err := postgres.Open(m.db)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	rrw := &revisionReadWriter{db: m.db}
+	if err := rrw.init(ctx); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return drv, dir, rrw, nil
+}
+
+// revert runs the down file of a migration in a transaction and deletes its
+// revision.
+func (m *Migrator) revert(ctx context.Context, f migrate.File, rev *migrate.Revision) error {
+	path := filepath.Join(m.dir, downDir, f.Name())
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("migration: reading down file of version %s: %w", rev.Version, err)
+	}
+
+	stmts, err := migrate.NewLocalFile(f.Name(), b).Stmts()
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("migration: reverting version %s: %w", rev.Version, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM `+revisionTable+` WHERE version = $1`, rev.Version); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,152 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// revisionTable is the table keeping track of applied migration files, it uses
+// the same name as the atlas CLI so both tools can operate the same database.
+const revisionTable = "atlas_schema_revisions"
+
+// revisionReadWriter implements migrate.RevisionReadWriter on top of a plain
+// SQL connection.
+type revisionReadWriter struct {
+	db *sql.DB
+}
+
+// Ident returns an object identifies this history table.
+func (r *revisionReadWriter) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{Name: revisionTable}
+}
+
+// init creates the revision table if it does not exist.
+func (r *revisionReadWriter) init(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+revisionTable+` (
+	version          varchar(255) NOT NULL PRIMARY KEY,
+	description      varchar(255) NOT NULL,
+	type             bigint       NOT NULL DEFAULT 2,
+	applied          bigint       NOT NULL DEFAULT 0,
+	total            bigint       NOT NULL DEFAULT 0,
+	executed_at      timestamp    NOT NULL,
+	execution_time   bigint       NOT NULL,
+	error            text         NULL,
+	error_stmt       text         NULL,
+	hash             varchar(255) NOT NULL,
+	partial_hashes   text         NULL,
+	operator_version varchar(255) NOT NULL
+)`)
+	return err
+}
+
+// ReadRevisions returns all revisions ordered by version.
+func (r *revisionReadWriter) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT version, description, type, applied, total, executed_at,
+	execution_time, error, error_stmt, hash, partial_hashes, operator_version
+FROM `+revisionTable+` ORDER BY version`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*migrate.Revision
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rev)
+	}
+
+	return res, rows.Err()
+}
+
+// ReadRevision returns a revision by version, or migrate.ErrRevisionNotExist.
+func (r *revisionReadWriter) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT version, description, type, applied, total, executed_at,
+	execution_time, error, error_stmt, hash, partial_hashes, operator_version
+FROM `+revisionTable+` WHERE version = $1`, version)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, migrate.ErrRevisionNotExist
+	}
+
+	return scanRevision(rows)
+}
+
+// WriteRevision saves the revision, replacing the one of the same version.
+func (r *revisionReadWriter) WriteRevision(ctx context.Context, rev *migrate.Revision) error {
+	partialHashes, err := json.Marshal(rev.PartialHashes)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `INSERT INTO `+revisionTable+` (version, description, type, applied, total,
+	executed_at, execution_time, error, error_stmt, hash, partial_hashes, operator_version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (version) DO UPDATE SET
+	description = excluded.description,
+	type = excluded.type,
+	applied = excluded.applied,
+	total = excluded.total,
+	executed_at = excluded.executed_at,
+	execution_time = excluded.execution_time,
+	error = excluded.error,
+	error_stmt = excluded.error_stmt,
+	hash = excluded.hash,
+	partial_hashes = excluded.partial_hashes,
+	operator_version = excluded.operator_version`,
+		rev.Version, rev.Description, int64(rev.Type), rev.Applied, rev.Total,
+		rev.ExecutedAt.UTC(), int64(rev.ExecutionTime), rev.Error, rev.ErrorStmt,
+		rev.Hash, string(partialHashes), rev.OperatorVersion,
+	)
+	return err
+}
+
+// DeleteRevision deletes a revision by version.
+func (r *revisionReadWriter) DeleteRevision(ctx context.Context, version string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM `+revisionTable+` WHERE version = $1`, version)
+	return err
+}
+
+func scanRevision(rows *sql.Rows) (*migrate.Revision, error) {
+	var (
+		rev                migrate.Revision
+		typ, executionTime int64
+		errText, errStmt   sql.NullString
+		partialHashes      sql.NullString
+		executedAt         time.Time
+	)
+	err := rows.Scan(
+		&rev.Version, &rev.Description, &typ, &rev.Applied, &rev.Total, &executedAt,
+		&executionTime, &errText, &errStmt, &rev.Hash, &partialHashes, &rev.OperatorVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rev.Type = migrate.RevisionType(typ)
+	rev.ExecutedAt = executedAt
+	rev.ExecutionTime = time.Duration(executionTime)
+	rev.Error = errText.String
+	rev.ErrorStmt = errStmt.String
+	if partialHashes.Valid && partialHashes.String != "" {
+		if err := json.Unmarshal([]byte(partialHashes.String), &rev.PartialHashes); err != nil {
+			return nil, errors.Join(errors.New("decoding partial hashes"), err)
+		}
+	}
+
+	return &rev, nil
+}
@@ -0,0 +1,149 @@
+package repoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+)
+
+// adjustAsset adds delta to the quantity of an item or the balance of a
+// currency of a player with client, which should be a transaction, and
+// returns the quantity or balance after the change. Debits are conditional
+// updates returning repo.ErrInsufficientFunds rather than going negative,
+// so that concurrent debits cannot overdraw an asset.
+func adjustAsset(ctx context.Context, client *entc.Client, playerID, assetType, asset string, delta int64) (int64, error) {
+	switch assetType {
+	case entity.AssetItem:
+		return adjustItem(ctx, client, playerID, asset, int(delta))
+	case entity.AssetCurrency:
+		return adjustCurrency(ctx, client, playerID, asset, delta)
+	default:
+		return 0, fmt.Errorf("repo: unknown asset type %q", assetType)
+	}
+}
+
+// lockAssets locks the inventory items and wallets of players holding
+// assets in order of id, so that concurrent transactions moving assets of
+// the same players wait for each other rather than deadlock. It runs with
+// the master client of ctx, which should be a transaction.
+func lockAssets(ctx context.Context, client database.Client, players []string, assets []*entity.TradeAsset) error {
+	var items, currencies []string
+	for _, a := range assets {
+		switch a.AssetType {
+		case entity.AssetItem:
+			items = append(items, a.Asset)
+		case entity.AssetCurrency:
+			currencies = append(currencies, a.Asset)
+		}
+	}
+
+	tx := client.Master(ctx)
+	if len(items) > 0 {
+		_, err := tx.InventoryItem.Query().
+			Where(inventoryitem.PlayerIDIn(players...), inventoryitem.ItemIDIn(items...)).
+			Order(entc.Asc(inventoryitem.FieldID)).
+			Modify(database.ForUpdate(client)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(currencies) > 0 {
+		_, err := tx.Wallet.Query().
+			Where(wallet.PlayerIDIn(players...), wallet.CurrencyIn(currencies...)).
+			Order(entc.Asc(wallet.FieldID)).
+			Modify(database.ForUpdate(client)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func adjustItem(ctx context.Context, client *entc.Client, playerID, itemID string, delta int) (int64, error) {
+	if delta >= 0 {
+		err := client.InventoryItem.Create().
+			SetPlayerID(playerID).
+			SetItemID(itemID).
+			SetQuantity(delta).
+			OnConflictColumns(inventoryitem.FieldPlayerID, inventoryitem.FieldItemID).
+			AddQuantity(delta).
+			UpdateUpdatedAt().
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		n, err := client.InventoryItem.Update().
+			Where(
+				inventoryitem.PlayerID(playerID),
+				inventoryitem.ItemID(itemID),
+				inventoryitem.QuantityGTE(-delta),
+			).
+			AddQuantity(delta).
+			Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			return 0, repo.ErrInsufficientFunds
+		}
+	}
+
+	row, err := client.InventoryItem.Query().
+		Where(inventoryitem.PlayerID(playerID), inventoryitem.ItemID(itemID)).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(row.Quantity), nil
+}
+
+func adjustCurrency(ctx context.Context, client *entc.Client, playerID, currency string, delta int64) (int64, error) {
+	if delta >= 0 {
+		err := client.Wallet.Create().
+			SetPlayerID(playerID).
+			SetCurrency(currency).
+			SetBalance(delta).
+			OnConflictColumns(wallet.FieldPlayerID, wallet.FieldCurrency).
+			AddBalance(delta).
+			UpdateUpdatedAt().
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		n, err := client.Wallet.Update().
+			Where(
+				wallet.PlayerID(playerID),
+				wallet.Currency(currency),
+				wallet.BalanceGTE(-delta),
+			).
+			AddBalance(delta).
+			Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			return 0, repo.ErrInsufficientFunds
+		}
+	}
+
+	row, err := client.Wallet.Query().
+		Where(wallet.PlayerID(playerID), wallet.Currency(currency)).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return row.Balance, nil
+}
@@ -0,0 +1,279 @@
+package repoimpl
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogversion"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+)
+
+// catalogBatchSize is the max number of rows written or matched by one
+// statement, it keeps bulk inserts and IN lists under the bind parameters
+// limit of Postgres.
+const catalogBatchSize = 1000
+
+// CatalogRepo implements interface CatalogRepo.
+type CatalogRepo struct {
+	client database.Client
+}
+
+// NewCatalogRepo creates and returns a new instance of repo.CatalogRepo.
+func NewCatalogRepo(
+	client database.Client,
+) repo.CatalogRepo {
+	return &CatalogRepo{
+		client: client,
+	}
+}
+
+// Apply to apply all changes and create the new version of the catalog in a
+// transaction on master, or in the transaction of ctx. Domain events of the
+// changes are appended to the outbox in the same transaction.
+func (r *CatalogRepo) Apply(ctx context.Context, changes *repo.CatalogChanges) (int64, error) {
+	var version int64
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		events, err := catalogItemEvents(ctx, client, changes)
+		if err != nil {
+			return err
+		}
+
+		if err := applyCatalogChanges(ctx, client, changes); err != nil {
+			return err
+		}
+
+		row, err := client.CatalogVersion.Create().
+			SetChangesetID(changes.ChangesetID).
+			SetPublishedBy(changes.PublishedBy).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		version = row.ID
+
+		payload, err := json.Marshal(&entity.CatalogPublishedEvent{
+			Version:     version,
+			ChangesetID: changes.ChangesetID,
+			PublishedBy: changes.PublishedBy,
+		})
+		if err != nil {
+			return err
+		}
+		events = append(events, &entity.OutboxEvent{
+			AggregateType: entity.AggregateCatalog,
+			AggregateID:   strconv.FormatInt(version, 10),
+			Type:          entity.EventCatalogPublished,
+			Payload:       payload,
+		})
+
+		return appendOutboxEvents(ctx, client, events)
+	})
+
+	return version, err
+}
+
+// Version to get the current version of the catalog.
+func (r *CatalogRepo) Version(ctx context.Context) (int64, error) {
+	row, err := r.client.Slave(ctx).CatalogVersion.Query().
+		Order(entc.Desc(catalogversion.FieldID)).
+		First(ctx)
+	if entc.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return row.ID, nil
+}
+
+func applyCatalogChanges(ctx context.Context, client *entc.Client, changes *repo.CatalogChanges) error {
+	for start := 0; start < len(changes.UpsertItems); start += catalogBatchSize {
+		end := min(start+catalogBatchSize, len(changes.UpsertItems))
+		builders := make([]*entc.ItemCreate, 0, end-start)
+		for _, i := range changes.UpsertItems[start:end] {
+			builders = append(builders, client.Item.Create().
+				SetID(i.ID).
+				SetName(i.Name).
+				SetCategory(i.Category).
+				SetDescription(i.Description),
+			)
+		}
+
+		err := client.Item.CreateBulk(builders...).
+			OnConflictColumns(item.FieldID).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(changes.ReplaceTranslations) > 0 {
+		itemIDs := make([]string, 0, len(changes.ReplaceTranslations))
+		var builders []*entc.ItemTranslationCreate
+		for itemID, translations := range changes.ReplaceTranslations {
+			itemIDs = append(itemIDs, itemID)
+			for _, t := range translations {
+				builders = append(builders, client.ItemTranslation.Create().
+					SetItemID(itemID).
+					SetLocale(t.Locale).
+					SetName(t.Name).
+					SetDescription(t.Description),
+				)
+			}
+		}
+
+		for start := 0; start < len(itemIDs); start += catalogBatchSize {
+			end := min(start+catalogBatchSize, len(itemIDs))
+			_, err := client.ItemTranslation.Delete().
+				Where(itemtranslation.ItemIDIn(itemIDs[start:end]...)).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		for start := 0; start < len(builders); start += catalogBatchSize {
+			end := min(start+catalogBatchSize, len(builders))
+			if err := client.ItemTranslation.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	for start := 0; start < len(changes.DeleteItemIDs); start += catalogBatchSize {
+		end := min(start+catalogBatchSize, len(changes.DeleteItemIDs))
+		_, err := client.ItemTranslation.Delete().
+			Where(itemtranslation.ItemIDIn(changes.DeleteItemIDs[start:end]...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Item.Delete().
+			Where(item.IDIn(changes.DeleteItemIDs[start:end]...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, e := range changes.UpsertLiveEvents {
+		err := client.LiveEvent.Create().
+			SetID(e.ID).
+			SetKind(e.Kind).
+			SetName(e.Name).
+			SetDescription(e.Description).
+			SetTimezone(e.Timezone).
+			SetStartAt(e.StartAt).
+			SetEndAt(e.EndAt).
+			SetItemIds(e.ItemIDs).
+			SetCreatedBy(e.CreatedBy).
+			OnConflictColumns(liveevent.FieldID).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for start := 0; start < len(changes.DeleteLiveEventIDs); start += catalogBatchSize {
+		end := min(start+catalogBatchSize, len(changes.DeleteLiveEventIDs))
+		_, err := client.LiveEvent.Delete().
+			Where(liveevent.IDIn(changes.DeleteLiveEventIDs[start:end]...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// catalogItemEvents returns the events of the item changes, it must be called
+// before they are applied to tell created items from updated ones.
+func catalogItemEvents(ctx context.Context, client *entc.Client, changes *repo.CatalogChanges) ([]*entity.OutboxEvent, error) {
+	upserts := make(map[string]*entity.Item, len(changes.UpsertItems))
+	ids := make([]string, 0, len(changes.UpsertItems)+len(changes.ReplaceTranslations))
+	for _, i := range changes.UpsertItems {
+		upserts[i.ID] = i
+		ids = append(ids, i.ID)
+	}
+	for itemID := range changes.ReplaceTranslations {
+		if _, ok := upserts[itemID]; !ok {
+			ids = append(ids, itemID)
+		}
+	}
+
+	// items of which only translations change are updated.
+	existing := make(map[string]*entity.Item, len(ids))
+	for start := 0; start < len(ids); start += catalogBatchSize {
+		end := min(start+catalogBatchSize, len(ids))
+		rows, err := client.Item.Query().
+			Where(item.IDIn(ids[start:end]...)).
+			All(database.SkipQueryCache(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			existing[row.ID] = &entity.Item{
+				ID:          row.ID,
+				Name:        row.Name,
+				Category:    row.Category,
+				Description: row.Description,
+			}
+		}
+	}
+
+	events := make([]*entity.OutboxEvent, 0, len(ids)+len(changes.DeleteItemIDs))
+	for _, id := range ids {
+		typ := entity.EventItemUpdated
+		i, ok := upserts[id]
+		if !ok {
+			if i, ok = existing[id]; !ok {
+				continue
+			}
+		} else if _, ok := existing[id]; !ok {
+			typ = entity.EventItemCreated
+		}
+
+		payload, err := json.Marshal(&entity.ItemEvent{
+			Item:         i,
+			Translations: changes.ReplaceTranslations[id],
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &entity.OutboxEvent{
+			AggregateType: entity.AggregateItem,
+			AggregateID:   id,
+			Type:          typ,
+			Payload:       payload,
+		})
+	}
+
+	for _, id := range changes.DeleteItemIDs {
+		payload, err := json.Marshal(&entity.ItemEvent{Item: &entity.Item{ID: id}})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &entity.OutboxEvent{
+			AggregateType: entity.AggregateItem,
+			AggregateID:   id,
+			Type:          entity.EventItemDeleted,
+			Payload:       payload,
+		})
+	}
+
+	return events, nil
+}
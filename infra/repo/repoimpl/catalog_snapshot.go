@@ -0,0 +1,63 @@
+package repoimpl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/catalogsnapshot"
+)
+
+// CatalogSnapshotRepo implements interface CatalogSnapshotRepo.
+type CatalogSnapshotRepo struct {
+	client database.Client
+}
+
+// NewCatalogSnapshotRepo creates and returns a new instance of
+// repo.CatalogSnapshotRepo.
+func NewCatalogSnapshotRepo(
+	client database.Client,
+) repo.CatalogSnapshotRepo {
+	return &CatalogSnapshotRepo{
+		client: client,
+	}
+}
+
+// Save to save a snapshot unless its version already has one.
+func (r *CatalogSnapshotRepo) Save(ctx context.Context, snapshot *entity.CatalogSnapshot) error {
+	err := r.client.Master(ctx).CatalogSnapshot.Create().
+		SetID(snapshot.Version).
+		SetHash(snapshot.Hash).
+		SetData(snapshot.Data).
+		OnConflictColumns(catalogsnapshot.FieldID).
+		DoNothing().
+		Exec(ctx)
+	// Postgres returns no row when nothing is inserted.
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	return err
+}
+
+// FindByVersion to find the snapshot of a version.
+func (r *CatalogSnapshotRepo) FindByVersion(ctx context.Context, version int64) (*entity.CatalogSnapshot, error) {
+	row, err := r.client.Slave(ctx).CatalogSnapshot.Get(ctx, version)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CatalogSnapshot{
+		Version:   row.ID,
+		Hash:      row.Hash,
+		Data:      row.Data,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,156 @@
+package repoimpl
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changeset"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/changesetchange"
+)
+
+// ChangesetRepo implements interface ChangesetRepo.
+type ChangesetRepo struct {
+	client database.Client
+}
+
+// NewChangesetRepo creates and returns a new instance of repo.ChangesetRepo.
+func NewChangesetRepo(
+	client database.Client,
+) repo.ChangesetRepo {
+	return &ChangesetRepo{
+		client: client,
+	}
+}
+
+// Create to create a changeset with its changes in a transaction on master,
+// or in the transaction of ctx.
+func (r *ChangesetRepo) Create(ctx context.Context, cs *entity.Changeset) (*entity.Changeset, error) {
+	var res *entity.Changeset
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		row, err := r.client.Master(ctx).Changeset.Create().
+			SetTitle(cs.Title).
+			SetStatus(cs.Status).
+			SetAuthor(cs.Author).
+			SetEditors(cs.Editors).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := r.PutChanges(ctx, row.ID, cs.Changes); err != nil {
+			return err
+		}
+
+		res = toChangeset(row)
+		res.Changes = cs.Changes
+
+		return nil
+	})
+
+	return res, err
+}
+
+// FindByID to find a changeset with its changes by id.
+func (r *ChangesetRepo) FindByID(ctx context.Context, id int64) (*entity.Changeset, error) {
+	row, err := r.client.Slave(ctx).Changeset.Query().
+		Where(changeset.ID(id)).
+		WithChanges(func(q *entc.ChangesetChangeQuery) {
+			q.Order(entc.Asc(changesetchange.FieldItemID))
+		}).
+		Only(ctx)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := toChangeset(row)
+	for _, c := range row.Edges.Changes {
+		change := &entity.ChangesetChange{
+			ItemID:       c.ItemID,
+			Op:           c.Op,
+			Translations: c.Translations,
+		}
+		if c.Op == entity.ChangeOpUpsert {
+			change.Item = &entity.Item{
+				ID:          c.ItemID,
+				Name:        c.Name,
+				Category:    c.Category,
+				Description: c.Description,
+			}
+		}
+		res.Changes = append(res.Changes, change)
+	}
+
+	return res, nil
+}
+
+// PutChanges to create or replace the changes of a changeset by item id.
+func (r *ChangesetRepo) PutChanges(ctx context.Context, id int64, changes []*entity.ChangesetChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	client := r.client.Master(ctx)
+	builders := make([]*entc.ChangesetChangeCreate, 0, len(changes))
+	for _, c := range changes {
+		b := client.ChangesetChange.Create().
+			SetChangesetID(id).
+			SetItemID(c.ItemID).
+			SetOp(c.Op).
+			SetTranslations(c.Translations)
+		if c.Item != nil {
+			b.SetName(c.Item.Name).
+				SetCategory(c.Item.Category).
+				SetDescription(c.Item.Description)
+		}
+		builders = append(builders, b)
+	}
+
+	for start := 0; start < len(builders); start += catalogBatchSize {
+		end := min(start+catalogBatchSize, len(builders))
+		err := client.ChangesetChange.CreateBulk(builders[start:end]...).
+			OnConflictColumns(changesetchange.FieldChangesetID, changesetchange.FieldItemID).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Update to update the status, editors, reviewer and catalog version of a
+// changeset.
+func (r *ChangesetRepo) Update(ctx context.Context, cs *entity.Changeset) error {
+	err := r.client.Master(ctx).Changeset.UpdateOneID(cs.ID).
+		SetStatus(cs.Status).
+		SetEditors(cs.Editors).
+		SetReviewer(cs.Reviewer).
+		SetCatalogVersion(cs.CatalogVersion).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+func toChangeset(row *entc.Changeset) *entity.Changeset {
+	return &entity.Changeset{
+		ID:             row.ID,
+		Title:          row.Title,
+		Status:         row.Status,
+		Author:         row.Author,
+		Editors:        row.Editors,
+		Reviewer:       row.Reviewer,
+		CatalogVersion: row.CatalogVersion,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
@@ -0,0 +1,97 @@
+package repoimpl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+)
+
+// InventoryRepo implements interface InventoryRepo.
+type InventoryRepo struct {
+	client database.Client
+}
+
+// NewInventoryRepo creates and returns a new instance of repo.InventoryRepo.
+func NewInventoryRepo(
+	client database.Client,
+) repo.InventoryRepo {
+	return &InventoryRepo{
+		client: client,
+	}
+}
+
+// FindByPlayer to list the items owned by a player ordered by item.
+func (r *InventoryRepo) FindByPlayer(ctx context.Context, playerID string) ([]*entity.InventoryItem, error) {
+	rows, err := r.client.Slave(ctx).InventoryItem.Query().
+		Where(inventoryitem.PlayerID(playerID), inventoryitem.QuantityGT(0)).
+		Order(entc.Asc(inventoryitem.FieldItemID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.InventoryItem, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &entity.InventoryItem{
+			PlayerID:  row.PlayerID,
+			ItemID:    row.ItemID,
+			Quantity:  row.Quantity,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// Grant to add quantities to the inventory of a player in a transaction on
+// master, or in the transaction of ctx, along with its ledger entries and
+// InventoryGranted event.
+func (r *InventoryRepo) Grant(ctx context.Context, playerID string, grants []*entity.InventoryGrant, source string) error {
+	if len(grants) == 0 {
+		return nil
+	}
+
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		entries := make([]*entity.LedgerEntry, 0, len(grants))
+		for _, g := range grants {
+			balance, err := adjustItem(ctx, client, playerID, g.ItemID, g.Quantity)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, &entity.LedgerEntry{
+				PlayerID:  playerID,
+				AssetType: entity.AssetItem,
+				Asset:     g.ItemID,
+				Delta:     int64(g.Quantity),
+				Balance:   balance,
+				Reason:    entity.LedgerReasonGrant,
+				Reference: source,
+			})
+		}
+		if err := appendLedgerEntries(ctx, client, entries); err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(&entity.InventoryGrantedEvent{
+			PlayerID: playerID,
+			Items:    grants,
+			Source:   source,
+		})
+		if err != nil {
+			return err
+		}
+
+		return appendOutboxEvents(ctx, client, []*entity.OutboxEvent{{
+			AggregateType: entity.AggregatePlayer,
+			AggregateID:   playerID,
+			Type:          entity.EventInventoryGranted,
+			Payload:       payload,
+		}})
+	})
+}
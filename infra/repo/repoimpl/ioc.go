@@ -7,4 +7,27 @@ import (
 // FXModule represents a FX module for options.
 var FXModule = fx.Provide(
 	NewItemRepo,
+	NewItemTranslationRepo,
+	NewCatalogRepo,
+	NewChangesetRepo,
+	NewCatalogSnapshotRepo,
+	NewOutboxRepo,
+	NewWebhookSubscriptionRepo,
+	NewWebhookDeliveryRepo,
+	NewJobRunRepo,
+	NewJobTaskRepo,
+	NewLiveEventRepo,
+	NewQuestRepo,
+	NewQuestProgressRepo,
+	NewPlayerEventRepo,
+	NewInventoryRepo,
+	NewLeaderboardRepo,
+	NewLeaderboardSnapshotRepo,
+	NewPlayerRepo,
+	NewPlayerAccountRepo,
+	NewWalletRepo,
+	NewLedgerRepo,
+	NewTradeRepo,
+	NewMarketRepo,
+	NewItemInstanceRepo,
 )
@@ -0,0 +1,282 @@
+package repoimpl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// ItemInstanceRepo implements interface ItemInstanceRepo.
+type ItemInstanceRepo struct {
+	client database.Client
+}
+
+// NewItemInstanceRepo creates and returns a new instance of
+// repo.ItemInstanceRepo.
+func NewItemInstanceRepo(
+	client database.Client,
+) repo.ItemInstanceRepo {
+	return &ItemInstanceRepo{
+		client: client,
+	}
+}
+
+// Mint to create an instance along with its history and event in a
+// transaction on master, or in the transaction of ctx.
+func (r *ItemInstanceRepo) Mint(ctx context.Context, inst *entity.ItemInstance, actor, reason string) (*entity.ItemInstance, error) {
+	var res *entity.ItemInstance
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		row, err := client.ItemInstance.Create().
+			SetItemID(inst.ItemID).
+			SetOwnerID(inst.OwnerID).
+			SetDurability(inst.Durability).
+			SetMaxDurability(inst.MaxDurability).
+			SetStats(inst.Stats).
+			SetStatus(entity.ItemInstanceStatusActive).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		res = toItemInstance(row)
+
+		return appendItemInstanceHistory(ctx, client, res, &entity.ItemInstanceHistory{
+			Action:     entity.ItemInstanceActionMint,
+			ToPlayerID: res.OwnerID,
+			Actor:      actor,
+			Reason:     reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Transfer to move an instance to another owner along with its history and
+// event in a transaction on master, or in the transaction of ctx.
+func (r *ItemInstanceRepo) Transfer(ctx context.Context, id, fromID, toID, actor, reason string) (*entity.ItemInstance, error) {
+	var res *entity.ItemInstance
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		n, err := client.ItemInstance.Update().
+			Where(
+				iteminstance.ID(id),
+				iteminstance.OwnerID(fromID),
+				iteminstance.Status(entity.ItemInstanceStatusActive),
+			).
+			SetOwnerID(toID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return repo.ErrConflict
+		}
+
+		row, err := client.ItemInstance.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		res = toItemInstance(row)
+
+		return appendItemInstanceHistory(ctx, client, res, &entity.ItemInstanceHistory{
+			Action:       entity.ItemInstanceActionTransfer,
+			FromPlayerID: fromID,
+			ToPlayerID:   toID,
+			Actor:        actor,
+			Reason:       reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Burn to burn an instance along with its history and event in a
+// transaction on master, or in the transaction of ctx.
+func (r *ItemInstanceRepo) Burn(ctx context.Context, id, actor, reason string, now int64) (*entity.ItemInstance, error) {
+	var res *entity.ItemInstance
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		n, err := client.ItemInstance.Update().
+			Where(iteminstance.ID(id), iteminstance.Status(entity.ItemInstanceStatusActive)).
+			SetStatus(entity.ItemInstanceStatusBurned).
+			SetBurnedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return repo.ErrConflict
+		}
+
+		row, err := client.ItemInstance.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		res = toItemInstance(row)
+
+		return appendItemInstanceHistory(ctx, client, res, &entity.ItemInstanceHistory{
+			Action:       entity.ItemInstanceActionBurn,
+			FromPlayerID: res.OwnerID,
+			Actor:        actor,
+			Reason:       reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FindByID to find an instance by id.
+func (r *ItemInstanceRepo) FindByID(ctx context.Context, id string) (*entity.ItemInstance, error) {
+	row, err := r.client.Slave(ctx).ItemInstance.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toItemInstance(row), nil
+}
+
+// FindAll to list instances with filter and pagination from the latest
+// minted.
+func (r *ItemInstanceRepo) FindAll(ctx context.Context, filter repo.ItemInstanceFilter, limit, offset int) (*repo.ListItemInstanceResult, error) {
+	query := r.client.Slave(ctx).ItemInstance.Query()
+	if filter.OwnerID != "" {
+		query = query.Where(iteminstance.OwnerID(filter.OwnerID))
+	}
+	if filter.ItemID != "" {
+		query = query.Where(iteminstance.ItemID(filter.ItemID))
+	}
+	if filter.Status != "" {
+		query = query.Where(iteminstance.Status(filter.Status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(entc.Desc(iteminstance.FieldCreatedAt), entc.Asc(iteminstance.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.ItemInstance, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toItemInstance(row))
+	}
+
+	return &repo.ListItemInstanceResult{
+		Instances: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// FindHistory to find the history of an instance in order.
+func (r *ItemInstanceRepo) FindHistory(ctx context.Context, id string) ([]*entity.ItemInstanceHistory, error) {
+	rows, err := r.client.Slave(ctx).ItemInstanceHistory.Query().
+		Where(iteminstancehistory.InstanceID(id)).
+		Order(entc.Asc(iteminstancehistory.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.ItemInstanceHistory, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toItemInstanceHistory(row))
+	}
+
+	return res, nil
+}
+
+// appendItemInstanceHistory writes the history of a change of an instance
+// along with its event with client, which should be a transaction.
+func appendItemInstanceHistory(ctx context.Context, client *entc.Client, inst *entity.ItemInstance, h *entity.ItemInstanceHistory) error {
+	row, err := client.ItemInstanceHistory.Create().
+		SetInstanceID(inst.ID).
+		SetAction(h.Action).
+		SetFromPlayerID(h.FromPlayerID).
+		SetToPlayerID(h.ToPlayerID).
+		SetActor(h.Actor).
+		SetReason(h.Reason).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(&entity.ItemInstanceEvent{
+		Instance: inst,
+		History:  toItemInstanceHistory(row),
+	})
+	if err != nil {
+		return err
+	}
+
+	events := map[string]string{
+		entity.ItemInstanceActionMint:     entity.EventItemInstanceMinted,
+		entity.ItemInstanceActionTransfer: entity.EventItemInstanceTransferred,
+		entity.ItemInstanceActionBurn:     entity.EventItemInstanceBurned,
+	}
+
+	return appendOutboxEvents(ctx, client, []*entity.OutboxEvent{{
+		AggregateType: entity.AggregateItemInstance,
+		AggregateID:   inst.ID,
+		Type:          events[h.Action],
+		Payload:       payload,
+	}})
+}
+
+func toItemInstance(row *entc.ItemInstance) *entity.ItemInstance {
+	return &entity.ItemInstance{
+		ID:            row.ID,
+		ItemID:        row.ItemID,
+		OwnerID:       row.OwnerID,
+		Durability:    row.Durability,
+		MaxDurability: row.MaxDurability,
+		Stats:         row.Stats,
+		Status:        row.Status,
+		BurnedAt:      row.BurnedAt,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+func toItemInstanceHistory(row *entc.ItemInstanceHistory) *entity.ItemInstanceHistory {
+	return &entity.ItemInstanceHistory{
+		ID:           row.ID,
+		InstanceID:   row.InstanceID,
+		Action:       row.Action,
+		FromPlayerID: row.FromPlayerID,
+		ToPlayerID:   row.ToPlayerID,
+		Actor:        row.Actor,
+		Reason:       row.Reason,
+		CreatedAt:    row.CreatedAt,
+	}
+}
@@ -0,0 +1,124 @@
+package repoimpl
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+)
+
+// ItemTranslationRepo implements interface ItemTranslationRepo.
+type ItemTranslationRepo struct {
+	client database.Client
+}
+
+// NewItemTranslationRepo creates and returns a new instance of repo.ItemTranslationRepo.
+func NewItemTranslationRepo(
+	client database.Client,
+) repo.ItemTranslationRepo {
+	return &ItemTranslationRepo{
+		client: client,
+	}
+}
+
+// FindAll to list all translations of all items.
+func (r *ItemTranslationRepo) FindAll(ctx context.Context) ([]*entity.ItemTranslation, error) {
+	rows, err := r.client.Slave(ctx).ItemTranslation.Query().
+		Order(entc.Asc(itemtranslation.FieldItemID, itemtranslation.FieldLocale)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toItemTranslations(rows), nil
+}
+
+// FindByLocale to list all translations of a locale.
+func (r *ItemTranslationRepo) FindByLocale(ctx context.Context, locale string) ([]*entity.ItemTranslation, error) {
+	rows, err := r.client.Slave(ctx).ItemTranslation.Query().
+		Where(itemtranslation.Locale(locale)).
+		Order(entc.Asc(itemtranslation.FieldItemID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toItemTranslations(rows), nil
+}
+
+// Upsert to create translations or update the existing ones of the same item
+// and locale. Empty fields leave the existing translation unchanged,
+// translations must be unique per item and locale.
+func (r *ItemTranslationRepo) Upsert(ctx context.Context, translations []*entity.ItemTranslation) error {
+	if len(translations) == 0 {
+		return nil
+	}
+
+	// a statement updates the same columns of all its rows, rows are grouped
+	// by the fields they carry.
+	var groups [4][]*entity.ItemTranslation
+	for _, t := range translations {
+		var g int
+		if t.Name != "" {
+			g |= 1
+		}
+		if t.Description != "" {
+			g |= 2
+		}
+		groups[g] = append(groups[g], t)
+	}
+
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		for g, group := range groups {
+			if g == 0 || len(group) == 0 {
+				continue
+			}
+
+			builders := make([]*entc.ItemTranslationCreate, 0, len(group))
+			for _, t := range group {
+				builders = append(builders, client.ItemTranslation.Create().
+					SetItemID(t.ItemID).
+					SetLocale(t.Locale).
+					SetName(t.Name).
+					SetDescription(t.Description),
+				)
+			}
+
+			err := client.ItemTranslation.CreateBulk(builders...).
+				OnConflictColumns(itemtranslation.FieldItemID, itemtranslation.FieldLocale).
+				Update(func(u *entc.ItemTranslationUpsert) {
+					if g&1 != 0 {
+						u.UpdateName()
+					}
+					if g&2 != 0 {
+						u.UpdateDescription()
+					}
+					u.UpdateUpdatedAt()
+				}).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func toItemTranslations(rows []*entc.ItemTranslation) []*entity.ItemTranslation {
+	var res []*entity.ItemTranslation
+	for _, row := range rows {
+		res = append(res, &entity.ItemTranslation{
+			ItemID:      row.ItemID,
+			Locale:      row.Locale,
+			Name:        row.Name,
+			Description: row.Description,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,77 @@
+package repoimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+)
+
+func newTestClient(t *testing.T) database.Client {
+	client, err := database.New(
+		database.Options.Driver(database.DriverSQLite),
+		database.Options.MasterURI("file:"+t.Name()+"?mode=memory&cache=shared&_fk=1"),
+		database.Options.MaxIdleConns(1),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+func TestItemTranslationRepo_Upsert(t *testing.T) {
+	tests := []struct {
+		name string
+		rows []*entity.ItemTranslation
+		want []*entity.ItemTranslation
+	}{
+		{
+			name: "TC01 - both fields - should replace the translation",
+			rows: []*entity.ItemTranslation{
+				{ItemID: "sw", Locale: "fr", Name: "Épée", Description: "Tranchante"},
+				{ItemID: "sh", Locale: "fr", Name: "Bouclier", Description: "Solide"},
+			},
+			want: []*entity.ItemTranslation{
+				{ItemID: "sh", Locale: "fr", Name: "Bouclier", Description: "Solide"},
+				{ItemID: "sw", Locale: "fr", Name: "Épée", Description: "Tranchante"},
+			},
+		},
+		{
+			name: "TC02 - one field - should keep the other",
+			rows: []*entity.ItemTranslation{
+				{ItemID: "sw", Locale: "fr", Name: "Lame"},
+				{ItemID: "sh", Locale: "fr", Description: "Rond"},
+			},
+			want: []*entity.ItemTranslation{
+				{ItemID: "sh", Locale: "fr", Name: "Écu", Description: "Rond"},
+				{ItemID: "sw", Locale: "fr", Name: "Lame", Description: "Aiguisée"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := newTestClient(t)
+			for _, id := range []string{"sw", "sh"} {
+				require.NoError(t, client.Master(ctx).Item.Create().SetID(id).SetName(id).SetCategory("weapon").SetDescription("").Exec(ctx))
+			}
+
+			r := NewItemTranslationRepo(client)
+			require.NoError(t, r.Upsert(ctx, []*entity.ItemTranslation{
+				{ItemID: "sw", Locale: "fr", Name: "Épée", Description: "Aiguisée"},
+				{ItemID: "sh", Locale: "fr", Name: "Écu", Description: "Léger"},
+			}))
+
+			require.NoError(t, r.Upsert(ctx, tt.rows))
+
+			got, err := r.FindByLocale(ctx, "fr")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package repoimpl
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobrun"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/jobtask"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// JobRunRepo implements interface JobRunRepo.
+type JobRunRepo struct {
+	client database.Client
+}
+
+// NewJobRunRepo creates and returns a new instance of repo.JobRunRepo.
+func NewJobRunRepo(
+	client database.Client,
+) repo.JobRunRepo {
+	return &JobRunRepo{
+		client: client,
+	}
+}
+
+// Create to create a run.
+func (r *JobRunRepo) Create(ctx context.Context, run *entity.JobRun) (*entity.JobRun, error) {
+	row, err := r.client.Master(ctx).JobRun.Create().
+		SetJob(run.Job).
+		SetTrigger(run.Trigger).
+		SetTaskID(run.TaskID).
+		SetStatus(run.Status).
+		SetNode(run.Node).
+		SetScheduledAt(run.ScheduledAt).
+		SetStartedAt(run.StartedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toJobRun(row), nil
+}
+
+// Update to update the outcome of a run.
+func (r *JobRunRepo) Update(ctx context.Context, run *entity.JobRun) error {
+	err := r.client.Master(ctx).JobRun.UpdateOneID(run.ID).
+		SetStatus(run.Status).
+		SetFinishedAt(run.FinishedAt).
+		SetDurationMs(run.DurationMs).
+		SetError(run.Error).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+// FindLast to find the latest run of a job. Reads go to master, so runs of
+// other replicas are seen right away.
+func (r *JobRunRepo) FindLast(ctx context.Context, job string) (*entity.JobRun, error) {
+	row, err := r.client.Master(database.SkipQueryCache(ctx)).JobRun.Query().
+		Where(jobrun.Job(job)).
+		Order(entc.Desc(jobrun.FieldID)).
+		First(ctx)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toJobRun(row), nil
+}
+
+// FindByJob to list the runs of a job with pagination.
+func (r *JobRunRepo) FindByJob(ctx context.Context, job string, limit, offset int) (*repo.ListJobRunResult, error) {
+	query := r.client.Slave(ctx).JobRun.Query().
+		Where(jobrun.Job(job))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(entc.Desc(jobrun.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.JobRun, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toJobRun(row))
+	}
+
+	return &repo.ListJobRunResult{
+		Runs: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// DeleteBefore to delete the runs started before a time.
+func (r *JobRunRepo) DeleteBefore(ctx context.Context, before int64) (int, error) {
+	return r.client.Master(ctx).JobRun.Delete().
+		Where(jobrun.StartedAtLT(before)).
+		Exec(ctx)
+}
+
+func toJobRun(row *entc.JobRun) *entity.JobRun {
+	return &entity.JobRun{
+		ID:          row.ID,
+		Job:         row.Job,
+		Trigger:     row.Trigger,
+		TaskID:      row.TaskID,
+		Status:      row.Status,
+		Node:        row.Node,
+		ScheduledAt: row.ScheduledAt,
+		StartedAt:   row.StartedAt,
+		FinishedAt:  row.FinishedAt,
+		DurationMs:  row.DurationMs,
+		Error:       row.Error,
+	}
+}
+
+// JobTaskRepo implements interface JobTaskRepo.
+type JobTaskRepo struct {
+	client database.Client
+}
+
+// NewJobTaskRepo creates and returns a new instance of repo.JobTaskRepo.
+func NewJobTaskRepo(
+	client database.Client,
+) repo.JobTaskRepo {
+	return &JobTaskRepo{
+		client: client,
+	}
+}
+
+// Create to create a pending task, in the transaction of ctx if any so that
+// tasks are enqueued along with the changes they follow.
+func (r *JobTaskRepo) Create(ctx context.Context, task *entity.JobTask) (*entity.JobTask, error) {
+	row, err := r.client.Master(ctx).JobTask.Create().
+		SetQueue(task.Queue).
+		SetPayload(task.Payload).
+		SetStatus(entity.JobTaskStatusPending).
+		SetRunAt(task.RunAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toJobTask(row), nil
+}
+
+// FindDue to list the pending tasks of a queue due at now. Reads go to
+// master, so tasks are never missed because of replication lag.
+func (r *JobTaskRepo) FindDue(ctx context.Context, queue string, now int64, limit int) ([]*entity.JobTask, error) {
+	rows, err := r.client.Master(database.SkipQueryCache(ctx)).JobTask.Query().
+		Where(
+			jobtask.Queue(queue),
+			jobtask.Status(entity.JobTaskStatusPending),
+			jobtask.RunAtLTE(now),
+		).
+		Order(entc.Asc(jobtask.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.JobTask, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toJobTask(row))
+	}
+
+	return res, nil
+}
+
+// Update to update the state of a task.
+func (r *JobTaskRepo) Update(ctx context.Context, task *entity.JobTask) error {
+	err := r.client.Master(ctx).JobTask.UpdateOneID(task.ID).
+		SetStatus(task.Status).
+		SetAttempts(task.Attempts).
+		SetRunAt(task.RunAt).
+		SetLastError(task.LastError).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+// CountByStatus to count the tasks of a queue by status.
+func (r *JobTaskRepo) CountByStatus(ctx context.Context, queue string) (map[string]int, error) {
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+	err := r.client.Slave(ctx).JobTask.Query().
+		Where(jobtask.Queue(queue)).
+		GroupBy(jobtask.FieldStatus).
+		Aggregate(entc.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]int, len(rows))
+	for _, row := range rows {
+		res[row.Status] = row.Count
+	}
+
+	return res, nil
+}
+
+// DeleteFinishedBefore to delete the done and failed tasks last updated
+// before a time.
+func (r *JobTaskRepo) DeleteFinishedBefore(ctx context.Context, before int64) (int, error) {
+	return r.client.Master(ctx).JobTask.Delete().
+		Where(
+			jobtask.StatusIn(entity.JobTaskStatusDone, entity.JobTaskStatusFailed),
+			jobtask.UpdatedAtLT(before),
+		).
+		Exec(ctx)
+}
+
+func toJobTask(row *entc.JobTask) *entity.JobTask {
+	return &entity.JobTask{
+		ID:        row.ID,
+		Queue:     row.Queue,
+		Payload:   row.Payload,
+		Status:    row.Status,
+		Attempts:  row.Attempts,
+		RunAt:     row.RunAt,
+		LastError: row.LastError,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,256 @@
+package repoimpl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboard"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/leaderboardsnapshot"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// LeaderboardRepo implements interface LeaderboardRepo.
+type LeaderboardRepo struct {
+	client database.Client
+}
+
+// NewLeaderboardRepo creates and returns a new instance of
+// repo.LeaderboardRepo.
+func NewLeaderboardRepo(
+	client database.Client,
+) repo.LeaderboardRepo {
+	return &LeaderboardRepo{
+		client: client,
+	}
+}
+
+// Create to create a leaderboard.
+func (r *LeaderboardRepo) Create(ctx context.Context, l *entity.Leaderboard) (*entity.Leaderboard, error) {
+	row, err := r.client.Master(ctx).Leaderboard.Create().
+		SetID(l.ID).
+		SetName(l.Name).
+		SetMetric(l.Metric).
+		SetPolicy(l.Policy).
+		SetReset(l.Reset).
+		SetTimezone(l.Timezone).
+		SetCreatedBy(l.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLeaderboard(row), nil
+}
+
+// FindAll to list the leaderboards ordered by id.
+func (r *LeaderboardRepo) FindAll(ctx context.Context) ([]*entity.Leaderboard, error) {
+	rows, err := r.client.Slave(ctx).Leaderboard.Query().
+		Order(entc.Asc(leaderboard.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLeaderboards(rows), nil
+}
+
+// FindByMetric to list the leaderboards of a metric ordered by id.
+func (r *LeaderboardRepo) FindByMetric(ctx context.Context, metric string) ([]*entity.Leaderboard, error) {
+	rows, err := r.client.Slave(ctx).Leaderboard.Query().
+		Where(leaderboard.Metric(metric)).
+		Order(entc.Asc(leaderboard.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLeaderboards(rows), nil
+}
+
+// FindByID to find a leaderboard by id.
+func (r *LeaderboardRepo) FindByID(ctx context.Context, id string) (*entity.Leaderboard, error) {
+	row, err := r.client.Slave(ctx).Leaderboard.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toLeaderboard(row), nil
+}
+
+// Update to update a leaderboard.
+func (r *LeaderboardRepo) Update(ctx context.Context, l *entity.Leaderboard) error {
+	err := r.client.Master(ctx).Leaderboard.UpdateOneID(l.ID).
+		SetName(l.Name).
+		SetMetric(l.Metric).
+		SetPolicy(l.Policy).
+		SetReset(l.Reset).
+		SetTimezone(l.Timezone).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+// Delete to delete a leaderboard along with its snapshots in a transaction
+// on master, or in the transaction of ctx.
+func (r *LeaderboardRepo) Delete(ctx context.Context, id string) error {
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		_, err := client.LeaderboardSnapshot.Delete().
+			Where(leaderboardsnapshot.LeaderboardID(id)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = client.Leaderboard.DeleteOneID(id).Exec(ctx)
+		if entc.IsNotFound(err) {
+			return repo.ErrNotFound
+		}
+
+		return err
+	})
+}
+
+func toLeaderboards(rows []*entc.Leaderboard) []*entity.Leaderboard {
+	res := make([]*entity.Leaderboard, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toLeaderboard(row))
+	}
+
+	return res
+}
+
+func toLeaderboard(row *entc.Leaderboard) *entity.Leaderboard {
+	return &entity.Leaderboard{
+		ID:        row.ID,
+		Name:      row.Name,
+		Metric:    row.Metric,
+		Policy:    row.Policy,
+		Reset:     row.Reset,
+		Timezone:  row.Timezone,
+		CreatedBy: row.CreatedBy,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+// LeaderboardSnapshotRepo implements interface LeaderboardSnapshotRepo.
+type LeaderboardSnapshotRepo struct {
+	client database.Client
+}
+
+// NewLeaderboardSnapshotRepo creates and returns a new instance of
+// repo.LeaderboardSnapshotRepo.
+func NewLeaderboardSnapshotRepo(
+	client database.Client,
+) repo.LeaderboardSnapshotRepo {
+	return &LeaderboardSnapshotRepo{
+		client: client,
+	}
+}
+
+// Create to create a snapshot, snapshots of periods already snapshotted are
+// left untouched.
+func (r *LeaderboardSnapshotRepo) Create(ctx context.Context, s *entity.LeaderboardSnapshot) (bool, error) {
+	id, err := r.client.Master(ctx).LeaderboardSnapshot.Create().
+		SetLeaderboardID(s.LeaderboardID).
+		SetPeriod(s.Period.Key).
+		SetPeriodStart(s.Period.Start).
+		SetPeriodEnd(s.Period.End).
+		SetEntries(s.Entries).
+		OnConflictColumns(leaderboardsnapshot.FieldLeaderboardID, leaderboardsnapshot.FieldPeriod).
+		DoNothing().
+		ID(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	return id != 0, err
+}
+
+// FindByPeriod to find the snapshot of a period of a leaderboard.
+func (r *LeaderboardSnapshotRepo) FindByPeriod(ctx context.Context, leaderboardID, period string) (*entity.LeaderboardSnapshot, error) {
+	row, err := r.client.Slave(ctx).LeaderboardSnapshot.Query().
+		Where(
+			leaderboardsnapshot.LeaderboardID(leaderboardID),
+			leaderboardsnapshot.Period(period),
+		).
+		Only(ctx)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toLeaderboardSnapshot(row), nil
+}
+
+// FindByLeaderboard to list the snapshots of a leaderboard with pagination,
+// their entries are not loaded.
+func (r *LeaderboardSnapshotRepo) FindByLeaderboard(ctx context.Context, leaderboardID string, limit, offset int) (*repo.ListLeaderboardSnapshotResult, error) {
+	query := r.client.Slave(ctx).LeaderboardSnapshot.Query().
+		Where(leaderboardsnapshot.LeaderboardID(leaderboardID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Select(
+			leaderboardsnapshot.FieldID,
+			leaderboardsnapshot.FieldLeaderboardID,
+			leaderboardsnapshot.FieldPeriod,
+			leaderboardsnapshot.FieldPeriodStart,
+			leaderboardsnapshot.FieldPeriodEnd,
+			leaderboardsnapshot.FieldCreatedAt,
+		).
+		Order(entc.Desc(leaderboardsnapshot.FieldPeriodEnd)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.LeaderboardSnapshot, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toLeaderboardSnapshot(row))
+	}
+
+	return &repo.ListLeaderboardSnapshotResult{
+		Snapshots: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+func toLeaderboardSnapshot(row *entc.LeaderboardSnapshot) *entity.LeaderboardSnapshot {
+	return &entity.LeaderboardSnapshot{
+		ID:            row.ID,
+		LeaderboardID: row.LeaderboardID,
+		Period: &entity.LeaderboardPeriod{
+			Key:   row.Period,
+			Start: row.PeriodStart,
+			End:   row.PeriodEnd,
+		},
+		Entries:   row.Entries,
+		CreatedAt: row.CreatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package repoimpl
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/liveevent"
+)
+
+// LiveEventRepo implements interface LiveEventRepo.
+type LiveEventRepo struct {
+	client database.Client
+}
+
+// NewLiveEventRepo creates and returns a new instance of repo.LiveEventRepo.
+func NewLiveEventRepo(
+	client database.Client,
+) repo.LiveEventRepo {
+	return &LiveEventRepo{
+		client: client,
+	}
+}
+
+// FindAll to list all events ordered by start.
+func (r *LiveEventRepo) FindAll(ctx context.Context) ([]*entity.LiveEvent, error) {
+	rows, err := r.client.Slave(ctx).LiveEvent.Query().
+		Order(entc.Asc(liveevent.FieldStartAt), entc.Asc(liveevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.LiveEvent, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toLiveEvent(row))
+	}
+
+	return res, nil
+}
+
+// FindByID to find an event by id.
+func (r *LiveEventRepo) FindByID(ctx context.Context, id string) (*entity.LiveEvent, error) {
+	row, err := r.client.Slave(ctx).LiveEvent.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toLiveEvent(row), nil
+}
+
+func toLiveEvent(row *entc.LiveEvent) *entity.LiveEvent {
+	return &entity.LiveEvent{
+		ID:          row.ID,
+		Kind:        row.Kind,
+		Name:        row.Name,
+		Description: row.Description,
+		Timezone:    row.Timezone,
+		StartAt:     row.StartAt,
+		EndAt:       row.EndAt,
+		ItemIDs:     row.ItemIds,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
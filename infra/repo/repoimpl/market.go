@@ -0,0 +1,480 @@
+package repoimpl
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketfill"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// maxMarketFills is the maximum number of orders an order is matched
+// against when placed, the rest of its quantity staying open.
+const maxMarketFills = 100
+
+// MarketRepo implements interface MarketRepo.
+type MarketRepo struct {
+	client database.Client
+}
+
+// NewMarketRepo creates and returns a new instance of repo.MarketRepo.
+func NewMarketRepo(
+	client database.Client,
+) repo.MarketRepo {
+	return &MarketRepo{
+		client: client,
+	}
+}
+
+// Place to place and match an order in a transaction on master, or in the
+// transaction of ctx. The matched orders are locked in order of matching,
+// so that concurrent orders fill each quantity once, then the traded rows of
+// their players in order of id, before the escrow of the order is taken.
+func (r *MarketRepo) Place(ctx context.Context, o *entity.MarketOrder, feeBps int64) (*entity.MarketOrder, []*entity.MarketFill, error) {
+	var (
+		res   *entity.MarketOrder
+		fills []*entity.MarketFill
+	)
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		row, err := client.MarketOrder.Create().
+			SetPlayerID(o.PlayerID).
+			SetSide(o.Side).
+			SetItemID(o.ItemID).
+			SetCurrency(o.Currency).
+			SetPrice(o.Price).
+			SetQuantity(o.Quantity).
+			SetRemaining(o.Quantity).
+			SetStatus(entity.MarketOrderStatusOpen).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		order := toMarketOrder(row)
+
+		matches, err := r.findMatches(ctx, client, order)
+		if err != nil {
+			return err
+		}
+
+		players := []string{order.PlayerID}
+		for _, m := range matches {
+			players = append(players, m.PlayerID)
+		}
+		assets := []*entity.TradeAsset{
+			{AssetType: entity.AssetItem, Asset: order.ItemID},
+			{AssetType: entity.AssetCurrency, Asset: order.Currency},
+		}
+		if err := lockAssets(ctx, r.client, players, assets); err != nil {
+			return err
+		}
+
+		escrow := escrowOf(order, order.Quantity)
+		if err := moveAssets(ctx, client, order.PlayerID, escrow, -1, entity.LedgerReasonMarketEscrow, order.Reference()); err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			res = order
+			return nil
+		}
+
+		for _, m := range matches {
+			if order.Remaining == 0 {
+				break
+			}
+			fill, err := fillOrders(ctx, client, order, m, min(order.Remaining, m.Remaining), feeBps)
+			if err != nil {
+				return err
+			}
+			order.Remaining -= fill.Quantity
+			fills = append(fills, fill)
+		}
+
+		row, err = client.MarketOrder.Get(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		res = toMarketOrder(row)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, fills, nil
+}
+
+// Cancel to cancel an order and release its escrow in a transaction on
+// master, or in the transaction of ctx.
+func (r *MarketRepo) Cancel(ctx context.Context, id int64) error {
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		n, err := client.MarketOrder.Update().
+			Where(marketorder.ID(id), marketorder.Status(entity.MarketOrderStatusOpen)).
+			SetStatus(entity.MarketOrderStatusCancelled).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return repo.ErrConflict
+		}
+
+		row, err := client.MarketOrder.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		o := toMarketOrder(row)
+
+		return moveAssets(ctx, client, o.PlayerID, escrowOf(o, o.Remaining), 1, entity.LedgerReasonMarketRelease, o.Reference())
+	})
+}
+
+// FindOrderByID to find an order by id.
+func (r *MarketRepo) FindOrderByID(ctx context.Context, id int64) (*entity.MarketOrder, error) {
+	row, err := r.client.Slave(ctx).MarketOrder.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toMarketOrder(row), nil
+}
+
+// FindOrders to list orders with filter and pagination.
+func (r *MarketRepo) FindOrders(ctx context.Context, filter repo.MarketOrderFilter, limit, offset int) (*repo.ListMarketOrderResult, error) {
+	query := r.client.Slave(ctx).MarketOrder.Query()
+	if filter.PlayerID != "" {
+		query = query.Where(marketorder.PlayerID(filter.PlayerID))
+	}
+	if filter.ItemID != "" {
+		query = query.Where(marketorder.ItemID(filter.ItemID))
+	}
+	if filter.Side != "" {
+		query = query.Where(marketorder.Side(filter.Side))
+	}
+	if filter.Status != "" {
+		query = query.Where(marketorder.Status(filter.Status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch filter.Sort {
+	case repo.MarketSortPrice:
+		query = query.Order(entc.Asc(marketorder.FieldPrice), entc.Asc(marketorder.FieldID))
+	case repo.MarketSortPriceDesc:
+		query = query.Order(entc.Desc(marketorder.FieldPrice), entc.Asc(marketorder.FieldID))
+	default:
+		query = query.Order(entc.Desc(marketorder.FieldID))
+	}
+	rows, err := query.Offset(offset).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.MarketOrder, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toMarketOrder(row))
+	}
+
+	return &repo.ListMarketOrderResult{
+		Orders: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// FindFills to list the fills of an item with pagination from the latest.
+func (r *MarketRepo) FindFills(ctx context.Context, itemID string, limit, offset int) (*repo.ListMarketFillResult, error) {
+	query := r.client.Slave(ctx).MarketFill.Query().
+		Where(marketfill.ItemID(itemID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(entc.Desc(marketfill.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.MarketFill, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toMarketFill(row))
+	}
+
+	return &repo.ListMarketFillResult{
+		Fills: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// FindCandles to aggregate the fills of an item into candles. Fills are
+// grouped by interval in the database, the open and close prices being
+// those of the first and last fill of each interval by id.
+func (r *MarketRepo) FindCandles(ctx context.Context, itemID, currency string, from, to, interval int64) ([]*entity.MarketCandle, error) {
+	client := r.client.Slave(ctx)
+	var rows []struct {
+		Start   int64 `json:"start"`
+		High    int64 `json:"high"`
+		Low     int64 `json:"low"`
+		Volume  int64 `json:"volume"`
+		Fills   int   `json:"fills"`
+		FirstID int64 `json:"first_id"`
+		LastID  int64 `json:"last_id"`
+	}
+	err := client.MarketFill.Query().
+		Where(
+			marketfill.ItemID(itemID),
+			marketfill.Currency(currency),
+			marketfill.CreatedAtGTE(from),
+			marketfill.CreatedAtLT(to),
+		).
+		Modify(func(s *sql.Selector) {
+			createdAt := s.C(marketfill.FieldCreatedAt)
+			s.Select(
+				sql.As(fmt.Sprintf("%s - %s %% %d", createdAt, createdAt, interval), "start"),
+				sql.As(sql.Max(s.C(marketfill.FieldPrice)), "high"),
+				sql.As(sql.Min(s.C(marketfill.FieldPrice)), "low"),
+				sql.As(sql.Sum(s.C(marketfill.FieldQuantity)), "volume"),
+				sql.As(sql.Count("*"), "fills"),
+				sql.As(sql.Min(s.C(marketfill.FieldID)), "first_id"),
+				sql.As(sql.Max(s.C(marketfill.FieldID)), "last_id"),
+			).
+				GroupBy("start").
+				OrderBy("start")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []*entity.MarketCandle{}, nil
+	}
+
+	ids := make([]int64, 0, 2*len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.FirstID, row.LastID)
+	}
+	edges, err := client.MarketFill.Query().
+		Where(marketfill.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prices := make(map[int64]int64, len(edges))
+	for _, f := range edges {
+		prices[f.ID] = f.Price
+	}
+
+	res := make([]*entity.MarketCandle, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &entity.MarketCandle{
+			Start:  row.Start,
+			Open:   prices[row.FirstID],
+			High:   row.High,
+			Low:    row.Low,
+			Close:  prices[row.LastID],
+			Volume: row.Volume,
+			Fills:  row.Fills,
+		})
+	}
+
+	return res, nil
+}
+
+// findMatches locks and returns the open orders an order is matched
+// against, by best price then time.
+func (r *MarketRepo) findMatches(ctx context.Context, client *entc.Client, o *entity.MarketOrder) ([]*entity.MarketOrder, error) {
+	query := client.MarketOrder.Query().
+		Where(
+			marketorder.ItemID(o.ItemID),
+			marketorder.Currency(o.Currency),
+			marketorder.Status(entity.MarketOrderStatusOpen),
+			marketorder.PlayerIDNEQ(o.PlayerID),
+		)
+	if o.Side == entity.MarketSideSell {
+		query = query.
+			Where(marketorder.Side(entity.MarketSideBuy), marketorder.PriceGTE(o.Price)).
+			Order(entc.Desc(marketorder.FieldPrice), entc.Asc(marketorder.FieldID))
+	} else {
+		query = query.
+			Where(marketorder.Side(entity.MarketSideSell), marketorder.PriceLTE(o.Price)).
+			Order(entc.Asc(marketorder.FieldPrice), entc.Asc(marketorder.FieldID))
+	}
+
+	rows, err := query.
+		Limit(maxMarketFills).
+		Modify(database.ForUpdate(r.client)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.MarketOrder, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toMarketOrder(row))
+	}
+
+	return res, nil
+}
+
+// fillOrders fills quantity of an order placed and of an open order matched
+// at the price of the latter with client, which should be a transaction.
+// The buyer receives the items escrowed by the seller, and the difference of
+// the price escrowed by its order, the seller the price net of the fee.
+func fillOrders(ctx context.Context, client *entc.Client, placed, matched *entity.MarketOrder, quantity, feeBps int64) (*entity.MarketFill, error) {
+	for _, o := range []*entity.MarketOrder{placed, matched} {
+		if err := fillOrder(ctx, client, o.ID, quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	sell, buy := matched, placed
+	if placed.Side == entity.MarketSideSell {
+		sell, buy = placed, matched
+	}
+	price := matched.Price
+	amount := price * quantity
+	fee := marketFee(amount, feeBps)
+
+	item := []*entity.TradeAsset{{AssetType: entity.AssetItem, Asset: buy.ItemID, Amount: quantity}}
+	if err := moveAssets(ctx, client, buy.PlayerID, item, 1, entity.LedgerReasonMarket, buy.Reference()); err != nil {
+		return nil, err
+	}
+	if refund := (buy.Price - price) * quantity; refund > 0 {
+		currency := []*entity.TradeAsset{{AssetType: entity.AssetCurrency, Asset: buy.Currency, Amount: refund}}
+		if err := moveAssets(ctx, client, buy.PlayerID, currency, 1, entity.LedgerReasonMarketRelease, buy.Reference()); err != nil {
+			return nil, err
+		}
+	}
+	if proceeds := amount - fee; proceeds > 0 {
+		currency := []*entity.TradeAsset{{AssetType: entity.AssetCurrency, Asset: sell.Currency, Amount: proceeds}}
+		if err := moveAssets(ctx, client, sell.PlayerID, currency, 1, entity.LedgerReasonMarket, sell.Reference()); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := client.MarketFill.Create().
+		SetItemID(sell.ItemID).
+		SetCurrency(sell.Currency).
+		SetPrice(price).
+		SetQuantity(quantity).
+		SetFee(fee).
+		SetSellOrderID(sell.ID).
+		SetBuyOrderID(buy.ID).
+		SetSellerID(sell.PlayerID).
+		SetBuyerID(buy.PlayerID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMarketFill(row), nil
+}
+
+// fillOrder subtracts quantity from the remaining quantity of the open order
+// of id, and fills the order once none remains, with client, which should be
+// a transaction. It returns repo.ErrConflict if the order is no longer open
+// with quantity remaining.
+func fillOrder(ctx context.Context, client *entc.Client, id, quantity int64) error {
+	n, err := client.MarketOrder.Update().
+		Where(
+			marketorder.ID(id),
+			marketorder.Status(entity.MarketOrderStatusOpen),
+			marketorder.RemainingGTE(quantity),
+		).
+		AddRemaining(-quantity).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repo.ErrConflict
+	}
+
+	_, err = client.MarketOrder.Update().
+		Where(marketorder.ID(id), marketorder.Remaining(0)).
+		SetStatus(entity.MarketOrderStatusFilled).
+		Save(ctx)
+
+	return err
+}
+
+// escrowOf returns the assets escrowed by an order for quantity, its items
+// for a sell order, their price for a buy order.
+func escrowOf(o *entity.MarketOrder, quantity int64) []*entity.TradeAsset {
+	if quantity == 0 {
+		return nil
+	}
+	if o.Side == entity.MarketSideSell {
+		return []*entity.TradeAsset{{AssetType: entity.AssetItem, Asset: o.ItemID, Amount: quantity}}
+	}
+
+	return []*entity.TradeAsset{{AssetType: entity.AssetCurrency, Asset: o.Currency, Amount: o.Price * quantity}}
+}
+
+// marketFee returns feeBps basis points of amount rounded down, without
+// overflowing for large amounts.
+func marketFee(amount, feeBps int64) int64 {
+	return amount/10000*feeBps + amount%10000*feeBps/10000
+}
+
+func toMarketOrder(row *entc.MarketOrder) *entity.MarketOrder {
+	return &entity.MarketOrder{
+		ID:        row.ID,
+		PlayerID:  row.PlayerID,
+		Side:      row.Side,
+		ItemID:    row.ItemID,
+		Currency:  row.Currency,
+		Price:     row.Price,
+		Quantity:  row.Quantity,
+		Remaining: row.Remaining,
+		Status:    row.Status,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func toMarketFill(row *entc.MarketFill) *entity.MarketFill {
+	return &entity.MarketFill{
+		ID:          row.ID,
+		ItemID:      row.ItemID,
+		Currency:    row.Currency,
+		Price:       row.Price,
+		Quantity:    row.Quantity,
+		Fee:         row.Fee,
+		SellOrderID: row.SellOrderID,
+		BuyOrderID:  row.BuyOrderID,
+		SellerID:    row.SellerID,
+		BuyerID:     row.BuyerID,
+		CreatedAt:   row.CreatedAt,
+	}
+}
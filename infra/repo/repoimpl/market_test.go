@@ -0,0 +1,103 @@
+package repoimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+func TestMarketRepo_Place(t *testing.T) {
+	escrowSell := &entity.LedgerEntry{PlayerID: "alice", AssetType: entity.AssetItem, Asset: "sw", Delta: -5, Balance: 5, Reason: entity.LedgerReasonMarketEscrow, Reference: "market_order:1"}
+
+	tests := []struct {
+		name         string
+		buy          int64
+		cancel       bool
+		wantStatuses []string
+		wantAssets   map[string]int64
+		wantLedger   []*entity.LedgerEntry
+	}{
+		{
+			name:         "TC01 - partial fill - should pay the seller net of the fee and refund the buyer",
+			buy:          3,
+			wantStatuses: []string{entity.MarketOrderStatusOpen, entity.MarketOrderStatusFilled},
+			wantAssets:   map[string]int64{"alice/sw": 5, "alice/gold": 297, "bob/sw": 3, "bob/gold": 700},
+			wantLedger: []*entity.LedgerEntry{
+				escrowSell,
+				{PlayerID: "bob", AssetType: entity.AssetCurrency, Asset: "gold", Delta: -360, Balance: 640, Reason: entity.LedgerReasonMarketEscrow, Reference: "market_order:2"},
+				{PlayerID: "bob", AssetType: entity.AssetItem, Asset: "sw", Delta: 3, Balance: 3, Reason: entity.LedgerReasonMarket, Reference: "market_order:2"},
+				{PlayerID: "bob", AssetType: entity.AssetCurrency, Asset: "gold", Delta: 60, Balance: 700, Reason: entity.LedgerReasonMarketRelease, Reference: "market_order:2"},
+				{PlayerID: "alice", AssetType: entity.AssetCurrency, Asset: "gold", Delta: 297, Balance: 297, Reason: entity.LedgerReasonMarket, Reference: "market_order:1"},
+			},
+		},
+		{
+			name:         "TC02 - full fill - should fill both orders",
+			buy:          5,
+			wantStatuses: []string{entity.MarketOrderStatusFilled, entity.MarketOrderStatusFilled},
+			wantAssets:   map[string]int64{"alice/sw": 5, "alice/gold": 495, "bob/sw": 5, "bob/gold": 500},
+			wantLedger: []*entity.LedgerEntry{
+				escrowSell,
+				{PlayerID: "bob", AssetType: entity.AssetCurrency, Asset: "gold", Delta: -600, Balance: 400, Reason: entity.LedgerReasonMarketEscrow, Reference: "market_order:2"},
+				{PlayerID: "bob", AssetType: entity.AssetItem, Asset: "sw", Delta: 5, Balance: 5, Reason: entity.LedgerReasonMarket, Reference: "market_order:2"},
+				{PlayerID: "bob", AssetType: entity.AssetCurrency, Asset: "gold", Delta: 100, Balance: 500, Reason: entity.LedgerReasonMarketRelease, Reference: "market_order:2"},
+				{PlayerID: "alice", AssetType: entity.AssetCurrency, Asset: "gold", Delta: 495, Balance: 495, Reason: entity.LedgerReasonMarket, Reference: "market_order:1"},
+			},
+		},
+		{
+			name:         "TC03 - cancel after a partial fill - should release the remaining escrow",
+			buy:          3,
+			cancel:       true,
+			wantStatuses: []string{entity.MarketOrderStatusCancelled, entity.MarketOrderStatusFilled},
+			wantAssets:   map[string]int64{"alice/sw": 7, "alice/gold": 297, "bob/sw": 3, "bob/gold": 700},
+			wantLedger: []*entity.LedgerEntry{
+				escrowSell,
+				{PlayerID: "bob", AssetType: entity.AssetCurrency, Asset: "gold", Delta: -360, Balance: 640, Reason: entity.LedgerReasonMarketEscrow, Reference: "market_order:2"},
+				{PlayerID: "bob", AssetType: entity.AssetItem, Asset: "sw", Delta: 3, Balance: 3, Reason: entity.LedgerReasonMarket, Reference: "market_order:2"},
+				{PlayerID: "bob", AssetType: entity.AssetCurrency, Asset: "gold", Delta: 60, Balance: 700, Reason: entity.LedgerReasonMarketRelease, Reference: "market_order:2"},
+				{PlayerID: "alice", AssetType: entity.AssetCurrency, Asset: "gold", Delta: 297, Balance: 297, Reason: entity.LedgerReasonMarket, Reference: "market_order:1"},
+				{PlayerID: "alice", AssetType: entity.AssetItem, Asset: "sw", Delta: 2, Balance: 7, Reason: entity.LedgerReasonMarketRelease, Reference: "market_order:1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := newTestClient(t)
+			seedAssets(t, client, map[string]int64{"alice/sw": 10, "bob/gold": 1000})
+			r := NewMarketRepo(client)
+
+			sell, fills, err := r.Place(ctx, &entity.MarketOrder{
+				PlayerID: "alice", Side: entity.MarketSideSell, ItemID: "sw", Currency: "gold", Price: 100, Quantity: 5,
+			}, 100)
+			require.NoError(t, err)
+			assert.Empty(t, fills)
+
+			buy, fills, err := r.Place(ctx, &entity.MarketOrder{
+				PlayerID: "bob", Side: entity.MarketSideBuy, ItemID: "sw", Currency: "gold", Price: 120, Quantity: tt.buy,
+			}, 100)
+			require.NoError(t, err)
+			require.Len(t, fills, 1)
+			assert.Equal(t, int64(100), fills[0].Price)
+			assert.Equal(t, tt.buy, fills[0].Quantity)
+
+			if tt.cancel {
+				require.NoError(t, r.Cancel(ctx, sell.ID))
+			}
+
+			var statuses []string
+			for _, id := range []int64{sell.ID, buy.ID} {
+				o, err := r.FindOrderByID(ctx, id)
+				require.NoError(t, err)
+				statuses = append(statuses, o.Status)
+			}
+			assert.Equal(t, tt.wantStatuses, statuses)
+			assert.Equal(t, tt.wantAssets, findAssets(t, client))
+			assert.Equal(t, tt.wantLedger, findLedger(t, client))
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package repoimpl
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/outboxevent"
+)
+
+// OutboxRepo implements interface OutboxRepo.
+type OutboxRepo struct {
+	client database.Client
+}
+
+// NewOutboxRepo creates and returns a new instance of repo.OutboxRepo.
+func NewOutboxRepo(
+	client database.Client,
+) repo.OutboxRepo {
+	return &OutboxRepo{
+		client: client,
+	}
+}
+
+// Append to append pending events in the transaction of ctx, or in their own
+// statement without one.
+func (r *OutboxRepo) Append(ctx context.Context, events []*entity.OutboxEvent) error {
+	return appendOutboxEvents(ctx, r.client.Master(ctx), events)
+}
+
+// FindByStatus to list the events of a status ordered by id. Reads go to
+// master, so pending events are never missed because of replication lag.
+func (r *OutboxRepo) FindByStatus(ctx context.Context, status string, limit int) ([]*entity.OutboxEvent, error) {
+	rows, err := r.client.Master(database.SkipQueryCache(ctx)).OutboxEvent.Query().
+		Where(outboxevent.Status(status)).
+		Order(entc.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+// FindDue to list the pending events due at now ordered by id, but those
+// queued behind an earlier pending event of their aggregate which waits for
+// its next attempt. Reads go to master like FindByStatus.
+func (r *OutboxRepo) FindDue(ctx context.Context, now int64, limit int) ([]*entity.OutboxEvent, error) {
+	rows, err := r.client.Master(database.SkipQueryCache(ctx)).OutboxEvent.Query().
+		Where(
+			outboxevent.Status(entity.OutboxStatusPending),
+			outboxevent.NextAttemptAtLTE(now),
+			func(s *sql.Selector) {
+				held := sql.Table(outboxevent.Table).As("held")
+				s.Where(sql.Not(sql.Exists(
+					sql.Select(held.C(outboxevent.FieldID)).
+						From(held).
+						Where(sql.And(
+							sql.ColumnsEQ(held.C(outboxevent.FieldAggregateType), s.C(outboxevent.FieldAggregateType)),
+							sql.ColumnsEQ(held.C(outboxevent.FieldAggregateID), s.C(outboxevent.FieldAggregateID)),
+							sql.ColumnsLT(held.C(outboxevent.FieldID), s.C(outboxevent.FieldID)),
+							sql.EQ(held.C(outboxevent.FieldStatus), entity.OutboxStatusPending),
+							sql.GT(held.C(outboxevent.FieldNextAttemptAt), now),
+						)),
+				)))
+			},
+		).
+		Order(entc.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOutboxEvents(rows), nil
+}
+
+// Update to update the delivery state of an event.
+func (r *OutboxRepo) Update(ctx context.Context, event *entity.OutboxEvent) error {
+	err := r.client.Master(ctx).OutboxEvent.UpdateOneID(event.ID).
+		SetStatus(event.Status).
+		SetAttempts(event.Attempts).
+		SetNextAttemptAt(event.NextAttemptAt).
+		SetLastError(event.LastError).
+		SetPublishedAt(event.PublishedAt).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+// Requeue to make dead events pending again.
+func (r *OutboxRepo) Requeue(ctx context.Context, ids []int64) (int, error) {
+	update := r.client.Master(ctx).OutboxEvent.Update().
+		Where(outboxevent.Status(entity.OutboxStatusDead))
+	if len(ids) > 0 {
+		update.Where(outboxevent.IDIn(ids...))
+	}
+
+	return update.
+		SetStatus(entity.OutboxStatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(0).
+		Save(ctx)
+}
+
+// DeletePublishedBefore to delete the events published before a time.
+func (r *OutboxRepo) DeletePublishedBefore(ctx context.Context, before int64) (int, error) {
+	return r.client.Master(ctx).OutboxEvent.Delete().
+		Where(
+			outboxevent.Status(entity.OutboxStatusPublished),
+			outboxevent.PublishedAtLT(before),
+		).
+		Exec(ctx)
+}
+
+func appendOutboxEvents(ctx context.Context, client *entc.Client, events []*entity.OutboxEvent) error {
+	for start := 0; start < len(events); start += catalogBatchSize {
+		end := min(start+catalogBatchSize, len(events))
+		builders := make([]*entc.OutboxEventCreate, 0, end-start)
+		for _, e := range events[start:end] {
+			builders = append(builders, client.OutboxEvent.Create().
+				SetAggregateType(e.AggregateType).
+				SetAggregateID(e.AggregateID).
+				SetType(e.Type).
+				SetPayload(e.Payload).
+				SetStatus(entity.OutboxStatusPending),
+			)
+		}
+
+		if err := client.OutboxEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toOutboxEvents(rows []*entc.OutboxEvent) []*entity.OutboxEvent {
+	res := make([]*entity.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &entity.OutboxEvent{
+			ID:            row.ID,
+			AggregateType: row.AggregateType,
+			AggregateID:   row.AggregateID,
+			Type:          row.Type,
+			Payload:       row.Payload,
+			Status:        row.Status,
+			Attempts:      row.Attempts,
+			NextAttemptAt: row.NextAttemptAt,
+			LastError:     row.LastError,
+			CreatedAt:     row.CreatedAt,
+			PublishedAt:   row.PublishedAt,
+		})
+	}
+
+	return res
+}
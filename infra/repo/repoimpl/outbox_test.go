@@ -0,0 +1,71 @@
+package repoimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+func TestOutboxRepo_FindDue(t *testing.T) {
+	tests := []struct {
+		name    string
+		waiting map[int64]int64
+		dead    []int64
+		want    []int64
+	}{
+		{
+			name: "TC01 - no event waiting - should list all pending events",
+			want: []int64{1, 2, 3, 4},
+		},
+		{
+			name:    "TC02 - event waiting for its next attempt - should hold later events of its aggregate",
+			waiting: map[int64]int64{1: 1001},
+			want:    []int64{2, 4},
+		},
+		{
+			name:    "TC03 - next attempt due - should be listed",
+			waiting: map[int64]int64{1: 1000},
+			want:    []int64{1, 2, 3, 4},
+		},
+		{
+			name:    "TC04 - dead event - should not hold its aggregate",
+			waiting: map[int64]int64{1: 1001},
+			dead:    []int64{1},
+			want:    []int64{2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := newTestClient(t)
+			r := NewOutboxRepo(client)
+
+			require.NoError(t, r.Append(ctx, []*entity.OutboxEvent{
+				{AggregateType: "item", AggregateID: "a", Type: "item.updated", Payload: []byte("{}")},
+				{AggregateType: "item", AggregateID: "b", Type: "item.updated", Payload: []byte("{}")},
+				{AggregateType: "item", AggregateID: "a", Type: "item.deleted", Payload: []byte("{}")},
+				{AggregateType: "player", AggregateID: "a", Type: "player.created", Payload: []byte("{}")},
+			}))
+			for id, at := range tt.waiting {
+				require.NoError(t, client.Master(ctx).OutboxEvent.UpdateOneID(id).SetNextAttemptAt(at).Exec(ctx))
+			}
+			for _, id := range tt.dead {
+				require.NoError(t, client.Master(ctx).OutboxEvent.UpdateOneID(id).SetStatus(entity.OutboxStatusDead).Exec(ctx))
+			}
+
+			events, err := r.FindDue(ctx, 1000, 10)
+			require.NoError(t, err)
+
+			var got []int64
+			for _, e := range events {
+				got = append(got, e.ID)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
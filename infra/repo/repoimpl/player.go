@@ -0,0 +1,314 @@
+package repoimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/inventoryitem"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstance"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/iteminstancehistory"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/marketorder"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/player"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playeraccount"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// PlayerRepo implements interface PlayerRepo.
+type PlayerRepo struct {
+	client database.Client
+}
+
+// NewPlayerRepo creates and returns a new instance of repo.PlayerRepo.
+func NewPlayerRepo(
+	client database.Client,
+) repo.PlayerRepo {
+	return &PlayerRepo{
+		client: client,
+	}
+}
+
+// Create to create a player.
+func (r *PlayerRepo) Create(ctx context.Context, p *entity.Player) (*entity.Player, error) {
+	row, err := r.client.Master(ctx).Player.Create().
+		SetID(p.ID).
+		SetDisplayName(p.DisplayName).
+		SetRegion(p.Region).
+		SetBanned(p.Banned).
+		SetBanReason(p.BanReason).
+		SetBannedUntil(p.BannedUntil).
+		Save(ctx)
+	if entc.IsConstraintError(err) {
+		return nil, repo.ErrConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toPlayer(row), nil
+}
+
+// FindByID to find a player by id.
+func (r *PlayerRepo) FindByID(ctx context.Context, id string) (*entity.Player, error) {
+	row, err := r.client.Slave(ctx).Player.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toPlayer(row), nil
+}
+
+// FindByIDs to find the players of ids ordered by id.
+func (r *PlayerRepo) FindByIDs(ctx context.Context, ids []string) ([]*entity.Player, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.client.Slave(ctx).Player.Query().
+		Where(player.IDIn(ids...)).
+		Order(entc.Asc(player.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPlayers(rows), nil
+}
+
+// FindAll to list the players matching a filter with pagination.
+func (r *PlayerRepo) FindAll(ctx context.Context, filter *repo.PlayerFilter, limit, offset int) (*repo.ListPlayerResult, error) {
+	query := r.client.Slave(ctx).Player.Query()
+	if filter != nil && filter.Region != "" {
+		query = query.Where(player.Region(filter.Region))
+	}
+	if filter != nil && filter.Banned != nil {
+		query = query.Where(player.Banned(*filter.Banned))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(entc.Asc(player.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repo.ListPlayerResult{
+		Players: toPlayers(rows),
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// Update to update the profile and ban status of a player.
+func (r *PlayerRepo) Update(ctx context.Context, p *entity.Player) error {
+	err := r.client.Master(ctx).Player.UpdateOneID(p.ID).
+		SetDisplayName(p.DisplayName).
+		SetRegion(p.Region).
+		SetBanned(p.Banned).
+		SetBanReason(p.BanReason).
+		SetBannedUntil(p.BannedUntil).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+// Delete to delete a player along with its accounts, inventory, wallets,
+// ledger, trades, market orders and item instances in a transaction on
+// master, or in the transaction of ctx. The escrow of the pending trades
+// proposed to the player is released to their proposers first.
+func (r *PlayerRepo) Delete(ctx context.Context, id string) error {
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		received, err := client.Trade.Query().
+			Where(trade.RecipientID(id), trade.Status(entity.TradeStatusPending)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		for _, tradeID := range received {
+			if err := releaseTrade(ctx, client, tradeID, entity.TradeStatusCancelled, time.Now().Unix()); err != nil {
+				return err
+			}
+		}
+		_, err = client.Trade.Delete().
+			Where(trade.Or(trade.ProposerID(id), trade.RecipientID(id))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.MarketOrder.Delete().Where(marketorder.PlayerID(id)).Exec(ctx); err != nil {
+			return err
+		}
+		_, err = client.ItemInstanceHistory.Delete().
+			Where(iteminstancehistory.HasInstanceWith(iteminstance.OwnerID(id))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if _, err := client.ItemInstance.Delete().Where(iteminstance.OwnerID(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := client.PlayerAccount.Delete().Where(playeraccount.PlayerID(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := client.InventoryItem.Delete().Where(inventoryitem.PlayerID(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := client.Wallet.Delete().Where(wallet.PlayerID(id)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := client.LedgerEntry.Delete().Where(ledgerentry.PlayerID(id)).Exec(ctx); err != nil {
+			return err
+		}
+
+		err = client.Player.DeleteOneID(id).Exec(ctx)
+		if entc.IsNotFound(err) {
+			return repo.ErrNotFound
+		}
+
+		return err
+	})
+}
+
+func toPlayers(rows []*entc.Player) []*entity.Player {
+	res := make([]*entity.Player, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toPlayer(row))
+	}
+
+	return res
+}
+
+func toPlayer(row *entc.Player) *entity.Player {
+	return &entity.Player{
+		ID:          row.ID,
+		DisplayName: row.DisplayName,
+		Region:      row.Region,
+		Banned:      row.Banned,
+		BanReason:   row.BanReason,
+		BannedUntil: row.BannedUntil,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// PlayerAccountRepo implements interface PlayerAccountRepo.
+type PlayerAccountRepo struct {
+	client database.Client
+}
+
+// NewPlayerAccountRepo creates and returns a new instance of
+// repo.PlayerAccountRepo.
+func NewPlayerAccountRepo(
+	client database.Client,
+) repo.PlayerAccountRepo {
+	return &PlayerAccountRepo{
+		client: client,
+	}
+}
+
+// Link to link an account to a player, the unique indexes of accounts
+// reject accounts already linked.
+func (r *PlayerAccountRepo) Link(ctx context.Context, a *entity.PlayerAccount) (*entity.PlayerAccount, error) {
+	row, err := r.client.Master(ctx).PlayerAccount.Create().
+		SetPlayerID(a.PlayerID).
+		SetProvider(a.Provider).
+		SetSubject(a.Subject).
+		Save(ctx)
+	if entc.IsConstraintError(err) {
+		return nil, repo.ErrConflict
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toPlayerAccount(row), nil
+}
+
+// Unlink to unlink the account of a provider from a player.
+func (r *PlayerAccountRepo) Unlink(ctx context.Context, playerID, provider string) error {
+	n, err := r.client.Master(ctx).PlayerAccount.Delete().
+		Where(
+			playeraccount.PlayerID(playerID),
+			playeraccount.Provider(provider),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repo.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindByPlayer to list the accounts linked to a player ordered by provider.
+func (r *PlayerAccountRepo) FindByPlayer(ctx context.Context, playerID string) ([]*entity.PlayerAccount, error) {
+	rows, err := r.client.Slave(ctx).PlayerAccount.Query().
+		Where(playeraccount.PlayerID(playerID)).
+		Order(entc.Asc(playeraccount.FieldProvider)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.PlayerAccount, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toPlayerAccount(row))
+	}
+
+	return res, nil
+}
+
+// FindBySubject to find the account of a provider and subject.
+func (r *PlayerAccountRepo) FindBySubject(ctx context.Context, provider, subject string) (*entity.PlayerAccount, error) {
+	row, err := r.client.Slave(ctx).PlayerAccount.Query().
+		Where(
+			playeraccount.Provider(provider),
+			playeraccount.Subject(subject),
+		).
+		Only(ctx)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toPlayerAccount(row), nil
+}
+
+func toPlayerAccount(row *entc.PlayerAccount) *entity.PlayerAccount {
+	return &entity.PlayerAccount{
+		ID:        row.ID,
+		PlayerID:  row.PlayerID,
+		Provider:  row.Provider,
+		Subject:   row.Subject,
+		CreatedAt: row.CreatedAt,
+	}
+}
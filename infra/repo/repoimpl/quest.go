@@ -0,0 +1,297 @@
+package repoimpl
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/playerevent"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/quest"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/questprogress"
+)
+
+// QuestRepo implements interface QuestRepo.
+type QuestRepo struct {
+	client database.Client
+}
+
+// NewQuestRepo creates and returns a new instance of repo.QuestRepo.
+func NewQuestRepo(
+	client database.Client,
+) repo.QuestRepo {
+	return &QuestRepo{
+		client: client,
+	}
+}
+
+// Create to create a quest.
+func (r *QuestRepo) Create(ctx context.Context, q *entity.Quest) (*entity.Quest, error) {
+	row, err := r.client.Master(ctx).Quest.Create().
+		SetID(q.ID).
+		SetKind(q.Kind).
+		SetName(q.Name).
+		SetDescription(q.Description).
+		SetObjectives(q.Objectives).
+		SetRewards(q.Rewards).
+		SetActive(q.Active).
+		SetCreatedBy(q.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toQuest(row), nil
+}
+
+// FindAll to list the quests ordered by id.
+func (r *QuestRepo) FindAll(ctx context.Context, activeOnly bool) ([]*entity.Quest, error) {
+	query := r.client.Slave(ctx).Quest.Query()
+	if activeOnly {
+		query = query.Where(quest.Active(true))
+	}
+
+	rows, err := query.Order(entc.Asc(quest.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.Quest, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toQuest(row))
+	}
+
+	return res, nil
+}
+
+// FindByID to find a quest by id.
+func (r *QuestRepo) FindByID(ctx context.Context, id string) (*entity.Quest, error) {
+	row, err := r.client.Slave(ctx).Quest.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toQuest(row), nil
+}
+
+// Update to update a quest.
+func (r *QuestRepo) Update(ctx context.Context, q *entity.Quest) error {
+	err := r.client.Master(ctx).Quest.UpdateOneID(q.ID).
+		SetKind(q.Kind).
+		SetName(q.Name).
+		SetDescription(q.Description).
+		SetObjectives(q.Objectives).
+		SetRewards(q.Rewards).
+		SetActive(q.Active).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+// Delete to delete a quest along with its progress in a transaction on
+// master, or in the transaction of ctx.
+func (r *QuestRepo) Delete(ctx context.Context, id string) error {
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		_, err := client.QuestProgress.Delete().Where(questprogress.QuestID(id)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = client.Quest.DeleteOneID(id).Exec(ctx)
+		if entc.IsNotFound(err) {
+			return repo.ErrNotFound
+		}
+
+		return err
+	})
+}
+
+func toQuest(row *entc.Quest) *entity.Quest {
+	return &entity.Quest{
+		ID:          row.ID,
+		Kind:        row.Kind,
+		Name:        row.Name,
+		Description: row.Description,
+		Objectives:  row.Objectives,
+		Rewards:     row.Rewards,
+		Active:      row.Active,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// QuestProgressRepo implements interface QuestProgressRepo.
+type QuestProgressRepo struct {
+	client database.Client
+}
+
+// NewQuestProgressRepo creates and returns a new instance of
+// repo.QuestProgressRepo.
+func NewQuestProgressRepo(
+	client database.Client,
+) repo.QuestProgressRepo {
+	return &QuestProgressRepo{
+		client: client,
+	}
+}
+
+// FindByPlayer to list the progress of a player ordered by quest.
+func (r *QuestProgressRepo) FindByPlayer(ctx context.Context, playerID string) ([]*entity.QuestProgress, error) {
+	rows, err := r.client.Slave(ctx).QuestProgress.Query().
+		Where(questprogress.PlayerID(playerID)).
+		Order(entc.Asc(questprogress.FieldQuestID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.QuestProgress, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toQuestProgress(row))
+	}
+
+	return res, nil
+}
+
+// Save to create or update the counts of a progress.
+func (r *QuestProgressRepo) Save(ctx context.Context, p *entity.QuestProgress) (*entity.QuestProgress, error) {
+	client := r.client.Master(ctx)
+	if p.ID == 0 {
+		row, err := client.QuestProgress.Create().
+			SetPlayerID(p.PlayerID).
+			SetQuestID(p.QuestID).
+			SetStatus(entity.QuestStatusInProgress).
+			SetCounts(p.Counts).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return toQuestProgress(row), nil
+	}
+
+	row, err := client.QuestProgress.UpdateOneID(p.ID).
+		SetCounts(p.Counts).
+		Save(ctx)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toQuestProgress(row), nil
+}
+
+// Complete to mark a progress completed in a transaction on master, or in the
+// transaction of ctx, along with its QuestCompleted event. The update is
+// conditioned on the progress being in progress, so concurrent completions
+// complete it once.
+func (r *QuestProgressRepo) Complete(ctx context.Context, p *entity.QuestProgress, rewards []*entity.QuestReward) (bool, error) {
+	var completed bool
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		n, err := client.QuestProgress.Update().
+			Where(
+				questprogress.ID(p.ID),
+				questprogress.Status(entity.QuestStatusInProgress),
+			).
+			SetStatus(entity.QuestStatusCompleted).
+			SetCompletedAt(p.CompletedAt).
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+		completed = true
+
+		payload, err := json.Marshal(&entity.QuestCompletedEvent{
+			PlayerID:    p.PlayerID,
+			QuestID:     p.QuestID,
+			Rewards:     rewards,
+			CompletedAt: p.CompletedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		return appendOutboxEvents(ctx, client, []*entity.OutboxEvent{{
+			AggregateType: entity.AggregatePlayer,
+			AggregateID:   p.PlayerID,
+			Type:          entity.EventQuestCompleted,
+			Payload:       payload,
+		}})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if completed {
+		p.Status = entity.QuestStatusCompleted
+	}
+
+	return completed, nil
+}
+
+func toQuestProgress(row *entc.QuestProgress) *entity.QuestProgress {
+	return &entity.QuestProgress{
+		ID:          row.ID,
+		PlayerID:    row.PlayerID,
+		QuestID:     row.QuestID,
+		Status:      row.Status,
+		Counts:      row.Counts,
+		CompletedAt: row.CompletedAt,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// PlayerEventRepo implements interface PlayerEventRepo.
+type PlayerEventRepo struct {
+	client database.Client
+}
+
+// NewPlayerEventRepo creates and returns a new instance of
+// repo.PlayerEventRepo.
+func NewPlayerEventRepo(
+	client database.Client,
+) repo.PlayerEventRepo {
+	return &PlayerEventRepo{
+		client: client,
+	}
+}
+
+// Record to record the id of a player event, events already recorded are
+// left untouched.
+func (r *PlayerEventRepo) Record(ctx context.Context, e *entity.PlayerEvent) (bool, error) {
+	n, err := r.client.Master(ctx).PlayerEvent.Create().
+		SetPlayerID(e.PlayerID).
+		SetEventID(e.ID).
+		SetType(e.Type).
+		OnConflictColumns(playerevent.FieldPlayerID, playerevent.FieldEventID).
+		DoNothing().
+		ID(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	return n != 0, err
+}
+
+// DeleteBefore to delete the events recorded before a time.
+func (r *PlayerEventRepo) DeleteBefore(ctx context.Context, before int64) (int, error) {
+	return r.client.Master(ctx).PlayerEvent.Delete().
+		Where(playerevent.CreatedAtLT(before)).
+		Exec(ctx)
+}
@@ -0,0 +1,249 @@
+package repoimpl
+
+import (
+	"context"
+	"slices"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/trade"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// TradeRepo implements interface TradeRepo.
+type TradeRepo struct {
+	client database.Client
+}
+
+// NewTradeRepo creates and returns a new instance of repo.TradeRepo.
+func NewTradeRepo(
+	client database.Client,
+) repo.TradeRepo {
+	return &TradeRepo{
+		client: client,
+	}
+}
+
+// Propose to create a trade and escrow its offered assets in a transaction
+// on master, or in the transaction of ctx.
+func (r *TradeRepo) Propose(ctx context.Context, t *entity.Trade) (*entity.Trade, error) {
+	var res *entity.Trade
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		row, err := client.Trade.Create().
+			SetProposerID(t.ProposerID).
+			SetRecipientID(t.RecipientID).
+			SetOffered(t.Offered).
+			SetRequested(t.Requested).
+			SetStatus(entity.TradeStatusPending).
+			SetExpiresAt(t.ExpiresAt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		res = toTrade(row)
+
+		return moveAssets(ctx, client, res.ProposerID, res.Offered, -1, entity.LedgerReasonTradeEscrow, res.Reference())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Accept to accept a trade in a transaction on master, or in the transaction
+// of ctx. The trade is resolved first so that it is accepted once, then the
+// traded rows of both players are locked in order of id, so that concurrent
+// trades of the same players wait for each other rather than deadlock.
+func (r *TradeRepo) Accept(ctx context.Context, id int64, now int64) error {
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		n, err := client.Trade.Update().
+			Where(
+				trade.ID(id),
+				trade.Status(entity.TradeStatusPending),
+				trade.ExpiresAtGT(now),
+			).
+			SetStatus(entity.TradeStatusAccepted).
+			SetResolvedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return repo.ErrConflict
+		}
+
+		row, err := client.Trade.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		t := toTrade(row)
+		players := []string{t.ProposerID, t.RecipientID}
+		if err := lockAssets(ctx, r.client, players, slices.Concat(t.Offered, t.Requested)); err != nil {
+			return err
+		}
+
+		if err := moveAssets(ctx, client, t.RecipientID, t.Requested, -1, entity.LedgerReasonTrade, t.Reference()); err != nil {
+			return err
+		}
+		if err := moveAssets(ctx, client, t.ProposerID, t.Requested, 1, entity.LedgerReasonTrade, t.Reference()); err != nil {
+			return err
+		}
+
+		return moveAssets(ctx, client, t.RecipientID, t.Offered, 1, entity.LedgerReasonTrade, t.Reference())
+	})
+}
+
+// Release to resolve a trade and release its escrow in a transaction on
+// master, or in the transaction of ctx.
+func (r *TradeRepo) Release(ctx context.Context, id int64, status string, now int64) error {
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		return releaseTrade(ctx, r.client.Master(ctx), id, status, now)
+	})
+}
+
+// FindByID to find a trade by id.
+func (r *TradeRepo) FindByID(ctx context.Context, id int64) (*entity.Trade, error) {
+	row, err := r.client.Slave(ctx).Trade.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toTrade(row), nil
+}
+
+// FindByPlayer to list the trades proposed to or by a player with
+// pagination from the latest.
+func (r *TradeRepo) FindByPlayer(ctx context.Context, playerID, status string, limit, offset int) (*repo.ListTradeResult, error) {
+	query := r.client.Slave(ctx).Trade.Query().
+		Where(trade.Or(trade.ProposerID(playerID), trade.RecipientID(playerID)))
+	if status != "" {
+		query = query.Where(trade.Status(status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(entc.Desc(trade.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.Trade, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toTrade(row))
+	}
+
+	return &repo.ListTradeResult{
+		Trades: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// FindExpired to find the pending trades expired at now in order of expiry.
+func (r *TradeRepo) FindExpired(ctx context.Context, now int64, limit int) ([]*entity.Trade, error) {
+	rows, err := r.client.Slave(ctx).Trade.Query().
+		Where(
+			trade.Status(entity.TradeStatusPending),
+			trade.ExpiresAtLTE(now),
+		).
+		Order(entc.Asc(trade.FieldExpiresAt), entc.Asc(trade.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.Trade, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toTrade(row))
+	}
+
+	return res, nil
+}
+
+// releaseTrade resolves the trade of id pending with status, and moves its
+// offered assets from escrow back to its proposer with client, which should
+// be a transaction.
+func releaseTrade(ctx context.Context, client *entc.Client, id int64, status string, now int64) error {
+	n, err := client.Trade.Update().
+		Where(trade.ID(id), trade.Status(entity.TradeStatusPending)).
+		SetStatus(status).
+		SetResolvedAt(now).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repo.ErrConflict
+	}
+
+	row, err := client.Trade.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	t := toTrade(row)
+
+	return moveAssets(ctx, client, t.ProposerID, t.Offered, 1, entity.LedgerReasonTradeRelease, t.Reference())
+}
+
+// moveAssets adds assets to those of a player, or subtracts them for a
+// negative sign, along with their ledger entries with client, which should
+// be a transaction.
+func moveAssets(ctx context.Context, client *entc.Client, playerID string, assets []*entity.TradeAsset, sign int64, reason, reference string) error {
+	if len(assets) == 0 {
+		return nil
+	}
+
+	entries := make([]*entity.LedgerEntry, 0, len(assets))
+	for _, a := range assets {
+		balance, err := adjustAsset(ctx, client, playerID, a.AssetType, a.Asset, sign*a.Amount)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, &entity.LedgerEntry{
+			PlayerID:  playerID,
+			AssetType: a.AssetType,
+			Asset:     a.Asset,
+			Delta:     sign * a.Amount,
+			Balance:   balance,
+			Reason:    reason,
+			Reference: reference,
+		})
+	}
+
+	return appendLedgerEntries(ctx, client, entries)
+}
+
+func toTrade(row *entc.Trade) *entity.Trade {
+	return &entity.Trade{
+		ID:          row.ID,
+		ProposerID:  row.ProposerID,
+		RecipientID: row.RecipientID,
+		Offered:     row.Offered,
+		Requested:   row.Requested,
+		Status:      row.Status,
+		ExpiresAt:   row.ExpiresAt,
+		ResolvedAt:  row.ResolvedAt,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,153 @@
+package repoimpl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+)
+
+// seedAssets creates the players of assets, keyed by "<player>/<asset>",
+// with the quantity of the item or the balance of the currency "gold".
+func seedAssets(t *testing.T, client database.Client, assets map[string]int64) {
+	ctx := context.Background()
+	c := client.Master(ctx)
+	for _, id := range []string{"alice", "bob"} {
+		require.NoError(t, c.Player.Create().SetID(id).SetDisplayName(id).Exec(ctx))
+	}
+	for key, n := range assets {
+		player, asset, _ := strings.Cut(key, "/")
+		if asset == "gold" {
+			require.NoError(t, c.Wallet.Create().SetPlayerID(player).SetCurrency(asset).SetBalance(n).Exec(ctx))
+		} else {
+			require.NoError(t, c.InventoryItem.Create().SetPlayerID(player).SetItemID(asset).SetQuantity(int(n)).Exec(ctx))
+		}
+	}
+}
+
+// findAssets returns the quantities of items and balances of currencies of
+// all players, keyed like seedAssets.
+func findAssets(t *testing.T, client database.Client) map[string]int64 {
+	ctx := context.Background()
+	res := make(map[string]int64)
+	items, err := client.Master(ctx).InventoryItem.Query().All(ctx)
+	require.NoError(t, err)
+	for _, i := range items {
+		res[i.PlayerID+"/"+i.ItemID] = int64(i.Quantity)
+	}
+	wallets, err := client.Master(ctx).Wallet.Query().All(ctx)
+	require.NoError(t, err)
+	for _, w := range wallets {
+		res[w.PlayerID+"/"+w.Currency] = w.Balance
+	}
+
+	return res
+}
+
+// findLedger returns the ledger entries of all players in order, without
+// their ids and times.
+func findLedger(t *testing.T, client database.Client) []*entity.LedgerEntry {
+	ctx := context.Background()
+	rows, err := client.Master(ctx).LedgerEntry.Query().Order(entc.Asc(ledgerentry.FieldID)).All(ctx)
+	require.NoError(t, err)
+
+	var res []*entity.LedgerEntry
+	for _, row := range rows {
+		res = append(res, &entity.LedgerEntry{
+			PlayerID:  row.PlayerID,
+			AssetType: row.AssetType,
+			Asset:     row.Asset,
+			Delta:     row.Delta,
+			Balance:   row.Balance,
+			Reason:    row.Reason,
+			Reference: row.Reference,
+		})
+	}
+
+	return res
+}
+
+func TestTradeRepo_Accept(t *testing.T) {
+	escrow := &entity.LedgerEntry{PlayerID: "alice", AssetType: entity.AssetItem, Asset: "sw", Delta: -2, Balance: 8, Reason: entity.LedgerReasonTradeEscrow, Reference: "trade:1"}
+
+	tests := []struct {
+		name       string
+		bobGold    int64
+		now        int64
+		wantErr    error
+		wantStatus string
+		wantAssets map[string]int64
+		wantLedger []*entity.LedgerEntry
+	}{
+		{
+			name:       "TC01 - pending trade - should swap the assets",
+			bobGold:    1000,
+			now:        1000,
+			wantStatus: entity.TradeStatusAccepted,
+			wantAssets: map[string]int64{"alice/sw": 8, "alice/gold": 150, "bob/sw": 2, "bob/gold": 850},
+			wantLedger: []*entity.LedgerEntry{
+				escrow,
+				{PlayerID: "bob", AssetType: entity.AssetCurrency, Asset: "gold", Delta: -150, Balance: 850, Reason: entity.LedgerReasonTrade, Reference: "trade:1"},
+				{PlayerID: "alice", AssetType: entity.AssetCurrency, Asset: "gold", Delta: 150, Balance: 150, Reason: entity.LedgerReasonTrade, Reference: "trade:1"},
+				{PlayerID: "bob", AssetType: entity.AssetItem, Asset: "sw", Delta: 2, Balance: 2, Reason: entity.LedgerReasonTrade, Reference: "trade:1"},
+			},
+		},
+		{
+			name:       "TC02 - recipient short of funds - should roll back and stay pending",
+			bobGold:    100,
+			now:        1000,
+			wantErr:    repo.ErrInsufficientFunds,
+			wantStatus: entity.TradeStatusPending,
+			wantAssets: map[string]int64{"alice/sw": 8, "bob/gold": 100},
+			wantLedger: []*entity.LedgerEntry{escrow},
+		},
+		{
+			name:       "TC03 - expired trade - should conflict",
+			bobGold:    1000,
+			now:        2000,
+			wantErr:    repo.ErrConflict,
+			wantStatus: entity.TradeStatusPending,
+			wantAssets: map[string]int64{"alice/sw": 8, "bob/gold": 1000},
+			wantLedger: []*entity.LedgerEntry{escrow},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := newTestClient(t)
+			seedAssets(t, client, map[string]int64{"alice/sw": 10, "bob/gold": tt.bobGold})
+			r := NewTradeRepo(client)
+
+			trade, err := r.Propose(ctx, &entity.Trade{
+				ProposerID:  "alice",
+				RecipientID: "bob",
+				Offered:     []*entity.TradeAsset{{AssetType: entity.AssetItem, Asset: "sw", Amount: 2}},
+				Requested:   []*entity.TradeAsset{{AssetType: entity.AssetCurrency, Asset: "gold", Amount: 150}},
+				ExpiresAt:   2000,
+			})
+			require.NoError(t, err)
+
+			err = r.Accept(ctx, trade.ID, tt.now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			got, err := r.FindByID(ctx, trade.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, tt.wantAssets, findAssets(t, client))
+			assert.Equal(t, tt.wantLedger, findLedger(t, client))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package repoimpl
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/ledgerentry"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/wallet"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// WalletRepo implements interface WalletRepo.
+type WalletRepo struct {
+	client database.Client
+}
+
+// NewWalletRepo creates and returns a new instance of repo.WalletRepo.
+func NewWalletRepo(
+	client database.Client,
+) repo.WalletRepo {
+	return &WalletRepo{
+		client: client,
+	}
+}
+
+// FindByPlayer to list the wallets of a player ordered by currency.
+func (r *WalletRepo) FindByPlayer(ctx context.Context, playerID string) ([]*entity.Wallet, error) {
+	rows, err := r.client.Slave(ctx).Wallet.Query().
+		Where(wallet.PlayerID(playerID)).
+		Order(entc.Asc(wallet.FieldCurrency)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.Wallet, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toWallet(row))
+	}
+
+	return res, nil
+}
+
+// Adjust to add amount to the balance of a currency of a player along with
+// its ledger entry in a transaction on master, or in the transaction of ctx.
+func (r *WalletRepo) Adjust(ctx context.Context, playerID, currency string, amount int64, reason, reference string) (*entity.Wallet, error) {
+	err := database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		balance, err := adjustCurrency(ctx, client, playerID, currency, amount)
+		if err != nil {
+			return err
+		}
+
+		return appendLedgerEntries(ctx, client, []*entity.LedgerEntry{{
+			PlayerID:  playerID,
+			AssetType: entity.AssetCurrency,
+			Asset:     currency,
+			Delta:     amount,
+			Balance:   balance,
+			Reason:    reason,
+			Reference: reference,
+		}})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.client.Master(ctx).Wallet.Query().
+		Where(wallet.PlayerID(playerID), wallet.Currency(currency)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWallet(row), nil
+}
+
+func toWallet(row *entc.Wallet) *entity.Wallet {
+	return &entity.Wallet{
+		PlayerID:  row.PlayerID,
+		Currency:  row.Currency,
+		Balance:   row.Balance,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+// LedgerRepo implements interface LedgerRepo.
+type LedgerRepo struct {
+	client database.Client
+}
+
+// NewLedgerRepo creates and returns a new instance of repo.LedgerRepo.
+func NewLedgerRepo(
+	client database.Client,
+) repo.LedgerRepo {
+	return &LedgerRepo{
+		client: client,
+	}
+}
+
+// FindByPlayer to list the ledger entries of a player with pagination from
+// the latest.
+func (r *LedgerRepo) FindByPlayer(ctx context.Context, playerID string, limit, offset int) (*repo.ListLedgerEntryResult, error) {
+	query := r.client.Slave(ctx).LedgerEntry.Query().
+		Where(ledgerentry.PlayerID(playerID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(entc.Desc(ledgerentry.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.LedgerEntry, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &entity.LedgerEntry{
+			ID:        row.ID,
+			PlayerID:  row.PlayerID,
+			AssetType: row.AssetType,
+			Asset:     row.Asset,
+			Delta:     row.Delta,
+			Balance:   row.Balance,
+			Reason:    row.Reason,
+			Reference: row.Reference,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+
+	return &repo.ListLedgerEntryResult{
+		Entries: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// appendLedgerEntries appends entries to the ledger with client, which
+// should be the transaction changing the assets.
+func appendLedgerEntries(ctx context.Context, client *entc.Client, entries []*entity.LedgerEntry) error {
+	builders := make([]*entc.LedgerEntryCreate, 0, len(entries))
+	for _, e := range entries {
+		builders = append(builders, client.LedgerEntry.Create().
+			SetPlayerID(e.PlayerID).
+			SetAssetType(e.AssetType).
+			SetAsset(e.Asset).
+			SetDelta(e.Delta).
+			SetBalance(e.Balance).
+			SetReason(e.Reason).
+			SetReference(e.Reference))
+	}
+
+	return client.LedgerEntry.CreateBulk(builders...).Exec(ctx)
+}
@@ -0,0 +1,285 @@
+package repoimpl
+
+import (
+	"context"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/domain/repo"
+	"github.com/nhatquangsin/game-service/infra/repo/database"
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhookdelivery"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/webhooksubscription"
+	"github.com/nhatquangsin/game-service/infra/utils"
+)
+
+// WebhookSubscriptionRepo implements interface WebhookSubscriptionRepo.
+type WebhookSubscriptionRepo struct {
+	client database.Client
+}
+
+// NewWebhookSubscriptionRepo creates and returns a new instance of
+// repo.WebhookSubscriptionRepo.
+func NewWebhookSubscriptionRepo(
+	client database.Client,
+) repo.WebhookSubscriptionRepo {
+	return &WebhookSubscriptionRepo{
+		client: client,
+	}
+}
+
+// Create to create a subscription.
+func (r *WebhookSubscriptionRepo) Create(ctx context.Context, s *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	row, err := r.client.Master(ctx).WebhookSubscription.Create().
+		SetURL(s.URL).
+		SetEvents(s.Events).
+		SetSecret(s.Secret).
+		SetDescription(s.Description).
+		SetActive(s.Active).
+		SetCreatedBy(s.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookSubscription(row), nil
+}
+
+// FindAll to list all subscriptions.
+func (r *WebhookSubscriptionRepo) FindAll(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	rows, err := r.client.Slave(ctx).WebhookSubscription.Query().
+		Order(entc.Asc(webhooksubscription.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toWebhookSubscription(row))
+	}
+
+	return res, nil
+}
+
+// FindByID to find a subscription by id.
+func (r *WebhookSubscriptionRepo) FindByID(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	row, err := r.client.Slave(ctx).WebhookSubscription.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookSubscription(row), nil
+}
+
+// Update to update a subscription.
+func (r *WebhookSubscriptionRepo) Update(ctx context.Context, s *entity.WebhookSubscription) error {
+	err := r.client.Master(ctx).WebhookSubscription.UpdateOneID(s.ID).
+		SetURL(s.URL).
+		SetEvents(s.Events).
+		SetSecret(s.Secret).
+		SetDescription(s.Description).
+		SetActive(s.Active).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+// Delete to delete a subscription and its deliveries in a transaction on
+// master, or in the transaction of ctx.
+func (r *WebhookSubscriptionRepo) Delete(ctx context.Context, id int64) error {
+	return database.RunInTx(ctx, r.client, func(ctx context.Context) error {
+		client := r.client.Master(ctx)
+		_, err := client.WebhookDelivery.Delete().
+			Where(webhookdelivery.SubscriptionID(id)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = client.WebhookSubscription.DeleteOneID(id).Exec(ctx)
+		if entc.IsNotFound(err) {
+			return repo.ErrNotFound
+		}
+
+		return err
+	})
+}
+
+func toWebhookSubscription(row *entc.WebhookSubscription) *entity.WebhookSubscription {
+	return &entity.WebhookSubscription{
+		ID:          row.ID,
+		URL:         row.URL,
+		Events:      row.Events,
+		Secret:      row.Secret,
+		Description: row.Description,
+		Active:      row.Active,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// WebhookDeliveryRepo implements interface WebhookDeliveryRepo.
+type WebhookDeliveryRepo struct {
+	client database.Client
+}
+
+// NewWebhookDeliveryRepo creates and returns a new instance of
+// repo.WebhookDeliveryRepo.
+func NewWebhookDeliveryRepo(
+	client database.Client,
+) repo.WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{
+		client: client,
+	}
+}
+
+// Create to create pending deliveries, deliveries of an event already created
+// for a subscription are skipped.
+func (r *WebhookDeliveryRepo) Create(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	client := r.client.Master(ctx)
+	for start := 0; start < len(deliveries); start += catalogBatchSize {
+		end := min(start+catalogBatchSize, len(deliveries))
+		builders := make([]*entc.WebhookDeliveryCreate, 0, end-start)
+		for _, d := range deliveries[start:end] {
+			builders = append(builders, client.WebhookDelivery.Create().
+				SetSubscriptionID(d.SubscriptionID).
+				SetEventID(d.EventID).
+				SetEventType(d.EventType).
+				SetPayload(d.Payload).
+				SetStatus(entity.WebhookDeliveryStatusPending),
+			)
+		}
+
+		err := client.WebhookDelivery.CreateBulk(builders...).
+			OnConflictColumns(webhookdelivery.FieldSubscriptionID, webhookdelivery.FieldEventID).
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindByID to find a delivery by id.
+func (r *WebhookDeliveryRepo) FindByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	row, err := r.client.Slave(ctx).WebhookDelivery.Get(ctx, id)
+	if entc.IsNotFound(err) {
+		return nil, repo.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookDelivery(row), nil
+}
+
+// FindBySubscription to list the deliveries of a subscription with
+// pagination.
+func (r *WebhookDeliveryRepo) FindBySubscription(
+	ctx context.Context,
+	subscriptionID int64,
+	status string,
+	limit, offset int,
+) (*repo.ListWebhookDeliveryResult, error) {
+	query := r.client.Slave(ctx).WebhookDelivery.Query().
+		Where(webhookdelivery.SubscriptionID(subscriptionID))
+	if status != "" {
+		query.Where(webhookdelivery.Status(status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Order(entc.Desc(webhookdelivery.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toWebhookDelivery(row))
+	}
+
+	return &repo.ListWebhookDeliveryResult{
+		Deliveries: res,
+		Metadata: utils.PageMetadata{
+			Total:   utils.Of(total),
+			HasNext: utils.Of(total > limit+offset),
+			Offset:  utils.Of(offset),
+			Limit:   utils.Of(limit),
+		},
+	}, nil
+}
+
+// FindDue to list the pending deliveries due at now. Reads go to master, so
+// deliveries are never missed because of replication lag.
+func (r *WebhookDeliveryRepo) FindDue(ctx context.Context, now int64, limit int) ([]*entity.WebhookDelivery, error) {
+	rows, err := r.client.Master(database.SkipQueryCache(ctx)).WebhookDelivery.Query().
+		Where(
+			webhookdelivery.Status(entity.WebhookDeliveryStatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(entc.Asc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toWebhookDelivery(row))
+	}
+
+	return res, nil
+}
+
+// Update to update the state and log of a delivery.
+func (r *WebhookDeliveryRepo) Update(ctx context.Context, d *entity.WebhookDelivery) error {
+	err := r.client.Master(ctx).WebhookDelivery.UpdateOneID(d.ID).
+		SetStatus(d.Status).
+		SetAttempts(d.Attempts).
+		SetNextAttemptAt(d.NextAttemptAt).
+		SetResponseStatus(d.ResponseStatus).
+		SetLastError(d.LastError).
+		SetDeliveredAt(d.DeliveredAt).
+		Exec(ctx)
+	if entc.IsNotFound(err) {
+		return repo.ErrNotFound
+	}
+
+	return err
+}
+
+func toWebhookDelivery(row *entc.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             row.ID,
+		SubscriptionID: row.SubscriptionID,
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         row.Status,
+		Attempts:       row.Attempts,
+		NextAttemptAt:  row.NextAttemptAt,
+		ResponseStatus: row.ResponseStatus,
+		LastError:      row.LastError,
+		DeliveredAt:    row.DeliveredAt,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
@@ -0,0 +1,185 @@
+// Package seed loads fixture data into the database for local and test
+// environments.
+package seed
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/nhatquangsin/game-service/infra/repo/entc"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/item"
+	"github.com/nhatquangsin/game-service/infra/repo/entc/itemtranslation"
+)
+
+// Names of the available profiles.
+const (
+	ProfileMinimal  = "minimal"
+	ProfileDemo     = "demo"
+	ProfileLoadTest = "load-test"
+)
+
+// loadTestCategories are the categories load test items are spread over.
+var loadTestCategories = []string{"currency", "weapon", "armor", "consumable", "material"}
+
+//go:embed fixtures/*.yaml
+var fixtures embed.FS
+
+// Fixture is a set of entities to load.
+type Fixture struct {
+	Items []*Item `yaml:"items"`
+}
+
+// Item is an item of a fixture along with its translations.
+type Item struct {
+	ID           string         `yaml:"id"`
+	Name         string         `yaml:"name"`
+	Category     string         `yaml:"category"`
+	Description  string         `yaml:"description"`
+	Translations []*Translation `yaml:"translations"`
+}
+
+// Translation is a translation of an item of a fixture.
+type Translation struct {
+	Locale      string `yaml:"locale"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+}
+
+// Profile returns the fixture of a named profile. n is the number of items
+// generated by the load-test profile and is ignored by the others.
+func Profile(name string, n int) (*Fixture, error) {
+	switch name {
+	case ProfileMinimal, ProfileDemo:
+		b, err := fixtures.ReadFile("fixtures/" + name + ".yaml")
+		if err != nil {
+			return nil, err
+		}
+		return Parse(b)
+	case ProfileLoadTest:
+		return Generate(n), nil
+	default:
+		return nil, fmt.Errorf("seed: unknown profile %q", name)
+	}
+}
+
+// LoadFile reads a fixture from a YAML file.
+func LoadFile(path string) (*Fixture, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(b)
+}
+
+// Parse parses a fixture from YAML.
+func Parse(b []byte) (*Fixture, error) {
+	f := &Fixture{}
+	if err := yaml.Unmarshal(b, f); err != nil {
+		return nil, fmt.Errorf("seed: parsing fixture: %w", err)
+	}
+
+	return f, nil
+}
+
+// Generate generates a fixture of n items with deterministic ids, so loading
+// it twice does not duplicate them.
+func Generate(n int) *Fixture {
+	f := &Fixture{}
+	for i := 1; i <= n; i++ {
+		f.Items = append(f.Items, &Item{
+			ID:          fmt.Sprintf("load_test_item_%06d", i),
+			Name:        fmt.Sprintf("Load Test Item %d", i),
+			Category:    loadTestCategories[i%len(loadTestCategories)],
+			Description: fmt.Sprintf("Generated item number %d.", i),
+		})
+	}
+
+	return f
+}
+
+// Load creates the entities of the fixture or updates them if they exist, in
+// one transaction. Loading the same fixture twice leaves the database
+// unchanged.
+func Load(ctx context.Context, client *entc.Client, f *Fixture) error {
+	return withTx(ctx, client, func(tx *entc.Client) error {
+		const batchSize = 1000
+		for start := 0; start < len(f.Items); start += batchSize {
+			end := min(start+batchSize, len(f.Items))
+
+			items := make([]*entc.ItemCreate, 0, end-start)
+			var translations []*entc.ItemTranslationCreate
+			for _, i := range f.Items[start:end] {
+				items = append(items, tx.Item.Create().
+					SetID(i.ID).
+					SetName(i.Name).
+					SetCategory(i.Category).
+					SetDescription(i.Description),
+				)
+				for _, t := range i.Translations {
+					translations = append(translations, tx.ItemTranslation.Create().
+						SetItemID(i.ID).
+						SetLocale(t.Locale).
+						SetName(t.Name).
+						SetDescription(t.Description),
+					)
+				}
+			}
+
+			err := tx.Item.CreateBulk(items...).
+				OnConflictColumns(item.FieldID).
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			if len(translations) == 0 {
+				continue
+			}
+
+			err = tx.ItemTranslation.CreateBulk(translations...).
+				OnConflictColumns(itemtranslation.FieldItemID, itemtranslation.FieldLocale).
+				UpdateNewValues().
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Truncate deletes all entities a fixture can load.
+func Truncate(ctx context.Context, client *entc.Client) error {
+	return withTx(ctx, client, func(tx *entc.Client) error {
+		if _, err := tx.ItemTranslation.Delete().Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.Item.Delete().Exec(ctx)
+		return err
+	})
+}
+
+// withTx runs fn in a transaction.
+func withTx(ctx context.Context, client *entc.Client, fn func(tx *entc.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx.Client()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,61 @@
+package seed
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhatquangsin/game-service/infra/repo/entc/enttest"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name             string
+		profile          string
+		n                int
+		wantItems        int
+		wantTranslations int
+	}{
+		{
+			name:      "TC01 - minimal profile - should load fixture items",
+			profile:   ProfileMinimal,
+			wantItems: 2,
+		},
+		{
+			name:             "TC02 - demo profile - should load items and translations",
+			profile:          ProfileDemo,
+			wantItems:        8,
+			wantTranslations: 5,
+		},
+		{
+			name:      "TC03 - load-test profile - should generate n items",
+			profile:   ProfileLoadTest,
+			n:         2500,
+			wantItems: 2500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+			defer client.Close()
+
+			f, err := Profile(tt.profile, tt.n)
+			require.NoError(t, err)
+
+			// Loading twice must not duplicate anything.
+			require.NoError(t, Load(ctx, client, f))
+			require.NoError(t, Load(ctx, client, f))
+
+			assert.Equal(t, tt.wantItems, client.Item.Query().CountX(ctx))
+			assert.Equal(t, tt.wantTranslations, client.ItemTranslation.Query().CountX(ctx))
+
+			require.NoError(t, Truncate(ctx, client))
+			assert.Zero(t, client.Item.Query().CountX(ctx))
+		})
+	}
+}
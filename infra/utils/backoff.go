@@ -0,0 +1,14 @@
+package utils
+
+import "time"
+
+// Backoff returns the delay before the next attempt after attempts failures:
+// base after the first failure, doubled by each other one up to max.
+func Backoff(base, max time.Duration, attempts int) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < max; i++ {
+		d *= 2
+	}
+
+	return min(d, max)
+}
@@ -0,0 +1,84 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by endpoints whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreaker returns a Middleware that stops calling the next endpoint
+// after maxFailures consecutive failures, errors for which failure reports
+// false, e.g. invalid requests, count as successes. A nil failure counts all
+// errors. Once cooldown elapsed, one call is let through: its success closes
+// the circuit, its failure opens it again.
+func CircuitBreaker(maxFailures int, cooldown time.Duration, failure func(error) bool) Middleware {
+	if failure == nil {
+		failure = func(error) bool { return true }
+	}
+
+	cb := &breaker{
+		maxFailures: maxFailures,
+		cooldown:    cooldown,
+		now:         time.Now,
+	}
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if !cb.allow() {
+				return nil, ErrCircuitOpen
+			}
+
+			response, err := next(ctx, request)
+			cb.done(err != nil && failure(err))
+
+			return response, err
+		}
+	}
+}
+
+type breaker struct {
+	mu          sync.Mutex
+	maxFailures int
+	cooldown    time.Duration
+	failures    int
+	openedAt    time.Time
+	probing     bool
+	now         func() time.Time
+}
+
+// allow reports whether a call may go through.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.maxFailures {
+		return true
+	}
+
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// done records the result of a call.
+func (b *breaker) done(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.maxFailures {
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,139 @@
+package endpoint
+
+import (
+	"log"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// FXModule represents a FX module for the middleware Factory.
+var FXModule = fx.Provide(
+	NewFactory,
+)
+
+// Config configures the middlewares of an endpoint, zero values disable a
+// middleware.
+type Config struct {
+	// Timeout of each attempt.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Logging logs every call.
+	Logging   bool `mapstructure:"logging"`
+	RateLimit struct {
+		// Rate is the number of calls per second per RateLimitKey.
+		Rate  float64 `mapstructure:"rate"`
+		Burst int     `mapstructure:"burst"`
+	} `mapstructure:"rate_limit"`
+	CircuitBreaker struct {
+		// MaxFailures is the number of consecutive failures opening the
+		// circuit.
+		MaxFailures int           `mapstructure:"max_failures"`
+		Cooldown    time.Duration `mapstructure:"cooldown"`
+	} `mapstructure:"circuit_breaker"`
+	Retry struct {
+		MaxAttempts int           `mapstructure:"max_attempts"`
+		Backoff     time.Duration `mapstructure:"backoff"`
+	} `mapstructure:"retry"`
+}
+
+// Factory builds the middlewares of endpoints by name.
+//
+// The middlewares of an endpoint are configured through viper by the keys of
+// Config under "endpoints.<name>", falling back to "endpoints.default" for
+// the keys it does not set. Dots in names nest keys, so "items.list" is
+// configured under "endpoints.items.list".
+type Factory struct {
+	v      *viper.Viper
+	logger *log.Logger
+}
+
+// NewFactory creates and returns new instance of Factory.
+func NewFactory(v *viper.Viper) *Factory {
+	return &Factory{
+		v:      v,
+		logger: log.Default(),
+	}
+}
+
+// Config returns the configuration of the endpoint named name.
+func (f *Factory) Config(name string) (Config, error) {
+	var cfg Config
+	for _, key := range []string{"endpoints.default", "endpoints." + name} {
+		sub := f.v.Sub(key)
+		if sub == nil {
+			continue
+		}
+
+		if err := viperutil.Unmarshal(sub, &cfg); err != nil {
+			return cfg, err
+		}
+	}
+
+	return cfg, nil
+}
+
+// BuildOption customizes the middlewares built by Factory.Build.
+type BuildOption func(*buildOptions)
+
+type buildOptions struct {
+	retryable func(error) bool
+	failure   func(error) bool
+}
+
+// RetryIf is a BuildOption to set the errors retried by the endpoint, see
+// Retry.
+func RetryIf(retryable func(error) bool) BuildOption {
+	return func(o *buildOptions) {
+		o.retryable = retryable
+	}
+}
+
+// FailureIf is a BuildOption to set the errors counted as failures by the
+// circuit breaker of the endpoint, see CircuitBreaker.
+func FailureIf(failure func(error) bool) BuildOption {
+	return func(o *buildOptions) {
+		o.failure = failure
+	}
+}
+
+// Build returns the middlewares configured for the endpoint named name,
+// chained from the outermost: panic recovery, logging, rate limiting, circuit
+// breaking, retries and timeout. Stateful middlewares are not shared, Build
+// must be called once per endpoint.
+func (f *Factory) Build(name string, opts ...BuildOption) (Middleware, error) {
+	cfg, err := f.Config(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var o buildOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var mws []Middleware
+	if cfg.Logging {
+		mws = append(mws, Logging(f.logger, name))
+	}
+	if cfg.RateLimit.Rate > 0 {
+		burst := cfg.RateLimit.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		mws = append(mws, RateLimit(cfg.RateLimit.Rate, burst))
+	}
+	if cfg.CircuitBreaker.MaxFailures > 0 {
+		mws = append(mws, CircuitBreaker(cfg.CircuitBreaker.MaxFailures, cfg.CircuitBreaker.Cooldown, o.failure))
+	}
+	if cfg.Retry.MaxAttempts > 1 {
+		mws = append(mws, Retry(cfg.Retry.MaxAttempts, cfg.Retry.Backoff, o.retryable))
+	}
+	if cfg.Timeout > 0 {
+		mws = append(mws, Timeout(cfg.Timeout))
+	}
+
+	return Chain(Recover(), mws...), nil
+}
@@ -0,0 +1,55 @@
+package endpoint
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactory_Config(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+endpoints:
+  default:
+    timeout: 5s
+    retry:
+      max_attempts: 2
+  items:
+    list:
+      timeout: 2s
+`)))
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		wantTimeout time.Duration
+		wantRetry   int
+	}{
+		{
+			name:        "TC01 - configured endpoint - should override defaults",
+			endpoint:    "items.list",
+			wantTimeout: 2 * time.Second,
+			wantRetry:   2,
+		},
+		{
+			name:        "TC02 - unconfigured endpoint - should use defaults",
+			endpoint:    "items.get",
+			wantTimeout: 5 * time.Second,
+			wantRetry:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := NewFactory(v).Config(tt.endpoint)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTimeout, cfg.Timeout)
+			assert.Equal(t, tt.wantRetry, cfg.Retry.MaxAttempts)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Logging returns a Middleware that logs every call of the endpoint named
+// name with its duration and error. Requests and responses are only logged
+// when they implement fmt.Stringer, whose String method should leave out
+// secrets, so that credentials never end up in the logs.
+func Logging(logger *log.Logger, name string) Middleware {
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			defer func(begin time.Time) {
+				var b strings.Builder
+				fmt.Fprintf(&b, "endpoint=%s took=%s", name, time.Since(begin))
+				if s, ok := request.(fmt.Stringer); ok {
+					fmt.Fprintf(&b, " request=%s", s)
+				}
+				if err != nil {
+					fmt.Fprintf(&b, " err=%v", err)
+				} else if s, ok := response.(fmt.Stringer); ok {
+					fmt.Fprintf(&b, " response=%s", s)
+				}
+				logger.Print(b.String())
+			}(time.Now())
+
+			return next(ctx, request)
+		}
+	}
+}
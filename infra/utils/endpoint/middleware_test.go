@@ -0,0 +1,203 @@
+package endpoint
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddlewares(t *testing.T) {
+	errFailed := errors.New("failed")
+	errInvalid := errors.New("invalid")
+	retryAll := func(error) bool { return true }
+	failure := func(err error) bool { return !errors.Is(err, errInvalid) }
+
+	tests := []struct {
+		name    string
+		mw      Middleware
+		ctx     context.Context
+		results []error
+		panics  bool
+		want    []error
+		wantN   int
+	}{
+		{
+			name:    "TC01 - rate limit - should reject calls beyond burst",
+			mw:      RateLimit(0.001, 2),
+			results: []error{nil, nil, nil},
+			want:    []error{nil, nil, ErrRateLimited},
+			wantN:   2,
+		},
+		{
+			name:    "TC02 - rate limit with key - should limit calls of the key",
+			mw:      RateLimit(0.001, 1),
+			ctx:     WithRateLimitKey(context.Background(), "player_1"),
+			results: []error{nil, nil},
+			want:    []error{nil, ErrRateLimited},
+			wantN:   1,
+		},
+		{
+			name:    "TC03 - circuit breaker - should open after max failures",
+			mw:      CircuitBreaker(2, time.Hour, nil),
+			results: []error{errFailed, errFailed, nil},
+			want:    []error{errFailed, errFailed, ErrCircuitOpen},
+			wantN:   2,
+		},
+		{
+			name:    "TC04 - circuit breaker - should not count errors other than failures",
+			mw:      CircuitBreaker(2, time.Hour, failure),
+			results: []error{errFailed, errInvalid, errFailed, nil},
+			want:    []error{errFailed, errInvalid, errFailed, nil},
+			wantN:   4,
+		},
+		{
+			name:    "TC05 - retry - should retry until success",
+			mw:      Retry(3, 0, retryAll),
+			results: []error{errFailed, errFailed, nil},
+			want:    []error{nil},
+			wantN:   3,
+		},
+		{
+			name:    "TC06 - retry - should stop after max attempts",
+			mw:      Retry(2, 0, retryAll),
+			results: []error{errFailed, errFailed, nil},
+			want:    []error{errFailed},
+			wantN:   2,
+		},
+		{
+			name:    "TC07 - retry by default - should not retry errors other than transient ones",
+			mw:      Retry(3, 0, nil),
+			results: []error{errFailed, nil},
+			want:    []error{errFailed},
+			wantN:   1,
+		},
+		{
+			name:    "TC08 - retry by default - should retry network timeouts",
+			mw:      Retry(3, 0, nil),
+			results: []error{&net.OpError{Op: "read", Err: os.ErrDeadlineExceeded}, nil},
+			want:    []error{nil},
+			wantN:   2,
+		},
+		{
+			name:    "TC09 - recover - should turn panics into errors",
+			mw:      Recover(),
+			results: []error{nil},
+			panics:  true,
+			want:    []error{&PanicError{}},
+			wantN:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			n := 0
+			e := tt.mw(func(context.Context, interface{}) (interface{}, error) {
+				err := tt.results[n]
+				n++
+				if tt.panics {
+					panic("boom")
+				}
+				return nil, err
+			})
+
+			for i, want := range tt.want {
+				_, err := e(ctx, nil)
+				if _, ok := want.(*PanicError); ok {
+					assert.IsType(t, want, err, "call %d", i)
+					continue
+				}
+				assert.ErrorIs(t, err, want, "call %d", i)
+			}
+			assert.Equal(t, tt.wantN, n)
+		})
+	}
+}
+
+type redacted struct{ Secret string }
+
+func (redacted) String() string { return "{Secret:***}" }
+
+func TestLogging(t *testing.T) {
+	tests := []struct {
+		name    string
+		request interface{}
+		err     error
+		want    string
+	}{
+		{
+			name:    "TC01 - plain request - should not be logged",
+			request: &struct{ Secret string }{Secret: "s3cr3t"},
+			want:    "endpoint=webhooks.create took=",
+		},
+		{
+			name:    "TC02 - stringer request - should be logged redacted",
+			request: redacted{Secret: "s3cr3t"},
+			want:    "request={Secret:***} response={Secret:***}",
+		},
+		{
+			name:    "TC03 - error - should be logged",
+			request: &struct{ Secret string }{Secret: "s3cr3t"},
+			err:     errors.New("failed"),
+			want:    "err=failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			e := Logging(log.New(&buf, "", 0), "webhooks.create")(func(_ context.Context, request interface{}) (interface{}, error) {
+				return request, tt.err
+			})
+
+			_, _ = e(context.Background(), tt.request)
+
+			assert.Contains(t, buf.String(), tt.want)
+			assert.NotContains(t, buf.String(), "s3cr3t")
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	mw := func(name string) Middleware {
+		return func(next Endpoint) Endpoint {
+			return func(ctx context.Context, request interface{}) (interface{}, error) {
+				calls = append(calls, name)
+				return next(ctx, request)
+			}
+		}
+	}
+
+	_, err := Chain(mw("first"), mw("second"), mw("third"))(Noop)(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "second", "third"}, calls)
+}
+
+func TestLimiter_Take(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(2, 1)
+	l.now = func() time.Time { return now }
+
+	ok, _ := l.Take("")
+	assert.True(t, ok)
+
+	ok, wait := l.Take("")
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	now = now.Add(wait)
+	ok, _ = l.Take("")
+	assert.True(t, ok)
+}
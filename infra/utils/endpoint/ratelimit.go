@@ -0,0 +1,123 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned by endpoints called more often than allowed.
+var ErrRateLimited = errors.New("rate limited")
+
+type rateLimitKey struct{}
+
+// WithRateLimitKey returns a copy of ctx whose calls are rate limited under
+// key, e.g. the id of the caller.
+func WithRateLimitKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, rateLimitKey{}, key)
+}
+
+// RateLimitKey returns the rate limit key of ctx, calls without key share
+// the empty key.
+func RateLimitKey(ctx context.Context) string {
+	key, _ := ctx.Value(rateLimitKey{}).(string)
+	return key
+}
+
+// RateLimit returns a Middleware that limits calls per RateLimitKey of their
+// context with a token bucket refilled by rate tokens per second, holding up
+// to burst tokens.
+func RateLimit(rate float64, burst int) Middleware {
+	limiter := NewLimiter(rate, burst)
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if !limiter.Allow(RateLimitKey(ctx)) {
+				return nil, ErrRateLimited
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// Limiter is a set of token buckets by key.
+type Limiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[string]*bucket
+	swept   time.Time
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter creates and returns new instance of Limiter.
+func NewLimiter(rate float64, burst int) *Limiter {
+	return &Limiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow takes a token from the bucket of key, it reports false when the
+// bucket is empty.
+func (l *Limiter) Allow(key string) bool {
+	ok, _ := l.Take(key)
+	return ok
+}
+
+// Take takes a token from the bucket of key. When the bucket is empty it
+// reports false and how long until a token is available.
+func (l *Limiter) Take(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		if l.rate <= 0 {
+			return false, math.MaxInt64
+		}
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+// Limit returns the burst of the limiter.
+func (l *Limiter) Limit() int {
+	return int(l.burst)
+}
+
+// sweep drops buckets refilled to burst, they are recreated on demand.
+func (l *Limiter) sweep(now time.Time) {
+	if l.rate <= 0 || now.Sub(l.swept) < time.Minute {
+		return
+	}
+	l.swept = now
+
+	full := time.Duration(l.burst / l.rate * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
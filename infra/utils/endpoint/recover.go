@@ -0,0 +1,34 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is returned by endpoints that panicked.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("endpoint panicked: %v", e.Value)
+}
+
+// Recover returns a Middleware that turns panics of the next endpoint into a
+// *PanicError.
+func Recover() Middleware {
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			defer func() {
+				if v := recover(); v != nil {
+					response, err = nil, &PanicError{Value: v, Stack: debug.Stack()}
+				}
+			}()
+
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"time"
+)
+
+// Retry returns a Middleware that calls the next endpoint up to maxAttempts
+// times while it fails with an error reported by retryable. Attempts are
+// spaced by backoff doubled on each attempt, with jitter. A nil retryable
+// retries transient errors only, see IsTransient.
+func Retry(maxAttempts int, backoff time.Duration, retryable func(error) bool) Middleware {
+	if retryable == nil {
+		retryable = IsTransient
+	}
+
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			for attempt := 1; ; attempt++ {
+				response, err := next(ctx, request)
+				if err == nil || attempt >= maxAttempts || !retryable(err) {
+					return response, err
+				}
+
+				delay := backoff << (attempt - 1)
+				delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1)) //nolint:gosec // jitter only.
+				select {
+				case <-ctx.Done():
+					return nil, err
+				case <-time.After(delay):
+				}
+			}
+		}
+	}
+}
+
+// IsTransient reports whether err is a network timeout, which may not happen
+// on another attempt. Context cancellation and deadlines are not transient.
+func IsTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,19 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+)
+
+// Timeout returns a Middleware that cancels the context of the next endpoint
+// after timeout.
+func Timeout(timeout time.Duration) Middleware {
+	return func(next Endpoint) Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Package redisutil provides the redis client configured for the service.
+package redisutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// FXModule represents a FX module for the default redis client. The client
+// connects lazily, so services not using redis boot without it.
+var FXModule = fx.Provide(
+	func(v *viper.Viper, lc fx.Lifecycle) (redis.UniversalClient, error) {
+		c, err := New(v, "default")
+		if err != nil {
+			return nil, err
+		}
+
+		lc.Append(fx.Hook{
+			OnStop: func(context.Context) error {
+				return c.Close()
+			},
+		})
+
+		return c, nil
+	},
+)
+
+// New creates the redis client named name, configured through viper by
+// following keys under "redis.clients.<name>":
+//
+//   - uri
+//     format: redis://:[password]@address:port/database
+//   - pool_size, min_idle_conns, idle_conn_timeout
+//   - read_timeout, write_timeout, dial_timeout
+func New(v *viper.Viper, name string) (redis.UniversalClient, error) {
+	cfg := struct {
+		URI             string        `mapstructure:"uri"`
+		PoolSize        int           `mapstructure:"pool_size"`
+		MinIdleConns    int           `mapstructure:"min_idle_conns"`
+		IdleConnTimeout time.Duration `mapstructure:"idle_conn_timeout"`
+		ReadTimeout     time.Duration `mapstructure:"read_timeout"`
+		WriteTimeout    time.Duration `mapstructure:"write_timeout"`
+		DialTimeout     time.Duration `mapstructure:"dial_timeout"`
+	}{
+		URI: "redis://localhost:6379/0",
+	}
+
+	if sub := v.Sub("redis.clients." + name); sub != nil {
+		if err := viperutil.Unmarshal(sub, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	opts, err := redis.ParseURL(cfg.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.PoolSize > 0 {
+		opts.PoolSize = cfg.PoolSize
+	}
+	opts.MinIdleConns = cfg.MinIdleConns
+	if cfg.IdleConnTimeout > 0 {
+		opts.IdleTimeout = cfg.IdleConnTimeout
+	}
+	if cfg.ReadTimeout > 0 {
+		opts.ReadTimeout = cfg.ReadTimeout
+	}
+	if cfg.WriteTimeout > 0 {
+		opts.WriteTimeout = cfg.WriteTimeout
+	}
+	if cfg.DialTimeout > 0 {
+		opts.DialTimeout = cfg.DialTimeout
+	}
+
+	return redis.NewClient(opts), nil
+}
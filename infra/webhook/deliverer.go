@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/fx"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+	"github.com/nhatquangsin/game-service/infra/utils"
+	viperutil "github.com/nhatquangsin/game-service/infra/utils/viper"
+)
+
+// FXModule represents a FX module for the Deliverer.
+var FXModule = fx.Provide(
+	LoadDeliverer,
+)
+
+// Config configures deliveries.
+type Config struct {
+	// Timeout of each attempt.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MaxAttempts is the number of failed attempts after which a delivery
+	// fails.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Backoff is the delay before the second attempt of a delivery, doubled
+	// by each failure up to MaxBackoff.
+	Backoff    time.Duration `mapstructure:"backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+	// PollInterval is how often the worker polls due deliveries, and
+	// BatchSize the max number of deliveries attempted by a poll.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+}
+
+// Deliverer posts signed deliveries to webhooks.
+type Deliverer struct {
+	cfg    Config
+	client *http.Client
+}
+
+// NewDeliverer creates and returns new instance of Deliverer, zero values of
+// cfg are defaulted.
+func NewDeliverer(cfg Config) *Deliverer {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 8
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = 10 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Hour
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+
+	return &Deliverer{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// LoadDeliverer creates the Deliverer configured by the keys webhooks.timeout,
+// webhooks.max_attempts, webhooks.backoff, webhooks.max_backoff,
+// webhooks.poll_interval and webhooks.batch_size.
+func LoadDeliverer(v *viper.Viper) (*Deliverer, error) {
+	cfg := struct {
+		Webhooks Config `mapstructure:"webhooks"`
+	}{}
+	if err := viperutil.Unmarshal(v, &cfg); err != nil {
+		return nil, err
+	}
+
+	return NewDeliverer(cfg.Webhooks), nil
+}
+
+// Config returns the configuration of the Deliverer.
+func (d *Deliverer) Config() Config {
+	return d.cfg
+}
+
+// Attempt posts delivery to the webhook of sub, and records the outcome in
+// delivery: succeeded on 2xx responses, else pending until its next attempt,
+// or failed after the max attempts.
+func (d *Deliverer) Attempt(ctx context.Context, sub *entity.WebhookSubscription, delivery *entity.WebhookDelivery, now time.Time) {
+	delivery.Attempts++
+	status, err := d.post(ctx, sub, delivery, now)
+	delivery.ResponseStatus = status
+	if err == nil {
+		delivery.Status = entity.WebhookDeliveryStatusSucceeded
+		delivery.LastError = ""
+		delivery.NextAttemptAt = 0
+		delivery.DeliveredAt = now.Unix()
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.cfg.MaxAttempts {
+		delivery.Status = entity.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = 0
+		return
+	}
+
+	delivery.Status = entity.WebhookDeliveryStatusPending
+	delivery.NextAttemptAt = now.Add(utils.Backoff(d.cfg.Backoff, d.cfg.MaxBackoff, delivery.Attempts)).Unix()
+}
+
+func (d *Deliverer) post(ctx context.Context, sub *entity.WebhookSubscription, delivery *entity.WebhookDelivery, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "game-service-webhooks")
+	req.Header.Set(HeaderID, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, now, delivery.Payload))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded %s", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nhatquangsin/game-service/domain/entity"
+)
+
+func TestDeliverer_Attempt(t *testing.T) {
+	now := time.Unix(1000, 0)
+	payload := []byte(`{"id":7,"type":"ItemCreated"}`)
+
+	tests := []struct {
+		name     string
+		status   int
+		attempts int
+		want     *entity.WebhookDelivery
+	}{
+		{
+			name:   "TC01 - accepted - should succeed",
+			status: http.StatusNoContent,
+			want: &entity.WebhookDelivery{
+				Status:         entity.WebhookDeliveryStatusSucceeded,
+				Attempts:       1,
+				ResponseStatus: http.StatusNoContent,
+				DeliveredAt:    1000,
+			},
+		},
+		{
+			name:     "TC02 - server error - should be retried with backoff",
+			status:   http.StatusInternalServerError,
+			attempts: 1,
+			want: &entity.WebhookDelivery{
+				Status:         entity.WebhookDeliveryStatusPending,
+				Attempts:       2,
+				NextAttemptAt:  1020,
+				ResponseStatus: http.StatusInternalServerError,
+				LastError:      "webhook responded 500 Internal Server Error",
+			},
+		},
+		{
+			name:     "TC03 - server error on the last attempt - should fail",
+			status:   http.StatusBadGateway,
+			attempts: 2,
+			want: &entity.WebhookDelivery{
+				Status:         entity.WebhookDeliveryStatusFailed,
+				Attempts:       3,
+				ResponseStatus: http.StatusBadGateway,
+				LastError:      "webhook responded 502 Bad Gateway",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotHeader http.Header
+			var gotBody []byte
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotHeader = r.Header
+				gotBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			sub := &entity.WebhookSubscription{ID: 1, URL: srv.URL, Secret: "s3cr3t", Active: true}
+			delivery := &entity.WebhookDelivery{
+				ID:        42,
+				EventType: entity.EventItemCreated,
+				Payload:   payload,
+				Status:    entity.WebhookDeliveryStatusPending,
+				Attempts:  tt.attempts,
+			}
+
+			d := NewDeliverer(Config{MaxAttempts: 3, Backoff: 10 * time.Second})
+			d.Attempt(context.Background(), sub, delivery, now)
+
+			assert.Equal(t, payload, gotBody)
+			assert.Equal(t, "42", gotHeader.Get(HeaderID))
+			assert.Equal(t, entity.EventItemCreated, gotHeader.Get(HeaderEvent))
+			assert.NoError(t, Verify("s3cr3t", gotHeader.Get(HeaderSignature), gotBody, time.Minute, now))
+			assert.ErrorIs(t, Verify("other", gotHeader.Get(HeaderSignature), gotBody, time.Minute, now), ErrInvalidSignature)
+
+			tt.want.ID = 42
+			tt.want.EventType = entity.EventItemCreated
+			tt.want.Payload = payload
+			assert.Equal(t, tt.want, delivery)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1000, 0)
+	body := []byte(`{}`)
+
+	tests := []struct {
+		name    string
+		header  string
+		wantErr error
+	}{
+		{
+			name:   "TC01 - signed by the secret - should be valid",
+			header: Sign("s3cr3t", now, body),
+		},
+		{
+			name:   "TC02 - one of several signatures matches - should be valid",
+			header: Sign("old", now, body) + ",v1=" + signature("s3cr3t", "1000", body),
+		},
+		{
+			name:    "TC03 - signed too long ago - should be invalid",
+			header:  Sign("s3cr3t", now.Add(-10*time.Minute), body),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "TC04 - malformed - should be invalid",
+			header:  "v1=abc",
+			wantErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify("s3cr3t", tt.header, body, 5*time.Minute, now)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
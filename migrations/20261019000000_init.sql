@@ -0,0 +1,8 @@
+-- Create "items" table
+CREATE TABLE "items" ("id" character varying NOT NULL, "name" character varying NOT NULL, "category" character varying NOT NULL, "description" character varying NOT NULL, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create "item_translations" table
+CREATE TABLE "item_translations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "locale" character varying NOT NULL, "name" character varying NOT NULL, "description" character varying NOT NULL, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, "item_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "item_translations_items_translations" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "itemtranslation_item_id_locale" to table: "item_translations"
+CREATE UNIQUE INDEX "itemtranslation_item_id_locale" ON "item_translations" ("item_id", "locale");
+-- Create index "itemtranslation_locale" to table: "item_translations"
+CREATE INDEX "itemtranslation_locale" ON "item_translations" ("locale");
@@ -0,0 +1,10 @@
+-- Create "catalog_versions" table
+CREATE TABLE "catalog_versions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "changeset_id" bigint NOT NULL DEFAULT 0, "published_by" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create "changesets" table
+CREATE TABLE "changesets" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "title" character varying NOT NULL, "status" character varying NOT NULL, "author" character varying NOT NULL, "reviewer" character varying NOT NULL DEFAULT '', "catalog_version" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "changeset_status" to table: "changesets"
+CREATE INDEX "changeset_status" ON "changesets" ("status");
+-- Create "changeset_changes" table
+CREATE TABLE "changeset_changes" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "item_id" character varying NOT NULL, "op" character varying NOT NULL, "name" character varying NOT NULL DEFAULT '', "category" character varying NOT NULL DEFAULT '', "description" character varying NOT NULL DEFAULT '', "translations" jsonb NULL, "changeset_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "changeset_changes_changesets_changes" FOREIGN KEY ("changeset_id") REFERENCES "changesets" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "changesetchange_changeset_id_item_id" to table: "changeset_changes"
+CREATE UNIQUE INDEX "changesetchange_changeset_id_item_id" ON "changeset_changes" ("changeset_id", "item_id");
@@ -0,0 +1,2 @@
+-- Create "catalog_snapshots" table
+CREATE TABLE "catalog_snapshots" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "hash" character varying NOT NULL, "data" bytea NOT NULL, "created_at" bigint NOT NULL, PRIMARY KEY ("id"));
@@ -0,0 +1,6 @@
+-- Create "outbox_events" table
+CREATE TABLE "outbox_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "aggregate_type" character varying NOT NULL, "aggregate_id" character varying NOT NULL, "type" character varying NOT NULL, "payload" bytea NOT NULL, "status" character varying NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, "published_at" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- Create index "outboxevent_status" to table: "outbox_events"
+CREATE INDEX "outboxevent_status" ON "outbox_events" ("status");
+-- Create index "outboxevent_aggregate_type_aggregate_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_aggregate_type_aggregate_id" ON "outbox_events" ("aggregate_type", "aggregate_id");
@@ -0,0 +1,8 @@
+-- Create "webhook_subscriptions" table
+CREATE TABLE "webhook_subscriptions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "url" character varying NOT NULL, "events" jsonb NOT NULL, "secret" character varying NOT NULL, "description" character varying NOT NULL DEFAULT '', "active" boolean NOT NULL DEFAULT true, "created_by" character varying NOT NULL, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "event_id" bigint NOT NULL, "event_type" character varying NOT NULL, "payload" bytea NOT NULL, "status" character varying NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" bigint NOT NULL DEFAULT 0, "response_status" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "delivered_at" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, "subscription_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_webhook_subscriptions_deliveries" FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+-- Create index "webhookdelivery_subscription_id_event_id" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhookdelivery_subscription_id_event_id" ON "webhook_deliveries" ("subscription_id", "event_id");
@@ -0,0 +1,12 @@
+-- Create "job_runs" table
+CREATE TABLE "job_runs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "job" character varying NOT NULL, "trigger" character varying NOT NULL, "task_id" bigint NOT NULL DEFAULT 0, "status" character varying NOT NULL, "node" character varying NOT NULL, "scheduled_at" bigint NOT NULL DEFAULT 0, "started_at" bigint NOT NULL, "finished_at" bigint NOT NULL DEFAULT 0, "duration_ms" bigint NOT NULL DEFAULT 0, "error" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- Create index "jobrun_job" to table: "job_runs"
+CREATE INDEX "jobrun_job" ON "job_runs" ("job");
+-- Create index "jobrun_started_at" to table: "job_runs"
+CREATE INDEX "jobrun_started_at" ON "job_runs" ("started_at");
+-- Create "job_tasks" table
+CREATE TABLE "job_tasks" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "queue" character varying NOT NULL, "payload" bytea NOT NULL, "status" character varying NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "run_at" bigint NOT NULL, "last_error" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "jobtask_queue_status_run_at" to table: "job_tasks"
+CREATE INDEX "jobtask_queue_status_run_at" ON "job_tasks" ("queue", "status", "run_at");
+-- Create index "jobtask_updated_at" to table: "job_tasks"
+CREATE INDEX "jobtask_updated_at" ON "job_tasks" ("updated_at");
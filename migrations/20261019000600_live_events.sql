@@ -0,0 +1,4 @@
+-- Create "live_events" table
+CREATE TABLE "live_events" ("id" character varying NOT NULL, "kind" character varying NOT NULL DEFAULT 'event', "name" character varying NOT NULL, "description" character varying NOT NULL DEFAULT '', "timezone" character varying NOT NULL DEFAULT 'UTC', "start_at" bigint NOT NULL, "end_at" bigint NOT NULL, "item_ids" jsonb NOT NULL, "created_by" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "liveevent_start_at_end_at" to table: "live_events"
+CREATE INDEX "liveevent_start_at_end_at" ON "live_events" ("start_at", "end_at");
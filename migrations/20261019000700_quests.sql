@@ -0,0 +1,16 @@
+-- Create "quests" table
+CREATE TABLE "quests" ("id" character varying NOT NULL, "kind" character varying NOT NULL DEFAULT 'quest', "name" character varying NOT NULL, "description" character varying NOT NULL DEFAULT '', "objectives" jsonb NOT NULL, "rewards" jsonb NOT NULL, "active" boolean NOT NULL DEFAULT true, "created_by" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create "quest_progresses" table
+CREATE TABLE "quest_progresses" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "player_id" character varying NOT NULL, "quest_id" character varying NOT NULL, "status" character varying NOT NULL, "counts" jsonb NOT NULL, "completed_at" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "questprogress_player_id_quest_id" to table: "quest_progresses"
+CREATE UNIQUE INDEX "questprogress_player_id_quest_id" ON "quest_progresses" ("player_id", "quest_id");
+-- Create "player_events" table
+CREATE TABLE "player_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "player_id" character varying NOT NULL, "event_id" character varying NOT NULL, "type" character varying NOT NULL, "created_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "playerevent_player_id_event_id" to table: "player_events"
+CREATE UNIQUE INDEX "playerevent_player_id_event_id" ON "player_events" ("player_id", "event_id");
+-- Create index "playerevent_created_at" to table: "player_events"
+CREATE INDEX "playerevent_created_at" ON "player_events" ("created_at");
+-- Create "inventory_items" table
+CREATE TABLE "inventory_items" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "player_id" character varying NOT NULL, "item_id" character varying NOT NULL, "quantity" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "inventoryitem_player_id_item_id" to table: "inventory_items"
+CREATE UNIQUE INDEX "inventoryitem_player_id_item_id" ON "inventory_items" ("player_id", "item_id");
@@ -0,0 +1,10 @@
+-- Create "leaderboards" table
+CREATE TABLE "leaderboards" ("id" character varying NOT NULL, "name" character varying NOT NULL, "metric" character varying NOT NULL, "policy" character varying NOT NULL DEFAULT 'best', "reset" character varying NOT NULL, "timezone" character varying NOT NULL DEFAULT 'UTC', "created_by" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "leaderboard_metric" to table: "leaderboards"
+CREATE INDEX "leaderboard_metric" ON "leaderboards" ("metric");
+-- Create "leaderboard_snapshots" table
+CREATE TABLE "leaderboard_snapshots" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "leaderboard_id" character varying NOT NULL, "period" character varying NOT NULL, "period_start" bigint NOT NULL, "period_end" bigint NOT NULL, "entries" jsonb NOT NULL, "created_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "leaderboardsnapshot_leaderboard_id_period" to table: "leaderboard_snapshots"
+CREATE UNIQUE INDEX "leaderboardsnapshot_leaderboard_id_period" ON "leaderboard_snapshots" ("leaderboard_id", "period");
+-- Create index "leaderboardsnapshot_leaderboard_id_period_end" to table: "leaderboard_snapshots"
+CREATE INDEX "leaderboardsnapshot_leaderboard_id_period_end" ON "leaderboard_snapshots" ("leaderboard_id", "period_end");
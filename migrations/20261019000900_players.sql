@@ -0,0 +1,22 @@
+-- Create "players" table
+CREATE TABLE "players" ("id" character varying NOT NULL, "display_name" character varying NOT NULL, "region" character varying NOT NULL DEFAULT '', "banned" boolean NOT NULL DEFAULT false, "ban_reason" character varying NOT NULL DEFAULT '', "banned_until" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "player_region" to table: "players"
+CREATE INDEX "player_region" ON "players" ("region");
+-- Create players of existing inventories
+INSERT INTO "players" ("id", "display_name", "created_at", "updated_at") SELECT "player_id", "player_id", MIN("created_at"), MAX("updated_at") FROM "inventory_items" GROUP BY "player_id";
+-- Modify "inventory_items" table
+ALTER TABLE "inventory_items" ADD CONSTRAINT "inventory_items_players_inventory" FOREIGN KEY ("player_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create "player_accounts" table
+CREATE TABLE "player_accounts" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "provider" character varying NOT NULL, "subject" character varying NOT NULL, "created_at" bigint NOT NULL, "player_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "player_accounts_players_accounts" FOREIGN KEY ("player_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "playeraccount_provider_subject" to table: "player_accounts"
+CREATE UNIQUE INDEX "playeraccount_provider_subject" ON "player_accounts" ("provider", "subject");
+-- Create index "playeraccount_player_id_provider" to table: "player_accounts"
+CREATE UNIQUE INDEX "playeraccount_player_id_provider" ON "player_accounts" ("player_id", "provider");
+-- Create "wallets" table
+CREATE TABLE "wallets" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "currency" character varying NOT NULL, "balance" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, "player_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "wallets_players_wallets" FOREIGN KEY ("player_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "wallet_player_id_currency" to table: "wallets"
+CREATE UNIQUE INDEX "wallet_player_id_currency" ON "wallets" ("player_id", "currency");
+-- Create "ledger_entries" table
+CREATE TABLE "ledger_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "asset_type" character varying NOT NULL, "asset" character varying NOT NULL, "delta" bigint NOT NULL, "balance" bigint NOT NULL, "reason" character varying NOT NULL, "reference" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, "player_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "ledger_entries_players_ledger" FOREIGN KEY ("player_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "ledgerentry_player_id_id" to table: "ledger_entries"
+CREATE INDEX "ledgerentry_player_id_id" ON "ledger_entries" ("player_id", "id");
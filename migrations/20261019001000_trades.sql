@@ -0,0 +1,8 @@
+-- Create "trades" table
+CREATE TABLE "trades" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "offered" jsonb NOT NULL, "requested" jsonb NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "expires_at" bigint NOT NULL, "resolved_at" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, "proposer_id" character varying NOT NULL, "recipient_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "trades_players_proposed_trades" FOREIGN KEY ("proposer_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "trades_players_received_trades" FOREIGN KEY ("recipient_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "trade_proposer_id_status" to table: "trades"
+CREATE INDEX "trade_proposer_id_status" ON "trades" ("proposer_id", "status");
+-- Create index "trade_recipient_id_status" to table: "trades"
+CREATE INDEX "trade_recipient_id_status" ON "trades" ("recipient_id", "status");
+-- Create index "trade_status_expires_at" to table: "trades"
+CREATE INDEX "trade_status_expires_at" ON "trades" ("status", "expires_at");
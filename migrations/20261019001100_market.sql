@@ -0,0 +1,10 @@
+-- Create "market_fills" table
+CREATE TABLE "market_fills" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "item_id" character varying NOT NULL, "currency" character varying NOT NULL, "price" bigint NOT NULL, "quantity" bigint NOT NULL, "fee" bigint NOT NULL DEFAULT 0, "sell_order_id" bigint NOT NULL, "buy_order_id" bigint NOT NULL, "seller_id" character varying NOT NULL, "buyer_id" character varying NOT NULL, "created_at" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "marketfill_item_id_created_at" to table: "market_fills"
+CREATE INDEX "marketfill_item_id_created_at" ON "market_fills" ("item_id", "created_at");
+-- Create "market_orders" table
+CREATE TABLE "market_orders" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "side" character varying NOT NULL, "item_id" character varying NOT NULL, "currency" character varying NOT NULL, "price" bigint NOT NULL, "quantity" bigint NOT NULL, "remaining" bigint NOT NULL, "status" character varying NOT NULL DEFAULT 'open', "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, "player_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "market_orders_players_market_orders" FOREIGN KEY ("player_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "marketorder_item_id_side_status_price" to table: "market_orders"
+CREATE INDEX "marketorder_item_id_side_status_price" ON "market_orders" ("item_id", "side", "status", "price");
+-- Create index "marketorder_player_id_status" to table: "market_orders"
+CREATE INDEX "marketorder_player_id_status" ON "market_orders" ("player_id", "status");
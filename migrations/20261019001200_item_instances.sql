@@ -0,0 +1,10 @@
+-- Create "item_instances" table
+CREATE TABLE "item_instances" ("id" character varying NOT NULL, "item_id" character varying NOT NULL, "durability" bigint NOT NULL DEFAULT 0, "max_durability" bigint NOT NULL DEFAULT 0, "stats" jsonb NULL, "status" character varying NOT NULL DEFAULT 'active', "burned_at" bigint NOT NULL DEFAULT 0, "created_at" bigint NOT NULL, "updated_at" bigint NOT NULL, "owner_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "item_instances_players_item_instances" FOREIGN KEY ("owner_id") REFERENCES "players" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "iteminstance_item_id_status" to table: "item_instances"
+CREATE INDEX "iteminstance_item_id_status" ON "item_instances" ("item_id", "status");
+-- Create index "iteminstance_owner_id_status" to table: "item_instances"
+CREATE INDEX "iteminstance_owner_id_status" ON "item_instances" ("owner_id", "status");
+-- Create "item_instance_histories" table
+CREATE TABLE "item_instance_histories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "action" character varying NOT NULL, "from_player_id" character varying NOT NULL DEFAULT '', "to_player_id" character varying NOT NULL DEFAULT '', "actor" character varying NOT NULL, "reason" character varying NOT NULL DEFAULT '', "created_at" bigint NOT NULL, "instance_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "item_instance_histories_item_instances_history" FOREIGN KEY ("instance_id") REFERENCES "item_instances" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "iteminstancehistory_instance_id" to table: "item_instance_histories"
+CREATE INDEX "iteminstancehistory_instance_id" ON "item_instance_histories" ("instance_id");
@@ -0,0 +1,4 @@
+-- Drop "item_translations" table
+DROP TABLE "item_translations";
+-- Drop "items" table
+DROP TABLE "items";
@@ -0,0 +1,6 @@
+-- Drop "changeset_changes" table
+DROP TABLE "changeset_changes";
+-- Drop "changesets" table
+DROP TABLE "changesets";
+-- Drop "catalog_versions" table
+DROP TABLE "catalog_versions";
@@ -0,0 +1,2 @@
+-- Drop "catalog_snapshots" table
+DROP TABLE "catalog_snapshots";
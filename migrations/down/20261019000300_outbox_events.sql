@@ -0,0 +1,2 @@
+-- Drop "outbox_events" table
+DROP TABLE "outbox_events";
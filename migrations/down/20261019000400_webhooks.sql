@@ -0,0 +1,4 @@
+-- Drop "webhook_deliveries" table
+DROP TABLE "webhook_deliveries";
+-- Drop "webhook_subscriptions" table
+DROP TABLE "webhook_subscriptions";
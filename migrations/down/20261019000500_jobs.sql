@@ -0,0 +1,4 @@
+-- Drop "job_tasks" table
+DROP TABLE "job_tasks";
+-- Drop "job_runs" table
+DROP TABLE "job_runs";
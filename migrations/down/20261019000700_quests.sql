@@ -0,0 +1,8 @@
+-- Drop "inventory_items" table
+DROP TABLE "inventory_items";
+-- Drop "player_events" table
+DROP TABLE "player_events";
+-- Drop "quest_progresses" table
+DROP TABLE "quest_progresses";
+-- Drop "quests" table
+DROP TABLE "quests";
@@ -0,0 +1,4 @@
+-- Drop "leaderboard_snapshots" table
+DROP TABLE "leaderboard_snapshots";
+-- Drop "leaderboards" table
+DROP TABLE "leaderboards";
@@ -0,0 +1,10 @@
+-- Drop "ledger_entries" table
+DROP TABLE "ledger_entries";
+-- Drop "wallets" table
+DROP TABLE "wallets";
+-- Drop "player_accounts" table
+DROP TABLE "player_accounts";
+-- Modify "inventory_items" table
+ALTER TABLE "inventory_items" DROP CONSTRAINT "inventory_items_players_inventory";
+-- Drop "players" table
+DROP TABLE "players";
@@ -0,0 +1,2 @@
+-- Drop "trades" table
+DROP TABLE "trades";
@@ -0,0 +1,4 @@
+-- Drop "market_orders" table
+DROP TABLE "market_orders";
+-- Drop "market_fills" table
+DROP TABLE "market_fills";
@@ -0,0 +1,4 @@
+-- Drop "item_instance_histories" table
+DROP TABLE "item_instance_histories";
+-- Drop "item_instances" table
+DROP TABLE "item_instances";
@@ -0,0 +1,2 @@
+-- Modify "changesets" table
+ALTER TABLE "changesets" DROP COLUMN "editors";